@@ -4,6 +4,8 @@ import (
 	adminModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admin"
 	adminContentModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincontent"
 	adminGameBindingsModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admingamebindings"
+	adminHarukiBotModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/adminharukibot"
+	adminMailModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/adminmail"
 	adminOAuthModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/adminoauth"
 	adminRiskModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/adminrisk"
 	adminSponsorModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/adminsponsor"
@@ -21,15 +23,18 @@ import (
 	subscriptionModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/subscription"
 	uploadModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/upload"
 	userModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/user"
+	userAccountDeletionModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/useraccountdeletion"
 	userActivityModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/useractivity"
 	userAuthModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userauth"
 	userAuthorizeSocialModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userauthorizesocial"
+	userDataExportModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userdataexport"
 	userGameBindingsModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usergamebindings"
 	userInfoModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userinfo"
 	userOAuthModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/useroauth"
 	userPasswordResetModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userpasswordreset"
 	userPrivateAPIModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userprivateapi"
 	userProfileModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userprofile"
+	userSecondFactorModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usersecondfactor"
 	userSocialModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usersocial"
 	userTicketsModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usertickets"
 	webhookModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/webhook"
@@ -55,6 +60,8 @@ func registerAdminRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 	adminUsersModule.RegisterAdminUserRoutes(apiHelper)
 	adminContentModule.RegisterAdminContentRoutes(apiHelper)
 	adminGameBindingsModule.RegisterAdminGlobalGameAccountBindingRoutes(apiHelper)
+	adminHarukiBotModule.RegisterAdminHarukiBotRoutes(apiHelper)
+	adminMailModule.RegisterAdminMailRoutes(apiHelper)
 	adminOAuthModule.RegisterAdminOAuthClientRoutes(apiHelper)
 	adminRiskModule.RegisterAdminRiskRoutes(apiHelper)
 	adminSponsorModule.RegisterAdminSponsorRoutes(apiHelper)
@@ -77,4 +84,7 @@ func registerUserRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
 	userGameBindingsModule.RegisterUserGameAccountBindingRoutes(apiHelper)
 	userActivityModule.RegisterUserActivityLogRoutes(apiHelper)
 	userTicketsModule.RegisterUserTicketRoutes(apiHelper)
+	userDataExportModule.RegisterUserDataExportRoutes(apiHelper)
+	userAccountDeletionModule.RegisterUserAccountDeletionRoutes(apiHelper)
+	userSecondFactorModule.RegisterUserSecondFactorRoutes(apiHelper)
 }
@@ -357,3 +357,44 @@ func sameExistingPath(a, b string) bool {
 	}
 	return filepath.Clean(a) == filepath.Clean(b)
 }
+
+func TestProviderDefinitions(t *testing.T) {
+	t.Run("legacy fields", func(t *testing.T) {
+		cfg := ThirdPartyDataProviderConfig{
+			Endpoint8823:            "https://8823/upload",
+			Secret8823:              "sec8823",
+			SendJSONZstandard8823:   true,
+			RestoreSuite8823:        true,
+			CheckURL8823:            "https://8823/check",
+			EndpointSakura:          "https://sakura/upload",
+			SendJSONZstandardSakura: true,
+			CheckEnabledSakura:      true,
+			CheckURLSakura:          "https://sakura/check",
+		}
+		providers := cfg.ProviderDefinitions()
+		if len(providers) != 2 {
+			t.Fatalf("len(providers) = %d, want 2 (providers without endpoint are skipped)", len(providers))
+		}
+		p8823, sakura := providers[0], providers[1]
+		if p8823.Name != "8823" || p8823.Auth != "x-credentials" || p8823.Format != "restored" || p8823.CheckURL != "" {
+			t.Fatalf("unexpected 8823 provider: %+v", p8823)
+		}
+		if p8823.Privacy.ConsentKey != "allow8823" || len(p8823.DataTypes) != 3 {
+			t.Fatalf("unexpected 8823 privacy/data types: %+v", p8823)
+		}
+		if sakura.Format != "json-zstd" || sakura.CheckURL != "https://sakura/check" || len(sakura.DataTypes) != 1 {
+			t.Fatalf("unexpected sakura provider: %+v", sakura)
+		}
+	})
+
+	t.Run("provider list wins", func(t *testing.T) {
+		cfg := ThirdPartyDataProviderConfig{
+			Providers:    []DataProviderConfig{{Name: "partner"}},
+			Endpoint8823: "https://8823/upload",
+		}
+		providers := cfg.ProviderDefinitions()
+		if len(providers) != 1 || providers[0].Name != "partner" {
+			t.Fatalf("providers = %+v, want only partner", providers)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package config
+
+import "strings"
+
+type legacyDataProvider struct {
+	name              string
+	consentKey        string
+	endpoint          string
+	secret            string
+	sendJSONZstandard bool
+	checkEnabled      bool
+	checkURL          string
+	restoreSuite      bool
+	auth              string
+	dataTypes         []string
+}
+
+// ProviderDefinitions returns Providers, or when it is empty, the providers
+// described by the deprecated per-partner fields. A legacy provider without
+// an endpoint is left out.
+func (c ThirdPartyDataProviderConfig) ProviderDefinitions() []DataProviderConfig {
+	if len(c.Providers) > 0 {
+		return append([]DataProviderConfig(nil), c.Providers...)
+	}
+
+	suiteAndMysekai := []string{"suite", "mysekai", "mysekai_birthday_party"}
+	legacy := []legacyDataProvider{
+		{
+			name:              "8823",
+			consentKey:        "allow8823",
+			endpoint:          c.Endpoint8823,
+			secret:            c.Secret8823,
+			sendJSONZstandard: c.SendJSONZstandard8823,
+			checkEnabled:      c.CheckEnabled8823,
+			checkURL:          c.CheckURL8823,
+			restoreSuite:      c.RestoreSuite8823,
+			auth:              "x-credentials",
+			dataTypes:         suiteAndMysekai,
+		},
+		{
+			name:              "sakura",
+			consentKey:        "allowSakura",
+			endpoint:          c.EndpointSakura,
+			secret:            c.SecretSakura,
+			sendJSONZstandard: c.SendJSONZstandardSakura,
+			checkEnabled:      c.CheckEnabledSakura,
+			checkURL:          c.CheckURLSakura,
+			restoreSuite:      c.RestoreSuiteSakura,
+			auth:              "bearer",
+			dataTypes:         []string{"suite"},
+		},
+		{
+			name:              "resona",
+			consentKey:        "allowResona",
+			endpoint:          c.EndpointResona,
+			secret:            c.SecretResona,
+			sendJSONZstandard: c.SendJSONZstandardResona,
+			checkEnabled:      c.CheckEnabledResona,
+			checkURL:          c.CheckURLResona,
+			restoreSuite:      c.RestoreSuiteResona,
+			auth:              "bearer",
+			dataTypes:         suiteAndMysekai,
+		},
+		{
+			name:              "luna",
+			consentKey:        "allowLuna",
+			endpoint:          c.EndpointLuna,
+			secret:            c.SecretLuna,
+			sendJSONZstandard: c.SendJSONZstandardLuna,
+			checkEnabled:      c.CheckEnabledLuna,
+			checkURL:          c.CheckURLLuna,
+			restoreSuite:      c.RestoreSuiteLuna,
+			auth:              "bearer",
+			dataTypes:         suiteAndMysekai,
+		},
+	}
+
+	providers := make([]DataProviderConfig, 0, len(legacy))
+	for _, p := range legacy {
+		if strings.TrimSpace(p.endpoint) == "" {
+			continue
+		}
+		format := "raw"
+		if p.sendJSONZstandard {
+			format = "json-zstd"
+			if p.restoreSuite {
+				format = "restored"
+			}
+		}
+		checkURL := ""
+		if p.checkEnabled {
+			checkURL = p.checkURL
+		}
+		providers = append(providers, DataProviderConfig{
+			Name:      p.name,
+			Enabled:   true,
+			Endpoint:  p.endpoint,
+			Secret:    p.secret,
+			Auth:      p.auth,
+			Format:    format,
+			CheckURL:  checkURL,
+			DataTypes: append([]string(nil), p.dataTypes...),
+			Privacy:   DataProviderPrivacyConfig{ConsentKey: p.consentKey},
+		})
+	}
+	return providers
+}
@@ -11,6 +11,22 @@ func defaultConfig() Config {
 			AutoMigrate:     false,
 			ShutdownTimeout: 10,
 		},
+		Metrics: MetricsConfig{
+			Path: "/metrics",
+		},
+		Tracing: TracingConfig{
+			ServiceName: "haruki-toolbox-backend",
+			Exporter:    "otlp",
+			SampleRatio: 1,
+		},
+		MongoDB: MongoDBConfig{
+			History: SnapshotHistoryConfig{
+				Enabled:             true,
+				Collection:          "snapshot_history",
+				DefaultMaxRevisions: 30,
+				DefaultMaxAgeDays:   90,
+			},
+		},
 		OAuth2: OAuth2Config{
 			Provider:                  "hydra",
 			HydraRequestTimeoutSecond: 10,
@@ -34,6 +50,20 @@ func defaultConfig() Config {
 		},
 		Webhook: WebhookConfig{
 			Enabled: true,
+			Delivery: WebhookDeliveryConfig{
+				MaxAttempts:         8,
+				BaseBackoffSeconds:  30,
+				MaxBackoffSeconds:   3600,
+				PollIntervalSeconds: 5,
+				BatchSize:           50,
+				Concurrency:         8,
+			},
+			Health: WebhookHealthConfig{
+				AutoDisableEnabled:                 true,
+				AutoDisableFailureThreshold:        20,
+				AutoDisableMinFailureWindowSeconds: 86400,
+				AttemptRetentionDays:               30,
+			},
 		},
 		Afdian: AfdianConfig{
 			APIBaseURL:           "https://afdian.com/api/open",
@@ -41,6 +71,46 @@ func defaultConfig() Config {
 			SyncEnabled:          true,
 			SyncIntervalSeconds:  300,
 		},
+		BlobStorage: BlobStorageConfig{
+			Backend:  "local",
+			LocalDir: "./data/blobs",
+			S3: S3BlobStorageConfig{
+				Region:                "us-east-1",
+				RequestTimeoutSeconds: 30,
+			},
+		},
+		Tickets: TicketsConfig{
+			Attachments: TicketAttachmentConfig{
+				Enabled:            true,
+				MaxFileSizeBytes:   10 * 1024 * 1024,
+				MaxFilesPerMessage: 5,
+				MaxImagePixels:     8192 * 8192,
+				ThumbnailMaxSize:   320,
+			},
+			SLA: TicketSLAConfig{
+				Enabled:              true,
+				SweepIntervalSeconds: 300,
+				FirstResponseMinutes: defaultTicketFirstResponseMinutes(),
+				ResolutionMinutes:    defaultTicketResolutionMinutes(),
+				AutoCloseEnabled:     true,
+				AutoCloseIdleHours:   168,
+			},
+		},
+		RiskEngine: RiskEngineConfig{
+			Enabled:              true,
+			QueueSize:            1024,
+			RuleRefreshSeconds:   30,
+			SweepIntervalSeconds: 60,
+		},
+		SekaiClient: SekaiClientConfig{
+			InheritJobs: InheritJobConfig{
+				Concurrency:        2,
+				MaxQueuedPerServer: 100,
+				JobTimeoutSeconds:  300,
+				StaleAfterSeconds:  900,
+				RetentionHours:     24,
+			},
+		},
 		Subscription: SubscriptionConfig{
 			UserAgent:            "Haruki-Toolbox-Backend",
 			RequestTimeoutSecond: 5,
@@ -48,10 +118,37 @@ func defaultConfig() Config {
 	}
 }
 
+func defaultTicketFirstResponseMinutes() map[string]int {
+	return map[string]int{
+		"urgent": 60,
+		"high":   4 * 60,
+		"normal": 24 * 60,
+		"low":    72 * 60,
+	}
+}
+
+func defaultTicketResolutionMinutes() map[string]int {
+	return map[string]int{
+		"urgent": 24 * 60,
+		"high":   72 * 60,
+		"normal": 7 * 24 * 60,
+		"low":    14 * 24 * 60,
+	}
+}
+
 func normalizeConfigDefaults(cfg *Config) error {
 	if cfg.Backend.ShutdownTimeout <= 0 {
 		cfg.Backend.ShutdownTimeout = 10
 	}
+	if strings.TrimSpace(cfg.MongoDB.History.Collection) == "" {
+		cfg.MongoDB.History.Collection = "snapshot_history"
+	}
+	if cfg.MongoDB.History.DefaultMaxRevisions < 0 {
+		cfg.MongoDB.History.DefaultMaxRevisions = 0
+	}
+	if cfg.MongoDB.History.DefaultMaxAgeDays < 0 {
+		cfg.MongoDB.History.DefaultMaxAgeDays = 0
+	}
 	if cfg.UserSystem.SMTP.TimeoutSeconds <= 0 {
 		cfg.UserSystem.SMTP.TimeoutSeconds = 10
 	}
@@ -100,6 +197,33 @@ func normalizeConfigDefaults(cfg *Config) error {
 	if cfg.Subscription.RequestTimeoutSecond <= 0 {
 		cfg.Subscription.RequestTimeoutSecond = 5
 	}
+	if cfg.Webhook.Delivery.MaxAttempts <= 0 {
+		cfg.Webhook.Delivery.MaxAttempts = 8
+	}
+	if cfg.Webhook.Delivery.BaseBackoffSeconds <= 0 {
+		cfg.Webhook.Delivery.BaseBackoffSeconds = 30
+	}
+	if cfg.Webhook.Delivery.MaxBackoffSeconds < cfg.Webhook.Delivery.BaseBackoffSeconds {
+		cfg.Webhook.Delivery.MaxBackoffSeconds = cfg.Webhook.Delivery.BaseBackoffSeconds
+	}
+	if cfg.Webhook.Delivery.PollIntervalSeconds <= 0 {
+		cfg.Webhook.Delivery.PollIntervalSeconds = 5
+	}
+	if cfg.Webhook.Delivery.BatchSize <= 0 {
+		cfg.Webhook.Delivery.BatchSize = 50
+	}
+	if cfg.Webhook.Delivery.Concurrency <= 0 {
+		cfg.Webhook.Delivery.Concurrency = 8
+	}
+	if cfg.Webhook.Health.AutoDisableFailureThreshold <= 0 {
+		cfg.Webhook.Health.AutoDisableFailureThreshold = 20
+	}
+	if cfg.Webhook.Health.AutoDisableMinFailureWindowSeconds < 0 {
+		cfg.Webhook.Health.AutoDisableMinFailureWindowSeconds = 0
+	}
+	if cfg.Webhook.Health.AttemptRetentionDays <= 0 {
+		cfg.Webhook.Health.AttemptRetentionDays = 30
+	}
 	if strings.TrimSpace(cfg.Afdian.APIBaseURL) == "" {
 		cfg.Afdian.APIBaseURL = "https://afdian.com/api/open"
 	}
@@ -112,6 +236,72 @@ func normalizeConfigDefaults(cfg *Config) error {
 	if cfg.Afdian.SyncIntervalSeconds < 60 {
 		cfg.Afdian.SyncIntervalSeconds = 60
 	}
+	if strings.TrimSpace(cfg.Metrics.Path) == "" {
+		cfg.Metrics.Path = "/metrics"
+	}
+	if strings.TrimSpace(cfg.Tracing.ServiceName) == "" {
+		cfg.Tracing.ServiceName = "haruki-toolbox-backend"
+	}
+	if strings.TrimSpace(cfg.Tracing.Exporter) == "" {
+		cfg.Tracing.Exporter = "otlp"
+	}
+	if strings.TrimSpace(cfg.BlobStorage.Backend) == "" {
+		cfg.BlobStorage.Backend = "local"
+	}
+	if strings.TrimSpace(cfg.BlobStorage.LocalDir) == "" {
+		cfg.BlobStorage.LocalDir = "./data/blobs"
+	}
+	if cfg.BlobStorage.S3.RequestTimeoutSeconds <= 0 {
+		cfg.BlobStorage.S3.RequestTimeoutSeconds = 30
+	}
+	if cfg.Tickets.Attachments.MaxFileSizeBytes <= 0 {
+		cfg.Tickets.Attachments.MaxFileSizeBytes = 10 * 1024 * 1024
+	}
+	if cfg.Tickets.Attachments.MaxFilesPerMessage <= 0 {
+		cfg.Tickets.Attachments.MaxFilesPerMessage = 5
+	}
+	if cfg.Tickets.Attachments.MaxImagePixels <= 0 {
+		cfg.Tickets.Attachments.MaxImagePixels = 8192 * 8192
+	}
+	if cfg.Tickets.Attachments.ThumbnailMaxSize <= 0 {
+		cfg.Tickets.Attachments.ThumbnailMaxSize = 320
+	}
+	if cfg.Tickets.SLA.SweepIntervalSeconds <= 0 {
+		cfg.Tickets.SLA.SweepIntervalSeconds = 300
+	}
+	if cfg.Tickets.SLA.FirstResponseMinutes == nil {
+		cfg.Tickets.SLA.FirstResponseMinutes = defaultTicketFirstResponseMinutes()
+	}
+	if cfg.Tickets.SLA.ResolutionMinutes == nil {
+		cfg.Tickets.SLA.ResolutionMinutes = defaultTicketResolutionMinutes()
+	}
+	if cfg.Tickets.SLA.AutoCloseIdleHours <= 0 {
+		cfg.Tickets.SLA.AutoCloseIdleHours = 168
+	}
+	if cfg.RiskEngine.QueueSize <= 0 {
+		cfg.RiskEngine.QueueSize = 1024
+	}
+	if cfg.RiskEngine.RuleRefreshSeconds <= 0 {
+		cfg.RiskEngine.RuleRefreshSeconds = 30
+	}
+	if cfg.RiskEngine.SweepIntervalSeconds <= 0 {
+		cfg.RiskEngine.SweepIntervalSeconds = 60
+	}
+	if cfg.SekaiClient.InheritJobs.Concurrency <= 0 {
+		cfg.SekaiClient.InheritJobs.Concurrency = 2
+	}
+	if cfg.SekaiClient.InheritJobs.MaxQueuedPerServer <= 0 {
+		cfg.SekaiClient.InheritJobs.MaxQueuedPerServer = 100
+	}
+	if cfg.SekaiClient.InheritJobs.JobTimeoutSeconds <= 0 {
+		cfg.SekaiClient.InheritJobs.JobTimeoutSeconds = 300
+	}
+	if cfg.SekaiClient.InheritJobs.StaleAfterSeconds <= 0 {
+		cfg.SekaiClient.InheritJobs.StaleAfterSeconds = 900
+	}
+	if cfg.SekaiClient.InheritJobs.RetentionHours <= 0 {
+		cfg.SekaiClient.InheritJobs.RetentionHours = 24
+	}
 
 	return nil
 }
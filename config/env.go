@@ -31,6 +31,16 @@ func applyEnvOverrides(cfg *Config) error {
 	overrideString(&cfg.MongoDB.Mysekai, "MONGODB_MYSEKAI_COLLECTION")
 	overrideString(&cfg.MongoDB.PrivateApiSecret, "PRIVATE_API_SECRET")
 	overrideString(&cfg.MongoDB.PrivateApiUserAgent, "PRIVATE_API_USER_AGENT")
+	if err := overrideBool(&cfg.MongoDB.History.Enabled, "MONGODB_HISTORY_ENABLED"); err != nil {
+		return err
+	}
+	overrideString(&cfg.MongoDB.History.Collection, "MONGODB_HISTORY_COLLECTION")
+	if err := overrideInt(&cfg.MongoDB.History.DefaultMaxRevisions, "MONGODB_HISTORY_MAX_REVISIONS"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.MongoDB.History.DefaultMaxAgeDays, "MONGODB_HISTORY_MAX_AGE_DAYS"); err != nil {
+		return err
+	}
 
 	overrideString(&cfg.Redis.Host, "REDIS_HOST")
 	if err := overrideInt(&cfg.Redis.Port, "REDIS_PORT"); err != nil {
@@ -42,6 +52,27 @@ func applyEnvOverrides(cfg *Config) error {
 	if err := overrideBool(&cfg.Webhook.Enabled, "WEBHOOK_ENABLED"); err != nil {
 		return err
 	}
+	if err := overrideInt(&cfg.Webhook.Delivery.MaxAttempts, "WEBHOOK_DELIVERY_MAX_ATTEMPTS"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Webhook.Delivery.BaseBackoffSeconds, "WEBHOOK_DELIVERY_BASE_BACKOFF_SECONDS"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Webhook.Delivery.MaxBackoffSeconds, "WEBHOOK_DELIVERY_MAX_BACKOFF_SECONDS"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Webhook.Delivery.Concurrency, "WEBHOOK_DELIVERY_CONCURRENCY"); err != nil {
+		return err
+	}
+	if err := overrideBool(&cfg.Webhook.Health.AutoDisableEnabled, "WEBHOOK_AUTO_DISABLE_ENABLED"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Webhook.Health.AutoDisableFailureThreshold, "WEBHOOK_AUTO_DISABLE_FAILURE_THRESHOLD"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Webhook.Health.AttemptRetentionDays, "WEBHOOK_ATTEMPT_RETENTION_DAYS"); err != nil {
+		return err
+	}
 
 	overrideString(&cfg.Afdian.UserID, "AFDIAN_USER_ID")
 	overrideString(&cfg.Afdian.APIToken, "AFDIAN_API_TOKEN", "AFDIAN_API_KEY")
@@ -57,6 +88,53 @@ func applyEnvOverrides(cfg *Config) error {
 		return err
 	}
 
+	if err := overrideBool(&cfg.Metrics.Enabled, "METRICS_ENABLED"); err != nil {
+		return err
+	}
+	overrideString(&cfg.Metrics.Token, "METRICS_TOKEN")
+	overrideString(&cfg.Metrics.ListenAddr, "METRICS_LISTEN_ADDR")
+	if err := overrideBool(&cfg.Tracing.Enabled, "TRACING_ENABLED"); err != nil {
+		return err
+	}
+	overrideString(&cfg.Tracing.Exporter, "TRACING_EXPORTER")
+	overrideString(&cfg.Tracing.OTLPEndpoint, "TRACING_OTLP_ENDPOINT")
+	overrideString(&cfg.Tracing.StdoutPath, "TRACING_STDOUT_PATH")
+	overrideString(&cfg.BlobStorage.Backend, "BLOB_STORAGE_BACKEND")
+	overrideString(&cfg.BlobStorage.LocalDir, "BLOB_STORAGE_LOCAL_DIR")
+	overrideString(&cfg.BlobStorage.S3.Endpoint, "BLOB_STORAGE_S3_ENDPOINT")
+	overrideString(&cfg.BlobStorage.S3.Region, "BLOB_STORAGE_S3_REGION")
+	overrideString(&cfg.BlobStorage.S3.Bucket, "BLOB_STORAGE_S3_BUCKET")
+	overrideString(&cfg.BlobStorage.S3.AccessKeyID, "BLOB_STORAGE_S3_ACCESS_KEY_ID")
+	overrideString(&cfg.BlobStorage.S3.SecretAccessKey, "BLOB_STORAGE_S3_SECRET_ACCESS_KEY")
+	if err := overrideBool(&cfg.BlobStorage.S3.ForcePathStyle, "BLOB_STORAGE_S3_FORCE_PATH_STYLE"); err != nil {
+		return err
+	}
+	if err := overrideBool(&cfg.Tickets.Attachments.Enabled, "TICKET_ATTACHMENTS_ENABLED"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Tickets.Attachments.MaxFilesPerMessage, "TICKET_ATTACHMENTS_MAX_FILES_PER_MESSAGE"); err != nil {
+		return err
+	}
+	if err := overrideBool(&cfg.Tickets.SLA.Enabled, "TICKET_SLA_ENABLED"); err != nil {
+		return err
+	}
+	if err := overrideBool(&cfg.Tickets.SLA.AutoCloseEnabled, "TICKET_AUTO_CLOSE_ENABLED"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.Tickets.SLA.AutoCloseIdleHours, "TICKET_AUTO_CLOSE_IDLE_HOURS"); err != nil {
+		return err
+	}
+
+	if err := overrideBool(&cfg.RiskEngine.Enabled, "RISK_ENGINE_ENABLED"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.RiskEngine.QueueSize, "RISK_ENGINE_QUEUE_SIZE"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.RiskEngine.RuleRefreshSeconds, "RISK_ENGINE_RULE_REFRESH_SECONDS"); err != nil {
+		return err
+	}
+
 	overrideString(&cfg.UserSystem.DBType, "HARUKI_DB_TYPE")
 	overrideString(&cfg.UserSystem.DBURL, "HARUKI_DB_URL")
 	overrideString(&cfg.UserSystem.CloudflareSecret, "CLOUDFLARE_SECRET")
@@ -123,6 +201,7 @@ func applyEnvOverrides(cfg *Config) error {
 		return err
 	}
 	overrideString(&cfg.Backend.LogLevel, "BACKEND_LOG_LEVEL")
+	overrideString(&cfg.Backend.LogFormat, "BACKEND_LOG_FORMAT")
 	overrideString(&cfg.Backend.MainLogFile, "BACKEND_MAIN_LOG_FILE")
 	overrideString(&cfg.Backend.AccessLog, "BACKEND_ACCESS_LOG")
 	overrideString(&cfg.Backend.AccessLogPath, "BACKEND_ACCESS_LOG_PATH")
@@ -151,6 +230,15 @@ func applyEnvOverrides(cfg *Config) error {
 
 	overrideString(&cfg.SekaiAPI.APIEndpoint, "SEKAI_API_ENDPOINT")
 	overrideString(&cfg.SekaiAPI.APIToken, "SEKAI_API_TOKEN")
+	if err := overrideInt(&cfg.SekaiClient.InheritJobs.Concurrency, "INHERIT_JOB_CONCURRENCY"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.SekaiClient.InheritJobs.MaxQueuedPerServer, "INHERIT_JOB_MAX_QUEUED_PER_SERVER"); err != nil {
+		return err
+	}
+	if err := overrideInt(&cfg.SekaiClient.InheritJobs.JobTimeoutSeconds, "INHERIT_JOB_TIMEOUT_SECONDS"); err != nil {
+		return err
+	}
 
 	overrideString(&cfg.HarukiProxy.UserAgent, "HARUKI_PROXY_USER_AGENT")
 	overrideString(&cfg.HarukiProxy.Version, "HARUKI_PROXY_VERSION")
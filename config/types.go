@@ -6,12 +6,30 @@ type RestoreSuiteConfig struct {
 }
 
 type MongoDBConfig struct {
-	URL                 string `yaml:"url"`
-	DB                  string `yaml:"db"`
-	Suite               string `yaml:"suite"`
-	Mysekai             string `yaml:"mysekai"`
-	PrivateApiSecret    string `yaml:"private_api_secret"`
-	PrivateApiUserAgent string `yaml:"private_api_user_agent"`
+	URL                 string                `yaml:"url"`
+	DB                  string                `yaml:"db"`
+	Suite               string                `yaml:"suite"`
+	Mysekai             string                `yaml:"mysekai"`
+	PrivateApiSecret    string                `yaml:"private_api_secret"`
+	PrivateApiUserAgent string                `yaml:"private_api_user_agent"`
+	History             SnapshotHistoryConfig `yaml:"history"`
+}
+
+type SnapshotHistoryConfig struct {
+	Enabled             bool                      `yaml:"enabled"`
+	Collection          string                    `yaml:"collection"`
+	DefaultMaxRevisions int                       `yaml:"default_max_revisions"`
+	DefaultMaxAgeDays   int                       `yaml:"default_max_age_days"`
+	Retention           []SnapshotRetentionConfig `yaml:"retention"`
+}
+
+// SnapshotRetentionConfig overrides the default retention for one server and/or
+// data type. An empty server or data_type matches every value.
+type SnapshotRetentionConfig struct {
+	Server       string `yaml:"server"`
+	DataType     string `yaml:"data_type"`
+	MaxRevisions int    `yaml:"max_revisions"`
+	MaxAgeDays   int    `yaml:"max_age_days"`
 }
 
 type RedisConfig struct {
@@ -21,8 +39,88 @@ type RedisConfig struct {
 }
 
 type WebhookConfig struct {
-	JWTSecret string `yaml:"jwt_secret"`
-	Enabled   bool   `yaml:"enabled"`
+	JWTSecret string                `yaml:"jwt_secret"`
+	Enabled   bool                  `yaml:"enabled"`
+	Delivery  WebhookDeliveryConfig `yaml:"delivery"`
+	Health    WebhookHealthConfig   `yaml:"health"`
+}
+
+// WebhookDeliveryConfig tunes the persistent webhook delivery queue. Failed
+// deliveries are retried with exponential backoff and moved to the dead-letter
+// state after MaxAttempts.
+type WebhookDeliveryConfig struct {
+	MaxAttempts         int `yaml:"max_attempts"`
+	BaseBackoffSeconds  int `yaml:"base_backoff_seconds"`
+	MaxBackoffSeconds   int `yaml:"max_backoff_seconds"`
+	PollIntervalSeconds int `yaml:"poll_interval_seconds"`
+	BatchSize           int `yaml:"batch_size"`
+	Concurrency         int `yaml:"concurrency"`
+}
+
+// WebhookHealthConfig controls delivery attempt logging and auto-disable.
+// With AutoDisableEnabled, an endpoint is switched off once it has failed
+// AutoDisableFailureThreshold consecutive attempts spanning at least
+// AutoDisableMinFailureWindowSeconds, and its owner email is notified.
+type WebhookHealthConfig struct {
+	AutoDisableEnabled                 bool `yaml:"auto_disable_enabled"`
+	AutoDisableFailureThreshold        int  `yaml:"auto_disable_failure_threshold"`
+	AutoDisableMinFailureWindowSeconds int  `yaml:"auto_disable_min_failure_window_seconds"`
+	AttemptRetentionDays               int  `yaml:"attempt_retention_days"`
+}
+
+// BlobStorageConfig selects where uploaded files such as ticket attachments
+// are kept. Backend is "local" (LocalDir) or "s3" (any S3-compatible store).
+type BlobStorageConfig struct {
+	Backend  string              `yaml:"backend"`
+	LocalDir string              `yaml:"local_dir"`
+	S3       S3BlobStorageConfig `yaml:"s3"`
+}
+
+type S3BlobStorageConfig struct {
+	Endpoint              string `yaml:"endpoint"`
+	Region                string `yaml:"region"`
+	Bucket                string `yaml:"bucket"`
+	AccessKeyID           string `yaml:"access_key_id"`
+	SecretAccessKey       string `yaml:"secret_access_key"`
+	ForcePathStyle        bool   `yaml:"force_path_style"`
+	RequestTimeoutSeconds int    `yaml:"request_timeout_seconds"`
+}
+
+type TicketsConfig struct {
+	Attachments TicketAttachmentConfig `yaml:"attachments"`
+	SLA         TicketSLAConfig        `yaml:"sla"`
+}
+
+// TicketAttachmentConfig limits files attached to ticket messages. Images
+// larger than MaxImagePixels are rejected before they are decoded.
+type TicketAttachmentConfig struct {
+	Enabled            bool  `yaml:"enabled"`
+	MaxFileSizeBytes   int64 `yaml:"max_file_size_bytes"`
+	MaxFilesPerMessage int   `yaml:"max_files_per_message"`
+	MaxImagePixels     int64 `yaml:"max_image_pixels"`
+	ThumbnailMaxSize   int   `yaml:"thumbnail_max_size"`
+}
+
+// TicketSLAConfig sets per-priority targets in minutes, keyed by ticket
+// priority (low, normal, high, urgent). A priority without a target is not
+// tracked. Tickets left in pending_user for AutoCloseIdleHours are closed
+// when AutoCloseEnabled is set.
+type TicketSLAConfig struct {
+	Enabled              bool           `yaml:"enabled"`
+	SweepIntervalSeconds int            `yaml:"sweep_interval_seconds"`
+	FirstResponseMinutes map[string]int `yaml:"first_response_minutes"`
+	ResolutionMinutes    map[string]int `yaml:"resolution_minutes"`
+	AutoCloseEnabled     bool           `yaml:"auto_close_enabled"`
+	AutoCloseIdleHours   int            `yaml:"auto_close_idle_hours"`
+}
+
+// RiskEngineConfig controls the in-process risk rule engine that evaluates
+// RiskRule configs against login, upload, game binding and OAuth2 signals.
+type RiskEngineConfig struct {
+	Enabled              bool `yaml:"enabled"`
+	QueueSize            int  `yaml:"queue_size"`
+	RuleRefreshSeconds   int  `yaml:"rule_refresh_seconds"`
+	SweepIntervalSeconds int  `yaml:"sweep_interval_seconds"`
 }
 
 type AfdianConfig struct {
@@ -35,7 +133,61 @@ type AfdianConfig struct {
 	SyncIntervalSeconds  int    `yaml:"sync_interval_seconds"`
 }
 
+// DataProviderConfig defines one third-party service that uploads are
+// forwarded to.
+type DataProviderConfig struct {
+	Name        string `yaml:"name" json:"name"`
+	DisplayName string `yaml:"display_name" json:"displayName"`
+	Enabled     bool   `yaml:"enabled" json:"enabled"`
+	Endpoint    string `yaml:"endpoint" json:"endpoint"`
+	Secret      string `yaml:"secret" json:"secret"`
+	// Auth is "bearer" (Authorization: Bearer <secret>) or "x-credentials"
+	// (X-Credentials plus the X-Server-Region, X-Upload-Type and X-User-Id
+	// headers, as the 8823 API expects).
+	Auth string `yaml:"auth" json:"auth"`
+	// Format is "raw", "json-zstd" or "restored"; "restored" sends restored
+	// suite data and falls back to "json-zstd" for other data types.
+	Format   string `yaml:"format" json:"format"`
+	CheckURL string `yaml:"check_url" json:"checkUrl"`
+	// Servers limits the provider to these servers; empty means all.
+	Servers   []string                  `yaml:"servers" json:"servers"`
+	DataTypes []string                  `yaml:"data_types" json:"dataTypes"`
+	Privacy   DataProviderPrivacyConfig `yaml:"privacy" json:"privacy"`
+}
+
+// DataProviderPrivacyConfig is what a game account's privacy settings must
+// allow before its data is sent to a provider.
+type DataProviderPrivacyConfig struct {
+	// ConsentKey is the privacy setting that must be on: one of the built-in
+	// keys such as "allow8823", or a key of "allowProviders". Defaults to the
+	// provider name.
+	ConsentKey string `yaml:"consent_key" json:"consentKey"`
+	// RequirePublicAPI additionally requires "allowPublicApi".
+	RequirePublicAPI bool `yaml:"require_public_api" json:"requirePublicApi"`
+}
+
+// DataProviderOutboxConfig tunes the persistent outbox that delivers uploads
+// to data providers. Only the newest snapshot per game account and data type
+// is kept; failed deliveries are retried with exponential backoff and marked
+// dead after MaxAttempts. ProviderConcurrency caps the in-flight deliveries
+// of a single provider so a slow partner cannot take the whole pool.
+type DataProviderOutboxConfig struct {
+	MaxAttempts         int `yaml:"max_attempts"`
+	BaseBackoffSeconds  int `yaml:"base_backoff_seconds"`
+	MaxBackoffSeconds   int `yaml:"max_backoff_seconds"`
+	PollIntervalSeconds int `yaml:"poll_interval_seconds"`
+	BatchSize           int `yaml:"batch_size"`
+	Concurrency         int `yaml:"concurrency"`
+	ProviderConcurrency int `yaml:"provider_concurrency"`
+	RetentionDays       int `yaml:"retention_days"`
+}
+
 type ThirdPartyDataProviderConfig struct {
+	Providers []DataProviderConfig     `yaml:"providers"`
+	Outbox    DataProviderOutboxConfig `yaml:"outbox"`
+
+	// Deprecated: the fields below are only read when Providers is empty;
+	// see ProviderDefinitions.
 	Endpoint8823            string `yaml:"endpoint_8823"`
 	Secret8823              string `yaml:"secret_8823"`
 	SendJSONZstandard8823   bool   `yaml:"send_json_zstandard_8823"`
@@ -63,53 +215,127 @@ type ThirdPartyDataProviderConfig struct {
 }
 
 type UserSystemConfig struct {
-	DBType                       string     `yaml:"db_type"`
-	DBURL                        string     `yaml:"db_url"`
-	CloudflareSecret             string     `yaml:"cloudflare_secret"`
-	TurnstileBypass              bool       `yaml:"turnstile_bypass"`
-	SMTP                         SMTPConfig `yaml:"smtp"`
-	SessionSignToken             string     `yaml:"session_sign_token"`
-	AuthProvider                 string     `yaml:"auth_provider"`
-	AuthProxyEnabled             bool       `yaml:"auth_proxy_enabled"`
-	AuthProxyTrustedHeader       string     `yaml:"auth_proxy_trusted_header"`
-	AuthProxyTrustedValue        string     `yaml:"auth_proxy_trusted_value"`
-	AuthProxySubjectHeader       string     `yaml:"auth_proxy_subject_header"`
-	AuthProxyNameHeader          string     `yaml:"auth_proxy_name_header"`
-	AuthProxyEmailHeader         string     `yaml:"auth_proxy_email_header"`
-	AuthProxyEmailVerifiedHeader string     `yaml:"auth_proxy_email_verified_header"`
-	AuthProxyUserIDHeader        string     `yaml:"auth_proxy_user_id_header"`
-	AuthProxySessionHeader       string     `yaml:"auth_proxy_session_header"`
-	KratosPublicURL              string     `yaml:"kratos_public_url"`
-	KratosAdminURL               string     `yaml:"kratos_admin_url"`
-	KratosRequestTimeout         int        `yaml:"kratos_request_timeout_seconds"`
-	KratosSessionHeader          string     `yaml:"kratos_session_header"`
-	KratosSessionCookie          string     `yaml:"kratos_session_cookie"`
-	KratosAutoLinkByEmail        bool       `yaml:"kratos_auto_link_by_email"`
-	KratosAutoProvisionUser      bool       `yaml:"kratos_auto_provision_user"`
-	AvatarSaveDir                string     `yaml:"avatar_save_dir"`
-	AvatarURL                    string     `yaml:"avatar_url"`
-	FrontendURL                  string     `yaml:"frontend_url"`
-	SocialPlatformVerifyToken    string     `yaml:"social_platform_verify_token"`
+	DBType                       string                `yaml:"db_type"`
+	DBURL                        string                `yaml:"db_url"`
+	CloudflareSecret             string                `yaml:"cloudflare_secret"`
+	TurnstileBypass              bool                  `yaml:"turnstile_bypass"`
+	SMTP                         SMTPConfig            `yaml:"smtp"`
+	SessionSignToken             string                `yaml:"session_sign_token"`
+	AuthProvider                 string                `yaml:"auth_provider"`
+	AuthProxyEnabled             bool                  `yaml:"auth_proxy_enabled"`
+	AuthProxyTrustedHeader       string                `yaml:"auth_proxy_trusted_header"`
+	AuthProxyTrustedValue        string                `yaml:"auth_proxy_trusted_value"`
+	AuthProxySubjectHeader       string                `yaml:"auth_proxy_subject_header"`
+	AuthProxyNameHeader          string                `yaml:"auth_proxy_name_header"`
+	AuthProxyEmailHeader         string                `yaml:"auth_proxy_email_header"`
+	AuthProxyEmailVerifiedHeader string                `yaml:"auth_proxy_email_verified_header"`
+	AuthProxyUserIDHeader        string                `yaml:"auth_proxy_user_id_header"`
+	AuthProxySessionHeader       string                `yaml:"auth_proxy_session_header"`
+	KratosPublicURL              string                `yaml:"kratos_public_url"`
+	KratosAdminURL               string                `yaml:"kratos_admin_url"`
+	KratosRequestTimeout         int                   `yaml:"kratos_request_timeout_seconds"`
+	KratosSessionHeader          string                `yaml:"kratos_session_header"`
+	KratosSessionCookie          string                `yaml:"kratos_session_cookie"`
+	KratosAutoLinkByEmail        bool                  `yaml:"kratos_auto_link_by_email"`
+	KratosAutoProvisionUser      bool                  `yaml:"kratos_auto_provision_user"`
+	AvatarSaveDir                string                `yaml:"avatar_save_dir"`
+	AvatarURL                    string                `yaml:"avatar_url"`
+	FrontendURL                  string                `yaml:"frontend_url"`
+	SocialPlatformVerifyToken    string                `yaml:"social_platform_verify_token"`
+	DataExport                   DataExportConfig      `yaml:"data_export"`
+	AccountDeletion              AccountDeletionConfig `yaml:"account_deletion"`
+	SecondFactor                 SecondFactorConfig    `yaml:"second_factor"`
+}
+
+// DataExportConfig controls self-service account exports. Archives are kept
+// in the blob storage for RetentionHours and a user may request a new one
+// every MinIntervalHours.
+type DataExportConfig struct {
+	RetentionHours      int `yaml:"retention_hours"`
+	MinIntervalHours    int `yaml:"min_interval_hours"`
+	MaxAttempts         int `yaml:"max_attempts"`
+	PollIntervalSeconds int `yaml:"poll_interval_seconds"`
+}
+
+// AccountDeletionConfig controls self-service account deletion. A request
+// can be cancelled for GracePeriodHours before the account is purged.
+type AccountDeletionConfig struct {
+	GracePeriodHours    int `yaml:"grace_period_hours"`
+	MaxAttempts         int `yaml:"max_attempts"`
+	PollIntervalSeconds int `yaml:"poll_interval_seconds"`
+}
+
+// SecondFactorConfig controls TOTP and WebAuthn second factors. AdminPolicy
+// is "off", "enrolled" (admins who enrolled a factor must use it) or
+// "required" (every admin must enroll and use one). A session that passed
+// the second factor counts as verified for VerifiedSessionHours.
+type SecondFactorConfig struct {
+	Issuer                string   `yaml:"issuer"`
+	AdminPolicy           string   `yaml:"admin_policy"`
+	VerifiedSessionHours  int      `yaml:"verified_session_hours"`
+	WebAuthnRPID          string   `yaml:"webauthn_rp_id"`
+	WebAuthnRPDisplayName string   `yaml:"webauthn_rp_display_name"`
+	WebAuthnOrigins       []string `yaml:"webauthn_origins"`
 }
 
 type BackendConfig struct {
-	Host             string   `yaml:"host"`
-	Port             int      `yaml:"port"`
-	SSL              bool     `yaml:"ssl"`
-	SSLCert          string   `yaml:"ssl_cert"`
-	SSLKey           string   `yaml:"ssl_key"`
-	AutoMigrate      bool     `yaml:"auto_migrate"`
-	ShutdownTimeout  int      `yaml:"shutdown_timeout_seconds"`
-	LogLevel         string   `yaml:"log_level"`
-	MainLogFile      string   `yaml:"main_log_file"`
-	AccessLog        string   `yaml:"access_log"`
-	AccessLogPath    string   `yaml:"access_log_path"`
-	CSPConnectSrc    []string `yaml:"csp_connect_src"`
-	EnableTrustProxy bool     `yaml:"enable_trust_proxy"`
-	TrustProxies     []string `yaml:"trusted_proxies"`
-	ProxyHeader      string   `yaml:"proxy_header"`
-	BackendURL       string   `yaml:"backend_url"`
-	BackendCDNURL    string   `yaml:"backend_cdn_url"`
+	Host            string `yaml:"host"`
+	Port            int    `yaml:"port"`
+	SSL             bool   `yaml:"ssl"`
+	SSLCert         string `yaml:"ssl_cert"`
+	SSLKey          string `yaml:"ssl_key"`
+	AutoMigrate     bool   `yaml:"auto_migrate"`
+	ShutdownTimeout int    `yaml:"shutdown_timeout_seconds"`
+	LogLevel        string `yaml:"log_level"`
+	// LogFormat is "text" (default) or "json", one object per line.
+	LogFormat string `yaml:"log_format"`
+	// ModuleLogLevels overrides LogLevel per logger name, e.g.
+	// {"HarukiDataSyncer": "DEBUG"}. Editable at runtime by admins.
+	ModuleLogLevels  map[string]string `yaml:"module_log_levels"`
+	MainLogFile      string            `yaml:"main_log_file"`
+	AccessLog        string            `yaml:"access_log"`
+	AccessLogPath    string            `yaml:"access_log_path"`
+	CSPConnectSrc    []string          `yaml:"csp_connect_src"`
+	EnableTrustProxy bool              `yaml:"enable_trust_proxy"`
+	TrustProxies     []string          `yaml:"trusted_proxies"`
+	ProxyHeader      string            `yaml:"proxy_header"`
+	BackendURL       string            `yaml:"backend_url"`
+	BackendCDNURL    string            `yaml:"backend_cdn_url"`
+	// Coordination controls how replicas sharing the same Redis split
+	// background work.
+	Coordination CoordinationConfig `yaml:"coordination"`
+}
+
+// CoordinationConfig names this instance and sets the lease used for leader
+// election of background schedulers. InstanceID defaults to the host name
+// plus a random suffix.
+type CoordinationConfig struct {
+	InstanceID         string `yaml:"instance_id"`
+	LeaderLeaseSeconds int    `yaml:"leader_lease_seconds"`
+}
+
+// MetricsConfig controls the Prometheus endpoint. With ListenAddr set the
+// metrics are served by a separate listener, which should be bound to a
+// private address; otherwise they are served at Path on the main server and
+// Token is required.
+type MetricsConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	Path       string `yaml:"path"`
+	Token      string `yaml:"token"`
+	ListenAddr string `yaml:"listen_addr"`
+}
+
+// TracingConfig controls OpenTelemetry tracing. Spans are exported over
+// OTLP/HTTP or written as JSON lines to StdoutPath (stdout when empty).
+// SampleRatio applies to root spans only; incoming sampled traces are kept.
+type TracingConfig struct {
+	Enabled      bool              `yaml:"enabled"`
+	ServiceName  string            `yaml:"service_name"`
+	Exporter     string            `yaml:"exporter"`
+	OTLPEndpoint string            `yaml:"otlp_endpoint"`
+	OTLPHeaders  map[string]string `yaml:"otlp_headers"`
+	StdoutPath   string            `yaml:"stdout_path"`
+	SampleRatio  float64           `yaml:"sample_ratio"`
 }
 
 type SMTPConfig struct {
@@ -119,6 +345,37 @@ type SMTPConfig struct {
 	SMTPPass       string `yaml:"smtp_pass"`
 	MailName       string `yaml:"mail_name"`
 	TimeoutSeconds int    `yaml:"timeout_seconds"`
+	// TLS is the transport of the relay above: "auto" (implicit TLS with a
+	// STARTTLS fallback), "starttls" or "none".
+	TLS string `yaml:"tls"`
+	// Relays are tried in order after the relay above when it fails. A relay
+	// that failed is moved to the back for RelayCooldownSeconds.
+	Relays               []SMTPRelayConfig `yaml:"relays"`
+	RelayCooldownSeconds int               `yaml:"relay_cooldown_seconds"`
+	DefaultLocale        string            `yaml:"default_locale"`
+	Outbox               MailOutboxConfig  `yaml:"outbox"`
+}
+
+type SMTPRelayConfig struct {
+	Name string `yaml:"name"`
+	Addr string `yaml:"addr"`
+	Port int    `yaml:"port"`
+	// Username defaults to Mail. AUTH is skipped when Password is empty.
+	Username       string `yaml:"username"`
+	Password       string `yaml:"password"`
+	Mail           string `yaml:"mail"`
+	TLS            string `yaml:"tls"`
+	TimeoutSeconds int    `yaml:"timeout_seconds"`
+}
+
+type MailOutboxConfig struct {
+	MaxAttempts         int `yaml:"max_attempts"`
+	BaseBackoffSeconds  int `yaml:"base_backoff_seconds"`
+	MaxBackoffSeconds   int `yaml:"max_backoff_seconds"`
+	PollIntervalSeconds int `yaml:"poll_interval_seconds"`
+	BatchSize           int `yaml:"batch_size"`
+	Concurrency         int `yaml:"concurrency"`
+	RetentionDays       int `yaml:"retention_days"`
 }
 
 type HarukiBotConfig struct {
@@ -161,6 +418,20 @@ type SekaiClientConfig struct {
 	JPServerInheritClientHeaders map[string]string `yaml:"jp_server_inherit_client_headers"`
 	ENServerInheritClientHeaders map[string]string `yaml:"en_server_inherit_client_headers"`
 	SuiteRemoveKeys              []string          `yaml:"suite_remove_keys"`
+	InheritJobs                  InheritJobConfig  `yaml:"inherit_jobs"`
+}
+
+// InheritJobConfig controls the background inherit upload workers.
+// Concurrency applies to every inherit server unless ServerConcurrency sets a
+// value for it. Jobs whose process stops heartbeating for StaleAfterSeconds
+// are reported as failed.
+type InheritJobConfig struct {
+	Concurrency        int            `yaml:"concurrency"`
+	ServerConcurrency  map[string]int `yaml:"server_concurrency"`
+	MaxQueuedPerServer int            `yaml:"max_queued_per_server"`
+	JobTimeoutSeconds  int            `yaml:"job_timeout_seconds"`
+	StaleAfterSeconds  int            `yaml:"stale_after_seconds"`
+	RetentionHours     int            `yaml:"retention_hours"`
 }
 
 type SekaiAPIConfig struct {
@@ -188,7 +459,12 @@ type Config struct {
 	Redis                  RedisConfig                  `yaml:"redis"`
 	Webhook                WebhookConfig                `yaml:"webhook"`
 	Afdian                 AfdianConfig                 `yaml:"afdian"`
+	RiskEngine             RiskEngineConfig             `yaml:"risk_engine"`
+	BlobStorage            BlobStorageConfig            `yaml:"blob_storage"`
+	Tickets                TicketsConfig                `yaml:"tickets"`
 	Backend                BackendConfig                `yaml:"backend"`
+	Metrics                MetricsConfig                `yaml:"metrics"`
+	Tracing                TracingConfig                `yaml:"tracing"`
 	UserSystem             UserSystemConfig             `yaml:"user_system"`
 	OAuth2                 OAuth2Config                 `yaml:"oauth2"`
 	Others                 OthersConfig                 `yaml:"others"`
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BotLoginHistory keeps the logins of a bot so its owner can spot a leaked
+// credential being used from somewhere unexpected.
+type BotLoginHistory struct {
+	ent.Schema
+}
+
+func (BotLoginHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "bot_login_histories"},
+	}
+}
+
+func (BotLoginHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("bot_id").
+			Immutable(),
+		field.String("ip").
+			MaxLen(64).
+			Optional().
+			Default("").
+			Immutable(),
+		field.String("location").
+			MaxLen(256).
+			Optional().
+			Default("").
+			Immutable(),
+		field.String("source").
+			MaxLen(32).
+			Immutable().
+			Comment("reported: self-reported by the bot client; toolbox: seen by the toolbox backend"),
+		field.Time("logged_in_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (BotLoginHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bot_id", "logged_in_at"),
+	}
+}
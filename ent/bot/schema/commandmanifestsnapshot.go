@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// CommandManifestSnapshot records the full command manifest after every change
+// made through the toolbox, so a bad routing change can be rolled back.
+type CommandManifestSnapshot struct {
+	ent.Schema
+}
+
+func (CommandManifestSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "command_manifest_snapshots"},
+	}
+}
+
+func (CommandManifestSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").
+			Unique().
+			Immutable().
+			Comment("Monotonic manifest version"),
+		field.String("etag").
+			MaxLen(64).
+			Immutable().
+			Comment("Hex SHA-256 of the canonical manifest JSON"),
+		field.Text("content").
+			Immutable().
+			Comment("Canonical manifest JSON"),
+		field.Int("entry_count").
+			Default(0).
+			Immutable(),
+		field.String("action").
+			MaxLen(32).
+			Immutable().
+			Comment("Change that produced this version, e.g. create, update, delete, rollback"),
+		field.String("created_by").
+			MaxLen(64).
+			Optional().
+			Immutable().
+			Comment("Toolbox user ID of the admin who made the change"),
+		field.Int("rolled_back_from").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Version restored by a rollback"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
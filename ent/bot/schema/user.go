@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -43,11 +45,42 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Last successful login time"),
+		field.String("name").
+			MaxLen(64).
+			Optional().
+			Default("").
+			Comment("Owner-chosen label to tell bots of the same owner apart"),
+		field.Enum("status").
+			Values("active", "revoked", "suspended").
+			Default("active").
+			Comment("Only active bots may authenticate"),
+		field.String("status_reason").
+			MaxLen(512).
+			Optional().
+			Default("").
+			Comment("Why the bot was revoked or suspended"),
+		field.String("status_changed_by").
+			MaxLen(64).
+			Optional().
+			Default("").
+			Comment("Toolbox user ID that last changed the status"),
+		field.Time("status_changed_at").
+			Optional().
+			Nillable(),
+		field.Time("credential_rotated_at").
+			Optional().
+			Nillable().
+			Comment("Last time a new credential was issued"),
+		field.Time("created_at").
+			Optional().
+			Nillable().
+			Default(time.Now).
+			Comment("Registration time; empty for bots registered before it was recorded"),
 	}
 }
 
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("owner_user_id").Unique(),
+		index.Fields("owner_user_id"),
 	}
 }
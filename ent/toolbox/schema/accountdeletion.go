@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountDeletion is a user's request to delete their account. The purge runs
+// once scheduled_for has passed unless the user cancels first. The row has no
+// foreign key to users, so it outlives the account and keeps the receipt of
+// what was removed.
+type AccountDeletion struct {
+	ent.Schema
+}
+
+func (AccountDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.String("user_id").MaxLen(64).NotEmpty().Immutable(),
+		field.Enum("status").Values("pending", "cancelled", "completed", "failed").Default("pending"),
+		field.String("locale").MaxLen(16).Default(""),
+		field.Time("scheduled_for"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Int("max_attempts").Positive(),
+		field.Time("next_attempt_at"),
+		field.String("last_error").MaxLen(500).Optional().Nillable(),
+		field.JSON("receipt", map[string]any{}).Optional(),
+		field.Time("cancelled_at").Optional().Nillable(),
+		field.Time("completed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (AccountDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+func (AccountDeletion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "account_deletions"},
+	}
+}
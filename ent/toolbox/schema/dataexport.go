@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataExport is a user's request for an archive of their account data. The
+// archive lives in the blob storage under storage_key until expires_at. Only
+// the SHA-256 of the download token is stored; the token itself is mailed to
+// the user.
+type DataExport struct {
+	ent.Schema
+}
+
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.String("user_id").MaxLen(64).NotEmpty().Immutable(),
+		field.Enum("status").Values("queued", "ready", "failed", "expired").Default("queued"),
+		field.String("locale").MaxLen(16).Default(""),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Int("max_attempts").Positive(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.String("storage_key").MaxLen(512).Optional().Nillable(),
+		field.Int64("size_bytes").Optional().Nillable(),
+		field.String("sha256").MaxLen(64).Optional().Nillable(),
+		field.String("download_token_hash").MaxLen(64).Optional().Nillable().Unique(),
+		field.Int("download_count").Default(0).NonNegative(),
+		field.String("last_error").MaxLen(500).Optional().Nillable(),
+		field.Time("ready_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_downloaded_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("status", "expires_at"),
+	}
+}
+
+func (DataExport) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "data_exports"},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataProviderOutbox holds the latest snapshot of one game account's data
+// type that still has to reach a third-party data provider. A newer upload
+// replaces the payload and bumps generation, so a backlog never replays
+// stale data.
+type DataProviderOutbox struct {
+	ent.Schema
+}
+
+func (DataProviderOutbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").MaxLen(32).NotEmpty().Immutable(),
+		field.String("server").MaxLen(8).Immutable(),
+		field.String("game_user_id").MaxLen(30).Immutable(),
+		field.String("data_type").MaxLen(32).Immutable(),
+		field.Bytes("payload"),
+		field.Int("generation").Default(1).Positive(),
+		field.Time("snapshot_at"),
+		field.Enum("status").Values("pending", "succeeded", "skipped", "dead").Default("pending"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Int("max_attempts").Positive(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Int("last_status_code").Optional().Nillable(),
+		field.String("last_error").MaxLen(500).Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (DataProviderOutbox) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "server", "game_user_id", "data_type").Unique(),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("provider", "status", "snapshot_at"),
+		index.Fields("server", "game_user_id"),
+	}
+}
+
+func (DataProviderOutbox) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "data_provider_outbox"},
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailMessage is one queued mail to a single recipient. Subject and body
+// are rendered when the mail is queued, so a retry sends exactly what the
+// first attempt would have sent.
+type EmailMessage struct {
+	ent.Schema
+}
+
+func (EmailMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.String("recipient").NotEmpty().MaxLen(320).Immutable(),
+		field.String("subject").MaxLen(998).Immutable(),
+		field.Text("body").Immutable(),
+		field.String("display_name").Default("").Immutable(),
+		field.String("template").MaxLen(64).Default("").Immutable(),
+		field.String("locale").MaxLen(16).Default("").Immutable(),
+		field.Enum("status").Values("queued", "sent", "failed", "bounced", "suppressed", "expired").Default("queued"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Int("max_attempts").Positive(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Time("expires_at").Optional().Nillable(),
+		field.String("relay").MaxLen(64).Optional().Nillable(),
+		field.String("last_error").MaxLen(500).Optional().Nillable(),
+		field.Time("sent_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (EmailMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("recipient", "created_at"),
+		index.Fields("created_at"),
+	}
+}
+
+func (EmailMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "email_messages"},
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSuppression lists addresses the outbox no longer sends to, either
+// because a relay rejected them permanently or because an admin added them.
+type EmailSuppression struct {
+	ent.Schema
+}
+
+func (EmailSuppression) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").NotEmpty().MaxLen(320).Unique(),
+		field.Enum("reason").Values("hard_bounce", "complaint", "manual"),
+		field.String("detail").MaxLen(500).Optional().Nillable(),
+		field.Int("bounce_count").Default(0).NonNegative(),
+		field.String("created_by").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (EmailSuppression) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "email_suppressions"},
+	}
+}
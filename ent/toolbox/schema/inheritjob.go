@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InheritJob tracks an asynchronous inherit upload. The inherit credentials
+// are only held in memory by the process that accepted the job and are never
+// stored here.
+type InheritJob struct {
+	ent.Schema
+}
+
+func (InheritJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.String("server").MaxLen(8).Immutable(),
+		field.String("upload_type").MaxLen(32).Immutable(),
+		field.Enum("status").Values("queued", "running", "succeeded", "failed").Default("queued"),
+		field.Enum("step").Values("init", "suite", "home_refresh", "mysekai", "persist").Optional().Nillable(),
+		field.Int64("game_user_id").Optional().Nillable(),
+		field.String("message").MaxLen(500).Optional().Nillable(),
+		field.String("error").MaxLen(500).Optional().Nillable(),
+		field.Int("error_code").Optional().Nillable(),
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("finished_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (InheritJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "updated_at"),
+		index.Fields("finished_at"),
+	}
+}
+
+func (InheritJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "inherit_jobs"},
+	}
+}
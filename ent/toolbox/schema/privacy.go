@@ -6,12 +6,16 @@ type SuiteDataPrivacySettings struct {
 	Allow8823      bool `json:"allow8823"`
 	AllowResona    bool `json:"allowResona"`
 	AllowLuna      bool `json:"allowLuna"`
+	// AllowProviders holds consent for data providers without a dedicated
+	// flag above, keyed by the provider's consent key.
+	AllowProviders map[string]bool `json:"allowProviders,omitempty"`
 }
 
 type MysekaiDataPrivacySettings struct {
-	AllowPublicApi  bool `json:"allowPublicApi"`
-	AllowFixtureApi bool `json:"allowFixtureApi"`
-	Allow8823       bool `json:"allow8823"`
-	AllowResona     bool `json:"allowResona"`
-	AllowLuna       bool `json:"allowLuna"`
+	AllowPublicApi  bool            `json:"allowPublicApi"`
+	AllowFixtureApi bool            `json:"allowFixtureApi"`
+	Allow8823       bool            `json:"allow8823"`
+	AllowResona     bool            `json:"allowResona"`
+	AllowLuna       bool            `json:"allowLuna"`
+	AllowProviders  map[string]bool `json:"allowProviders,omitempty"`
 }
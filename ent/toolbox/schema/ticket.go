@@ -27,6 +27,10 @@ func (Ticket) Fields() []ent.Field {
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("closed_at").Optional().Nillable(),
+		field.Time("first_response_at").Optional().Nillable(),
+		field.Time("resolved_at").Optional().Nillable(),
+		field.Time("first_response_breached_at").Optional().Nillable(),
+		field.Time("resolution_breached_at").Optional().Nillable(),
 		field.JSON("metadata", map[string]any{}).Optional(),
 	}
 }
@@ -35,6 +39,8 @@ func (Ticket) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("messages", TicketMessage.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("attachments", TicketAttachment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -43,6 +49,7 @@ func (Ticket) Indexes() []ent.Index {
 		index.Fields("ticket_id").Unique(),
 		index.Fields("creator_user_id", "created_at"),
 		index.Fields("status", "created_at"),
+		index.Fields("status", "updated_at"),
 		index.Fields("assignee_admin_id", "created_at"),
 	}
 }
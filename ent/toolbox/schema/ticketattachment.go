@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TicketAttachment is a file attached to a ticket message. The bytes live in
+// the blob store under storage_key; images are stored with their metadata
+// stripped and get a JPEG thumbnail under thumbnail_key.
+type TicketAttachment struct {
+	ent.Schema
+}
+
+func (TicketAttachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.Int("ticket_id").Immutable(),
+		field.Int("message_id").Immutable(),
+		field.String("uploader_user_id").MaxLen(64).Immutable(),
+		field.String("file_name").MaxLen(255),
+		field.String("content_type").MaxLen(128),
+		field.Int64("size_bytes").NonNegative(),
+		field.String("sha256").MaxLen(64),
+		field.String("storage_key").MaxLen(512).Immutable(),
+		field.String("thumbnail_key").MaxLen(512).Optional().Nillable(),
+		field.Int("width").Optional().Nillable(),
+		field.Int("height").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (TicketAttachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ticket", Ticket.Type).
+			Ref("attachments").
+			Field("ticket_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("message", TicketMessage.Type).
+			Ref("attachments").
+			Field("message_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+func (TicketAttachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ticket_id", "created_at"),
+		index.Fields("message_id"),
+	}
+}
+
+func (TicketAttachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "ticket_attachments"},
+	}
+}
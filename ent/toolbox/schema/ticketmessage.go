@@ -33,6 +33,8 @@ func (TicketMessage) Edges() []ent.Edge {
 			Field("ticket_id").
 			Required().
 			Unique(),
+		edge.To("attachments", TicketAttachment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -23,6 +23,10 @@ func (User) Fields() []ent.Field {
 		field.Enum("role").Values("user", "admin", "super_admin").Default("user"),
 		field.Bool("banned").Default(false),
 		field.String("ban_reason").Optional().Nillable(),
+		// ban_expires_at is only set for temporary bans; the risk engine lifts
+		// the ban once it has passed.
+		field.Time("ban_expires_at").Optional().Nillable(),
+		field.Time("upload_blocked_until").Optional().Nillable(),
 		field.String("kratos_identity_id").Optional().Nillable().Unique(),
 		field.Time("created_at").Optional().Nillable(),
 	}
@@ -51,5 +55,6 @@ func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("created_at"),
 		index.Fields("role", "banned"),
+		index.Fields("banned", "ban_expires_at"),
 	}
 }
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserRecoveryCode is a single-use code that stands in for a lost second
+// factor. Only the SHA-256 of the code is stored.
+type UserRecoveryCode struct {
+	ent.Schema
+}
+
+func (UserRecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").MaxLen(64).NotEmpty().Immutable(),
+		field.String("code_hash").MaxLen(64).NotEmpty().Immutable(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (UserRecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "code_hash").Unique(),
+	}
+}
+
+func (UserRecoveryCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user_recovery_codes"},
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// UserTOTP is the authenticator app enrolled as a user's second factor. It
+// only counts once confirmed_at is set. last_used_step is the last accepted
+// 30 second time step, so a code cannot be replayed.
+type UserTOTP struct {
+	ent.Schema
+}
+
+func (UserTOTP) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").MaxLen(64).NotEmpty().Unique().Immutable(),
+		field.String("secret").NotEmpty().Sensitive(),
+		field.Time("confirmed_at").Optional().Nillable(),
+		field.Int64("last_used_step").Default(0),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (UserTOTP) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user_totp_factors"},
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebAuthnCredential is a security key or passkey registered as a user's
+// second factor. id is the base64url credential id; credential holds the
+// JSON credential record (public key, flags, sign count) kept by the
+// WebAuthn library.
+type WebAuthnCredential struct {
+	ent.Schema
+}
+
+func (WebAuthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(1400).NotEmpty().Unique().Immutable(),
+		field.String("user_id").MaxLen(64).NotEmpty().Immutable(),
+		field.String("name").MaxLen(64).Default(""),
+		field.Bytes("credential").NotEmpty(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (WebAuthnCredential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (WebAuthnCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webauthn_credentials"},
+	}
+}
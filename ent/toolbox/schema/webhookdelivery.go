@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.Enum("endpoint_type").Values("webhook", "oauth2_client").Immutable(),
+		field.String("endpoint_id").NotEmpty().Immutable(),
+		field.String("client_id").Optional().Nillable().Immutable(),
+		field.String("callback_url").NotEmpty(),
+		field.String("game_user_id").MaxLen(30).Immutable(),
+		field.String("server").MaxLen(8).Immutable(),
+		field.String("data_type").MaxLen(32).Immutable(),
+		field.String("event_id").Default("").Immutable(),
+		field.Text("payload").Optional().Immutable(),
+		field.Enum("status").Values("pending", "succeeded", "dead").Default("pending"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Int("max_attempts").Positive(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Int("last_status_code").Optional().Nillable(),
+		field.String("last_error").MaxLen(500).Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("endpoint_type", "endpoint_id", "created_at"),
+		index.Fields("client_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_deliveries"},
+	}
+}
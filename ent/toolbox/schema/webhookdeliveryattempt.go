@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDeliveryAttempt records one HTTP callback made to a webhook endpoint.
+// Direct callbacks made while the delivery queue is unavailable have no
+// delivery_id.
+type WebhookDeliveryAttempt struct {
+	ent.Schema
+}
+
+func (WebhookDeliveryAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("endpoint_type").Values("webhook", "oauth2_client").Immutable(),
+		field.String("endpoint_id").NotEmpty().Immutable(),
+		field.String("client_id").Optional().Nillable().Immutable(),
+		field.String("delivery_id").Default("").Immutable(),
+		field.String("event_id").Default("").Immutable(),
+		field.Int("attempt").Default(0).NonNegative().Immutable(),
+		field.Bool("success").Immutable(),
+		field.Int("status_code").Optional().Nillable().Immutable(),
+		field.Int64("latency_ms").Default(0).NonNegative().Immutable(),
+		field.String("error").MaxLen(500).Optional().Nillable().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (WebhookDeliveryAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("endpoint_type", "endpoint_id", "created_at"),
+		index.Fields("client_id", "created_at"),
+		index.Fields("delivery_id"),
+		index.Fields("created_at"),
+	}
+}
+
+func (WebhookDeliveryAttempt) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_delivery_attempts"},
+	}
+}
@@ -21,7 +21,14 @@ func (WebhookEndpoint) Fields() []ent.Field {
 		field.String("credential").NotEmpty(),
 		field.String("callback_url").NotEmpty(),
 		field.String("bearer").Optional().Nillable(),
+		field.String("signing_secret").Optional().Nillable().Sensitive(),
+		field.String("previous_signing_secret").Optional().Nillable().Sensitive(),
+		field.Time("previous_signing_secret_expires_at").Optional().Nillable(),
 		field.Bool("enabled").Default(true),
+		field.String("owner_email").Optional().Nillable(),
+		field.Int("consecutive_failures").Default(0).NonNegative(),
+		field.Time("failing_since").Optional().Nillable(),
+		field.Time("auto_disabled_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
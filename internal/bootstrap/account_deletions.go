@@ -0,0 +1,34 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	userAccountDeletionModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/useraccountdeletion"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildAccountDeletionOptions(cfg harukiConfig.AccountDeletionConfig) userAccountDeletionModule.Options {
+	opts := userAccountDeletionModule.DefaultOptions()
+	if cfg.GracePeriodHours > 0 {
+		opts.GracePeriod = time.Duration(cfg.GracePeriodHours) * time.Hour
+	}
+	if cfg.MaxAttempts > 0 {
+		opts.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.PollIntervalSeconds > 0 {
+		opts.PollInterval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	}
+	return opts
+}
+
+// startAccountDeletionWorker purges accounts whose deletion grace period
+// has ended.
+func startAccountDeletionWorker(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, cfg harukiConfig.AccountDeletionConfig, logger *harukiLogger.Logger) func() {
+	opts := buildAccountDeletionOptions(cfg)
+	userAccountDeletionModule.Configure(opts)
+	logger.Infof("account deletion worker enabled: grace_period=%s", opts.GracePeriod)
+	return userAccountDeletionModule.NewWorker(apiHelper, harukiLogger.NewLoggerFromGlobal("AccountDeletion")).Start(ctx)
+}
@@ -8,17 +8,23 @@ import (
 
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	sponsorModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/sponsor"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/coordination"
 	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 )
 
-const defaultAfdianSponsorSyncInterval = 5 * time.Minute
+const (
+	defaultAfdianSponsorSyncInterval = 5 * time.Minute
+	afdianSponsorSyncLeaderRole      = "afdian-sponsor-sync"
+)
 
 // startAfdianSponsorSyncScheduler launches the background sync and returns a
 // function that blocks until the goroutine has fully exited. Callers must cancel
 // ctx and then invoke the returned wait before closing the Ent client, otherwise
-// an in-flight sync can use the client after it is closed.
-func startAfdianSponsorSyncScheduler(ctx context.Context, db *dbManager.Client, cfg harukiConfig.AfdianConfig, logger *harukiLogger.Logger) func() {
+// an in-flight sync can use the client after it is closed. Only the instance
+// leading the afdianSponsorSyncLeaderRole syncs, so replicas do not multiply
+// the Afdian API calls.
+func startAfdianSponsorSyncScheduler(ctx context.Context, db *dbManager.Client, cfg harukiConfig.AfdianConfig, elector *coordination.Elector, logger *harukiLogger.Logger) func() {
 	if !cfg.SyncEnabled {
 		logger.Infof("afdian sponsor sync scheduler disabled: sync_enabled is false")
 		return func() {}
@@ -34,11 +40,19 @@ func startAfdianSponsorSyncScheduler(ctx context.Context, db *dbManager.Client,
 	}
 
 	logger.Infof("afdian sponsor sync scheduler enabled with interval %s", interval)
+	elector.Register(ctx, afdianSponsorSyncLeaderRole)
+	runIfLeader := func() {
+		if !elector.IsLeader(afdianSponsorSyncLeaderRole) {
+			logger.Debugf("afdian sponsor sync skipped: another instance is the leader")
+			return
+		}
+		runAfdianSponsorSync(ctx, db, cfg, logger)
+	}
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		runAfdianSponsorSync(ctx, db, cfg, logger)
+		runIfLeader()
 
 		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
@@ -48,7 +62,7 @@ func startAfdianSponsorSyncScheduler(ctx context.Context, db *dbManager.Client,
 				logger.Infof("afdian sponsor sync scheduler stopped")
 				return
 			case <-ticker.C:
-				runAfdianSponsorSync(ctx, db, cfg, logger)
+				runIfLeader()
 			}
 		}
 	}()
@@ -0,0 +1,109 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	entDialect "entgo.io/ent/dialect"
+	neopgManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+)
+
+const (
+	// Bots used to be unique per owner. Ent's auto-migration never drops
+	// indexes, so the old unique index has to go before it recreates a plain
+	// one under the same name.
+	dropBotUserOwnerUniqueIndexSQL = `
+DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1
+		FROM pg_indexes
+		WHERE schemaname = 'public'
+			AND tablename = 'user'
+			AND indexname = 'user_owner_user_id'
+			AND indexdef ILIKE '%UNIQUE INDEX%'
+	) THEN
+		DROP INDEX public.user_owner_user_id;
+	END IF;
+END $$;
+`
+	checkBotUserOwnerUniqueIndexExistsSQL = `
+SELECT EXISTS (
+	SELECT 1
+	FROM pg_indexes
+	WHERE schemaname = 'public'
+		AND tablename = 'user'
+		AND indexdef ILIKE '%UNIQUE INDEX%'
+		AND indexdef ILIKE '%(owner_user_id)%'
+);
+`
+	checkBotUserStatusColumnExistsSQL = `
+SELECT EXISTS (
+	SELECT 1
+	FROM information_schema.columns
+	WHERE table_schema = 'public'
+		AND table_name = 'user'
+		AND column_name = 'status'
+);
+`
+	checkBotLoginHistoriesTableExistsSQL = `SELECT to_regclass('public.bot_login_histories') IS NOT NULL`
+)
+
+func queryBotSchemaExists(ctx context.Context, botClient *neopgManager.Client, query string) (bool, error) {
+	sqlDB := botClient.SQLDB()
+	if sqlDB == nil {
+		return false, fmt.Errorf("underlying SQL DB is not available")
+	}
+
+	var exists bool
+	if err := sqlDB.QueryRowContext(ctx, query).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// prepareBotSchemaMigration runs before the bot schema auto-migration.
+func prepareBotSchemaMigration(ctx context.Context, botClient *neopgManager.Client) error {
+	if botClient.Dialect() != entDialect.Postgres {
+		return nil
+	}
+	sqlDB := botClient.SQLDB()
+	if sqlDB == nil {
+		return fmt.Errorf("underlying SQL DB is not available")
+	}
+	if _, err := sqlDB.ExecContext(ctx, dropBotUserOwnerUniqueIndexSQL); err != nil {
+		return fmt.Errorf("drop bot user.owner_user_id unique index: %w", err)
+	}
+	return nil
+}
+
+// validateBotSchemaCompatibility checks, when auto_migrate is off, that the
+// bot database allows several bots per owner and has the credential
+// lifecycle columns.
+func validateBotSchemaCompatibility(ctx context.Context, botClient *neopgManager.Client) error {
+	if botClient.Dialect() != entDialect.Postgres {
+		return nil
+	}
+	uniqueOwner, err := queryBotSchemaExists(ctx, botClient, checkBotUserOwnerUniqueIndexExistsSQL)
+	if err != nil {
+		return fmt.Errorf("check bot user.owner_user_id index: %w", err)
+	}
+	if uniqueOwner {
+		return fmt.Errorf("bot user.owner_user_id is still unique; run schema migration or enable backend.auto_migrate")
+	}
+	statusExists, err := queryBotSchemaExists(ctx, botClient, checkBotUserStatusColumnExistsSQL)
+	if err != nil {
+		return fmt.Errorf("check bot user.status column: %w", err)
+	}
+	if !statusExists {
+		return fmt.Errorf("bot user.status column is missing; run schema migration or enable backend.auto_migrate")
+	}
+	historyExists, err := queryBotSchemaExists(ctx, botClient, checkBotLoginHistoriesTableExistsSQL)
+	if err != nil {
+		return fmt.Errorf("check bot_login_histories table existence: %w", err)
+	}
+	if !historyExists {
+		return fmt.Errorf("bot_login_histories table is missing; run schema migration or enable backend.auto_migrate")
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package bootstrap
+
+import (
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/coordination"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// newSchedulerElector builds the elector that singleton schedulers register
+// with. Call Start on it before starting the schedulers.
+func newSchedulerElector(rdb *redis.Client, cfg harukiConfig.CoordinationConfig) *coordination.Elector {
+	lease := coordination.DefaultLeaderLease
+	if cfg.LeaderLeaseSeconds > 0 {
+		lease = time.Duration(cfg.LeaderLeaseSeconds) * time.Second
+	}
+	return coordination.NewElector(rdb, cfg.InstanceID, lease, harukiLogger.NewLoggerFromGlobal("Coordination"))
+}
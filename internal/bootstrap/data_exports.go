@@ -0,0 +1,41 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	userDataExportModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userdataexport"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildDataExportOptions(cfg harukiConfig.DataExportConfig) userDataExportModule.Options {
+	opts := userDataExportModule.DefaultOptions()
+	if cfg.RetentionHours > 0 {
+		opts.Retention = time.Duration(cfg.RetentionHours) * time.Hour
+	}
+	if cfg.MinIntervalHours > 0 {
+		opts.MinInterval = time.Duration(cfg.MinIntervalHours) * time.Hour
+	}
+	if cfg.MaxAttempts > 0 {
+		opts.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.PollIntervalSeconds > 0 {
+		opts.PollInterval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	}
+	return opts
+}
+
+// startDataExportWorker builds requested account data exports and removes
+// expired ones.
+func startDataExportWorker(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, cfg harukiConfig.DataExportConfig, logger *harukiLogger.Logger) func() {
+	opts := buildDataExportOptions(cfg)
+	userDataExportModule.Configure(opts)
+	if apiHelper.BlobStore == nil {
+		logger.Warnf("blob storage unavailable, data exports stay disabled")
+		return func() {}
+	}
+	logger.Infof("data export worker enabled: retention=%s min_interval=%s", opts.Retention, opts.MinInterval)
+	return userDataExportModule.NewWorker(apiHelper, harukiLogger.NewLoggerFromGlobal("DataExport")).Start(ctx)
+}
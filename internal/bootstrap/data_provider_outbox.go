@@ -0,0 +1,63 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildDataProviderOutboxOptions(cfg harukiConfig.DataProviderOutboxConfig) harukiHandler.DataProviderOutboxOptions {
+	opts := harukiHandler.DefaultDataProviderOutboxOptions()
+	if cfg.MaxAttempts > 0 {
+		opts.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BaseBackoffSeconds > 0 {
+		opts.BaseBackoff = time.Duration(cfg.BaseBackoffSeconds) * time.Second
+	}
+	if cfg.MaxBackoffSeconds > 0 {
+		opts.MaxBackoff = time.Duration(cfg.MaxBackoffSeconds) * time.Second
+	}
+	if opts.MaxBackoff < opts.BaseBackoff {
+		opts.MaxBackoff = opts.BaseBackoff
+	}
+	if cfg.PollIntervalSeconds > 0 {
+		opts.PollInterval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	}
+	if cfg.BatchSize > 0 {
+		opts.BatchSize = cfg.BatchSize
+	}
+	if cfg.Concurrency > 0 {
+		opts.Concurrency = cfg.Concurrency
+	}
+	if cfg.ProviderConcurrency > 0 {
+		opts.ProviderConcurrency = cfg.ProviderConcurrency
+	}
+	if opts.ProviderConcurrency > opts.Concurrency {
+		opts.ProviderConcurrency = opts.Concurrency
+	}
+	if cfg.RetentionDays > 0 {
+		opts.Retention = time.Duration(cfg.RetentionDays) * 24 * time.Hour
+	}
+	return opts
+}
+
+// startDataProviderOutboxWorker forwards queued uploads to third-party data
+// providers. The worker always runs, since uploads are queued whenever a
+// provider is configured, including ones added at runtime.
+func startDataProviderOutboxWorker(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, cfg harukiConfig.DataProviderOutboxConfig, logger *harukiLogger.Logger) func() {
+	opts := buildDataProviderOutboxOptions(cfg)
+	harukiHandler.ConfigureDataProviderOutbox(opts)
+	logger.Infof("data provider outbox worker enabled: max_attempts=%d poll_interval=%s concurrency=%d provider_concurrency=%d", opts.MaxAttempts, opts.PollInterval, opts.Concurrency, opts.ProviderConcurrency)
+	worker := harukiHandler.NewDataProviderOutboxWorker(
+		apiHelper.DBManager.DB,
+		apiHelper.GetDataProviders,
+		apiHelper.RedisClient(),
+		harukiLogger.NewLoggerFromGlobal("DataProviderOutbox"),
+		opts,
+	)
+	return worker.Start(ctx)
+}
@@ -2,9 +2,17 @@ package bootstrap
 
 import (
 	"context"
+	stdsql "database/sql"
 	"fmt"
+	neopgManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
 	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/XSAM/otelsql"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const startupDependencyTimeout = 15 * time.Second
@@ -22,3 +30,41 @@ func ensureRedisReady(ctx context.Context, redisManager *harukiRedis.HarukiRedis
 func startupContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), startupDependencyTimeout)
 }
+
+// openSQLDriver opens the database the way the generated ent Open does, but
+// traces every query and keeps the *sql.DB so its pool stats can be exported.
+func openSQLDriver(driverName, dataSourceName string) (*entsql.Driver, *stdsql.DB, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+	default:
+		return nil, nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+	db, err := otelsql.Open(driverName, dataSourceName,
+		otelsql.WithAttributes(attribute.String("db.system.name", driverName)),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entsql.OpenDB(driverName, db), db, nil
+}
+
+func openToolboxDB(driverName, dataSourceName string) (*dbManager.Client, *stdsql.DB, error) {
+	drv, db, err := openSQLDriver(driverName, dataSourceName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dbManager.NewClient(dbManager.Driver(drv)), db, nil
+}
+
+func openBotDB(driverName, dataSourceName string) (*neopgManager.Client, *stdsql.DB, error) {
+	drv, db, err := openSQLDriver(driverName, dataSourceName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return neopgManager.NewClient(neopgManager.Driver(drv)), db, nil
+}
@@ -3,10 +3,12 @@ package bootstrap
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v3"
@@ -31,6 +33,10 @@ func newFiberApp(cfg harukiConfig.Config) (*fiber.App, func() error, error) {
 		EnableIPValidation: true,
 	})
 
+	app.Use(tracingMiddleware(app))
+	if cfg.Metrics.Enabled {
+		app.Use(metricsMiddleware(app))
+	}
 	app.Use(compress.New(compress.Config{Level: compress.LevelBestSpeed}))
 	app.Use(cspMiddleware(cfg))
 
@@ -41,6 +47,46 @@ func newFiberApp(cfg harukiConfig.Config) (*fiber.App, func() error, error) {
 	return app, closeAccessLogFile, nil
 }
 
+// newRoutePatternResolver returns the route pattern that handled a request,
+// or false when the request only passed through middleware, for which
+// c.Route() reports the middleware's own path.
+func newRoutePatternResolver(app *fiber.App) func(fiber.Ctx) (string, bool) {
+	var (
+		routesOnce sync.Once
+		routes     map[string]struct{}
+	)
+	return func(c fiber.Ctx) (string, bool) {
+		// Routes are all registered before the server starts listening.
+		routesOnce.Do(func() {
+			routes = make(map[string]struct{})
+			for _, route := range app.GetRoutes(true) {
+				routes[route.Method+" "+route.Path] = struct{}{}
+			}
+		})
+		route := c.Route()
+		if route == nil {
+			return "", false
+		}
+		if _, ok := routes[route.Method+" "+route.Path]; !ok {
+			return "", false
+		}
+		return route.Path, true
+	}
+}
+
+// responseStatus is the status the client receives once the error handler
+// has turned err into a response.
+func responseStatus(c fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+	return fiber.StatusInternalServerError
+}
+
 func cspMiddleware(cfg harukiConfig.Config) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		nonceBytes := make([]byte, 16)
@@ -0,0 +1,49 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	uploadModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/upload"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildInheritJobOptions(cfg harukiConfig.InheritJobConfig) uploadModule.InheritJobOptions {
+	opts := uploadModule.DefaultInheritJobOptions()
+	if cfg.Concurrency > 0 {
+		opts.Concurrency = cfg.Concurrency
+	}
+	if len(cfg.ServerConcurrency) > 0 {
+		opts.ServerConcurrency = make(map[string]int, len(cfg.ServerConcurrency))
+		for server, concurrency := range cfg.ServerConcurrency {
+			if concurrency > 0 {
+				opts.ServerConcurrency[server] = concurrency
+			}
+		}
+	}
+	if cfg.MaxQueuedPerServer > 0 {
+		opts.MaxQueuedPerServer = cfg.MaxQueuedPerServer
+	}
+	if cfg.JobTimeoutSeconds > 0 {
+		opts.JobTimeout = time.Duration(cfg.JobTimeoutSeconds) * time.Second
+	}
+	if cfg.StaleAfterSeconds > 0 {
+		opts.StaleAfter = time.Duration(cfg.StaleAfterSeconds) * time.Second
+	}
+	if opts.StaleAfter < 2*opts.SweepInterval {
+		opts.StaleAfter = 2 * opts.SweepInterval
+	}
+	if cfg.RetentionHours > 0 {
+		opts.Retention = time.Duration(cfg.RetentionHours) * time.Hour
+	}
+	return opts
+}
+
+// startInheritJobRunner runs the queued inherit-code upload jobs.
+func startInheritJobRunner(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, cfg harukiConfig.InheritJobConfig, logger *harukiLogger.Logger) func() {
+	opts := buildInheritJobOptions(cfg)
+	logger.Infof("inherit job runner enabled: concurrency=%d max_queued_per_server=%d job_timeout=%s", opts.Concurrency, opts.MaxQueuedPerServer, opts.JobTimeout)
+	return uploadModule.StartInheritJobRunner(ctx, apiHelper, harukiLogger.NewLoggerFromGlobal("InheritJob"), opts)
+}
@@ -0,0 +1,64 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	platformMailOutbox "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/mailoutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+func buildMailOutboxOptions(cfg harukiConfig.SMTPConfig) platformMailOutbox.Options {
+	opts := platformMailOutbox.DefaultOptions()
+	outbox := cfg.Outbox
+	if outbox.MaxAttempts > 0 {
+		opts.MaxAttempts = outbox.MaxAttempts
+	}
+	if outbox.BaseBackoffSeconds > 0 {
+		opts.BaseBackoff = time.Duration(outbox.BaseBackoffSeconds) * time.Second
+	}
+	if outbox.MaxBackoffSeconds > 0 {
+		opts.MaxBackoff = time.Duration(outbox.MaxBackoffSeconds) * time.Second
+	}
+	if opts.MaxBackoff < opts.BaseBackoff {
+		opts.MaxBackoff = opts.BaseBackoff
+	}
+	if outbox.PollIntervalSeconds > 0 {
+		opts.PollInterval = time.Duration(outbox.PollIntervalSeconds) * time.Second
+	}
+	if outbox.BatchSize > 0 {
+		opts.BatchSize = outbox.BatchSize
+	}
+	if outbox.Concurrency > 0 {
+		opts.Concurrency = outbox.Concurrency
+	}
+	if outbox.RetentionDays > 0 {
+		opts.Retention = time.Duration(outbox.RetentionDays) * 24 * time.Hour
+	}
+	if locale := harukiSMTP.SupportedLocale(cfg.DefaultLocale); locale != "" {
+		opts.DefaultLocale = locale
+	}
+	return opts
+}
+
+// newMailOutbox wraps the SMTP relays in the persistent outbox that every
+// handler and worker sends mail through.
+func newMailOutbox(db *postgresql.Client, cfg harukiConfig.SMTPConfig, logger *harukiLogger.Logger) *platformMailOutbox.Outbox {
+	smtpClient := harukiSMTP.NewSMTPClient(cfg)
+	if len(smtpClient.Relays) == 0 {
+		logger.Warnf("no smtp relay configured, queued mail will not be sent")
+	}
+	opts := buildMailOutboxOptions(cfg)
+	platformMailOutbox.Configure(opts)
+	return platformMailOutbox.New(db, smtpClient, harukiLogger.NewLoggerFromGlobal("MailOutbox"), opts)
+}
+
+// startMailOutboxWorker sends the mail queued in the outbox.
+func startMailOutboxWorker(ctx context.Context, outbox *platformMailOutbox.Outbox, logger *harukiLogger.Logger) func() {
+	opts := platformMailOutbox.CurrentOptions()
+	logger.Infof("mail outbox worker enabled: max_attempts=%d poll_interval=%s concurrency=%d", opts.MaxAttempts, opts.PollInterval, opts.Concurrency)
+	return outbox.Start(ctx)
+}
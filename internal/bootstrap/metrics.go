@@ -0,0 +1,100 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+)
+
+const unmatchedRouteLabel = "unmatched"
+
+// metricsMiddleware records every request under its route pattern rather
+// than its path, so path parameters do not blow up the label cardinality.
+// Requests that only passed through middleware are labelled unmatched.
+func metricsMiddleware(app *fiber.App) fiber.Handler {
+	resolveRoute := newRoutePatternResolver(app)
+	return func(c fiber.Ctx) error {
+		started := time.Now()
+		err := c.Next()
+		label, ok := resolveRoute(c)
+		if !ok {
+			label = unmatchedRouteLabel
+		}
+		harukiMetrics.ObserveHTTPRequest(c.Method(), label, responseStatus(c, err), time.Since(started))
+		return err
+	}
+}
+
+// metricsTokenValid accepts any request when token is empty; callers only
+// allow that for the private listener.
+func metricsTokenValid(token, authorization string) bool {
+	if token == "" {
+		return true
+	}
+	provided, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(provided)), []byte(token)) == 1
+}
+
+func handleMetrics(token string) fiber.Handler {
+	metricsHandler := adaptor.HTTPHandler(harukiMetrics.Handler())
+	return func(c fiber.Ctx) error {
+		if !metricsTokenValid(token, c.Get(fiber.HeaderAuthorization)) {
+			return fiber.NewError(fiber.StatusUnauthorized, "invalid metrics token")
+		}
+		return metricsHandler(c)
+	}
+}
+
+// startMetricsServer serves the metrics on their own listener when
+// metrics.listen_addr is set, otherwise mounts them on app. The returned
+// func shuts the listener down.
+func startMetricsServer(app *fiber.App, cfg harukiConfig.MetricsConfig, logger *harukiLogger.Logger) func() {
+	if !cfg.Enabled {
+		return func() {}
+	}
+	token := strings.TrimSpace(cfg.Token)
+	if strings.TrimSpace(cfg.ListenAddr) == "" {
+		app.Get(cfg.Path, handleMetrics(token))
+		logger.Infof("Prometheus metrics served at %s", cfg.Path)
+		return func() {}
+	}
+
+	mux := http.NewServeMux()
+	metricsHandler := harukiMetrics.Handler()
+	mux.HandleFunc(cfg.Path, func(w http.ResponseWriter, r *http.Request) {
+		if !metricsTokenValid(token, r.Header.Get(fiber.HeaderAuthorization)) {
+			http.Error(w, "invalid metrics token", http.StatusUnauthorized)
+			return
+		}
+		metricsHandler.ServeHTTP(w, r)
+	})
+	server := &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("Prometheus metrics listener on %s stopped: %v", cfg.ListenAddr, err)
+		}
+	}()
+	logger.Infof("Prometheus metrics served at %s%s", cfg.ListenAddr, cfg.Path)
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), resourceCloseTimeout)
+		defer cancel()
+		_ = server.Shutdown(ctx)
+	}
+}
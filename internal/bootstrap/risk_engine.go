@@ -0,0 +1,38 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	riskEngineModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/riskengine"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildRiskEngineOptions(cfg harukiConfig.RiskEngineConfig) riskEngineModule.Options {
+	opts := riskEngineModule.DefaultOptions()
+	if cfg.QueueSize > 0 {
+		opts.QueueSize = cfg.QueueSize
+	}
+	if cfg.RuleRefreshSeconds > 0 {
+		opts.RuleRefreshInterval = time.Duration(cfg.RuleRefreshSeconds) * time.Second
+	}
+	if cfg.SweepIntervalSeconds > 0 {
+		opts.SweepInterval = time.Duration(cfg.SweepIntervalSeconds) * time.Second
+	}
+	return opts
+}
+
+// startRiskEngine installs the engine as the helper's risk signal sink.
+func startRiskEngine(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, cfg harukiConfig.RiskEngineConfig, logger *harukiLogger.Logger) func() {
+	if !cfg.Enabled {
+		logger.Infof("risk engine disabled")
+		return func() {}
+	}
+	opts := buildRiskEngineOptions(cfg)
+	engine := riskEngineModule.NewEngine(apiHelper, harukiLogger.NewLoggerFromGlobal("RiskEngine"), opts)
+	apiHelper.RiskSignals = engine
+	logger.Infof("risk engine enabled: queue_size=%d rule_refresh=%s", opts.QueueSize, opts.RuleRefreshInterval)
+	return engine.Start(ctx)
+}
@@ -6,16 +6,16 @@ import (
 	"fmt"
 	harukiAPI "github.com/Team-Haruki/Haruki-Toolbox-Backend/api"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiDatabaseManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
 	harukiMongo "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/mongo"
-	neopgManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
-	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/dataprovider"
 	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 	harukiSekaiAPIClient "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekaiapi"
-	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
 	harukiVersion "github.com/Team-Haruki/Haruki-Toolbox-Backend/version"
 	"net/http"
 	"os"
@@ -40,9 +40,22 @@ func Run(cfg harukiConfig.Config) error {
 	if err := validateBackendConfig(cfg); err != nil {
 		return err
 	}
+	if err := validateMetricsConfig(cfg); err != nil {
+		return err
+	}
+	if err := validateTracingConfig(cfg); err != nil {
+		return err
+	}
 	if err := validateBotRegistrationConfig(cfg); err != nil {
 		return err
 	}
+	if err := validateSnapshotHistoryConfig(cfg); err != nil {
+		return err
+	}
+	dataProviders, err := dataprovider.NormalizeList(cfg.ThirdPartyDataProvider.ProviderDefinitions())
+	if err != nil {
+		return fmt.Errorf("invalid third_party_data_provider config: %w", err)
+	}
 
 	loggerWriter, closeMainLogFile, err := openMainLogWriter(cfg.Backend.MainLogFile)
 	if err != nil {
@@ -54,6 +67,10 @@ func Run(cfg harukiConfig.Config) error {
 
 	// Set global log level and file writer for NewLoggerFromGlobal
 	harukiLogger.SetGlobalLogLevel(cfg.Backend.LogLevel)
+	harukiLogger.SetGlobalLogFormat(cfg.Backend.LogFormat)
+	if err := harukiLogger.SetModuleLevels(cfg.Backend.ModuleLogLevels); err != nil {
+		return fmt.Errorf("apply module log levels: %w", err)
+	}
 	harukiLogger.SetGlobalFileWriter(loggerWriter)
 
 	mainLogger := harukiLogger.NewLogger("Main", cfg.Backend.LogLevel, loggerWriter)
@@ -61,6 +78,12 @@ func Run(cfg harukiConfig.Config) error {
 	mainLogger.Infof("Build commit: %s, built at: %s", harukiVersion.Commit, harukiVersion.BuildDate)
 	mainLogger.Infof("Powered By Haruki Dev Team")
 
+	stopTracing, err := startTracing(cfg.Tracing, mainLogger)
+	if err != nil {
+		return fmt.Errorf("init tracing: %w", err)
+	}
+	defer stopTracing()
+
 	sekaiAPIClient := harukiSekaiAPIClient.NewHarukiSekaiAPIClient(cfg.SekaiAPI.APIEndpoint, cfg.SekaiAPI.APIToken)
 	mongoCtx, cancelMongoInit := startupContext()
 	mongoManager, err := harukiMongo.NewMongoDBManager(
@@ -79,6 +102,15 @@ func Run(cfg harukiConfig.Config) error {
 		defer cancel()
 		_ = mongoManager.Disconnect(closeCtx)
 	}()
+	if cfg.MongoDB.History.Enabled {
+		historyCtx, cancelHistoryInit := startupContext()
+		err := mongoManager.EnableSnapshotHistory(historyCtx, buildSnapshotHistoryOptions(cfg.MongoDB.History))
+		cancelHistoryInit()
+		if err != nil {
+			return fmt.Errorf("init snapshot history: %w", err)
+		}
+		mainLogger.Infof("snapshot history enabled in collection %s", cfg.MongoDB.History.Collection)
+	}
 
 	redisClient := harukiRedis.NewRedisClient(cfg.Redis)
 	defer func() {
@@ -90,7 +122,7 @@ func Run(cfg harukiConfig.Config) error {
 		return fmt.Errorf("init Redis: %w", err)
 	}
 	cancelRedisInit()
-	entClient, err := dbManager.Open(cfg.UserSystem.DBType, cfg.UserSystem.DBURL)
+	entClient, entDB, err := openToolboxDB(cfg.UserSystem.DBType, cfg.UserSystem.DBURL)
 	if err != nil {
 		return fmt.Errorf("init PostgreSQL: %w", err)
 	}
@@ -137,7 +169,7 @@ func Run(cfg harukiConfig.Config) error {
 	}
 	cancelGrantsCleanup()
 
-	smtpClient := harukiSMTP.NewSMTPClient(cfg.UserSystem.SMTP)
+	mailOutbox := newMailOutbox(entClient, cfg.UserSystem.SMTP, mainLogger)
 	sessionHandler := harukiAPIHelper.NewSessionHandler(redisClient.Redis, cfg.UserSystem.SessionSignToken)
 	sessionHandler.ConfigureIdentityProvider(
 		cfg.UserSystem.AuthProvider,
@@ -161,6 +193,9 @@ func Run(cfg harukiConfig.Config) error {
 		cfg.UserSystem.AuthProxyUserIDHeader,
 	)
 	sessionHandler.ConfigureAuthProxySessionHeader(cfg.UserSystem.AuthProxySessionHeader)
+	if err := secondfactor.Configure(buildSecondFactorOptions(cfg.UserSystem.SecondFactor)); err != nil {
+		return fmt.Errorf("configure second factor: %w", err)
+	}
 	app, closeAccessLogFile, err := newFiberApp(cfg)
 	if err != nil {
 		return err
@@ -171,7 +206,7 @@ func Run(cfg harukiConfig.Config) error {
 
 	dbMgr := harukiDatabaseManager.NewHarukiToolboxDBManager(entClient, redisClient, mongoManager)
 	if botDBURL := strings.TrimSpace(cfg.HarukiBot.DBURL); botDBURL != "" {
-		botClient, botErr := neopgManager.Open(cfg.UserSystem.DBType, botDBURL)
+		botClient, botDB, botErr := openBotDB(cfg.UserSystem.DBType, botDBURL)
 		if botErr != nil {
 			return fmt.Errorf("init Bot PostgreSQL: %w", botErr)
 		}
@@ -180,19 +215,43 @@ func Run(cfg harukiConfig.Config) error {
 		}()
 		if cfg.Backend.AutoMigrate {
 			botSchemaCtx, cancelBotSchema := startupContext()
+			if err := prepareBotSchemaMigration(botSchemaCtx, botClient); err != nil {
+				cancelBotSchema()
+				return fmt.Errorf("prepare bot schema migration: %w", err)
+			}
 			if err := botClient.Schema.Create(botSchemaCtx); err != nil {
 				cancelBotSchema()
 				return fmt.Errorf("create bot schema resources: %w", err)
 			}
 			cancelBotSchema()
 			mainLogger.Infof("bot schema migration completed")
+		} else {
+			botSchemaCtx, cancelBotSchema := startupContext()
+			if err := validateBotSchemaCompatibility(botSchemaCtx, botClient); err != nil {
+				cancelBotSchema()
+				return fmt.Errorf("ensure bot schema compatibility: %w", err)
+			}
+			cancelBotSchema()
 		}
 		dbMgr.BotDB = botClient
+		if cfg.Metrics.Enabled {
+			if err := harukiMetrics.RegisterDBStats(botDB, "haruki_bot"); err != nil {
+				return fmt.Errorf("register bot database metrics: %w", err)
+			}
+		}
+	}
+	if cfg.Metrics.Enabled {
+		if err := harukiMetrics.RegisterDBStats(entDB, "toolbox"); err != nil {
+			return fmt.Errorf("register database metrics: %w", err)
+		}
+		if err := harukiMetrics.RegisterRedisPoolStats(redisClient.Redis); err != nil {
+			return fmt.Errorf("register Redis metrics: %w", err)
+		}
 	}
 	apiHelper := harukiAPIHelper.NewHarukiToolboxRouterHelpers(
 		app,
 		dbMgr,
-		smtpClient,
+		mailOutbox,
 		sessionHandler,
 		sekaiAPIClient,
 		cfg.Others.PublicAPIAllowedKeys,
@@ -207,15 +266,49 @@ func Run(cfg harukiConfig.Config) error {
 	)
 	apiHelper.BotRegistrationEnabled = cfg.HarukiBot.EnableRegistration
 	apiHelper.BotCredentialSignToken = cfg.HarukiBot.CredentialSignToken
+	apiHelper.DataProviders = dataProviders
+	if err := configureTicketAttachments(apiHelper, cfg, mainLogger); err != nil {
+		return err
+	}
 	harukiAPI.RegisterRoutes(apiHelper)
+	stopMetricsServer := startMetricsServer(app, cfg.Metrics, mainLogger)
+	defer stopMetricsServer()
+	elector := newSchedulerElector(redisClient.Redis, cfg.Backend.Coordination)
+	mainLogger.Infof("coordination instance id: %s", elector.InstanceID())
+	electorCtx, stopElector := context.WithCancel(context.Background())
+	waitElector := elector.Start(electorCtx)
 	schedulerCtx, stopSchedulers := context.WithCancel(context.Background())
-	waitAfdianScheduler := startAfdianSponsorSyncScheduler(schedulerCtx, entClient, cfg.Afdian, mainLogger)
-	// Cancel then drain the scheduler goroutine before the deferred entClient.Close
-	// runs, so an in-flight sync never uses the client after it is closed. Both
-	// calls are idempotent, so the explicit shutdown path below can repeat them.
+	waitMailOutboxWorker := startMailOutboxWorker(schedulerCtx, mailOutbox, mainLogger)
+	waitAfdianScheduler := startAfdianSponsorSyncScheduler(schedulerCtx, entClient, cfg.Afdian, elector, mainLogger)
+	waitWebhookDeliveryWorker := startWebhookDeliveryWorker(schedulerCtx, entClient, cfg.Webhook, mailOutbox, cfg.UserSystem.SMTP.MailName, mainLogger)
+	waitDataProviderOutboxWorker := startDataProviderOutboxWorker(schedulerCtx, apiHelper, cfg.ThirdPartyDataProvider.Outbox, mainLogger)
+	waitInheritJobRunner := startInheritJobRunner(schedulerCtx, apiHelper, cfg.SekaiClient.InheritJobs, mainLogger)
+	waitRiskEngine := startRiskEngine(schedulerCtx, apiHelper, cfg.RiskEngine, mainLogger)
+	waitTicketSLAWorker := startTicketSLAWorker(schedulerCtx, entClient, cfg.Tickets.SLA, mailOutbox, mainLogger)
+	waitDataExportWorker := startDataExportWorker(schedulerCtx, apiHelper, cfg.UserSystem.DataExport, mainLogger)
+	waitAccountDeletionWorker := startAccountDeletionWorker(schedulerCtx, apiHelper, cfg.UserSystem.AccountDeletion, mainLogger)
+	waitLoginSecondFactorSweeper := startLoginSecondFactorSweeper(schedulerCtx, apiHelper)
+	// Every start function above returns a wait that blocks until its goroutines
+	// have exited. Cancel schedulerCtx, then call each wait before the deferred
+	// entClient and Redis Close run, so an in-flight job never uses a client
+	// after it is closed. Both steps are idempotent, so the explicit shutdown
+	// path below can repeat them.
 	stopAndWaitSchedulers := func() {
 		stopSchedulers()
+		waitMailOutboxWorker()
 		waitAfdianScheduler()
+		waitWebhookDeliveryWorker()
+		waitDataProviderOutboxWorker()
+		waitInheritJobRunner()
+		waitRiskEngine()
+		waitTicketSLAWorker()
+		waitDataExportWorker()
+		waitAccountDeletionWorker()
+		waitLoginSecondFactorSweeper()
+		// Resign only after the schedulers drained, so another instance does
+		// not start a run while this one is still finishing.
+		stopElector()
+		waitElector()
 	}
 	defer stopAndWaitSchedulers()
 	loadedRegions, failedRegions := harukiHandler.GetSuiteRestorerLoadStatus()
@@ -3,19 +3,24 @@ package bootstrap
 import (
 	"context"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
 	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"io"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v3"
 	goredis "github.com/redis/go-redis/v9"
 )
 
@@ -283,3 +288,300 @@ func TestValidateUserSystemConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateSnapshotHistoryConfig(t *testing.T) {
+	cfg := harukiConfig.Config{}
+	cfg.MongoDB.History.Enabled = true
+	cfg.MongoDB.History.Retention = []harukiConfig.SnapshotRetentionConfig{
+		{Server: "jp", DataType: "suite", MaxRevisions: 10},
+	}
+	if err := validateSnapshotHistoryConfig(cfg); err != nil {
+		t.Fatalf("expected valid retention rule to pass, got %v", err)
+	}
+
+	cfg.MongoDB.History.Retention = []harukiConfig.SnapshotRetentionConfig{{DataType: "mysekai_birthday_party"}}
+	if err := validateSnapshotHistoryConfig(cfg); err == nil {
+		t.Fatalf("expected unsupported data type to fail")
+	}
+
+	cfg.MongoDB.History.Retention = []harukiConfig.SnapshotRetentionConfig{{Server: "xx"}}
+	if err := validateSnapshotHistoryConfig(cfg); err == nil {
+		t.Fatalf("expected unsupported server to fail")
+	}
+}
+
+func TestBuildWebhookDeliveryOptions(t *testing.T) {
+	opts := buildWebhookDeliveryOptions(harukiConfig.WebhookDeliveryConfig{})
+	if opts.MaxAttempts != 8 || opts.BaseBackoff != 30*time.Second || opts.Concurrency != 8 {
+		t.Fatalf("unexpected default delivery options: %+v", opts)
+	}
+
+	opts = buildWebhookDeliveryOptions(harukiConfig.WebhookDeliveryConfig{
+		MaxAttempts:        3,
+		BaseBackoffSeconds: 120,
+		MaxBackoffSeconds:  60,
+	})
+	if opts.MaxAttempts != 3 || opts.BaseBackoff != 2*time.Minute {
+		t.Fatalf("unexpected configured delivery options: %+v", opts)
+	}
+	if opts.MaxBackoff != opts.BaseBackoff {
+		t.Fatalf("max backoff = %s, want it raised to base backoff %s", opts.MaxBackoff, opts.BaseBackoff)
+	}
+}
+
+func TestBuildDataProviderOutboxOptions(t *testing.T) {
+	opts := buildDataProviderOutboxOptions(harukiConfig.DataProviderOutboxConfig{})
+	if opts.MaxAttempts != 10 || opts.Concurrency != 16 || opts.ProviderConcurrency != 4 || opts.Retention != 7*24*time.Hour {
+		t.Fatalf("unexpected default outbox options: %+v", opts)
+	}
+
+	opts = buildDataProviderOutboxOptions(harukiConfig.DataProviderOutboxConfig{
+		MaxAttempts:         5,
+		Concurrency:         2,
+		ProviderConcurrency: 8,
+		RetentionDays:       1,
+	})
+	if opts.MaxAttempts != 5 || opts.Concurrency != 2 || opts.Retention != 24*time.Hour {
+		t.Fatalf("unexpected configured outbox options: %+v", opts)
+	}
+	if opts.ProviderConcurrency != 2 {
+		t.Fatalf("provider concurrency = %d, want it capped to concurrency 2", opts.ProviderConcurrency)
+	}
+}
+
+func TestBuildTicketSLAOptions(t *testing.T) {
+	logger := harukiLogger.NewLogger("TicketSLATest", "DEBUG", io.Discard)
+	opts := buildTicketSLAOptions(harukiConfig.TicketSLAConfig{
+		Enabled:              true,
+		FirstResponseMinutes: map[string]int{"urgent": 30, "bogus": 5},
+		ResolutionMinutes:    map[string]int{"urgent": 600, "low": 0},
+		AutoCloseIdleHours:   48,
+	}, logger)
+	if !opts.Enabled || opts.AutoCloseEnabled || opts.AutoCloseAfter != 48*time.Hour {
+		t.Fatalf("unexpected sla options: %+v", opts)
+	}
+	if len(opts.Targets) != 1 {
+		t.Fatalf("targets = %+v, want only urgent", opts.Targets)
+	}
+	if target := opts.Targets["urgent"]; target.FirstResponse != 30*time.Minute || target.Resolution != 10*time.Hour {
+		t.Fatalf("urgent target = %+v", target)
+	}
+
+	opts = buildTicketSLAOptions(harukiConfig.TicketSLAConfig{}, logger)
+	if len(opts.Targets) != 4 || opts.SweepInterval != 5*time.Minute {
+		t.Fatalf("unexpected default sla options: %+v", opts)
+	}
+}
+
+func TestBuildMailOutboxOptions(t *testing.T) {
+	opts := buildMailOutboxOptions(harukiConfig.SMTPConfig{
+		DefaultLocale: "en-US",
+		Outbox: harukiConfig.MailOutboxConfig{
+			MaxAttempts:        3,
+			BaseBackoffSeconds: 120,
+			MaxBackoffSeconds:  60,
+			RetentionDays:      7,
+		},
+	})
+	if opts.MaxAttempts != 3 || opts.BaseBackoff != 2*time.Minute || opts.MaxBackoff != 2*time.Minute {
+		t.Fatalf("unexpected mail outbox options: %+v", opts)
+	}
+	if opts.Retention != 7*24*time.Hour || opts.DefaultLocale != "en" {
+		t.Fatalf("unexpected mail outbox options: %+v", opts)
+	}
+
+	opts = buildMailOutboxOptions(harukiConfig.SMTPConfig{DefaultLocale: "fr"})
+	if opts.PollInterval != 5*time.Second || opts.Concurrency != 4 || opts.DefaultLocale != "zh-CN" {
+		t.Fatalf("unexpected default mail outbox options: %+v", opts)
+	}
+}
+
+func TestBuildDataExportOptions(t *testing.T) {
+	opts := buildDataExportOptions(harukiConfig.DataExportConfig{
+		RetentionHours:   48,
+		MinIntervalHours: 6,
+		MaxAttempts:      5,
+	})
+	if opts.Retention != 48*time.Hour || opts.MinInterval != 6*time.Hour || opts.MaxAttempts != 5 || opts.PollInterval != 30*time.Second {
+		t.Fatalf("unexpected data export options: %+v", opts)
+	}
+
+	opts = buildDataExportOptions(harukiConfig.DataExportConfig{})
+	if opts.Retention != 7*24*time.Hour || opts.MinInterval != 24*time.Hour || opts.MaxAttempts != 3 {
+		t.Fatalf("unexpected default data export options: %+v", opts)
+	}
+}
+
+func TestBuildAccountDeletionOptions(t *testing.T) {
+	opts := buildAccountDeletionOptions(harukiConfig.AccountDeletionConfig{GracePeriodHours: 72, PollIntervalSeconds: 10})
+	if opts.GracePeriod != 72*time.Hour || opts.PollInterval != 10*time.Second || opts.MaxAttempts != 5 {
+		t.Fatalf("unexpected account deletion options: %+v", opts)
+	}
+
+	opts = buildAccountDeletionOptions(harukiConfig.AccountDeletionConfig{})
+	if opts.GracePeriod != 7*24*time.Hour || opts.PollInterval != time.Minute {
+		t.Fatalf("unexpected default account deletion options: %+v", opts)
+	}
+}
+
+func TestBuildSecondFactorOptions(t *testing.T) {
+	opts := buildSecondFactorOptions(harukiConfig.SecondFactorConfig{AdminPolicy: "Required", VerifiedSessionHours: 8, WebAuthnRPID: "toolbox.example"})
+	if opts.AdminPolicy != secondfactor.PolicyRequired || opts.VerifiedSessionTTL != 8*time.Hour || opts.WebAuthnRPID != "toolbox.example" {
+		t.Fatalf("unexpected second factor options: %+v", opts)
+	}
+
+	opts = buildSecondFactorOptions(harukiConfig.SecondFactorConfig{AdminPolicy: "sometimes"})
+	if opts.AdminPolicy != secondfactor.PolicyOff || opts.VerifiedSessionTTL != 24*time.Hour || opts.Issuer != "Haruki Toolbox" {
+		t.Fatalf("unexpected default second factor options: %+v", opts)
+	}
+}
+
+func TestValidateMetricsConfig(t *testing.T) {
+	cfg := harukiConfig.Config{}
+	cfg.Metrics.Enabled = true
+	cfg.Metrics.Path = "/metrics"
+	if err := validateMetricsConfig(cfg); err == nil {
+		t.Fatalf("expected metrics on the main server without a token to fail")
+	}
+	cfg.Metrics.Token = "scrape-token"
+	if err := validateMetricsConfig(cfg); err != nil {
+		t.Fatalf("expected token protected metrics to pass, got %v", err)
+	}
+	cfg.Metrics.Token = ""
+	cfg.Metrics.ListenAddr = "127.0.0.1:9090"
+	if err := validateMetricsConfig(cfg); err != nil {
+		t.Fatalf("expected private listener without token to pass, got %v", err)
+	}
+	cfg.Metrics.Path = "metrics"
+	if err := validateMetricsConfig(cfg); err == nil {
+		t.Fatalf("expected relative metrics path to fail")
+	}
+}
+
+func TestMetricsEndpointLabelsRoutePatterns(t *testing.T) {
+	app := fiber.New()
+	app.Use(metricsMiddleware(app))
+	app.Get("/metrics-test/users/:id", func(c fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	app.Get("/metrics", handleMetrics("scrape-token"))
+
+	for _, path := range []string{"/metrics-test/users/1", "/metrics-test/users/2", "/metrics-test/missing"} {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		if err != nil {
+			t.Fatalf("request %s: %v", path, err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/metrics", nil))
+	if err != nil {
+		t.Fatalf("scrape without token: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != fiber.StatusUnauthorized {
+		t.Fatalf("scrape without token status = %d, want 401", resp.StatusCode)
+	}
+
+	req := httptest.NewRequest(fiber.MethodGet, "/metrics", nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer scrape-token")
+	resp, err = app.Test(req)
+	if err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil || resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("scrape status = %d, err = %v", resp.StatusCode, err)
+	}
+	text := string(body)
+	for _, want := range []string{
+		`haruki_toolbox_http_requests_total{method="GET",route="/metrics-test/users/:id",status="200"} 2`,
+		`route="unmatched",status="404"`,
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("metrics output missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "/metrics-test/users/1") {
+		t.Fatalf("metrics output should not contain raw paths")
+	}
+}
+
+func TestValidateTracingConfig(t *testing.T) {
+	cfg := harukiConfig.Config{}
+	cfg.Tracing.Enabled = true
+	cfg.Tracing.Exporter = "otlp"
+	cfg.Tracing.SampleRatio = 1
+	if err := validateTracingConfig(cfg); err == nil {
+		t.Fatalf("expected otlp exporter without endpoint to fail")
+	}
+	cfg.Tracing.OTLPEndpoint = "http://collector:4318"
+	if err := validateTracingConfig(cfg); err != nil {
+		t.Fatalf("expected otlp exporter with endpoint to pass, got %v", err)
+	}
+	cfg.Tracing.Exporter = "stdout"
+	if err := validateTracingConfig(cfg); err != nil {
+		t.Fatalf("expected stdout exporter to pass, got %v", err)
+	}
+	cfg.Tracing.SampleRatio = 1.5
+	if err := validateTracingConfig(cfg); err == nil {
+		t.Fatalf("expected sample ratio above 1 to fail")
+	}
+	cfg.Tracing.SampleRatio = 1
+	cfg.Tracing.Exporter = "zipkin"
+	if err := validateTracingConfig(cfg); err == nil {
+		t.Fatalf("expected unknown exporter to fail")
+	}
+}
+
+func TestTracingMiddlewareSetsRequestID(t *testing.T) {
+	app := fiber.New()
+	app.Use(tracingMiddleware(app))
+	app.Get("/trace-test", func(c fiber.Ctx) error {
+		return c.SendString(c.Get("X-Request-ID"))
+	})
+
+	cases := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "caller request id",
+			headers: map[string]string{"X-Request-ID": "req-123"},
+			want:    "req-123",
+		},
+		{
+			name:    "trace id from traceparent",
+			headers: map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+			want:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name:    "unsafe request id replaced",
+			headers: map[string]string{"X-Request-ID": "bad id\r\nforged"},
+		},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(fiber.MethodGet, "/trace-test", nil)
+		for key, value := range tc.headers {
+			req.Header.Set(key, value)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("%s: request failed: %v", tc.name, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		got := resp.Header.Get("X-Request-ID")
+		if string(body) != got {
+			t.Fatalf("%s: handler saw request id %q, response has %q", tc.name, body, got)
+		}
+		if tc.want != "" && got != tc.want {
+			t.Fatalf("%s: request id = %q, want %q", tc.name, got, tc.want)
+		}
+		if tc.want == "" && len(got) != 32 {
+			t.Fatalf("%s: expected a generated request id, got %q", tc.name, got)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	userAuthModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/userauth"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildSecondFactorOptions(cfg harukiConfig.SecondFactorConfig) secondfactor.Options {
+	opts := secondfactor.DefaultOptions()
+	if cfg.Issuer != "" {
+		opts.Issuer = cfg.Issuer
+	}
+	opts.AdminPolicy = secondfactor.NormalizePolicy(cfg.AdminPolicy)
+	if cfg.VerifiedSessionHours > 0 {
+		opts.VerifiedSessionTTL = time.Duration(cfg.VerifiedSessionHours) * time.Hour
+	}
+	opts.WebAuthnRPID = cfg.WebAuthnRPID
+	opts.WebAuthnRPDisplayName = cfg.WebAuthnRPDisplayName
+	opts.WebAuthnOrigins = cfg.WebAuthnOrigins
+	return opts
+}
+
+// startLoginSecondFactorSweeper revokes the sessions of password logins that
+// expired waiting for their second factor.
+func startLoginSecondFactorSweeper(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) func() {
+	return userAuthModule.StartLoginSecondFactorSweeper(ctx, apiHelper, harukiLogger.NewLoggerFromGlobal("LoginSecondFactor"))
+}
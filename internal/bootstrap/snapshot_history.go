@@ -0,0 +1,35 @@
+package bootstrap
+
+import (
+	"strings"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiMongo "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/mongo"
+)
+
+func buildSnapshotHistoryOptions(cfg harukiConfig.SnapshotHistoryConfig) harukiMongo.SnapshotHistoryOptions {
+	opts := harukiMongo.SnapshotHistoryOptions{
+		Collection: strings.TrimSpace(cfg.Collection),
+		Default:    snapshotRetentionPolicy(cfg.DefaultMaxRevisions, cfg.DefaultMaxAgeDays),
+	}
+	for _, rule := range cfg.Retention {
+		opts.Overrides = append(opts.Overrides, harukiMongo.SnapshotRetentionOverride{
+			Server:   strings.ToLower(strings.TrimSpace(rule.Server)),
+			DataType: strings.ToLower(strings.TrimSpace(rule.DataType)),
+			Policy:   snapshotRetentionPolicy(rule.MaxRevisions, rule.MaxAgeDays),
+		})
+	}
+	return opts
+}
+
+func snapshotRetentionPolicy(maxRevisions, maxAgeDays int) harukiMongo.SnapshotRetentionPolicy {
+	policy := harukiMongo.SnapshotRetentionPolicy{}
+	if maxRevisions > 0 {
+		policy.MaxRevisions = maxRevisions
+	}
+	if maxAgeDays > 0 {
+		policy.MaxAge = time.Duration(maxAgeDays) * 24 * time.Hour
+	}
+	return policy
+}
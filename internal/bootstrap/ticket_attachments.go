@@ -0,0 +1,50 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildTicketAttachmentOptions(cfg harukiConfig.TicketAttachmentConfig) ticketattachments.Options {
+	opts := ticketattachments.DefaultOptions()
+	opts.Enabled = cfg.Enabled
+	if cfg.MaxFileSizeBytes > 0 {
+		opts.MaxFileSizeBytes = cfg.MaxFileSizeBytes
+	}
+	if cfg.MaxFilesPerMessage > 0 {
+		opts.MaxFilesPerMessage = cfg.MaxFilesPerMessage
+	}
+	if cfg.MaxImagePixels > 0 {
+		opts.MaxImagePixels = cfg.MaxImagePixels
+	}
+	if cfg.ThumbnailMaxSize > 0 {
+		opts.ThumbnailMaxSize = cfg.ThumbnailMaxSize
+	}
+	return opts
+}
+
+// configureTicketAttachments opens the blob store used for ticket
+// attachments. A store that cannot be opened only fails startup while
+// attachments are enabled.
+func configureTicketAttachments(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, cfg harukiConfig.Config, logger *harukiLogger.Logger) error {
+	opts := buildTicketAttachmentOptions(cfg.Tickets.Attachments)
+	ticketattachments.Configure(opts)
+	store, err := blobstore.New(cfg.BlobStorage)
+	if err != nil {
+		if opts.Enabled {
+			return fmt.Errorf("init blob storage: %w", err)
+		}
+		logger.Warnf("blob storage unavailable, ticket attachments stay disabled: %v", err)
+		return nil
+	}
+	apiHelper.BlobStore = store
+	if opts.Enabled {
+		logger.Infof("ticket attachments enabled: backend=%s max_file_size_bytes=%d max_files_per_message=%d", cfg.BlobStorage.Backend, opts.MaxFileSizeBytes, opts.MaxFilesPerMessage)
+	}
+	return nil
+}
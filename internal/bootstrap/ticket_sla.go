@@ -0,0 +1,59 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	platformTicketNotifications "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketnotifications"
+	platformTicketSLA "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketsla"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildTicketSLAOptions(cfg harukiConfig.TicketSLAConfig, logger *harukiLogger.Logger) platformTicketSLA.Options {
+	opts := platformTicketSLA.DefaultOptions()
+	opts.Enabled = cfg.Enabled
+	opts.AutoCloseEnabled = cfg.AutoCloseEnabled
+	if cfg.SweepIntervalSeconds > 0 {
+		opts.SweepInterval = time.Duration(cfg.SweepIntervalSeconds) * time.Second
+	}
+	if cfg.AutoCloseIdleHours > 0 {
+		opts.AutoCloseAfter = time.Duration(cfg.AutoCloseIdleHours) * time.Hour
+	}
+	if cfg.FirstResponseMinutes != nil || cfg.ResolutionMinutes != nil {
+		opts.Targets = make(map[ticket.Priority]platformTicketSLA.Target)
+		for _, priority := range []ticket.Priority{ticket.PriorityLow, ticket.PriorityNormal, ticket.PriorityHigh, ticket.PriorityUrgent} {
+			target := platformTicketSLA.Target{
+				FirstResponse: time.Duration(cfg.FirstResponseMinutes[string(priority)]) * time.Minute,
+				Resolution:    time.Duration(cfg.ResolutionMinutes[string(priority)]) * time.Minute,
+			}
+			if target.FirstResponse > 0 || target.Resolution > 0 {
+				opts.Targets[priority] = target
+			}
+		}
+		for _, targets := range []map[string]int{cfg.FirstResponseMinutes, cfg.ResolutionMinutes} {
+			for key := range targets {
+				if err := ticket.PriorityValidator(ticket.Priority(key)); err != nil {
+					logger.Warnf("ignoring ticket sla target for unknown priority %q", key)
+				}
+			}
+		}
+	}
+	return opts
+}
+
+// startTicketSLAWorker marks and escalates SLA breaches and auto-closes
+// tickets left waiting on the user, whichever of the two is enabled.
+func startTicketSLAWorker(ctx context.Context, db *postgresql.Client, cfg harukiConfig.TicketSLAConfig, mailer platformTicketNotifications.MailSender, logger *harukiLogger.Logger) func() {
+	opts := buildTicketSLAOptions(cfg, logger)
+	platformTicketSLA.Configure(opts)
+	if !opts.Enabled && !opts.AutoCloseEnabled {
+		logger.Infof("ticket sla worker disabled")
+		return func() {}
+	}
+	logger.Infof("ticket sla worker enabled: sla=%t auto_close=%t auto_close_after=%s", opts.Enabled, opts.AutoCloseEnabled, opts.AutoCloseAfter)
+	worker := platformTicketSLA.NewWorker(db, mailer, harukiLogger.NewLoggerFromGlobal("TicketSLA"), opts)
+	return worker.Start(ctx)
+}
@@ -0,0 +1,148 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
+	harukiVersion "github.com/Team-Haruki/Haruki-Toolbox-Backend/version"
+
+	"github.com/gofiber/fiber/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	headerRequestID    = "X-Request-ID"
+	maxRequestIDLength = 128
+)
+
+// fiberHeaderCarrier adapts the request headers for the OTel propagator.
+type fiberHeaderCarrier struct {
+	c fiber.Ctx
+}
+
+func (h fiberHeaderCarrier) Get(key string) string {
+	return h.c.Get(key)
+}
+
+func (h fiberHeaderCarrier) Set(key, value string) {
+	h.c.Request().Header.Set(key, value)
+}
+
+func (h fiberHeaderCarrier) Keys() []string {
+	headers := h.c.GetReqHeaders()
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// tracingMiddleware continues the caller's W3C trace, or starts a new one,
+// and stores the server span in c.Context() for handlers and the upload
+// pipeline. Every request leaves with an X-Request-ID: the caller's when it
+// sent a usable one, else the trace ID, so system logs and traces share a key.
+// It runs even with tracing disabled, when spans are no-ops but the incoming
+// traceparent is still forwarded to outbound calls.
+func tracingMiddleware(app *fiber.App) fiber.Handler {
+	resolveRoute := newRoutePatternResolver(app)
+	propagator := otel.GetTextMapPropagator()
+	return func(c fiber.Ctx) error {
+		ctx := propagator.Extract(c.Context(), fiberHeaderCarrier{c: c})
+		ctx, span := harukiTracing.Tracer().Start(ctx, c.Method(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Method()),
+				attribute.String("url.path", c.Path()),
+				attribute.String("client.address", c.IP()),
+				attribute.String("user_agent.original", c.Get(fiber.HeaderUserAgent)),
+			),
+		)
+		defer span.End()
+
+		requestID := sanitizeRequestID(c.Get(headerRequestID))
+		if requestID == "" {
+			requestID = harukiTracing.TraceID(ctx)
+		}
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		c.Request().Header.Set(headerRequestID, requestID)
+		c.Set(headerRequestID, requestID)
+		span.SetAttributes(attribute.String("http.request.id", requestID))
+
+		c.SetContext(harukiLogger.WithContextFields(ctx, harukiLogger.KV(harukiLogger.FieldRequestID, requestID)))
+		err := c.Next()
+
+		status := responseStatus(c, err)
+		if route, ok := resolveRoute(c); ok {
+			span.SetName(c.Method() + " " + route)
+			span.SetAttributes(attribute.String("http.route", route))
+		}
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+}
+
+// sanitizeRequestID drops caller-supplied IDs that are too long or contain
+// anything but printable ASCII, so they cannot forge log lines.
+func sanitizeRequestID(value string) string {
+	if value == "" || len(value) > maxRequestIDLength {
+		return ""
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] < 0x21 || value[i] > 0x7e {
+			return ""
+		}
+	}
+	return value
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
+
+// startTracing installs the global tracer provider. The returned func flushes
+// the spans still buffered and is safe to call when tracing is disabled.
+func startTracing(cfg harukiConfig.TracingConfig, logger *harukiLogger.Logger) (func(), error) {
+	shutdown, err := harukiTracing.Setup(context.Background(), harukiTracing.Options{
+		Enabled:        cfg.Enabled,
+		ServiceName:    cfg.ServiceName,
+		ServiceVersion: harukiVersion.Version,
+		Exporter:       cfg.Exporter,
+		OTLPEndpoint:   cfg.OTLPEndpoint,
+		OTLPHeaders:    cfg.OTLPHeaders,
+		StdoutPath:     cfg.StdoutPath,
+		SampleRatio:    cfg.SampleRatio,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Enabled {
+		logger.Infof("Tracing enabled, exporting spans via %s", cfg.Exporter)
+	}
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), resourceCloseTimeout)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			logger.Warnf("Failed to flush traces: %v", err)
+		}
+	}, nil
+}
@@ -3,7 +3,10 @@ package bootstrap
 import (
 	"fmt"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	harukiOAuth2 "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/oauth2"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 	"strings"
 )
 
@@ -64,6 +67,53 @@ func validateBackendConfig(cfg harukiConfig.Config) error {
 			return fmt.Errorf("backend.ssl_cert and backend.ssl_key are required when backend.ssl=true")
 		}
 	}
+	switch strings.ToLower(strings.TrimSpace(cfg.Backend.LogFormat)) {
+	case "", harukiLogger.FormatText, harukiLogger.FormatJSON:
+	default:
+		return fmt.Errorf("backend.log_format must be %q or %q", harukiLogger.FormatText, harukiLogger.FormatJSON)
+	}
+	for module, level := range cfg.Backend.ModuleLogLevels {
+		if strings.TrimSpace(module) == "" {
+			return fmt.Errorf("backend.module_log_levels contains an empty module name")
+		}
+		if _, ok := harukiLogger.ParseLevel(level); !ok {
+			return fmt.Errorf("backend.module_log_levels.%s has invalid level %q", module, level)
+		}
+	}
+	return nil
+}
+
+func validateMetricsConfig(cfg harukiConfig.Config) error {
+	metrics := cfg.Metrics
+	if !metrics.Enabled {
+		return nil
+	}
+	if !strings.HasPrefix(metrics.Path, "/") {
+		return fmt.Errorf("metrics.path must start with /")
+	}
+	if strings.TrimSpace(metrics.ListenAddr) == "" && strings.TrimSpace(metrics.Token) == "" {
+		return fmt.Errorf("metrics.token is required when metrics are served on the main server; set it or metrics.listen_addr")
+	}
+	return nil
+}
+
+func validateTracingConfig(cfg harukiConfig.Config) error {
+	tracing := cfg.Tracing
+	if !tracing.Enabled {
+		return nil
+	}
+	if tracing.SampleRatio < 0 || tracing.SampleRatio > 1 {
+		return fmt.Errorf("tracing.sample_ratio must be between 0 and 1")
+	}
+	switch strings.ToLower(strings.TrimSpace(tracing.Exporter)) {
+	case harukiTracing.ExporterOTLP:
+		if strings.TrimSpace(tracing.OTLPEndpoint) == "" {
+			return fmt.Errorf("tracing.otlp_endpoint is required when tracing.exporter=otlp")
+		}
+	case harukiTracing.ExporterStdout:
+	default:
+		return fmt.Errorf("tracing.exporter must be %q or %q", harukiTracing.ExporterOTLP, harukiTracing.ExporterStdout)
+	}
 	return nil
 }
 
@@ -73,3 +123,26 @@ func validateBotRegistrationConfig(cfg harukiConfig.Config) error {
 	}
 	return nil
 }
+
+func validateSnapshotHistoryConfig(cfg harukiConfig.Config) error {
+	history := cfg.MongoDB.History
+	if !history.Enabled {
+		return nil
+	}
+	for i, rule := range history.Retention {
+		if server := strings.ToLower(strings.TrimSpace(rule.Server)); server != "" {
+			if _, err := harukiUtils.ParseSupportedDataUploadServer(server); err != nil {
+				return fmt.Errorf("mongodb.history.retention[%d].server=%q is unsupported", i, rule.Server)
+			}
+		}
+		switch dataType := strings.ToLower(strings.TrimSpace(rule.DataType)); harukiUtils.UploadDataType(dataType) {
+		case "", harukiUtils.UploadDataTypeSuite, harukiUtils.UploadDataTypeMysekai:
+		default:
+			return fmt.Errorf("mongodb.history.retention[%d].data_type=%q is unsupported; use suite or mysekai", i, rule.DataType)
+		}
+		if rule.MaxRevisions < 0 || rule.MaxAgeDays < 0 {
+			return fmt.Errorf("mongodb.history.retention[%d] limits must not be negative", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+)
+
+func buildWebhookDeliveryOptions(cfg harukiConfig.WebhookDeliveryConfig) harukiHandler.WebhookDeliveryOptions {
+	opts := harukiHandler.DefaultWebhookDeliveryOptions()
+	if cfg.MaxAttempts > 0 {
+		opts.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BaseBackoffSeconds > 0 {
+		opts.BaseBackoff = time.Duration(cfg.BaseBackoffSeconds) * time.Second
+	}
+	if cfg.MaxBackoffSeconds > 0 {
+		opts.MaxBackoff = time.Duration(cfg.MaxBackoffSeconds) * time.Second
+	}
+	if opts.MaxBackoff < opts.BaseBackoff {
+		opts.MaxBackoff = opts.BaseBackoff
+	}
+	if cfg.PollIntervalSeconds > 0 {
+		opts.PollInterval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	}
+	if cfg.BatchSize > 0 {
+		opts.BatchSize = cfg.BatchSize
+	}
+	if cfg.Concurrency > 0 {
+		opts.Concurrency = cfg.Concurrency
+	}
+	return opts
+}
+
+func buildWebhookHealthOptions(cfg harukiConfig.WebhookHealthConfig) harukiHandler.WebhookHealthOptions {
+	opts := harukiHandler.DefaultWebhookHealthOptions()
+	if !cfg.AutoDisableEnabled {
+		opts.AutoDisableFailureThreshold = 0
+	} else if cfg.AutoDisableFailureThreshold > 0 {
+		opts.AutoDisableFailureThreshold = cfg.AutoDisableFailureThreshold
+	}
+	if cfg.AutoDisableMinFailureWindowSeconds >= 0 {
+		opts.AutoDisableMinFailureWindow = time.Duration(cfg.AutoDisableMinFailureWindowSeconds) * time.Second
+	}
+	if cfg.AttemptRetentionDays > 0 {
+		opts.AttemptRetention = time.Duration(cfg.AttemptRetentionDays) * 24 * time.Hour
+	}
+	return opts
+}
+
+// startWebhookDeliveryWorker sends queued webhook deliveries and retries the
+// failed ones.
+func startWebhookDeliveryWorker(ctx context.Context, db *dbManager.Client, cfg harukiConfig.WebhookConfig, mailer harukiHandler.WebhookMailSender, mailDisplayName string, logger *harukiLogger.Logger) func() {
+	opts := buildWebhookDeliveryOptions(cfg.Delivery)
+	harukiHandler.ConfigureWebhookDelivery(opts)
+	harukiHandler.ConfigureWebhookHealth(buildWebhookHealthOptions(cfg.Health), mailer, mailDisplayName)
+	if !cfg.Enabled {
+		logger.Infof("webhook delivery worker disabled: webhook is not enabled")
+		return func() {}
+	}
+	logger.Infof("webhook delivery worker enabled: max_attempts=%d poll_interval=%s concurrency=%d", opts.MaxAttempts, opts.PollInterval, opts.Concurrency)
+	worker := harukiHandler.NewWebhookDeliveryWorker(db, harukiLogger.NewLoggerFromGlobal("WebhookDelivery"), opts)
+	return worker.Start(ctx)
+}
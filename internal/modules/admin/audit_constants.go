@@ -11,8 +11,10 @@ const (
 
 	adminAuditActionConfigPublicAPIKeysUpdate = "admin.config.public_api_keys.update"
 	adminAuditActionConfigRuntimeUpdate       = "admin.config.runtime.update"
+	adminAuditActionConfigDataProviderResync  = "admin.config.data_providers.resync"
 	adminAuditActionMeTicketNotificationsGet  = "admin.me.ticket_notifications.get"
 	adminAuditActionMeTicketNotificationsSet  = "admin.me.ticket_notifications.set"
 	adminAuditActionMeSessionsDelete          = "admin.me.sessions.delete"
 	adminAuditActionMeReauth                  = "admin.me.reauth"
+	adminAuditActionMeReauthWebAuthn          = "admin.me.reauth.webauthn"
 )
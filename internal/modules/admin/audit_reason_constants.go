@@ -75,6 +75,7 @@ const (
 	adminFailureReasonGenerateUploadCodeFailed             = "generate_upload_code_failed"
 	adminFailureReasonHashPasswordFailed                   = "hash_password_failed"
 	adminFailureReasonInvalidBanReason                     = "invalid_ban_reason"
+	adminFailureReasonInvalidDataProviders                 = "invalid_data_providers"
 	adminFailureReasonInvalidFriendLinkId                  = "invalid_friend_link_id"
 	adminFailureReasonInvalidGameUserId                    = "invalid_game_user_id"
 	adminFailureReasonInvalidGroupId                       = "invalid_group_id"
@@ -85,6 +86,7 @@ const (
 	adminFailureReasonInvalidIncludeRevoked                = "invalid_include_revoked"
 	adminFailureReasonInvalidItemId                        = "invalid_item_id"
 	adminFailureReasonInvalidItems                         = "invalid_items"
+	adminFailureReasonInvalidModuleLogLevels               = "invalid_module_log_levels"
 	adminFailureReasonInvalidPassword                      = "invalid_password"
 	adminFailureReasonInvalidPathParams                    = "invalid_path_params"
 	adminFailureReasonInvalidPlatformId                    = "invalid_platform_id"
@@ -130,8 +132,13 @@ const (
 	adminFailureReasonResolveFriendGroupItemNextIdFailed   = "resolve_friend_group_item_next_id_failed"
 	adminFailureReasonResolveFriendLinkNextIdFailed        = "resolve_friend_link_next_id_failed"
 	adminFailureReasonRestoreClientFailed                  = "restore_client_failed"
+	adminFailureReasonResyncDataProvidersFailed            = "resync_data_providers_failed"
 	adminFailureReasonRevokeAuthorizationsFailed           = "revoke_authorizations_failed"
 	adminFailureReasonRevokeTokensFailed                   = "revoke_tokens_failed"
+	adminFailureReasonSecondFactorCheckFailed              = "second_factor_check_failed"
+	adminFailureReasonSecondFactorInvalid                  = "second_factor_invalid"
+	adminFailureReasonSecondFactorNotEnrolled              = "second_factor_not_enrolled"
+	adminFailureReasonSecondFactorRequired                 = "second_factor_required"
 	adminFailureReasonSessionStoreUnavailable              = "session_store_unavailable"
 	adminFailureReasonSocialPlatformConflict               = "social_platform_conflict"
 	adminFailureReasonUnbanUserFailed                      = "unban_user_failed"
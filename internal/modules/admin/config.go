@@ -4,6 +4,7 @@ import (
 	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
@@ -27,6 +28,11 @@ type runtimeConfigPayload struct {
 	HarukiProxyUnpackKey *string     `json:"harukiProxyUnpackKey,omitempty"`
 	WebhookJWTSecret     *string     `json:"webhookJwtSecret,omitempty"`
 	WebhookEnabled       *bool       `json:"webhookEnabled,omitempty"`
+	// ModuleLogLevels replaces all per-module log level overrides; an empty
+	// object clears them.
+	ModuleLogLevels *map[string]string `json:"moduleLogLevels,omitempty"`
+	// DataProviders replaces the whole third-party data provider list.
+	DataProviders *[]dataProviderPayload `json:"dataProviders,omitempty"`
 }
 
 type runtimeConfigResponse struct {
@@ -40,6 +46,10 @@ type runtimeConfigResponse struct {
 	HarukiProxyUnpackKeyConfigured bool   `json:"harukiProxyUnpackKeyConfigured"`
 	WebhookJWTSecretConfigured     bool   `json:"webhookJwtSecretConfigured"`
 	WebhookEnabled                 bool   `json:"webhookEnabled"`
+
+	ModuleLogLevels map[string]string `json:"moduleLogLevels"`
+
+	DataProviders []dataProviderResponse `json:"dataProviders"`
 }
 
 func sanitizePublicAPIAllowedKeys(keys []string) ([]string, error) {
@@ -73,6 +83,22 @@ func sanitizeOptionalRuntimeSecret(raw *string, fieldName string) (*string, erro
 	return &out, nil
 }
 
+func sanitizeModuleLogLevels(levels map[string]string) (map[string]string, error) {
+	result := make(map[string]string, len(levels))
+	for module, level := range levels {
+		normalizedModule := strings.TrimSpace(module)
+		if normalizedModule == "" {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "moduleLogLevels contains empty module name")
+		}
+		normalizedLevel, ok := harukiLogger.ParseLevel(level)
+		if !ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "invalid log level for module "+normalizedModule)
+		}
+		result[normalizedModule] = normalizedLevel
+	}
+	return result, nil
+}
+
 func buildRuntimeConfigResponse(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) runtimeConfigResponse {
 	privateAPIToken, privateAPIUserAgent := apiHelper.GetPrivateAPIAuth()
 	harukiProxyUserAgent, harukiProxyVersion, harukiProxySecret, harukiProxyUnpackKey := apiHelper.GetHarukiProxyConfig()
@@ -89,6 +115,10 @@ func buildRuntimeConfigResponse(apiHelper *harukiAPIHelper.HarukiToolboxRouterHe
 		HarukiProxyUnpackKeyConfigured: strings.TrimSpace(harukiProxyUnpackKey) != "",
 		WebhookJWTSecretConfigured:     strings.TrimSpace(webhookJWTSecret) != "",
 		WebhookEnabled:                 apiHelper.GetWebhookEnabled(),
+
+		ModuleLogLevels: harukiLogger.GetModuleLevels(),
+
+		DataProviders: buildDataProviderResponses(apiHelper.GetDataProviders()),
 	}
 }
 
@@ -186,6 +216,24 @@ func handleUpdateRuntimeConfig(apiHelper *harukiAPIHelper.HarukiToolboxRouterHel
 			return respondFiberOrBadRequest(c, err, "invalid webhookJwtSecret")
 		}
 
+		if payload.ModuleLogLevels != nil {
+			moduleLogLevels, err := sanitizeModuleLogLevels(*payload.ModuleLogLevels)
+			if err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigRuntimeUpdate, adminAuditTargetTypeConfig, "runtime", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidModuleLogLevels, nil))
+				return respondFiberOrBadRequest(c, err, "invalid moduleLogLevels")
+			}
+			update.ModuleLogLevels = &moduleLogLevels
+		}
+
+		if payload.DataProviders != nil {
+			dataProviders, err := sanitizeDataProviders(*payload.DataProviders, apiHelper.GetDataProviders())
+			if err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigRuntimeUpdate, adminAuditTargetTypeConfig, "runtime", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidDataProviders, nil))
+				return respondFiberOrBadRequest(c, err, "invalid dataProviders")
+			}
+			update.DataProviders = &dataProviders
+		}
+
 		if privateAPIToken != nil {
 			update.PrivateAPIToken = privateAPIToken
 		}
@@ -231,6 +279,8 @@ func handleUpdateRuntimeConfig(apiHelper *harukiAPIHelper.HarukiToolboxRouterHel
 			"updatedPrivateToken":  privateAPIToken != nil,
 			"updatedWebhookSecret": webhookJWTSecret != nil,
 			"updatedWebhookFlag":   payload.WebhookEnabled != nil,
+			"updatedLogLevels":     payload.ModuleLogLevels != nil,
+			"updatedDataProviders": payload.DataProviders != nil,
 		})
 		return harukiAPIHelper.SuccessResponse(c, "runtime config updated", &resp)
 	}
@@ -0,0 +1,352 @@
+package admin
+
+import (
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/dataprovider"
+	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type dataProviderPayload struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Enabled     bool   `json:"enabled"`
+	Endpoint    string `json:"endpoint"`
+	// Secret is kept from the existing provider of the same name when
+	// omitted; an empty string clears it.
+	Secret    *string                                `json:"secret,omitempty"`
+	Auth      string                                 `json:"auth"`
+	Format    string                                 `json:"format"`
+	CheckURL  string                                 `json:"checkUrl"`
+	Servers   []string                               `json:"servers"`
+	DataTypes []string                               `json:"dataTypes"`
+	Privacy   harukiConfig.DataProviderPrivacyConfig `json:"privacy"`
+}
+
+type dataProviderResponse struct {
+	Name             string                                 `json:"name"`
+	DisplayName      string                                 `json:"displayName"`
+	Enabled          bool                                   `json:"enabled"`
+	Endpoint         string                                 `json:"endpoint"`
+	SecretConfigured bool                                   `json:"secretConfigured"`
+	Auth             string                                 `json:"auth"`
+	Format           string                                 `json:"format"`
+	CheckURL         string                                 `json:"checkUrl"`
+	Servers          []string                               `json:"servers"`
+	DataTypes        []string                               `json:"dataTypes"`
+	Privacy          harukiConfig.DataProviderPrivacyConfig `json:"privacy"`
+}
+
+type dataProviderStatusItem struct {
+	dataprovider.DeliveryStatus
+	DisplayName string `json:"displayName"`
+	Enabled     bool   `json:"enabled"`
+}
+
+type dataProviderStatusResponse struct {
+	Items []dataProviderStatusItem `json:"items"`
+}
+
+const (
+	defaultDataProviderOutboxPage     = 1
+	defaultDataProviderOutboxPageSize = 50
+	maxDataProviderOutboxPageSize     = 200
+)
+
+type dataProviderOutboxSummaryItem struct {
+	postgresql.DataProviderOutboxSummary
+	DisplayName string `json:"displayName"`
+	Enabled     bool   `json:"enabled"`
+}
+
+type dataProviderOutboxSummaryResponse struct {
+	GeneratedAt time.Time                       `json:"generatedAt"`
+	Items       []dataProviderOutboxSummaryItem `json:"items"`
+}
+
+type dataProviderOutboxEntryItem struct {
+	ID             int        `json:"id"`
+	Provider       string     `json:"provider"`
+	Server         string     `json:"server"`
+	GameUserID     string     `json:"gameUserId"`
+	DataType       string     `json:"dataType"`
+	Generation     int        `json:"generation"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	MaxAttempts    int        `json:"maxAttempts"`
+	SnapshotAt     time.Time  `json:"snapshotAt"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastStatusCode *int       `json:"lastStatusCode,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+type dataProviderOutboxEntryListResponse struct {
+	Page       int                           `json:"page"`
+	PageSize   int                           `json:"pageSize"`
+	Total      int                           `json:"total"`
+	TotalPages int                           `json:"totalPages"`
+	HasMore    bool                          `json:"hasMore"`
+	Items      []dataProviderOutboxEntryItem `json:"items"`
+}
+
+type dataProviderResyncPayload struct {
+	Provider   string `json:"provider"`
+	Server     string `json:"server"`
+	GameUserID string `json:"gameUserId"`
+	DataType   string `json:"dataType"`
+}
+
+type dataProviderResyncResponse struct {
+	Requeued int `json:"requeued"`
+}
+
+// sanitizeDataProviders validates the replacement provider list, carrying
+// over secrets the payload leaves out.
+func sanitizeDataProviders(payload []dataProviderPayload, existing []harukiConfig.DataProviderConfig) ([]harukiConfig.DataProviderConfig, error) {
+	existingSecrets := make(map[string]string, len(existing))
+	for _, provider := range existing {
+		existingSecrets[provider.Name] = provider.Secret
+	}
+
+	providers := make([]harukiConfig.DataProviderConfig, 0, len(payload))
+	for _, item := range payload {
+		provider := harukiConfig.DataProviderConfig{
+			Name:        item.Name,
+			DisplayName: item.DisplayName,
+			Enabled:     item.Enabled,
+			Endpoint:    item.Endpoint,
+			Auth:        item.Auth,
+			Format:      item.Format,
+			CheckURL:    item.CheckURL,
+			Servers:     item.Servers,
+			DataTypes:   item.DataTypes,
+			Privacy:     item.Privacy,
+		}
+		providers = append(providers, provider)
+	}
+	normalized, err := dataprovider.NormalizeList(providers)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid dataProviders: "+err.Error())
+	}
+	for i := range normalized {
+		if secret := payload[i].Secret; secret != nil {
+			normalized[i].Secret = strings.TrimSpace(*secret)
+		} else {
+			normalized[i].Secret = existingSecrets[normalized[i].Name]
+		}
+	}
+	return normalized, nil
+}
+
+func buildDataProviderResponses(providers []harukiConfig.DataProviderConfig) []dataProviderResponse {
+	responses := make([]dataProviderResponse, 0, len(providers))
+	for _, provider := range providers {
+		responses = append(responses, dataProviderResponse{
+			Name:             provider.Name,
+			DisplayName:      provider.DisplayName,
+			Enabled:          provider.Enabled,
+			Endpoint:         provider.Endpoint,
+			SecretConfigured: provider.Secret != "",
+			Auth:             provider.Auth,
+			Format:           provider.Format,
+			CheckURL:         provider.CheckURL,
+			Servers:          append([]string{}, provider.Servers...),
+			DataTypes:        append([]string{}, provider.DataTypes...),
+			Privacy:          provider.Privacy,
+		})
+	}
+	return responses
+}
+
+func handleGetDataProviderStatus(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		providers := apiHelper.GetDataProviders()
+		names := make([]string, 0, len(providers))
+		for _, provider := range providers {
+			names = append(names, provider.Name)
+		}
+		statuses, err := dataprovider.GetDeliveryStatus(c.Context(), apiHelper.RedisClient(), names)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data provider status")
+		}
+
+		items := make([]dataProviderStatusItem, 0, len(providers))
+		for i, provider := range providers {
+			items = append(items, dataProviderStatusItem{
+				DeliveryStatus: statuses[i],
+				DisplayName:    provider.DisplayName,
+				Enabled:        provider.Enabled,
+			})
+		}
+		resp := dataProviderStatusResponse{Items: items}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetDataProviderOutbox(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		providers := apiHelper.GetDataProviders()
+		names := make([]string, 0, len(providers))
+		for _, provider := range providers {
+			names = append(names, provider.Name)
+		}
+		now := adminNowUTC()
+		summaries, err := apiHelper.DBManager.DB.SummarizeDataProviderOutbox(c.Context(), names, now)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data provider outbox")
+		}
+
+		items := make([]dataProviderOutboxSummaryItem, 0, len(providers))
+		for i, provider := range providers {
+			items = append(items, dataProviderOutboxSummaryItem{
+				DataProviderOutboxSummary: summaries[i],
+				DisplayName:               provider.DisplayName,
+				Enabled:                   provider.Enabled,
+			})
+		}
+		resp := dataProviderOutboxSummaryResponse{GeneratedAt: now, Items: items}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+// parseDataProviderOutboxFilter reads the outbox filter shared by the entry
+// listing and the resync endpoint.
+func parseDataProviderOutboxFilter(provider, server, gameUserID, dataType string) (postgresql.DataProviderOutboxFilter, error) {
+	filter := postgresql.DataProviderOutboxFilter{
+		Provider:   strings.TrimSpace(provider),
+		Server:     strings.TrimSpace(server),
+		GameUserID: strings.TrimSpace(gameUserID),
+		DataType:   strings.TrimSpace(dataType),
+	}
+	if filter.Server != "" {
+		if _, err := harukiUtils.ParseSupportedDataUploadServer(filter.Server); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid server")
+		}
+	}
+	if filter.GameUserID != "" {
+		if _, err := strconv.ParseInt(filter.GameUserID, 10, 64); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid gameUserId")
+		}
+	}
+	if filter.DataType != "" {
+		if _, err := harukiUtils.ParseUploadDataType(filter.DataType); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid dataType")
+		}
+	}
+	return filter, nil
+}
+
+func handleListDataProviderOutboxEntries(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		filter, err := parseDataProviderOutboxFilter(c.Query("provider"), c.Query("server"), c.Query("game_user_id"), c.Query("data_type"))
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid outbox filter")
+		}
+		for _, rawStatus := range strings.Split(c.Query("status"), ",") {
+			rawStatus = strings.TrimSpace(rawStatus)
+			if rawStatus == "" {
+				continue
+			}
+			status := dataprovideroutbox.Status(rawStatus)
+			if err := dataprovideroutbox.StatusValidator(status); err != nil {
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid status")
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultDataProviderOutboxPage, defaultDataProviderOutboxPageSize, maxDataProviderOutboxPageSize)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListDataProviderOutbox(c.Context(), filter, (page-1)*pageSize, pageSize)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data provider outbox")
+		}
+		items := make([]dataProviderOutboxEntryItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, dataProviderOutboxEntryItem{
+				ID:             row.ID,
+				Provider:       row.Provider,
+				Server:         row.Server,
+				GameUserID:     row.GameUserID,
+				DataType:       row.DataType,
+				Generation:     row.Generation,
+				Status:         string(row.Status),
+				Attempts:       row.Attempts,
+				MaxAttempts:    row.MaxAttempts,
+				SnapshotAt:     row.SnapshotAt.UTC(),
+				NextAttemptAt:  row.NextAttemptAt.UTC(),
+				LastStatusCode: row.LastStatusCode,
+				LastError:      row.LastError,
+				DeliveredAt:    row.DeliveredAt,
+				UpdatedAt:      row.UpdatedAt.UTC(),
+			})
+		}
+		resp := dataProviderOutboxEntryListResponse{
+			Page:       page,
+			PageSize:   pageSize,
+			Total:      total,
+			TotalPages: platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:    platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:      items,
+		}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+// handleResyncDataProviders re-queues the stored snapshots of a server,
+// optionally narrowed to one game account, provider or data type.
+func handleResyncDataProviders(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var payload dataProviderResyncPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigDataProviderResync, adminAuditTargetTypeConfig, "data_providers", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidRequestPayload, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		filter, err := parseDataProviderOutboxFilter(payload.Provider, payload.Server, payload.GameUserID, payload.DataType)
+		if err == nil && filter.Server == "" {
+			err = fiber.NewError(fiber.StatusBadRequest, "server is required")
+		}
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigDataProviderResync, adminAuditTargetTypeConfig, "data_providers", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidRequestPayload, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid resync request")
+		}
+		if filter.Provider != "" {
+			known := false
+			for _, provider := range apiHelper.GetDataProviders() {
+				known = known || provider.Name == filter.Provider
+			}
+			if !known {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigDataProviderResync, adminAuditTargetTypeConfig, "data_providers", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidDataProviders, nil))
+				return harukiAPIHelper.ErrorBadRequest(c, "unknown provider")
+			}
+		}
+
+		requeued, err := harukiHandler.ResyncDataProviderOutbox(c.Context(), apiHelper.DBManager.DB, filter)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigDataProviderResync, adminAuditTargetTypeConfig, "data_providers", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonResyncDataProvidersFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to resync data providers")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionConfigDataProviderResync, adminAuditTargetTypeConfig, "data_providers", harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"provider":   filter.Provider,
+			"server":     filter.Server,
+			"gameUserId": filter.GameUserID,
+			"dataType":   filter.DataType,
+			"requeued":   requeued,
+		})
+		resp := dataProviderResyncResponse{Requeued: requeued}
+		return harukiAPIHelper.SuccessResponse(c, "data provider snapshots queued for resync", &resp)
+	}
+}
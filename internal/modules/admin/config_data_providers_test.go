@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestDataProviderOutboxEndpoints(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:admin-data-provider-outbox-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:     &database.HarukiToolboxDBManager{DB: client},
+		DataProviders: []harukiConfig.DataProviderConfig{{Name: "partner", DisplayName: "Partner", Enabled: true}},
+	}
+
+	enqueuedAt := time.Now().UTC().Add(-time.Minute)
+	if err := client.EnqueueDataProviderSnapshots(t.Context(), []postgresql.DataProviderOutboxRecord{
+		{Provider: "partner", Server: "jp", GameUserID: "123", DataType: "suite", Payload: []byte("a"), MaxAttempts: 3},
+		{Provider: "partner", Server: "en", GameUserID: "456", DataType: "suite", Payload: []byte("b"), MaxAttempts: 3},
+	}, enqueuedAt); err != nil {
+		t.Fatalf("EnqueueDataProviderSnapshots returned error: %v", err)
+	}
+
+	app := fiber.New()
+	app.Get("/outbox", handleGetDataProviderOutbox(helper))
+	app.Get("/outbox/entries", handleListDataProviderOutboxEntries(helper))
+	app.Post("/resync", handleResyncDataProviders(helper))
+
+	get := func(target string) (int, map[string]any) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, target, nil))
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		var decoded map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+		return resp.StatusCode, decoded
+	}
+	resync := func(payload map[string]any) (int, map[string]any) {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatalf("json.Marshal returned error: %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPost, "/resync", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		var decoded map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+		return resp.StatusCode, decoded
+	}
+
+	status, body := get("/outbox")
+	if status != fiber.StatusOK {
+		t.Fatalf("outbox status code = %d, want %d", status, fiber.StatusOK)
+	}
+	items := body["updatedData"].(map[string]any)["items"].([]any)
+	summary := items[0].(map[string]any)
+	if summary["provider"] != "partner" || summary["displayName"] != "Partner" || summary["pending"] != float64(2) || summary["lagSeconds"].(float64) < 60 {
+		t.Fatalf("unexpected outbox summary: %v", summary)
+	}
+
+	status, body = get("/outbox/entries?server=jp&status=pending")
+	if status != fiber.StatusOK {
+		t.Fatalf("entries status code = %d, want %d", status, fiber.StatusOK)
+	}
+	if total := body["updatedData"].(map[string]any)["total"]; total != float64(1) {
+		t.Fatalf("filtered entries total = %v, want 1", total)
+	}
+	if status, _ := get("/outbox/entries?status=unknown"); status != fiber.StatusBadRequest {
+		t.Fatalf("invalid status filter status code = %d, want %d", status, fiber.StatusBadRequest)
+	}
+
+	if status, _ := resync(map[string]any{"gameUserId": "123"}); status != fiber.StatusBadRequest {
+		t.Fatalf("resync without server status code = %d, want %d", status, fiber.StatusBadRequest)
+	}
+	if status, _ := resync(map[string]any{"server": "jp", "provider": "missing"}); status != fiber.StatusBadRequest {
+		t.Fatalf("resync of unknown provider status code = %d, want %d", status, fiber.StatusBadRequest)
+	}
+	status, body = resync(map[string]any{"server": "jp", "gameUserId": "123"})
+	if status != fiber.StatusOK {
+		t.Fatalf("resync status code = %d, want %d", status, fiber.StatusOK)
+	}
+	if requeued := body["updatedData"].(map[string]any)["requeued"]; requeued != float64(1) {
+		t.Fatalf("requeued = %v, want 1", requeued)
+	}
+}
@@ -3,9 +3,11 @@ package admin
 import (
 	"bytes"
 	"encoding/json"
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -279,3 +281,110 @@ func TestHandleUpdateRuntimeConfigPropagatesAcrossHelpers(t *testing.T) {
 		t.Fatalf("helper2 public api keys = %#v, want [shared-key]", keys)
 	}
 }
+
+func TestHandleUpdateRuntimeConfigModuleLogLevels(t *testing.T) {
+	previous := harukiLogger.GetModuleLevels()
+	t.Cleanup(func() { _ = harukiLogger.SetModuleLevels(previous) })
+
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{}
+	app := fiber.New()
+	app.Put("/", handleUpdateRuntimeConfig(helper))
+
+	send := func(payload map[string]any) *http.Response {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatalf("json.Marshal returned error: %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		return resp
+	}
+
+	resp := send(map[string]any{"moduleLogLevels": map[string]string{" HarukiDataSyncer ": "debug", "Main": "warn"}})
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	levels := harukiLogger.GetModuleLevels()
+	if len(levels) != 2 || levels["HarukiDataSyncer"] != "DEBUG" || levels["Main"] != "WARNING" {
+		t.Fatalf("module levels not applied: %#v", levels)
+	}
+
+	resp = send(map[string]any{"moduleLogLevels": map[string]string{"Main": "LOUD"}})
+	if resp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("invalid level status code = %d, want %d", resp.StatusCode, fiber.StatusBadRequest)
+	}
+	if harukiLogger.GetModuleLevels()["Main"] != "WARNING" {
+		t.Fatalf("rejected update should keep previous levels")
+	}
+
+	resp = send(map[string]any{"moduleLogLevels": map[string]string{}})
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("clear status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	if levels := harukiLogger.GetModuleLevels(); len(levels) != 0 {
+		t.Fatalf("expected module levels to be cleared, got %#v", levels)
+	}
+}
+
+func TestHandleUpdateRuntimeConfigDataProviders(t *testing.T) {
+	helper, _ := newAdminConfigRedisHelper(t)
+	helper.DataProviders = []harukiConfig.DataProviderConfig{
+		{Name: "partner", Enabled: true, Endpoint: "https://partner/upload", Secret: "kept", Auth: "bearer", Format: "raw", DataTypes: []string{"suite"}},
+	}
+	app := fiber.New()
+	app.Put("/", handleUpdateRuntimeConfig(helper))
+
+	send := func(payload map[string]any) (*http.Response, map[string]any) {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatalf("json.Marshal returned error: %v", err)
+		}
+		req := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		var decoded map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+		return resp, decoded
+	}
+
+	resp, body := send(map[string]any{"dataProviders": []map[string]any{
+		{"name": "partner", "enabled": true, "endpoint": "https://partner/v2", "format": "json-zstd", "dataTypes": []string{"suite", "mysekai"}},
+		{"name": "newcomer", "enabled": false, "endpoint": "https://newcomer/upload", "secret": "fresh", "dataTypes": []string{"suite"}, "servers": []string{"jp"}},
+	}})
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	providers := helper.GetDataProviders()
+	if len(providers) != 2 || providers[0].Secret != "kept" || providers[0].Endpoint != "https://partner/v2" || providers[1].Secret != "fresh" {
+		t.Fatalf("providers not updated as expected: %+v", providers)
+	}
+	listed := body["updatedData"].(map[string]any)["dataProviders"].([]any)
+	if first := listed[0].(map[string]any); first["secretConfigured"] != true || first["secret"] != nil {
+		t.Fatalf("response must report secretConfigured without the secret: %v", first)
+	}
+
+	if resp, _ := send(map[string]any{"dataProviders": []map[string]any{
+		{"name": "dup", "endpoint": "https://a", "dataTypes": []string{"suite"}},
+		{"name": "dup", "endpoint": "https://b", "dataTypes": []string{"suite"}},
+	}}); resp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("duplicate names status code = %d, want %d", resp.StatusCode, fiber.StatusBadRequest)
+	}
+	if len(helper.GetDataProviders()) != 2 {
+		t.Fatalf("rejected update should keep previous providers")
+	}
+
+	if resp, _ := send(map[string]any{"dataProviders": []map[string]any{}}); resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("clear status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	other := &harukiAPIHelper.HarukiToolboxRouterHelpers{DBManager: helper.DBManager, DataProviders: providers}
+	if got := other.GetDataProviders(); len(got) != 0 {
+		t.Fatalf("cleared providers should propagate through the store, got %+v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"errors"
 	"strings"
 
 	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
@@ -80,6 +81,30 @@ func handleAdminReauth(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fi
 			return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
 		}
 
+		method, err := verifyAdminReauthSecondFactor(c, apiHelper, userID, payload.Assertion)
+		if err != nil {
+			reason := adminFailureReasonSecondFactorInvalid
+			status := fiber.StatusInternalServerError
+			if fiberErr, ok := err.(*fiber.Error); ok {
+				status = fiberErr.Code
+			}
+			switch {
+			case errors.Is(err, errAdminSecondFactorMissing):
+				reason = adminFailureReasonSecondFactorRequired
+			case status == fiber.StatusInternalServerError:
+				reason = adminFailureReasonSecondFactorCheckFailed
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauth, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(reason, nil))
+			switch status {
+			case fiber.StatusBadRequest:
+				return respondFiberOrBadRequest(c, err, "invalid second factor")
+			case fiber.StatusInternalServerError:
+				return respondFiberOrInternal(c, err, "failed to verify second factor")
+			default:
+				return respondFiberOrForbidden(c, err, "invalid second factor")
+			}
+		}
+
 		if err := markCurrentAdminSessionReauthenticated(c, apiHelper, userID); err != nil {
 			reason := adminFailureReasonReauthRequired
 			if fiberErr, ok := err.(*fiber.Error); ok {
@@ -104,8 +129,17 @@ func handleAdminReauth(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fi
 			return harukiAPIHelper.ErrorInternal(c, "failed to save reauthentication state")
 		}
 
-		resp := adminReauthResponse{ReauthenticatedAt: adminNowUTC()}
-		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauth, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultSuccess, nil)
+		var metadata map[string]any
+		if method != "" {
+			if err := markCurrentAdminSessionSecondFactorVerified(c, apiHelper, userID); err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauth, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonSessionStoreUnavailable, nil))
+				return harukiAPIHelper.ErrorInternal(c, "failed to save reauthentication state")
+			}
+			metadata = map[string]any{"secondFactorMethod": method}
+		}
+
+		resp := adminReauthResponse{ReauthenticatedAt: adminNowUTC(), SecondFactorMethod: method}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauth, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultSuccess, metadata)
 		return harukiAPIHelper.SuccessResponse(c, "reauthenticated", &resp)
 	}
 }
@@ -0,0 +1,64 @@
+package admin
+
+import (
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+var errAdminSecondFactorMissing = fiber.NewError(fiber.StatusForbidden, "second factor required")
+
+// verifyAdminReauthSecondFactor checks the second factor assertion sent
+// with a reauthentication. Admins without a second factor pass with an
+// empty method.
+func verifyAdminReauthSecondFactor(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID string, assertion secondfactor.Assertion) (string, error) {
+	if apiHelper == nil || apiHelper.DBManager == nil || apiHelper.DBManager.Redis == nil || apiHelper.DBManager.Redis.Redis == nil {
+		return "", fiber.NewError(fiber.StatusInternalServerError, "session store unavailable")
+	}
+	enrolled, err := secondfactor.Enrolled(c.Context(), apiHelper.DBManager.DB, userID)
+	if err != nil {
+		return "", err
+	}
+	if !enrolled {
+		return "", nil
+	}
+	if assertion.Empty() {
+		return "", errAdminSecondFactorMissing
+	}
+	return secondfactor.Verify(c.Context(), apiHelper.DBManager.DB, apiHelper.DBManager.Redis.Redis, userID, secondfactor.PurposeReauth, assertion, adminNowUTC())
+}
+
+func markCurrentAdminSessionSecondFactorVerified(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID string) error {
+	sessionMarker, err := resolveCurrentAdminSessionMarker(c, apiHelper)
+	if err != nil {
+		return err
+	}
+	return secondfactor.MarkSessionVerified(c.Context(), apiHelper.DBManager.Redis.Redis, userID, sessionMarker)
+}
+
+// handleBeginAdminReauthWebAuthn issues the security key challenge that is
+// answered in the webauthn field of a reauthentication.
+func handleBeginAdminReauthWebAuthn(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return respondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		if apiHelper == nil || apiHelper.DBManager == nil || apiHelper.DBManager.Redis == nil || apiHelper.DBManager.Redis.Redis == nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauthWebAuthn, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonSessionStoreUnavailable, nil))
+			return harukiAPIHelper.ErrorInternal(c, "session store unavailable")
+		}
+		options, err := secondfactor.BeginWebAuthnAssertion(c.Context(), apiHelper.DBManager.DB, apiHelper.DBManager.Redis.Redis, userID, secondfactor.PurposeReauth)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauthWebAuthn, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonSecondFactorCheckFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to create security key challenge")
+		}
+		if options == nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionMeReauthWebAuthn, adminAuditTargetTypeUser, userID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonSecondFactorNotEnrolled, nil))
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, "no security keys registered", nil)
+		}
+		return harukiAPIHelper.SuccessResponse(c, "security key challenge created", options)
+	}
+}
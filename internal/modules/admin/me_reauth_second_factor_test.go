@@ -0,0 +1,113 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestHandleAdminReauthRequiresEnrolledSecondFactor(t *testing.T) {
+	helper, redisManager := newAdminSessionTestHelper(t)
+	client := enttest.Open(t, "sqlite3", "file:admin-reauth-second-factor-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	helper.DBManager.DB = client
+
+	kratosServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/identities/kratos-admin-1":
+			_, _ = w.Write([]byte(`{"id":"kratos-admin-1","traits":{"email":"admin@example.com"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/self-service/login/api":
+			_, _ = w.Write([]byte(`{"id":"flow-1"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/self-service/login":
+			var payload map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if payload["password"] != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"reason":"the provided credentials are invalid"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"session_token":"password-check-token"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/sessions/whoami":
+			sessionID := "current-session-id"
+			if r.Header.Get("X-Session-Token") == "password-check-token" {
+				sessionID = "password-check-session"
+			}
+			_, _ = w.Write([]byte(`{"id":"` + sessionID + `","active":true,"identity":{"id":"kratos-admin-1","traits":{"email":"admin@example.com"}}}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/admin/sessions/"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(kratosServer.Close)
+	helper.SessionHandler.ConfigureIdentityProvider("kratos", kratosServer.URL, kratosServer.URL, "X-Session-Token", "ory_kratos_session", true, true, 2*time.Second, nil)
+
+	ctx := context.Background()
+	if _, err := client.User.Create().SetID("admin-1").SetName("admin").SetEmail("admin@example.com").SetRole(userSchema.RoleAdmin).SetKratosIdentityID("kratos-admin-1").Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	now := time.Now().UTC()
+	secret, err := secondfactor.BeginTOTPEnrollment(ctx, client, "admin-1", now.Add(-2*time.Minute))
+	if err != nil {
+		t.Fatalf("BeginTOTPEnrollment returned error: %v", err)
+	}
+	enrollCode, _ := secondfactor.TOTPCode(secret, now.Add(-2*time.Minute))
+	if _, err := secondfactor.ConfirmTOTPEnrollment(ctx, client, "admin-1", enrollCode, now.Add(-2*time.Minute)); err != nil {
+		t.Fatalf("ConfirmTOTPEnrollment returned error: %v", err)
+	}
+
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "admin-1")
+		c.Locals("userRole", roleAdmin)
+		c.Locals("identityID", "kratos-admin-1")
+		return c.Next()
+	})
+	app.Post("/reauth", handleAdminReauth(helper))
+	do := func(body string) int {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/reauth", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Session-Token", "current-session-token")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		return resp.StatusCode
+	}
+
+	if status := do(`{"password":"secret"}`); status != fiber.StatusForbidden {
+		t.Fatalf("reauth without second factor status = %d, want 403", status)
+	}
+	if status := do(`{"password":"secret","totpCode":"12"}`); status != fiber.StatusForbidden {
+		t.Fatalf("reauth with wrong code status = %d, want 403", status)
+	}
+	code, _ := secondfactor.TOTPCode(secret, now)
+	if status := do(`{"password":"wrong","totpCode":"` + code + `"}`); status != fiber.StatusForbidden {
+		t.Fatalf("reauth with wrong password status = %d, want 403", status)
+	}
+	if status := do(`{"password":"secret","totpCode":"` + code + `"}`); status != fiber.StatusOK {
+		t.Fatalf("reauth status = %d, want 200", status)
+	}
+
+	if exists := redisManager.Redis.Exists(ctx, buildAdminReauthMarkerKey("admin-1", "kratos:current-session-id")).Val(); exists != 1 {
+		t.Fatalf("expected the reauth marker to be set")
+	}
+	if verified, _ := secondfactor.SessionVerified(ctx, redisManager.Redis, "admin-1", "kratos:current-session-id"); !verified {
+		t.Fatalf("expected the session to be marked as second factor verified")
+	}
+}
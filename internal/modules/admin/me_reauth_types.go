@@ -2,6 +2,8 @@ package admin
 
 import (
 	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
 )
 
 const (
@@ -9,10 +11,14 @@ const (
 	adminReauthMarkerPrefix = "admin:reauth:"
 )
 
+// adminReauthPayload carries the password and, for admins with a second
+// factor, one of totpCode, recoveryCode or webauthn.
 type adminReauthPayload struct {
 	Password string `json:"password"`
+	secondfactor.Assertion
 }
 
 type adminReauthResponse struct {
-	ReauthenticatedAt time.Time `json:"reauthenticatedAt"`
+	ReauthenticatedAt  time.Time `json:"reauthenticatedAt"`
+	SecondFactorMethod string    `json:"secondFactorMethod,omitempty"`
 }
@@ -20,11 +20,20 @@ func registerAdminConfigRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHel
 	cfg.Put("/public-api-keys", requireReauth, handleUpdatePublicAPIAllowedKeys(apiHelper))
 	cfg.Get("/runtime", handleGetRuntimeConfig(apiHelper))
 	cfg.Put("/runtime", requireReauth, handleUpdateRuntimeConfig(apiHelper))
+	cfg.Get("/data-providers/status", handleGetDataProviderStatus(apiHelper))
+	cfg.Get("/data-providers/outbox", handleGetDataProviderOutbox(apiHelper))
+	cfg.Get("/data-providers/outbox/entries", handleListDataProviderOutboxEntries(apiHelper))
+	cfg.Post("/data-providers/resync", requireReauth, handleResyncDataProviders(apiHelper))
 }
 
 func registerAdminSelfRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, adminGroup fiber.Router) {
-	me := adminGroup.Group("/me", adminCoreModule.RequireAdmin(apiHelper))
-	me.Get("/ticket-notifications", handleGetAdminTicketNotificationPreference(apiHelper))
-	me.Put("/ticket-notifications", handleUpdateAdminTicketNotificationPreference(apiHelper))
-	me.Post("/reauth", handleAdminReauth(apiHelper))
+	// Reauthentication is how an admin satisfies the second factor policy,
+	// so those routes only check the role.
+	me := adminGroup.Group("/me")
+	requireAdmin := adminCoreModule.RequireAdmin(apiHelper)
+	requireAdminRole := adminCoreModule.RequireAdminRole(apiHelper)
+	me.Get("/ticket-notifications", requireAdmin, handleGetAdminTicketNotificationPreference(apiHelper))
+	me.Put("/ticket-notifications", requireAdmin, handleUpdateAdminTicketNotificationPreference(apiHelper))
+	me.Post("/reauth", requireAdminRole, handleAdminReauth(apiHelper))
+	me.Post("/reauth/webauthn", requireAdminRole, handleBeginAdminReauthWebAuthn(apiHelper))
 }
@@ -25,12 +25,20 @@ const (
 	adminFailureReasonInvalidUserSession          = "invalid_user_session"
 	adminFailureReasonBannedUserDenied            = "banned_user_denied"
 	adminFailureReasonInsufficientPermissions     = "insufficient_permissions"
+	adminFailureReasonSecondFactorNotEnrolled     = "second_factor_not_enrolled"
+	adminFailureReasonSecondFactorRequired        = "second_factor_required"
+	adminFailureReasonSecondFactorCheckFailed     = "second_factor_check_failed"
 )
 
 type userRoleLookup func(c fiber.Ctx, userID string) (role string, banned bool, err error)
 
 type UserRoleLookup = userRoleLookup
 
+// secondFactorGuard decides whether userID with role may pass given the
+// second factor policy. It returns a failure reason and a fiber error when
+// access is denied.
+type secondFactorGuard func(c fiber.Ctx, userID string, role string) (reason string, err error)
+
 func normalizeRole(role string) string {
 	normalized := strings.ToLower(strings.TrimSpace(role))
 	if normalized == "" {
@@ -103,6 +111,10 @@ func logAdminAccessDenied(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxR
 }
 
 func requireAnyRole(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, lookup userRoleLookup, allowedRoles ...string) fiber.Handler {
+	return requireAnyRoleWithGuard(apiHelper, lookup, nil, allowedRoles...)
+}
+
+func requireAnyRoleWithGuard(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, lookup userRoleLookup, guard secondFactorGuard, allowedRoles ...string) fiber.Handler {
 	allowed := make(map[string]struct{}, len(allowedRoles))
 	validAllowedRoles := make([]string, 0, len(allowedRoles))
 	for _, role := range allowedRoles {
@@ -140,6 +152,18 @@ func requireAnyRole(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, looku
 			logAdminAccessDenied(c, apiHelper, adminFailureReasonInsufficientPermissions, validAllowedRoles, normalizedRole)
 			return harukiAPIHelper.ErrorForbidden(c, "insufficient permissions")
 		}
+		if guard != nil {
+			if reason, err := guard(c, userID, normalizedRole); err != nil {
+				logAdminAccessDenied(c, apiHelper, reason, validAllowedRoles, normalizedRole)
+				if fiberErr, ok := err.(*fiber.Error); ok && fiberErr.Code == fiber.StatusForbidden {
+					return harukiAPIHelper.ErrorForbidden(c, fiberErr.Message)
+				}
+				if fiberErr, ok := err.(*fiber.Error); ok && fiberErr.Code == fiber.StatusUnauthorized {
+					return harukiAPIHelper.ErrorUnauthorized(c, fiberErr.Message)
+				}
+				return harukiAPIHelper.ErrorInternal(c, "failed to verify second factor")
+			}
+		}
 
 		c.Locals("userRole", normalizedRole)
 		return c.Next()
@@ -147,11 +171,17 @@ func requireAnyRole(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, looku
 }
 
 func RequireAdmin(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
-	return requireAnyRole(apiHelper, roleLookupFromDB(apiHelper), RoleAdmin, RoleSuperAdmin)
+	return requireAnyRoleWithGuard(apiHelper, roleLookupFromDB(apiHelper), secondFactorGuardFromPolicy(apiHelper), RoleAdmin, RoleSuperAdmin)
 }
 
 func RequireSuperAdmin(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
-	return requireAnyRole(apiHelper, roleLookupFromDB(apiHelper), RoleSuperAdmin)
+	return requireAnyRoleWithGuard(apiHelper, roleLookupFromDB(apiHelper), secondFactorGuardFromPolicy(apiHelper), RoleSuperAdmin)
+}
+
+// RequireAdminRole checks the admin role only and skips the second factor
+// policy. It guards the routes an admin uses to satisfy that policy.
+func RequireAdminRole(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return requireAnyRole(apiHelper, roleLookupFromDB(apiHelper), RoleAdmin, RoleSuperAdmin)
 }
 
 func RequireAnyRoleWithLookup(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, lookup UserRoleLookup, allowedRoles ...string) fiber.Handler {
@@ -0,0 +1,57 @@
+package admincore
+
+import (
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+// secondFactorGuardFromPolicy enforces the configured admin second factor
+// policy. Admins that must use a second factor pass only once the current
+// session was verified at login or through reauthentication.
+func secondFactorGuardFromPolicy(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) secondFactorGuard {
+	return func(c fiber.Ctx, userID string, role string) (string, error) {
+		if secondfactor.CurrentOptions().AdminPolicy == secondfactor.PolicyOff {
+			return "", nil
+		}
+		db, rdb := secondFactorStores(apiHelper)
+		if db == nil || rdb == nil {
+			return adminFailureReasonSecondFactorCheckFailed, fiber.ErrInternalServerError
+		}
+		required, enrolled, err := secondfactor.RequiredForRole(c.Context(), db, userID, role)
+		if err != nil {
+			return adminFailureReasonSecondFactorCheckFailed, err
+		}
+		if !required {
+			return "", nil
+		}
+		if !enrolled {
+			return adminFailureReasonSecondFactorNotEnrolled, fiber.NewError(fiber.StatusForbidden, "second factor enrollment required")
+		}
+		marker, err := CurrentSessionMarker(c, apiHelper)
+		if err != nil {
+			return adminFailureReasonInvalidUserSession, err
+		}
+		verified, err := secondfactor.SessionVerified(c.Context(), rdb, userID, marker)
+		if err != nil {
+			return adminFailureReasonSecondFactorCheckFailed, err
+		}
+		if !verified {
+			return adminFailureReasonSecondFactorRequired, fiber.NewError(fiber.StatusForbidden, "second factor required")
+		}
+		return "", nil
+	}
+}
+
+func secondFactorStores(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) (*postgresql.Client, *redis.Client) {
+	if apiHelper == nil || apiHelper.DBManager == nil || apiHelper.DBManager.DB == nil {
+		return nil, nil
+	}
+	if apiHelper.DBManager.Redis == nil || apiHelper.DBManager.Redis.Redis == nil {
+		return apiHelper.DBManager.DB, nil
+	}
+	return apiHelper.DBManager.DB, apiHelper.DBManager.Redis.Redis
+}
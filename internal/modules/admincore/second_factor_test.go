@@ -0,0 +1,97 @@
+package admincore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRequireAdminEnforcesSecondFactorPolicy(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:admincore-second-factor-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	if err := secondfactor.Configure(secondfactor.Options{AdminPolicy: secondfactor.PolicyRequired}); err != nil {
+		t.Fatalf("Configure returned error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = secondfactor.Configure(secondfactor.DefaultOptions())
+	})
+
+	ctx := context.Background()
+	if _, err := db.User.Create().SetID("admin-1").SetName("admin").SetEmail("admin@example.com").SetRole(userSchema.RoleAdmin).Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	sessionHandler := harukiAPIHelper.NewSessionHandler(rdb, "")
+	sessionHandler.ConfigureAuthProxy(true, "X-Proxy-Secret", "proxy", "", "", "", "", "")
+	sessionHandler.ConfigureAuthProxySessionHeader("X-Proxy-Session")
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:      &database.HarukiToolboxDBManager{DB: db, Redis: &harukiRedis.HarukiRedisManager{Redis: rdb}},
+		SessionHandler: sessionHandler,
+	}
+
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "admin-1")
+		return c.Next()
+	})
+	ok := func(c fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) }
+	app.Get("/guarded", RequireAdmin(helper), ok)
+	app.Get("/role-only", RequireAdminRole(helper), ok)
+	request := func(path string) int {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Proxy-Secret", "proxy")
+		req.Header.Set("X-Proxy-Session", "session-1")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test(%s) returned error: %v", path, err)
+		}
+		return resp.StatusCode
+	}
+
+	if status := request("/guarded"); status != fiber.StatusForbidden {
+		t.Fatalf("status without enrollment = %d, want 403", status)
+	}
+	if status := request("/role-only"); status != fiber.StatusOK {
+		t.Fatalf("role-only status = %d, want 200", status)
+	}
+
+	now := time.Now().UTC()
+	secret, err := secondfactor.BeginTOTPEnrollment(ctx, db, "admin-1", now)
+	if err != nil {
+		t.Fatalf("BeginTOTPEnrollment returned error: %v", err)
+	}
+	code, _ := secondfactor.TOTPCode(secret, now)
+	if _, err := secondfactor.ConfirmTOTPEnrollment(ctx, db, "admin-1", code, now); err != nil {
+		t.Fatalf("ConfirmTOTPEnrollment returned error: %v", err)
+	}
+	if status := request("/guarded"); status != fiber.StatusForbidden {
+		t.Fatalf("status for unverified session = %d, want 403", status)
+	}
+
+	if err := secondfactor.MarkSessionVerified(ctx, rdb, "admin-1", "authproxy-session:session-1"); err != nil {
+		t.Fatalf("MarkSessionVerified returned error: %v", err)
+	}
+	if status := request("/guarded"); status != fiber.StatusOK {
+		t.Fatalf("status for verified session = %d, want 200", status)
+	}
+}
@@ -0,0 +1,80 @@
+package admincore
+
+import (
+	"context"
+	"strings"
+
+	platformAuthHeader "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/authheader"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func resolveCurrentKratosSessionID(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, bearerToken, kratosHeaderToken, cookieHeader string) (string, error) {
+	sessionToken := strings.TrimSpace(kratosHeaderToken)
+	if sessionToken == "" {
+		sessionToken = strings.TrimSpace(bearerToken)
+	}
+	return apiHelper.SessionHandler.ResolveKratosSessionID(ctx, sessionToken, cookieHeader)
+}
+
+func resolveCurrentAuthProxySessionMarker(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) (string, error) {
+	if apiHelper == nil || apiHelper.SessionHandler == nil || !apiHelper.SessionHandler.UsesAuthProxy() {
+		return "", nil
+	}
+
+	trustedHeader := strings.TrimSpace(apiHelper.SessionHandler.AuthProxyTrustedHeader)
+	trustedValue := strings.TrimSpace(apiHelper.SessionHandler.AuthProxyTrustedValue)
+	if trustedHeader == "" || trustedValue == "" {
+		return "", nil
+	}
+	if !apiHelper.SessionHandler.AuthProxyTrustedValueMatches(c.Get(trustedHeader)) {
+		return "", nil
+	}
+
+	if sessionID, ok := c.Locals("authProxySessionID").(string); ok && strings.TrimSpace(sessionID) != "" {
+		return "authproxy-session:" + strings.TrimSpace(sessionID), nil
+	}
+	sessionHeader := strings.TrimSpace(apiHelper.SessionHandler.AuthProxySessionHeader)
+	if sessionHeader == "" {
+		return "", fiber.NewError(fiber.StatusUnauthorized, "invalid user session")
+	}
+	if sessionID := strings.TrimSpace(c.Get(sessionHeader)); sessionID != "" {
+		return "authproxy-session:" + sessionID, nil
+	}
+	return "", fiber.NewError(fiber.StatusUnauthorized, "invalid user session")
+}
+
+// CurrentSessionMarker identifies the session of the request, either the
+// auth proxy session or the Kratos session. Per-session state such as admin
+// reauthentication and second factor verification is keyed by it.
+func CurrentSessionMarker(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) (string, error) {
+	if sessionMarker, err := resolveCurrentAuthProxySessionMarker(c, apiHelper); err != nil || sessionMarker != "" {
+		return sessionMarker, err
+	}
+
+	if apiHelper == nil || apiHelper.SessionHandler == nil || !apiHelper.SessionHandler.UsesKratosProvider() {
+		return "", fiber.NewError(fiber.StatusUnauthorized, "invalid user session")
+	}
+
+	authHeader := c.Get("Authorization")
+	bearerToken, _ := platformAuthHeader.ExtractBearerToken(authHeader)
+	kratosHeaderToken := strings.TrimSpace(c.Get(apiHelper.SessionHandler.KratosSessionHeader))
+	cookieHeader := strings.TrimSpace(c.Get("Cookie"))
+	if bearerToken == "" && kratosHeaderToken == "" && cookieHeader == "" {
+		return "", fiber.NewError(fiber.StatusUnauthorized, "missing token")
+	}
+
+	sessionID, err := resolveCurrentKratosSessionID(harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP()), apiHelper, bearerToken, kratosHeaderToken, cookieHeader)
+	if err != nil {
+		if harukiAPIHelper.IsIdentityProviderUnavailableError(err) {
+			return "", fiber.NewError(fiber.StatusInternalServerError, "identity provider unavailable")
+		}
+		return "", fiber.NewError(fiber.StatusUnauthorized, "invalid user session")
+	}
+	sessionID = strings.TrimSpace(sessionID)
+	if sessionID == "" {
+		return "", fiber.NewError(fiber.StatusUnauthorized, "invalid user session")
+	}
+	return "kratos:" + sessionID, nil
+}
@@ -0,0 +1,147 @@
+package adminharukibot
+
+import (
+	"strconv"
+	"strings"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	botUser "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	adminAuditActionBotSuspend   = "admin.haruki_bot.suspend"
+	adminAuditActionBotUnsuspend = "admin.haruki_bot.unsuspend"
+	adminAuditTargetTypeBot      = "haruki_bot"
+)
+
+func respondBotAccountError(c fiber.Ctx, err error, fallbackMessage string) error {
+	if _, ok := err.(*fiber.Error); ok {
+		return adminCoreModule.RespondFiberOrBadRequest(c, err, fallbackMessage)
+	}
+	return harukiAPIHelper.ErrorInternal(c, fallbackMessage)
+}
+
+func handleListBotsByOwner(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		ownerQQ, err := strconv.ParseInt(strings.TrimSpace(c.Query("owner_qq")), 10, 64)
+		if err != nil || ownerQQ <= 0 {
+			return harukiAPIHelper.ErrorBadRequest(c, "owner_qq must be a positive integer")
+		}
+		rows, err := apiHelper.DBManager.BotDB.User.Query().
+			Where(botUser.OwnerUserIDEQ(ownerQQ)).
+			Select(botUser.FieldBotID).
+			All(c.Context())
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bots")
+		}
+		botIDs := make([]int, 0, len(rows))
+		for _, row := range rows {
+			botIDs = append(botIDs, row.BotID)
+		}
+		bots, err := platformBotAccount.ListBots(c.Context(), apiHelper.DBManager.BotDB, botIDs)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bots")
+		}
+		resp := botListResponse{Items: bots}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetBot(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		botID, err := parsePositiveIntParam(c.Params("bot_id"), "bot_id")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid bot_id")
+		}
+		row, err := platformBotAccount.GetBot(c.Context(), apiHelper.DBManager.BotDB, botID)
+		if err != nil {
+			return respondBotAccountError(c, err, "failed to query bot")
+		}
+		logins, err := platformBotAccount.ListLogins(c.Context(), apiHelper.DBManager.BotDB, row, platformBotAccount.DefaultLoginLimit)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query login history")
+		}
+		resp := botDetailResponse{Bot: platformBotAccount.BotFromRow(row), Logins: logins}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleSuspendBot(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		actorID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		botID, err := parsePositiveIntParam(c.Params("bot_id"), "bot_id")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid bot_id")
+		}
+		var payload botSuspendPayload
+		if err := c.Bind().JSON(&payload); err != nil {
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request body")
+		}
+		reason, err := platformBotAccount.NormalizeReason(payload.Reason)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid reason")
+		}
+		if reason == "" {
+			return harukiAPIHelper.ErrorBadRequest(c, "reason is required")
+		}
+
+		if err := platformBotAccount.Suspend(c.Context(), apiHelper.DBManager.BotDB, botID, actorID, reason, adminNowUTC()); err != nil {
+			return respondBotAccountError(c, err, "failed to suspend bot")
+		}
+		row, err := platformBotAccount.GetBot(c.Context(), apiHelper.DBManager.BotDB, botID)
+		if err != nil {
+			return respondBotAccountError(c, err, "failed to query bot")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionBotSuspend, adminAuditTargetTypeBot, strconv.Itoa(botID), harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"ownerUserID": row.OwnerUserID,
+			"reason":      reason,
+		})
+		bot := platformBotAccount.BotFromRow(row)
+		return harukiAPIHelper.SuccessResponse(c, "bot suspended", &bot)
+	}
+}
+
+func handleUnsuspendBot(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		actorID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		botID, err := parsePositiveIntParam(c.Params("bot_id"), "bot_id")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid bot_id")
+		}
+
+		if err := platformBotAccount.Unsuspend(c.Context(), apiHelper.DBManager.BotDB, botID, actorID, adminNowUTC()); err != nil {
+			return respondBotAccountError(c, err, "failed to unsuspend bot")
+		}
+		row, err := platformBotAccount.GetBot(c.Context(), apiHelper.DBManager.BotDB, botID)
+		if err != nil {
+			return respondBotAccountError(c, err, "failed to query bot")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionBotUnsuspend, adminAuditTargetTypeBot, strconv.Itoa(botID), harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"ownerUserID": row.OwnerUserID,
+		})
+		bot := platformBotAccount.BotFromRow(row)
+		return harukiAPIHelper.SuccessResponse(c, "bot unsuspended", &bot)
+	}
+}
@@ -0,0 +1,20 @@
+package adminharukibot
+
+import "time"
+
+const (
+	defaultCommandVolumeWindow = 7 * 24 * time.Hour
+	maxCommandVolumeRange      = 90 * 24 * time.Hour
+
+	defaultHourlyRequestWindow = 48 * time.Hour
+	maxHourlyRequestRange      = 31 * 24 * time.Hour
+
+	defaultDailyRequestWindow = 30 * 24 * time.Hour
+	maxDailyRequestRange      = 366 * 24 * time.Hour
+)
+
+var adminNow = time.Now
+
+func adminNowUTC() time.Time {
+	return adminNow().UTC()
+}
@@ -0,0 +1,141 @@
+package adminharukibot
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformBotAnalytics "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botanalytics"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func parseLimit(raw string, fallback, max int) (int, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return fallback, nil
+	}
+	limit, err := strconv.Atoi(trimmed)
+	if err != nil || limit <= 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "limit must be a positive integer")
+	}
+	if limit > max {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "limit exceeds max allowed value")
+	}
+	return limit, nil
+}
+
+func parseBotIDFilter(raw string) ([]string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return nil, nil
+	}
+	botID, err := strconv.Atoi(trimmed)
+	if err != nil || botID <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "bot_id must be a positive integer")
+	}
+	return []string{platformBotAnalytics.PIDForBotID(botID)}, nil
+}
+
+func parseCommandVolumeQuery(c fiber.Ctx, now time.Time) (platformBotAnalytics.CommandVolumeQuery, error) {
+	var query platformBotAnalytics.CommandVolumeQuery
+	from, to, err := platformTime.ResolveTimeRange(c.Query("from"), c.Query("to"), now, defaultCommandVolumeWindow, maxCommandVolumeRange)
+	if err != nil {
+		return query, err
+	}
+	bucket, err := platformBotAnalytics.ParseBucket(c.Query("bucket"))
+	if err != nil {
+		return query, err
+	}
+	tz, loc, err := platformBotAnalytics.ParseTimezone(c.Query("tz"))
+	if err != nil {
+		return query, err
+	}
+	groupBy, err := platformBotAnalytics.ParseGroupBy(c.Query("group_by"))
+	if err != nil {
+		return query, err
+	}
+	limit, err := parseLimit(c.Query("limit"), platformBotAnalytics.DefaultSeriesLimit, platformBotAnalytics.MaxSeriesLimit)
+	if err != nil {
+		return query, err
+	}
+	pids, err := parseBotIDFilter(c.Query("bot_id"))
+	if err != nil {
+		return query, err
+	}
+	return platformBotAnalytics.CommandVolumeQuery{
+		From:     from,
+		To:       to,
+		Bucket:   bucket,
+		Timezone: tz,
+		Location: loc,
+		GroupBy:  groupBy,
+		Limit:    limit,
+		Platform: strings.TrimSpace(c.Query("platform")),
+		Command:  strings.TrimSpace(c.Query("command")),
+		PIDs:     pids,
+	}, nil
+}
+
+func handleGetCommandVolume(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		now := adminNowUTC()
+		query, err := parseCommandVolumeQuery(c, now)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid command volume query")
+		}
+
+		resp, err := platformBotAnalytics.BuildCommandVolume(c.Context(), apiHelper.DBManager.BotDB, query, now)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to build command volume")
+		}
+		return harukiAPIHelper.SuccessResponse(c, "success", resp)
+	}
+}
+
+func handleGetTopBots(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		limit, err := parseLimit(c.Query("limit"), platformBotAnalytics.DefaultTopBotsLimit, platformBotAnalytics.MaxTopBotsLimit)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid limit")
+		}
+
+		resp, err := platformBotAnalytics.BuildTopBots(c.Context(), apiHelper.DBManager.BotDB, limit, adminNowUTC())
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bot ranking")
+		}
+		return harukiAPIHelper.SuccessResponse(c, "success", resp)
+	}
+}
+
+func handleGetRequestCurve(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, granularity string) fiber.Handler {
+	defaultWindow, maxRange := defaultHourlyRequestWindow, maxHourlyRequestRange
+	if granularity == platformBotAnalytics.GranularityDaily {
+		defaultWindow, maxRange = defaultDailyRequestWindow, maxDailyRequestRange
+	}
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		now := adminNowUTC()
+		from, to, err := platformTime.ResolveTimeRange(c.Query("from"), c.Query("to"), now, defaultWindow, maxRange)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid time range")
+		}
+
+		resp, err := platformBotAnalytics.BuildRequestCurve(c.Context(), apiHelper.DBManager.BotDB, granularity, from, to, now)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bot requests")
+		}
+		return harukiAPIHelper.SuccessResponse(c, "success", resp)
+	}
+}
@@ -0,0 +1,106 @@
+package adminharukibot
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newAdminHarukiBotTestApp(t *testing.T, name string) (*fiber.App, *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager: &database.HarukiToolboxDBManager{BotDB: client},
+	}
+	app := fiber.New()
+	app.Get("/commands", handleGetCommandVolume(helper))
+	return app, helper
+}
+
+func TestHandleGetCommandVolume(t *testing.T) {
+	now := time.Date(2026, 6, 2, 0, 0, 0, 0, time.UTC)
+	previousNow := adminNow
+	adminNow = func() time.Time { return now }
+	t.Cleanup(func() { adminNow = previousNow })
+
+	app, helper := newAdminHarukiBotTestApp(t, "admin-haruki-bot-commands-test")
+	for _, pid := range []string{"10000001", "10000001", "10000002"} {
+		if _, err := helper.DBManager.BotDB.CommandLog.Create().
+			SetPlatform("qq").
+			SetPid(pid).
+			SetCommand("/pjsk").
+			SetCreatedAt(now.Add(-time.Hour)).
+			Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed command log: %v", err)
+		}
+	}
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/commands?bucket=day&group_by=platform&bot_id=10000001", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	var payload struct {
+		UpdatedData struct {
+			Total   int64  `json:"total"`
+			GroupBy string `json:"groupBy"`
+			Series  []struct {
+				Key   string `json:"key"`
+				Total int64  `json:"total"`
+			} `json:"series"`
+		} `json:"updatedData"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	got := payload.UpdatedData
+	if got.Total != 2 || got.GroupBy != "platform" || len(got.Series) != 1 || got.Series[0].Key != "qq" {
+		t.Fatalf("unexpected command volume: %s", body)
+	}
+
+	for _, target := range []string{
+		"/commands?group_by=uid",
+		"/commands?bot_id=abc",
+		"/commands?limit=500",
+		"/commands?from=2026-01-01T00:00:00Z&to=2026-06-01T00:00:00Z",
+	} {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, target, nil))
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		if resp.StatusCode != fiber.StatusBadRequest {
+			t.Fatalf("%s status code = %d, want %d", target, resp.StatusCode, fiber.StatusBadRequest)
+		}
+	}
+}
+
+func TestHandleBotAnalyticsWithoutBotDatabase(t *testing.T) {
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{DBManager: &database.HarukiToolboxDBManager{}}
+	app := fiber.New()
+	app.Get("/top-bots", handleGetTopBots(helper))
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/top-bots", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusInternalServerError {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, fiber.StatusInternalServerError)
+	}
+}
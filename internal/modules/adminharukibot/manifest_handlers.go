@@ -0,0 +1,226 @@
+package adminharukibot
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformBotManifest "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botmanifest"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	adminAuditActionManifestCreate   = "admin.bot_manifest.create"
+	adminAuditActionManifestUpdate   = "admin.bot_manifest.update"
+	adminAuditActionManifestDelete   = "admin.bot_manifest.delete"
+	adminAuditActionManifestRollback = "admin.bot_manifest.rollback"
+	adminAuditTargetTypeManifest     = "bot_command_manifest"
+)
+
+func parsePositiveIntParam(raw, name string) (int, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || value <= 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, name+" must be a positive integer")
+	}
+	return value, nil
+}
+
+func (p manifestEntryPayload) entry() platformBotManifest.Entry {
+	return platformBotManifest.Entry{
+		Prefixes:         p.Prefixes,
+		Priority:         p.Priority,
+		Mode:             p.Mode,
+		Module:           p.Module,
+		Path:             p.Path,
+		AdditionalParams: p.AdditionalParams,
+	}
+}
+
+// respondManifestError maps validation errors to 400/404, conflicts to 409
+// with the conflict list, and a lost race for the next version to 409.
+func respondManifestError(c fiber.Ctx, err error, fallbackMessage string) error {
+	var conflictErr *platformBotManifest.ConflictError
+	if errors.As(err, &conflictErr) {
+		resp := manifestConflictResponse{Conflicts: conflictErr.Conflicts}
+		return harukiAPIHelper.UpdatedDataResponse(c, fiber.StatusConflict, conflictErr.Error(), &resp)
+	}
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return adminCoreModule.RespondFiberOrBadRequest(c, fiberErr, fallbackMessage)
+	}
+	if neopg.IsConstraintError(err) {
+		return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, "command manifest was changed concurrently, please retry", nil)
+	}
+	return harukiAPIHelper.ErrorInternal(c, fallbackMessage)
+}
+
+func handleGetManifest(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		manifest, err := platformBotManifest.Load(c.Context(), apiHelper.DBManager.BotDB)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to load command manifest")
+		}
+		conflicts := platformBotManifest.DetectConflicts(manifest.Entries)
+		if conflicts == nil {
+			conflicts = []platformBotManifest.Conflict{}
+		}
+		resp := manifestResponse{Manifest: manifest, Conflicts: conflicts}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleCreateManifestEntry(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		actorID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		var payload manifestEntryPayload
+		if err := c.Bind().JSON(&payload); err != nil {
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request body")
+		}
+
+		entry, snapshot, err := platformBotManifest.Create(c.Context(), apiHelper.DBManager.BotDB, payload.entry(), actorID)
+		if err != nil {
+			return respondManifestError(c, err, "failed to create command manifest entry")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionManifestCreate, adminAuditTargetTypeManifest, entry.Endpoint(), harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"version":  snapshot.Version,
+			"prefixes": entry.Prefixes,
+			"priority": entry.Priority,
+		})
+		resp := manifestEntryResponse{Entry: entry, Version: snapshot}
+		return harukiAPIHelper.UpdatedDataResponse(c, fiber.StatusCreated, "command manifest entry created", &resp)
+	}
+}
+
+func handleUpdateManifestEntry(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		actorID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		id, err := parsePositiveIntParam(c.Params("id"), "id")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid id")
+		}
+		var payload manifestEntryPayload
+		if err := c.Bind().JSON(&payload); err != nil {
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request body")
+		}
+
+		entry, snapshot, err := platformBotManifest.Update(c.Context(), apiHelper.DBManager.BotDB, id, payload.entry(), actorID)
+		if err != nil {
+			return respondManifestError(c, err, "failed to update command manifest entry")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionManifestUpdate, adminAuditTargetTypeManifest, entry.Endpoint(), harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"id":       id,
+			"version":  snapshot.Version,
+			"prefixes": entry.Prefixes,
+			"priority": entry.Priority,
+		})
+		resp := manifestEntryResponse{Entry: entry, Version: snapshot}
+		return harukiAPIHelper.SuccessResponse(c, "command manifest entry updated", &resp)
+	}
+}
+
+func handleDeleteManifestEntry(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		actorID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		id, err := parsePositiveIntParam(c.Params("id"), "id")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid id")
+		}
+
+		snapshot, err := platformBotManifest.Delete(c.Context(), apiHelper.DBManager.BotDB, id, actorID)
+		if err != nil {
+			return respondManifestError(c, err, "failed to delete command manifest entry")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionManifestDelete, adminAuditTargetTypeManifest, strconv.Itoa(id), harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"version": snapshot.Version,
+		})
+		resp := manifestEntryResponse{Version: snapshot}
+		return harukiAPIHelper.SuccessResponse(c, "command manifest entry deleted", &resp)
+	}
+}
+
+func handleListManifestVersions(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		limit, err := parseLimit(c.Query("limit"), platformBotManifest.DefaultVersionLimit, platformBotManifest.MaxVersionLimit)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid limit")
+		}
+		versions, err := platformBotManifest.ListVersions(c.Context(), apiHelper.DBManager.BotDB, limit)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to list command manifest versions")
+		}
+		resp := manifestVersionsResponse{Items: versions}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetManifestVersion(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		version, err := parsePositiveIntParam(c.Params("version"), "version")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid version")
+		}
+		snapshot, err := platformBotManifest.GetVersion(c.Context(), apiHelper.DBManager.BotDB, version)
+		if err != nil {
+			return respondManifestError(c, err, "failed to load command manifest version")
+		}
+		return harukiAPIHelper.SuccessResponse(c, "success", snapshot)
+	}
+}
+
+func handleRollbackManifest(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			return harukiAPIHelper.ErrorInternal(c, "bot database is not configured")
+		}
+		actorID, _, err := adminCoreModule.CurrentAdminActor(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrUnauthorized(c, err, "missing user session")
+		}
+		version, err := parsePositiveIntParam(c.Params("version"), "version")
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid version")
+		}
+
+		snapshot, err := platformBotManifest.Rollback(c.Context(), apiHelper.DBManager.BotDB, version, actorID)
+		if err != nil {
+			return respondManifestError(c, err, "failed to roll back command manifest")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionManifestRollback, adminAuditTargetTypeManifest, strconv.Itoa(version), harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"version":    snapshot.Version,
+			"entryCount": snapshot.EntryCount,
+		})
+		resp := manifestEntryResponse{Version: snapshot}
+		return harukiAPIHelper.SuccessResponse(c, "command manifest rolled back", &resp)
+	}
+}
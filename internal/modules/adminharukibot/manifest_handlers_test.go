@@ -0,0 +1,78 @@
+package adminharukibot
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestManifestHandlersReportConflicts(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:admin-bot-manifest-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager: &database.HarukiToolboxDBManager{BotDB: client},
+	}
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "admin-1")
+		c.Locals("userRole", "admin")
+		return c.Next()
+	})
+	app.Post("/manifest/commands", handleCreateManifestEntry(helper))
+	app.Post("/manifest/versions/:version/rollback", handleRollbackManifest(helper))
+
+	post := func(target, body string) (*http.Response, []byte) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		data, _ := io.ReadAll(resp.Body)
+		return resp, data
+	}
+
+	resp, body := post("/manifest/commands", `{"prefixes":["/card"],"mode":"GET","module":"pjsk","path":"card"}`)
+	if resp.StatusCode != fiber.StatusCreated {
+		t.Fatalf("create status = %d, body %s", resp.StatusCode, body)
+	}
+
+	resp, body = post("/manifest/commands", `{"prefixes":["/cards"],"mode":"GET","module":"pjsk","path":"cards"}`)
+	if resp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("conflicting create status = %d, body %s", resp.StatusCode, body)
+	}
+	var payload struct {
+		UpdatedData manifestConflictResponse `json:"updatedData"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("failed to decode conflict response: %v", err)
+	}
+	if len(payload.UpdatedData.Conflicts) != 1 || payload.UpdatedData.Conflicts[0].ShadowedBy != "/card" {
+		t.Fatalf("unexpected conflicts: %s", body)
+	}
+
+	if resp, body = post("/manifest/commands", `{"prefixes":["/x"],"mode":"HEAD","module":"pjsk","path":"x"}`); resp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("invalid create status = %d, body %s", resp.StatusCode, body)
+	}
+	if resp, body = post("/manifest/versions/1/rollback", ``); resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("rollback status = %d, body %s", resp.StatusCode, body)
+	}
+	if count, _ := client.CommandManifest.Query().Count(t.Context()); count != 0 {
+		t.Fatalf("rollback to the baseline left %d entries", count)
+	}
+	if resp, _ = post("/manifest/versions/42/rollback", ``); resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("missing version rollback status = %d, want %d", resp.StatusCode, fiber.StatusNotFound)
+	}
+}
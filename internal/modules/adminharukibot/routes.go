@@ -0,0 +1,29 @@
+package adminharukibot
+
+import (
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformBotAnalytics "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botanalytics"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+)
+
+func RegisterAdminHarukiBotRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	adminGroup := adminCoreModule.AdminRootGroup(apiHelper)
+	bot := adminGroup.Group("/haruki-bot", adminCoreModule.RequireAdmin(apiHelper))
+	bot.Get("/commands", handleGetCommandVolume(apiHelper))
+	bot.Get("/top-bots", handleGetTopBots(apiHelper))
+	bot.Get("/requests/hourly", handleGetRequestCurve(apiHelper, platformBotAnalytics.GranularityHourly))
+	bot.Get("/requests/daily", handleGetRequestCurve(apiHelper, platformBotAnalytics.GranularityDaily))
+
+	bot.Get("/bots", handleListBotsByOwner(apiHelper))
+	bot.Get("/bots/:bot_id", handleGetBot(apiHelper))
+	bot.Post("/bots/:bot_id/suspend", handleSuspendBot(apiHelper))
+	bot.Post("/bots/:bot_id/unsuspend", handleUnsuspendBot(apiHelper))
+
+	bot.Get("/manifest", handleGetManifest(apiHelper))
+	bot.Post("/manifest/commands", handleCreateManifestEntry(apiHelper))
+	bot.Put("/manifest/commands/:id", handleUpdateManifestEntry(apiHelper))
+	bot.Delete("/manifest/commands/:id", handleDeleteManifestEntry(apiHelper))
+	bot.Get("/manifest/versions", handleListManifestVersions(apiHelper))
+	bot.Get("/manifest/versions/:version", handleGetManifestVersion(apiHelper))
+	bot.Post("/manifest/versions/:version/rollback", handleRollbackManifest(apiHelper))
+}
@@ -0,0 +1,46 @@
+package adminharukibot
+
+import (
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
+	platformBotManifest "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botmanifest"
+)
+
+type manifestEntryPayload struct {
+	Prefixes         []string `json:"prefixes"`
+	Priority         int      `json:"priority"`
+	Mode             string   `json:"mode"`
+	Module           string   `json:"module"`
+	Path             string   `json:"path"`
+	AdditionalParams []string `json:"additionalParams"`
+}
+
+type manifestResponse struct {
+	*platformBotManifest.Manifest
+	Conflicts []platformBotManifest.Conflict `json:"conflicts"`
+}
+
+type manifestEntryResponse struct {
+	Entry   *platformBotManifest.Entry    `json:"entry,omitempty"`
+	Version *platformBotManifest.Snapshot `json:"version"`
+}
+
+type manifestConflictResponse struct {
+	Conflicts []platformBotManifest.Conflict `json:"conflicts"`
+}
+
+type manifestVersionsResponse struct {
+	Items []platformBotManifest.Snapshot `json:"items"`
+}
+
+type botListResponse struct {
+	Items []platformBotAccount.Bot `json:"items"`
+}
+
+type botDetailResponse struct {
+	Bot    platformBotAccount.Bot     `json:"bot"`
+	Logins []platformBotAccount.Login `json:"logins"`
+}
+
+type botSuspendPayload struct {
+	Reason string `json:"reason"`
+}
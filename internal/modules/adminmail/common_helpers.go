@@ -0,0 +1,62 @@
+package adminmail
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	adminMailMessageTargetType     = "email_message"
+	adminMailSuppressionTargetType = "email_suppression"
+	adminMailTargetIDAll           = "all"
+)
+
+const (
+	adminMailActionSummary           = "admin.mail.summary"
+	adminMailActionMessageList       = "admin.mail.message.list"
+	adminMailActionMessageGet        = "admin.mail.message.get"
+	adminMailActionMessageRetry      = "admin.mail.message.retry"
+	adminMailActionSuppressionList   = "admin.mail.suppression.list"
+	adminMailActionSuppressionAdd    = "admin.mail.suppression.add"
+	adminMailActionSuppressionDelete = "admin.mail.suppression.delete"
+)
+
+const (
+	adminMailFailureReasonInvalidRequestPayload    = "invalid_request_payload"
+	adminMailFailureReasonInvalidMessageFilter     = "invalid_message_filter"
+	adminMailFailureReasonInvalidMessageID         = "invalid_message_id"
+	adminMailFailureReasonMessageNotFound          = "message_not_found"
+	adminMailFailureReasonMessageNotRetryable      = "message_not_retryable"
+	adminMailFailureReasonQueryMessagesFailed      = "query_messages_failed"
+	adminMailFailureReasonRetryMessageFailed       = "retry_message_failed"
+	adminMailFailureReasonInvalidSuppressionFilter = "invalid_suppression_filter"
+	adminMailFailureReasonInvalidEmail             = "invalid_email"
+	adminMailFailureReasonSuppressionNotFound      = "suppression_not_found"
+	adminMailFailureReasonQuerySuppressionsFailed  = "query_suppressions_failed"
+	adminMailFailureReasonUpdateSuppressionFailed  = "update_suppression_failed"
+)
+
+const (
+	defaultAdminMailPage     = 1
+	defaultAdminMailPageSize = 50
+	maxAdminMailPageSize     = 200
+)
+
+var adminMailNow = time.Now
+
+func adminMailNowUTC() time.Time {
+	return adminMailNow().UTC()
+}
+
+func sanitizeMessageID(raw string) (string, error) {
+	messageID := strings.TrimSpace(raw)
+	if messageID == "" {
+		return "", fiber.NewError(fiber.StatusBadRequest, "message id is required")
+	}
+	if strings.ContainsAny(messageID, " \t\r\n/") {
+		return "", fiber.NewError(fiber.StatusBadRequest, "message id contains invalid characters")
+	}
+	return messageID, nil
+}
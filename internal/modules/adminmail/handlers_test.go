@@ -0,0 +1,139 @@
+package adminmail
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestAdminMailHandlers(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:admin-mail-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:  &database.HarukiToolboxDBManager{DB: client},
+		SMTPClient: &harukiSMTP.HarukiSMTPClient{Relays: []harukiSMTP.Relay{{Name: "primary", Addr: "smtp.example.com:465"}}},
+	}
+
+	now := time.Now().UTC().Add(-time.Minute)
+	if err := client.EnqueueEmailMessages(t.Context(), []postgresql.EmailMessageRecord{
+		{ID: "queued1", Recipient: "a@example.com", Subject: "Hi", Body: "secret code", MaxAttempts: 3},
+		{ID: "failed1", Recipient: "b@example.com", Subject: "Hi", Body: "secret code", MaxAttempts: 3},
+	}, now); err != nil {
+		t.Fatalf("EnqueueEmailMessages returned error: %v", err)
+	}
+	if err := client.FinishEmailMessage(t.Context(), "failed1", emailmessage.StatusFailed, "", "421 too busy", now); err != nil {
+		t.Fatalf("FinishEmailMessage returned error: %v", err)
+	}
+
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "admin-1")
+		c.Locals("userRole", "super_admin")
+		return c.Next()
+	})
+	app.Get("/summary", handleGetAdminMailSummary(helper))
+	app.Get("/messages", handleListAdminMailMessages(helper))
+	app.Get("/messages/:message_id", handleGetAdminMailMessage(helper))
+	app.Post("/messages/:message_id/retry", handleRetryAdminMailMessage(helper))
+	app.Get("/suppressions", handleListAdminMailSuppressions(helper))
+	app.Post("/suppressions", handleAddAdminMailSuppression(helper))
+	app.Delete("/suppressions/:email", handleDeleteAdminMailSuppression(helper))
+
+	do := func(method, target string, payload any) (int, map[string]any) {
+		var body *bytes.Reader
+		if payload != nil {
+			encoded, err := json.Marshal(payload)
+			if err != nil {
+				t.Fatalf("json.Marshal returned error: %v", err)
+			}
+			body = bytes.NewReader(encoded)
+		} else {
+			body = bytes.NewReader(nil)
+		}
+		req := httptest.NewRequest(method, target, body)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		var decoded map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+		return resp.StatusCode, decoded
+	}
+
+	status, body := do(http.MethodGet, "/summary", nil)
+	if status != fiber.StatusOK {
+		t.Fatalf("summary status code = %d, want %d", status, fiber.StatusOK)
+	}
+	data := body["updatedData"].(map[string]any)
+	messages := data["messages"].(map[string]any)
+	if messages["queued"] != float64(1) || messages["failed"] != float64(1) || messages["lagSeconds"].(float64) < 60 {
+		t.Fatalf("unexpected summary: %v", messages)
+	}
+	if relays := data["relays"].([]any); len(relays) != 1 || relays[0].(map[string]any)["name"] != "primary" {
+		t.Fatalf("unexpected relays: %v", relays)
+	}
+
+	status, body = do(http.MethodGet, "/messages?status=failed", nil)
+	if status != fiber.StatusOK {
+		t.Fatalf("messages status code = %d, want %d", status, fiber.StatusOK)
+	}
+	items := body["updatedData"].(map[string]any)["items"].([]any)
+	if len(items) != 1 || items[0].(map[string]any)["id"] != "failed1" || items[0].(map[string]any)["lastError"] != "421 too busy" {
+		t.Fatalf("unexpected failed messages: %v", items)
+	}
+	if _, ok := items[0].(map[string]any)["body"]; ok {
+		t.Fatalf("message listing exposes the body")
+	}
+	if status, _ := do(http.MethodGet, "/messages?status=unknown", nil); status != fiber.StatusBadRequest {
+		t.Fatalf("invalid status filter status code = %d, want %d", status, fiber.StatusBadRequest)
+	}
+	if status, _ := do(http.MethodGet, "/messages/missing", nil); status != fiber.StatusNotFound {
+		t.Fatalf("missing message status code = %d, want %d", status, fiber.StatusNotFound)
+	}
+
+	if status, _ := do(http.MethodPost, "/messages/queued1/retry", nil); status != fiber.StatusBadRequest {
+		t.Fatalf("retry of a queued message status code = %d, want %d", status, fiber.StatusBadRequest)
+	}
+	status, body = do(http.MethodPost, "/messages/failed1/retry", nil)
+	if status != fiber.StatusOK || body["updatedData"].(map[string]any)["status"] != "queued" {
+		t.Fatalf("retry = %d %v, want a queued message", status, body)
+	}
+
+	if status, _ := do(http.MethodPost, "/suppressions", map[string]any{"email": "Someone <x@example.com>"}); status != fiber.StatusBadRequest {
+		t.Fatalf("add suppression with a display name status code = %d, want %d", status, fiber.StatusBadRequest)
+	}
+	status, body = do(http.MethodPost, "/suppressions", map[string]any{"email": "Complaint@Example.com", "reason": "complaint", "detail": "marked as spam"})
+	if status != fiber.StatusOK {
+		t.Fatalf("add suppression status code = %d, want %d", status, fiber.StatusOK)
+	}
+	added := body["updatedData"].(map[string]any)
+	if added["email"] != "complaint@example.com" || added["reason"] != "complaint" || added["createdBy"] != "admin-1" {
+		t.Fatalf("unexpected suppression: %v", added)
+	}
+	status, body = do(http.MethodGet, "/suppressions?q=complaint", nil)
+	if status != fiber.StatusOK || body["updatedData"].(map[string]any)["total"] != float64(1) {
+		t.Fatalf("list suppressions = %d %v, want one match", status, body)
+	}
+	if status, _ := do(http.MethodDelete, "/suppressions/complaint%40example.com", nil); status != fiber.StatusOK {
+		t.Fatalf("delete suppression status code = %d, want %d", status, fiber.StatusOK)
+	}
+	if status, _ := do(http.MethodDelete, "/suppressions/complaint%40example.com", nil); status != fiber.StatusNotFound {
+		t.Fatalf("second delete suppression status code = %d, want %d", status, fiber.StatusNotFound)
+	}
+}
@@ -0,0 +1,163 @@
+package adminmail
+
+import (
+	"strings"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformMailOutbox "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/mailoutbox"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type relayStatusReporter interface {
+	RelayStatuses() []harukiSMTP.RelayStatus
+}
+
+func parseAdminMailMessageFilter(c fiber.Ctx) (postgresql.EmailMessageFilter, error) {
+	filter := postgresql.EmailMessageFilter{
+		Recipient: strings.TrimSpace(c.Query("recipient")),
+		Template:  strings.TrimSpace(c.Query("template")),
+	}
+	for _, rawStatus := range strings.Split(c.Query("status"), ",") {
+		rawStatus = strings.TrimSpace(rawStatus)
+		if rawStatus == "" {
+			continue
+		}
+		status := emailmessage.Status(rawStatus)
+		if err := emailmessage.StatusValidator(status); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid status")
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+	return filter, nil
+}
+
+func handleGetAdminMailSummary(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		now := adminMailNowUTC()
+		summary, err := apiHelper.DBManager.DB.SummarizeEmailMessages(c.Context(), now)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSummary, adminMailMessageTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonQueryMessagesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to summarize mail outbox")
+		}
+		resp := adminMailSummaryResponse{
+			GeneratedAt: now,
+			Messages:    summary,
+			Relays:      []harukiSMTP.RelayStatus{},
+		}
+		if reporter, ok := apiHelper.SMTPClient.(relayStatusReporter); ok {
+			if relays := reporter.RelayStatuses(); relays != nil {
+				resp.Relays = relays
+			}
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSummary, adminMailMessageTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, nil)
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleListAdminMailMessages(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		filter, err := parseAdminMailMessageFilter(c)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageList, adminMailMessageTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidMessageFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid message filter")
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultAdminMailPage, defaultAdminMailPageSize, maxAdminMailPageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageList, adminMailMessageTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidMessageFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListEmailMessages(c.Context(), filter, (page-1)*pageSize, pageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageList, adminMailMessageTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonQueryMessagesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query mail messages")
+		}
+
+		items := make([]adminMailMessageItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminMailMessageItem(row))
+		}
+		resp := adminMailMessageListResponse{
+			GeneratedAt: adminMailNowUTC(),
+			Page:        page,
+			PageSize:    pageSize,
+			Total:       total,
+			TotalPages:  platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:     platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageList, adminMailMessageTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"total": total,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetAdminMailMessage(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		messageID, err := sanitizeMessageID(c.Params("message_id"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageGet, adminMailMessageTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidMessageID, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid message_id")
+		}
+
+		row, err := apiHelper.DBManager.DB.GetEmailMessageMetadata(c.Context(), messageID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageGet, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonMessageNotFound, nil))
+				return harukiAPIHelper.ErrorNotFound(c, "mail message not found")
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageGet, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonQueryMessagesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query mail message")
+		}
+
+		resp := buildAdminMailMessageItem(row)
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageGet, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultSuccess, nil)
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleRetryAdminMailMessage(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		messageID, err := sanitizeMessageID(c.Params("message_id"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageRetry, adminMailMessageTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidMessageID, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid message_id")
+		}
+
+		db := apiHelper.DBManager.DB
+		requeued, err := db.RequeueEmailMessage(c.Context(), messageID, platformMailOutbox.CurrentOptions().MaxAttempts, adminMailNowUTC())
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageRetry, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonRetryMessageFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to retry mail message")
+		}
+		row, err := db.GetEmailMessageMetadata(c.Context(), messageID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageRetry, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonMessageNotFound, nil))
+				return harukiAPIHelper.ErrorNotFound(c, "mail message not found")
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageRetry, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonQueryMessagesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query mail message")
+		}
+		if !requeued {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageRetry, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonMessageNotRetryable, map[string]any{
+				"status": string(row.Status),
+			}))
+			return harukiAPIHelper.ErrorBadRequest(c, "only failed or bounced mail can be retried")
+		}
+		platformMailOutbox.Notify()
+
+		resp := buildAdminMailMessageItem(row)
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionMessageRetry, adminMailMessageTargetType, messageID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"recipient": row.Recipient,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "mail message queued for retry", &resp)
+	}
+}
@@ -0,0 +1,48 @@
+package adminmail
+
+import (
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+)
+
+func buildAdminMailMessageItem(row *postgresql.EmailMessage) adminMailMessageItem {
+	item := adminMailMessageItem{
+		ID:          row.ID,
+		Recipient:   row.Recipient,
+		Subject:     row.Subject,
+		Template:    row.Template,
+		Locale:      row.Locale,
+		Status:      string(row.Status),
+		Attempts:    row.Attempts,
+		MaxAttempts: row.MaxAttempts,
+		Relay:       row.Relay,
+		LastError:   row.LastError,
+		CreatedAt:   row.CreatedAt.UTC(),
+		UpdatedAt:   row.UpdatedAt.UTC(),
+	}
+	if row.Status == emailmessage.StatusQueued {
+		nextAttemptAt := row.NextAttemptAt.UTC()
+		item.NextAttemptAt = &nextAttemptAt
+	}
+	if row.ExpiresAt != nil {
+		expiresAt := row.ExpiresAt.UTC()
+		item.ExpiresAt = &expiresAt
+	}
+	if row.SentAt != nil {
+		sentAt := row.SentAt.UTC()
+		item.SentAt = &sentAt
+	}
+	return item
+}
+
+func buildAdminMailSuppressionItem(row *postgresql.EmailSuppression) adminMailSuppressionItem {
+	return adminMailSuppressionItem{
+		Email:       row.Email,
+		Reason:      string(row.Reason),
+		Detail:      row.Detail,
+		BounceCount: row.BounceCount,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt.UTC(),
+		UpdatedAt:   row.UpdatedAt.UTC(),
+	}
+}
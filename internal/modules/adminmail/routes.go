@@ -0,0 +1,20 @@
+package adminmail
+
+import (
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+)
+
+func RegisterAdminMailRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	adminGroup := adminCoreModule.AdminRootGroup(apiHelper)
+	mail := adminGroup.Group("/mail", adminCoreModule.RequireAdmin(apiHelper))
+
+	mail.Get("/summary", handleGetAdminMailSummary(apiHelper))
+	mail.Get("/messages", handleListAdminMailMessages(apiHelper))
+	mail.Get("/messages/:message_id", handleGetAdminMailMessage(apiHelper))
+	mail.Get("/suppressions", handleListAdminMailSuppressions(apiHelper))
+
+	mail.Post("/messages/:message_id/retry", adminCoreModule.RequireSuperAdmin(apiHelper), handleRetryAdminMailMessage(apiHelper))
+	mail.Post("/suppressions", adminCoreModule.RequireSuperAdmin(apiHelper), handleAddAdminMailSuppression(apiHelper))
+	mail.Delete("/suppressions/:email", adminCoreModule.RequireSuperAdmin(apiHelper), handleDeleteAdminMailSuppression(apiHelper))
+}
@@ -0,0 +1,125 @@
+package adminmail
+
+import (
+	"net/url"
+	"strings"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformIdentity "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/identity"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func parseSuppressionReason(raw string, fallback emailsuppression.Reason) (emailsuppression.Reason, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return fallback, nil
+	}
+	reason := emailsuppression.Reason(raw)
+	if err := emailsuppression.ReasonValidator(reason); err != nil {
+		return "", fiber.NewError(fiber.StatusBadRequest, "invalid reason")
+	}
+	return reason, nil
+}
+
+func handleListAdminMailSuppressions(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		reason, err := parseSuppressionReason(c.Query("reason"), "")
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionList, adminMailSuppressionTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidSuppressionFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid suppression filter")
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultAdminMailPage, defaultAdminMailPageSize, maxAdminMailPageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionList, adminMailSuppressionTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidSuppressionFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListEmailSuppressions(c.Context(), c.Query("q"), reason, (page-1)*pageSize, pageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionList, adminMailSuppressionTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonQuerySuppressionsFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query mail suppressions")
+		}
+
+		items := make([]adminMailSuppressionItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminMailSuppressionItem(row))
+		}
+		resp := adminMailSuppressionListResponse{
+			GeneratedAt: adminMailNowUTC(),
+			Page:        page,
+			PageSize:    pageSize,
+			Total:       total,
+			TotalPages:  platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:     platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionList, adminMailSuppressionTargetType, adminMailTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"total": total,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleAddAdminMailSuppression(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var payload adminMailSuppressionPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionAdd, adminMailSuppressionTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidRequestPayload, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		email, ok := platformIdentity.ParseEmail(payload.Email)
+		if !ok {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionAdd, adminMailSuppressionTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidEmail, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid email")
+		}
+		reason, err := parseSuppressionReason(payload.Reason, emailsuppression.ReasonManual)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionAdd, adminMailSuppressionTargetType, email, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidRequestPayload, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid reason")
+		}
+		actorUserID, _, _ := adminCoreModule.CurrentAdminActor(c)
+
+		row, err := apiHelper.DBManager.DB.AddEmailSuppression(c.Context(), email, reason, payload.Detail, actorUserID, adminMailNowUTC())
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionAdd, adminMailSuppressionTargetType, email, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonUpdateSuppressionFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to add mail suppression")
+		}
+
+		resp := buildAdminMailSuppressionItem(row)
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionAdd, adminMailSuppressionTargetType, email, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"reason": string(reason),
+		})
+		return harukiAPIHelper.SuccessResponse(c, "mail suppression added", &resp)
+	}
+}
+
+func handleDeleteAdminMailSuppression(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		rawEmail, err := url.PathUnescape(c.Params("email"))
+		if err != nil {
+			rawEmail = ""
+		}
+		email, ok := platformIdentity.ParseEmail(rawEmail)
+		if !ok {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionDelete, adminMailSuppressionTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonInvalidEmail, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid email")
+		}
+
+		deleted, err := apiHelper.DBManager.DB.DeleteEmailSuppression(c.Context(), email)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionDelete, adminMailSuppressionTargetType, email, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonUpdateSuppressionFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to delete mail suppression")
+		}
+		if !deleted {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionDelete, adminMailSuppressionTargetType, email, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminMailFailureReasonSuppressionNotFound, nil))
+			return harukiAPIHelper.ErrorNotFound(c, "mail suppression not found")
+		}
+
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminMailActionSuppressionDelete, adminMailSuppressionTargetType, email, harukiAPIHelper.SystemLogResultSuccess, nil)
+		return harukiAPIHelper.SuccessResponse[string](c, "mail suppression deleted", nil)
+	}
+}
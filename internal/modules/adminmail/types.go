@@ -0,0 +1,71 @@
+package adminmail
+
+import (
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+type adminMailSummaryResponse struct {
+	GeneratedAt time.Time                      `json:"generatedAt"`
+	Messages    *postgresql.EmailOutboxSummary `json:"messages"`
+	// Relays is the relay health seen by the instance that answered.
+	Relays []harukiSMTP.RelayStatus `json:"relays"`
+}
+
+// adminMailMessageItem leaves out the body, which may hold verification
+// codes.
+type adminMailMessageItem struct {
+	ID            string     `json:"id"`
+	Recipient     string     `json:"recipient"`
+	Subject       string     `json:"subject"`
+	Template      string     `json:"template,omitempty"`
+	Locale        string     `json:"locale,omitempty"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	MaxAttempts   int        `json:"maxAttempts"`
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	Relay         *string    `json:"relay,omitempty"`
+	LastError     *string    `json:"lastError,omitempty"`
+	SentAt        *time.Time `json:"sentAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+type adminMailMessageListResponse struct {
+	GeneratedAt time.Time              `json:"generatedAt"`
+	Page        int                    `json:"page"`
+	PageSize    int                    `json:"pageSize"`
+	Total       int                    `json:"total"`
+	TotalPages  int                    `json:"totalPages"`
+	HasMore     bool                   `json:"hasMore"`
+	Items       []adminMailMessageItem `json:"items"`
+}
+
+type adminMailSuppressionItem struct {
+	Email       string    `json:"email"`
+	Reason      string    `json:"reason"`
+	Detail      *string   `json:"detail,omitempty"`
+	BounceCount int       `json:"bounceCount"`
+	CreatedBy   *string   `json:"createdBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type adminMailSuppressionListResponse struct {
+	GeneratedAt time.Time                  `json:"generatedAt"`
+	Page        int                        `json:"page"`
+	PageSize    int                        `json:"pageSize"`
+	Total       int                        `json:"total"`
+	TotalPages  int                        `json:"totalPages"`
+	HasMore     bool                       `json:"hasMore"`
+	Items       []adminMailSuppressionItem `json:"items"`
+}
+
+type adminMailSuppressionPayload struct {
+	Email  string `json:"email"`
+	Reason string `json:"reason"`
+	Detail string `json:"detail"`
+}
@@ -18,22 +18,28 @@ const (
 )
 
 const (
-	adminAuditActionOAuthClientList               = "admin.oauth_client.list"
-	adminAuditActionOAuthClientCreate             = "admin.oauth_client.create"
-	adminAuditActionOAuthClientActiveUpdate       = "admin.oauth_client.active.update"
-	adminAuditActionOAuthClientUpdate             = "admin.oauth_client.update"
-	adminAuditActionOAuthClientRotateSecret       = "admin.oauth_client.rotate_secret"
-	adminAuditActionOAuthClientDelete             = "admin.oauth_client.delete"
-	adminAuditActionOAuthClientStatisticsQuery    = "admin.oauth_client.statistics.query"
-	adminAuditActionOAuthClientAuthorizationsList = "admin.oauth_client.authorizations.list"
-	adminAuditActionOAuthClientRevoke             = "admin.oauth_client.revoke"
-	adminAuditActionOAuthClientRestore            = "admin.oauth_client.restore"
-	adminAuditActionOAuthClientAuditLogsQuery     = "admin.oauth_client.audit_logs.query"
-	adminAuditActionOAuthClientAuditSummaryQuery  = "admin.oauth_client.audit_summary.query"
-	adminAuditActionOAuthClientWebhookList        = "admin.oauth_client.webhook.list"
-	adminAuditActionOAuthClientWebhookCreate      = "admin.oauth_client.webhook.create"
-	adminAuditActionOAuthClientWebhookUpdate      = "admin.oauth_client.webhook.update"
-	adminAuditActionOAuthClientWebhookDelete      = "admin.oauth_client.webhook.delete"
+	adminAuditActionOAuthClientList                = "admin.oauth_client.list"
+	adminAuditActionOAuthClientCreate              = "admin.oauth_client.create"
+	adminAuditActionOAuthClientActiveUpdate        = "admin.oauth_client.active.update"
+	adminAuditActionOAuthClientUpdate              = "admin.oauth_client.update"
+	adminAuditActionOAuthClientRotateSecret        = "admin.oauth_client.rotate_secret"
+	adminAuditActionOAuthClientDelete              = "admin.oauth_client.delete"
+	adminAuditActionOAuthClientStatisticsQuery     = "admin.oauth_client.statistics.query"
+	adminAuditActionOAuthClientAuthorizationsList  = "admin.oauth_client.authorizations.list"
+	adminAuditActionOAuthClientRevoke              = "admin.oauth_client.revoke"
+	adminAuditActionOAuthClientRestore             = "admin.oauth_client.restore"
+	adminAuditActionOAuthClientAuditLogsQuery      = "admin.oauth_client.audit_logs.query"
+	adminAuditActionOAuthClientAuditSummaryQuery   = "admin.oauth_client.audit_summary.query"
+	adminAuditActionOAuthClientWebhookList         = "admin.oauth_client.webhook.list"
+	adminAuditActionOAuthClientWebhookCreate       = "admin.oauth_client.webhook.create"
+	adminAuditActionOAuthClientWebhookUpdate       = "admin.oauth_client.webhook.update"
+	adminAuditActionOAuthClientWebhookDelete       = "admin.oauth_client.webhook.delete"
+	adminAuditActionOAuthClientDeliveryList        = "admin.oauth_client.webhook.delivery.list"
+	adminAuditActionOAuthClientDeliveryReplay      = "admin.oauth_client.webhook.delivery.replay"
+	adminAuditActionOAuthClientDeliveryPurge       = "admin.oauth_client.webhook.delivery.purge"
+	adminAuditActionOAuthClientWebhookRotateSecret = "admin.oauth_client.webhook.signing_secret.rotate"
+	adminAuditActionOAuthClientAttemptList         = "admin.oauth_client.webhook.attempt.list"
+	adminAuditActionOAuthClientWebhookHealthQuery  = "admin.oauth_client.webhook.health.query"
 )
 
 const (
@@ -87,6 +93,19 @@ const (
 	adminFailureReasonWebhookNotFound                 = "webhook_not_found"
 	adminFailureReasonCreateWebhookFailed             = "create_webhook_failed"
 	adminFailureReasonDeleteWebhookFailed             = "delete_webhook_failed"
+	adminFailureReasonInvalidDeliveryFilter           = "invalid_delivery_filter"
+	adminFailureReasonDeliveryNotFound                = "delivery_not_found"
+	adminFailureReasonQueryDeliveriesFailed           = "query_deliveries_failed"
+	adminFailureReasonReplayDeliveryFailed            = "replay_delivery_failed"
+	adminFailureReasonDeliveryNotReplayable           = "delivery_not_replayable"
+	adminFailureReasonPurgeDeliveriesFailed           = "purge_deliveries_failed"
+	adminFailureReasonInvalidGracePeriod              = "invalid_grace_period"
+	adminFailureReasonGenerateSigningSecretFailed     = "generate_signing_secret_failed"
+	adminFailureReasonRotateSigningSecretFailed       = "rotate_signing_secret_failed"
+	adminFailureReasonInvalidOwnerEmail               = "invalid_owner_email"
+	adminFailureReasonInvalidAttemptFilter            = "invalid_attempt_filter"
+	adminFailureReasonQueryAttemptsFailed             = "query_attempts_failed"
+	adminFailureReasonQueryHealthFailed               = "query_health_failed"
 )
 
 func resolveUploadLogTimeRange(fromRaw, toRaw string, now time.Time) (time.Time, time.Time, error) {
@@ -0,0 +1,209 @@
+package adminoauth
+
+import (
+	"errors"
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	oauth2Module "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/oauth2"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	defaultAdminOAuthClientDeliveryPage     = 1
+	defaultAdminOAuthClientDeliveryPageSize = 50
+	maxAdminOAuthClientDeliveryPageSize     = 200
+)
+
+func parseAdminOAuthClientDeliveryFilter(c fiber.Ctx, clientID string) (postgresql.WebhookDeliveryFilter, error) {
+	filter := postgresql.WebhookDeliveryFilter{
+		EndpointType: webhookdelivery.EndpointTypeOauth2Client,
+		EndpointID:   strings.TrimSpace(c.Query("webhook_id")),
+		ClientID:     clientID,
+	}
+	for _, rawStatus := range strings.Split(c.Query("status"), ",") {
+		rawStatus = strings.TrimSpace(rawStatus)
+		if rawStatus == "" {
+			continue
+		}
+		status := webhookdelivery.Status(rawStatus)
+		if err := webhookdelivery.StatusValidator(status); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid status")
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+	before, err := platformTime.ParseFlexibleTime(c.Query("before"))
+	if err != nil {
+		return filter, err
+	}
+	filter.Before = before
+	return filter, nil
+}
+
+func buildAdminOAuthClientWebhookDeliveryItem(row *postgresql.WebhookDelivery) adminOAuthClientWebhookDeliveryItem {
+	item := adminOAuthClientWebhookDeliveryItem{
+		ID:             row.ID,
+		WebhookID:      row.EndpointID,
+		CallbackURL:    row.CallbackURL,
+		GameUserID:     row.GameUserID,
+		Server:         row.Server,
+		DataType:       row.DataType,
+		Status:         string(row.Status),
+		Attempts:       row.Attempts,
+		MaxAttempts:    row.MaxAttempts,
+		LastStatusCode: row.LastStatusCode,
+		LastError:      row.LastError,
+		CreatedAt:      row.CreatedAt.UTC(),
+		UpdatedAt:      row.UpdatedAt.UTC(),
+	}
+	if row.Status == webhookdelivery.StatusPending {
+		nextAttemptAt := row.NextAttemptAt.UTC()
+		item.NextAttemptAt = &nextAttemptAt
+	}
+	if row.DeliveredAt != nil {
+		deliveredAt := row.DeliveredAt.UTC()
+		item.DeliveredAt = &deliveredAt
+	}
+	return item
+}
+
+// ensureHydraOAuthClientForDeliveries checks the client exists and writes the
+// failure response and audit entry when it does not. A nil error with ok=false
+// means the response has already been sent.
+func ensureHydraOAuthClientForDeliveries(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, action string) (string, bool, error) {
+	clientID := strings.TrimSpace(c.Params("client_id"))
+	if clientID == "" {
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, action, adminAuditTargetTypeOAuthClient, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonMissingClientID, nil))
+		return "", false, harukiAPIHelper.ErrorBadRequest(c, "client_id is required")
+	}
+	if _, err := oauth2Module.GetHydraOAuthClient(c.Context(), clientID); err != nil {
+		if oauth2Module.IsHydraNotFoundError(err) {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, action, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonClientNotFound, map[string]any{"hydraMode": true}))
+			return clientID, false, harukiAPIHelper.ErrorNotFound(c, "oauth client not found")
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, action, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonQueryClientFailed, map[string]any{"hydraMode": true}))
+		return clientID, false, harukiAPIHelper.ErrorInternal(c, "failed to query oauth client")
+	}
+	return clientID, true, nil
+}
+
+func handleListHydraOAuthClientWebhookDeliveries(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		clientID, ok, err := ensureHydraOAuthClientForDeliveries(c, apiHelper, adminAuditActionOAuthClientDeliveryList)
+		if !ok {
+			return err
+		}
+
+		filter, err := parseAdminOAuthClientDeliveryFilter(c, clientID)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidDeliveryFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid delivery filter")
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultAdminOAuthClientDeliveryPage, defaultAdminOAuthClientDeliveryPageSize, maxAdminOAuthClientDeliveryPageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidDeliveryFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListWebhookDeliveries(c.Context(), filter, (page-1)*pageSize, pageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonQueryDeliveriesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query oauth client webhook deliveries")
+		}
+
+		items := make([]adminOAuthClientWebhookDeliveryItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminOAuthClientWebhookDeliveryItem(row))
+		}
+		resp := adminOAuthClientWebhookDeliveryListResponse{
+			GeneratedAt: adminNowUTC(),
+			ClientID:    clientID,
+			Page:        page,
+			PageSize:    pageSize,
+			Total:       total,
+			TotalPages:  platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:     platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"total": total})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleReplayHydraOAuthClientWebhookDelivery(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		clientID, ok, err := ensureHydraOAuthClientForDeliveries(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay)
+		if !ok {
+			return err
+		}
+		deliveryID := strings.TrimSpace(c.Params("delivery_id"))
+		if deliveryID == "" {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonDeliveryNotFound, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, "delivery_id is required")
+		}
+
+		// Scope the lookup to the client so one client's deliveries cannot be
+		// replayed through another client's route.
+		exists, err := apiHelper.DBManager.DB.WebhookDelivery.Query().
+			Where(
+				webhookdelivery.IDEQ(deliveryID),
+				webhookdelivery.EndpointTypeEQ(webhookdelivery.EndpointTypeOauth2Client),
+				webhookdelivery.ClientIDEQ(clientID),
+			).
+			Exist(c.Context())
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonQueryDeliveriesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query oauth client webhook delivery")
+		}
+		if !exists {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonDeliveryNotFound, nil))
+			return harukiAPIHelper.ErrorNotFound(c, "oauth client webhook delivery not found")
+		}
+
+		row, err := apiHelper.DBManager.DB.ReplayWebhookDelivery(c.Context(), deliveryID, adminNowUTC())
+		if err != nil {
+			if errors.Is(err, postgresql.ErrWebhookDeliveryNotReplayable) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonDeliveryNotReplayable, nil))
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, err.Error(), nil)
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonReplayDeliveryFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to replay oauth client webhook delivery")
+		}
+		harukiHandler.NotifyWebhookDeliveryWorker()
+
+		resp := buildAdminOAuthClientWebhookDeliveryItem(row)
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryReplay, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"deliveryID": deliveryID, "webhookID": row.EndpointID})
+		return harukiAPIHelper.SuccessResponse(c, "oauth client webhook delivery queued for replay", &resp)
+	}
+}
+
+func handlePurgeHydraOAuthClientWebhookDeliveries(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		clientID, ok, err := ensureHydraOAuthClientForDeliveries(c, apiHelper, adminAuditActionOAuthClientDeliveryPurge)
+		if !ok {
+			return err
+		}
+
+		filter, err := parseAdminOAuthClientDeliveryFilter(c, clientID)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryPurge, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidDeliveryFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid delivery filter")
+		}
+
+		deleted, err := apiHelper.DBManager.DB.PurgeWebhookDeliveries(c.Context(), filter)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryPurge, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonPurgeDeliveriesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to purge oauth client webhook deliveries")
+		}
+
+		resp := adminOAuthClientWebhookDeliveryPurgeResponse{ClientID: clientID, Deleted: deleted}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientDeliveryPurge, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"deleted": deleted, "webhookID": filter.EndpointID})
+		return harukiAPIHelper.SuccessResponse(c, "oauth client webhook deliveries purged", &resp)
+	}
+}
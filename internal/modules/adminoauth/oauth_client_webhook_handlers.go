@@ -6,7 +6,9 @@ import (
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
+	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
 	"strings"
+	"time"
 
 	sql "entgo.io/ent/dialect/sql"
 	"github.com/gofiber/fiber/v3"
@@ -86,10 +88,17 @@ func handleCreateHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 			return harukiAPIHelper.ErrorInternal(c, "failed to create oauth client webhook")
 		}
 
+		signingSecret, err := harukiHandler.GenerateWebhookSigningSecret()
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookCreate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonGenerateSigningSecretFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to create oauth client webhook")
+		}
+
 		create := apiHelper.DBManager.DB.OAuth2ClientWebhookEndpoint.Create().
 			SetID(webhookID).
 			SetClientID(clientID).
-			SetCallbackURL(callbackURL)
+			SetCallbackURL(callbackURL).
+			SetSigningSecret(signingSecret)
 		if payload.Enabled == nil {
 			create.SetEnabled(true)
 		} else {
@@ -98,6 +107,12 @@ func handleCreateHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 		if bearer := sanitizeAdminOAuthWebhookBearer(payload.Bearer); bearer != nil {
 			create.SetBearer(*bearer)
 		}
+		ownerEmail, err := sanitizeAdminOAuthWebhookOwnerEmail(payload.OwnerEmail)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookCreate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidOwnerEmail, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid ownerEmail")
+		}
+		create.SetNillableOwnerEmail(ownerEmail)
 
 		created, err := create.Save(c.Context())
 		if err != nil {
@@ -110,6 +125,7 @@ func handleCreateHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 		}
 
 		resp := buildAdminOAuthClientWebhookMutationResponse(created)
+		resp.SigningSecret = signingSecret
 		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookCreate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"webhookID": created.ID, "enabled": created.Enabled, "bearerSet": resp.Webhook.BearerSet})
 		return harukiAPIHelper.SuccessResponse(c, "oauth client webhook created", &resp)
 	}
@@ -155,6 +171,11 @@ func handleUpdateHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 		}
 		if payload.Enabled != nil {
 			update.SetEnabled(*payload.Enabled)
+			if *payload.Enabled {
+				// Re-enabling starts a fresh failure streak; otherwise the
+				// next failed attempt would switch the endpoint off again.
+				update.SetConsecutiveFailures(0).ClearFailingSince().ClearAutoDisabledAt()
+			}
 			changed = true
 		}
 		if payload.ClearBearer {
@@ -168,6 +189,19 @@ func handleUpdateHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 			}
 			changed = true
 		}
+		if payload.OwnerEmail != nil {
+			ownerEmail, err := sanitizeAdminOAuthWebhookOwnerEmail(payload.OwnerEmail)
+			if err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookUpdate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidOwnerEmail, nil))
+				return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid ownerEmail")
+			}
+			if ownerEmail != nil {
+				update.SetOwnerEmail(*ownerEmail)
+			} else {
+				update.ClearOwnerEmail()
+			}
+			changed = true
+		}
 		if !changed {
 			resp := buildAdminOAuthClientWebhookMutationResponse(current)
 			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookUpdate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"webhookID": webhookID, "noChange": true})
@@ -185,7 +219,7 @@ func handleUpdateHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 		}
 
 		resp := buildAdminOAuthClientWebhookMutationResponse(updated)
-		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookUpdate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"webhookID": webhookID, "enabled": updated.Enabled, "bearerSet": resp.Webhook.BearerSet})
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookUpdate, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"webhookID": webhookID, "enabled": updated.Enabled, "bearerSet": resp.Webhook.BearerSet, "updatedOwnerEmail": payload.OwnerEmail != nil})
 		return harukiAPIHelper.SuccessResponse(c, "oauth client webhook updated", &resp)
 	}
 }
@@ -215,3 +249,62 @@ func handleDeleteHydraOAuthClientWebhook(apiHelper *harukiAPIHelper.HarukiToolbo
 		return harukiAPIHelper.SuccessResponse[string](c, "oauth client webhook deleted", nil)
 	}
 }
+
+func handleRotateHydraOAuthClientWebhookSigningSecret(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		clientID := strings.TrimSpace(c.Params("client_id"))
+		webhookID := strings.TrimSpace(c.Params("webhook_id"))
+		if clientID == "" || webhookID == "" {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonMissingClientID, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, "client_id and webhook_id are required")
+		}
+
+		var payload adminOAuthClientWebhookRotateSecretPayload
+		if len(c.Body()) > 0 {
+			if err := c.Bind().Body(&payload); err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidRequestPayload, nil))
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+			}
+		}
+		gracePeriod, err := harukiHandler.ResolveWebhookSigningSecretGracePeriod(payload.GracePeriodSeconds)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidGracePeriod, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, err.Error())
+		}
+		signingSecret, err := harukiHandler.GenerateWebhookSigningSecret()
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonGenerateSigningSecretFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to rotate oauth client webhook signing secret")
+		}
+
+		var graceUntil *time.Time
+		if gracePeriod > 0 {
+			until := adminNowUTC().Add(gracePeriod)
+			graceUntil = &until
+		}
+		updated, err := apiHelper.DBManager.DB.RotateOAuth2ClientWebhookSigningSecret(c.Context(), clientID, webhookID, signingSecret, graceUntil)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonWebhookNotFound, nil))
+				return harukiAPIHelper.ErrorNotFound(c, "oauth client webhook not found")
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonRotateSigningSecretFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to rotate oauth client webhook signing secret")
+		}
+
+		resp := adminOAuthClientWebhookRotateSecretResponse{
+			GeneratedAt:                    adminNowUTC(),
+			ClientID:                       clientID,
+			WebhookID:                      updated.ID,
+			SigningSecret:                  signingSecret,
+			SignatureHeaderName:            harukiHandler.WebhookSignatureHeader,
+			PreviousSigningSecretExpiresAt: updated.PreviousSigningSecretExpiresAt,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookRotateSecret, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"webhookID":          webhookID,
+			"gracePeriodSeconds": int(gracePeriod / time.Second),
+			"previousRetained":   updated.PreviousSigningSecretExpiresAt != nil,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "oauth client webhook signing secret rotated", &resp)
+	}
+}
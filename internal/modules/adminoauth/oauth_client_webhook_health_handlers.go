@@ -0,0 +1,198 @@
+package adminoauth
+
+import (
+	"strings"
+	"time"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+
+	sql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	defaultAdminOAuthClientWebhookHealthHours   = 24
+	maxAdminOAuthClientWebhookHealthHours       = 24 * 30
+	adminOAuthClientWebhookHealthRecentFailures = 5
+)
+
+func parseAdminOAuthClientAttemptFilter(c fiber.Ctx, clientID string) (postgresql.WebhookDeliveryAttemptFilter, error) {
+	filter := postgresql.WebhookDeliveryAttemptFilter{
+		EndpointType: webhookdeliveryattempt.EndpointTypeOauth2Client,
+		EndpointID:   strings.TrimSpace(c.Query("webhook_id")),
+		ClientID:     clientID,
+		DeliveryID:   strings.TrimSpace(c.Query("delivery_id")),
+		EventID:      strings.TrimSpace(c.Query("event_id")),
+	}
+	success, err := adminCoreModule.ParseOptionalBoolField(c.Query("success"), "success")
+	if err != nil {
+		return filter, err
+	}
+	filter.Success = success
+	from, err := platformTime.ParseFlexibleTime(c.Query("from"))
+	if err != nil {
+		return filter, err
+	}
+	to, err := platformTime.ParseFlexibleTime(c.Query("to"))
+	if err != nil {
+		return filter, err
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return filter, fiber.NewError(fiber.StatusBadRequest, "from must be before to")
+	}
+	filter.From, filter.To = from, to
+	return filter, nil
+}
+
+func parseAdminOAuthClientWebhookHealthHours(raw string) (int, error) {
+	hours, err := platformPagination.ParsePositiveInt(raw, defaultAdminOAuthClientWebhookHealthHours, "hours")
+	if err != nil {
+		return 0, err
+	}
+	if hours > maxAdminOAuthClientWebhookHealthHours {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "hours exceeds max range")
+	}
+	return hours, nil
+}
+
+func buildAdminOAuthClientWebhookAttemptItem(row *postgresql.WebhookDeliveryAttempt) adminOAuthClientWebhookAttemptItem {
+	return adminOAuthClientWebhookAttemptItem{
+		ID:         row.ID,
+		WebhookID:  row.EndpointID,
+		DeliveryID: row.DeliveryID,
+		EventID:    row.EventID,
+		Attempt:    row.Attempt,
+		Success:    row.Success,
+		StatusCode: row.StatusCode,
+		LatencyMs:  row.LatencyMs,
+		Error:      row.Error,
+		CreatedAt:  row.CreatedAt.UTC(),
+	}
+}
+
+func buildAdminOAuthClientWebhookHealthItem(row *postgresql.OAuth2ClientWebhookEndpoint, stats *postgresql.WebhookEndpointHealthStats) adminOAuthClientWebhookHealthItem {
+	item := adminOAuthClientWebhookHealthItem{
+		WebhookID:           row.ID,
+		CallbackURL:         row.CallbackURL,
+		Enabled:             row.Enabled,
+		ConsecutiveFailures: row.ConsecutiveFailures,
+		FailingSince:        adminOAuthUTCTimePtr(row.FailingSince),
+		AutoDisabledAt:      adminOAuthUTCTimePtr(row.AutoDisabledAt),
+		RecentFailures:      []adminOAuthClientWebhookAttemptItem{},
+	}
+	if stats == nil {
+		return item
+	}
+	item.TotalAttempts = stats.TotalAttempts
+	item.SuccessfulAttempts = stats.SuccessfulAttempts
+	item.FailedAttempts = stats.FailedAttempts
+	item.SuccessRate = stats.SuccessRate
+	item.P95LatencyMs = stats.P95LatencyMs
+	item.LastSuccessAt = stats.LastSuccessAt
+	for _, failure := range stats.RecentFailures {
+		item.RecentFailures = append(item.RecentFailures, buildAdminOAuthClientWebhookAttemptItem(failure))
+	}
+	return item
+}
+
+func handleListHydraOAuthClientWebhookAttempts(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		clientID, ok, err := ensureHydraOAuthClientForDeliveries(c, apiHelper, adminAuditActionOAuthClientAttemptList)
+		if !ok {
+			return err
+		}
+
+		filter, err := parseAdminOAuthClientAttemptFilter(c, clientID)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientAttemptList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidAttemptFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid attempt filter")
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultAdminOAuthClientDeliveryPage, defaultAdminOAuthClientDeliveryPageSize, maxAdminOAuthClientDeliveryPageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientAttemptList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidAttemptFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListWebhookDeliveryAttempts(c.Context(), filter, (page-1)*pageSize, pageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientAttemptList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonQueryAttemptsFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query oauth client webhook attempts")
+		}
+
+		items := make([]adminOAuthClientWebhookAttemptItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminOAuthClientWebhookAttemptItem(row))
+		}
+		resp := adminOAuthClientWebhookAttemptListResponse{
+			GeneratedAt: adminNowUTC(),
+			ClientID:    clientID,
+			Page:        page,
+			PageSize:    pageSize,
+			Total:       total,
+			TotalPages:  platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:     platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientAttemptList, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"total": total})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetHydraOAuthClientWebhookHealth(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		clientID, ok, err := ensureHydraOAuthClientForDeliveries(c, apiHelper, adminAuditActionOAuthClientWebhookHealthQuery)
+		if !ok {
+			return err
+		}
+
+		windowHours, err := parseAdminOAuthClientWebhookHealthHours(c.Query("hours"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookHealthQuery, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonInvalidHours, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid hours")
+		}
+
+		query := apiHelper.DBManager.DB.OAuth2ClientWebhookEndpoint.Query().
+			Where(oauth2clientwebhookendpoint.ClientIDEQ(clientID)).
+			Order(oauth2clientwebhookendpoint.ByCreatedAt(sql.OrderDesc()), oauth2clientwebhookendpoint.ByID(sql.OrderAsc()))
+		if webhookID := strings.TrimSpace(c.Query("webhook_id")); webhookID != "" {
+			query = query.Where(oauth2clientwebhookendpoint.IDEQ(webhookID))
+		}
+		rows, err := query.All(c.Context())
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookHealthQuery, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonQueryWebhooksFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query oauth client webhooks")
+		}
+		webhookIDs := make([]string, 0, len(rows))
+		for _, row := range rows {
+			webhookIDs = append(webhookIDs, row.ID)
+		}
+
+		now := adminNowUTC()
+		since := now.Add(-time.Duration(windowHours) * time.Hour)
+		stats, err := apiHelper.DBManager.DB.GetWebhookEndpointHealthStats(c.Context(), webhookdeliveryattempt.EndpointTypeOauth2Client, webhookIDs, since, adminOAuthClientWebhookHealthRecentFailures)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookHealthQuery, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminFailureReasonQueryHealthFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query oauth client webhook health")
+		}
+
+		items := make([]adminOAuthClientWebhookHealthItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminOAuthClientWebhookHealthItem(row, stats[row.ID]))
+		}
+		resp := adminOAuthClientWebhookHealthResponse{
+			GeneratedAt: now,
+			ClientID:    clientID,
+			WindowHours: windowHours,
+			Total:       len(items),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionOAuthClientWebhookHealthQuery, adminAuditTargetTypeOAuthClient, clientID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{"total": resp.Total, "windowHours": windowHours})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
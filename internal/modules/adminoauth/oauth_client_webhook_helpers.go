@@ -4,7 +4,9 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"strings"
+	"time"
 
+	platformIdentity "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/identity"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
 
@@ -38,6 +40,27 @@ func sanitizeAdminOAuthWebhookBearer(raw *string) *string {
 	return &trimmed
 }
 
+// sanitizeAdminOAuthWebhookOwnerEmail returns nil for an absent or blank
+// address, which callers treat as "no owner".
+func sanitizeAdminOAuthWebhookOwnerEmail(raw *string) (*string, error) {
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return nil, nil
+	}
+	email, ok := platformIdentity.ParseEmail(*raw)
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid ownerEmail")
+	}
+	return &email, nil
+}
+
+func adminOAuthUTCTimePtr(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+	utc := value.UTC()
+	return &utc
+}
+
 func buildAdminOAuthClientWebhookItem(row *postgresql.OAuth2ClientWebhookEndpoint) adminOAuthClientWebhookItem {
 	item := adminOAuthClientWebhookItem{}
 	if row == nil {
@@ -50,6 +73,18 @@ func buildAdminOAuthClientWebhookItem(row *postgresql.OAuth2ClientWebhookEndpoin
 	item.CreatedAt = row.CreatedAt.UTC()
 	item.UpdatedAt = row.UpdatedAt.UTC()
 	item.BearerSet = row.Bearer != nil && strings.TrimSpace(*row.Bearer) != ""
+	item.SigningSecretSet = row.SigningSecret != nil && *row.SigningSecret != ""
+	if row.PreviousSigningSecretExpiresAt != nil && row.PreviousSigningSecretExpiresAt.After(adminNowUTC()) {
+		expiresAt := row.PreviousSigningSecretExpiresAt.UTC()
+		item.PreviousSigningSecretExpiresAt = &expiresAt
+	}
+	if row.OwnerEmail != nil && strings.TrimSpace(*row.OwnerEmail) != "" {
+		ownerEmail := strings.TrimSpace(*row.OwnerEmail)
+		item.OwnerEmail = &ownerEmail
+	}
+	item.ConsecutiveFailures = row.ConsecutiveFailures
+	item.FailingSince = adminOAuthUTCTimePtr(row.FailingSince)
+	item.AutoDisabledAt = adminOAuthUTCTimePtr(row.AutoDisabledAt)
 	return item
 }
 
@@ -7,16 +7,23 @@ type adminOAuthClientWebhookPayload struct {
 	Bearer      *string `json:"bearer,omitempty"`
 	Enabled     *bool   `json:"enabled,omitempty"`
 	ClearBearer bool    `json:"clearBearer,omitempty"`
+	OwnerEmail  *string `json:"ownerEmail,omitempty"`
 }
 
 type adminOAuthClientWebhookItem struct {
-	ID          string    `json:"id"`
-	ClientID    string    `json:"clientId"`
-	CallbackURL string    `json:"callbackUrl"`
-	BearerSet   bool      `json:"bearerSet"`
-	Enabled     bool      `json:"enabled"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID                             string     `json:"id"`
+	ClientID                       string     `json:"clientId"`
+	CallbackURL                    string     `json:"callbackUrl"`
+	BearerSet                      bool       `json:"bearerSet"`
+	Enabled                        bool       `json:"enabled"`
+	SigningSecretSet               bool       `json:"signingSecretSet"`
+	PreviousSigningSecretExpiresAt *time.Time `json:"previousSigningSecretExpiresAt,omitempty"`
+	OwnerEmail                     *string    `json:"ownerEmail,omitempty"`
+	ConsecutiveFailures            int        `json:"consecutiveFailures"`
+	FailingSince                   *time.Time `json:"failingSince,omitempty"`
+	AutoDisabledAt                 *time.Time `json:"autoDisabledAt,omitempty"`
+	CreatedAt                      time.Time  `json:"createdAt"`
+	UpdatedAt                      time.Time  `json:"updatedAt"`
 }
 
 type adminOAuthClientWebhookListResponse struct {
@@ -27,7 +34,103 @@ type adminOAuthClientWebhookListResponse struct {
 }
 
 type adminOAuthClientWebhookMutationResponse struct {
-	GeneratedAt time.Time                   `json:"generatedAt"`
-	ClientID    string                      `json:"clientId"`
-	Webhook     adminOAuthClientWebhookItem `json:"webhook"`
+	GeneratedAt   time.Time                   `json:"generatedAt"`
+	ClientID      string                      `json:"clientId"`
+	Webhook       adminOAuthClientWebhookItem `json:"webhook"`
+	SigningSecret string                      `json:"signingSecret,omitempty"`
+}
+
+type adminOAuthClientWebhookRotateSecretPayload struct {
+	GracePeriodSeconds *int `json:"gracePeriodSeconds,omitempty"`
+}
+
+type adminOAuthClientWebhookRotateSecretResponse struct {
+	GeneratedAt                    time.Time  `json:"generatedAt"`
+	ClientID                       string     `json:"clientId"`
+	WebhookID                      string     `json:"webhookId"`
+	SigningSecret                  string     `json:"signingSecret"`
+	SignatureHeaderName            string     `json:"signatureHeaderName"`
+	PreviousSigningSecretExpiresAt *time.Time `json:"previousSigningSecretExpiresAt,omitempty"`
+}
+
+type adminOAuthClientWebhookDeliveryItem struct {
+	ID             string     `json:"id"`
+	WebhookID      string     `json:"webhookId"`
+	CallbackURL    string     `json:"callbackUrl"`
+	GameUserID     string     `json:"gameUserId"`
+	Server         string     `json:"server"`
+	DataType       string     `json:"dataType"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	MaxAttempts    int        `json:"maxAttempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int       `json:"lastStatusCode,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+type adminOAuthClientWebhookDeliveryListResponse struct {
+	GeneratedAt time.Time                             `json:"generatedAt"`
+	ClientID    string                                `json:"clientId"`
+	Page        int                                   `json:"page"`
+	PageSize    int                                   `json:"pageSize"`
+	Total       int                                   `json:"total"`
+	TotalPages  int                                   `json:"totalPages"`
+	HasMore     bool                                  `json:"hasMore"`
+	Items       []adminOAuthClientWebhookDeliveryItem `json:"items"`
+}
+
+type adminOAuthClientWebhookDeliveryPurgeResponse struct {
+	ClientID string `json:"clientId"`
+	Deleted  int    `json:"deleted"`
+}
+
+type adminOAuthClientWebhookAttemptItem struct {
+	ID         int       `json:"id"`
+	WebhookID  string    `json:"webhookId"`
+	DeliveryID string    `json:"deliveryId,omitempty"`
+	EventID    string    `json:"eventId,omitempty"`
+	Attempt    int       `json:"attempt"`
+	Success    bool      `json:"success"`
+	StatusCode *int      `json:"statusCode,omitempty"`
+	LatencyMs  int64     `json:"latencyMs"`
+	Error      *string   `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type adminOAuthClientWebhookAttemptListResponse struct {
+	GeneratedAt time.Time                            `json:"generatedAt"`
+	ClientID    string                               `json:"clientId"`
+	Page        int                                  `json:"page"`
+	PageSize    int                                  `json:"pageSize"`
+	Total       int                                  `json:"total"`
+	TotalPages  int                                  `json:"totalPages"`
+	HasMore     bool                                 `json:"hasMore"`
+	Items       []adminOAuthClientWebhookAttemptItem `json:"items"`
+}
+
+type adminOAuthClientWebhookHealthItem struct {
+	WebhookID           string                               `json:"webhookId"`
+	CallbackURL         string                               `json:"callbackUrl"`
+	Enabled             bool                                 `json:"enabled"`
+	ConsecutiveFailures int                                  `json:"consecutiveFailures"`
+	FailingSince        *time.Time                           `json:"failingSince,omitempty"`
+	AutoDisabledAt      *time.Time                           `json:"autoDisabledAt,omitempty"`
+	TotalAttempts       int                                  `json:"totalAttempts"`
+	SuccessfulAttempts  int                                  `json:"successfulAttempts"`
+	FailedAttempts      int                                  `json:"failedAttempts"`
+	SuccessRate         *float64                             `json:"successRate,omitempty"`
+	P95LatencyMs        *int64                               `json:"p95LatencyMs,omitempty"`
+	LastSuccessAt       *time.Time                           `json:"lastSuccessAt,omitempty"`
+	RecentFailures      []adminOAuthClientWebhookAttemptItem `json:"recentFailures"`
+}
+
+type adminOAuthClientWebhookHealthResponse struct {
+	GeneratedAt time.Time                           `json:"generatedAt"`
+	ClientID    string                              `json:"clientId"`
+	WindowHours int                                 `json:"windowHours"`
+	Total       int                                 `json:"total"`
+	Items       []adminOAuthClientWebhookHealthItem `json:"items"`
 }
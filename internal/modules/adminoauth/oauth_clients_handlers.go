@@ -17,7 +17,13 @@ func RegisterAdminOAuthClientRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRout
 		oauthClients.Get("/:client_id/audit-logs", handleListHydraOAuthClientAuditLogs(apiHelper))
 		oauthClients.Get("/:client_id/audit-summary", handleGetHydraOAuthClientAuditSummary(apiHelper))
 		oauthClients.Get("/:client_id/webhooks", handleListHydraOAuthClientWebhooks(apiHelper))
+		oauthClients.Get("/:client_id/webhooks/deliveries", handleListHydraOAuthClientWebhookDeliveries(apiHelper))
+		oauthClients.Get("/:client_id/webhooks/attempts", handleListHydraOAuthClientWebhookAttempts(apiHelper))
+		oauthClients.Get("/:client_id/webhooks/health", handleGetHydraOAuthClientWebhookHealth(apiHelper))
 		oauthClients.Post("/:client_id/webhooks", adminCoreModule.RequireSuperAdmin(apiHelper), handleCreateHydraOAuthClientWebhook(apiHelper))
+		oauthClients.Post("/:client_id/webhooks/deliveries/:delivery_id/replay", adminCoreModule.RequireSuperAdmin(apiHelper), handleReplayHydraOAuthClientWebhookDelivery(apiHelper))
+		oauthClients.Delete("/:client_id/webhooks/deliveries", adminCoreModule.RequireSuperAdmin(apiHelper), handlePurgeHydraOAuthClientWebhookDeliveries(apiHelper))
+		oauthClients.Post("/:client_id/webhooks/:webhook_id/signing-secret/rotate", adminCoreModule.RequireSuperAdmin(apiHelper), handleRotateHydraOAuthClientWebhookSigningSecret(apiHelper))
 		oauthClients.Put("/:client_id/webhooks/:webhook_id", adminCoreModule.RequireSuperAdmin(apiHelper), handleUpdateHydraOAuthClientWebhook(apiHelper))
 		oauthClients.Delete("/:client_id/webhooks/:webhook_id", adminCoreModule.RequireSuperAdmin(apiHelper), handleDeleteHydraOAuthClientWebhook(apiHelper))
 		oauthClients.Post("/:client_id/revoke", adminCoreModule.RequireSuperAdmin(apiHelper), handleRevokeHydraOAuthClient(apiHelper))
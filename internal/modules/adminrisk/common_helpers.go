@@ -9,6 +9,7 @@ const (
 	adminAuditActionRiskEventCreate  = "admin.risk.event.create"
 	adminAuditActionRiskEventResolve = "admin.risk.event.resolve"
 	adminAuditActionRiskRulesUpsert  = "admin.risk.rules.upsert"
+	adminAuditActionRiskRuleBacktest = "admin.risk.rules.backtest"
 	adminAuditTargetTypeRiskEvent    = "risk_event"
 	adminAuditTargetTypeRiskRule     = "risk_rule"
 )
@@ -21,6 +22,9 @@ const (
 const (
 	defaultRiskEventWindowHours = 24
 	maxRiskEventRangeHours      = 24 * 366 // up to ~1 year (leap-safe); coarse buckets keep point count sane
+
+	defaultRiskRuleBacktestWindowHours = 24 * 7
+	maxRiskRuleBacktestRangeHours      = 24 * 31
 )
 
 func resolveRiskEventTimeRange(fromRaw, toRaw string, now time.Time) (time.Time, time.Time, error) {
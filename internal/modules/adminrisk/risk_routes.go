@@ -17,4 +17,5 @@ func RegisterAdminRiskRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpe
 	rules := risk.Group("/rules")
 	rules.Get("", handleListRiskRules(apiHelper))
 	rules.Put("", adminCoreModule.RequireSuperAdmin(apiHelper), handleUpsertRiskRules(apiHelper))
+	rules.Post("/:key/backtest", adminCoreModule.RequireSuperAdmin(apiHelper), handleBacktestRiskRule(apiHelper))
 }
@@ -0,0 +1,75 @@
+package adminrisk
+
+import (
+	"errors"
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	riskEngineModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/riskengine"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskrule"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// handleBacktestRiskRule replays a proposed or stored rule config over past
+// logs. It only reads, so a threshold can be checked before it is saved.
+func handleBacktestRiskRule(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		key := strings.TrimSpace(c.Params("key"))
+		if key == "" {
+			return harukiAPIHelper.ErrorBadRequest(c, "rule key is required")
+		}
+
+		var payload riskRuleBacktestPayload
+		if len(c.Body()) > 0 {
+			if err := c.Bind().Body(&payload); err != nil {
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+			}
+		}
+		from, to, err := platformTime.ResolveTimeRange(
+			payload.From,
+			payload.To,
+			adminNow(),
+			defaultRiskRuleBacktestWindowHours*time.Hour,
+			maxRiskRuleBacktestRangeHours*time.Hour,
+		)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid time range")
+		}
+
+		config := payload.Config
+		if config == nil {
+			row, err := apiHelper.DBManager.DB.RiskRule.Query().
+				Where(riskrule.RuleKeyEQ(key)).
+				Only(c.Context())
+			if err != nil {
+				if postgresql.IsNotFound(err) {
+					return harukiAPIHelper.ErrorNotFound(c, "risk rule not found")
+				}
+				return harukiAPIHelper.ErrorInternal(c, "failed to query risk rule")
+			}
+			config = row.Config
+		}
+
+		report, err := riskEngineModule.Backtest(c.Context(), apiHelper.DBManager.DB, key, config, from, to, riskEngineModule.DefaultBacktestMaxSignals)
+		if err != nil {
+			if errors.Is(err, riskEngineModule.ErrInvalidRuleConfig) {
+				return harukiAPIHelper.ErrorBadRequest(c, err.Error())
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to backtest risk rule")
+		}
+
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionRiskRuleBacktest, adminAuditTargetTypeRiskRule, key, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"proposed":       payload.Config != nil,
+			"from":           from.UTC(),
+			"to":             to.UTC(),
+			"signalsScanned": report.SignalsScanned,
+			"triggerCount":   report.TriggerCount,
+		})
+		resp := riskRuleBacktestResponse{GeneratedAt: adminNowUTC(), BacktestReport: report}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
@@ -2,6 +2,7 @@ package adminrisk
 
 import (
 	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	riskEngineModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/riskengine"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskrule"
@@ -75,6 +76,9 @@ func handleUpsertRiskRules(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
 			if configValue == nil {
 				configValue = map[string]any{}
 			}
+			if err := riskEngineModule.ValidateRuleConfig(configValue); err != nil {
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid rule config for "+key+": "+err.Error())
+			}
 			existing, err := apiHelper.DBManager.DB.RiskRule.Query().
 				Where(riskrule.RuleKeyEQ(key)).
 				Only(c.Context())
@@ -1,6 +1,9 @@
 package adminrisk
 
-import "time"
+import (
+	riskEngineModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/riskengine"
+	"time"
+)
 
 type riskEventFilters struct {
 	From         time.Time
@@ -75,3 +78,14 @@ type riskRuleListResponse struct {
 	GeneratedAt time.Time      `json:"generatedAt"`
 	Items       []riskRuleItem `json:"items"`
 }
+
+type riskRuleBacktestPayload struct {
+	Config map[string]any `json:"config,omitempty"`
+	From   string         `json:"from,omitempty"`
+	To     string         `json:"to,omitempty"`
+}
+
+type riskRuleBacktestResponse struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	*riskEngineModule.BacktestReport
+}
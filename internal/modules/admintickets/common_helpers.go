@@ -8,6 +8,7 @@ const (
 	adminAuditActionTicketMessageAppend = "admin.ticket.message.append"
 	adminAuditActionTicketStatusUpdate  = "admin.ticket.status.update"
 	adminAuditActionTicketAssign        = "admin.ticket.assign"
+	adminAuditActionAttachmentDownload  = "admin.ticket.attachment.download"
 	adminAuditTargetTypeTicket          = "ticket"
 )
 
@@ -6,4 +6,7 @@ const (
 	maxAdminTicketPageSize      = 200
 	maxAdminTicketMessageLength = 4000
 	maxAdminTicketPreviewLength = 120
+
+	defaultAdminTicketSLAReportWindowDays = 30
+	maxAdminTicketSLAReportRangeDays      = 366
 )
@@ -3,13 +3,18 @@ package admintickets
 import (
 	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
 	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
 	platformTicketNotifications "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketnotifications"
+	platformTicketSLA "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketsla"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	sql "entgo.io/ent/dialect/sql"
@@ -76,7 +81,10 @@ func handleAdminGetTicketDetail(apiHelper *harukiAPIHelper.HarukiToolboxRouterHe
 		row, err := apiHelper.DBManager.DB.Ticket.Query().
 			Where(ticket.TicketIDEQ(publicTicketID)).
 			WithMessages(func(q *postgresql.TicketMessageQuery) {
-				q.Order(ticketmessage.ByCreatedAt(sql.OrderAsc()), ticketmessage.ByID(sql.OrderAsc()))
+				q.Order(ticketmessage.ByCreatedAt(sql.OrderAsc()), ticketmessage.ByID(sql.OrderAsc())).
+					WithAttachments(func(aq *postgresql.TicketAttachmentQuery) {
+						aq.Order(ticketattachment.ByCreatedAt(sql.OrderAsc()), ticketattachment.ByID(sql.OrderAsc()))
+					})
 			}).
 			Only(c.Context())
 		if err != nil {
@@ -120,6 +128,10 @@ func handleAdminAppendTicketMessage(apiHelper *harukiAPIHelper.HarukiToolboxRout
 		if messageLength == 0 || messageLength > maxAdminTicketMessageLength {
 			return harukiAPIHelper.ErrorBadRequest(c, "message must be 1-4000 characters")
 		}
+		attachments, err := ticketattachments.PrepareRequest(c)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid attachments")
+		}
 
 		row, err := queryAdminTicketByPublicID(c, apiHelper, publicTicketID)
 		if err != nil {
@@ -146,19 +158,36 @@ func handleAdminAppendTicketMessage(apiHelper *harukiAPIHelper.HarukiToolboxRout
 			return harukiAPIHelper.ErrorInternal(c, "failed to append ticket message")
 		}
 
-		if !payload.Internal && row.Status != ticket.StatusClosed {
-			update := tx.Ticket.UpdateOneID(row.ID).SetStatus(ticket.StatusPendingUser)
-			if row.ClosedAt != nil {
-				update.ClearClosedAt()
+		savedAttachments, blobKeys, err := ticketattachments.Save(c.Context(), apiHelper.BlobStore, tx, row.ID, savedMessage.ID, actorUserID, attachments)
+		if err != nil {
+			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
+			return harukiAPIHelper.ErrorInternal(c, "failed to save ticket attachments")
+		}
+		savedMessage.Edges.Attachments = savedAttachments
+
+		if !payload.Internal && (row.Status != ticket.StatusClosed || row.FirstResponseAt == nil) {
+			update := tx.Ticket.UpdateOneID(row.ID)
+			if row.Status != ticket.StatusClosed {
+				update.SetStatus(ticket.StatusPendingUser)
+				if row.ClosedAt != nil {
+					update.ClearClosedAt()
+				}
+				platformTicketSLA.ApplyStatusChange(update, row, ticket.StatusPendingUser, adminNowUTC())
+			}
+			if row.FirstResponseAt == nil {
+				update.SetFirstResponseAt(adminNowUTC())
 			}
 			if _, err := update.Save(c.Context()); err != nil {
 				_ = tx.Rollback()
+				ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
 				return harukiAPIHelper.ErrorInternal(c, "failed to update ticket status")
 			}
 		}
 
 		if err := tx.Commit(); err != nil {
 			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
 			return harukiAPIHelper.ErrorInternal(c, "failed to append ticket message")
 		}
 
@@ -173,7 +202,8 @@ func handleAdminAppendTicketMessage(apiHelper *harukiAPIHelper.HarukiToolboxRout
 		}
 		items := buildAdminTicketMessageItems([]*postgresql.TicketMessage{savedMessage}, userNameByUserID)
 		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionTicketMessageAppend, adminAuditTargetTypeTicket, row.TicketID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
-			"internal": payload.Internal,
+			"internal":    payload.Internal,
+			"attachments": len(savedAttachments),
 		})
 		return harukiAPIHelper.SuccessResponse(c, "message added", &items[0])
 	}
@@ -215,6 +245,7 @@ func handleAdminUpdateTicketStatus(apiHelper *harukiAPIHelper.HarukiToolboxRoute
 		} else {
 			update.ClearClosedAt()
 		}
+		platformTicketSLA.ApplyStatusChange(update, row, statusValue, adminNowUTC())
 		updated, err := update.Save(c.Context())
 		if err != nil {
 			_ = tx.Rollback()
@@ -352,3 +383,73 @@ func handleAdminAssignTicket(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpe
 		return harukiAPIHelper.SuccessResponse(c, "ticket assignment updated", &resp)
 	}
 }
+
+func handleAdminDownloadTicketAttachment(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, thumbnail bool) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		publicTicketID := strings.TrimSpace(c.Params("ticket_id"))
+		attachmentID := strings.TrimSpace(c.Params("attachment_id"))
+		if publicTicketID == "" || attachmentID == "" {
+			return harukiAPIHelper.ErrorBadRequest(c, "ticket_id and attachment_id are required")
+		}
+
+		row, err := apiHelper.DBManager.DB.TicketAttachment.Query().
+			Where(
+				ticketattachment.IDEQ(attachmentID),
+				ticketattachment.HasTicketWith(ticket.TicketIDEQ(publicTicketID)),
+			).
+			Only(c.Context())
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				return harukiAPIHelper.ErrorNotFound(c, "attachment not found")
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to query attachment")
+		}
+		if !thumbnail {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminAuditActionAttachmentDownload, adminAuditTargetTypeTicket, publicTicketID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+				"attachmentId": row.ID,
+			})
+		}
+		if err := ticketattachments.Send(c, apiHelper.BlobStore, row, thumbnail); err != nil {
+			if fiberErr, ok := err.(*fiber.Error); ok {
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiberErr.Code, fiberErr.Message, nil)
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to read attachment")
+		}
+		return nil
+	}
+}
+
+func handleAdminGetTicketSLAReport(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		now := adminNowUTC()
+		from, to, err := platformTime.ResolveTimeRange(
+			c.Query("from"),
+			c.Query("to"),
+			now,
+			defaultAdminTicketSLAReportWindowDays*24*time.Hour,
+			maxAdminTicketSLAReportRangeDays*24*time.Hour,
+		)
+		if err != nil {
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid time range")
+		}
+
+		report, err := platformTicketSLA.BuildReport(c.Context(), apiHelper.DBManager.DB, platformTicketSLA.CurrentOptions(), from, to, now)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to build ticket sla report")
+		}
+		assigneeIDs := make([]string, 0, len(report.ByAssignee))
+		for _, bucket := range report.ByAssignee {
+			if bucket.Key != platformTicketSLA.UnassignedKey {
+				assigneeIDs = append(assigneeIDs, bucket.Key)
+			}
+		}
+		nameByUserID, err := loadAdminTicketUserNames(c, apiHelper, assigneeIDs)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query ticket users")
+		}
+		for i := range report.ByAssignee {
+			report.ByAssignee[i].Name = strings.TrimSpace(nameByUserID[report.ByAssignee[i].Key])
+		}
+		return harukiAPIHelper.SuccessResponse(c, "success", report)
+	}
+}
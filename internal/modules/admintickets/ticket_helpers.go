@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
+	platformTicketSLA "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketsla"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
@@ -51,6 +53,15 @@ func buildAdminTicketListItem(row *postgresql.Ticket, creatorNameByUserID map[st
 		closed := row.ClosedAt.UTC()
 		item.ClosedAt = &closed
 	}
+	if row.FirstResponseAt != nil {
+		firstResponse := row.FirstResponseAt.UTC()
+		item.FirstResponseAt = &firstResponse
+	}
+	if row.ResolvedAt != nil {
+		resolved := row.ResolvedAt.UTC()
+		item.ResolvedAt = &resolved
+	}
+	item.SLA = platformTicketSLA.Evaluate(row, platformTicketSLA.CurrentOptions(), adminNowUTC())
 	applyAdminTicketLatestMessageSummary(&item, row.Edges.Messages)
 	return item
 }
@@ -196,11 +207,12 @@ func buildAdminTicketMessageItems(rows []*postgresql.TicketMessage, userNameByUs
 	items := make([]adminTicketMessageItem, 0, len(rows))
 	for _, row := range rows {
 		item := adminTicketMessageItem{
-			ID:         row.ID,
-			SenderRole: string(row.SenderRole),
-			Message:    row.Message,
-			Internal:   row.Internal,
-			CreatedAt:  row.CreatedAt.UTC(),
+			ID:          row.ID,
+			SenderRole:  string(row.SenderRole),
+			Message:     row.Message,
+			Internal:    row.Internal,
+			Attachments: ticketattachments.BuildItems(row.Edges.Attachments),
+			CreatedAt:   row.CreatedAt.UTC(),
 		}
 		if row.SenderUserID != nil {
 			item.SenderUserID = *row.SenderUserID
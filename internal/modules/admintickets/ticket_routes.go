@@ -9,8 +9,11 @@ func RegisterAdminTicketRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHel
 	adminGroup := adminCoreModule.AdminRootGroup(apiHelper)
 	tickets := adminGroup.Group("/tickets", adminCoreModule.RequireAdmin(apiHelper))
 	tickets.Get("", handleAdminListTickets(apiHelper))
+	tickets.Get("/sla/report", handleAdminGetTicketSLAReport(apiHelper))
 	tickets.Get("/:ticket_id", handleAdminGetTicketDetail(apiHelper))
 	tickets.Post("/:ticket_id/messages", handleAdminAppendTicketMessage(apiHelper))
 	tickets.Put("/:ticket_id/status", handleAdminUpdateTicketStatus(apiHelper))
 	tickets.Put("/:ticket_id/assign", handleAdminAssignTicket(apiHelper))
+	tickets.Get("/:ticket_id/attachments/:attachment_id", handleAdminDownloadTicketAttachment(apiHelper, false))
+	tickets.Get("/:ticket_id/attachments/:attachment_id/thumbnail", handleAdminDownloadTicketAttachment(apiHelper, true))
 }
@@ -1,6 +1,8 @@
 package admintickets
 
 import (
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
+	platformTicketSLA "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketsla"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
 	"time"
 )
@@ -29,8 +31,8 @@ type adminTicketListResponse struct {
 }
 
 type adminAppendTicketMessagePayload struct {
-	Message  string `json:"message"`
-	Internal bool   `json:"internal"`
+	Message  string `json:"message" form:"message"`
+	Internal bool   `json:"internal" form:"internal"`
 }
 
 type adminUpdateTicketStatusPayload struct {
@@ -42,32 +44,36 @@ type adminAssignTicketPayload struct {
 }
 
 type adminTicketListItem struct {
-	TicketID              string     `json:"ticketId"`
-	CreatorUserID         string     `json:"creatorUserId"`
-	CreatorUserName       string     `json:"creatorUserName,omitempty"`
-	Subject               string     `json:"subject"`
-	Category              string     `json:"category,omitempty"`
-	Priority              string     `json:"priority"`
-	Status                string     `json:"status"`
-	AssigneeAdminID       string     `json:"assigneeAdminId,omitempty"`
-	AssigneeAdminName     string     `json:"assigneeAdminName,omitempty"`
-	LastMessageSenderRole string     `json:"lastMessageSenderRole,omitempty"`
-	LastMessagePreview    string     `json:"lastMessagePreview,omitempty"`
-	LastMessageInternal   *bool      `json:"lastMessageInternal,omitempty"`
-	CreatedAt             time.Time  `json:"createdAt"`
-	UpdatedAt             time.Time  `json:"updatedAt"`
-	LastMessageAt         *time.Time `json:"lastMessageAt,omitempty"`
-	ClosedAt              *time.Time `json:"closedAt,omitempty"`
+	TicketID              string                    `json:"ticketId"`
+	CreatorUserID         string                    `json:"creatorUserId"`
+	CreatorUserName       string                    `json:"creatorUserName,omitempty"`
+	Subject               string                    `json:"subject"`
+	Category              string                    `json:"category,omitempty"`
+	Priority              string                    `json:"priority"`
+	Status                string                    `json:"status"`
+	AssigneeAdminID       string                    `json:"assigneeAdminId,omitempty"`
+	AssigneeAdminName     string                    `json:"assigneeAdminName,omitempty"`
+	LastMessageSenderRole string                    `json:"lastMessageSenderRole,omitempty"`
+	LastMessagePreview    string                    `json:"lastMessagePreview,omitempty"`
+	LastMessageInternal   *bool                     `json:"lastMessageInternal,omitempty"`
+	CreatedAt             time.Time                 `json:"createdAt"`
+	UpdatedAt             time.Time                 `json:"updatedAt"`
+	LastMessageAt         *time.Time                `json:"lastMessageAt,omitempty"`
+	FirstResponseAt       *time.Time                `json:"firstResponseAt,omitempty"`
+	ResolvedAt            *time.Time                `json:"resolvedAt,omitempty"`
+	ClosedAt              *time.Time                `json:"closedAt,omitempty"`
+	SLA                   *platformTicketSLA.Status `json:"sla,omitempty"`
 }
 
 type adminTicketMessageItem struct {
-	ID             int       `json:"id"`
-	SenderUserID   string    `json:"senderUserId,omitempty"`
-	SenderUserName string    `json:"senderUserName,omitempty"`
-	SenderRole     string    `json:"senderRole"`
-	Message        string    `json:"message"`
-	Internal       bool      `json:"internal"`
-	CreatedAt      time.Time `json:"createdAt"`
+	ID             int                      `json:"id"`
+	SenderUserID   string                   `json:"senderUserId,omitempty"`
+	SenderUserName string                   `json:"senderUserName,omitempty"`
+	SenderRole     string                   `json:"senderRole"`
+	Message        string                   `json:"message"`
+	Internal       bool                     `json:"internal"`
+	Attachments    []ticketattachments.Item `json:"attachments,omitempty"`
+	CreatedAt      time.Time                `json:"createdAt"`
 }
 
 type adminTicketDetailResponse struct {
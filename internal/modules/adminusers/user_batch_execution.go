@@ -11,7 +11,7 @@ import (
 
 func executeBatchBan(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, actorUserID, actorRole, targetUserID string, reason *string) (int, error) {
 	update := applyManagedTargetUserUpdateGuards(
-		apiHelper.DBManager.DB.User.Update().SetBanned(true),
+		apiHelper.DBManager.DB.User.Update().SetBanned(true).ClearBanExpiresAt(),
 		actorUserID,
 		actorRole,
 		targetUserID,
@@ -28,7 +28,8 @@ func executeBatchUnban(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToo
 	return applyManagedTargetUserUpdateGuards(
 		apiHelper.DBManager.DB.User.Update().
 			SetBanned(false).
-			ClearBanReason(),
+			ClearBanReason().
+			ClearBanExpiresAt(),
 		actorUserID,
 		actorRole,
 		targetUserID,
@@ -45,7 +45,8 @@ func handleRestoreUser(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fi
 		affected, err := applyManagedTargetUserUpdateGuards(
 			apiHelper.DBManager.DB.User.Update().
 				SetBanned(false).
-				ClearBanReason(),
+				ClearBanReason().
+				ClearBanExpiresAt(),
 			actorUserID,
 			actorRole,
 			targetUser.ID,
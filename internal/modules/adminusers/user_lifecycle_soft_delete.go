@@ -59,7 +59,8 @@ func handleSoftDeleteUser(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 		affected, err := applyManagedTargetUserUpdateGuards(
 			apiHelper.DBManager.DB.User.Update().
 				SetBanned(true).
-				SetBanReason(banReason),
+				SetBanReason(banReason).
+				ClearBanExpiresAt(),
 			actorUserID,
 			actorRole,
 			targetUser.ID,
@@ -0,0 +1,51 @@
+package adminusers
+
+import (
+	"context"
+	"errors"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"time"
+)
+
+func queryUserKratosIdentityID(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID string) (*string, error) {
+	row, err := apiHelper.DBManager.DB.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldID, user.FieldKratosIdentityID).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return row.KratosIdentityID, nil
+}
+
+// ForceLogoutUser revokes every session of a user through the same path as
+// the batch force-logout action.
+func ForceLogoutUser(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID string) error {
+	kratosIdentityID, err := queryUserKratosIdentityID(ctx, apiHelper, userID)
+	if err != nil {
+		return err
+	}
+	return executeBatchForceLogout(ctx, apiHelper, userID, kratosIdentityID)
+}
+
+// TemporarilyBanUser bans a regular user until expiresAt and clears their
+// sessions and OAuth grants like a manual ban. It reports false when the user
+// was already banned or is staff.
+func TemporarilyBanUser(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID, reason string, expiresAt time.Time) (bool, error) {
+	applied, err := apiHelper.DBManager.DB.TemporarilyBanUser(ctx, userID, reason, expiresAt)
+	if err != nil || !applied {
+		return applied, err
+	}
+	kratosIdentityID, err := queryUserKratosIdentityID(ctx, apiHelper, userID)
+	if err != nil {
+		return true, err
+	}
+	// As with manual bans, only a failed session clear is treated as an error.
+	sessionClearFailed, oauthRevokeFailed := cleanupManagedUserAccessAfterBan(ctx, apiHelper, userID, kratosIdentityID)
+	if sessionClearFailed {
+		message, _ := resolveManagedUserBanFinalizeOutcome(sessionClearFailed, oauthRevokeFailed)
+		return true, errors.New(message)
+	}
+	return true, nil
+}
@@ -127,7 +127,7 @@ func handleBanUser(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.
 		}
 
 		update := applyManagedTargetUserUpdateGuards(
-			apiHelper.DBManager.DB.User.Update().SetBanned(true),
+			apiHelper.DBManager.DB.User.Update().SetBanned(true).ClearBanExpiresAt(),
 			actorUserID,
 			actorRole,
 			targetUser.ID,
@@ -231,7 +231,8 @@ func handleUnbanUser(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fibe
 		affected, err := applyManagedTargetUserUpdateGuards(
 			apiHelper.DBManager.DB.User.Update().
 				SetBanned(false).
-				ClearBanReason(),
+				ClearBanReason().
+				ClearBanExpiresAt(),
 			actorUserID,
 			actorRole,
 			targetUser.ID,
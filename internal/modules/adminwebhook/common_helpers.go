@@ -3,6 +3,7 @@ package adminwebhook
 import (
 	"crypto/rand"
 	"encoding/hex"
+	platformIdentity "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/identity"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
@@ -28,24 +29,46 @@ const (
 	adminWebhookActionSubscribers    = "admin.webhook.subscribers.list"
 	adminWebhookActionGetSettings    = "admin.webhook.settings.get"
 	adminWebhookActionUpdateSettings = "admin.webhook.settings.update"
+	adminWebhookActionDeliveryList   = "admin.webhook.delivery.list"
+	adminWebhookActionDeliveryGet    = "admin.webhook.delivery.get"
+	adminWebhookActionDeliveryReplay = "admin.webhook.delivery.replay"
+	adminWebhookActionDeliveryPurge  = "admin.webhook.delivery.purge"
+	adminWebhookActionRotateSecret   = "admin.webhook.signing_secret.rotate"
+	adminWebhookActionAttemptList    = "admin.webhook.attempt.list"
+	adminWebhookActionHealthQuery    = "admin.webhook.health.query"
 )
 
 const (
-	adminWebhookFailureReasonInvalidRequestPayload      = "invalid_request_payload"
-	adminWebhookFailureReasonInvalidWebhookID           = "invalid_webhook_id"
-	adminWebhookFailureReasonInvalidCallbackURL         = "invalid_callback_url"
-	adminWebhookFailureReasonInvalidCredential          = "invalid_credential"
-	adminWebhookFailureReasonWebhookConflict            = "webhook_conflict"
-	adminWebhookFailureReasonWebhookNotFound            = "webhook_not_found"
-	adminWebhookFailureReasonQueryWebhooksFailed        = "query_webhooks_failed"
-	adminWebhookFailureReasonQuerySubscribersFailed     = "query_webhook_subscribers_failed"
-	adminWebhookFailureReasonCreateWebhookFailed        = "create_webhook_failed"
-	adminWebhookFailureReasonUpdateWebhookFailed        = "update_webhook_failed"
-	adminWebhookFailureReasonDeleteWebhookFailed        = "delete_webhook_failed"
-	adminWebhookFailureReasonResolveNextWebhookIDFailed = "resolve_next_webhook_id_failed"
-	adminWebhookFailureReasonGenerateCredentialFailed   = "generate_credential_failed"
-	adminWebhookFailureReasonPersistRuntimeConfigFailed = "persist_runtime_config_failed"
-	adminWebhookFailureReasonInvalidWebhookJWTSecret    = "invalid_webhook_jwt_secret"
+	adminWebhookFailureReasonInvalidRequestPayload       = "invalid_request_payload"
+	adminWebhookFailureReasonInvalidWebhookID            = "invalid_webhook_id"
+	adminWebhookFailureReasonInvalidCallbackURL          = "invalid_callback_url"
+	adminWebhookFailureReasonInvalidCredential           = "invalid_credential"
+	adminWebhookFailureReasonWebhookConflict             = "webhook_conflict"
+	adminWebhookFailureReasonWebhookNotFound             = "webhook_not_found"
+	adminWebhookFailureReasonQueryWebhooksFailed         = "query_webhooks_failed"
+	adminWebhookFailureReasonQuerySubscribersFailed      = "query_webhook_subscribers_failed"
+	adminWebhookFailureReasonCreateWebhookFailed         = "create_webhook_failed"
+	adminWebhookFailureReasonUpdateWebhookFailed         = "update_webhook_failed"
+	adminWebhookFailureReasonDeleteWebhookFailed         = "delete_webhook_failed"
+	adminWebhookFailureReasonResolveNextWebhookIDFailed  = "resolve_next_webhook_id_failed"
+	adminWebhookFailureReasonGenerateCredentialFailed    = "generate_credential_failed"
+	adminWebhookFailureReasonPersistRuntimeConfigFailed  = "persist_runtime_config_failed"
+	adminWebhookFailureReasonInvalidWebhookJWTSecret     = "invalid_webhook_jwt_secret"
+	adminWebhookFailureReasonInvalidDeliveryFilter       = "invalid_delivery_filter"
+	adminWebhookFailureReasonInvalidDeliveryID           = "invalid_delivery_id"
+	adminWebhookFailureReasonDeliveryNotFound            = "delivery_not_found"
+	adminWebhookFailureReasonQueryDeliveriesFailed       = "query_deliveries_failed"
+	adminWebhookFailureReasonReplayDeliveryFailed        = "replay_delivery_failed"
+	adminWebhookFailureReasonDeliveryNotReplayable       = "delivery_not_replayable"
+	adminWebhookFailureReasonPurgeDeliveriesFailed       = "purge_deliveries_failed"
+	adminWebhookFailureReasonInvalidGracePeriod          = "invalid_grace_period"
+	adminWebhookFailureReasonGenerateSigningSecretFailed = "generate_signing_secret_failed"
+	adminWebhookFailureReasonRotateSigningSecretFailed   = "rotate_signing_secret_failed"
+	adminWebhookFailureReasonInvalidOwnerEmail           = "invalid_owner_email"
+	adminWebhookFailureReasonInvalidAttemptFilter        = "invalid_attempt_filter"
+	adminWebhookFailureReasonQueryAttemptsFailed         = "query_attempts_failed"
+	adminWebhookFailureReasonInvalidHealthWindow         = "invalid_health_window"
+	adminWebhookFailureReasonQueryHealthFailed           = "query_health_failed"
 )
 
 var adminWebhookNow = time.Now
@@ -84,6 +107,19 @@ func sanitizeOptionalBearer(raw *string) *string {
 	return &trimmed
 }
 
+// sanitizeWebhookOwnerEmail returns nil for an absent or blank address, which
+// callers treat as "no owner".
+func sanitizeWebhookOwnerEmail(raw *string) (*string, error) {
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return nil, nil
+	}
+	email, ok := platformIdentity.ParseEmail(*raw)
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid ownerEmail")
+	}
+	return &email, nil
+}
+
 func sanitizeWebhookJWTSecret(raw *string) (*string, error) {
 	if raw == nil {
 		return nil, nil
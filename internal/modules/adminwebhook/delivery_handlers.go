@@ -0,0 +1,184 @@
+package adminwebhook
+
+import (
+	"errors"
+	"strings"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	adminWebhookDeliveryTargetType      = "webhook_delivery"
+	defaultAdminWebhookDeliveryPage     = 1
+	defaultAdminWebhookDeliveryPageSize = 50
+	maxAdminWebhookDeliveryPageSize     = 200
+)
+
+func parseAdminWebhookDeliveryFilter(c fiber.Ctx) (postgresql.WebhookDeliveryFilter, error) {
+	filter := postgresql.WebhookDeliveryFilter{
+		EndpointID: strings.TrimSpace(c.Query("endpoint_id")),
+		ClientID:   strings.TrimSpace(c.Query("client_id")),
+	}
+	if rawType := strings.TrimSpace(c.Query("endpoint_type")); rawType != "" {
+		endpointType := webhookdelivery.EndpointType(rawType)
+		if err := webhookdelivery.EndpointTypeValidator(endpointType); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid endpoint_type")
+		}
+		filter.EndpointType = endpointType
+	}
+	for _, rawStatus := range strings.Split(c.Query("status"), ",") {
+		rawStatus = strings.TrimSpace(rawStatus)
+		if rawStatus == "" {
+			continue
+		}
+		status := webhookdelivery.Status(rawStatus)
+		if err := webhookdelivery.StatusValidator(status); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid status")
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+	before, err := platformTime.ParseFlexibleTime(c.Query("before"))
+	if err != nil {
+		return filter, err
+	}
+	filter.Before = before
+	return filter, nil
+}
+
+func sanitizeWebhookDeliveryID(raw string) (string, error) {
+	deliveryID := strings.TrimSpace(raw)
+	if deliveryID == "" {
+		return "", fiber.NewError(fiber.StatusBadRequest, "delivery id is required")
+	}
+	if strings.ContainsAny(deliveryID, " \t\r\n/") {
+		return "", fiber.NewError(fiber.StatusBadRequest, "delivery id contains invalid characters")
+	}
+	return deliveryID, nil
+}
+
+func handleListAdminWebhookDeliveries(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		filter, err := parseAdminWebhookDeliveryFilter(c)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryList, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidDeliveryFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid delivery filter")
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultAdminWebhookDeliveryPage, defaultAdminWebhookDeliveryPageSize, maxAdminWebhookDeliveryPageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryList, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidDeliveryFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListWebhookDeliveries(c.Context(), filter, (page-1)*pageSize, pageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryList, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonQueryDeliveriesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query webhook deliveries")
+		}
+
+		items := make([]adminWebhookDeliveryItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminWebhookDeliveryItem(row))
+		}
+		resp := adminWebhookDeliveryListResponse{
+			GeneratedAt: adminWebhookNowUTC(),
+			Page:        page,
+			PageSize:    pageSize,
+			Total:       total,
+			TotalPages:  platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:     platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryList, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"total": total,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetAdminWebhookDelivery(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		deliveryID, err := sanitizeWebhookDeliveryID(c.Params("delivery_id"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryGet, adminWebhookDeliveryTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidDeliveryID, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid delivery_id")
+		}
+
+		row, err := apiHelper.DBManager.DB.WebhookDelivery.Get(c.Context(), deliveryID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryGet, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonDeliveryNotFound, nil))
+				return harukiAPIHelper.ErrorNotFound(c, "webhook delivery not found")
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryGet, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonQueryDeliveriesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query webhook delivery")
+		}
+
+		resp := buildAdminWebhookDeliveryItem(row)
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryGet, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultSuccess, nil)
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleReplayAdminWebhookDelivery(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		deliveryID, err := sanitizeWebhookDeliveryID(c.Params("delivery_id"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryReplay, adminWebhookDeliveryTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidDeliveryID, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid delivery_id")
+		}
+
+		row, err := apiHelper.DBManager.DB.ReplayWebhookDelivery(c.Context(), deliveryID, adminWebhookNowUTC())
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryReplay, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonDeliveryNotFound, nil))
+				return harukiAPIHelper.ErrorNotFound(c, "webhook delivery not found")
+			}
+			if errors.Is(err, postgresql.ErrWebhookDeliveryNotReplayable) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryReplay, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonDeliveryNotReplayable, nil))
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, err.Error(), nil)
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryReplay, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonReplayDeliveryFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to replay webhook delivery")
+		}
+		harukiHandler.NotifyWebhookDeliveryWorker()
+
+		resp := buildAdminWebhookDeliveryItem(row)
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryReplay, adminWebhookDeliveryTargetType, deliveryID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"endpointType": resp.EndpointType,
+			"endpointId":   resp.EndpointID,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "webhook delivery queued for replay", &resp)
+	}
+}
+
+func handlePurgeAdminWebhookDeliveries(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		filter, err := parseAdminWebhookDeliveryFilter(c)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryPurge, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidDeliveryFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid delivery filter")
+		}
+
+		deleted, err := apiHelper.DBManager.DB.PurgeWebhookDeliveries(c.Context(), filter)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryPurge, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonPurgeDeliveriesFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to purge webhook deliveries")
+		}
+
+		resp := adminWebhookDeliveryPurgeResponse{Deleted: deleted}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionDeliveryPurge, adminWebhookDeliveryTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"deleted":    deleted,
+			"endpointId": filter.EndpointID,
+			"statuses":   filter.Statuses,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "webhook deliveries purged", &resp)
+	}
+}
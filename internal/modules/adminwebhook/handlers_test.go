@@ -7,11 +7,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	webhookModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/webhook"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
 
 	"github.com/gofiber/fiber/v3"
 	_ "github.com/mattn/go-sqlite3"
@@ -224,3 +228,324 @@ func TestAdminWebhookCRUDHandlersPreserveCallbackPlaceholders(t *testing.T) {
 		t.Fatalf("callback url = %q, want placeholders to be preserved after update", row.CallbackURL)
 	}
 }
+
+func TestAdminWebhookDeliveryHandlers(t *testing.T) {
+	helper := newAdminWebhookTestHelper(t)
+	app := newAdminWebhookTestApp()
+	app.Get("/webhooks/deliveries", handleListAdminWebhookDeliveries(helper))
+	app.Get("/webhooks/deliveries/:delivery_id", handleGetAdminWebhookDelivery(helper))
+	app.Post("/webhooks/deliveries/:delivery_id/replay", handleReplayAdminWebhookDelivery(helper))
+	app.Delete("/webhooks/deliveries", handlePurgeAdminWebhookDeliveries(helper))
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	if err := helper.DBManager.DB.EnqueueWebhookDeliveries(ctx, []postgresql.WebhookDeliveryRecord{
+		{ID: "d1", EndpointType: webhookdelivery.EndpointTypeWebhook, EndpointID: "1", CallbackURL: "https://93.184.216.34/a", GameUserID: "123", Server: "jp", DataType: "suite", MaxAttempts: 3},
+		{ID: "d2", EndpointType: webhookdelivery.EndpointTypeWebhook, EndpointID: "2", CallbackURL: "https://93.184.216.34/b", GameUserID: "123", Server: "jp", DataType: "suite", MaxAttempts: 3},
+	}, now); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+	if err := helper.DBManager.DB.FailWebhookDelivery(ctx, "d1", nil, "connection refused", nil); err != nil {
+		t.Fatalf("FailWebhookDelivery returned error: %v", err)
+	}
+
+	listResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/deliveries?status=dead", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if listResp.StatusCode != fiber.StatusOK {
+		t.Fatalf("list status code = %d, want %d", listResp.StatusCode, fiber.StatusOK)
+	}
+	var listBody struct {
+		UpdatedData adminWebhookDeliveryListResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(listResp.Body).Decode(&listBody); err != nil {
+		t.Fatalf("decode list response returned error: %v", err)
+	}
+	if listBody.UpdatedData.Total != 1 || listBody.UpdatedData.Items[0].ID != "d1" {
+		t.Fatalf("dead deliveries = %+v, want only d1", listBody.UpdatedData)
+	}
+	if listBody.UpdatedData.Items[0].LastError == nil || *listBody.UpdatedData.Items[0].LastError != "connection refused" {
+		t.Fatalf("last error = %v", listBody.UpdatedData.Items[0].LastError)
+	}
+
+	badResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/deliveries?status=unknown", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if badResp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("invalid status filter code = %d, want %d", badResp.StatusCode, fiber.StatusBadRequest)
+	}
+
+	missingResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/deliveries/missing", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if missingResp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("get missing delivery code = %d, want %d", missingResp.StatusCode, fiber.StatusNotFound)
+	}
+
+	replayResp, err := app.Test(httptest.NewRequest(http.MethodPost, "/webhooks/deliveries/d1/replay", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if replayResp.StatusCode != fiber.StatusOK {
+		t.Fatalf("replay status code = %d, want %d", replayResp.StatusCode, fiber.StatusOK)
+	}
+	row, err := helper.DBManager.DB.WebhookDelivery.Get(ctx, "d1")
+	if err != nil {
+		t.Fatalf("query replayed delivery returned error: %v", err)
+	}
+	if row.Status != webhookdelivery.StatusPending || row.Attempts != 0 {
+		t.Fatalf("replayed delivery = %+v, want pending with no attempts", row)
+	}
+	if row.LastError != nil || row.LastStatusCode != nil {
+		t.Fatalf("replayed delivery kept the stale failure: %+v", row)
+	}
+	// A pending delivery may be in flight on a worker, so it cannot be
+	// replayed again.
+	againResp, err := app.Test(httptest.NewRequest(http.MethodPost, "/webhooks/deliveries/d1/replay", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if againResp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("replay pending delivery code = %d, want %d", againResp.StatusCode, fiber.StatusConflict)
+	}
+	missingReplayResp, err := app.Test(httptest.NewRequest(http.MethodPost, "/webhooks/deliveries/missing/replay", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if missingReplayResp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("replay missing delivery code = %d, want %d", missingReplayResp.StatusCode, fiber.StatusNotFound)
+	}
+
+	if err := helper.DBManager.DB.CompleteWebhookDelivery(ctx, "d2", 200, now); err != nil {
+		t.Fatalf("CompleteWebhookDelivery returned error: %v", err)
+	}
+	purgeResp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/webhooks/deliveries", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if purgeResp.StatusCode != fiber.StatusOK {
+		t.Fatalf("purge status code = %d, want %d", purgeResp.StatusCode, fiber.StatusOK)
+	}
+	var purgeBody struct {
+		UpdatedData adminWebhookDeliveryPurgeResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(purgeResp.Body).Decode(&purgeBody); err != nil {
+		t.Fatalf("decode purge response returned error: %v", err)
+	}
+	if purgeBody.UpdatedData.Deleted != 1 {
+		t.Fatalf("purged = %d, want 1 (pending deliveries are kept)", purgeBody.UpdatedData.Deleted)
+	}
+}
+
+func TestAdminWebhookSigningSecretHandlers(t *testing.T) {
+	helper := newAdminWebhookTestHelper(t)
+	app := newAdminWebhookTestApp()
+	app.Post("/webhooks", handleCreateAdminWebhook(helper))
+	app.Post("/webhooks/:webhook_id/signing-secret/rotate", handleRotateAdminWebhookSigningSecret(helper))
+
+	createReq := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"id":"signing-test","credential":"cred-sign","callbackUrl":"https://93.184.216.34/callback"}`))
+	createReq.Header.Set("Content-Type", "application/json")
+	createResp, err := app.Test(createReq)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	var createBody struct {
+		UpdatedData adminWebhookMutationResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(createResp.Body).Decode(&createBody); err != nil {
+		t.Fatalf("decode create response returned error: %v", err)
+	}
+	initialSecret := createBody.UpdatedData.SigningSecret
+	if !strings.HasPrefix(initialSecret, "whsec_") || !createBody.UpdatedData.Webhook.SigningSecretSet {
+		t.Fatalf("create response = %+v, want a provisioned signing secret", createBody.UpdatedData)
+	}
+
+	badReq := httptest.NewRequest(http.MethodPost, "/webhooks/signing-test/signing-secret/rotate", strings.NewReader(`{"gracePeriodSeconds":-1}`))
+	badReq.Header.Set("Content-Type", "application/json")
+	badResp, err := app.Test(badReq)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if badResp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("negative grace status code = %d, want %d", badResp.StatusCode, fiber.StatusBadRequest)
+	}
+
+	rotateReq := httptest.NewRequest(http.MethodPost, "/webhooks/signing-test/signing-secret/rotate", strings.NewReader(`{"gracePeriodSeconds":3600}`))
+	rotateReq.Header.Set("Content-Type", "application/json")
+	rotateResp, err := app.Test(rotateReq)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if rotateResp.StatusCode != fiber.StatusOK {
+		t.Fatalf("rotate status code = %d, want %d", rotateResp.StatusCode, fiber.StatusOK)
+	}
+	var rotateBody struct {
+		UpdatedData adminWebhookRotateSecretResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(rotateResp.Body).Decode(&rotateBody); err != nil {
+		t.Fatalf("decode rotate response returned error: %v", err)
+	}
+	if rotateBody.UpdatedData.SigningSecret == "" || rotateBody.UpdatedData.SigningSecret == initialSecret || rotateBody.UpdatedData.PreviousSigningSecretExpiresAt == nil {
+		t.Fatalf("rotate response = %+v", rotateBody.UpdatedData)
+	}
+
+	row, err := helper.DBManager.DB.WebhookEndpoint.Get(context.Background(), "signing-test")
+	if err != nil {
+		t.Fatalf("query rotated webhook endpoint returned error: %v", err)
+	}
+	active := row.SigningSecrets().Active(time.Now())
+	if len(active) != 2 || active[0] != rotateBody.UpdatedData.SigningSecret || active[1] != initialSecret {
+		t.Fatalf("active secrets = %v, want new then previous", active)
+	}
+
+	missingResp, err := app.Test(httptest.NewRequest(http.MethodPost, "/webhooks/missing/signing-secret/rotate", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if missingResp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("rotate missing status code = %d, want %d", missingResp.StatusCode, fiber.StatusNotFound)
+	}
+}
+
+func TestAdminWebhookHealthHandlers(t *testing.T) {
+	helper := newAdminWebhookTestHelper(t)
+	app := newAdminWebhookTestApp()
+	app.Post("/webhooks", handleCreateAdminWebhook(helper))
+	app.Put("/webhooks/:webhook_id", handleUpdateAdminWebhook(helper))
+	app.Get("/webhooks/attempts", handleListAdminWebhookAttempts(helper))
+	app.Get("/webhooks/health", handleGetAdminWebhookHealth(helper))
+
+	badOwnerReq := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"callbackUrl":"https://93.184.216.34/callback","ownerEmail":"not-an-email"}`))
+	badOwnerReq.Header.Set("Content-Type", "application/json")
+	badOwnerResp, err := app.Test(badOwnerReq)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if badOwnerResp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("invalid owner email code = %d, want %d", badOwnerResp.StatusCode, fiber.StatusBadRequest)
+	}
+
+	createReq := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"callbackUrl":"https://93.184.216.34/callback","ownerEmail":" Owner@Example.com "}`))
+	createReq.Header.Set("Content-Type", "application/json")
+	createResp, err := app.Test(createReq)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	var createBody struct {
+		UpdatedData adminWebhookMutationResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(createResp.Body).Decode(&createBody); err != nil {
+		t.Fatalf("decode create response returned error: %v", err)
+	}
+	webhookID := createBody.UpdatedData.Webhook.ID
+	if ownerEmail := createBody.UpdatedData.Webhook.OwnerEmail; ownerEmail == nil || *ownerEmail != "owner@example.com" {
+		t.Fatalf("owner email = %v", ownerEmail)
+	}
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	statusOK := http.StatusOK
+	for i, success := range []bool{true, false, false} {
+		record := postgresql.WebhookDeliveryAttemptRecord{
+			EndpointType: webhookdeliveryattempt.EndpointTypeWebhook,
+			EndpointID:   webhookID,
+			DeliveryID:   "d1",
+			Attempt:      i + 1,
+			Success:      success,
+			Latency:      time.Duration(i+1) * 100 * time.Millisecond,
+		}
+		if success {
+			record.StatusCode = &statusOK
+		} else {
+			record.Error = "connection refused"
+		}
+		if err := helper.DBManager.DB.RecordWebhookDeliveryAttempt(ctx, record, now.Add(time.Duration(i-3)*time.Minute)); err != nil {
+			t.Fatalf("RecordWebhookDeliveryAttempt returned error: %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := helper.DBManager.DB.MarkWebhookEndpointAttemptFailed(ctx, webhookdeliveryattempt.EndpointTypeWebhook, webhookID, now, postgresql.WebhookAutoDisablePolicy{FailureThreshold: 2}); err != nil {
+			t.Fatalf("MarkWebhookEndpointAttemptFailed returned error: %v", err)
+		}
+	}
+
+	attemptsResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/attempts?success=false&endpoint_type=webhook", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if attemptsResp.StatusCode != fiber.StatusOK {
+		t.Fatalf("attempts status code = %d, want %d", attemptsResp.StatusCode, fiber.StatusOK)
+	}
+	var attemptsBody struct {
+		UpdatedData adminWebhookAttemptListResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(attemptsResp.Body).Decode(&attemptsBody); err != nil {
+		t.Fatalf("decode attempts response returned error: %v", err)
+	}
+	if attemptsBody.UpdatedData.Total != 2 || attemptsBody.UpdatedData.Items[0].Attempt != 3 {
+		t.Fatalf("failed attempts = %+v", attemptsBody.UpdatedData)
+	}
+
+	badFilterResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/attempts?endpoint_type=unknown", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if badFilterResp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("invalid endpoint type code = %d, want %d", badFilterResp.StatusCode, fiber.StatusBadRequest)
+	}
+
+	healthResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/health?hours=1", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if healthResp.StatusCode != fiber.StatusOK {
+		t.Fatalf("health status code = %d, want %d", healthResp.StatusCode, fiber.StatusOK)
+	}
+	var healthBody struct {
+		UpdatedData adminWebhookHealthResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(healthResp.Body).Decode(&healthBody); err != nil {
+		t.Fatalf("decode health response returned error: %v", err)
+	}
+	if healthBody.UpdatedData.Total != 1 {
+		t.Fatalf("health items = %d, want 1", healthBody.UpdatedData.Total)
+	}
+	health := healthBody.UpdatedData.Items[0]
+	if health.Enabled || health.AutoDisabledAt == nil || health.ConsecutiveFailures != 2 {
+		t.Fatalf("health state = %+v, want auto-disabled", health)
+	}
+	if health.TotalAttempts != 3 || health.FailedAttempts != 2 || health.P95LatencyMs == nil || *health.P95LatencyMs != 300 {
+		t.Fatalf("health stats = %+v", health)
+	}
+	if health.LastSuccessAt == nil || len(health.RecentFailures) != 2 {
+		t.Fatalf("health history = %+v", health)
+	}
+
+	tooWideResp, err := app.Test(httptest.NewRequest(http.MethodGet, "/webhooks/health?hours=100000", nil))
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	if tooWideResp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("oversized window code = %d, want %d", tooWideResp.StatusCode, fiber.StatusBadRequest)
+	}
+
+	enableReq := httptest.NewRequest(http.MethodPut, "/webhooks/"+webhookID, strings.NewReader(`{"enabled":true,"ownerEmail":""}`))
+	enableReq.Header.Set("Content-Type", "application/json")
+	enableResp, err := app.Test(enableReq)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	var enableBody struct {
+		UpdatedData adminWebhookMutationResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(enableResp.Body).Decode(&enableBody); err != nil {
+		t.Fatalf("decode update response returned error: %v", err)
+	}
+	enabled := enableBody.UpdatedData.Webhook
+	if !enabled.Enabled || enabled.ConsecutiveFailures != 0 || enabled.FailingSince != nil || enabled.AutoDisabledAt != nil || enabled.OwnerEmail != nil {
+		t.Fatalf("re-enabled webhook = %+v, want health reset and owner cleared", enabled)
+	}
+}
@@ -0,0 +1,157 @@
+package adminwebhook
+
+import (
+	"strings"
+	"time"
+
+	adminCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/admincore"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
+
+	sql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	adminWebhookAttemptTargetType        = "webhook_attempt"
+	defaultAdminWebhookHealthWindowHours = 24
+	maxAdminWebhookHealthWindowHours     = 24 * 30
+	adminWebhookHealthRecentFailures     = 5
+)
+
+func parseAdminWebhookAttemptFilter(c fiber.Ctx) (postgresql.WebhookDeliveryAttemptFilter, error) {
+	filter := postgresql.WebhookDeliveryAttemptFilter{
+		EndpointID: strings.TrimSpace(c.Query("endpoint_id")),
+		ClientID:   strings.TrimSpace(c.Query("client_id")),
+		DeliveryID: strings.TrimSpace(c.Query("delivery_id")),
+		EventID:    strings.TrimSpace(c.Query("event_id")),
+	}
+	if rawType := strings.TrimSpace(c.Query("endpoint_type")); rawType != "" {
+		endpointType := webhookdeliveryattempt.EndpointType(rawType)
+		if err := webhookdeliveryattempt.EndpointTypeValidator(endpointType); err != nil {
+			return filter, fiber.NewError(fiber.StatusBadRequest, "invalid endpoint_type")
+		}
+		filter.EndpointType = endpointType
+	}
+	success, err := adminCoreModule.ParseOptionalBoolField(c.Query("success"), "success")
+	if err != nil {
+		return filter, err
+	}
+	filter.Success = success
+	from, err := platformTime.ParseFlexibleTime(c.Query("from"))
+	if err != nil {
+		return filter, err
+	}
+	to, err := platformTime.ParseFlexibleTime(c.Query("to"))
+	if err != nil {
+		return filter, err
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return filter, fiber.NewError(fiber.StatusBadRequest, "from must be before to")
+	}
+	filter.From, filter.To = from, to
+	return filter, nil
+}
+
+func parseAdminWebhookHealthWindowHours(raw string) (int, error) {
+	hours, err := platformPagination.ParsePositiveInt(raw, defaultAdminWebhookHealthWindowHours, "hours")
+	if err != nil {
+		return 0, err
+	}
+	if hours > maxAdminWebhookHealthWindowHours {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "hours exceeds max range")
+	}
+	return hours, nil
+}
+
+func handleListAdminWebhookAttempts(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		filter, err := parseAdminWebhookAttemptFilter(c)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionAttemptList, adminWebhookAttemptTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidAttemptFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid attempt filter")
+		}
+		page, pageSize, err := platformPagination.ParsePageAndPageSize(c, defaultAdminWebhookDeliveryPage, defaultAdminWebhookDeliveryPageSize, maxAdminWebhookDeliveryPageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionAttemptList, adminWebhookAttemptTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidAttemptFilter, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid pagination")
+		}
+
+		rows, total, err := apiHelper.DBManager.DB.ListWebhookDeliveryAttempts(c.Context(), filter, (page-1)*pageSize, pageSize)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionAttemptList, adminWebhookAttemptTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonQueryAttemptsFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query webhook attempts")
+		}
+
+		items := make([]adminWebhookAttemptItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminWebhookAttemptItem(row))
+		}
+		resp := adminWebhookAttemptListResponse{
+			GeneratedAt: adminWebhookNowUTC(),
+			Page:        page,
+			PageSize:    pageSize,
+			Total:       total,
+			TotalPages:  platformPagination.CalculateTotalPages(total, pageSize),
+			HasMore:     platformPagination.HasMoreByOffset(page, pageSize, total),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionAttemptList, adminWebhookAttemptTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"total": total,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleGetAdminWebhookHealth(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		windowHours, err := parseAdminWebhookHealthWindowHours(c.Query("hours"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionHealthQuery, adminWebhookTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidHealthWindow, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid hours")
+		}
+
+		query := apiHelper.DBManager.DB.WebhookEndpoint.Query().
+			Order(webhookendpoint.ByID(sql.OrderAsc()))
+		if endpointID := strings.TrimSpace(c.Query("endpoint_id")); endpointID != "" {
+			query = query.Where(webhookendpoint.IDEQ(endpointID))
+		}
+		rows, err := query.All(c.Context())
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionHealthQuery, adminWebhookTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonQueryWebhooksFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query webhooks")
+		}
+		endpointIDs := make([]string, 0, len(rows))
+		for _, row := range rows {
+			endpointIDs = append(endpointIDs, row.ID)
+		}
+
+		now := adminWebhookNowUTC()
+		since := now.Add(-time.Duration(windowHours) * time.Hour)
+		stats, err := apiHelper.DBManager.DB.GetWebhookEndpointHealthStats(c.Context(), webhookdeliveryattempt.EndpointTypeWebhook, endpointIDs, since, adminWebhookHealthRecentFailures)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionHealthQuery, adminWebhookTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonQueryHealthFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to query webhook health")
+		}
+
+		items := make([]adminWebhookHealthItem, 0, len(rows))
+		for _, row := range rows {
+			items = append(items, buildAdminWebhookHealthItem(row, stats[row.ID]))
+		}
+		resp := adminWebhookHealthResponse{
+			GeneratedAt: now,
+			WindowHours: windowHours,
+			Total:       len(items),
+			Items:       items,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionHealthQuery, adminWebhookTargetType, adminWebhookTargetIDAll, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"total":       resp.Total,
+			"windowHours": windowHours,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
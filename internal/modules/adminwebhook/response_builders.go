@@ -4,7 +4,9 @@ import (
 	webhookModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/webhook"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
 	"strings"
+	"time"
 )
 
 func buildAdminWebhookSettingsResponse(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) adminWebhookSettingsResponse {
@@ -26,11 +28,31 @@ func buildAdminWebhookItem(row *postgresql.WebhookEndpoint) adminWebhookItem {
 		bearer := strings.TrimSpace(*row.Bearer)
 		item.Bearer = &bearer
 	}
+	item.SigningSecretSet = row.SigningSecret != nil && *row.SigningSecret != ""
+	if row.PreviousSigningSecretExpiresAt != nil && row.PreviousSigningSecretExpiresAt.After(adminWebhookNowUTC()) {
+		expiresAt := row.PreviousSigningSecretExpiresAt.UTC()
+		item.PreviousSigningSecretExpiresAt = &expiresAt
+	}
+	if row.OwnerEmail != nil && strings.TrimSpace(*row.OwnerEmail) != "" {
+		ownerEmail := strings.TrimSpace(*row.OwnerEmail)
+		item.OwnerEmail = &ownerEmail
+	}
+	item.ConsecutiveFailures = row.ConsecutiveFailures
+	item.FailingSince = utcTimePtr(row.FailingSince)
+	item.AutoDisabledAt = utcTimePtr(row.AutoDisabledAt)
 	createdAt := row.CreatedAt.UTC()
 	item.CreatedAt = &createdAt
 	return item
 }
 
+func utcTimePtr(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+	utc := value.UTC()
+	return &utc
+}
+
 func buildAdminWebhookMutationResponse(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, row *postgresql.WebhookEndpoint) adminWebhookMutationResponse {
 	resp := adminWebhookMutationResponse{
 		Webhook:         buildAdminWebhookItem(row),
@@ -46,3 +68,74 @@ func buildAdminWebhookMutationResponse(apiHelper *harukiAPIHelper.HarukiToolboxR
 	}
 	return resp
 }
+
+func buildAdminWebhookDeliveryItem(row *postgresql.WebhookDelivery) adminWebhookDeliveryItem {
+	item := adminWebhookDeliveryItem{
+		ID:             row.ID,
+		EndpointType:   string(row.EndpointType),
+		EndpointID:     row.EndpointID,
+		ClientID:       row.ClientID,
+		CallbackURL:    row.CallbackURL,
+		GameUserID:     row.GameUserID,
+		Server:         row.Server,
+		DataType:       row.DataType,
+		Status:         string(row.Status),
+		Attempts:       row.Attempts,
+		MaxAttempts:    row.MaxAttempts,
+		LastStatusCode: row.LastStatusCode,
+		LastError:      row.LastError,
+		CreatedAt:      row.CreatedAt.UTC(),
+		UpdatedAt:      row.UpdatedAt.UTC(),
+	}
+	if row.Status == webhookdelivery.StatusPending {
+		nextAttemptAt := row.NextAttemptAt.UTC()
+		item.NextAttemptAt = &nextAttemptAt
+	}
+	if row.DeliveredAt != nil {
+		deliveredAt := row.DeliveredAt.UTC()
+		item.DeliveredAt = &deliveredAt
+	}
+	return item
+}
+
+func buildAdminWebhookAttemptItem(row *postgresql.WebhookDeliveryAttempt) adminWebhookAttemptItem {
+	return adminWebhookAttemptItem{
+		ID:           row.ID,
+		EndpointType: string(row.EndpointType),
+		EndpointID:   row.EndpointID,
+		ClientID:     row.ClientID,
+		DeliveryID:   row.DeliveryID,
+		EventID:      row.EventID,
+		Attempt:      row.Attempt,
+		Success:      row.Success,
+		StatusCode:   row.StatusCode,
+		LatencyMs:    row.LatencyMs,
+		Error:        row.Error,
+		CreatedAt:    row.CreatedAt.UTC(),
+	}
+}
+
+func buildAdminWebhookHealthItem(row *postgresql.WebhookEndpoint, stats *postgresql.WebhookEndpointHealthStats) adminWebhookHealthItem {
+	item := adminWebhookHealthItem{
+		WebhookID:           row.ID,
+		CallbackURL:         row.CallbackURL,
+		Enabled:             row.Enabled,
+		ConsecutiveFailures: row.ConsecutiveFailures,
+		FailingSince:        utcTimePtr(row.FailingSince),
+		AutoDisabledAt:      utcTimePtr(row.AutoDisabledAt),
+		RecentFailures:      []adminWebhookAttemptItem{},
+	}
+	if stats == nil {
+		return item
+	}
+	item.TotalAttempts = stats.TotalAttempts
+	item.SuccessfulAttempts = stats.SuccessfulAttempts
+	item.FailedAttempts = stats.FailedAttempts
+	item.SuccessRate = stats.SuccessRate
+	item.P95LatencyMs = stats.P95LatencyMs
+	item.LastSuccessAt = stats.LastSuccessAt
+	for _, failure := range stats.RecentFailures {
+		item.RecentFailures = append(item.RecentFailures, buildAdminWebhookAttemptItem(failure))
+	}
+	return item
+}
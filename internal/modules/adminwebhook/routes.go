@@ -11,10 +11,17 @@ func RegisterAdminWebhookRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHe
 
 	webhooks.Get("", handleListAdminWebhooks(apiHelper))
 	webhooks.Get("/settings", handleGetAdminWebhookSettings(apiHelper))
+	webhooks.Get("/deliveries", handleListAdminWebhookDeliveries(apiHelper))
+	webhooks.Get("/deliveries/:delivery_id", handleGetAdminWebhookDelivery(apiHelper))
+	webhooks.Get("/attempts", handleListAdminWebhookAttempts(apiHelper))
+	webhooks.Get("/health", handleGetAdminWebhookHealth(apiHelper))
 	webhooks.Get("/:webhook_id/subscribers", handleListAdminWebhookSubscribers(apiHelper))
 
 	webhooks.Post("", adminCoreModule.RequireSuperAdmin(apiHelper), handleCreateAdminWebhook(apiHelper))
 	webhooks.Put("/settings", adminCoreModule.RequireSuperAdmin(apiHelper), handleUpdateAdminWebhookSettings(apiHelper))
+	webhooks.Post("/deliveries/:delivery_id/replay", adminCoreModule.RequireSuperAdmin(apiHelper), handleReplayAdminWebhookDelivery(apiHelper))
+	webhooks.Delete("/deliveries", adminCoreModule.RequireSuperAdmin(apiHelper), handlePurgeAdminWebhookDeliveries(apiHelper))
 	webhooks.Put("/:webhook_id", adminCoreModule.RequireSuperAdmin(apiHelper), handleUpdateAdminWebhook(apiHelper))
+	webhooks.Post("/:webhook_id/signing-secret/rotate", adminCoreModule.RequireSuperAdmin(apiHelper), handleRotateAdminWebhookSigningSecret(apiHelper))
 	webhooks.Delete("/:webhook_id", adminCoreModule.RequireSuperAdmin(apiHelper), handleDeleteAdminWebhook(apiHelper))
 }
@@ -19,16 +19,23 @@ type adminWebhookPayload struct {
 	Bearer      *string `json:"bearer,omitempty"`
 	Enabled     *bool   `json:"enabled,omitempty"`
 	ClearBearer bool    `json:"clearBearer,omitempty"`
+	OwnerEmail  *string `json:"ownerEmail,omitempty"`
 }
 
 type adminWebhookItem struct {
-	ID                string     `json:"id"`
-	Credential        string     `json:"credential"`
-	CallbackURL       string     `json:"callbackUrl"`
-	Bearer            *string    `json:"bearer,omitempty"`
-	Enabled           bool       `json:"enabled"`
-	SubscriptionCount int        `json:"subscriptionCount"`
-	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	ID                             string     `json:"id"`
+	Credential                     string     `json:"credential"`
+	CallbackURL                    string     `json:"callbackUrl"`
+	Bearer                         *string    `json:"bearer,omitempty"`
+	Enabled                        bool       `json:"enabled"`
+	SigningSecretSet               bool       `json:"signingSecretSet"`
+	PreviousSigningSecretExpiresAt *time.Time `json:"previousSigningSecretExpiresAt,omitempty"`
+	OwnerEmail                     *string    `json:"ownerEmail,omitempty"`
+	ConsecutiveFailures            int        `json:"consecutiveFailures"`
+	FailingSince                   *time.Time `json:"failingSince,omitempty"`
+	AutoDisabledAt                 *time.Time `json:"autoDisabledAt,omitempty"`
+	SubscriptionCount              int        `json:"subscriptionCount"`
+	CreatedAt                      *time.Time `json:"createdAt,omitempty"`
 }
 
 type adminWebhookListResponse struct {
@@ -48,6 +55,18 @@ type adminWebhookMutationResponse struct {
 	Webhook         adminWebhookItem `json:"webhook"`
 	Token           string           `json:"token"`
 	TokenHeaderName string           `json:"tokenHeaderName"`
+	SigningSecret   string           `json:"signingSecret,omitempty"`
+}
+
+type adminWebhookRotateSecretPayload struct {
+	GracePeriodSeconds *int `json:"gracePeriodSeconds,omitempty"`
+}
+
+type adminWebhookRotateSecretResponse struct {
+	WebhookID                      string     `json:"webhookId"`
+	SigningSecret                  string     `json:"signingSecret"`
+	SignatureHeaderName            string     `json:"signatureHeaderName"`
+	PreviousSigningSecretExpiresAt *time.Time `json:"previousSigningSecretExpiresAt,omitempty"`
 }
 
 type adminWebhookSubscribersResponse struct {
@@ -56,3 +75,85 @@ type adminWebhookSubscribersResponse struct {
 	Total       int                          `json:"total"`
 	Items       []adminWebhookSubscriberItem `json:"items"`
 }
+
+type adminWebhookDeliveryItem struct {
+	ID             string     `json:"id"`
+	EndpointType   string     `json:"endpointType"`
+	EndpointID     string     `json:"endpointId"`
+	ClientID       *string    `json:"clientId,omitempty"`
+	CallbackURL    string     `json:"callbackUrl"`
+	GameUserID     string     `json:"gameUserId"`
+	Server         string     `json:"server"`
+	DataType       string     `json:"dataType"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	MaxAttempts    int        `json:"maxAttempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int       `json:"lastStatusCode,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+type adminWebhookDeliveryListResponse struct {
+	GeneratedAt time.Time                  `json:"generatedAt"`
+	Page        int                        `json:"page"`
+	PageSize    int                        `json:"pageSize"`
+	Total       int                        `json:"total"`
+	TotalPages  int                        `json:"totalPages"`
+	HasMore     bool                       `json:"hasMore"`
+	Items       []adminWebhookDeliveryItem `json:"items"`
+}
+
+type adminWebhookDeliveryPurgeResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+type adminWebhookAttemptItem struct {
+	ID           int       `json:"id"`
+	EndpointType string    `json:"endpointType"`
+	EndpointID   string    `json:"endpointId"`
+	ClientID     *string   `json:"clientId,omitempty"`
+	DeliveryID   string    `json:"deliveryId,omitempty"`
+	EventID      string    `json:"eventId,omitempty"`
+	Attempt      int       `json:"attempt"`
+	Success      bool      `json:"success"`
+	StatusCode   *int      `json:"statusCode,omitempty"`
+	LatencyMs    int64     `json:"latencyMs"`
+	Error        *string   `json:"error,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type adminWebhookAttemptListResponse struct {
+	GeneratedAt time.Time                 `json:"generatedAt"`
+	Page        int                       `json:"page"`
+	PageSize    int                       `json:"pageSize"`
+	Total       int                       `json:"total"`
+	TotalPages  int                       `json:"totalPages"`
+	HasMore     bool                      `json:"hasMore"`
+	Items       []adminWebhookAttemptItem `json:"items"`
+}
+
+type adminWebhookHealthItem struct {
+	WebhookID           string                    `json:"webhookId"`
+	CallbackURL         string                    `json:"callbackUrl"`
+	Enabled             bool                      `json:"enabled"`
+	ConsecutiveFailures int                       `json:"consecutiveFailures"`
+	FailingSince        *time.Time                `json:"failingSince,omitempty"`
+	AutoDisabledAt      *time.Time                `json:"autoDisabledAt,omitempty"`
+	TotalAttempts       int                       `json:"totalAttempts"`
+	SuccessfulAttempts  int                       `json:"successfulAttempts"`
+	FailedAttempts      int                       `json:"failedAttempts"`
+	SuccessRate         *float64                  `json:"successRate,omitempty"`
+	P95LatencyMs        *int64                    `json:"p95LatencyMs,omitempty"`
+	LastSuccessAt       *time.Time                `json:"lastSuccessAt,omitempty"`
+	RecentFailures      []adminWebhookAttemptItem `json:"recentFailures"`
+}
+
+type adminWebhookHealthResponse struct {
+	GeneratedAt time.Time                `json:"generatedAt"`
+	WindowHours int                      `json:"windowHours"`
+	Total       int                      `json:"total"`
+	Items       []adminWebhookHealthItem `json:"items"`
+}
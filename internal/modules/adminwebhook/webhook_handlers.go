@@ -7,6 +7,7 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
 	harukiHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
 	"strings"
+	"time"
 
 	sql "entgo.io/ent/dialect/sql"
 	"github.com/gofiber/fiber/v3"
@@ -94,11 +95,17 @@ func handleCreateAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 			}
 			credential = generatedCredential
 		}
+		signingSecret, err := harukiHandler.GenerateWebhookSigningSecret()
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionCreate, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonGenerateSigningSecretFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to create webhook")
+		}
 
 		builder := apiHelper.DBManager.DB.WebhookEndpoint.Create().
 			SetID(webhookID).
 			SetCredential(credential).
-			SetCallbackURL(callbackURL)
+			SetCallbackURL(callbackURL).
+			SetSigningSecret(signingSecret)
 		if payload.Enabled == nil {
 			builder.SetEnabled(true)
 		} else {
@@ -107,6 +114,12 @@ func handleCreateAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 		if bearer := sanitizeOptionalBearer(payload.Bearer); bearer != nil {
 			builder.SetBearer(*bearer)
 		}
+		ownerEmail, err := sanitizeWebhookOwnerEmail(payload.OwnerEmail)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionCreate, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidOwnerEmail, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid ownerEmail")
+		}
+		builder.SetNillableOwnerEmail(ownerEmail)
 
 		created, err := builder.Save(c.Context())
 		if err != nil {
@@ -119,6 +132,7 @@ func handleCreateAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 		}
 
 		resp := buildAdminWebhookMutationResponse(apiHelper, created)
+		resp.SigningSecret = signingSecret
 		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionCreate, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
 			"generatedID":         payload.ID == nil || strings.TrimSpace(*payload.ID) == "",
 			"generatedCredential": payload.Credential == nil || strings.TrimSpace(*payload.Credential) == "",
@@ -173,6 +187,11 @@ func handleUpdateAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 		}
 		if payload.Enabled != nil {
 			update.SetEnabled(*payload.Enabled)
+			if *payload.Enabled {
+				// Re-enabling starts a fresh failure streak; otherwise the
+				// next failed attempt would switch the endpoint off again.
+				update.SetConsecutiveFailures(0).ClearFailingSince().ClearAutoDisabledAt()
+			}
 			changed = true
 		}
 		if payload.ClearBearer {
@@ -186,6 +205,19 @@ func handleUpdateAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 			}
 			changed = true
 		}
+		if payload.OwnerEmail != nil {
+			ownerEmail, err := sanitizeWebhookOwnerEmail(payload.OwnerEmail)
+			if err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionUpdate, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidOwnerEmail, nil))
+				return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid ownerEmail")
+			}
+			if ownerEmail != nil {
+				update.SetOwnerEmail(*ownerEmail)
+			} else {
+				update.ClearOwnerEmail()
+			}
+			changed = true
+		}
 		if !changed {
 			resp := buildAdminWebhookMutationResponse(apiHelper, current)
 			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionUpdate, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
@@ -211,6 +243,7 @@ func handleUpdateAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 			"updatedCallback":   payload.CallbackURL != nil,
 			"updatedEnabled":    payload.Enabled != nil,
 			"updatedBearer":     payload.ClearBearer || payload.Bearer != nil,
+			"updatedOwnerEmail": payload.OwnerEmail != nil,
 		})
 		return harukiAPIHelper.SuccessResponse(c, "webhook updated", &resp)
 	}
@@ -238,3 +271,58 @@ func handleDeleteAdminWebhook(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 		return harukiAPIHelper.SuccessResponse[string](c, "webhook deleted", nil)
 	}
 }
+
+func handleRotateAdminWebhookSigningSecret(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		webhookID, err := sanitizeWebhookID(c.Params("webhook_id"))
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, "", harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidWebhookID, nil))
+			return adminCoreModule.RespondFiberOrBadRequest(c, err, "invalid webhook_id")
+		}
+
+		var payload adminWebhookRotateSecretPayload
+		if len(c.Body()) > 0 {
+			if err := c.Bind().Body(&payload); err != nil {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidRequestPayload, nil))
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+			}
+		}
+		gracePeriod, err := harukiHandler.ResolveWebhookSigningSecretGracePeriod(payload.GracePeriodSeconds)
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonInvalidGracePeriod, nil))
+			return harukiAPIHelper.ErrorBadRequest(c, err.Error())
+		}
+		signingSecret, err := harukiHandler.GenerateWebhookSigningSecret()
+		if err != nil {
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonGenerateSigningSecretFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to rotate webhook signing secret")
+		}
+
+		var graceUntil *time.Time
+		if gracePeriod > 0 {
+			until := adminWebhookNowUTC().Add(gracePeriod)
+			graceUntil = &until
+		}
+		updated, err := apiHelper.DBManager.DB.RotateWebhookEndpointSigningSecret(c.Context(), webhookID, signingSecret, graceUntil)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonWebhookNotFound, nil))
+				return harukiAPIHelper.ErrorNotFound(c, "webhook not found")
+			}
+			adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultFailure, adminCoreModule.AdminFailureMetadata(adminWebhookFailureReasonRotateSigningSecretFailed, nil))
+			return harukiAPIHelper.ErrorInternal(c, "failed to rotate webhook signing secret")
+		}
+
+		resp := adminWebhookRotateSecretResponse{
+			WebhookID:                      updated.ID,
+			SigningSecret:                  signingSecret,
+			SignatureHeaderName:            harukiHandler.WebhookSignatureHeader,
+			PreviousSigningSecretExpiresAt: updated.PreviousSigningSecretExpiresAt,
+		}
+		adminCoreModule.WriteAdminAuditLog(c, apiHelper, adminWebhookActionRotateSecret, adminWebhookTargetType, webhookID, harukiAPIHelper.SystemLogResultSuccess, map[string]any{
+			"gracePeriodSeconds": int(gracePeriod / time.Second),
+			"previousRetained":   updated.PreviousSigningSecretExpiresAt != nil,
+		})
+		return harukiAPIHelper.SuccessResponse(c, "webhook signing secret rotated", &resp)
+	}
+}
@@ -0,0 +1,43 @@
+package harukibotneo
+
+import (
+	"strings"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/authheader"
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const botIDLocalKey = "botID"
+
+// requireBotCredential authenticates a bot by the credential token it got at
+// registration, sent as "Authorization: Bearer <token>", and records the
+// login in the bot's login history.
+func requireBotCredential(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			harukiLogger.Errorf("bot database is not configured")
+			return harukiAPIHelper.ErrorInternal(c, "bot service unavailable")
+		}
+		token, ok := authheader.ExtractBearerToken(c.Get(fiber.HeaderAuthorization))
+		if !ok || strings.TrimSpace(apiHelper.BotCredentialSignToken) == "" {
+			return harukiAPIHelper.ErrorUnauthorized(c, "missing bot credential")
+		}
+		bot, err := verifyBotCredential(c.Context(), apiHelper.DBManager.BotDB, apiHelper.BotCredentialSignToken, token)
+		if err != nil {
+			harukiLogger.Errorf("Failed to verify bot credential: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "bot service unavailable")
+		}
+		if bot == nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "invalid bot credential")
+		}
+		if _, err := platformBotAccount.RecordLogin(c.Context(), apiHelper.DBManager.BotDB, bot.BotID, c.IP(), "", platformBotAccount.LoginSourceToolbox, accountNow().UTC()); err != nil {
+			harukiLogger.Warnf("Failed to record bot login: %v", err)
+		}
+		c.Locals(botIDLocalKey, bot.BotID)
+		return c.Next()
+	}
+}
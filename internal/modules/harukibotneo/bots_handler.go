@@ -0,0 +1,205 @@
+package harukibotneo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
+	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+var accountNow = time.Now
+
+// resolveOwnedBot checks that the :bot_id route parameter names a bot
+// registered with one of the current user's verified QQ numbers. Bots of
+// other owners are reported as not found.
+func resolveOwnedBot(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID string) (int, error) {
+	botID, err := strconv.Atoi(strings.TrimSpace(c.Params("bot_id")))
+	if err != nil || botID <= 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "bot_id must be a positive integer")
+	}
+	botIDs, err := loadOwnedBotIDs(c.Context(), apiHelper.DBManager.DB, apiHelper.DBManager.BotDB, userID)
+	if err != nil {
+		return 0, err
+	}
+	selected, err := selectOwnedBotIDs(strconv.Itoa(botID), botIDs)
+	if err != nil {
+		return 0, err
+	}
+	return selected[0], nil
+}
+
+func respondBotAccountError(c fiber.Ctx, err error, fallbackMessage string) error {
+	if _, ok := err.(*fiber.Error); ok {
+		return respondFiberOrBadRequest(c, err, fallbackMessage)
+	}
+	harukiLogger.Errorf("%s: %v", fallbackMessage, err)
+	return harukiAPIHelper.ErrorInternal(c, fallbackMessage)
+}
+
+func handleListOwnerBots(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			harukiLogger.Errorf("bot database is not configured")
+			return harukiAPIHelper.ErrorInternal(c, "bot service unavailable")
+		}
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		ctx := c.Context()
+
+		botIDs, err := loadOwnedBotIDs(ctx, apiHelper.DBManager.DB, apiHelper.DBManager.BotDB, userID)
+		if err != nil {
+			harukiLogger.Errorf("Failed to query owned bots: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bot registration")
+		}
+		bots, err := platformBotAccount.ListBots(ctx, apiHelper.DBManager.BotDB, botIDs)
+		if err != nil {
+			harukiLogger.Errorf("Failed to list owned bots: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bot registration")
+		}
+		resp := ownerBotsResponse{Bots: bots, MaxBotsPerOwner: platformBotAccount.MaxBotsPerOwner}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
+func handleRotateBotCredential(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			harukiLogger.Errorf("bot database is not configured")
+			return harukiAPIHelper.ErrorInternal(c, "bot service unavailable")
+		}
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		botID := 0
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.haruki_bot.credential_rotate", result, userID, map[string]any{
+				"reason": reason,
+				"botID":  botID,
+			})
+		}()
+
+		botID, err = resolveOwnedBot(c, apiHelper, userID)
+		if err != nil {
+			reason = "bot_not_owned"
+			return respondBotAccountError(c, err, "failed to query bot registration")
+		}
+		credentialPlain, err := platformBotAccount.RotateCredential(c.Context(), apiHelper.DBManager.BotDB, botID, accountNow().UTC())
+		if err != nil {
+			reason = "rotate_failed"
+			return respondBotAccountError(c, err, "failed to rotate credential")
+		}
+		botIDStr := strconv.Itoa(botID)
+		credentialJWT, err := signCredentialJWT(apiHelper.BotCredentialSignToken, botIDStr, credentialPlain)
+		if err != nil {
+			reason = "sign_failed"
+			harukiLogger.Errorf("Failed to sign credential JWT: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to sign credential")
+		}
+
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		resp := registrationResultData{BotID: botIDStr, Credential: credentialJWT}
+		c.Set(fiber.HeaderCacheControl, "no-store")
+		return harukiAPIHelper.SuccessResponse(c, "credential rotated; it is shown only once", &resp)
+	}
+}
+
+func handleRevokeBot(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			harukiLogger.Errorf("bot database is not configured")
+			return harukiAPIHelper.ErrorInternal(c, "bot service unavailable")
+		}
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		botID := 0
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.haruki_bot.revoke", result, userID, map[string]any{
+				"reason": reason,
+				"botID":  botID,
+			})
+		}()
+
+		var payload botStatusPayload
+		if len(c.Body()) > 0 {
+			if err := c.Bind().JSON(&payload); err != nil {
+				reason = "invalid_body"
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid request body")
+			}
+		}
+		statusReason, err := platformBotAccount.NormalizeReason(payload.Reason)
+		if err != nil {
+			reason = "invalid_reason"
+			return respondFiberOrBadRequest(c, err, "invalid reason")
+		}
+		botID, err = resolveOwnedBot(c, apiHelper, userID)
+		if err != nil {
+			reason = "bot_not_owned"
+			return respondBotAccountError(c, err, "failed to query bot registration")
+		}
+		if err := platformBotAccount.Revoke(c.Context(), apiHelper.DBManager.BotDB, botID, userID, statusReason, accountNow().UTC()); err != nil {
+			reason = "revoke_failed"
+			return respondBotAccountError(c, err, "failed to revoke bot")
+		}
+		row, err := platformBotAccount.GetBot(c.Context(), apiHelper.DBManager.BotDB, botID)
+		if err != nil {
+			reason = "reload_failed"
+			return respondBotAccountError(c, err, "failed to query bot registration")
+		}
+
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		bot := platformBotAccount.BotFromRow(row)
+		return harukiAPIHelper.SuccessResponse(c, "bot revoked", &bot)
+	}
+}
+
+func handleListBotLogins(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			harukiLogger.Errorf("bot database is not configured")
+			return harukiAPIHelper.ErrorInternal(c, "bot service unavailable")
+		}
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		limit, err := platformPagination.ParsePositiveInt(c.Query("limit"), platformBotAccount.DefaultLoginLimit, "limit")
+		if err != nil {
+			return respondFiberOrBadRequest(c, err, "invalid limit")
+		}
+		if limit > platformBotAccount.MaxLoginLimit {
+			return harukiAPIHelper.ErrorBadRequest(c, "limit exceeds max allowed value")
+		}
+		botID, err := resolveOwnedBot(c, apiHelper, userID)
+		if err != nil {
+			return respondBotAccountError(c, err, "failed to query bot registration")
+		}
+		row, err := platformBotAccount.GetBot(c.Context(), apiHelper.DBManager.BotDB, botID)
+		if err != nil {
+			return respondBotAccountError(c, err, "failed to query bot registration")
+		}
+		logins, err := platformBotAccount.ListLogins(c.Context(), apiHelper.DBManager.BotDB, row, limit)
+		if err != nil {
+			return respondBotAccountError(c, err, "failed to query login history")
+		}
+		resp := botLoginsResponse{BotID: botID, Logins: logins}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
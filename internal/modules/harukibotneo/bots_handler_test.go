@@ -0,0 +1,156 @@
+package harukibotneo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	neopgEnttest "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestSelectRegistrationTarget(t *testing.T) {
+	one := []*neopg.User{{BotID: 10000001}}
+	two := []*neopg.User{{BotID: 10000001}, {BotID: 10000002}}
+	full := make([]*neopg.User, platformBotAccount.MaxBotsPerOwner)
+
+	tests := []struct {
+		name      string
+		owned     []*neopg.User
+		botID     int
+		newBot    bool
+		wantBotID int
+		wantCode  int
+	}{
+		{name: "first registration creates", owned: nil},
+		{name: "single bot is reset", owned: one, wantBotID: 10000001},
+		{name: "several bots need bot_id", owned: two, wantCode: fiber.StatusConflict},
+		{name: "bot_id picks the bot", owned: two, botID: 10000002, wantBotID: 10000002},
+		{name: "foreign bot_id", owned: two, botID: 10000003, wantCode: fiber.StatusNotFound},
+		{name: "new_bot creates another", owned: one, newBot: true},
+		{name: "new_bot respects the cap", owned: full, newBot: true, wantCode: fiber.StatusConflict},
+		{name: "bot_id with new_bot", owned: one, botID: 10000001, newBot: true, wantCode: fiber.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := selectRegistrationTarget(tt.owned, tt.botID, tt.newBot)
+			if tt.wantCode != 0 {
+				if fiberErr, ok := err.(*fiber.Error); !ok || fiberErr.Code != tt.wantCode {
+					t.Fatalf("error = %v, want fiber error %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			gotBotID := 0
+			if target != nil {
+				gotBotID = target.BotID
+			}
+			if gotBotID != tt.wantBotID {
+				t.Fatalf("target bot = %d, want %d", gotBotID, tt.wantBotID)
+			}
+		})
+	}
+}
+
+func TestOwnerBotEndpointsRotateAndRevoke(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:bot-owner-lifecycle-test?mode=memory&cache=shared&_fk=1")
+	botDB := neopgEnttest.Open(t, "sqlite3", "file:bot-owner-lifecycle-bot-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = db.Close()
+		_ = botDB.Close()
+	})
+	ctx := t.Context()
+	if _, err := db.User.Create().SetID("owner").SetName("owner").SetEmail("owner@example.com").Save(ctx); err != nil {
+		t.Fatalf("failed to seed user: %v", err)
+	}
+	if _, err := db.SocialPlatformInfo.Create().SetPlatform("qq").SetPlatformUserID("111").SetVerified(true).SetUserID("owner").Save(ctx); err != nil {
+		t.Fatalf("failed to seed qq binding: %v", err)
+	}
+	for ownerQQ, botID := range map[int64]int{111: 10000001, 222: 10000002} {
+		if _, err := botDB.User.Create().SetOwnerUserID(ownerQQ).SetBotID(botID).SetCredential("hash").Save(ctx); err != nil {
+			t.Fatalf("failed to seed bot: %v", err)
+		}
+	}
+
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:              &database.HarukiToolboxDBManager{DB: db, BotDB: botDB},
+		BotCredentialSignToken: "sign-token",
+	}
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "owner")
+		return c.Next()
+	})
+	app.Get("/bots", handleListOwnerBots(helper))
+	app.Post("/bots/:bot_id/credential/rotate", handleRotateBotCredential(helper))
+	app.Post("/bots/:bot_id/revoke", handleRevokeBot(helper))
+	app.Get("/bots/:bot_id/logins", handleListBotLogins(helper))
+
+	do := func(method, path, body string) (*http.Response, map[string]any) {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		var decoded map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+		return resp, decoded
+	}
+
+	resp, body := do(http.MethodGet, "/bots", "")
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("list status = %d", resp.StatusCode)
+	}
+	bots := body["updatedData"].(map[string]any)["bots"].([]any)
+	if len(bots) != 1 || bots[0].(map[string]any)["botId"].(float64) != 10000001 {
+		t.Fatalf("listed bots = %v, want only 10000001", bots)
+	}
+
+	if resp, _ := do(http.MethodPost, "/bots/10000002/credential/rotate", ""); resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("rotating a foreign bot status = %d, want %d", resp.StatusCode, fiber.StatusNotFound)
+	}
+
+	resp, body = do(http.MethodPost, "/bots/10000001/credential/rotate", "")
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("rotate status = %d", resp.StatusCode)
+	}
+	if resp.Header.Get(fiber.HeaderCacheControl) != "no-store" {
+		t.Fatalf("rotate response must not be cached")
+	}
+	token := body["updatedData"].(map[string]any)["credential"].(string)
+	bot, err := verifyBotCredential(ctx, botDB, "sign-token", token)
+	if err != nil || bot == nil || bot.BotID != 10000001 {
+		t.Fatalf("rotated credential should authenticate, got %v, %v", bot, err)
+	}
+
+	if resp, _ := do(http.MethodPost, "/bots/10000001/revoke", `{"reason":"leaked"}`); resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("revoke status = %d", resp.StatusCode)
+	}
+	if bot, err := verifyBotCredential(ctx, botDB, "sign-token", token); err != nil || bot != nil {
+		t.Fatalf("revoked bot should not authenticate, got %v, %v", bot, err)
+	}
+	if resp, _ := do(http.MethodPost, "/bots/10000001/credential/rotate", ""); resp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("rotating a revoked bot status = %d, want %d", resp.StatusCode, fiber.StatusConflict)
+	}
+
+	if resp, _ := do(http.MethodGet, "/bots/10000001/logins?limit=500", ""); resp.StatusCode != fiber.StatusBadRequest {
+		t.Fatalf("oversized limit status = %d, want %d", resp.StatusCode, fiber.StatusBadRequest)
+	}
+	if resp, _ := do(http.MethodGet, "/bots/10000001/logins", ""); resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("logins status = %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,17 @@
+package harukibotneo
+
+import (
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func respondFiberOrBadRequest(c fiber.Ctx, err error, fallbackMessage string) error {
+	if fiberErr, ok := err.(*fiber.Error); ok {
+		return c.Status(fiberErr.Code).JSON(fiber.Map{
+			"status":  fiberErr.Code,
+			"message": fiberErr.Message,
+		})
+	}
+	return harukiAPIHelper.ErrorBadRequest(c, fallbackMessage)
+}
@@ -18,7 +18,8 @@ const (
 	botIDMax     = 99999999
 	botIDRetries = 10
 
-	credentialBytes = 32
+	defaultStatsWindow = 7 * 24 * time.Hour
+	maxStatsRange      = 90 * 24 * time.Hour
 
 	rateLimitLimitedByNone   = int64(0)
 	rateLimitLimitedByIP     = int64(1)
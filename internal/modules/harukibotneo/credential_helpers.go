@@ -7,8 +7,10 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
 	botUser "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 	"math/big"
+	"strconv"
 
 	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func generateCode() (string, error) {
@@ -47,3 +49,48 @@ func signCredentialJWT(secret, botID, credential string) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(secret))
 }
+
+// parseCredentialJWT checks the signature of a credential issued by
+// signCredentialJWT and returns its bot ID and plaintext credential.
+func parseCredentialJWT(secret, token string) (int, string, error) {
+	claims := jwt.MapClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !parsed.Valid {
+		return 0, "", fmt.Errorf("invalid credential token")
+	}
+	botIDStr, _ := claims["bot_id"].(string)
+	credential, _ := claims["credential"].(string)
+	botID, err := strconv.Atoi(botIDStr)
+	if err != nil || botID <= 0 || credential == "" {
+		return 0, "", fmt.Errorf("invalid credential claims")
+	}
+	return botID, credential, nil
+}
+
+// verifyBotCredential returns the bot the credential token belongs to, or
+// nil when the token is invalid, was replaced by a later registration or
+// rotation, or the bot is revoked or suspended.
+func verifyBotCredential(ctx context.Context, botDB *neopg.Client, secret, token string) (*neopg.User, error) {
+	botID, credential, err := parseCredentialJWT(secret, token)
+	if err != nil {
+		return nil, nil
+	}
+	bot, err := botDB.User.Query().
+		Where(botUser.BotIDEQ(botID)).
+		Only(ctx)
+	if err != nil {
+		if neopg.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if bot.Status != botUser.StatusActive || bot.Credential == "" {
+		return nil, nil
+	}
+	if bcrypt.CompareHashAndPassword([]byte(bot.Credential), []byte(credential)) != nil {
+		return nil, nil
+	}
+	return bot, nil
+}
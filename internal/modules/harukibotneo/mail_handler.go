@@ -1,13 +1,13 @@
 package harukibotneo
 
 import (
+	"errors"
 	"fmt"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
 	"strconv"
-	"strings"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -59,12 +59,16 @@ func handleSendMail(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber
 
 		// Send email
 		email := fmt.Sprintf("%s@qq.com", qqStr)
-		body := strings.ReplaceAll(smtp.VerificationCodeTemplate, "{{CODE}}", code)
-		if err := apiHelper.SMTPClient.Send([]string{email}, "您的验证码 | Haruki Bot", body, "Haruki Bot | 星云科技"); err != nil {
+		vars := map[string]string{"CODE": code, "PRODUCT": "Haruki Bot"}
+		locale := smtp.LocaleFromAcceptLanguage(c.Get("Accept-Language"))
+		if err := apiHelper.SMTPClient.SendTemplate([]string{email}, smtp.TemplateVerificationCode, locale, vars, "Haruki Bot | 星云科技"); err != nil {
 			if delErr := apiHelper.DBManager.Redis.DeleteCache(ctx, redisKey); delErr != nil {
 				harukiLogger.Warnf("Failed to rollback verification code for QQ %s: %v", qqStr, delErr)
 			}
 			releaseSendMailRateLimit(c, apiHelper, clientIP, qqStr)
+			if errors.Is(err, smtp.ErrRecipientSuppressed) {
+				return harukiAPIHelper.ErrorBadRequest(c, "email address cannot receive mail")
+			}
 			harukiLogger.Errorf("Failed to send email to %s: %v", email, err)
 			return harukiAPIHelper.ErrorInternal(c, "failed to send verification email")
 		}
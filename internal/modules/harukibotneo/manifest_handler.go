@@ -0,0 +1,66 @@
+package harukibotneo
+
+import (
+	"strings"
+
+	platformBotManifest "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botmanifest"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// etagMatches reports whether an If-None-Match header lists etag. Weak
+// validators match too, since the manifest body is compared as a whole.
+func etagMatches(header, etag string) bool {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func buildManifestResponse(manifest *platformBotManifest.Manifest) manifestResponse {
+	commands := make([]manifestCommand, 0, len(manifest.Entries))
+	for _, entry := range manifest.Entries {
+		commands = append(commands, manifestCommand{
+			CommandPrefixes:         entry.Prefixes,
+			CommandPriority:         entry.Priority,
+			CommandMode:             entry.Mode,
+			CommandModule:           entry.Module,
+			CommandPath:             entry.Path,
+			CommandAdditionalParams: entry.AdditionalParams,
+		})
+	}
+	return manifestResponse{Version: manifest.Version, ETag: manifest.ETag, Commands: commands}
+}
+
+// handleGetManifest serves the live command manifest to bots. Bots should
+// poll with the ETag they last saw in If-None-Match and get 304 until an
+// admin changes the manifest.
+func handleGetManifest(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		manifest, err := platformBotManifest.Load(c.Context(), apiHelper.DBManager.BotDB)
+		if err != nil {
+			harukiLogger.Errorf("Failed to load command manifest: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to load command manifest")
+		}
+		etag := `"` + manifest.ETag + `"`
+		c.Set(fiber.HeaderETag, etag)
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		if etagMatches(c.Get(fiber.HeaderIfNoneMatch), etag) {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+		resp := buildManifestResponse(manifest)
+		return harukiAPIHelper.SuccessResponse(c, "ok", &resp)
+	}
+}
@@ -0,0 +1,87 @@
+package harukibotneo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	neopgEnttest "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+
+	"github.com/gofiber/fiber/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHandleGetManifestRequiresCredentialAndHonoursETag(t *testing.T) {
+	botDB := neopgEnttest.Open(t, "sqlite3", "file:bot-manifest-feed-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = botDB.Close()
+	})
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret-credential"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash credential: %v", err)
+	}
+	if _, err := botDB.User.Create().SetOwnerUserID(111).SetBotID(10000001).SetCredential(string(hash)).Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed bot: %v", err)
+	}
+	if _, err := botDB.CommandManifest.Create().
+		SetCommandPrefixes([]string{"/card"}).
+		SetCommandMode("GET").
+		SetCommandModule("pjsk").
+		SetCommandPath("card").
+		Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed manifest: %v", err)
+	}
+
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:              &database.HarukiToolboxDBManager{BotDB: botDB},
+		BotCredentialSignToken: "sign-token",
+	}
+	app := fiber.New()
+	app.Get("/manifest", requireBotCredential(helper), handleGetManifest(helper))
+
+	valid, err := signCredentialJWT("sign-token", "10000001", "secret-credential")
+	if err != nil {
+		t.Fatalf("failed to sign credential: %v", err)
+	}
+	stale, _ := signCredentialJWT("sign-token", "10000001", "old-credential")
+	forged, _ := signCredentialJWT("other-token", "10000001", "secret-credential")
+
+	get := func(token, ifNoneMatch string) *http.Response {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/manifest", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		return resp
+	}
+
+	for name, token := range map[string]string{"missing": "", "stale": stale, "forged": forged} {
+		if resp := get(token, ""); resp.StatusCode != fiber.StatusUnauthorized {
+			t.Fatalf("%s credential status = %d, want %d", name, resp.StatusCode, fiber.StatusUnauthorized)
+		}
+	}
+
+	resp := get(valid, "")
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	etag := resp.Header.Get("ETag")
+	if len(etag) != 66 {
+		t.Fatalf("ETag = %q, want a quoted sha256", etag)
+	}
+	if resp := get(valid, `W/"other", `+etag); resp.StatusCode != fiber.StatusNotModified {
+		t.Fatalf("matching If-None-Match status = %d, want %d", resp.StatusCode, fiber.StatusNotModified)
+	}
+	if resp := get(valid, `"other"`); resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("stale If-None-Match status = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+}
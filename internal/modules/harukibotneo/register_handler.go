@@ -1,9 +1,9 @@
 package harukibotneo
 
 import (
-	"crypto/rand"
 	"crypto/subtle"
-	"encoding/base64"
+	"fmt"
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
 	botUser "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
@@ -13,7 +13,6 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
-	"golang.org/x/crypto/bcrypt"
 )
 
 func handleRegister(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
@@ -81,56 +80,72 @@ func handleRegister(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber
 			return harukiAPIHelper.ErrorBadRequest(c, "verification code not found or expired")
 		}
 
-		// Generate new credential
-		credentialRaw := make([]byte, credentialBytes)
-		if _, err := rand.Read(credentialRaw); err != nil {
-			harukiLogger.Errorf("Failed to generate credential: %v", err)
-			return harukiAPIHelper.ErrorInternal(c, "failed to generate credential")
+		name, err := platformBotAccount.NormalizeName(payload.Name)
+		if err != nil {
+			return respondFiberOrBadRequest(c, err, "invalid name")
 		}
-		credentialPlain := base64.URLEncoding.EncodeToString(credentialRaw)
-
-		hashedCredential, err := bcrypt.GenerateFromPassword([]byte(credentialPlain), bcrypt.DefaultCost)
+		requestedBotID, err := parseRequestedBotID(payload.BotID)
 		if err != nil {
-			harukiLogger.Errorf("Failed to hash credential: %v", err)
-			return harukiAPIHelper.ErrorInternal(c, "failed to process credential")
+			return respondFiberOrBadRequest(c, err, "invalid bot_id")
 		}
 
-		// Check if already registered — update credential if so, otherwise create new
-		existing, err := apiHelper.DBManager.BotDB.User.Query().
-			Where(botUser.OwnerUserIDEQ(payload.QQNumber)).
-			Only(ctx)
-		if err != nil && !neopg.IsNotFound(err) {
+		ownedBots, err := platformBotAccount.ListOwnerBots(ctx, apiHelper.DBManager.BotDB, payload.QQNumber)
+		if err != nil {
 			harukiLogger.Errorf("Failed to check bot registration: %v", err)
 			return harukiAPIHelper.ErrorInternal(c, "registration service unavailable")
 		}
+		existing, err := selectRegistrationTarget(ownedBots, requestedBotID, payload.NewBot)
+		if err != nil {
+			return respondFiberOrBadRequest(c, err, "invalid registration request")
+		}
 
 		var botIDStr string
+		var credentialPlain string
 		var statusCode int
 		var message string
 
 		if existing != nil {
-			// Update existing credential
-			_, err = existing.Update().
-				SetCredential(string(hashedCredential)).
-				Save(ctx)
+			// Reset the credential of an existing bot
+			credentialPlain, err = platformBotAccount.RotateCredential(ctx, apiHelper.DBManager.BotDB, existing.BotID, accountNow().UTC())
 			if err != nil {
+				if _, ok := err.(*fiber.Error); ok {
+					return respondFiberOrBadRequest(c, err, "failed to update credential")
+				}
 				harukiLogger.Errorf("Failed to update bot credential: %v", err)
 				return harukiAPIHelper.ErrorInternal(c, "failed to update credential")
 			}
+			if name != "" {
+				if err := apiHelper.DBManager.BotDB.User.Update().
+					Where(botUser.BotIDEQ(existing.BotID)).
+					SetName(name).
+					Exec(ctx); err != nil {
+					harukiLogger.Warnf("Failed to update bot name: %v", err)
+				}
+			}
 			botIDStr = strconv.Itoa(existing.BotID)
 			statusCode = fiber.StatusOK
 			message = "credential reset successful"
 		} else {
+			var hashedCredential string
+			credentialPlain, hashedCredential, err = platformBotAccount.NewCredential()
+			if err != nil {
+				harukiLogger.Errorf("Failed to generate credential: %v", err)
+				return harukiAPIHelper.ErrorInternal(c, "failed to generate credential")
+			}
 			// Generate bot_id for new registration
 			botID, err := generateUniqueBotID(ctx, apiHelper.DBManager.BotDB)
 			if err != nil {
 				harukiLogger.Errorf("Failed to generate bot_id: %v", err)
 				return harukiAPIHelper.ErrorInternal(c, "failed to generate bot ID")
 			}
+			now := accountNow().UTC()
 			_, err = apiHelper.DBManager.BotDB.User.Create().
 				SetOwnerUserID(payload.QQNumber).
 				SetBotID(botID).
-				SetCredential(string(hashedCredential)).
+				SetName(name).
+				SetCredential(hashedCredential).
+				SetCredentialRotatedAt(now).
+				SetCreatedAt(now).
 				Save(ctx)
 			if err != nil {
 				harukiLogger.Errorf("Failed to create bot registration: %v", err)
@@ -156,6 +171,47 @@ func handleRegister(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber
 			BotID:      botIDStr,
 			Credential: credentialJWT,
 		}
+		c.Set(fiber.HeaderCacheControl, "no-store")
 		return harukiAPIHelper.UpdatedDataResponse(c, statusCode, message, &result)
 	}
 }
+
+func parseRequestedBotID(raw string) (int, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return 0, nil
+	}
+	botID, err := strconv.Atoi(trimmed)
+	if err != nil || botID <= 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "bot_id must be a positive integer")
+	}
+	return botID, nil
+}
+
+// selectRegistrationTarget decides whether a registration resets the
+// credential of one of the owner's bots or creates a new one. Without
+// bot_id an owner with exactly one bot keeps the old behaviour of resetting
+// it; an owner with several has to say which one.
+func selectRegistrationTarget(ownedBots []*neopg.User, requestedBotID int, newBot bool) (*neopg.User, error) {
+	if requestedBotID > 0 {
+		if newBot {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "bot_id and new_bot cannot be used together")
+		}
+		for _, bot := range ownedBots {
+			if bot.BotID == requestedBotID {
+				return bot, nil
+			}
+		}
+		return nil, fiber.NewError(fiber.StatusNotFound, "bot not found for this qq_number")
+	}
+	if newBot || len(ownedBots) == 0 {
+		if len(ownedBots) >= platformBotAccount.MaxBotsPerOwner {
+			return nil, fiber.NewError(fiber.StatusConflict, fmt.Sprintf("at most %d bots can be registered per qq_number", platformBotAccount.MaxBotsPerOwner))
+		}
+		return nil, nil
+	}
+	if len(ownedBots) > 1 {
+		return nil, fiber.NewError(fiber.StatusConflict, "multiple bots are registered for this qq_number; specify bot_id or new_bot")
+	}
+	return ownedBots[0], nil
+}
@@ -15,4 +15,28 @@ func RegisterHarukiBotNeoRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHe
 		apiHelper.SessionHandler.VerifySessionToken,
 		handleRegister(apiHelper),
 	)
+	botAPI.Get("/stats",
+		apiHelper.SessionHandler.VerifySessionToken,
+		handleGetOwnerStats(apiHelper),
+	)
+	botAPI.Get("/bots",
+		apiHelper.SessionHandler.VerifySessionToken,
+		handleListOwnerBots(apiHelper),
+	)
+	botAPI.Post("/bots/:bot_id/credential/rotate",
+		apiHelper.SessionHandler.VerifySessionToken,
+		handleRotateBotCredential(apiHelper),
+	)
+	botAPI.Post("/bots/:bot_id/revoke",
+		apiHelper.SessionHandler.VerifySessionToken,
+		handleRevokeBot(apiHelper),
+	)
+	botAPI.Get("/bots/:bot_id/logins",
+		apiHelper.SessionHandler.VerifySessionToken,
+		handleListBotLogins(apiHelper),
+	)
+	botAPI.Get("/manifest",
+		requireBotCredential(apiHelper),
+		handleGetManifest(apiHelper),
+	)
 }
@@ -0,0 +1,161 @@
+package harukibotneo
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	platformBotAnalytics "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botanalytics"
+	platformTime "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	botUser "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/socialplatforminfo"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+var statsNow = time.Now
+
+// loadOwnedBotIDs returns the bots registered with any QQ number the user
+// has verified on their account, in ascending bot_id order.
+func loadOwnedBotIDs(ctx context.Context, db *postgresql.Client, botDB *neopg.Client, userID string) ([]int, error) {
+	bindings, err := db.SocialPlatformInfo.Query().
+		Where(
+			socialplatforminfo.PlatformEQ(string(harukiAPIHelper.SocialPlatformQQ)),
+			socialplatforminfo.UserSocialPlatformInfoEQ(userID),
+			socialplatforminfo.VerifiedEQ(true),
+		).
+		Select(socialplatforminfo.FieldPlatformUserID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerIDs := make([]int64, 0, len(bindings))
+	for _, binding := range bindings {
+		qq, err := strconv.ParseInt(strings.TrimSpace(binding.PlatformUserID), 10, 64)
+		if err != nil || qq <= 0 {
+			continue
+		}
+		ownerIDs = append(ownerIDs, qq)
+	}
+	if len(ownerIDs) == 0 {
+		return nil, nil
+	}
+
+	bots, err := botDB.User.Query().
+		Where(botUser.OwnerUserIDIn(ownerIDs...)).
+		Select(botUser.FieldBotID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	botIDs := make([]int, 0, len(bots))
+	for _, bot := range bots {
+		botIDs = append(botIDs, bot.BotID)
+	}
+	sort.Ints(botIDs)
+	return botIDs, nil
+}
+
+// selectOwnedBotIDs narrows botIDs to the bot_id query parameter, if any.
+func selectOwnedBotIDs(raw string, botIDs []int) ([]int, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return botIDs, nil
+	}
+	botID, err := strconv.Atoi(trimmed)
+	if err != nil || botID <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "bot_id must be a positive integer")
+	}
+	for _, owned := range botIDs {
+		if owned == botID {
+			return []int{botID}, nil
+		}
+	}
+	return nil, fiber.NewError(fiber.StatusNotFound, "bot not found")
+}
+
+func parseOwnerCommandVolumeQuery(c fiber.Ctx, botIDs []int, now time.Time) (platformBotAnalytics.CommandVolumeQuery, error) {
+	var query platformBotAnalytics.CommandVolumeQuery
+	from, to, err := platformTime.ResolveTimeRange(c.Query("from"), c.Query("to"), now, defaultStatsWindow, maxStatsRange)
+	if err != nil {
+		return query, err
+	}
+	bucket, err := platformBotAnalytics.ParseBucket(c.Query("bucket"))
+	if err != nil {
+		return query, err
+	}
+	tz, loc, err := platformBotAnalytics.ParseTimezone(c.Query("tz"))
+	if err != nil {
+		return query, err
+	}
+	groupBy, err := platformBotAnalytics.ParseGroupBy(c.Query("group_by"))
+	if err != nil {
+		return query, err
+	}
+	pids := make([]string, 0, len(botIDs))
+	for _, botID := range botIDs {
+		pids = append(pids, platformBotAnalytics.PIDForBotID(botID))
+	}
+	return platformBotAnalytics.CommandVolumeQuery{
+		From:     from,
+		To:       to,
+		Bucket:   bucket,
+		Timezone: tz,
+		Location: loc,
+		GroupBy:  groupBy,
+		PIDs:     pids,
+	}, nil
+}
+
+func handleGetOwnerStats(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		if apiHelper.DBManager.BotDB == nil {
+			harukiLogger.Errorf("bot database is not configured")
+			return harukiAPIHelper.ErrorInternal(c, "bot statistics unavailable")
+		}
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		ctx := c.Context()
+
+		botIDs, err := loadOwnedBotIDs(ctx, apiHelper.DBManager.DB, apiHelper.DBManager.BotDB, userID)
+		if err != nil {
+			harukiLogger.Errorf("Failed to query owned bots: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bot registration")
+		}
+		if len(botIDs) == 0 {
+			return harukiAPIHelper.ErrorNotFound(c, "no registered bot is owned by a verified QQ binding")
+		}
+		botIDs, err = selectOwnedBotIDs(c.Query("bot_id"), botIDs)
+		if err != nil {
+			return respondFiberOrBadRequest(c, err, "invalid bot_id")
+		}
+
+		now := statsNow().UTC()
+		query, err := parseOwnerCommandVolumeQuery(c, botIDs, now)
+		if err != nil {
+			return respondFiberOrBadRequest(c, err, "invalid statistics query")
+		}
+		ranks, err := platformBotAnalytics.BuildBotRanks(ctx, apiHelper.DBManager.BotDB, botIDs)
+		if err != nil {
+			harukiLogger.Errorf("Failed to query bot ranking: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to query bot ranking")
+		}
+		commands, err := platformBotAnalytics.BuildCommandVolume(ctx, apiHelper.DBManager.BotDB, query, now)
+		if err != nil {
+			harukiLogger.Errorf("Failed to build bot command volume: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to build command volume")
+		}
+
+		resp := ownerStatsResponse{Bots: ranks, Commands: commands}
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
@@ -0,0 +1,74 @@
+package harukibotneo
+
+import (
+	"testing"
+
+	neopgEnttest "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestLoadOwnedBotIDsFollowsVerifiedQQBindings(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:bot-owner-stats-test?mode=memory&cache=shared&_fk=1")
+	botDB := neopgEnttest.Open(t, "sqlite3", "file:bot-owner-stats-bot-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = db.Close()
+		_ = botDB.Close()
+	})
+
+	seedUser := func(userID, qq string, verified bool) {
+		t.Helper()
+		if _, err := db.User.Create().
+			SetID(userID).
+			SetName(userID).
+			SetEmail(userID + "@example.com").
+			Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed user: %v", err)
+		}
+		if _, err := db.SocialPlatformInfo.Create().
+			SetPlatform("qq").
+			SetPlatformUserID(qq).
+			SetVerified(verified).
+			SetUserID(userID).
+			Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed qq binding: %v", err)
+		}
+	}
+	seedUser("owner", "111", true)
+	seedUser("pending", "222", false)
+	for ownerQQ, botID := range map[int64]int{111: 10000001, 222: 10000002} {
+		if _, err := botDB.User.Create().
+			SetOwnerUserID(ownerQQ).
+			SetBotID(botID).
+			SetCredential("hash").
+			Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed bot user: %v", err)
+		}
+	}
+
+	owned, err := loadOwnedBotIDs(t.Context(), db, botDB, "owner")
+	if err != nil {
+		t.Fatalf("loadOwnedBotIDs returned error: %v", err)
+	}
+	if len(owned) != 1 || owned[0] != 10000001 {
+		t.Fatalf("owned bots = %v, want [10000001]", owned)
+	}
+	pending, err := loadOwnedBotIDs(t.Context(), db, botDB, "pending")
+	if err != nil {
+		t.Fatalf("loadOwnedBotIDs returned error: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("unverified binding should not own bots, got %v", pending)
+	}
+
+	if selected, err := selectOwnedBotIDs("", owned); err != nil || len(selected) != 1 {
+		t.Fatalf("selectOwnedBotIDs(\"\") = %v, %v", selected, err)
+	}
+	if _, err := selectOwnedBotIDs("10000002", owned); err == nil {
+		t.Fatalf("expected a bot owned by someone else to be rejected")
+	} else if fiberErr, ok := err.(*fiber.Error); !ok || fiberErr.Code != fiber.StatusNotFound {
+		t.Fatalf("unexpected error for foreign bot: %v", err)
+	}
+}
@@ -1,5 +1,10 @@
 package harukibotneo
 
+import (
+	platformBotAccount "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botaccount"
+	platformBotAnalytics "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/botanalytics"
+)
+
 type sendMailPayload struct {
 	QQNumber int64 `json:"qq_number"`
 }
@@ -7,6 +12,9 @@ type sendMailPayload struct {
 type registerPayload struct {
 	QQNumber         int64  `json:"qq_number"`
 	VerificationCode string `json:"verification_code"`
+	BotID            string `json:"bot_id,omitempty"`
+	NewBot           bool   `json:"new_bot,omitempty"`
+	Name             string `json:"name,omitempty"`
 }
 
 type registrationStatusResponse struct {
@@ -17,3 +25,37 @@ type registrationResultData struct {
 	BotID      string `json:"bot_id"`
 	Credential string `json:"credential"`
 }
+
+type ownerStatsResponse struct {
+	Bots     []platformBotAnalytics.BotRank      `json:"bots"`
+	Commands *platformBotAnalytics.CommandVolume `json:"commands"`
+}
+
+type manifestCommand struct {
+	CommandPrefixes         []string `json:"command_prefixes"`
+	CommandPriority         int      `json:"command_priority"`
+	CommandMode             string   `json:"command_mode"`
+	CommandModule           string   `json:"command_module"`
+	CommandPath             string   `json:"command_path"`
+	CommandAdditionalParams []string `json:"command_additional_params"`
+}
+
+type manifestResponse struct {
+	Version  int               `json:"version"`
+	ETag     string            `json:"etag"`
+	Commands []manifestCommand `json:"commands"`
+}
+
+type ownerBotsResponse struct {
+	Bots            []platformBotAccount.Bot `json:"bots"`
+	MaxBotsPerOwner int                      `json:"maxBotsPerOwner"`
+}
+
+type botStatusPayload struct {
+	Reason string `json:"reason"`
+}
+
+type botLoginsResponse struct {
+	BotID  int                        `json:"botId"`
+	Logins []platformBotAccount.Login `json:"logins"`
+}
@@ -1,13 +1,30 @@
 package ios
 
 import (
+	"fmt"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	iosGen "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/ios"
 	"regexp"
+	"strconv"
 )
 
 var modulePathPattern = regexp.MustCompile(`^([a-z-]+)-haruki-toolbox-([a-z_-]+)\.(\w+)$`)
 
+const (
+	defaultChunkSizeMB = 1
+	maxChunkSizeMB     = 10
+)
+
+// parseChunkSizeMB parses the chunk query. 0 turns chunking off so the
+// script uploads the body in one request.
+func parseChunkSizeMB(raw string) (int, error) {
+	parsed, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || parsed < 0 || parsed > maxChunkSizeMB {
+		return 0, fmt.Errorf("chunk must be between 0 and %d MB", maxChunkSizeMB)
+	}
+	return int(parsed), nil
+}
+
 func getEndpoint(endpointType iosGen.EndpointType) string {
 	if endpointType == iosGen.EndpointTypeCDN && harukiConfig.Cfg.Backend.BackendCDNURL != "" {
 		return harukiConfig.Cfg.Backend.BackendCDNURL
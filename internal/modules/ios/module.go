@@ -9,7 +9,6 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/iosscriptcode"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
 	"math/rand/v2"
-	"strconv"
 	"strings"
 	"time"
 
@@ -48,20 +47,20 @@ func handleModuleGeneration(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelper
 		ext := matches[3]
 		app, ok := iosGen.ParseProxyApp(ext)
 		if !ok {
-			return harukiAPIHelper.ErrorBadRequest(c, fmt.Sprintf("unsupported extension: %s. Supported: sgmodule, lnplugin, conf, stoverride", ext))
+			return harukiAPIHelper.ErrorBadRequest(c, fmt.Sprintf("unsupported extension: %s. Supported: %s", ext, iosGen.SupportedProxyAppExtensions()))
 		}
 		endpointStr := c.Query("endpoint", "direct")
 		endpointType, ok := iosGen.ParseEndpointType(endpointStr)
 		if !ok {
 			return harukiAPIHelper.ErrorBadRequest(c, fmt.Sprintf("unsupported endpoint: %s. Supported: direct, cdn", endpointStr))
 		}
-		chunkSizeMB := 1
+		chunkSizeMB := defaultChunkSizeMB
 		if chunkStr := c.Query("chunk"); chunkStr != "" {
-			parsed, err := strconv.ParseInt(chunkStr, 10, 64)
-			if err != nil || parsed < 1 || parsed > 10 {
-				return harukiAPIHelper.ErrorBadRequest(c, "chunk must be between 1 and 10 MB")
+			parsed, err := parseChunkSizeMB(chunkStr)
+			if err != nil {
+				return harukiAPIHelper.ErrorBadRequest(c, err.Error())
 			}
-			chunkSizeMB = int(parsed)
+			chunkSizeMB = parsed
 		}
 		regionStrs := strings.Split(regionsStr, "-")
 		var regions []harukiUtils.SupportedDataUploadServer
@@ -126,9 +125,6 @@ func handleModuleGeneration(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelper
 			mode = iosGen.UploadModeProxy
 		case "script":
 			mode = iosGen.UploadModeScript
-			if app == iosGen.ProxyAppQuantumultX {
-				return harukiAPIHelper.ErrorBadRequest(c, "Quantumult X does not support script upload mode. Use proxy mode instead.")
-			}
 		default:
 			return harukiAPIHelper.ErrorBadRequest(c, fmt.Sprintf("unsupported mode: %s. Supported: proxy, script", modeStr))
 		}
@@ -140,6 +136,9 @@ func handleModuleGeneration(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelper
 			Mode:        mode,
 			ChunkSizeMB: chunkSizeMB,
 		}
+		if err := req.Validate(); err != nil {
+			return harukiAPIHelper.ErrorBadRequest(c, err.Error())
+		}
 		endpoint := getEndpoint(endpointType)
 		content, err := iosGen.GenerateModule(req, endpoint, endpointStr)
 		if err != nil {
@@ -6,7 +6,6 @@ import (
 	iosGen "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/ios"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/iosscriptcode"
-	"strconv"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -29,11 +28,11 @@ func handleScriptGeneration(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelper
 		}
 		chunkSizeMB := 1
 		if chunkStr := c.Query("chunk"); chunkStr != "" {
-			parsed, err := strconv.ParseInt(chunkStr, 10, 64)
-			if err != nil || parsed < 1 || parsed > 10 {
-				return harukiAPIHelper.ErrorBadRequest(c, "chunk must be between 1 and 10 MB")
+			parsed, err := parseChunkSizeMB(chunkStr)
+			if err != nil {
+				return harukiAPIHelper.ErrorBadRequest(c, err.Error())
 			}
-			chunkSizeMB = int(parsed)
+			chunkSizeMB = parsed
 		}
 		endpointStr := c.Query("endpoint", "direct")
 		endpointType, ok := iosGen.ParseEndpointType(endpointStr)
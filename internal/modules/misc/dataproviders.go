@@ -0,0 +1,35 @@
+package misc
+
+import (
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// handleGetDataProviders lists the enabled data providers so clients can
+// offer a consent switch for each of them. Secrets and endpoints are left
+// out.
+func handleGetDataProviders(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		result := make([]DataProviderData, 0)
+		for _, provider := range apiHelper.GetDataProviders() {
+			if !provider.Enabled {
+				continue
+			}
+			result = append(result, DataProviderData{
+				Name:             provider.Name,
+				DisplayName:      provider.DisplayName,
+				Servers:          append([]string{}, provider.Servers...),
+				DataTypes:        append([]string{}, provider.DataTypes...),
+				ConsentKey:       provider.Privacy.ConsentKey,
+				RequirePublicAPI: provider.Privacy.RequirePublicAPI,
+			})
+		}
+		return harukiAPIHelper.SuccessResponse[[]DataProviderData](c, "Successfully fetched data providers", &result)
+	}
+}
+
+func registerDataProvidersRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	api := apiHelper.Router.Group("/api/misc")
+	api.Get("/data_providers", handleGetDataProviders(apiHelper))
+}
@@ -13,3 +13,12 @@ type FriendGroupData struct {
 	Group     string            `json:"group"`
 	GroupList []FriendGroupItem `json:"groupList"`
 }
+
+type DataProviderData struct {
+	Name             string   `json:"name"`
+	DisplayName      string   `json:"displayName"`
+	Servers          []string `json:"servers"`
+	DataTypes        []string `json:"dataTypes"`
+	ConsentKey       string   `json:"consentKey"`
+	RequirePublicAPI bool     `json:"requirePublicApi"`
+}
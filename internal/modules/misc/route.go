@@ -5,5 +5,6 @@ import harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 func RegisterMiscRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
 	registerFriendGroupsRoutes(apiHelper)
 	registerFriendLinksRoutes(apiHelper)
+	registerDataProvidersRoutes(apiHelper)
 	apiHelper.Router.Get("/api/health", handleHealth(apiHelper))
 }
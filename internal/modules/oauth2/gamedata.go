@@ -7,6 +7,7 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/data"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 	harukiOAuth2 "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/oauth2"
 	"strconv"
 	"time"
@@ -15,46 +16,65 @@ import (
 	"github.com/gofiber/fiber/v3"
 )
 
-func handleOAuth2GetGameData(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
-	return func(c fiber.Ctx) error {
-		ctx := c.Context()
+type oauth2GameDataTarget struct {
+	Server     harukiUtils.SupportedDataUploadServer
+	DataType   harukiUtils.UploadDataType
+	GameUserID int64
+}
 
-		serverStr := c.Params("server")
-		dataTypeStr := c.Params("data_type")
-		gameUserIDStr := c.Params("user_id")
+// resolveOAuth2GameDataTarget parses the game-data route parameters and checks
+// that the token's user owns, or has been granted, the requested data.
+func resolveOAuth2GameDataTarget(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) (*oauth2GameDataTarget, error) {
+	serverStr := c.Params("server")
+	dataTypeStr := c.Params("data_type")
+	gameUserIDStr := c.Params("user_id")
 
-		authUserID, err := userCoreModule.CurrentUserID(c)
-		if err != nil {
-			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
-		}
+	authUserID, err := userCoreModule.CurrentUserID(c)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+	}
 
-		server, err := harukiUtils.ParseSupportedDataUploadServer(serverStr)
-		if err != nil {
-			return harukiAPIHelper.ErrorBadRequest(c, "invalid server")
-		}
+	server, err := harukiUtils.ParseSupportedDataUploadServer(serverStr)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorBadRequest(c, "invalid server")
+	}
 
-		dataType, err := harukiUtils.ParseUploadDataType(dataTypeStr)
-		if err != nil {
-			return harukiAPIHelper.ErrorBadRequest(c, "invalid data_type")
-		}
+	dataType, err := harukiUtils.ParseUploadDataType(dataTypeStr)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorBadRequest(c, "invalid data_type")
+	}
 
-		gameUserID, err := strconv.ParseInt(gameUserIDStr, 10, 64)
-		if err != nil {
-			return harukiAPIHelper.ErrorBadRequest(c, "Invalid game user_id, it must be integer")
-		}
+	gameUserID, err := strconv.ParseInt(gameUserIDStr, 10, 64)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorBadRequest(c, "Invalid game user_id, it must be integer")
+	}
 
-		access, err := apiHelper.DBManager.DB.CanAccessGameAccountData(ctx, authUserID, string(server), gameUserIDStr, string(dataType), time.Now().UTC())
-		if err != nil {
-			harukiLogger.Errorf("Failed to verify oauth2 game account data access: %v", err)
-			return harukiAPIHelper.ErrorInternal(c, "failed to query game account binding")
-		}
-		if access == nil || !access.Allowed {
-			return harukiAPIHelper.ErrorNotFound(c, "game account binding not found or not owned by you")
+	access, err := apiHelper.DBManager.DB.CanAccessGameAccountData(c.Context(), authUserID, string(server), gameUserIDStr, string(dataType), time.Now().UTC())
+	if err != nil {
+		harukiLogger.Errorf("Failed to verify oauth2 game account data access: %v", err)
+		return nil, harukiAPIHelper.ErrorInternal(c, "failed to query game account binding")
+	}
+	if access == nil || !access.Allowed {
+		return nil, harukiAPIHelper.ErrorNotFound(c, "game account binding not found or not owned by you")
+	}
+	return &oauth2GameDataTarget{Server: server, DataType: dataType, GameUserID: gameUserID}, nil
+}
+
+func handleOAuth2GetGameData(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := c.Context()
+
+		target, err := resolveOAuth2GameDataTarget(c, apiHelper)
+		if target == nil {
+			return err
 		}
+		server, dataType, gameUserID := target.Server, target.DataType, target.GameUserID
 
 		requestKey := c.Query("key")
 		cacheKey := harukiRedis.BuildGameDataCacheKey("oauth2", string(server), string(dataType), gameUserID, requestKey)
-		if cached, found, cErr := apiHelper.DBManager.Redis.GetRawCache(ctx, cacheKey); cErr == nil && found {
+		cached, found, cErr := apiHelper.DBManager.Redis.GetRawCache(ctx, cacheKey)
+		harukiMetrics.RecordCacheLookup(harukiMetrics.CacheOAuth2, found, cErr)
+		if cErr == nil && found {
 			c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
 			return c.SendString(cached)
 		} else if cErr != nil {
@@ -75,11 +95,7 @@ func handleOAuth2GetGameData(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpe
 		}
 
 		if err != nil {
-			if fErr, ok := err.(*fiber.Error); ok {
-				return harukiAPIHelper.UpdatedDataResponse[string](c, fErr.Code, fErr.Message, nil)
-			}
-			harukiLogger.Errorf("Failed to load OAuth2 game data: %v", err)
-			return harukiAPIHelper.ErrorInternal(c, "failed to get user data")
+			return respondOAuth2GameDataError(c, err)
 		}
 
 		if encoded, mErr := sonic.Marshal(resp); mErr == nil {
@@ -99,4 +115,16 @@ func registerOAuth2GameDataRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouter
 		harukiOAuth2.VerifyOAuth2Token(apiHelper.DBManager.DB, harukiOAuth2.ScopeGameDataRead),
 		handleOAuth2GetGameData(apiHelper),
 	)
+	o.Get("/:server/:data_type/:user_id/revisions",
+		harukiOAuth2.VerifyOAuth2Token(apiHelper.DBManager.DB, harukiOAuth2.ScopeGameDataRead),
+		handleOAuth2ListGameDataRevisions(apiHelper),
+	)
+	o.Get("/:server/:data_type/:user_id/revisions/diff",
+		harukiOAuth2.VerifyOAuth2Token(apiHelper.DBManager.DB, harukiOAuth2.ScopeGameDataRead),
+		handleOAuth2DiffGameDataRevisions(apiHelper),
+	)
+	o.Get("/:server/:data_type/:user_id/revisions/:timestamp",
+		harukiOAuth2.VerifyOAuth2Token(apiHelper.DBManager.DB, harukiOAuth2.ScopeGameDataRead),
+		handleOAuth2GetGameDataRevision(apiHelper),
+	)
 }
@@ -0,0 +1,87 @@
+package oauth2
+
+import (
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/data"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func handleOAuth2ListGameDataRevisions(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, err := resolveOAuth2GameDataTarget(c, apiHelper)
+		if target == nil {
+			return err
+		}
+		resp, err := data.HandleRevisionListRequest(c, apiHelper, target.GameUserID, target.Server, target.DataType)
+		if err != nil {
+			return respondOAuth2GameDataError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
+
+func handleOAuth2GetGameDataRevision(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, err := resolveOAuth2GameDataTarget(c, apiHelper)
+		if target == nil {
+			return err
+		}
+		requestKey := c.Query("key")
+		timestamp := c.Params("timestamp")
+		var resp *data.SnapshotRevisionResponse
+		if target.DataType == harukiUtils.UploadDataTypeSuite {
+			allowedKeySet, allowedKeys := oauth2PublicAPIAllowedKeys(apiHelper)
+			resp, err = data.HandleSuiteRevisionRequest(c, apiHelper, target.GameUserID, target.Server, timestamp, requestKey, allowedKeySet, allowedKeys)
+		} else {
+			resp, err = data.HandleMysekaiRevisionRequest(c, apiHelper, target.GameUserID, target.Server, timestamp, requestKey)
+		}
+		if err != nil {
+			return respondOAuth2GameDataError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
+
+func handleOAuth2DiffGameDataRevisions(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, err := resolveOAuth2GameDataTarget(c, apiHelper)
+		if target == nil {
+			return err
+		}
+		requestKey := c.Query("key")
+		var resp any
+		switch target.DataType {
+		case harukiUtils.UploadDataTypeSuite:
+			allowedKeySet, allowedKeys := oauth2PublicAPIAllowedKeys(apiHelper)
+			resp, err = data.HandleSuiteRevisionDiffRequest(c, apiHelper, target.GameUserID, target.Server, requestKey, allowedKeySet, allowedKeys)
+		case harukiUtils.UploadDataTypeMysekai:
+			resp, err = data.HandleMysekaiRevisionDiffRequest(c, apiHelper, target.GameUserID, target.Server, requestKey)
+		default:
+			err = data.ValidateRevisionDataType(target.DataType)
+		}
+		if err != nil {
+			return respondOAuth2GameDataError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
+
+func oauth2PublicAPIAllowedKeys(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) (map[string]struct{}, []string) {
+	publicAPIAllowedKeys := apiHelper.GetPublicAPIAllowedKeys()
+	allowedKeySet := make(map[string]struct{}, len(publicAPIAllowedKeys))
+	for _, k := range publicAPIAllowedKeys {
+		allowedKeySet[k] = struct{}{}
+	}
+	return allowedKeySet, publicAPIAllowedKeys
+}
+
+func respondOAuth2GameDataError(c fiber.Ctx, err error) error {
+	if fErr, ok := err.(*fiber.Error); ok {
+		return harukiAPIHelper.UpdatedDataResponse[string](c, fErr.Code, fErr.Message, nil)
+	}
+	harukiLogger.Errorf("Failed to load OAuth2 game data: %v", err)
+	return harukiAPIHelper.ErrorInternal(c, "failed to get user data")
+}
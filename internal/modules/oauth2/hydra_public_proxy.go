@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
@@ -74,6 +75,48 @@ func handleHydraPublicProxy(endpointPath string) fiber.Handler {
 	}
 }
 
+// handleHydraTokenProxy proxies the token endpoint and reports each grant to
+// the risk engine.
+func handleHydraTokenProxy(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	proxy := handleHydraPublicProxy("/oauth2/token")
+	return func(c fiber.Ctx) error {
+		err := proxy(c)
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+		}
+		// Provider outages are not the caller's doing.
+		if status >= fiber.StatusInternalServerError {
+			return err
+		}
+		harukiAPIHelper.PublishRiskSignal(apiHelper, harukiAPIHelper.RiskSignal{
+			Kind:     harukiAPIHelper.RiskSignalKindOAuth2TokenGrant,
+			Success:  status < fiber.StatusBadRequest,
+			IP:       c.IP(),
+			ClientID: tokenRequestClientID(c),
+			Metadata: map[string]any{
+				"grantType":  strings.TrimSpace(c.FormValue("grant_type")),
+				"statusCode": status,
+			},
+		})
+		return err
+	}
+}
+
+func tokenRequestClientID(c fiber.Ctx) string {
+	if clientID := strings.TrimSpace(c.FormValue("client_id")); clientID != "" {
+		return clientID
+	}
+	req := http.Request{Header: http.Header{"Authorization": {c.Get("Authorization")}}}
+	if username, _, ok := req.BasicAuth(); ok {
+		if decoded, err := url.QueryUnescape(username); err == nil {
+			return strings.TrimSpace(decoded)
+		}
+		return strings.TrimSpace(username)
+	}
+	return ""
+}
+
 func copyHydraResponseHeaders(c fiber.Ctx, header http.Header) {
 	for _, name := range []string{"Content-Type", "Cache-Control", "Pragma", "WWW-Authenticate", "Location"} {
 		if value := strings.TrimSpace(header.Get(name)); value != "" {
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	harukiOAuth2 "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/oauth2"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 
 	"github.com/bytedance/sonic"
 )
@@ -120,7 +121,7 @@ func hydraHTTPClient() *http.Client {
 		return hydraSharedHTTPClient
 	}
 
-	client := &http.Client{Timeout: timeout}
+	client := &http.Client{Timeout: timeout, Transport: harukiTracing.Transport(nil)}
 	hydraSharedHTTPClient = client
 	hydraSharedTimeoutNano = timeoutNano
 	return hydraSharedHTTPClient
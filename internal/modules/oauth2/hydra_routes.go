@@ -14,7 +14,7 @@ func registerHydraOAuth2Routes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHel
 	}
 
 	apiHelper.Router.Get("/api/oauth2/authorize", handleHydraAuthorizeRedirect())
-	apiHelper.Router.Post("/api/oauth2/token", handleHydraPublicProxy("/oauth2/token"))
+	apiHelper.Router.Post("/api/oauth2/token", handleHydraTokenProxy(apiHelper))
 	apiHelper.Router.Post("/api/oauth2/revoke", handleHydraPublicProxy("/oauth2/revoke"))
 
 	apiHelper.Router.Get("/api/oauth2/login", handleHydraGetLoginRequest())
@@ -8,6 +8,7 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 	"strconv"
 	"time"
 
@@ -58,7 +59,9 @@ func handlePublicDataRequest(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpe
 		var resp any
 		requestKey := c.Query("key")
 		cacheKey := harukiRedis.BuildGameDataCacheKey("public", string(server), string(dataType), userID, requestKey)
-		if cached, found, err := apiHelper.DBManager.Redis.GetRawCache(ctx, cacheKey); err == nil && found {
+		cached, found, err := apiHelper.DBManager.Redis.GetRawCache(ctx, cacheKey)
+		harukiMetrics.RecordCacheLookup(harukiMetrics.CachePublic, found, err)
+		if err == nil && found {
 			c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
 			return c.SendString(cached)
 		} else if err != nil {
@@ -0,0 +1,128 @@
+package riskengine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	adminUsersModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/adminusers"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+)
+
+type actionExecutor interface {
+	TemporarilyBan(ctx context.Context, userID, reason string, until time.Time) (bool, error)
+	ForceLogout(ctx context.Context, userID string) (bool, error)
+	BlockUploads(ctx context.Context, userID string, until time.Time) (bool, error)
+	GameAccountOwner(ctx context.Context, server, gameUserID string) (string, error)
+}
+
+type actionResult struct {
+	Action string `json:"action"`
+	UserID string `json:"userId,omitempty"`
+	Status string `json:"status"`
+	Until  string `json:"until,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+const (
+	actionStatusApplied = "applied"
+	actionStatusSkipped = "skipped"
+	actionStatusFailed  = "failed"
+)
+
+// applyActions runs the rule's automatic actions against the user behind the
+// signal. Bans and logouts only target the acting user; an upload block falls
+// back to the owner of the uploaded game account.
+func (e *Engine) applyActions(ctx context.Context, rule *compiledRule, signal harukiAPIHelper.RiskSignal) []actionResult {
+	if len(rule.actions) == 0 {
+		return nil
+	}
+	now := signal.OccurredAt
+	results := make([]actionResult, 0, len(rule.actions))
+	for _, action := range rule.actions {
+		result := actionResult{Action: action, UserID: signal.UserID, Status: actionStatusSkipped}
+		var applied bool
+		var err error
+		switch action {
+		case ActionTempBan:
+			if signal.UserID == "" {
+				break
+			}
+			until := now.Add(rule.banDuration).UTC()
+			result.Until = until.Format(time.RFC3339)
+			applied, err = e.actions.TemporarilyBan(ctx, signal.UserID, fmt.Sprintf("automatic risk rule %s", rule.key), until)
+		case ActionForceLogout:
+			if signal.UserID == "" {
+				break
+			}
+			applied, err = e.actions.ForceLogout(ctx, signal.UserID)
+		case ActionUploadBlock:
+			if result.UserID == "" && signal.Server != "" && signal.GameUserID != "" {
+				result.UserID, err = e.actions.GameAccountOwner(ctx, signal.Server, signal.GameUserID)
+			}
+			if err != nil || result.UserID == "" {
+				break
+			}
+			until := now.Add(rule.uploadBlockDuration).UTC()
+			result.Until = until.Format(time.RFC3339)
+			applied, err = e.actions.BlockUploads(ctx, result.UserID, until)
+		}
+		switch {
+		case err != nil:
+			result.Status = actionStatusFailed
+			result.Error = err.Error()
+			e.logger.Warnf("Risk rule %s action %s failed for user %s: %v", rule.key, action, result.UserID, err)
+		case applied:
+			result.Status = actionStatusApplied
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// userActionExecutor applies actions through the same code paths admins use.
+// Staff accounts are never acted on automatically.
+type userActionExecutor struct {
+	apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
+}
+
+func (x userActionExecutor) TemporarilyBan(ctx context.Context, userID, reason string, until time.Time) (bool, error) {
+	return adminUsersModule.TemporarilyBanUser(ctx, x.apiHelper, userID, reason, until)
+}
+
+func (x userActionExecutor) ForceLogout(ctx context.Context, userID string) (bool, error) {
+	regular, err := x.apiHelper.DBManager.DB.User.Query().
+		Where(user.IDEQ(userID), user.RoleEQ(user.RoleUser)).
+		Exist(ctx)
+	if err != nil || !regular {
+		return false, err
+	}
+	if err := adminUsersModule.ForceLogoutUser(ctx, x.apiHelper, userID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (x userActionExecutor) BlockUploads(ctx context.Context, userID string, until time.Time) (bool, error) {
+	return x.apiHelper.DBManager.DB.BlockUserUploads(ctx, userID, until)
+}
+
+func (x userActionExecutor) GameAccountOwner(ctx context.Context, server, gameUserID string) (string, error) {
+	binding, err := x.apiHelper.DBManager.DB.GameAccountBinding.Query().
+		Where(gameaccountbinding.ServerEQ(server), gameaccountbinding.GameUserIDEQ(gameUserID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if binding.Edges.User == nil {
+		return "", nil
+	}
+	return binding.Edges.User.ID, nil
+}
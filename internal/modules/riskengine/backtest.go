@@ -0,0 +1,343 @@
+package riskengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
+)
+
+const (
+	systemLogActionLogin              = "user.login"
+	systemLogActionGameAccountBinding = "user.game_account_binding.create"
+
+	DefaultBacktestMaxSignals = 200000
+	backtestPageSize          = 2000
+	maxBacktestSubjects       = 100
+)
+
+// ErrInvalidRuleConfig wraps config problems found by Backtest so callers can
+// tell them apart from storage errors.
+var ErrInvalidRuleConfig = errors.New("invalid rule config")
+
+// historicalMetadataKeys lists the metadata each history source can rebuild.
+// Live signals may carry more, which a match filter on those keys cannot see
+// during a replay.
+var historicalMetadataKeys = map[string][]string{
+	harukiAPIHelper.RiskSignalKindLogin:                   {"reason"},
+	harukiAPIHelper.RiskSignalKindGameBindingVerification: {"reason"},
+	harukiAPIHelper.RiskSignalKindUpload:                  {"dataType", "uploadMethod"},
+}
+
+// historicalFields lists the signal fields each history source records.
+var historicalFields = map[string][]string{
+	harukiAPIHelper.RiskSignalKindLogin:                   {groupByIP, groupByUser},
+	harukiAPIHelper.RiskSignalKindGameBindingVerification: {groupByIP, groupByUser, groupByGameAccount},
+	harukiAPIHelper.RiskSignalKindUpload:                  {groupByUser, groupByGameAccount},
+}
+
+type BacktestSubject struct {
+	Value            string    `json:"value"`
+	Triggers         int       `json:"triggers"`
+	MaxCount         int       `json:"maxCount"`
+	FirstTriggeredAt time.Time `json:"firstTriggeredAt"`
+	LastTriggeredAt  time.Time `json:"lastTriggeredAt"`
+}
+
+// BacktestReport describes what a rule would have done over a time range.
+// Subject lists are sorted by trigger count and capped; the *Count fields
+// hold the full number of distinct values.
+type BacktestReport struct {
+	RuleKey        string            `json:"ruleKey"`
+	RuleType       string            `json:"ruleType"`
+	Event          string            `json:"event"`
+	GroupBy        string            `json:"groupBy"`
+	From           time.Time         `json:"from"`
+	To             time.Time         `json:"to"`
+	SignalsScanned int               `json:"signalsScanned"`
+	Truncated      bool              `json:"truncated"`
+	TriggerCount   int               `json:"triggerCount"`
+	Actions        []string          `json:"actions,omitempty"`
+	SubjectCount   int               `json:"subjectCount"`
+	Subjects       []BacktestSubject `json:"subjects"`
+	UserCount      int               `json:"userCount"`
+	Users          []BacktestSubject `json:"users"`
+	IPCount        int               `json:"ipCount"`
+	IPs            []BacktestSubject `json:"ips"`
+	Notes          []string          `json:"notes,omitempty"`
+}
+
+// Backtest replays config over the stored history between from and to and
+// reports which subjects would have triggered it. Nothing is written and no
+// action is taken. At most maxSignals history rows are replayed.
+func Backtest(ctx context.Context, db *postgresql.Client, key string, config map[string]any, from, to time.Time, maxSignals int) (*BacktestReport, error) {
+	if !isEngineRuleConfig(config) {
+		return nil, fmt.Errorf("%w: config has no rule type", ErrInvalidRuleConfig)
+	}
+	rule, err := compileRule(key, config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRuleConfig, err)
+	}
+	if maxSignals <= 0 {
+		maxSignals = DefaultBacktestMaxSignals
+	}
+
+	report := &BacktestReport{
+		RuleKey:  key,
+		RuleType: rule.ruleType,
+		Event:    rule.event,
+		GroupBy:  rule.groupBy,
+		From:     from.UTC(),
+		To:       to.UTC(),
+		Actions:  rule.actions,
+		Notes:    backtestNotes(rule),
+	}
+	evaluator := newRuleEvaluator()
+	subjects := make(map[string]*BacktestSubject)
+	users := make(map[string]*BacktestSubject)
+	ips := make(map[string]*BacktestSubject)
+	replay := func(signal harukiAPIHelper.RiskSignal) {
+		group, count, fired := evaluator.evaluate(rule, signal)
+		if !fired {
+			return
+		}
+		report.TriggerCount++
+		recordBacktestTrigger(subjects, group, count, signal.OccurredAt)
+		recordBacktestTrigger(users, signal.UserID, count, signal.OccurredAt)
+		recordBacktestTrigger(ips, signal.IP, count, signal.OccurredAt)
+	}
+
+	var scanned int
+	var truncated bool
+	switch rule.event {
+	case harukiAPIHelper.RiskSignalKindLogin, harukiAPIHelper.RiskSignalKindGameBindingVerification:
+		scanned, truncated, err = replaySystemLogs(ctx, db, rule.event, from, to, maxSignals, replay)
+	case harukiAPIHelper.RiskSignalKindUpload:
+		scanned, truncated, err = replayUploadLogs(ctx, db, from, to, maxSignals, replay)
+	}
+	if err != nil {
+		return nil, err
+	}
+	report.SignalsScanned = scanned
+	report.Truncated = truncated
+	report.SubjectCount, report.Subjects = rankBacktestSubjects(subjects)
+	report.UserCount, report.Users = rankBacktestSubjects(users)
+	report.IPCount, report.IPs = rankBacktestSubjects(ips)
+	return report, nil
+}
+
+func backtestNotes(rule *compiledRule) []string {
+	if rule.event == harukiAPIHelper.RiskSignalKindOAuth2TokenGrant {
+		return []string{"OAuth2 token grants are not stored, so there is no history to replay"}
+	}
+	var notes []string
+	if !rule.enabled {
+		notes = append(notes, "rule is disabled; the report shows what it would do once enabled")
+	}
+	for _, field := range []string{rule.groupBy, rule.distinctBy} {
+		if field != "" && !slices.Contains(historicalFields[rule.event], field) {
+			notes = append(notes, fmt.Sprintf("%s history does not record %s, so no event can be grouped by it", rule.event, field))
+		}
+	}
+	matchKeys := make([]string, 0, len(rule.match))
+	for field := range rule.match {
+		if !slices.Contains(historicalMetadataKeys[rule.event], field) {
+			matchKeys = append(matchKeys, field)
+		}
+	}
+	sort.Strings(matchKeys)
+	for _, field := range matchKeys {
+		notes = append(notes, fmt.Sprintf("%s history does not record %s, so the match on it never succeeds", rule.event, field))
+	}
+	return notes
+}
+
+func recordBacktestTrigger(subjects map[string]*BacktestSubject, value string, count int, at time.Time) {
+	if value == "" {
+		return
+	}
+	subject, ok := subjects[value]
+	if !ok {
+		subject = &BacktestSubject{Value: value, FirstTriggeredAt: at.UTC()}
+		subjects[value] = subject
+	}
+	subject.Triggers++
+	subject.MaxCount = max(subject.MaxCount, count)
+	subject.LastTriggeredAt = at.UTC()
+}
+
+func rankBacktestSubjects(subjects map[string]*BacktestSubject) (int, []BacktestSubject) {
+	ranked := make([]BacktestSubject, 0, len(subjects))
+	for _, subject := range subjects {
+		ranked = append(ranked, *subject)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Triggers != ranked[j].Triggers {
+			return ranked[i].Triggers > ranked[j].Triggers
+		}
+		return ranked[i].Value < ranked[j].Value
+	})
+	if len(ranked) > maxBacktestSubjects {
+		ranked = ranked[:maxBacktestSubjects]
+	}
+	return len(subjects), ranked
+}
+
+// replaySystemLogs turns user.login and game account binding audit logs back
+// into the signals the live handlers publish, oldest first.
+func replaySystemLogs(ctx context.Context, db *postgresql.Client, event string, from, to time.Time, maxSignals int, replay func(harukiAPIHelper.RiskSignal)) (int, bool, error) {
+	action := systemLogActionLogin
+	if event == harukiAPIHelper.RiskSignalKindGameBindingVerification {
+		action = systemLogActionGameAccountBinding
+	}
+	scanned := 0
+	var afterTime time.Time
+	var afterID int
+	for scanned < maxSignals {
+		query := db.SystemLog.Query().
+			Where(
+				systemlog.ActionEQ(action),
+				systemlog.EventTimeGTE(from),
+				systemlog.EventTimeLTE(to),
+			)
+		if afterID > 0 {
+			query = query.Where(systemlog.Or(
+				systemlog.EventTimeGT(afterTime),
+				systemlog.And(systemlog.EventTimeEQ(afterTime), systemlog.IDGT(afterID)),
+			))
+		}
+		limit := min(backtestPageSize, maxSignals-scanned)
+		rows, err := query.
+			Order(postgresql.Asc(systemlog.FieldEventTime), postgresql.Asc(systemlog.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return scanned, false, err
+		}
+		for _, row := range rows {
+			scanned++
+			if signal, ok := systemLogRiskSignal(event, row); ok {
+				replay(signal)
+			}
+		}
+		if len(rows) < limit {
+			return scanned, false, nil
+		}
+		last := rows[len(rows)-1]
+		afterTime, afterID = last.EventTime, last.ID
+	}
+	more, err := db.SystemLog.Query().
+		Where(
+			systemlog.ActionEQ(action),
+			systemlog.EventTimeLTE(to),
+			systemlog.Or(
+				systemlog.EventTimeGT(afterTime),
+				systemlog.And(systemlog.EventTimeEQ(afterTime), systemlog.IDGT(afterID)),
+			),
+		).
+		Exist(ctx)
+	return scanned, more, err
+}
+
+func systemLogRiskSignal(event string, row *postgresql.SystemLog) (harukiAPIHelper.RiskSignal, bool) {
+	reason := strings.TrimSpace(fmt.Sprint(row.Metadata["reason"]))
+	signal := harukiAPIHelper.RiskSignal{
+		Kind:       event,
+		Success:    row.Result == systemlog.ResultSuccess,
+		OccurredAt: row.EventTime,
+		Metadata:   map[string]any{"reason": reason},
+	}
+	if row.TargetID != nil {
+		signal.UserID = strings.TrimSpace(*row.TargetID)
+	}
+	if row.IP != nil {
+		signal.IP = strings.TrimSpace(*row.IP)
+	}
+	switch event {
+	case harukiAPIHelper.RiskSignalKindLogin:
+		if !harukiAPIHelper.IsLoginRiskSignalReason(reason) {
+			return signal, false
+		}
+	case harukiAPIHelper.RiskSignalKindGameBindingVerification:
+		success, ok := harukiAPIHelper.GameBindingVerificationRiskSignalResult(reason)
+		if !ok {
+			return signal, false
+		}
+		signal.Success = success
+		signal.Server = metadataString(row.Metadata, "server")
+		signal.GameUserID = metadataString(row.Metadata, "gameUserID")
+	}
+	return signal, true
+}
+
+func metadataString(metadata map[string]any, key string) string {
+	value, ok := metadata[key].(string)
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(value)
+}
+
+// replayUploadLogs replays upload_logs rows, oldest first. Upload logs do not
+// store the client IP.
+func replayUploadLogs(ctx context.Context, db *postgresql.Client, from, to time.Time, maxSignals int, replay func(harukiAPIHelper.RiskSignal)) (int, bool, error) {
+	scanned := 0
+	var afterTime time.Time
+	var afterID int
+	for scanned < maxSignals {
+		query := db.UploadLog.Query().
+			Where(uploadlog.UploadTimeGTE(from), uploadlog.UploadTimeLTE(to))
+		if afterID > 0 {
+			query = query.Where(uploadlog.Or(
+				uploadlog.UploadTimeGT(afterTime),
+				uploadlog.And(uploadlog.UploadTimeEQ(afterTime), uploadlog.IDGT(afterID)),
+			))
+		}
+		limit := min(backtestPageSize, maxSignals-scanned)
+		rows, err := query.
+			Order(postgresql.Asc(uploadlog.FieldUploadTime), postgresql.Asc(uploadlog.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return scanned, false, err
+		}
+		for _, row := range rows {
+			scanned++
+			replay(harukiAPIHelper.RiskSignal{
+				Kind:       harukiAPIHelper.RiskSignalKindUpload,
+				Success:    row.Success,
+				OccurredAt: row.UploadTime,
+				UserID:     strings.TrimSpace(row.ToolboxUserID),
+				Server:     row.Server,
+				GameUserID: row.GameUserID,
+				Metadata: map[string]any{
+					"dataType":     row.DataType,
+					"uploadMethod": row.UploadMethod,
+				},
+			})
+		}
+		if len(rows) < limit {
+			return scanned, false, nil
+		}
+		last := rows[len(rows)-1]
+		afterTime, afterID = last.UploadTime, last.ID
+	}
+	more, err := db.UploadLog.Query().
+		Where(
+			uploadlog.UploadTimeLTE(to),
+			uploadlog.Or(
+				uploadlog.UploadTimeGT(afterTime),
+				uploadlog.And(uploadlog.UploadTimeEQ(afterTime), uploadlog.IDGT(afterID)),
+			),
+		).
+		Exist(ctx)
+	return scanned, more, err
+}
@@ -0,0 +1,159 @@
+package riskengine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
+)
+
+func createLoginLog(t *testing.T, client *postgresql.Client, at time.Time, result systemlog.Result, userID, ip, reason string) {
+	t.Helper()
+	builder := client.SystemLog.Create().
+		SetEventTime(at).
+		SetAction(systemLogActionLogin).
+		SetResult(result).
+		SetIP(ip).
+		SetMetadata(map[string]any{"reason": reason})
+	if userID != "" {
+		builder.SetTargetID(userID)
+	}
+	if err := builder.Exec(context.Background()); err != nil {
+		t.Fatalf("create login log: %v", err)
+	}
+}
+
+func TestBacktestLoginRuleReportsWithoutWriting(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:risk-backtest-login-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		createLoginLog(t, client, base.Add(time.Duration(i)*time.Second), systemlog.ResultFailure, "", "203.0.113.7", "invalid_credentials")
+	}
+	// Service failures are not replayed, matching the live handler.
+	for i := 0; i < 5; i++ {
+		createLoginLog(t, client, base.Add(time.Duration(i)*time.Second), systemlog.ResultFailure, "", "198.51.100.9", "identity_provider_unavailable")
+	}
+	createLoginLog(t, client, base.Add(10*time.Second), systemlog.ResultFailure, "u1", "192.0.2.1", "banned")
+	createLoginLog(t, client, base.Add(11*time.Second), systemlog.ResultFailure, "u1", "192.0.2.1", "banned")
+	createLoginLog(t, client, base.Add(12*time.Second), systemlog.ResultFailure, "u1", "192.0.2.1", "banned")
+	// Outside the requested range.
+	createLoginLog(t, client, base.Add(-time.Hour), systemlog.ResultFailure, "", "192.0.2.1", "invalid_credentials")
+
+	config := map[string]any{
+		"type":          "threshold",
+		"event":         "login",
+		"groupBy":       "ip",
+		"threshold":     3,
+		"windowSeconds": 60,
+		"actions":       []any{"temp_ban"},
+	}
+	report, err := Backtest(context.Background(), client, "login-burst", config, base.Add(-time.Minute), base.Add(time.Minute), 0)
+	if err != nil {
+		t.Fatalf("Backtest returned error: %v", err)
+	}
+	if report.SignalsScanned != 12 || report.Truncated {
+		t.Fatalf("scanned = %d truncated = %v", report.SignalsScanned, report.Truncated)
+	}
+	if report.TriggerCount != 2 || report.SubjectCount != 2 {
+		t.Fatalf("triggers = %d subjects = %d", report.TriggerCount, report.SubjectCount)
+	}
+	if report.Subjects[0].Value != "192.0.2.1" || report.Subjects[1].Value != "203.0.113.7" {
+		t.Fatalf("subjects = %+v", report.Subjects)
+	}
+	if report.Subjects[1].MaxCount != 3 || !report.Subjects[1].FirstTriggeredAt.Equal(base.Add(2*time.Second)) {
+		t.Fatalf("subject = %+v", report.Subjects[1])
+	}
+	if report.UserCount != 1 || report.Users[0].Value != "u1" || report.IPCount != 2 {
+		t.Fatalf("users = %+v ips = %+v", report.Users, report.IPs)
+	}
+
+	events, err := client.RiskEvent.Query().Count(context.Background())
+	if err != nil {
+		t.Fatalf("count risk events: %v", err)
+	}
+	if events != 0 {
+		t.Fatalf("backtest wrote %d risk events", events)
+	}
+}
+
+func TestBacktestUploadRuleAndTruncation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:risk-backtest-upload-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		if err := client.UploadLog.Create().
+			SetServer("jp").
+			SetGameUserID("1001").
+			SetToolboxUserID("u2").
+			SetDataType("suite").
+			SetUploadMethod("manual").
+			SetSuccess(false).
+			SetUploadTime(base.Add(time.Duration(i) * time.Minute)).
+			Exec(ctx); err != nil {
+			t.Fatalf("create upload log: %v", err)
+		}
+	}
+
+	config := map[string]any{
+		"type":          "sliding_window",
+		"event":         "upload",
+		"match":         map[string]any{"uploadMethod": []any{"manual"}, "failureStage": []any{"decode"}},
+		"groupBy":       "game_account",
+		"distinctBy":    "",
+		"threshold":     2,
+		"windowSeconds": 600,
+	}
+	report, err := Backtest(ctx, client, "uploads", config, base, base.Add(time.Hour), 3)
+	if err != nil {
+		t.Fatalf("Backtest returned error: %v", err)
+	}
+	if report.SignalsScanned != 3 || !report.Truncated {
+		t.Fatalf("scanned = %d truncated = %v", report.SignalsScanned, report.Truncated)
+	}
+	if report.TriggerCount != 0 || len(report.Notes) != 1 || !strings.Contains(report.Notes[0], "failureStage") {
+		t.Fatalf("triggers = %d notes = %v", report.TriggerCount, report.Notes)
+	}
+
+	delete(config["match"].(map[string]any), "failureStage")
+	report, err = Backtest(ctx, client, "uploads", config, base, base.Add(time.Hour), 0)
+	if err != nil {
+		t.Fatalf("Backtest returned error: %v", err)
+	}
+	if report.SignalsScanned != 5 || report.Truncated || report.TriggerCount != 1 {
+		t.Fatalf("scanned = %d truncated = %v triggers = %d", report.SignalsScanned, report.Truncated, report.TriggerCount)
+	}
+	if report.Subjects[0].Value != "jp:1001" || report.Users[0].Value != "u2" || report.IPCount != 0 {
+		t.Fatalf("report = %+v", report)
+	}
+}
+
+func TestBacktestRejectsInvalidConfig(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:risk-backtest-invalid-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	now := time.Now()
+	for _, config := range []map[string]any{
+		{"note": "manual"},
+		{"type": "threshold", "event": "login"},
+	} {
+		_, err := Backtest(context.Background(), client, "bad", config, now.Add(-time.Hour), now, 0)
+		if !errors.Is(err, ErrInvalidRuleConfig) {
+			t.Fatalf("Backtest(%v) error = %v, want ErrInvalidRuleConfig", config, err)
+		}
+	}
+}
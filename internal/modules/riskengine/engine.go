@@ -0,0 +1,267 @@
+package riskengine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskrule"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	sql "entgo.io/ent/dialect/sql"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	EventSource = "engine"
+
+	riskEngineOperationTimeout = 10 * time.Second
+	maxRiskEventTextLength     = 300
+)
+
+var riskEngineNow = time.Now
+
+type Options struct {
+	QueueSize           int
+	RuleRefreshInterval time.Duration
+	SweepInterval       time.Duration
+}
+
+func DefaultOptions() Options {
+	return Options{
+		QueueSize:           1024,
+		RuleRefreshInterval: 30 * time.Second,
+		SweepInterval:       time.Minute,
+	}
+}
+
+// Engine evaluates RiskRule configs against risk signals published by the
+// login, upload, game binding and OAuth2 handlers. Window state and
+// cooldowns are kept in Redis, so replicas count each other's signals; see
+// sharedRuleEvaluator for what happens while Redis is down.
+type Engine struct {
+	apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
+	db        *postgresql.Client
+	logger    *harukiLogger.Logger
+	options   Options
+	actions   actionExecutor
+	signals   chan harukiAPIHelper.RiskSignal
+	dropped   atomic.Int64
+
+	// rules, invalid and evaluator are only touched by the engine goroutine.
+	rules     []*compiledRule
+	invalid   map[string]time.Time
+	evaluator *sharedRuleEvaluator
+}
+
+func NewEngine(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger, options Options) *Engine {
+	defaults := DefaultOptions()
+	if options.QueueSize <= 0 {
+		options.QueueSize = defaults.QueueSize
+	}
+	if options.RuleRefreshInterval <= 0 {
+		options.RuleRefreshInterval = defaults.RuleRefreshInterval
+	}
+	if options.SweepInterval <= 0 {
+		options.SweepInterval = defaults.SweepInterval
+	}
+	var rdb *redis.Client
+	if apiHelper.DBManager.Redis != nil {
+		rdb = apiHelper.DBManager.Redis.Redis
+	}
+	return &Engine{
+		apiHelper: apiHelper,
+		db:        apiHelper.DBManager.DB,
+		logger:    logger,
+		options:   options,
+		actions:   userActionExecutor{apiHelper: apiHelper},
+		signals:   make(chan harukiAPIHelper.RiskSignal, options.QueueSize),
+		invalid:   make(map[string]time.Time),
+		evaluator: newSharedRuleEvaluator(rdb),
+	}
+}
+
+// PublishRiskSignal queues signal for evaluation. When the queue is full the
+// signal is dropped rather than slowing down the request that produced it.
+func (e *Engine) PublishRiskSignal(signal harukiAPIHelper.RiskSignal) {
+	select {
+	case e.signals <- signal:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// Start loads the rules and evaluates signals until ctx is cancelled. Call
+// the returned wait before closing the database.
+func (e *Engine) Start(ctx context.Context) func() {
+	e.refreshRules(ctx)
+	e.sweep(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		refresh := time.NewTicker(e.options.RuleRefreshInterval)
+		defer refresh.Stop()
+		sweep := time.NewTicker(e.options.SweepInterval)
+		defer sweep.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case signal := <-e.signals:
+				e.evaluate(ctx, signal)
+			case <-refresh.C:
+				e.refreshRules(ctx)
+			case <-sweep.C:
+				e.sweep(ctx)
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+func (e *Engine) refreshRules(ctx context.Context) {
+	queryCtx, cancel := context.WithTimeout(ctx, riskEngineOperationTimeout)
+	defer cancel()
+	rows, err := e.db.RiskRule.Query().Order(riskrule.ByRuleKey(sql.OrderAsc())).All(queryCtx)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Warnf("Failed to load risk rules: %v", err)
+		}
+		return
+	}
+	rules := make([]*compiledRule, 0, len(rows))
+	seen := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		if !isEngineRuleConfig(row.Config) {
+			continue
+		}
+		rule, err := compileRule(row.RuleKey, row.Config)
+		if err != nil {
+			seen[row.RuleKey] = struct{}{}
+			if logged, ok := e.invalid[row.RuleKey]; !ok || !logged.Equal(row.UpdatedAt) {
+				e.invalid[row.RuleKey] = row.UpdatedAt
+				e.logger.Warnf("Skipping risk rule %s: %v", row.RuleKey, err)
+			}
+			continue
+		}
+		if rule.enabled {
+			rules = append(rules, rule)
+		}
+	}
+	for key := range e.invalid {
+		if _, ok := seen[key]; !ok {
+			delete(e.invalid, key)
+		}
+	}
+	e.rules = rules
+}
+
+func (e *Engine) evaluate(ctx context.Context, signal harukiAPIHelper.RiskSignal) {
+	if signal.OccurredAt.IsZero() {
+		signal.OccurredAt = riskEngineNow()
+	}
+	evalCtx, cancel := context.WithTimeout(ctx, riskEngineOperationTimeout)
+	defer cancel()
+	for _, rule := range e.rules {
+		if group, count, fired := e.evaluator.evaluate(evalCtx, rule, signal); fired {
+			e.fire(ctx, rule, group, count, signal)
+		}
+	}
+}
+
+func (e *Engine) fire(ctx context.Context, rule *compiledRule, group string, count int, signal harukiAPIHelper.RiskSignal) {
+	fireCtx, cancel := context.WithTimeout(ctx, riskEngineOperationTimeout)
+	defer cancel()
+
+	actionResults := e.applyActions(fireCtx, rule, signal)
+	metadata := map[string]any{
+		"rule":          rule.key,
+		"ruleType":      rule.ruleType,
+		"event":         rule.event,
+		"groupBy":       rule.groupBy,
+		"groupValue":    group,
+		"count":         count,
+		"threshold":     rule.threshold,
+		"windowSeconds": int(rule.window.Seconds()),
+	}
+	if rule.distinctBy != "" {
+		metadata["distinctBy"] = rule.distinctBy
+	}
+	if signal.Server != "" {
+		metadata["server"] = signal.Server
+		metadata["gameUserId"] = signal.GameUserID
+	}
+	if signal.ClientID != "" {
+		metadata["clientId"] = signal.ClientID
+	}
+	if len(actionResults) > 0 {
+		metadata["actions"] = actionResults
+	}
+
+	builder := e.db.RiskEvent.Create().
+		SetEventTime(signal.OccurredAt.UTC()).
+		SetStatus(riskevent.StatusOpen).
+		SetSeverity(rule.severity).
+		SetSource(EventSource).
+		SetAction(truncateRiskEventText("rule."+rule.key, 128)).
+		SetReason(truncateRiskEventText(describeTrigger(rule, group, count), maxRiskEventTextLength)).
+		SetMetadata(metadata)
+	if signal.UserID != "" {
+		builder.SetTargetUserID(truncateRiskEventText(signal.UserID, 64))
+	}
+	if signal.IP != "" {
+		builder.SetIP(truncateRiskEventText(signal.IP, 128))
+	}
+	if err := builder.Exec(fireCtx); err != nil {
+		e.logger.Warnf("Failed to record risk event for rule %s: %v", rule.key, err)
+		return
+	}
+	e.logger.Infof("Risk rule %s triggered for %s=%s (count=%d)", rule.key, rule.groupBy, group, count)
+}
+
+func describeTrigger(rule *compiledRule, group string, count int) string {
+	what := fmt.Sprintf("%d %s %s event(s)", count, rule.result, rule.event)
+	if rule.result == resultAny {
+		what = fmt.Sprintf("%d %s event(s)", count, rule.event)
+	}
+	if rule.ruleType == RuleTypeVelocity {
+		what = fmt.Sprintf("%d distinct %s value(s) in %s events", count, rule.distinctBy, rule.event)
+	}
+	return fmt.Sprintf("%s for %s %s within %s", what, rule.groupBy, group, rule.window)
+}
+
+func truncateRiskEventText(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	return strings.ToValidUTF8(value[:limit], "")
+}
+
+func (e *Engine) sweep(ctx context.Context) {
+	now := riskEngineNow()
+	sweepCtx, cancel := context.WithTimeout(ctx, riskEngineOperationTimeout)
+	defer cancel()
+	lifted, err := e.db.LiftExpiredTemporaryBans(sweepCtx, now)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Warnf("Failed to lift expired temporary bans: %v", err)
+		}
+	} else if lifted > 0 {
+		e.logger.Infof("Lifted %d expired temporary ban(s)", lifted)
+	}
+	if dropped := e.dropped.Swap(0); dropped > 0 {
+		e.logger.Warnf("Dropped %d risk signal(s) because the queue was full", dropped)
+	}
+	if fallbacks := e.evaluator.takeFallbacks(); fallbacks > 0 {
+		e.logger.Warnf("Evaluated %d risk rule check(s) against local windows because Redis was unavailable", fallbacks)
+	}
+	e.evaluator.prune(e.rules, now)
+}
@@ -0,0 +1,446 @@
+package riskengine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+)
+
+type recordedAction struct {
+	action string
+	userID string
+	until  time.Time
+}
+
+type fakeActionExecutor struct {
+	mu      sync.Mutex
+	calls   []recordedAction
+	owners  map[string]string
+	applied bool
+}
+
+func (f *fakeActionExecutor) record(action, userID string, until time.Time) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, recordedAction{action: action, userID: userID, until: until})
+	return f.applied, nil
+}
+
+func (f *fakeActionExecutor) TemporarilyBan(_ context.Context, userID, _ string, until time.Time) (bool, error) {
+	return f.record(ActionTempBan, userID, until)
+}
+
+func (f *fakeActionExecutor) ForceLogout(_ context.Context, userID string) (bool, error) {
+	return f.record(ActionForceLogout, userID, time.Time{})
+}
+
+func (f *fakeActionExecutor) BlockUploads(_ context.Context, userID string, until time.Time) (bool, error) {
+	return f.record(ActionUploadBlock, userID, until)
+}
+
+func (f *fakeActionExecutor) GameAccountOwner(_ context.Context, server, gameUserID string) (string, error) {
+	return f.owners[server+":"+gameUserID], nil
+}
+
+func (f *fakeActionExecutor) snapshot() []recordedAction {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]recordedAction(nil), f.calls...)
+}
+
+func newTestEngine(t *testing.T, name string) (*Engine, *postgresql.Client, *fakeActionExecutor) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	apiHelper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager: &database.HarukiToolboxDBManager{DB: client},
+	}
+	engine := NewEngine(apiHelper, harukiLogger.NewLogger("RiskEngineTest", "DEBUG", io.Discard), DefaultOptions())
+	actions := &fakeActionExecutor{owners: map[string]string{}, applied: true}
+	engine.actions = actions
+	return engine, client, actions
+}
+
+// newTestReplicas returns two engines that share one database and one Redis,
+// like two replicas of the backend.
+func newTestReplicas(t *testing.T, name string) (*Engine, *Engine, *postgresql.Client, *miniredis.Miniredis) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr(), MaxRetries: -1})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	apiHelper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager: &database.HarukiToolboxDBManager{DB: client, Redis: &harukiRedis.HarukiRedisManager{Redis: rdb}},
+	}
+	newReplica := func() *Engine {
+		engine := NewEngine(apiHelper, harukiLogger.NewLogger("RiskEngineTest", "DEBUG", io.Discard), DefaultOptions())
+		engine.actions = &fakeActionExecutor{owners: map[string]string{}, applied: true}
+		return engine
+	}
+	return newReplica(), newReplica(), client, srv
+}
+
+func createTestRule(t *testing.T, client *postgresql.Client, key string, config map[string]any) {
+	t.Helper()
+	if err := client.RiskRule.Create().SetRuleKey(key).SetConfig(config).Exec(context.Background()); err != nil {
+		t.Fatalf("create rule %s: %v", key, err)
+	}
+}
+
+func engineEvents(t *testing.T, client *postgresql.Client) []*postgresql.RiskEvent {
+	t.Helper()
+	rows, err := client.RiskEvent.Query().Order(postgresql.Asc(riskevent.FieldID)).All(context.Background())
+	if err != nil {
+		t.Fatalf("query risk events: %v", err)
+	}
+	return rows
+}
+
+func TestEngineThresholdRuleFiresOncePerCooldown(t *testing.T) {
+	engine, client, actions := newTestEngine(t, "risk-engine-threshold-test")
+	ctx := context.Background()
+	createTestRule(t, client, "login-failures", map[string]any{
+		"type":          "threshold",
+		"event":         "login",
+		"groupBy":       "ip",
+		"threshold":     3,
+		"windowSeconds": 60,
+		"severity":      "high",
+		"actions":       []any{"temp_ban", "force_logout"},
+	})
+	createTestRule(t, client, "manual-note", map[string]any{"note": "not an engine rule"})
+	engine.refreshRules(ctx)
+	if len(engine.rules) != 1 {
+		t.Fatalf("loaded rules = %d, want 1", len(engine.rules))
+	}
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	login := func(at time.Time, success bool) {
+		engine.evaluate(ctx, harukiAPIHelper.RiskSignal{
+			Kind:       harukiAPIHelper.RiskSignalKindLogin,
+			Success:    success,
+			OccurredAt: at,
+			UserID:     "u1",
+			IP:         "203.0.113.7",
+		})
+	}
+	login(base, false)
+	login(base.Add(time.Second), true)
+	login(base.Add(2*time.Second), false)
+	if events := engineEvents(t, client); len(events) != 0 {
+		t.Fatalf("events before threshold = %d", len(events))
+	}
+	login(base.Add(3*time.Second), false)
+	login(base.Add(4*time.Second), false)
+
+	events := engineEvents(t, client)
+	if len(events) != 1 {
+		t.Fatalf("events after threshold = %d, want 1", len(events))
+	}
+	event := events[0]
+	if event.Source != EventSource || event.Action == nil || *event.Action != "rule.login-failures" || event.Severity != riskevent.SeverityHigh {
+		t.Fatalf("event = source %q action %v severity %q", event.Source, event.Action, event.Severity)
+	}
+	if event.TargetUserID == nil || *event.TargetUserID != "u1" || event.IP == nil || *event.IP != "203.0.113.7" {
+		t.Fatalf("event target = %v ip = %v", event.TargetUserID, event.IP)
+	}
+	if event.Metadata["rule"] != "login-failures" || event.Metadata["groupValue"] != "203.0.113.7" {
+		t.Fatalf("event metadata = %v", event.Metadata)
+	}
+
+	calls := actions.snapshot()
+	if len(calls) != 2 || calls[0].action != ActionTempBan || calls[1].action != ActionForceLogout {
+		t.Fatalf("actions = %+v", calls)
+	}
+	if !calls[0].until.Equal(base.Add(3*time.Second + defaultBanDuration)) {
+		t.Fatalf("ban until = %s", calls[0].until)
+	}
+
+	// A later window reaches the threshold again once the cooldown has passed.
+	next := base.Add(2 * time.Minute)
+	login(next, false)
+	login(next.Add(time.Second), false)
+	login(next.Add(2*time.Second), false)
+	if events := engineEvents(t, client); len(events) != 2 {
+		t.Fatalf("events after second window = %d, want 2", len(events))
+	}
+}
+
+func TestEngineSlidingWindowRule(t *testing.T) {
+	engine, client, _ := newTestEngine(t, "risk-engine-sliding-test")
+	ctx := context.Background()
+	createTestRule(t, client, "binding-failures", map[string]any{
+		"type":            "sliding_window",
+		"event":           "game_binding_verification",
+		"groupBy":         "user",
+		"threshold":       3,
+		"windowSeconds":   60,
+		"cooldownSeconds": 1,
+	})
+	engine.refreshRules(ctx)
+
+	base := time.Date(2026, 5, 1, 12, 0, 50, 0, time.UTC)
+	fail := func(at time.Time) {
+		engine.evaluate(ctx, harukiAPIHelper.RiskSignal{
+			Kind:       harukiAPIHelper.RiskSignalKindGameBindingVerification,
+			OccurredAt: at,
+			UserID:     "u2",
+			Server:     "jp",
+			GameUserID: "1001",
+		})
+	}
+	// Three failures straddle a minute boundary, which a fixed window would
+	// split, but stay within 60 seconds of each other.
+	fail(base)
+	fail(base.Add(5 * time.Second))
+	fail(base.Add(20 * time.Second))
+	if events := engineEvents(t, client); len(events) != 1 {
+		t.Fatalf("events = %d, want 1", len(events))
+	}
+
+	// The first failure slides out, but the window still holds three.
+	fail(base.Add(60 * time.Second))
+	if events := engineEvents(t, client); len(events) != 2 {
+		t.Fatalf("events after sliding = %d, want 2", len(events))
+	}
+	fail(base.Add(200 * time.Second))
+	if events := engineEvents(t, client); len(events) != 2 {
+		t.Fatalf("events after window emptied = %d, want 2", len(events))
+	}
+}
+
+func TestEngineVelocityRuleBlocksBindingOwner(t *testing.T) {
+	engine, client, actions := newTestEngine(t, "risk-engine-velocity-test")
+	ctx := context.Background()
+	createTestRule(t, client, "upload-ip-spread", map[string]any{
+		"type":               "velocity",
+		"event":              "upload",
+		"result":             "any",
+		"groupBy":            "game_account",
+		"distinctBy":         "ip",
+		"threshold":          3,
+		"windowSeconds":      600,
+		"actions":            []any{"upload_block"},
+		"uploadBlockSeconds": 3600,
+	})
+	engine.refreshRules(ctx)
+	actions.owners["jp:1001"] = "owner"
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	upload := func(at time.Time, ip string) {
+		engine.evaluate(ctx, harukiAPIHelper.RiskSignal{
+			Kind:       harukiAPIHelper.RiskSignalKindUpload,
+			Success:    true,
+			OccurredAt: at,
+			IP:         ip,
+			Server:     "jp",
+			GameUserID: "1001",
+		})
+	}
+	upload(base, "198.51.100.1")
+	upload(base.Add(time.Second), "198.51.100.1")
+	upload(base.Add(2*time.Second), "198.51.100.2")
+	if events := engineEvents(t, client); len(events) != 0 {
+		t.Fatalf("events with two distinct ips = %d", len(events))
+	}
+	upload(base.Add(3*time.Second), "198.51.100.3")
+
+	events := engineEvents(t, client)
+	if len(events) != 1 {
+		t.Fatalf("events = %d, want 1", len(events))
+	}
+	if events[0].TargetUserID != nil {
+		t.Fatalf("anonymous upload event target = %v", *events[0].TargetUserID)
+	}
+	calls := actions.snapshot()
+	if len(calls) != 1 || calls[0].action != ActionUploadBlock || calls[0].userID != "owner" {
+		t.Fatalf("actions = %+v", calls)
+	}
+	if !calls[0].until.Equal(base.Add(3*time.Second + time.Hour)) {
+		t.Fatalf("upload block until = %s", calls[0].until)
+	}
+}
+
+func TestEngineSkipsInvalidAndDisabledRules(t *testing.T) {
+	engine, client, _ := newTestEngine(t, "risk-engine-invalid-test")
+	ctx := context.Background()
+	createTestRule(t, client, "broken", map[string]any{"type": "threshold", "event": "login"})
+	createTestRule(t, client, "disabled", map[string]any{
+		"enabled":       false,
+		"type":          "threshold",
+		"event":         "login",
+		"groupBy":       "ip",
+		"threshold":     1,
+		"windowSeconds": 60,
+	})
+	engine.refreshRules(ctx)
+	if len(engine.rules) != 0 {
+		t.Fatalf("loaded rules = %d, want 0", len(engine.rules))
+	}
+	if _, ok := engine.invalid["broken"]; !ok {
+		t.Fatalf("invalid rule was not remembered")
+	}
+}
+
+func TestEngineStartEvaluatesPublishedSignalsAndLiftsBans(t *testing.T) {
+	engine, client, _ := newTestEngine(t, "risk-engine-start-test")
+	createTestRule(t, client, "oauth-burst", map[string]any{
+		"type":          "threshold",
+		"event":         "oauth2_token_grant",
+		"groupBy":       "client",
+		"threshold":     1,
+		"windowSeconds": 60,
+	})
+	if _, err := client.User.Create().
+		SetID("expired").
+		SetName("expired").
+		SetEmail("expired@example.com").
+		SetBanned(true).
+		SetBanExpiresAt(time.Now().Add(-time.Minute)).
+		Save(context.Background()); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wait := engine.Start(ctx)
+	engine.PublishRiskSignal(harukiAPIHelper.RiskSignal{
+		Kind:     harukiAPIHelper.RiskSignalKindOAuth2TokenGrant,
+		ClientID: "client-a",
+	})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		count, err := client.RiskEvent.Query().Where(riskevent.SourceEQ(EventSource)).Count(context.Background())
+		if err != nil {
+			t.Fatalf("count events: %v", err)
+		}
+		if count == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("engine did not record the risk event")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	wait()
+
+	expired, err := client.User.Get(context.Background(), "expired")
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	if expired.Banned || expired.BanExpiresAt != nil {
+		t.Fatalf("expired temporary ban was not lifted")
+	}
+}
+
+func TestEngineReplicasShareWindowsThroughRedis(t *testing.T) {
+	first, second, client, _ := newTestReplicas(t, "risk-engine-replicas-test")
+	ctx := context.Background()
+	createTestRule(t, client, "login-failures", map[string]any{
+		"type":          "threshold",
+		"event":         "login",
+		"groupBy":       "ip",
+		"threshold":     3,
+		"windowSeconds": 60,
+	})
+	createTestRule(t, client, "binding-failures", map[string]any{
+		"type":          "sliding_window",
+		"event":         "game_binding_verification",
+		"groupBy":       "user",
+		"threshold":     3,
+		"windowSeconds": 60,
+	})
+	createTestRule(t, client, "upload-ip-spread", map[string]any{
+		"type":          "velocity",
+		"event":         "upload",
+		"result":        "any",
+		"groupBy":       "game_account",
+		"distinctBy":    "ip",
+		"threshold":     3,
+		"windowSeconds": 60,
+	})
+	first.refreshRules(ctx)
+	second.refreshRules(ctx)
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	replicas := []*Engine{first, second}
+	send := func(i int, signal harukiAPIHelper.RiskSignal) {
+		signal.OccurredAt = base.Add(time.Duration(i) * time.Second)
+		replicas[i%2].evaluate(ctx, signal)
+	}
+	// Each replica sees at most two of the three signals a rule needs.
+	for i := range 3 {
+		send(i, harukiAPIHelper.RiskSignal{Kind: harukiAPIHelper.RiskSignalKindLogin, IP: "203.0.113.7"})
+		send(i, harukiAPIHelper.RiskSignal{Kind: harukiAPIHelper.RiskSignalKindGameBindingVerification, UserID: "u2"})
+		send(i, harukiAPIHelper.RiskSignal{Kind: harukiAPIHelper.RiskSignalKindUpload, Success: true, Server: "jp", GameUserID: "1001", IP: fmt.Sprintf("198.51.100.%d", i+1)})
+	}
+	fired := map[string]int{}
+	for _, event := range engineEvents(t, client) {
+		fired[event.Metadata["rule"].(string)]++
+	}
+	if len(fired) != 3 || fired["login-failures"] != 1 || fired["binding-failures"] != 1 || fired["upload-ip-spread"] != 1 {
+		t.Fatalf("fired rules = %v, want each rule once", fired)
+	}
+
+	// The cooldown started by one replica holds on the other.
+	send(3, harukiAPIHelper.RiskSignal{Kind: harukiAPIHelper.RiskSignalKindGameBindingVerification, UserID: "u2"})
+	send(4, harukiAPIHelper.RiskSignal{Kind: harukiAPIHelper.RiskSignalKindGameBindingVerification, UserID: "u2"})
+	if events := engineEvents(t, client); len(events) != 3 {
+		t.Fatalf("events during cooldown = %d, want 3", len(events))
+	}
+	if first.evaluator.takeFallbacks() != 0 || second.evaluator.takeFallbacks() != 0 {
+		t.Fatalf("replicas fell back to local windows with Redis up")
+	}
+}
+
+func TestEngineFallsBackToLocalWindowsWhenRedisIsDown(t *testing.T) {
+	engine, _, client, srv := newTestReplicas(t, "risk-engine-redis-down-test")
+	ctx := context.Background()
+	createTestRule(t, client, "login-failures", map[string]any{
+		"type":          "sliding_window",
+		"event":         "login",
+		"groupBy":       "ip",
+		"threshold":     2,
+		"windowSeconds": 60,
+	})
+	engine.refreshRules(ctx)
+	srv.Close()
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := range 2 {
+		engine.evaluate(ctx, harukiAPIHelper.RiskSignal{
+			Kind:       harukiAPIHelper.RiskSignalKindLogin,
+			OccurredAt: base.Add(time.Duration(i) * time.Second),
+			IP:         "203.0.113.7",
+		})
+	}
+	if events := engineEvents(t, client); len(events) != 1 {
+		t.Fatalf("events = %d, want 1", len(events))
+	}
+	if fallbacks := engine.evaluator.takeFallbacks(); fallbacks != 2 {
+		t.Fatalf("fallbacks = %d, want 2", fallbacks)
+	}
+}
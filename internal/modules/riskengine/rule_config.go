@@ -0,0 +1,226 @@
+package riskengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
+)
+
+const (
+	RuleTypeThreshold     = "threshold"
+	RuleTypeSlidingWindow = "sliding_window"
+	RuleTypeVelocity      = "velocity"
+
+	ActionTempBan     = "temp_ban"
+	ActionForceLogout = "force_logout"
+	ActionUploadBlock = "upload_block"
+
+	resultFailure = "failure"
+	resultSuccess = "success"
+	resultAny     = "any"
+
+	groupByIP          = "ip"
+	groupByUser        = "user"
+	groupByEmail       = "email"
+	groupByGameAccount = "game_account"
+	groupByClient      = "client"
+
+	defaultBanDuration         = 24 * time.Hour
+	defaultUploadBlockDuration = 24 * time.Hour
+	maxRuleThreshold           = 10000
+	maxRuleWindow              = 7 * 24 * time.Hour
+)
+
+var (
+	validRuleTypes   = []string{RuleTypeThreshold, RuleTypeSlidingWindow, RuleTypeVelocity}
+	validRuleEvents  = []string{harukiAPIHelper.RiskSignalKindLogin, harukiAPIHelper.RiskSignalKindUpload, harukiAPIHelper.RiskSignalKindGameBindingVerification, harukiAPIHelper.RiskSignalKindOAuth2TokenGrant}
+	validRuleResults = []string{resultFailure, resultSuccess, resultAny}
+	validRuleGroups  = []string{groupByIP, groupByUser, groupByEmail, groupByGameAccount, groupByClient}
+	validRuleActions = []string{ActionTempBan, ActionForceLogout, ActionUploadBlock}
+)
+
+// ruleConfig is the engine's view of RiskRule.config. Rules whose config has
+// no "type" are not engine rules and are ignored.
+//
+// threshold counts matching events per fixed window, sliding_window counts
+// them over the trailing window, and velocity counts distinct distinctBy
+// values seen for one groupBy value within the trailing window. The counts
+// and cooldowns are shared by all replicas through Redis.
+type ruleConfig struct {
+	Enabled            *bool               `json:"enabled"`
+	Type               string              `json:"type"`
+	Event              string              `json:"event"`
+	Result             string              `json:"result"`
+	Match              map[string][]string `json:"match"`
+	GroupBy            string              `json:"groupBy"`
+	DistinctBy         string              `json:"distinctBy"`
+	Threshold          int                 `json:"threshold"`
+	WindowSeconds      int                 `json:"windowSeconds"`
+	CooldownSeconds    int                 `json:"cooldownSeconds"`
+	Severity           string              `json:"severity"`
+	Actions            []string            `json:"actions"`
+	BanDurationSeconds int                 `json:"banDurationSeconds"`
+	UploadBlockSeconds int                 `json:"uploadBlockSeconds"`
+}
+
+type compiledRule struct {
+	key                 string
+	enabled             bool
+	ruleType            string
+	event               string
+	result              string
+	match               map[string][]string
+	groupBy             string
+	distinctBy          string
+	threshold           int
+	window              time.Duration
+	cooldown            time.Duration
+	severity            riskevent.Severity
+	actions             []string
+	banDuration         time.Duration
+	uploadBlockDuration time.Duration
+}
+
+func isEngineRuleConfig(config map[string]any) bool {
+	_, ok := config["type"]
+	return ok
+}
+
+// ValidateRuleConfig checks an engine rule config before it is stored.
+// Configs without a "type" key are not engine rules and always pass.
+func ValidateRuleConfig(config map[string]any) error {
+	if !isEngineRuleConfig(config) {
+		return nil
+	}
+	_, err := compileRule("", config)
+	return err
+}
+
+func compileRule(key string, config map[string]any) (*compiledRule, error) {
+	raw, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("encode config: %w", err)
+	}
+	var cfg ruleConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("decode config: %w", err)
+	}
+
+	rule := &compiledRule{
+		key:        key,
+		enabled:    cfg.Enabled == nil || *cfg.Enabled,
+		ruleType:   strings.ToLower(strings.TrimSpace(cfg.Type)),
+		event:      strings.ToLower(strings.TrimSpace(cfg.Event)),
+		result:     strings.ToLower(strings.TrimSpace(cfg.Result)),
+		match:      cfg.Match,
+		groupBy:    strings.ToLower(strings.TrimSpace(cfg.GroupBy)),
+		distinctBy: strings.ToLower(strings.TrimSpace(cfg.DistinctBy)),
+		threshold:  cfg.Threshold,
+		window:     time.Duration(cfg.WindowSeconds) * time.Second,
+		severity:   riskevent.Severity(strings.ToLower(strings.TrimSpace(cfg.Severity))),
+	}
+	if !slices.Contains(validRuleTypes, rule.ruleType) {
+		return nil, fmt.Errorf("type must be one of %s", strings.Join(validRuleTypes, ", "))
+	}
+	if !slices.Contains(validRuleEvents, rule.event) {
+		return nil, fmt.Errorf("event must be one of %s", strings.Join(validRuleEvents, ", "))
+	}
+	if rule.result == "" {
+		rule.result = resultFailure
+	}
+	if !slices.Contains(validRuleResults, rule.result) {
+		return nil, fmt.Errorf("result must be one of %s", strings.Join(validRuleResults, ", "))
+	}
+	if !slices.Contains(validRuleGroups, rule.groupBy) {
+		return nil, fmt.Errorf("groupBy must be one of %s", strings.Join(validRuleGroups, ", "))
+	}
+	if rule.ruleType == RuleTypeVelocity {
+		if !slices.Contains(validRuleGroups, rule.distinctBy) || rule.distinctBy == rule.groupBy {
+			return nil, fmt.Errorf("velocity rules need a distinctBy different from groupBy")
+		}
+	} else if rule.distinctBy != "" {
+		return nil, fmt.Errorf("distinctBy is only valid for velocity rules")
+	}
+	if rule.threshold < 1 || rule.threshold > maxRuleThreshold {
+		return nil, fmt.Errorf("threshold must be between 1 and %d", maxRuleThreshold)
+	}
+	if rule.window <= 0 || rule.window > maxRuleWindow {
+		return nil, fmt.Errorf("windowSeconds must be between 1 and %d", int(maxRuleWindow.Seconds()))
+	}
+	rule.cooldown = rule.window
+	if cfg.CooldownSeconds > 0 {
+		rule.cooldown = time.Duration(cfg.CooldownSeconds) * time.Second
+	}
+	if rule.severity == "" {
+		rule.severity = riskevent.SeverityMedium
+	}
+	if err := riskevent.SeverityValidator(rule.severity); err != nil {
+		return nil, fmt.Errorf("invalid severity %q", cfg.Severity)
+	}
+	for _, action := range cfg.Actions {
+		action = strings.ToLower(strings.TrimSpace(action))
+		if !slices.Contains(validRuleActions, action) {
+			return nil, fmt.Errorf("actions must be drawn from %s", strings.Join(validRuleActions, ", "))
+		}
+		if !slices.Contains(rule.actions, action) {
+			rule.actions = append(rule.actions, action)
+		}
+	}
+	rule.banDuration = defaultBanDuration
+	if cfg.BanDurationSeconds > 0 {
+		rule.banDuration = time.Duration(cfg.BanDurationSeconds) * time.Second
+	}
+	rule.uploadBlockDuration = defaultUploadBlockDuration
+	if cfg.UploadBlockSeconds > 0 {
+		rule.uploadBlockDuration = time.Duration(cfg.UploadBlockSeconds) * time.Second
+	}
+	return rule, nil
+}
+
+func signalField(signal harukiAPIHelper.RiskSignal, field string) string {
+	switch field {
+	case groupByIP:
+		return signal.IP
+	case groupByUser:
+		return signal.UserID
+	case groupByEmail:
+		return signal.Email
+	case groupByGameAccount:
+		if signal.Server == "" || signal.GameUserID == "" {
+			return ""
+		}
+		return signal.Server + ":" + signal.GameUserID
+	case groupByClient:
+		return signal.ClientID
+	default:
+		return ""
+	}
+}
+
+func (r *compiledRule) matches(signal harukiAPIHelper.RiskSignal) bool {
+	if signal.Kind != r.event {
+		return false
+	}
+	switch r.result {
+	case resultFailure:
+		if signal.Success {
+			return false
+		}
+	case resultSuccess:
+		if !signal.Success {
+			return false
+		}
+	}
+	for field, allowed := range r.match {
+		value, ok := signal.Metadata[field]
+		if !ok || !slices.Contains(allowed, fmt.Sprint(value)) {
+			return false
+		}
+	}
+	return true
+}
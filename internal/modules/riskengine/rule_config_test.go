@@ -0,0 +1,104 @@
+package riskengine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
+)
+
+func TestValidateRuleConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  map[string]any
+		wantErr string
+	}{
+		{name: "non engine config", config: map[string]any{"note": "manual"}},
+		{name: "threshold", config: map[string]any{"type": "threshold", "event": "login", "groupBy": "ip", "threshold": 5, "windowSeconds": 60}},
+		{name: "velocity", config: map[string]any{"type": "velocity", "event": "upload", "groupBy": "game_account", "distinctBy": "ip", "threshold": 3, "windowSeconds": 600, "actions": []any{"upload_block"}}},
+		{name: "unknown type", config: map[string]any{"type": "magic"}, wantErr: "type must be one of"},
+		{name: "unknown event", config: map[string]any{"type": "threshold", "event": "logout", "groupBy": "ip", "threshold": 1, "windowSeconds": 1}, wantErr: "event must be one of"},
+		{name: "velocity without distinct", config: map[string]any{"type": "velocity", "event": "login", "groupBy": "ip", "threshold": 2, "windowSeconds": 60}, wantErr: "distinctBy"},
+		{name: "distinct on threshold", config: map[string]any{"type": "threshold", "event": "login", "groupBy": "ip", "distinctBy": "email", "threshold": 2, "windowSeconds": 60}, wantErr: "only valid for velocity"},
+		{name: "zero threshold", config: map[string]any{"type": "threshold", "event": "login", "groupBy": "ip", "windowSeconds": 60}, wantErr: "threshold must be"},
+		{name: "window too long", config: map[string]any{"type": "sliding_window", "event": "login", "groupBy": "ip", "threshold": 1, "windowSeconds": 8 * 86400}, wantErr: "windowSeconds must be"},
+		{name: "bad severity", config: map[string]any{"type": "threshold", "event": "login", "groupBy": "ip", "threshold": 1, "windowSeconds": 60, "severity": "extreme"}, wantErr: "invalid severity"},
+		{name: "bad action", config: map[string]any{"type": "threshold", "event": "login", "groupBy": "ip", "threshold": 1, "windowSeconds": 60, "actions": []any{"delete_account"}}, wantErr: "actions must be"},
+		{name: "wrong field type", config: map[string]any{"type": "threshold", "threshold": "five"}, wantErr: "decode config"},
+	}
+	for _, tt := range tests {
+		err := ValidateRuleConfig(tt.config)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("%s: ValidateRuleConfig returned error: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("%s: ValidateRuleConfig error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCompileRuleDefaults(t *testing.T) {
+	t.Parallel()
+
+	rule, err := compileRule("login-burst", map[string]any{
+		"type":          " Threshold ",
+		"event":         "login",
+		"groupBy":       "ip",
+		"threshold":     3,
+		"windowSeconds": 300,
+		"actions":       []any{"temp_ban", "TEMP_BAN"},
+	})
+	if err != nil {
+		t.Fatalf("compileRule returned error: %v", err)
+	}
+	if !rule.enabled || rule.ruleType != RuleTypeThreshold || rule.result != resultFailure {
+		t.Fatalf("compiled rule = %+v", rule)
+	}
+	if rule.cooldown != 5*time.Minute || rule.severity != riskevent.SeverityMedium || rule.banDuration != defaultBanDuration {
+		t.Fatalf("compiled defaults = cooldown %s, severity %s, ban %s", rule.cooldown, rule.severity, rule.banDuration)
+	}
+	if len(rule.actions) != 1 || rule.actions[0] != ActionTempBan {
+		t.Fatalf("compiled actions = %v", rule.actions)
+	}
+}
+
+func TestCompiledRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	rule, err := compileRule("uploads", map[string]any{
+		"type":          "sliding_window",
+		"event":         "upload",
+		"result":        "any",
+		"match":         map[string]any{"dataType": []any{"suite", "mysekai"}},
+		"groupBy":       "game_account",
+		"threshold":     2,
+		"windowSeconds": 60,
+	})
+	if err != nil {
+		t.Fatalf("compileRule returned error: %v", err)
+	}
+	signal := harukiAPIHelper.RiskSignal{
+		Kind:     harukiAPIHelper.RiskSignalKindUpload,
+		Success:  true,
+		Metadata: map[string]any{"dataType": "suite"},
+	}
+	if !rule.matches(signal) {
+		t.Fatalf("expected upload signal to match")
+	}
+	signal.Metadata["dataType"] = "other"
+	if rule.matches(signal) {
+		t.Fatalf("expected match filter to reject dataType=other")
+	}
+	signal.Kind = harukiAPIHelper.RiskSignalKindLogin
+	signal.Metadata["dataType"] = "suite"
+	if rule.matches(signal) {
+		t.Fatalf("expected login signal not to match upload rule")
+	}
+}
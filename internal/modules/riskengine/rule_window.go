@@ -0,0 +1,166 @@
+package riskengine
+
+import (
+	"strings"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+)
+
+// subjectState is the per rule and groupBy value state. Only as many
+// timestamps or distinct values as the threshold are kept, which is all a
+// rule needs to decide whether it fires.
+type subjectState struct {
+	windowStart time.Time
+	count       int
+	times       []time.Time
+	distinct    map[string]time.Time
+	lastSeen    time.Time
+	lastFired   time.Time
+}
+
+// ruleEvaluator holds the window state for each rule and groupBy value. The
+// engine and backtests share it so a replay makes the same decisions as live
+// evaluation.
+type ruleEvaluator struct {
+	state map[string]*subjectState
+}
+
+func newRuleEvaluator() *ruleEvaluator {
+	return &ruleEvaluator{state: make(map[string]*subjectState)}
+}
+
+func stateKey(rule *compiledRule, group string) string {
+	return rule.key + "\x00" + rule.ruleType + "\x00" + group
+}
+
+// evaluate feeds signal to rule and reports the groupBy value and count when
+// the rule fires. Firing starts the rule cooldown for that value.
+func (v *ruleEvaluator) evaluate(rule *compiledRule, signal harukiAPIHelper.RiskSignal) (string, int, bool) {
+	if !rule.matches(signal) {
+		return "", 0, false
+	}
+	group := signalField(signal, rule.groupBy)
+	if group == "" {
+		return "", 0, false
+	}
+	count, reached := rule.observe(v.subject(rule, group), signal)
+	if !reached {
+		return group, count, false
+	}
+	return group, count, v.claimFire(rule, group, signal.OccurredAt)
+}
+
+func (v *ruleEvaluator) subject(rule *compiledRule, group string) *subjectState {
+	key := stateKey(rule, group)
+	state, ok := v.state[key]
+	if !ok {
+		state = &subjectState{}
+		v.state[key] = state
+	}
+	return state
+}
+
+// claimFire starts the rule cooldown for group unless it is still running.
+func (v *ruleEvaluator) claimFire(rule *compiledRule, group string, at time.Time) bool {
+	state := v.subject(rule, group)
+	if !rule.canFire(state, at) {
+		return false
+	}
+	state.lastFired = at
+	return true
+}
+
+// prune drops state that can no longer affect a decision, including state
+// for rules that are no longer loaded.
+func (v *ruleEvaluator) prune(rules []*compiledRule, now time.Time) {
+	byKey := make(map[string]*compiledRule, len(rules))
+	for _, rule := range rules {
+		byKey[rule.key+"\x00"+rule.ruleType] = rule
+	}
+	for key, state := range v.state {
+		parts := strings.SplitN(key, "\x00", 3)
+		rule, ok := byKey[parts[0]+"\x00"+parts[1]]
+		if !ok || rule.expired(state, now) {
+			delete(v.state, key)
+		}
+	}
+}
+
+// observe records signal and returns the current count and whether it has
+// reached the rule threshold.
+func (r *compiledRule) observe(state *subjectState, signal harukiAPIHelper.RiskSignal) (int, bool) {
+	at := signal.OccurredAt
+	state.lastSeen = at
+	switch r.ruleType {
+	case RuleTypeThreshold:
+		windowStart := at.Truncate(r.window)
+		if !state.windowStart.Equal(windowStart) {
+			state.windowStart = windowStart
+			state.count = 0
+		}
+		state.count++
+		return state.count, state.count >= r.threshold
+	case RuleTypeSlidingWindow:
+		state.times = append(state.times, at)
+		state.times = pruneTimes(state.times, at.Add(-r.window))
+		if len(state.times) > r.threshold {
+			state.times = state.times[len(state.times)-r.threshold:]
+		}
+		return len(state.times), len(state.times) >= r.threshold
+	case RuleTypeVelocity:
+		value := signalField(signal, r.distinctBy)
+		if state.distinct == nil {
+			state.distinct = make(map[string]time.Time)
+		}
+		cutoff := at.Add(-r.window)
+		for key, seen := range state.distinct {
+			if seen.Before(cutoff) {
+				delete(state.distinct, key)
+			}
+		}
+		if value != "" {
+			if _, ok := state.distinct[value]; !ok && len(state.distinct) >= r.threshold {
+				evictOldest(state.distinct)
+			}
+			state.distinct[value] = at
+		}
+		return len(state.distinct), len(state.distinct) >= r.threshold
+	default:
+		return 0, false
+	}
+}
+
+// canFire applies the rule cooldown so a subject that stays over the
+// threshold produces one risk event per cooldown.
+func (r *compiledRule) canFire(state *subjectState, at time.Time) bool {
+	return state.lastFired.IsZero() || at.Sub(state.lastFired) >= r.cooldown
+}
+
+// expired reports whether the state can be dropped without changing any
+// future decision.
+func (r *compiledRule) expired(state *subjectState, now time.Time) bool {
+	if now.Sub(state.lastSeen) < r.window {
+		return false
+	}
+	return state.lastFired.IsZero() || now.Sub(state.lastFired) >= r.cooldown
+}
+
+func pruneTimes(times []time.Time, cutoff time.Time) []time.Time {
+	drop := 0
+	for drop < len(times) && times[drop].Before(cutoff) {
+		drop++
+	}
+	return times[drop:]
+}
+
+func evictOldest(values map[string]time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, seen := range values {
+		if oldestKey == "" || seen.Before(oldest) {
+			oldestKey, oldest = key, seen
+		}
+	}
+	delete(values, oldestKey)
+}
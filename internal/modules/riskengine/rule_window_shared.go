@@ -0,0 +1,138 @@
+package riskengine
+
+import (
+	"context"
+	"crypto/rand"
+	"strconv"
+	"time"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// riskCounterScript counts a hit in a fixed window; only the first hit
+	// sets the expiry so later hits cannot keep the window alive.
+	riskCounterScript = `
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`
+
+	// riskWindowScript adds a member scored by its time, drops members older
+	// than the cutoff and keeps at most the threshold newest members, which is
+	// all a rule needs. An empty member only counts what is already there.
+	riskWindowScript = `
+if ARGV[2] ~= '' then
+  redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+end
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[3])
+local limit = tonumber(ARGV[4])
+local count = redis.call('ZCARD', KEYS[1])
+if count > limit then
+  redis.call('ZREMRANGEBYRANK', KEYS[1], 0, count - limit - 1)
+  count = limit
+end
+if count > 0 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[5])
+end
+return count
+`
+
+	// riskCooldownScript starts the cooldown unless the last firing is less
+	// than a cooldown before this signal. Times are signal times in
+	// milliseconds, matching the in-memory evaluator.
+	riskCooldownScript = `
+local last = redis.call('GET', KEYS[1])
+if last and tonumber(ARGV[1]) - tonumber(last) < tonumber(ARGV[2]) then
+  return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`
+)
+
+// sharedRuleEvaluator keeps rule windows and cooldowns in Redis, so a rule
+// counts the signals every instance received and fires once per cooldown
+// across all of them. When Redis cannot be reached the signal is evaluated
+// against the local ruleEvaluator instead, which only sees this instance's
+// signals until Redis is back.
+type sharedRuleEvaluator struct {
+	rdb       *redis.Client
+	local     *ruleEvaluator
+	fallbacks int
+}
+
+func newSharedRuleEvaluator(rdb *redis.Client) *sharedRuleEvaluator {
+	return &sharedRuleEvaluator{rdb: rdb, local: newRuleEvaluator()}
+}
+
+func (v *sharedRuleEvaluator) evaluate(ctx context.Context, rule *compiledRule, signal harukiAPIHelper.RiskSignal) (string, int, bool) {
+	if v.rdb == nil {
+		return v.local.evaluate(rule, signal)
+	}
+	if !rule.matches(signal) {
+		return "", 0, false
+	}
+	group := signalField(signal, rule.groupBy)
+	if group == "" {
+		return "", 0, false
+	}
+	count, err := rule.observeShared(ctx, v.rdb, group, signal)
+	if err != nil {
+		v.fallbacks++
+		return v.local.evaluate(rule, signal)
+	}
+	if count < rule.threshold {
+		return group, count, false
+	}
+	at := signal.OccurredAt
+	fired, err := v.rdb.Eval(ctx, riskCooldownScript, []string{harukiRedis.BuildRiskEngineCooldownKey(rule.key, rule.ruleType, group)}, at.UnixMilli(), rule.cooldown.Milliseconds()).Int()
+	if err != nil {
+		v.fallbacks++
+		return group, count, v.local.claimFire(rule, group, at)
+	}
+	return group, count, fired == 1
+}
+
+// prune drops local fallback state; the Redis keys expire on their own.
+func (v *sharedRuleEvaluator) prune(rules []*compiledRule, now time.Time) {
+	v.local.prune(rules, now)
+}
+
+// takeFallbacks returns how many signals fell back to local state since the
+// last call.
+func (v *sharedRuleEvaluator) takeFallbacks() int {
+	fallbacks := v.fallbacks
+	v.fallbacks = 0
+	return fallbacks
+}
+
+// observeShared is observe with the state kept in Redis.
+func (r *compiledRule) observeShared(ctx context.Context, rdb *redis.Client, group string, signal harukiAPIHelper.RiskSignal) (int, error) {
+	at := signal.OccurredAt
+	switch r.ruleType {
+	case RuleTypeThreshold:
+		windowStart := at.Truncate(r.window)
+		key := harukiRedis.BuildRiskEngineCounterKey(r.key, r.ruleType, windowStart.Unix(), group)
+		return rdb.Eval(ctx, riskCounterScript, []string{key}, (2 * r.window).Milliseconds()).Int()
+	case RuleTypeSlidingWindow:
+		// Every event is its own member; the random suffix keeps events with
+		// the same timestamp on different instances apart.
+		member := strconv.FormatInt(at.UnixNano(), 10) + ":" + rand.Text()
+		return r.observeWindow(ctx, rdb, group, at, member)
+	case RuleTypeVelocity:
+		return r.observeWindow(ctx, rdb, group, at, signalField(signal, r.distinctBy))
+	default:
+		return 0, nil
+	}
+}
+
+func (r *compiledRule) observeWindow(ctx context.Context, rdb *redis.Client, group string, at time.Time, member string) (int, error) {
+	key := harukiRedis.BuildRiskEngineWindowKey(r.key, r.ruleType, group)
+	return rdb.Eval(ctx, riskWindowScript, []string{key}, at.UnixMilli(), member, at.Add(-r.window).UnixMilli(), r.threshold, r.window.Milliseconds()).Int()
+}
@@ -5,6 +5,12 @@ import (
 	"fmt"
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 var uploadSemaphore = make(chan struct{}, 10)
@@ -29,15 +35,29 @@ func HandleUpload(
 	helper *harukiAPIHelper.HarukiToolboxRouterHelpers,
 	uploadMethod harukiUtils.UploadMethod,
 ) (*harukiUtils.HandleDataResult, error) {
+	// The span opens before the semaphore so time spent queueing for an
+	// upload slot shows up in the trace.
+	ctx, span := harukiTracing.Start(ctx, "upload",
+		attribute.String("upload.server", string(server)),
+		attribute.String("upload.data_type", string(dataType)),
+		attribute.String("upload.method", string(uploadMethod)),
+		attribute.Int("upload.size_bytes", len(data)),
+	)
+	defer span.End()
+	ctx = harukiLogger.WithContextFields(ctx, harukiLogger.KV(harukiLogger.FieldServer, string(server)))
+	if gameUserID != nil {
+		ctx = harukiLogger.WithContextFields(ctx, harukiLogger.KV(harukiLogger.FieldGameUserID, *gameUserID))
+	}
 
 	uploadSemaphore <- struct{}{}
 	defer func() { <-uploadSemaphore }()
+	span.AddEvent("upload slot acquired")
 
 	uploadCtx, err := buildUploadContext(server, dataType, gameUserID, userID, uploadMethod)
 	if err != nil {
 		return nil, err
 	}
-	handler := newUploadDataHandler(helper)
+	handler := newUploadDataHandler(helper, uploadCtx.UploadMethod)
 	auditWritten := false
 	writeUploadAudit := func(success bool, errorMessage *string) {
 		if auditWritten {
@@ -48,8 +68,13 @@ func HandleUpload(
 	}
 	fail := func(stage string, result *harukiUtils.HandleDataResult, err error) (*harukiUtils.HandleDataResult, error) {
 		uploadCtx.FailureStage = stage
+		span.SetAttributes(attribute.String("upload.failure_stage", stage))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
 		if err != nil && handler.Logger != nil {
-			handler.Logger.Warnf(
+			handler.Logger.WithContext(ctx).Warnf(
 				"Upload failed stage=%s method=%s server=%s dataType=%s expectedGameUserId=%s parsedGameUserId=%s parsedGameUserIdType=%s err=%v",
 				stage,
 				uploadCtx.UploadMethod,
@@ -78,6 +103,13 @@ func HandleUpload(
 		err = fmt.Errorf("%w: %s", errUploadOwnerBanned, banMessage)
 		return fail(uploadStageAccountPolicy, nil, err)
 	}
+	blocked, err := helper.DBManager.DB.IsUploadBlocked(ctx, string(uploadCtx.Server), uploadCtx.expectedGameUserIDString(), uploadCtx.ToolboxUserID, time.Now())
+	if err != nil {
+		return fail(uploadStageAccountPolicy, nil, err)
+	}
+	if blocked {
+		return fail(uploadStageAccountPolicy, nil, errUploadBlocked)
+	}
 	if err := validateGameAccountBelonging(belongs); err != nil {
 		return fail(uploadStageAccountPolicy, nil, err)
 	}
@@ -86,7 +118,7 @@ func HandleUpload(
 		return fail(uploadStageAccountPolicy, nil, err)
 	}
 
-	unpackedMap, result, err := handler.DecodeUploadData(data, uploadCtx.Server)
+	unpackedMap, result, err := handler.DecodeUploadData(ctx, data, uploadCtx.Server)
 	if err != nil {
 		return fail(uploadStageDecodePayload, result, err)
 	}
@@ -100,7 +132,8 @@ func HandleUpload(
 	if err != nil {
 		return fail(uploadStagePreprocess, nil, err)
 	}
-	if err := handler.PersistUploadData(ctx, processedData, uploadCtx.Server, uploadCtx.DataType, &uploadCtx.ExpectedGameUserID); err != nil {
+	changedKeys, err := handler.PersistUploadData(ctx, processedData, uploadCtx.Server, uploadCtx.DataType, &uploadCtx.ExpectedGameUserID)
+	if err != nil {
 		return fail(uploadStagePersist, nil, err)
 	}
 	result = &harukiUtils.HandleDataResult{UserID: &uploadCtx.ExpectedGameUserID}
@@ -109,8 +142,8 @@ func HandleUpload(
 	}
 	writeUploadAudit(true, nil)
 	if err = helper.DBManager.Redis.ClearUploadedGameDataCaches(ctx, string(uploadCtx.DataType), string(uploadCtx.Server), uploadCtx.ExpectedGameUserID); err != nil {
-		handler.Logger.Warnf("Failed to clear redis cache: %v", err)
+		handler.Logger.WithContext(ctx).Warnf("Failed to clear redis cache: %v", err)
 	}
-	handler.RunUploadFanout(data, processedData, uploadCtx.Server, uploadCtx.DataType, &uploadCtx.ExpectedGameUserID, uploadCtx.Settings, uploadCtx.AllowPublicAPI)
+	handler.RunUploadFanout(ctx, data, processedData, changedKeys, uploadCtx.Server, uploadCtx.DataType, &uploadCtx.ExpectedGameUserID, uploadCtx.Settings, uploadCtx.AllowPublicAPI)
 	return result, nil
 }
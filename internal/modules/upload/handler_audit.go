@@ -6,6 +6,7 @@ import (
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 	"strings"
 	"time"
 )
@@ -46,6 +47,8 @@ func dispatchUploadAuditLog(
 	success bool,
 	errorMessage *string,
 ) {
+	harukiMetrics.RecordUpload(string(uploadCtx.Server), string(uploadCtx.DataType), string(uploadCtx.UploadMethod), success)
+	publishUploadRiskSignal(helper, uploadCtx, success)
 	select {
 	case uploadAuditSemaphore <- struct{}{}:
 		go func() {
@@ -57,6 +60,21 @@ func dispatchUploadAuditLog(
 	}
 }
 
+func publishUploadRiskSignal(helper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadCtx *uploadContext, success bool) {
+	harukiAPIHelper.PublishRiskSignal(helper, harukiAPIHelper.RiskSignal{
+		Kind:       harukiAPIHelper.RiskSignalKindUpload,
+		Success:    success,
+		UserID:     uploadCtx.ToolboxUserID,
+		Server:     string(uploadCtx.Server),
+		GameUserID: uploadCtx.expectedGameUserIDString(),
+		Metadata: map[string]any{
+			"dataType":     string(uploadCtx.DataType),
+			"uploadMethod": string(uploadCtx.UploadMethod),
+			"failureStage": uploadCtx.FailureStage,
+		},
+	})
+}
+
 func persistUploadAuditLog(
 	helper *harukiAPIHelper.HarukiToolboxRouterHelpers,
 	logger *harukiLogger.Logger,
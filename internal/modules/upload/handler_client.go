@@ -2,6 +2,7 @@ package upload
 
 import (
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiDataHandler "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/handler"
 	harukiHttp "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/http"
@@ -18,13 +19,15 @@ var (
 	sharedDataHandlerLogger = harukiLogger.NewLoggerFromGlobal("SekaiDataHandler")
 )
 
-func newUploadDataHandler(helper *harukiAPIHelper.HarukiToolboxRouterHelpers) *harukiDataHandler.DataHandler {
+func newUploadDataHandler(helper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadMethod harukiUtils.UploadMethod) *harukiDataHandler.DataHandler {
 	return &harukiDataHandler.DataHandler{
 		DBManager:      helper.DBManager,
 		SekaiAPIClient: helper.SekaiAPIClient,
 		HttpClient:     getSharedHTTPClient(),
 		Logger:         sharedDataHandlerLogger,
 		WebhookEnabled: helper.GetWebhookEnabled(),
+		UploadMethod:   uploadMethod,
+		DataProviders:  helper.GetDataProviders(),
 	}
 }
 
@@ -39,7 +42,7 @@ func getSharedHTTPClient() *harukiHttp.Client {
 	}
 	sharedHttpClientMu.RUnlock()
 
-	client := harukiHttp.NewClient(proxy, 15*time.Second)
+	client := harukiHttp.NewTracedClient(proxy, 15*time.Second)
 
 	sharedHttpClientMu.Lock()
 	defer sharedHttpClientMu.Unlock()
@@ -14,6 +14,8 @@ func mapUploadProcessingError(err error) *fiber.Error {
 		return fiber.NewError(fiber.StatusForbidden, "upload is not allowed for this bound account")
 	case errors.Is(err, errUploadOwnerBanned):
 		return fiber.NewError(fiber.StatusForbidden, "account owner is banned")
+	case errors.Is(err, errUploadBlocked):
+		return fiber.NewError(fiber.StatusForbidden, "uploads are temporarily blocked for this account")
 	case errors.Is(err, errUploadCNMysekaiDenied):
 		return fiber.NewError(fiber.StatusForbidden, "cn mysekai upload is not allowed")
 	default:
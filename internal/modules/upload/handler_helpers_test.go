@@ -156,6 +156,9 @@ func TestMapUploadProcessingError(t *testing.T) {
 	if got := mapUploadProcessingError(errUploadOwnerBanned); got == nil || got.Code != 403 {
 		t.Fatalf("expected banned owner to map to 403, got %#v", got)
 	}
+	if got := mapUploadProcessingError(errUploadBlocked); got == nil || got.Code != 403 {
+		t.Fatalf("expected upload block to map to 403, got %#v", got)
+	}
 	if got := mapUploadProcessingError(errUploadCNMysekaiDenied); got == nil || got.Code != 403 {
 		t.Fatalf("expected cn mysekai deny to map to 403, got %#v", got)
 	}
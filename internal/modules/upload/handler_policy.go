@@ -14,6 +14,7 @@ var (
 	errUploadOwnershipMismatch = errors.New("upload game account ownership mismatch")
 	errUploadOwnerBanned       = errors.New("upload game account owner banned")
 	errUploadCNMysekaiDenied   = errors.New("upload cn mysekai denied")
+	errUploadBlocked           = errors.New("upload temporarily blocked")
 )
 
 func ParseGameAccountSetting(ctx context.Context, db *postgresql.Client, server string, gameUserID string, uploadMethod harukiUtils.UploadMethod, userID *string) (bool, *bool, harukiAPIHelper.HarukiToolboxGameAccountPrivacySettings, *bool, *bool, *string, error) {
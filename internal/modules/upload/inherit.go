@@ -1,18 +1,19 @@
 package upload
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	harukiSekai "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekai"
+	"strings"
 
 	"github.com/gofiber/fiber/v3"
 )
 
 func handleInheritSubmit(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
 	return func(c fiber.Ctx) error {
-		ctx := c.Context()
 		serverStr := c.Params("server")
 		uploadTypeStr := c.Params("upload_type")
 		server, err := harukiUtils.ParseSupportedInheritUploadServer(serverStr)
@@ -27,21 +28,43 @@ func handleInheritSubmit(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 		if err := c.Bind().Body(data); err != nil {
 			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
 		}
-		retriever := harukiSekai.NewSekaiDataRetriever(server, *data, uploadType)
-		result, err := retriever.Run(ctx)
+		runner := currentInheritJobRunner()
+		if runner == nil {
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusServiceUnavailable, "inherit upload is not available", nil)
+		}
+		job, err := runner.Submit(c.Context(), server, uploadType, *data)
 		if err != nil {
-			uploadServer := harukiUtils.SupportedDataUploadServer(server)
-			recordInheritRetrievalFailure(apiHelper, uploadServer, uploadType, result, err)
-			return harukiAPIHelper.ErrorBadRequest(c, "failed to retrieve game data")
+			if errors.Is(err, errInheritJobQueueFull) {
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusServiceUnavailable, "inherit queue is full, please retry later", nil)
+			}
+			sharedDataHandlerLogger.Errorf("Failed to queue inherit job: %v", err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to queue inherit job")
+		}
+		resp := buildInheritJobResponse(job)
+		return harukiAPIHelper.UpdatedDataResponse(c, fiber.StatusAccepted, "inherit job queued", &resp)
+	}
+}
+
+func handleInheritJobStatus(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		jobID := strings.TrimSpace(c.Params("job_id"))
+		if jobID == "" {
+			return harukiAPIHelper.ErrorBadRequest(c, "job_id is required")
 		}
-		uploadServer := harukiUtils.SupportedDataUploadServer(server)
-		if err := uploadMysekaiDataIfNeeded(c, apiHelper, uploadType, result, uploadServer); err != nil {
-			return err
+		job, err := apiHelper.DBManager.DB.InheritJob.Get(c.Context(), jobID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				return harukiAPIHelper.ErrorNotFound(c, "inherit job not found")
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to query inherit job")
 		}
-		if err := uploadSuiteData(c, apiHelper, result, uploadServer); err != nil {
-			return err
+		// The job id is the only credential for polling, so a mismatched path
+		// is reported the same way as an unknown id.
+		if job.Server != c.Params("server") || job.UploadType != c.Params("upload_type") {
+			return harukiAPIHelper.ErrorNotFound(c, "inherit job not found")
 		}
-		return harukiAPIHelper.SuccessResponse[string](c, fmt.Sprintf("%s server user %d successfully uploaded data.", serverStr, result.UserID), nil)
+		resp := buildInheritJobResponse(job)
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
 	}
 }
 
@@ -77,13 +100,21 @@ func inheritRetrievalFailureDataType(uploadType harukiUtils.UploadDataType, err
 	return harukiUtils.UploadDataTypeSuite
 }
 
-func uploadMysekaiDataIfNeeded(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadType harukiUtils.UploadDataType, result *harukiUtils.SekaiInheritDataRetrieverResponse, server harukiUtils.SupportedDataUploadServer) error {
-	ctx := c.Context()
+// persistInheritResult stores the retrieved data the same way a manual upload
+// would. The returned error carries the status code and message to report.
+func persistInheritResult(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadType harukiUtils.UploadDataType, result *harukiUtils.SekaiInheritDataRetrieverResponse, server harukiUtils.SupportedDataUploadServer) *fiber.Error {
+	if err := uploadMysekaiDataIfNeeded(ctx, apiHelper, uploadType, result, server); err != nil {
+		return err
+	}
+	return uploadSuiteData(ctx, apiHelper, result, server)
+}
+
+func uploadMysekaiDataIfNeeded(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadType harukiUtils.UploadDataType, result *harukiUtils.SekaiInheritDataRetrieverResponse, server harukiUtils.SupportedDataUploadServer) *fiber.Error {
 	if uploadType != harukiUtils.UploadDataTypeMysekai {
 		return nil
 	}
 	if result.Mysekai == nil {
-		return harukiAPIHelper.ErrorBadRequest(c, "Retrieve mysekai data failed, it seems you may not have completed the tutorial yet.")
+		return fiber.NewError(fiber.StatusBadRequest, "Retrieve mysekai data failed, it seems you may not have completed the tutorial yet.")
 	}
 	_, err := HandleUpload(
 		ctx,
@@ -97,17 +128,16 @@ func uploadMysekaiDataIfNeeded(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToo
 	)
 	if err != nil {
 		if mapped := mapUploadProcessingError(err); mapped != nil {
-			return harukiAPIHelper.UpdatedDataResponse[string](c, mapped.Code, mapped.Message, nil)
+			return mapped
 		}
-		return harukiAPIHelper.ErrorBadRequest(c, "failed to process mysekai upload")
+		return fiber.NewError(fiber.StatusBadRequest, "failed to process mysekai upload")
 	}
 	return nil
 }
 
-func uploadSuiteData(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, result *harukiUtils.SekaiInheritDataRetrieverResponse, server harukiUtils.SupportedDataUploadServer) error {
-	ctx := c.Context()
+func uploadSuiteData(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, result *harukiUtils.SekaiInheritDataRetrieverResponse, server harukiUtils.SupportedDataUploadServer) *fiber.Error {
 	if result.Suite == nil {
-		return harukiAPIHelper.ErrorBadRequest(c, "Retrieve suite data failed: unknown error")
+		return fiber.NewError(fiber.StatusBadRequest, "Retrieve suite data failed: unknown error")
 	}
 	_, err := HandleUpload(
 		ctx,
@@ -121,9 +151,9 @@ func uploadSuiteData(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouter
 	)
 	if err != nil {
 		if mapped := mapUploadProcessingError(err); mapped != nil {
-			return harukiAPIHelper.UpdatedDataResponse[string](c, mapped.Code, mapped.Message, nil)
+			return mapped
 		}
-		return harukiAPIHelper.ErrorBadRequest(c, "failed to process suite upload")
+		return fiber.NewError(fiber.StatusBadRequest, "failed to process suite upload")
 	}
 	return nil
 }
@@ -132,4 +162,5 @@ func registerInheritRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
 	api := apiHelper.Router.Group("/api/inherit/:server/:upload_type", openUploadEntryGuard(apiHelper))
 
 	api.Post("/submit", handleInheritSubmit(apiHelper))
+	api.Get("/jobs/:job_id", handleInheritJobStatus(apiHelper))
 }
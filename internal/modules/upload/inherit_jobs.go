@@ -0,0 +1,493 @@
+package upload
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSekai "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekai"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
+
+	"github.com/gofiber/fiber/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	inheritJobStatusTimeout      = 5 * time.Second
+	inheritJobInterruptedMessage = "inherit job was interrupted by a service restart, please submit again"
+)
+
+var errInheritJobQueueFull = errors.New("inherit job queue is full")
+
+var inheritJobNow = time.Now
+
+type InheritJobOptions struct {
+	// Concurrency is the number of jobs run at once for each server unless
+	// ServerConcurrency overrides it.
+	Concurrency       int
+	ServerConcurrency map[string]int
+	// MaxQueuedPerServer bounds the jobs waiting for a worker; submissions
+	// beyond it are rejected instead of queued.
+	MaxQueuedPerServer int
+	JobTimeout         time.Duration
+	// StaleAfter is how long an unfinished job may go without a heartbeat
+	// before any instance reports it as failed.
+	StaleAfter    time.Duration
+	SweepInterval time.Duration
+	Retention     time.Duration
+}
+
+func DefaultInheritJobOptions() InheritJobOptions {
+	return InheritJobOptions{
+		Concurrency:        2,
+		MaxQueuedPerServer: 100,
+		JobTimeout:         5 * time.Minute,
+		StaleAfter:         15 * time.Minute,
+		SweepInterval:      time.Minute,
+		Retention:          24 * time.Hour,
+	}
+}
+
+func (o InheritJobOptions) concurrencyFor(server harukiUtils.SupportedInheritUploadServer) int {
+	if n, ok := o.ServerConcurrency[string(server)]; ok && n > 0 {
+		return n
+	}
+	if o.Concurrency > 0 {
+		return o.Concurrency
+	}
+	return 1
+}
+
+// inheritDataRetriever is the part of the Sekai retriever a job needs.
+type inheritDataRetriever interface {
+	SetProgressReporter(fn harukiSekai.RetrieverProgressFunc)
+	Run(ctx context.Context) (*harukiUtils.SekaiInheritDataRetrieverResponse, error)
+}
+
+var newInheritDataRetriever = func(server harukiUtils.SupportedInheritUploadServer, inherit harukiUtils.InheritInformation, uploadType harukiUtils.UploadDataType) inheritDataRetriever {
+	return harukiSekai.NewSekaiDataRetriever(server, inherit, uploadType)
+}
+
+var persistInheritJobResult = persistInheritResult
+
+type inheritJobTask struct {
+	id         string
+	server     harukiUtils.SupportedInheritUploadServer
+	uploadType harukiUtils.UploadDataType
+	inherit    harukiUtils.InheritInformation
+	// submitter is the span of the request that queued the job; the job's
+	// own trace links back to it.
+	submitter trace.SpanContext
+}
+
+// InheritJobRunner runs inherit uploads in the background with a bounded
+// worker pool per server. Credentials live only in the in-memory queue.
+type InheritJobRunner struct {
+	apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
+	db        *postgresql.Client
+	logger    *harukiLogger.Logger
+	options   InheritJobOptions
+
+	mu      sync.Mutex
+	queues  map[harukiUtils.SupportedInheritUploadServer]chan inheritJobTask
+	pending map[string]struct{}
+	ctx     context.Context
+	wg      sync.WaitGroup
+}
+
+func NewInheritJobRunner(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger, options InheritJobOptions) *InheritJobRunner {
+	defaults := DefaultInheritJobOptions()
+	if options.MaxQueuedPerServer <= 0 {
+		options.MaxQueuedPerServer = defaults.MaxQueuedPerServer
+	}
+	if options.JobTimeout <= 0 {
+		options.JobTimeout = defaults.JobTimeout
+	}
+	if options.StaleAfter <= 0 {
+		options.StaleAfter = defaults.StaleAfter
+	}
+	if options.SweepInterval <= 0 {
+		options.SweepInterval = defaults.SweepInterval
+	}
+	return &InheritJobRunner{
+		apiHelper: apiHelper,
+		db:        apiHelper.DBManager.DB,
+		logger:    logger,
+		options:   options,
+		queues:    make(map[harukiUtils.SupportedInheritUploadServer]chan inheritJobTask),
+		pending:   make(map[string]struct{}),
+	}
+}
+
+var (
+	inheritJobRunnerMu sync.RWMutex
+	inheritJobRunner   *InheritJobRunner
+)
+
+func currentInheritJobRunner() *InheritJobRunner {
+	inheritJobRunnerMu.RLock()
+	defer inheritJobRunnerMu.RUnlock()
+	return inheritJobRunner
+}
+
+func setInheritJobRunner(runner *InheritJobRunner) {
+	inheritJobRunnerMu.Lock()
+	defer inheritJobRunnerMu.Unlock()
+	inheritJobRunner = runner
+}
+
+// StartInheritJobRunner fails jobs left behind by a previous process, starts
+// the workers and makes the runner available to the inherit handlers. Cancel
+// ctx, then call the returned wait before closing the database.
+func StartInheritJobRunner(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger, options InheritJobOptions) func() {
+	runner := NewInheritJobRunner(apiHelper, logger, options)
+	wait := runner.Start(ctx)
+	setInheritJobRunner(runner)
+	return func() {
+		setInheritJobRunner(nil)
+		wait()
+	}
+}
+
+func (r *InheritJobRunner) Start(ctx context.Context) func() {
+	r.mu.Lock()
+	r.ctx = ctx
+	r.mu.Unlock()
+	r.sweep(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.options.SweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.sweep(ctx)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.wg.Wait()
+			r.failAbandoned()
+		})
+	}
+}
+
+// Submit records a queued job and hands it to the server's workers.
+func (r *InheritJobRunner) Submit(ctx context.Context, server harukiUtils.SupportedInheritUploadServer, uploadType harukiUtils.UploadDataType, inherit harukiUtils.InheritInformation) (*postgresql.InheritJob, error) {
+	queue, err := r.queueFor(server)
+	if err != nil {
+		return nil, err
+	}
+	if len(queue) >= cap(queue) {
+		return nil, errInheritJobQueueFull
+	}
+	jobID, err := generateInheritJobID()
+	if err != nil {
+		return nil, err
+	}
+	job, err := r.db.CreateInheritJob(ctx, jobID, string(server), string(uploadType), inheritJobNow())
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.pending[jobID] = struct{}{}
+	r.mu.Unlock()
+	select {
+	case queue <- inheritJobTask{id: jobID, server: server, uploadType: uploadType, inherit: inherit, submitter: trace.SpanContextFromContext(ctx)}:
+		return job, nil
+	default:
+		r.forget(jobID)
+		deleteCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), inheritJobStatusTimeout)
+		defer cancel()
+		if err := r.db.InheritJob.DeleteOneID(jobID).Exec(deleteCtx); err != nil {
+			r.logger.Warnf("Failed to remove rejected inherit job %s: %v", jobID, err)
+		}
+		return nil, errInheritJobQueueFull
+	}
+}
+
+func (r *InheritJobRunner) queueFor(server harukiUtils.SupportedInheritUploadServer) (chan inheritJobTask, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ctx == nil {
+		return nil, fmt.Errorf("inherit job runner is not started")
+	}
+	if err := r.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if queue, ok := r.queues[server]; ok {
+		return queue, nil
+	}
+	queue := make(chan inheritJobTask, r.options.MaxQueuedPerServer)
+	r.queues[server] = queue
+	workers := r.options.concurrencyFor(server)
+	for i := 0; i < workers; i++ {
+		r.wg.Add(1)
+		go r.work(r.ctx, queue)
+	}
+	return queue, nil
+}
+
+func (r *InheritJobRunner) work(ctx context.Context, queue chan inheritJobTask) {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-queue:
+			// Tasks picked up during shutdown stay pending so the wait
+			// function reports them as interrupted.
+			if ctx.Err() != nil {
+				return
+			}
+			r.run(ctx, task)
+		}
+	}
+}
+
+func (r *InheritJobRunner) run(ctx context.Context, task inheritJobTask) {
+	defer r.forget(task.id)
+	ctx, span := harukiTracing.Tracer().Start(ctx, "inherit_job",
+		trace.WithLinks(trace.Link{SpanContext: task.submitter}),
+		trace.WithAttributes(
+			attribute.String("inherit_job.id", task.id),
+			attribute.String("upload.server", string(task.server)),
+			attribute.String("upload.data_type", string(task.uploadType)),
+		),
+	)
+	defer span.End()
+
+	started, err := r.db.StartInheritJob(ctx, task.id, inheritJobNow())
+	if err != nil {
+		if ctx.Err() != nil {
+			r.finish(task.id, 0, fiber.StatusServiceUnavailable, inheritJobInterruptedMessage, "")
+			return
+		}
+		r.logger.Warnf("Failed to start inherit job %s: %v", task.id, err)
+		r.finish(task.id, 0, fiber.StatusInternalServerError, "failed to start inherit job", "")
+		return
+	}
+	if !started {
+		return
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, r.options.JobTimeout)
+	defer cancel()
+
+	var gameUserID int64
+	retriever := newInheritDataRetriever(task.server, task.inherit, task.uploadType)
+	retriever.SetProgressReporter(func(step harukiSekai.RetrieverStep, userID int64) {
+		if userID > 0 {
+			gameUserID = userID
+		}
+		r.progress(task.id, inheritjob.Step(step), userID)
+	})
+	result, err := retriever.Run(jobCtx)
+	uploadServer := harukiUtils.SupportedDataUploadServer(task.server)
+	if result != nil && result.UserID > 0 {
+		gameUserID = result.UserID
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			r.finish(task.id, gameUserID, fiber.StatusServiceUnavailable, inheritJobInterruptedMessage, "")
+			return
+		}
+		recordInheritRetrievalFailure(r.apiHelper, uploadServer, task.uploadType, result, err)
+		r.finish(task.id, gameUserID, fiber.StatusBadRequest, "failed to retrieve game data", "")
+		return
+	}
+
+	r.progress(task.id, inheritjob.StepPersist, gameUserID)
+	if failure := persistInheritJobResult(jobCtx, r.apiHelper, task.uploadType, result, uploadServer); failure != nil {
+		r.finish(task.id, gameUserID, failure.Code, failure.Message, "")
+		return
+	}
+	r.finish(task.id, gameUserID, 0, "", fmt.Sprintf("%s server user %d successfully uploaded data.", task.server, gameUserID))
+}
+
+// finish records the outcome; a zero errorCode marks success. It uses a
+// context detached from shutdown so the final state is still written.
+func (r *InheritJobRunner) finish(jobID string, gameUserID int64, errorCode int, errorMessage string, message string) {
+	ctx, cancel := context.WithTimeout(context.Background(), inheritJobStatusTimeout)
+	defer cancel()
+	var err error
+	if errorCode == 0 {
+		err = r.db.CompleteInheritJob(ctx, jobID, gameUserID, message, inheritJobNow())
+	} else {
+		err = r.db.FailInheritJob(ctx, jobID, gameUserID, errorCode, errorMessage, inheritJobNow())
+	}
+	if err != nil {
+		r.logger.Warnf("Failed to record inherit job %s result: %v", jobID, err)
+	}
+}
+
+func (r *InheritJobRunner) progress(jobID string, step inheritjob.Step, gameUserID int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), inheritJobStatusTimeout)
+	defer cancel()
+	if err := r.db.UpdateInheritJobProgress(ctx, jobID, step, gameUserID, inheritJobNow()); err != nil {
+		r.logger.Warnf("Failed to update inherit job %s progress: %v", jobID, err)
+	}
+}
+
+func (r *InheritJobRunner) forget(jobID string) {
+	r.mu.Lock()
+	delete(r.pending, jobID)
+	r.mu.Unlock()
+}
+
+func (r *InheritJobRunner) pendingIDs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]string, 0, len(r.pending))
+	for id := range r.pending {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// sweep keeps this process's jobs alive, fails jobs whose process is gone
+// and drops old finished jobs.
+func (r *InheritJobRunner) sweep(ctx context.Context) {
+	now := inheritJobNow().UTC()
+	if err := r.db.TouchInheritJobs(ctx, r.pendingIDs(), now); err != nil && ctx.Err() == nil {
+		r.logger.Warnf("Failed to refresh inherit jobs: %v", err)
+	}
+	failed, err := r.db.FailStaleInheritJobs(ctx, now.Add(-r.options.StaleAfter), inheritJobInterruptedMessage, now)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.logger.Warnf("Failed to fail stale inherit jobs: %v", err)
+		}
+	} else if failed > 0 {
+		r.logger.Warnf("Marked %d interrupted inherit job(s) as failed", failed)
+	}
+	if r.options.Retention <= 0 {
+		return
+	}
+	if _, err := r.db.PurgeFinishedInheritJobs(ctx, now.Add(-r.options.Retention)); err != nil && ctx.Err() == nil {
+		r.logger.Warnf("Failed to purge finished inherit jobs: %v", err)
+	}
+}
+
+// failAbandoned reports jobs still queued at shutdown; their credentials are
+// lost with this process.
+func (r *InheritJobRunner) failAbandoned() {
+	for _, jobID := range r.pendingIDs() {
+		r.finish(jobID, 0, fiber.StatusServiceUnavailable, inheritJobInterruptedMessage, "")
+		r.forget(jobID)
+	}
+}
+
+func generateInheritJobID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+type inheritJobStep struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+type inheritJobResponse struct {
+	JobID      string           `json:"jobId"`
+	Server     string           `json:"server"`
+	UploadType string           `json:"uploadType"`
+	Status     string           `json:"status"`
+	Step       *string          `json:"step,omitempty"`
+	Steps      []inheritJobStep `json:"steps"`
+	GameUserID *int64           `json:"gameUserId,omitempty"`
+	Message    *string          `json:"message,omitempty"`
+	Error      *string          `json:"error,omitempty"`
+	ErrorCode  *int             `json:"errorCode,omitempty"`
+	CreatedAt  time.Time        `json:"createdAt"`
+	StartedAt  *time.Time       `json:"startedAt,omitempty"`
+	FinishedAt *time.Time       `json:"finishedAt,omitempty"`
+}
+
+func inheritJobStepsFor(uploadType string) []inheritjob.Step {
+	steps := []inheritjob.Step{inheritjob.StepInit, inheritjob.StepSuite, inheritjob.StepHomeRefresh}
+	if uploadType == string(harukiUtils.UploadDataTypeMysekai) {
+		steps = append(steps, inheritjob.StepMysekai)
+	}
+	return append(steps, inheritjob.StepPersist)
+}
+
+// buildInheritJobSteps marks each step pending, running, done or failed from
+// the job's current step and status.
+func buildInheritJobSteps(job *postgresql.InheritJob) []inheritJobStep {
+	names := inheritJobStepsFor(job.UploadType)
+	steps := make([]inheritJobStep, 0, len(names))
+	current := -1
+	if job.Step != nil {
+		for i, name := range names {
+			if name == *job.Step {
+				current = i
+				break
+			}
+		}
+	}
+	for i, name := range names {
+		state := "pending"
+		switch {
+		case job.Status == inheritjob.StatusSucceeded:
+			state = "done"
+		case current < 0:
+		case i < current:
+			state = "done"
+		case i == current && job.Status == inheritjob.StatusFailed:
+			state = "failed"
+		case i == current:
+			state = "running"
+		}
+		steps = append(steps, inheritJobStep{Name: string(name), State: state})
+	}
+	return steps
+}
+
+func buildInheritJobResponse(job *postgresql.InheritJob) inheritJobResponse {
+	resp := inheritJobResponse{
+		JobID:      job.ID,
+		Server:     job.Server,
+		UploadType: job.UploadType,
+		Status:     string(job.Status),
+		Steps:      buildInheritJobSteps(job),
+		GameUserID: job.GameUserID,
+		Message:    job.Message,
+		Error:      job.Error,
+		ErrorCode:  job.ErrorCode,
+		CreatedAt:  job.CreatedAt.UTC(),
+	}
+	if job.Step != nil {
+		step := string(*job.Step)
+		resp.Step = &step
+	}
+	if job.StartedAt != nil {
+		startedAt := job.StartedAt.UTC()
+		resp.StartedAt = &startedAt
+	}
+	if job.FinishedAt != nil {
+		finishedAt := job.FinishedAt.UTC()
+		resp.FinishedAt = &finishedAt
+	}
+	return resp
+}
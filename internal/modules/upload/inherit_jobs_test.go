@@ -0,0 +1,227 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSekai "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekai"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type stubInheritRetriever struct {
+	progress harukiSekai.RetrieverProgressFunc
+	run      func(ctx context.Context, progress harukiSekai.RetrieverProgressFunc) (*harukiUtils.SekaiInheritDataRetrieverResponse, error)
+}
+
+func (r *stubInheritRetriever) SetProgressReporter(fn harukiSekai.RetrieverProgressFunc) {
+	r.progress = fn
+}
+
+func (r *stubInheritRetriever) Run(ctx context.Context) (*harukiUtils.SekaiInheritDataRetrieverResponse, error) {
+	return r.run(ctx, r.progress)
+}
+
+func useStubInheritRetriever(t *testing.T, run func(ctx context.Context, progress harukiSekai.RetrieverProgressFunc) (*harukiUtils.SekaiInheritDataRetrieverResponse, error)) {
+	t.Helper()
+	original := newInheritDataRetriever
+	newInheritDataRetriever = func(harukiUtils.SupportedInheritUploadServer, harukiUtils.InheritInformation, harukiUtils.UploadDataType) inheritDataRetriever {
+		return &stubInheritRetriever{run: run}
+	}
+	t.Cleanup(func() {
+		newInheritDataRetriever = original
+	})
+}
+
+func newInheritJobTestHelper(t *testing.T, name string) (*harukiAPIHelper.HarukiToolboxRouterHelpers, *postgresql.Client) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager: &database.HarukiToolboxDBManager{DB: client},
+	}, client
+}
+
+func newInheritJobTestRunner(helper *harukiAPIHelper.HarukiToolboxRouterHelpers, options InheritJobOptions) *InheritJobRunner {
+	return NewInheritJobRunner(helper, harukiLogger.NewLogger("inherit-job-test", "DEBUG", io.Discard), options)
+}
+
+func waitInheritJobFinished(t *testing.T, client *postgresql.Client, jobID string) *postgresql.InheritJob {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := client.InheritJob.Get(context.Background(), jobID)
+		if err != nil {
+			t.Fatalf("get job: %v", err)
+		}
+		if job.Status == inheritjob.StatusSucceeded || job.Status == inheritjob.StatusFailed {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %s did not finish", jobID)
+	return nil
+}
+
+func TestInheritJobRunnerReportsProgressAndResult(t *testing.T) {
+	helper, client := newInheritJobTestHelper(t, "inherit-job-runner-success-test")
+	useStubInheritRetriever(t, func(ctx context.Context, progress harukiSekai.RetrieverProgressFunc) (*harukiUtils.SekaiInheritDataRetrieverResponse, error) {
+		progress(harukiSekai.RetrieverStepInit, 0)
+		progress(harukiSekai.RetrieverStepSuite, 1001)
+		progress(harukiSekai.RetrieverStepHomeRefresh, 1001)
+		progress(harukiSekai.RetrieverStepMysekai, 1001)
+		return &harukiUtils.SekaiInheritDataRetrieverResponse{UserID: 1001}, nil
+	})
+	originalPersist := persistInheritJobResult
+	persistInheritJobResult = func(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadType harukiUtils.UploadDataType, result *harukiUtils.SekaiInheritDataRetrieverResponse, server harukiUtils.SupportedDataUploadServer) *fiber.Error {
+		return fiber.NewError(fiber.StatusBadRequest, "Retrieve mysekai data failed, it seems you may not have completed the tutorial yet.")
+	}
+	defer func() {
+		persistInheritJobResult = originalPersist
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runner := newInheritJobTestRunner(helper, DefaultInheritJobOptions())
+	wait := runner.Start(ctx)
+	defer func() {
+		cancel()
+		wait()
+	}()
+
+	job, err := runner.Submit(context.Background(), harukiUtils.SupportedInheritUploadServerJP, harukiUtils.UploadDataTypeMysekai, harukiUtils.InheritInformation{})
+	if err != nil {
+		t.Fatalf("Submit returned error: %v", err)
+	}
+	if job.Status != inheritjob.StatusQueued {
+		t.Fatalf("submitted job status = %s, want queued", job.Status)
+	}
+
+	finished := waitInheritJobFinished(t, client, job.ID)
+	if finished.Status != inheritjob.StatusFailed || finished.ErrorCode == nil || *finished.ErrorCode != fiber.StatusBadRequest {
+		t.Fatalf("finished job = %+v", finished)
+	}
+	if finished.GameUserID == nil || *finished.GameUserID != 1001 {
+		t.Fatalf("game user id = %v, want 1001", finished.GameUserID)
+	}
+
+	resp := buildInheritJobResponse(finished)
+	want := []inheritJobStep{
+		{Name: "init", State: "done"},
+		{Name: "suite", State: "done"},
+		{Name: "home_refresh", State: "done"},
+		{Name: "mysekai", State: "done"},
+		{Name: "persist", State: "failed"},
+	}
+	if len(resp.Steps) != len(want) {
+		t.Fatalf("steps = %+v, want %+v", resp.Steps, want)
+	}
+	for i := range want {
+		if resp.Steps[i] != want[i] {
+			t.Fatalf("steps = %+v, want %+v", resp.Steps, want)
+		}
+	}
+}
+
+func TestInheritJobRunnerRejectsWhenQueueFullAndFailsOnShutdown(t *testing.T) {
+	helper, client := newInheritJobTestHelper(t, "inherit-job-runner-shutdown-test")
+	started := make(chan struct{}, 1)
+	useStubInheritRetriever(t, func(ctx context.Context, progress harukiSekai.RetrieverProgressFunc) (*harukiUtils.SekaiInheritDataRetrieverResponse, error) {
+		started <- struct{}{}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	options := DefaultInheritJobOptions()
+	options.Concurrency = 1
+	options.MaxQueuedPerServer = 1
+	runner := newInheritJobTestRunner(helper, options)
+	wait := runner.Start(ctx)
+
+	running, err := runner.Submit(context.Background(), harukiUtils.SupportedInheritUploadServerEN, harukiUtils.UploadDataTypeSuite, harukiUtils.InheritInformation{})
+	if err != nil {
+		t.Fatalf("Submit returned error: %v", err)
+	}
+	<-started
+	queued, err := runner.Submit(context.Background(), harukiUtils.SupportedInheritUploadServerEN, harukiUtils.UploadDataTypeSuite, harukiUtils.InheritInformation{})
+	if err != nil {
+		t.Fatalf("Submit returned error: %v", err)
+	}
+	if _, err := runner.Submit(context.Background(), harukiUtils.SupportedInheritUploadServerEN, harukiUtils.UploadDataTypeSuite, harukiUtils.InheritInformation{}); !errors.Is(err, errInheritJobQueueFull) {
+		t.Fatalf("third Submit error = %v, want queue full", err)
+	}
+	if count, err := client.InheritJob.Query().Count(context.Background()); err != nil || count != 2 {
+		t.Fatalf("stored jobs = %d, %v; want 2", count, err)
+	}
+
+	cancel()
+	wait()
+	for _, id := range []string{running.ID, queued.ID} {
+		job, err := client.InheritJob.Get(context.Background(), id)
+		if err != nil {
+			t.Fatalf("get job: %v", err)
+		}
+		if job.Status != inheritjob.StatusFailed || job.Error == nil || *job.Error != inheritJobInterruptedMessage {
+			t.Fatalf("job %s after shutdown = %+v", id, job)
+		}
+	}
+	if _, err := runner.Submit(context.Background(), harukiUtils.SupportedInheritUploadServerEN, harukiUtils.UploadDataTypeSuite, harukiUtils.InheritInformation{}); err == nil {
+		t.Fatalf("Submit after shutdown succeeded")
+	}
+}
+
+func TestHandleInheritJobStatus(t *testing.T) {
+	helper, client := newInheritJobTestHelper(t, "inherit-job-status-test")
+	if _, err := client.CreateInheritJob(context.Background(), "job-status", "jp", "suite", time.Now()); err != nil {
+		t.Fatalf("CreateInheritJob returned error: %v", err)
+	}
+	app := fiber.New()
+	app.Get("/api/inherit/:server/:upload_type/jobs/:job_id", handleInheritJobStatus(helper))
+
+	tests := []struct {
+		path       string
+		wantStatus int
+	}{
+		{path: "/api/inherit/jp/suite/jobs/job-status", wantStatus: fiber.StatusOK},
+		{path: "/api/inherit/en/suite/jobs/job-status", wantStatus: fiber.StatusNotFound},
+		{path: "/api/inherit/jp/mysekai/jobs/job-status", wantStatus: fiber.StatusNotFound},
+		{path: "/api/inherit/jp/suite/jobs/missing", wantStatus: fiber.StatusNotFound},
+	}
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, tt.path, nil))
+		if err != nil {
+			t.Fatalf("app.Test(%s) returned error: %v", tt.path, err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Fatalf("%s status = %d, want %d", tt.path, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantStatus != fiber.StatusOK {
+			continue
+		}
+		var body struct {
+			UpdatedData inheritJobResponse `json:"updatedData"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if body.UpdatedData.JobID != "job-status" || body.UpdatedData.Status != "queued" || len(body.UpdatedData.Steps) != 4 {
+			t.Fatalf("response = %+v", body.UpdatedData)
+		}
+	}
+}
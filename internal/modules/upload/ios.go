@@ -2,6 +2,7 @@ package upload
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
@@ -12,6 +13,8 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekai"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
+	"hash/crc32"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,6 +37,9 @@ type dataUploadHeader struct {
 	UploadId      string `header:"X-Upload-Id"`
 	ChunkIndex    int    `header:"X-Chunk-Index"`
 	TotalChunks   int    `header:"X-Total-Chunks"`
+	// ChunkCRC32 is the optional CRC-32 (IEEE) of the chunk body as eight hex
+	// digits. Scripts generated before it existed do not send it.
+	ChunkCRC32 string `header:"X-Chunk-CRC32"`
 }
 
 func validateDataUploadHeader(header *dataUploadHeader) error {
@@ -50,9 +56,38 @@ func validateDataUploadHeader(header *dataUploadHeader) error {
 	if header.ChunkIndex < 0 || header.ChunkIndex >= header.TotalChunks {
 		return fmt.Errorf("X-Chunk-Index is out of range")
 	}
+	if header.ChunkCRC32 != "" {
+		if _, err := parseChunkCRC32(header.ChunkCRC32); err != nil {
+			return fmt.Errorf("X-Chunk-CRC32 must be eight hex digits")
+		}
+	}
 	return nil
 }
 
+func parseChunkCRC32(raw string) (uint32, error) {
+	if len(raw) != 8 {
+		return 0, fmt.Errorf("invalid length")
+	}
+	value, err := strconv.ParseUint(raw, 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(value), nil
+}
+
+// verifyIOSUploadChunkChecksum reports whether body matches the checksum the
+// script sent; chunks without a checksum are accepted.
+func verifyIOSUploadChunkChecksum(expected string, body []byte) bool {
+	if expected == "" {
+		return true
+	}
+	value, err := parseChunkCRC32(expected)
+	if err != nil {
+		return false
+	}
+	return crc32.ChecksumIEEE(body) == value
+}
+
 func buildChunkUploadKey(toolboxUserID string, server harukiUtils.SupportedDataUploadServer, gameUserID int64, uploadID string) string {
 	return fmt.Sprintf("%s%s%s%s%d%s%s", toolboxUserID, chunkUploadIDSepChar, server, chunkUploadIDSepChar, gameUserID, chunkUploadIDSepChar, uploadID)
 }
@@ -131,23 +166,80 @@ func handleIOSProxyMysekaiBirthdayPartyDelivery(apiHelper *harukiAPIHelper.Haruk
 	}
 }
 
+// iosScriptUploadTarget is the game account an iOS script upload belongs to.
+type iosScriptUploadTarget struct {
+	server     harukiUtils.SupportedDataUploadServer
+	uploadType harukiUtils.UploadDataType
+	gameUserID int64
+}
+
+// lookupIOSScriptUploadOwner returns the toolbox user an upload code belongs
+// to.
+func lookupIOSScriptUploadOwner(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, uploadCode string) (string, error) {
+	if uploadCode == "" {
+		return "", fiber.NewError(fiber.StatusBadRequest, "missing upload_code")
+	}
+	record, err := apiHelper.DBManager.DB.IOSScriptCode.Query().
+		Where(iosscriptcode.UploadCodeEQ(uploadCode)).
+		Only(ctx)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return "", fiber.NewError(fiber.StatusUnauthorized, "invalid upload code")
+		}
+		return "", fiber.NewError(fiber.StatusInternalServerError, "failed to validate upload code")
+	}
+	return record.UserID, nil
+}
+
+// resolveIOSScriptUploadTarget parses the original game API URL and checks
+// that its game account is a verified binding of toolboxUserID.
+func resolveIOSScriptUploadTarget(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, toolboxUserID, originalURL string) (iosScriptUploadTarget, error) {
+	uploadType, gameUserID := ExtractUploadTypeAndUserID(originalURL)
+	if uploadType == "" {
+		return iosScriptUploadTarget{}, fiber.NewError(fiber.StatusBadRequest, "Unknown upload type")
+	}
+	var server harukiUtils.SupportedDataUploadServer
+	for s, tuple := range sekai.GetAPIEndpoint() {
+		if strings.Contains(originalURL, tuple[1]) {
+			server = s
+			break
+		}
+	}
+	if server == "" {
+		return iosScriptUploadTarget{}, fiber.NewError(fiber.StatusBadRequest, "Unknown game server")
+	}
+	bindings, err := apiHelper.DBManager.DB.GameAccountBinding.Query().
+		Where(gameaccountbinding.HasUserWith(user.IDEQ(toolboxUserID))).
+		Where(gameaccountbinding.ServerEQ(string(server))).
+		Where(gameaccountbinding.VerifiedEQ(true)).
+		All(ctx)
+	if err != nil || len(bindings) == 0 {
+		return iosScriptUploadTarget{}, fiber.NewError(fiber.StatusBadRequest, "No verified game account binding found for this server")
+	}
+	gameUserIDStr := strconv.FormatInt(gameUserID, 10)
+	for _, binding := range bindings {
+		if binding.GameUserID == gameUserIDStr {
+			return iosScriptUploadTarget{server: server, uploadType: uploadType, gameUserID: gameUserID}, nil
+		}
+	}
+	return iosScriptUploadTarget{}, fiber.NewError(fiber.StatusBadRequest, "Game user ID does not match your bound accounts")
+}
+
+func respondIOSScriptUploadError(c fiber.Ctx, err error) error {
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return harukiAPIHelper.UpdatedDataResponse[string](c, fiberErr.Code, fiberErr.Message, nil)
+	}
+	return harukiAPIHelper.ErrorInternal(c, "failed to validate upload")
+}
+
 func handleIOSScriptUploadWithValidation(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		ctx := c.Context()
-		uploadCode := c.Params("upload_code")
-		if uploadCode == "" {
-			return harukiAPIHelper.ErrorBadRequest(c, "missing upload_code")
-		}
-		record, err := apiHelper.DBManager.DB.IOSScriptCode.Query().
-			Where(iosscriptcode.UploadCodeEQ(uploadCode)).
-			Only(ctx)
+		toolboxUserID, err := lookupIOSScriptUploadOwner(ctx, apiHelper, c.Params("upload_code"))
 		if err != nil {
-			if postgresql.IsNotFound(err) {
-				return harukiAPIHelper.ErrorUnauthorized(c, "invalid upload code")
-			}
-			return harukiAPIHelper.ErrorInternal(c, "failed to validate upload code")
+			return respondIOSScriptUploadError(c, err)
 		}
-		toolboxUserID := record.UserID
 		chunkIndex64, err := strconv.ParseInt(c.Get("X-Chunk-Index", ""), 10, 64)
 		if err != nil {
 			return harukiAPIHelper.ErrorBadRequest(c, "invalid X-Chunk-Index")
@@ -162,6 +254,7 @@ func handleIOSScriptUploadWithValidation(apiHelper *harukiAPIHelper.HarukiToolbo
 			UploadId:      c.Get("X-Upload-Id"),
 			ChunkIndex:    int(chunkIndex64),
 			TotalChunks:   int(totalChunks64),
+			ChunkCRC32:    c.Get("X-Chunk-CRC32"),
 		}
 		if err := validateDataUploadHeader(header); err != nil {
 			return harukiAPIHelper.ErrorBadRequest(c, "invalid upload headers")
@@ -169,43 +262,18 @@ func handleIOSScriptUploadWithValidation(apiHelper *harukiAPIHelper.HarukiToolbo
 		if header.ScriptVersion == "" {
 			header.ScriptVersion = "unknown"
 		}
-		uploadType, gameUserId := ExtractUploadTypeAndUserID(header.OriginalUrl)
-		if uploadType == "" {
-			return harukiAPIHelper.ErrorBadRequest(c, "Unknown upload type")
-		}
-		var server harukiUtils.SupportedDataUploadServer
-		for s, tuple := range sekai.GetAPIEndpoint() {
-			if strings.Contains(header.OriginalUrl, tuple[1]) {
-				server = s
-				break
-			}
-		}
-		if server == "" {
-			return harukiAPIHelper.ErrorBadRequest(c, "Unknown game server")
-		}
-		bindings, err := apiHelper.DBManager.DB.GameAccountBinding.Query().
-			Where(gameaccountbinding.HasUserWith(user.IDEQ(toolboxUserID))).
-			Where(gameaccountbinding.ServerEQ(string(server))).
-			Where(gameaccountbinding.VerifiedEQ(true)).
-			All(ctx)
-		if err != nil || len(bindings) == 0 {
-			return harukiAPIHelper.ErrorBadRequest(c, "No verified game account binding found for this server")
-		}
-		gameUserIdStr := strconv.FormatInt(gameUserId, 10)
-		matched := false
-		for _, binding := range bindings {
-			if binding.GameUserID == gameUserIdStr {
-				matched = true
-				break
-			}
-		}
-		if !matched {
-			return harukiAPIHelper.ErrorBadRequest(c, "Game user ID does not match your bound accounts")
+		target, err := resolveIOSScriptUploadTarget(ctx, apiHelper, toolboxUserID, header.OriginalUrl)
+		if err != nil {
+			return respondIOSScriptUploadError(c, err)
 		}
+		server, uploadType, gameUserId := target.server, target.uploadType, target.gameUserID
 		body := c.Request().Body()
 		if len(body) == 0 {
 			return harukiAPIHelper.ErrorBadRequest(c, "empty upload body")
 		}
+		if !verifyIOSUploadChunkChecksum(header.ChunkCRC32, body) {
+			return harukiAPIHelper.ErrorBadRequest(c, "chunk checksum mismatch")
+		}
 		if apiHelper == nil || apiHelper.DBManager == nil || apiHelper.DBManager.Redis == nil || apiHelper.DBManager.Redis.Redis == nil {
 			return harukiAPIHelper.ErrorInternal(c, "upload store unavailable")
 		}
@@ -252,7 +320,7 @@ func handleIOSScriptUploadWithValidation(apiHelper *harukiAPIHelper.HarukiToolbo
 				copy(payload[offset:], c.Data)
 				offset += len(c.Data)
 			}
-			uploadCtx, cancel := context.WithTimeout(context.Background(), asyncUploadTimeout)
+			uploadCtx, cancel := context.WithTimeout(harukiTracing.Detach(ctx), asyncUploadTimeout)
 			defer cancel()
 			_, err := HandleUpload(uploadCtx, payload, server, harukiUtils.UploadDataType(uploadType), &userId, &toolboxUserID, apiHelper, harukiUtils.UploadMethodIOSScript)
 			if err != nil {
@@ -263,6 +331,85 @@ func handleIOSScriptUploadWithValidation(apiHelper *harukiAPIHelper.HarukiToolbo
 	}
 }
 
+// iosUploadStatusResponse tells a script which chunks of an upload the
+// server already holds, so it only resends the missing ones.
+type iosUploadStatusResponse struct {
+	UploadID         string `json:"uploadId"`
+	Completed        bool   `json:"completed"`
+	TotalChunks      int    `json:"totalChunks"`
+	ReceivedChunks   []int  `json:"receivedChunks"`
+	MissingChunks    []int  `json:"missingChunks"`
+	ExpiresInSeconds int    `json:"expiresInSeconds"`
+}
+
+func buildIOSUploadStatusResponse(uploadID string, status iosUploadChunkStatus, requestedTotal int) iosUploadStatusResponse {
+	total := status.TotalChunks
+	if total == 0 {
+		total = requestedTotal
+	}
+	received := make(map[int]struct{}, len(status.ReceivedChunks))
+	for _, index := range status.ReceivedChunks {
+		received[index] = struct{}{}
+	}
+	missing := make([]int, 0, total)
+	if !status.Completed {
+		for index := 0; index < total; index++ {
+			if _, ok := received[index]; !ok {
+				missing = append(missing, index)
+			}
+		}
+	}
+	return iosUploadStatusResponse{
+		UploadID:         uploadID,
+		Completed:        status.Completed,
+		TotalChunks:      total,
+		ReceivedChunks:   status.ReceivedChunks,
+		MissingChunks:    missing,
+		ExpiresInSeconds: int(status.ExpiresIn / time.Second),
+	}
+}
+
+// handleIOSScriptUploadStatus reports the chunks received for the upload
+// identified by the same X-Upload-Id and X-Original-Url headers the chunks
+// are sent with. X-Total-Chunks is optional and only fills in the missing
+// chunks of an upload the server has not seen yet. Querying the status
+// keeps a stored upload alive for another iosChunkUploadTTL.
+func handleIOSScriptUploadStatus(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := c.Context()
+		toolboxUserID, err := lookupIOSScriptUploadOwner(ctx, apiHelper, c.Params("upload_code"))
+		if err != nil {
+			return respondIOSScriptUploadError(c, err)
+		}
+		uploadID := strings.TrimSpace(c.Get("X-Upload-Id"))
+		if uploadID == "" || len(uploadID) > maxUploadIDLength {
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid X-Upload-Id")
+		}
+		requestedTotal := 0
+		if raw := c.Get("X-Total-Chunks"); raw != "" {
+			requestedTotal, err = strconv.Atoi(raw)
+			if err != nil || requestedTotal < 1 || requestedTotal > maxUploadChunkCount {
+				return harukiAPIHelper.ErrorBadRequest(c, "invalid X-Total-Chunks")
+			}
+		}
+		target, err := resolveIOSScriptUploadTarget(ctx, apiHelper, toolboxUserID, c.Get("X-Original-Url"))
+		if err != nil {
+			return respondIOSScriptUploadError(c, err)
+		}
+		if apiHelper.DBManager.Redis == nil || apiHelper.DBManager.Redis.Redis == nil {
+			return harukiAPIHelper.ErrorInternal(c, "upload store unavailable")
+		}
+		uploadKey := buildChunkUploadKey(toolboxUserID, target.server, target.gameUserID, uploadID)
+		status, err := loadIOSUploadChunkStatus(ctx, apiHelper.DBManager.Redis.Redis, uploadKey)
+		if err != nil {
+			logger.Errorf("Failed to load upload chunk status for %s: %v", uploadKey, err)
+			return harukiAPIHelper.ErrorInternal(c, "failed to load upload status")
+		}
+		resp := buildIOSUploadStatusResponse(uploadID, status, requestedTotal)
+		return harukiAPIHelper.SuccessResponse(c, "success", &resp)
+	}
+}
+
 func parseIOSProxyPathInt(raw string) (int64, error) {
 	return strconv.ParseInt(raw, 10, 64)
 }
@@ -274,6 +421,7 @@ func registerIOSUploadRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpe
 		api := apiHelper.Router.Group(prefix)
 
 		api.Post("/script/:upload_code/upload", proxyGuard, handleIOSScriptUploadWithValidation(apiHelper, logger))
+		api.Get("/script/:upload_code/upload/status", proxyGuard, handleIOSScriptUploadStatus(apiHelper, logger))
 		api.Get("/proxy/:server/suite/user/:user_id", proxyGuard, handleIOSProxySuite(apiHelper, logger))
 		api.Post("/proxy/:server/user/:user_id/mysekai", proxyGuard, handleIOSProxyMysekai(apiHelper, logger))
 		api.Put("/proxy/:server/user/:user_id/mysekai/birthday-party/:party_id/delivery", proxyGuard, handleIOSProxyMysekaiBirthdayPartyDelivery(apiHelper, logger))
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	"sort"
 	"strconv"
-	"sync"
 	"time"
 
 	goredis "github.com/redis/go-redis/v9"
@@ -14,7 +14,46 @@ import (
 
 const iosChunkUploadTTL = 5 * time.Minute
 
-var iosUploadChunkStoreMu sync.Mutex
+// iosUploadChunkPersistScript returns {state, chunk count, upload size}; the
+// states match the iosUploadChunkState constants.
+const iosUploadChunkPersistScript = `
+local total = tonumber(ARGV[1])
+local storedTotal = redis.call('HGET', KEYS[1], 'total')
+if storedTotal then
+  local parsedTotal = tonumber(storedTotal)
+  if parsedTotal == nil then
+    return redis.error_reply('parse stored total chunks: ' .. storedTotal)
+  end
+  if parsedTotal ~= total then
+    return {-1, 0, 0}
+  end
+end
+local size = 0
+local storedSize = redis.call('HGET', KEYS[1], 'size')
+if storedSize then
+  size = tonumber(storedSize)
+  if size == nil then
+    return redis.error_reply('parse stored upload size: ' .. storedSize)
+  end
+end
+local newSize = size - redis.call('HSTRLEN', KEYS[2], ARGV[2]) + string.len(ARGV[3])
+if newSize > tonumber(ARGV[4]) then
+  return {-2, redis.call('HLEN', KEYS[2]), size}
+end
+redis.call('HSET', KEYS[1], 'total', total, 'size', newSize)
+redis.call('HSET', KEYS[2], ARGV[2], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[5])
+redis.call('PEXPIRE', KEYS[2], ARGV[5])
+local count = redis.call('HLEN', KEYS[2])
+if count ~= total then
+  redis.call('DEL', KEYS[3])
+  return {0, count, newSize}
+end
+if redis.call('SET', KEYS[3], '1', 'NX', 'PX', ARGV[5]) then
+  return {1, count, newSize}
+end
+return {2, count, newSize}
+`
 
 const (
 	iosUploadChunkStateIncomplete int64 = iota
@@ -24,6 +63,32 @@ const (
 	iosUploadChunkStateTooLarge          = -2
 )
 
+// iosUploadChunkStatusScript returns {completed, total chunks, ttl in ms,
+// received chunk indexes...} and refreshes the TTL of a stored upload. The
+// claim key outlives the cleared chunks, so a finished upload still reports
+// completed until it expires.
+const iosUploadChunkStatusScript = `
+local completed = redis.call('EXISTS', KEYS[3])
+local total = redis.call('HGET', KEYS[1], 'total')
+if not total then
+  return {completed, 0, 0}
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+redis.call('PEXPIRE', KEYS[2], ARGV[1])
+local result = {completed, tonumber(total), tonumber(ARGV[1])}
+for _, index in ipairs(redis.call('HKEYS', KEYS[2])) do
+  table.insert(result, tonumber(index))
+end
+return result
+`
+
+type iosUploadChunkStatus struct {
+	Completed      bool
+	TotalChunks    int
+	ReceivedChunks []int
+	ExpiresIn      time.Duration
+}
+
 type iosUploadChunkPersistResult struct {
 	State int64
 	Count int
@@ -36,6 +101,10 @@ func iosUploadRedisKeys(uploadKey string) (metaKey string, chunkDataKey string,
 		harukiRedis.BuildIOSUploadChunkClaimKey(uploadKey)
 }
 
+// persistIOSUploadChunk stores one chunk and claims the upload once every
+// chunk arrived. The whole step runs as one Lua script, so concurrent chunks
+// of the same upload hitting different instances never lose a size update or
+// claim the same upload twice.
 func persistIOSUploadChunk(
 	ctx context.Context,
 	redisClient *goredis.Client,
@@ -49,98 +118,26 @@ func persistIOSUploadChunk(
 	}
 
 	metaKey, chunkDataKey, claimKey := iosUploadRedisKeys(uploadKey)
-	chunkIndexField := strconv.Itoa(chunkIndex)
-
-	iosUploadChunkStoreMu.Lock()
-	defer iosUploadChunkStoreMu.Unlock()
-
-	meta, err := redisClient.HGetAll(ctx, metaKey).Result()
+	values, err := redisClient.Eval(
+		ctx,
+		iosUploadChunkPersistScript,
+		[]string{metaKey, chunkDataKey, claimKey},
+		totalChunks,
+		strconv.Itoa(chunkIndex),
+		chunkData,
+		maxDataChunksSize,
+		iosChunkUploadTTL.Milliseconds(),
+	).Int64Slice()
 	if err != nil {
 		return iosUploadChunkPersistResult{}, err
 	}
-
-	currentSize := int64(0)
-	if rawTotal, ok := meta["total"]; ok && rawTotal != "" {
-		expectedTotal, parseErr := strconv.Atoi(rawTotal)
-		if parseErr != nil {
-			return iosUploadChunkPersistResult{}, fmt.Errorf("parse stored total chunks: %w", parseErr)
-		}
-		if expectedTotal != totalChunks {
-			return iosUploadChunkPersistResult{State: iosUploadChunkStateInconsistentTotal}, nil
-		}
-	}
-	if rawSize, ok := meta["size"]; ok && rawSize != "" {
-		parsedSize, parseErr := strconv.ParseInt(rawSize, 10, 64)
-		if parseErr != nil {
-			return iosUploadChunkPersistResult{}, fmt.Errorf("parse stored upload size: %w", parseErr)
-		}
-		currentSize = parsedSize
-	}
-
-	oldChunkData, err := redisClient.HGet(ctx, chunkDataKey, chunkIndexField).Bytes()
-	if err != nil && err != goredis.Nil {
-		return iosUploadChunkPersistResult{}, err
-	}
-	if err == goredis.Nil {
-		oldChunkData = nil
-	}
-
-	newSize := currentSize - int64(len(oldChunkData)) + int64(len(chunkData))
-	if newSize > maxDataChunksSize {
-		count, countErr := redisClient.HLen(ctx, chunkDataKey).Result()
-		if countErr != nil {
-			return iosUploadChunkPersistResult{}, countErr
-		}
-		return iosUploadChunkPersistResult{
-			State: iosUploadChunkStateTooLarge,
-			Count: int(count),
-			Size:  currentSize,
-		}, nil
-	}
-
-	pipe := redisClient.TxPipeline()
-	pipe.HSet(ctx, metaKey, map[string]any{
-		"total": totalChunks,
-		"size":  newSize,
-	})
-	pipe.HSet(ctx, chunkDataKey, chunkIndexField, chunkData)
-	pipe.Expire(ctx, metaKey, iosChunkUploadTTL)
-	pipe.Expire(ctx, chunkDataKey, iosChunkUploadTTL)
-	if _, err := pipe.Exec(ctx); err != nil {
-		return iosUploadChunkPersistResult{}, err
-	}
-
-	count, err := redisClient.HLen(ctx, chunkDataKey).Result()
-	if err != nil {
-		return iosUploadChunkPersistResult{}, err
-	}
-	if int(count) != totalChunks {
-		if err := redisClient.Del(ctx, claimKey).Err(); err != nil {
-			return iosUploadChunkPersistResult{}, err
-		}
-		return iosUploadChunkPersistResult{
-			State: iosUploadChunkStateIncomplete,
-			Count: int(count),
-			Size:  newSize,
-		}, nil
-	}
-
-	setStatus, err := redisClient.SetArgs(ctx, claimKey, "1", goredis.SetArgs{
-		Mode: "NX",
-		TTL:  iosChunkUploadTTL,
-	}).Result()
-	if err != nil && err != goredis.Nil {
-		return iosUploadChunkPersistResult{}, err
-	}
-	claimed := err == nil && setStatus == "OK"
-	state := iosUploadChunkStateCompleteAlreadyClaimed
-	if claimed {
-		state = iosUploadChunkStateCompleteClaimed
+	if len(values) != 3 {
+		return iosUploadChunkPersistResult{}, fmt.Errorf("unexpected chunk persist script result length: %d", len(values))
 	}
 	return iosUploadChunkPersistResult{
-		State: state,
-		Count: int(count),
-		Size:  newSize,
+		State: values[0],
+		Count: int(values[1]),
+		Size:  values[2],
 	}, nil
 }
 
@@ -155,10 +152,6 @@ func loadIOSUploadChunks(
 	}
 
 	_, chunkDataKey, _ := iosUploadRedisKeys(uploadKey)
-
-	iosUploadChunkStoreMu.Lock()
-	defer iosUploadChunkStoreMu.Unlock()
-
 	rawChunks, err := redisClient.HGetAll(ctx, chunkDataKey).Result()
 	if err != nil {
 		return nil, err
@@ -184,13 +177,49 @@ func loadIOSUploadChunks(
 	return chunks, nil
 }
 
+// loadIOSUploadChunkStatus reports which chunks of an upload arrived. A
+// query counts as activity and extends the upload by iosChunkUploadTTL.
+func loadIOSUploadChunkStatus(ctx context.Context, redisClient *goredis.Client, uploadKey string) (iosUploadChunkStatus, error) {
+	if redisClient == nil {
+		return iosUploadChunkStatus{}, fmt.Errorf("redis client is nil")
+	}
+
+	metaKey, chunkDataKey, claimKey := iosUploadRedisKeys(uploadKey)
+	values, err := redisClient.Eval(
+		ctx,
+		iosUploadChunkStatusScript,
+		[]string{metaKey, chunkDataKey, claimKey},
+		iosChunkUploadTTL.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return iosUploadChunkStatus{}, err
+	}
+	if len(values) < 3 {
+		return iosUploadChunkStatus{}, fmt.Errorf("unexpected chunk status script result length: %d", len(values))
+	}
+	received := make([]int, 0, len(values)-3)
+	for _, index := range values[3:] {
+		received = append(received, int(index))
+	}
+	sort.Ints(received)
+	return iosUploadChunkStatus{
+		Completed:      values[0] == 1,
+		TotalChunks:    int(values[1]),
+		ReceivedChunks: received,
+		ExpiresIn:      time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
+
+// clearIOSUploadChunks drops the chunks of an assembled upload. The claim key
+// is left to expire so status queries still see the upload as completed and a
+// script that missed the final response does not upload it again.
 func clearIOSUploadChunks(ctx context.Context, redisClient *goredis.Client, uploadKey string) error {
 	if redisClient == nil {
 		return fmt.Errorf("redis client is nil")
 	}
 
-	metaKey, chunkDataKey, claimKey := iosUploadRedisKeys(uploadKey)
-	return redisClient.Del(ctx, metaKey, chunkDataKey, claimKey).Err()
+	metaKey, chunkDataKey, _ := iosUploadRedisKeys(uploadKey)
+	return redisClient.Del(ctx, metaKey, chunkDataKey).Err()
 }
 
 func resetIOSUploadClaim(ctx context.Context, redisClient *goredis.Client, uploadKey string) error {
@@ -3,7 +3,12 @@ package upload
 import (
 	"context"
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	goredis "github.com/redis/go-redis/v9"
@@ -134,12 +139,101 @@ func TestIOSUploadChunkStoreLifecycle(t *testing.T) {
 	}
 
 	metaKey, chunkDataKey, claimKey := iosUploadRedisKeys(uploadKey)
-	exists, err := client.Exists(ctx, metaKey, chunkDataKey, claimKey).Result()
+	exists, err := client.Exists(ctx, metaKey, chunkDataKey).Result()
 	if err != nil {
 		t.Fatalf("Exists returned error: %v", err)
 	}
 	if exists != 0 {
-		t.Fatalf("expected upload keys to be cleared")
+		t.Fatalf("expected upload chunks to be cleared")
+	}
+	if exists := client.Exists(ctx, claimKey).Val(); exists != 1 {
+		t.Fatalf("expected the claim to outlive the chunks")
+	}
+	status, err := loadIOSUploadChunkStatus(ctx, client, uploadKey)
+	if err != nil {
+		t.Fatalf("loadIOSUploadChunkStatus returned error: %v", err)
+	}
+	if !status.Completed {
+		t.Fatalf("expected a cleared upload to report completed")
+	}
+}
+
+func TestIOSUploadChunkStatusReportsReceivedChunksAndExtendsTTL(t *testing.T) {
+	t.Parallel()
+
+	srv := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	ctx := context.Background()
+	uploadKey := buildChunkUploadKey("toolbox-user", harukiUtils.SupportedDataUploadServerJP, 123456, "upload-id")
+
+	status, err := loadIOSUploadChunkStatus(ctx, client, uploadKey)
+	if err != nil {
+		t.Fatalf("loadIOSUploadChunkStatus returned error: %v", err)
+	}
+	if status.Completed || status.TotalChunks != 0 || len(status.ReceivedChunks) != 0 {
+		t.Fatalf("unknown upload status = %#v", status)
+	}
+	resp := buildIOSUploadStatusResponse("upload-id", status, 3)
+	if resp.TotalChunks != 3 || len(resp.MissingChunks) != 3 || resp.ExpiresInSeconds != 0 {
+		t.Fatalf("unknown upload response = %#v", resp)
+	}
+
+	for _, index := range []int{3, 0} {
+		if _, err := persistIOSUploadChunk(ctx, client, uploadKey, 4, index, []byte("ab")); err != nil {
+			t.Fatalf("persistIOSUploadChunk(%d) returned error: %v", index, err)
+		}
+	}
+	srv.FastForward(iosChunkUploadTTL - time.Second)
+	status, err = loadIOSUploadChunkStatus(ctx, client, uploadKey)
+	if err != nil {
+		t.Fatalf("loadIOSUploadChunkStatus returned error: %v", err)
+	}
+	if status.Completed || status.TotalChunks != 4 || !reflect.DeepEqual(status.ReceivedChunks, []int{0, 3}) {
+		t.Fatalf("partial upload status = %#v", status)
+	}
+	resp = buildIOSUploadStatusResponse("upload-id", status, 0)
+	if !reflect.DeepEqual(resp.MissingChunks, []int{1, 2}) || resp.ExpiresInSeconds != int(iosChunkUploadTTL/time.Second) {
+		t.Fatalf("partial upload response = %#v", resp)
+	}
+
+	// The query refreshed the TTL, so the chunks survive past the original
+	// expiry.
+	srv.FastForward(2 * time.Second)
+	status, err = loadIOSUploadChunkStatus(ctx, client, uploadKey)
+	if err != nil {
+		t.Fatalf("loadIOSUploadChunkStatus returned error: %v", err)
+	}
+	if len(status.ReceivedChunks) != 2 {
+		t.Fatalf("chunks expired despite the status query: %#v", status)
+	}
+}
+
+func TestVerifyIOSUploadChunkChecksum(t *testing.T) {
+	t.Parallel()
+
+	body := []byte("123456789")
+	// 0xCBF43926 is the CRC-32 (IEEE) check value of "123456789".
+	for _, tc := range []struct {
+		checksum string
+		want     bool
+	}{
+		{"", true},
+		{"cbf43926", true},
+		{"CBF43926", true},
+		{"cbf43927", false},
+		{"cbf4392", false},
+		{"zzzzzzzz", false},
+	} {
+		if got := verifyIOSUploadChunkChecksum(tc.checksum, body); got != tc.want {
+			t.Fatalf("verifyIOSUploadChunkChecksum(%q) = %t, want %t", tc.checksum, got, tc.want)
+		}
+	}
+	header := &dataUploadHeader{UploadId: "u-1", TotalChunks: 1, ChunkCRC32: "not-hex!"}
+	if err := validateDataUploadHeader(header); err == nil {
+		t.Fatalf("expected a malformed checksum header to be rejected")
 	}
 }
 
@@ -210,6 +304,45 @@ func TestIOSUploadChunkStoreEnforcesMaxSize(t *testing.T) {
 	}
 }
 
+func TestIOSUploadChunkStoreConcurrentChunksClaimOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	uploadKey := buildChunkUploadKey("toolbox-user", harukiUtils.SupportedDataUploadServerJP, 123456, "upload-id")
+	srv := miniredis.RunT(t)
+	// Separate clients stand in for separate instances.
+	const totalChunks = 16
+	var claimed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < totalChunks; i++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			client := goredis.NewClient(&goredis.Options{Addr: srv.Addr()})
+			defer func() {
+				_ = client.Close()
+			}()
+			result, err := persistIOSUploadChunk(ctx, client, uploadKey, totalChunks, index, []byte("chunk"))
+			if err != nil {
+				t.Errorf("persistIOSUploadChunk(%d) returned error: %v", index, err)
+				return
+			}
+			if result.State == iosUploadChunkStateCompleteClaimed {
+				claimed.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := claimed.Load(); got != 1 {
+		t.Fatalf("claimed %d times, want 1", got)
+	}
+	metaKey, _, _ := iosUploadRedisKeys(uploadKey)
+	if size := srv.HGet(metaKey, "size"); size != strconv.Itoa(totalChunks*len("chunk")) {
+		t.Fatalf("stored size = %q, want %d", size, totalChunks*len("chunk"))
+	}
+}
+
 func newIOSUploadRedisClient(t *testing.T) *goredis.Client {
 	t.Helper()
 
@@ -2,75 +2,25 @@ package upload
 
 import (
 	"context"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/coordination"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 const (
 	openUploadRateLimitPerMinute = 180
 	openUploadRateLimitWindow    = time.Minute
-	openUploadRateLimitKeyTTL    = 2 * time.Minute
 )
 
-var fallbackOpenUploadRateLimiter = newOpenUploadRateLimiter(openUploadRateLimitPerMinute, openUploadRateLimitWindow)
-
-type openUploadRateLimiter struct {
-	mu          sync.Mutex
-	window      time.Duration
-	maxRequests int
-	buckets     map[string]openUploadRateBucket
-	hits        uint64
-}
-
-type openUploadRateBucket struct {
-	windowStart time.Time
-	count       int
-}
-
-func newOpenUploadRateLimiter(maxRequests int, window time.Duration) *openUploadRateLimiter {
-	return &openUploadRateLimiter{
-		window:      window,
-		maxRequests: maxRequests,
-		buckets:     make(map[string]openUploadRateBucket),
-	}
-}
-
-func (l *openUploadRateLimiter) allow(now time.Time, key string) bool {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	bucket, ok := l.buckets[key]
-	if !ok || now.Sub(bucket.windowStart) >= l.window {
-		l.buckets[key] = openUploadRateBucket{windowStart: now, count: 1}
-		l.hits++
-		if l.hits%256 == 0 {
-			l.cleanupLocked(now)
-		}
-		return true
-	}
-	if bucket.count >= l.maxRequests {
-		return false
-	}
-
-	bucket.count++
-	l.buckets[key] = bucket
-	return true
-}
-
-func (l *openUploadRateLimiter) cleanupLocked(now time.Time) {
-	for key, bucket := range l.buckets {
-		if now.Sub(bucket.windowStart) >= l.window {
-			delete(l.buckets, key)
-		}
-	}
-}
+// openUploadRateLimiter shares its buckets across instances through Redis.
+var openUploadRateLimiter = coordination.NewRateLimiter(openUploadRateLimitPerMinute, openUploadRateLimitWindow, harukiRedis.BuildUploadIngressRateLimitKey)
 
 func openUploadEntryGuard(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
 	return func(c fiber.Ctx) error {
@@ -79,12 +29,7 @@ func openUploadEntryGuard(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 
 		allowed, retryAfter, err := consumeOpenUploadRateLimit(c.Context(), apiHelper, bucketKey, now)
 		if err != nil {
-			// Fallback to in-process limiter when Redis is unavailable.
-			harukiLogger.Warnf("Open upload redis rate limiter fallback: %v", err)
-			if fallbackOpenUploadRateLimiter.allow(now, bucketKey) {
-				return c.Next()
-			}
-			retryAfter = int(openUploadRateLimitWindow / time.Second)
+			harukiLogger.Warnf("Open upload rate limiter fell back to local buckets: %v", err)
 		}
 
 		if allowed {
@@ -102,21 +47,19 @@ func openUploadEntryGuard(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 	}
 }
 
+// consumeOpenUploadRateLimit counts one request against the shared bucket.
+// A non-nil error means Redis was unavailable and the local fallback bucket
+// decided instead.
 func consumeOpenUploadRateLimit(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, bucketKey string, now time.Time) (bool, int, error) {
-	if apiHelper == nil || apiHelper.DBManager == nil || apiHelper.DBManager.Redis == nil {
-		return false, 0, fiber.NewError(fiber.StatusInternalServerError, "redis rate limiter not initialized")
-	}
-
-	windowSlot := now.Unix() / int64(openUploadRateLimitWindow/time.Second)
-	key := harukiRedis.BuildUploadIngressRateLimitKey(windowSlot, bucketKey)
-	count, err := apiHelper.DBManager.Redis.IncrementWithTTL(ctx, key, openUploadRateLimitKeyTTL)
-	if err != nil {
-		return false, 0, err
+	var redisClient *goredis.Client
+	if apiHelper != nil && apiHelper.DBManager != nil && apiHelper.DBManager.Redis != nil {
+		redisClient = apiHelper.DBManager.Redis.Redis
 	}
-	if count <= int64(openUploadRateLimitPerMinute) {
-		return true, 0, nil
+	decision, err := openUploadRateLimiter.Allow(ctx, redisClient, bucketKey, now)
+	if decision.Allowed {
+		return true, 0, err
 	}
-	return false, secondsUntilWindowReset(now, openUploadRateLimitWindow), nil
+	return false, secondsUntilWindowReset(now, openUploadRateLimitWindow), err
 }
 
 func secondsUntilWindowReset(now time.Time, window time.Duration) int {
@@ -12,44 +12,6 @@ import (
 	goredis "github.com/redis/go-redis/v9"
 )
 
-func TestOpenUploadRateLimiterAllow(t *testing.T) {
-	t.Parallel()
-
-	limiter := newOpenUploadRateLimiter(2, time.Minute)
-	now := time.Unix(1700000000, 0).UTC()
-	key := "127.0.0.1|POST|/inherit"
-
-	if !limiter.allow(now, key) {
-		t.Fatalf("first request should pass")
-	}
-	if !limiter.allow(now.Add(10*time.Second), key) {
-		t.Fatalf("second request should pass")
-	}
-	if limiter.allow(now.Add(20*time.Second), key) {
-		t.Fatalf("third request in same window should be blocked")
-	}
-	if !limiter.allow(now.Add(61*time.Second), key) {
-		t.Fatalf("request after window reset should pass")
-	}
-}
-
-func TestOpenUploadRateLimiterIsolatedByKey(t *testing.T) {
-	t.Parallel()
-
-	limiter := newOpenUploadRateLimiter(1, time.Minute)
-	now := time.Unix(1700000000, 0).UTC()
-
-	if !limiter.allow(now, "ip-a|POST|/inherit") {
-		t.Fatalf("first key should pass")
-	}
-	if !limiter.allow(now, "ip-b|POST|/inherit") {
-		t.Fatalf("different key should pass")
-	}
-	if limiter.allow(now, "ip-a|POST|/inherit") {
-		t.Fatalf("same key should be blocked after limit")
-	}
-}
-
 func TestConsumeOpenUploadRateLimit(t *testing.T) {
 	t.Parallel()
 
@@ -87,18 +49,18 @@ func TestConsumeOpenUploadRateLimit(t *testing.T) {
 	}
 }
 
-func TestConsumeOpenUploadRateLimitRedisMissing(t *testing.T) {
+func TestConsumeOpenUploadRateLimitRedisMissingFallsBackToLocalBucket(t *testing.T) {
 	t.Parallel()
 
-	allowed, retryAfter, err := consumeOpenUploadRateLimit(context.Background(), &api.HarukiToolboxRouterHelpers{}, "k", time.Now().UTC())
+	allowed, retryAfter, err := consumeOpenUploadRateLimit(context.Background(), &api.HarukiToolboxRouterHelpers{}, "redis-missing|POST|/inherit", time.Now().UTC())
 	if err == nil {
 		t.Fatalf("expected redis initialization error")
 	}
-	if allowed {
-		t.Fatalf("allowed should be false on redis initialization error")
+	if !allowed {
+		t.Fatalf("local fallback should allow the first request")
 	}
 	if retryAfter != 0 {
-		t.Fatalf("retryAfter should be 0 on redis initialization error, got %d", retryAfter)
+		t.Fatalf("retryAfter should be 0 when allowed, got %d", retryAfter)
 	}
 }
 
@@ -0,0 +1,200 @@
+package useraccountdeletion
+
+import (
+	"strings"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
+	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type accountDeletionPayload struct {
+	Password string `json:"password"`
+}
+
+type accountDeletionItem struct {
+	ID           string         `json:"id"`
+	Status       string         `json:"status"`
+	ScheduledFor time.Time      `json:"scheduledFor"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	CancelledAt  *time.Time     `json:"cancelledAt,omitempty"`
+	CompletedAt  *time.Time     `json:"completedAt,omitempty"`
+	Cancellable  bool           `json:"cancellable"`
+	Receipt      map[string]any `json:"receipt,omitempty"`
+}
+
+func buildAccountDeletionItem(row *postgresql.AccountDeletion, now time.Time) accountDeletionItem {
+	item := accountDeletionItem{
+		ID:           row.ID,
+		Status:       string(row.Status),
+		ScheduledFor: row.ScheduledFor.UTC(),
+		CreatedAt:    row.CreatedAt.UTC(),
+		Cancellable:  row.Status == accountdeletion.StatusPending && row.ScheduledFor.After(now),
+		Receipt:      row.Receipt,
+	}
+	if row.CancelledAt != nil {
+		cancelledAt := row.CancelledAt.UTC()
+		item.CancelledAt = &cancelledAt
+	}
+	if row.CompletedAt != nil {
+		completedAt := row.CompletedAt.UTC()
+		item.CompletedAt = &completedAt
+	}
+	return item
+}
+
+func handleGetAccountDeletion(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		row, err := apiHelper.DBManager.DB.GetLatestAccountDeletion(c.Context(), userID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				return harukiAPIHelper.ErrorNotFound(c, "no account deletion request")
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to query account deletion")
+		}
+		item := buildAccountDeletionItem(row, accountDeletionNow().UTC())
+		return harukiAPIHelper.SuccessResponse(c, "ok", &item)
+	}
+}
+
+func handleRequestAccountDeletion(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		deletionID := ""
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.account_deletion.request", result, userID, map[string]any{
+				"reason":     reason,
+				"deletionId": deletionID,
+			})
+		}()
+
+		var payload accountDeletionPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		if strings.TrimSpace(payload.Password) == "" {
+			reason = "missing_password"
+			return harukiAPIHelper.ErrorBadRequest(c, "password is required")
+		}
+
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		db := apiHelper.DBManager.DB
+		userRow, err := db.User.Query().Where(userSchema.IDEQ(userID)).Only(ctx)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				reason = "user_not_found"
+				return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+			}
+			reason = "query_user_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to verify account")
+		}
+		if userRow.Role != userSchema.RoleUser {
+			reason = "privileged_account"
+			return harukiAPIHelper.ErrorForbidden(c, "administrator accounts must be demoted before they can be deleted")
+		}
+		if failure, respond := userCoreModule.Reauthenticate(c, ctx, apiHelper, userRow, payload.Password); respond != nil {
+			reason = failure
+			return respond()
+		}
+
+		pending, err := db.HasPendingAccountDeletion(ctx, userID)
+		if err != nil {
+			reason = "query_deletion_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to query account deletion")
+		}
+		if pending {
+			reason = "deletion_pending"
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, "account deletion is already scheduled", nil)
+		}
+
+		deletionID, err = generateDeletionID()
+		if err != nil {
+			reason = "generate_id_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to schedule account deletion")
+		}
+		options := CurrentOptions()
+		now := accountDeletionNow().UTC()
+		locale := harukiSMTP.LocaleFromAcceptLanguage(c.Get(fiber.HeaderAcceptLanguage))
+		row, err := db.CreateAccountDeletion(ctx, deletionID, userID, locale, options.MaxAttempts, now.Add(options.GracePeriod), now)
+		if err != nil {
+			reason = "create_deletion_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to schedule account deletion")
+		}
+		sendScheduledMail(apiHelper, userRow.Email, row)
+
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		item := buildAccountDeletionItem(row, now)
+		return harukiAPIHelper.UpdatedDataResponse(c, fiber.StatusAccepted, "account deletion scheduled", &item)
+	}
+}
+
+// sendScheduledMail tells the owner that the account will be deleted, so a
+// request made from a stolen session does not go unnoticed.
+func sendScheduledMail(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, email string, row *postgresql.AccountDeletion) {
+	if apiHelper.SMTPClient == nil || strings.TrimSpace(email) == "" {
+		return
+	}
+	vars := map[string]string{"SCHEDULED_FOR": row.ScheduledFor.UTC().Format(mailTimeFormat)}
+	if err := apiHelper.SMTPClient.SendTemplate([]string{email}, harukiSMTP.TemplateAccountDeletionScheduled, row.Locale, vars, harukiConfig.Cfg.UserSystem.SMTP.MailName); err != nil {
+		harukiLogger.Warnf("Failed to send account deletion notice for %s: %v", row.ID, err)
+	}
+}
+
+func handleCancelAccountDeletion(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.account_deletion.cancel", result, userID, map[string]any{
+				"reason": reason,
+			})
+		}()
+
+		db := apiHelper.DBManager.DB
+		now := accountDeletionNow().UTC()
+		cancelled, err := db.CancelAccountDeletion(c.Context(), userID, now)
+		if err != nil {
+			reason = "cancel_deletion_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to cancel account deletion")
+		}
+		if !cancelled {
+			pending, err := db.HasPendingAccountDeletion(c.Context(), userID)
+			if err != nil {
+				reason = "query_deletion_failed"
+				return harukiAPIHelper.ErrorInternal(c, "failed to query account deletion")
+			}
+			if pending {
+				reason = "grace_period_over"
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, "account deletion is already in progress", nil)
+			}
+			reason = "no_pending_deletion"
+			return harukiAPIHelper.ErrorNotFound(c, "no pending account deletion")
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		return harukiAPIHelper.SuccessResponse[string](c, "account deletion cancelled", nil)
+	}
+}
@@ -0,0 +1,334 @@
+package useraccountdeletion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type recordingMailer struct {
+	mu   sync.Mutex
+	sent []map[string]string
+}
+
+func (m *recordingMailer) Send([]string, string, string, string) error { return nil }
+
+func (m *recordingMailer) SendTemplate(to []string, template, locale string, vars map[string]string, _ string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, map[string]string{"to": strings.Join(to, ","), "template": template, "locale": locale, "receipt": vars["RECEIPT_ID"]})
+	return nil
+}
+
+type stubGameData struct {
+	deleted []string
+}
+
+func (s *stubGameData) DeleteData(_ context.Context, userID int64, server string) (int64, error) {
+	s.deleted = append(s.deleted, server)
+	return 2, nil
+}
+
+// newKratosServer serves the password check for identity-1 (password
+// "secret") and records the identity deletion.
+func newKratosServer(t *testing.T, identityDeleted *bool) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/identities/identity-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"identity-1","traits":{"email":"u1@example.com"}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/admin/identities/identity-1":
+			*identityDeleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/self-service/login/api":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"flow-1"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/self-service/login":
+			var payload map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if payload["password"] != "secret" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"reason":"the provided credentials are invalid"}}`))
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"session_token":"token-1"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/sessions/whoami":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"session-1","active":true,"identity":{"id":"identity-1","traits":{"email":"u1@example.com"}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/admin/sessions/session-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func seedAccountDeletionUser(t *testing.T, client *postgresql.Client, avatarDir string) {
+	t.Helper()
+	ctx := context.Background()
+	if err := os.WriteFile(filepath.Join(avatarDir, "u1.png"), []byte("avatar"), 0o644); err != nil {
+		t.Fatalf("write avatar returned error: %v", err)
+	}
+	if _, err := client.User.Create().
+		SetID("u1").
+		SetName("u1").
+		SetEmail("u1@example.com").
+		SetKratosIdentityID("identity-1").
+		SetAvatarPath("u1.png").
+		Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	if _, err := client.User.Create().SetID("u2").SetName("u2").SetEmail("u2@example.com").Save(ctx); err != nil {
+		t.Fatalf("create second user returned error: %v", err)
+	}
+	if _, err := client.GameAccountBinding.Create().SetServer("jp").SetGameUserID("123456").SetVerified(true).SetUserID("u1").Save(ctx); err != nil {
+		t.Fatalf("create binding returned error: %v", err)
+	}
+	if _, err := client.GameAccountDataGrant.Create().
+		SetOwnerUserID("u1").
+		SetGranteeUserID("u2").
+		SetServer("jp").
+		SetGameUserID("123456").
+		SetDataType("suite").
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		Save(ctx); err != nil {
+		t.Fatalf("create grant returned error: %v", err)
+	}
+	ticketRow, err := client.Ticket.Create().SetTicketID("T-1").SetCreatorUserID("u1").SetSubject("help").Save(ctx)
+	if err != nil {
+		t.Fatalf("create ticket returned error: %v", err)
+	}
+	if _, err := client.TicketMessage.Create().SetTicketID(ticketRow.ID).SetSenderUserID("u1").SetMessage("question").Save(ctx); err != nil {
+		t.Fatalf("create message returned error: %v", err)
+	}
+	if _, err := client.DataExport.Create().SetID("e1").SetUserID("u1").SetMaxAttempts(1).SetStorageKey("exports/u1/e1.zip").Save(ctx); err != nil {
+		t.Fatalf("create data export returned error: %v", err)
+	}
+	if _, err := client.UploadLog.Create().
+		SetServer("jp").
+		SetGameUserID("123456").
+		SetToolboxUserID("u1").
+		SetDataType("suite").
+		SetUploadMethod("manual").
+		SetSuccess(true).
+		SetUploadTime(time.Now()).
+		Save(ctx); err != nil {
+		t.Fatalf("create upload log returned error: %v", err)
+	}
+}
+
+func TestAccountDeletionLifecycle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:user-account-deletion-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore returned error: %v", err)
+	}
+	if err := store.Put(context.Background(), "exports/u1/e1.zip", "application/zip", []byte("zip")); err != nil {
+		t.Fatalf("store.Put returned error: %v", err)
+	}
+	redisServer := miniredis.RunT(t)
+	redisClient := goredis.NewClient(&goredis.Options{Addr: redisServer.Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+	avatarDir := t.TempDir()
+	seedAccountDeletionUser(t, client, avatarDir)
+
+	identityDeleted := false
+	kratosServer := newKratosServer(t, &identityDeleted)
+	sessionHandler := harukiAPIHelper.NewSessionHandler(redisClient, "local-sign-key")
+	sessionHandler.ConfigureIdentityProvider("kratos", kratosServer.URL, kratosServer.URL, "X-Session-Token", "ory_kratos_session", true, true, 2*time.Second, nil)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	originalNow := accountDeletionNow
+	accountDeletionNow = func() time.Time { return now }
+	originalEnabled := oauthConsentRevocationEnabled
+	oauthConsentRevocationEnabled = func() bool { return false }
+	originalAvatarDir := harukiConfig.Cfg.UserSystem.AvatarSaveDir
+	harukiConfig.Cfg.UserSystem.AvatarSaveDir = avatarDir
+	originalOptions := CurrentOptions()
+	Configure(Options{GracePeriod: 24 * time.Hour, MaxAttempts: 2})
+	t.Cleanup(func() {
+		accountDeletionNow = originalNow
+		oauthConsentRevocationEnabled = originalEnabled
+		harukiConfig.Cfg.UserSystem.AvatarSaveDir = originalAvatarDir
+		Configure(originalOptions)
+	})
+
+	mailer := &recordingMailer{}
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:      &database.HarukiToolboxDBManager{DB: client, Redis: &harukiRedis.HarukiRedisManager{Redis: redisClient}},
+		SessionHandler: sessionHandler,
+		SMTPClient:     mailer,
+		BlobStore:      store,
+	}
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "u1")
+		c.Locals("userRole", "user")
+		return c.Next()
+	})
+	app.Get("/account-deletion", handleGetAccountDeletion(helper))
+	app.Post("/account-deletion", handleRequestAccountDeletion(helper))
+	app.Delete("/account-deletion", handleCancelAccountDeletion(helper))
+
+	do := func(method, body string) *http.Response {
+		req := httptest.NewRequest(method, "/account-deletion", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		return resp
+	}
+
+	if resp := do(http.MethodGet, ""); resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("get before request status code = %d, want %d", resp.StatusCode, fiber.StatusNotFound)
+	}
+	if resp := do(http.MethodPost, `{"password":"wrong"}`); resp.StatusCode != fiber.StatusForbidden {
+		t.Fatalf("request with wrong password status code = %d, want %d", resp.StatusCode, fiber.StatusForbidden)
+	}
+	if resp := do(http.MethodPost, `{"password":"secret"}`); resp.StatusCode != fiber.StatusAccepted {
+		t.Fatalf("request status code = %d, want %d", resp.StatusCode, fiber.StatusAccepted)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0]["template"] != harukiSMTP.TemplateAccountDeletionScheduled || mailer.sent[0]["locale"] != harukiSMTP.LocaleEN {
+		t.Fatalf("sent mail = %v, want the scheduled notice", mailer.sent)
+	}
+	if resp := do(http.MethodPost, `{"password":"secret"}`); resp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("second request status code = %d, want %d", resp.StatusCode, fiber.StatusConflict)
+	}
+	if resp := do(http.MethodDelete, ""); resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("cancel status code = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	if resp := do(http.MethodDelete, ""); resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("second cancel status code = %d, want %d", resp.StatusCode, fiber.StatusNotFound)
+	}
+
+	resp := do(http.MethodPost, `{"password":"secret"}`)
+	if resp.StatusCode != fiber.StatusAccepted {
+		t.Fatalf("repeated request status code = %d, want %d", resp.StatusCode, fiber.StatusAccepted)
+	}
+	var body map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+	deletionID := body["updatedData"].(map[string]any)["id"].(string)
+
+	worker := NewWorker(helper, harukiLogger.NewLogger("AccountDeletionTest", "ERROR", nil))
+	if attempted := worker.RunOnce(context.Background()); attempted != 0 {
+		t.Fatalf("RunOnce within the grace period attempted %d, want 0", attempted)
+	}
+
+	now = now.Add(25 * time.Hour)
+	if resp := do(http.MethodDelete, ""); resp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("cancel after the grace period status code = %d, want %d", resp.StatusCode, fiber.StatusConflict)
+	}
+	if attempted := worker.RunOnce(context.Background()); attempted != 1 {
+		t.Fatalf("RunOnce attempted %d, want 1", attempted)
+	}
+
+	ctx := context.Background()
+	row, err := client.AccountDeletion.Get(ctx, deletionID)
+	if err != nil {
+		t.Fatalf("get deletion returned error: %v", err)
+	}
+	if row.Status != accountdeletion.StatusCompleted || row.Receipt["receiptId"] != deletionID {
+		t.Fatalf("deletion after purge = %+v, want completed with a receipt", row)
+	}
+	if row.Receipt["dataGrants"] != float64(1) || row.Receipt["identityDeleted"] != true {
+		t.Fatalf("receipt = %v, want one data grant", row.Receipt)
+	}
+	if !identityDeleted {
+		t.Fatalf("Kratos identity was not deleted")
+	}
+	if exists, _ := client.User.Get(ctx, "u1"); exists != nil {
+		t.Fatalf("user still exists after purge")
+	}
+	if count, _ := client.GameAccountBinding.Query().Count(ctx); count != 0 {
+		t.Fatalf("bindings after purge = %d, want 0", count)
+	}
+	if count, _ := client.GameAccountDataGrant.Query().Count(ctx); count != 0 {
+		t.Fatalf("grants after purge = %d, want 0", count)
+	}
+	if count, _ := client.Ticket.Query().Count(ctx); count != 0 {
+		t.Fatalf("tickets after purge = %d, want 0", count)
+	}
+	if count, _ := client.DataExport.Query().Count(ctx); count != 0 {
+		t.Fatalf("data exports after purge = %d, want 0", count)
+	}
+	if count, _ := client.UploadLog.Query().Where(uploadlog.ToolboxUserIDIsNil()).Count(ctx); count != 1 {
+		t.Fatalf("anonymized upload logs = %d, want 1", count)
+	}
+	if _, _, err := store.Get(ctx, "exports/u1/e1.zip"); err != blobstore.ErrNotFound {
+		t.Fatalf("export archive after purge error = %v, want ErrNotFound", err)
+	}
+	if _, err := os.Stat(filepath.Join(avatarDir, "u1.png")); !os.IsNotExist(err) {
+		t.Fatalf("avatar after purge stat error = %v, want not exist", err)
+	}
+	last := mailer.sent[len(mailer.sent)-1]
+	if last["template"] != harukiSMTP.TemplateAccountDeletionCompleted || last["to"] != "u1@example.com" || last["receipt"] != deletionID {
+		t.Fatalf("last mail = %v, want the receipt", last)
+	}
+
+	count, err := client.SystemLog.Query().
+		Where(systemlog.ActionEQ("user.account_deletion.purge"), systemlog.ResultEQ(harukiAPIHelper.SystemLogResultSuccess)).
+		Count(ctx)
+	if err != nil || count != 1 {
+		t.Fatalf("purge system logs = %d (%v), want 1", count, err)
+	}
+}
+
+func TestPurgeGameDataSkipsMissingStore(t *testing.T) {
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{DBManager: &database.HarukiToolboxDBManager{}}
+	bindings := []*postgresql.GameAccountBinding{
+		{Server: "jp", GameUserID: "123456"},
+		{Server: "en", GameUserID: "not-a-number"},
+	}
+
+	gameData := &stubGameData{}
+	report := &purgeReport{}
+	if err := purgeGameData(context.Background(), helper, gameData, bindings, report); err != nil {
+		t.Fatalf("purgeGameData returned error: %v", err)
+	}
+	if report.GameDataDocuments != 2 || len(gameData.deleted) != 1 || len(report.Notes) != 1 {
+		t.Fatalf("report = %+v, deleted = %v", report, gameData.deleted)
+	}
+
+	report = &purgeReport{}
+	if err := purgeGameData(context.Background(), helper, nil, bindings[:1], report); err != nil {
+		t.Fatalf("purgeGameData without a store returned error: %v", err)
+	}
+	if len(report.Notes) != 1 || !strings.Contains(report.Notes[0], "not configured") {
+		t.Fatalf("notes = %v, want the missing store note", report.Notes)
+	}
+}
@@ -0,0 +1,269 @@
+package useraccountdeletion
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	oauth2Module "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/oauth2"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountdatagrant"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhooksubscription"
+)
+
+// gameDataPurger is the part of the Mongo manager the purge needs.
+type gameDataPurger interface {
+	DeleteData(ctx context.Context, userID int64, server string) (int64, error)
+}
+
+// The OAuth2 hooks are variables so tests can run without Hydra.
+var (
+	oauthConsentRevocationEnabled = oauth2Module.HydraOAuthManagementEnabled
+	revokeOAuthConsents           = func(ctx context.Context, subjects []string) error {
+		return oauth2Module.RevokeHydraConsentSessionsForSubjects(ctx, subjects, "")
+	}
+)
+
+// purgeReport is what the deletion receipt lists. Counts cover what this
+// run removed; a retried purge only counts what was still left.
+type purgeReport struct {
+	GameAccounts          int      `json:"gameAccounts"`
+	GameDataDocuments     int64    `json:"gameDataDocuments"`
+	WebhookSubscriptions  int      `json:"webhookSubscriptions"`
+	DataGrants            int      `json:"dataGrants"`
+	Tickets               int      `json:"tickets"`
+	TicketAttachments     int      `json:"ticketAttachments"`
+	DataExports           int      `json:"dataExports"`
+	SecondFactors         int      `json:"secondFactors"`
+	UploadLogsAnonymized  int      `json:"uploadLogsAnonymized"`
+	OAuth2ConsentsRevoked bool     `json:"oauth2ConsentsRevoked"`
+	IdentityDeleted       bool     `json:"identityDeleted"`
+	SessionsCleared       bool     `json:"sessionsCleared"`
+	AvatarRemoved         bool     `json:"avatarRemoved"`
+	Notes                 []string `json:"notes,omitempty"`
+}
+
+func (r *purgeReport) note(format string, args ...any) {
+	r.Notes = append(r.Notes, fmt.Sprintf(format, args...))
+}
+
+// purgeUser removes the account and everything tied to it. Steps that reach
+// another system which must not keep the data (MongoDB, Hydra, Kratos) fail
+// the run so it is retried; cleanups that only leave unreachable leftovers
+// (caches, sessions, files) are noted in the report instead. Every step is
+// safe to repeat.
+func purgeUser(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, gameData gameDataPurger, userRow *postgresql.User) (*purgeReport, error) {
+	db := apiHelper.DBManager.DB
+	report := &purgeReport{}
+
+	bindings, err := db.GameAccountBinding.Query().
+		Where(gameaccountbinding.HasUserWith(user.IDEQ(userRow.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query game account bindings: %w", err)
+	}
+	report.GameAccounts = len(bindings)
+	verified := make([]*postgresql.GameAccountBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		// An unverified binding does not prove ownership, so its game data
+		// belongs to whoever actually owns the account.
+		if binding.Verified {
+			verified = append(verified, binding)
+		}
+	}
+	if err := purgeGameData(ctx, apiHelper, gameData, verified, report); err != nil {
+		return nil, err
+	}
+
+	if oauthConsentRevocationEnabled() {
+		subjects := oauth2Module.HydraSubjectsForUser(userRow.ID, userRow.KratosIdentityID)
+		if err := revokeOAuthConsents(ctx, subjects); err != nil {
+			return nil, fmt.Errorf("revoke oauth2 consents: %w", err)
+		}
+		report.OAuth2ConsentsRevoked = true
+	} else {
+		report.note("OAuth2 authorization management is not enabled, no consents to revoke")
+	}
+
+	if handler := apiHelper.SessionHandler; handler != nil && handler.UsesKratosProvider() &&
+		userRow.KratosIdentityID != nil && strings.TrimSpace(*userRow.KratosIdentityID) != "" {
+		if err := handler.DeleteKratosIdentityByID(ctx, strings.TrimSpace(*userRow.KratosIdentityID)); err != nil {
+			return nil, fmt.Errorf("delete identity: %w", err)
+		}
+		report.IdentityDeleted = true
+	}
+
+	blobKeys, err := purgeRows(ctx, db, userRow.ID, verified, report)
+	if err != nil {
+		return nil, err
+	}
+	if store := apiHelper.BlobStore; store != nil {
+		failed := 0
+		for _, key := range blobKeys {
+			if err := store.Delete(ctx, key); err != nil {
+				failed++
+			}
+		}
+		if failed > 0 {
+			report.note("%d stored file(s) of tickets or data exports could not be removed", failed)
+		}
+	}
+
+	if err := harukiAPIHelper.ClearUserSessionsWithContext(ctx, apiHelper.RedisClient(), userRow.ID); err != nil {
+		report.note("local sessions could not be cleared and will expire on their own")
+	} else {
+		report.SessionsCleared = true
+	}
+	if userRow.AvatarPath != nil && strings.TrimSpace(*userRow.AvatarPath) != "" {
+		path := filepath.Join(harukiConfig.Cfg.UserSystem.AvatarSaveDir, filepath.Base(strings.TrimSpace(*userRow.AvatarPath)))
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			report.note("avatar file could not be removed")
+		} else {
+			report.AvatarRemoved = true
+		}
+	}
+	return report, nil
+}
+
+func purgeGameData(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, gameData gameDataPurger, bindings []*postgresql.GameAccountBinding, report *purgeReport) error {
+	if len(bindings) == 0 {
+		return nil
+	}
+	if gameData == nil {
+		report.note("game data store is not configured, no game data to delete")
+	}
+	for _, binding := range bindings {
+		gameUserID, err := strconv.ParseInt(strings.TrimSpace(binding.GameUserID), 10, 64)
+		if err != nil {
+			report.note("game data of %s account %s skipped because its id is not numeric", binding.Server, binding.GameUserID)
+			continue
+		}
+		if gameData != nil {
+			deleted, err := gameData.DeleteData(ctx, gameUserID, binding.Server)
+			if err != nil {
+				return fmt.Errorf("delete game data of %s account %s: %w", binding.Server, binding.GameUserID, err)
+			}
+			report.GameDataDocuments += deleted
+		}
+		if apiHelper.DBManager.Redis != nil {
+			if err := apiHelper.DBManager.Redis.ClearPublicGameDataCaches(ctx, binding.Server, gameUserID); err != nil {
+				report.note("cached game data of %s account %s could not be cleared and will expire on its own", binding.Server, binding.GameUserID)
+			}
+		}
+	}
+	return nil
+}
+
+// purgeRows deletes the PostgreSQL side in one transaction and returns the
+// blob keys to remove once it has committed. The user row goes last; its
+// foreign keys cascade to bindings, social platforms and the iOS script code.
+func purgeRows(ctx context.Context, db *postgresql.Client, userID string, bindings []*postgresql.GameAccountBinding, report *purgeReport) ([]string, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start transaction: %w", err)
+	}
+	blobKeys, err := purgeRowsTx(ctx, tx, userID, bindings, report)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return blobKeys, nil
+}
+
+func purgeRowsTx(ctx context.Context, tx *postgresql.Tx, userID string, bindings []*postgresql.GameAccountBinding, report *purgeReport) ([]string, error) {
+	for _, binding := range bindings {
+		deleted, err := tx.WebhookSubscription.Delete().
+			Where(
+				webhooksubscription.ServerEQ(binding.Server),
+				webhooksubscription.UserIDEQ(binding.GameUserID),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("delete webhook subscriptions: %w", err)
+		}
+		report.WebhookSubscriptions += deleted
+	}
+
+	deleted, err := tx.GameAccountDataGrant.Delete().
+		Where(gameaccountdatagrant.Or(
+			gameaccountdatagrant.OwnerUserIDEQ(userID),
+			gameaccountdatagrant.GranteeUserIDEQ(userID),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("delete data grants: %w", err)
+	}
+	report.DataGrants = deleted
+
+	var blobKeys []string
+	ticketIDs, err := tx.Ticket.Query().Where(ticket.CreatorUserIDEQ(userID)).IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query tickets: %w", err)
+	}
+	if len(ticketIDs) > 0 {
+		attachments, err := tx.TicketAttachment.Query().
+			Where(ticketattachment.TicketIDIn(ticketIDs...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query ticket attachments: %w", err)
+		}
+		for _, attachment := range attachments {
+			blobKeys = append(blobKeys, attachment.StorageKey)
+			if attachment.ThumbnailKey != nil {
+				blobKeys = append(blobKeys, *attachment.ThumbnailKey)
+			}
+		}
+		report.TicketAttachments = len(attachments)
+		// Messages and attachments cascade with the ticket.
+		if report.Tickets, err = tx.Ticket.Delete().Where(ticket.IDIn(ticketIDs...)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("delete tickets: %w", err)
+		}
+	}
+
+	exports, err := tx.DataExport.Query().Where(dataexport.UserIDEQ(userID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query data exports: %w", err)
+	}
+	for _, export := range exports {
+		if export.StorageKey != nil {
+			blobKeys = append(blobKeys, *export.StorageKey)
+		}
+	}
+	if report.DataExports, err = tx.DataExport.Delete().Where(dataexport.UserIDEQ(userID)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("delete data exports: %w", err)
+	}
+
+	if report.SecondFactors, err = secondfactor.DeleteAll(ctx, tx, userID); err != nil {
+		return nil, fmt.Errorf("delete second factors: %w", err)
+	}
+
+	// Upload logs stay for the upload statistics but lose the link to the
+	// account.
+	if report.UploadLogsAnonymized, err = tx.UploadLog.Update().
+		Where(uploadlog.ToolboxUserIDEQ(userID)).
+		ClearToolboxUserID().
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("anonymize upload logs: %w", err)
+	}
+
+	if _, err := tx.User.Delete().Where(user.IDEQ(userID)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("delete user: %w", err)
+	}
+	return blobKeys, nil
+}
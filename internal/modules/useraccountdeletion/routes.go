@@ -0,0 +1,13 @@
+package useraccountdeletion
+
+import (
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+)
+
+func RegisterUserAccountDeletionRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	r := apiHelper.Router.Group("/api/user/:toolbox_user_id/account-deletion", userCoreModule.RouteHandlers(userCoreModule.RequireAuthenticatedVerifiedSelf(apiHelper, "toolbox_user_id"))...)
+	r.Get("/", handleGetAccountDeletion(apiHelper))
+	r.Post("/", handleRequestAccountDeletion(apiHelper))
+	r.Delete("/", handleCancelAccountDeletion(apiHelper))
+}
@@ -0,0 +1,256 @@
+package useraccountdeletion
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+const (
+	// deletionLease must outlive one purge, so another instance does not
+	// start the same one meanwhile.
+	deletionLease       = 30 * time.Minute
+	deletionStepTimeout = 20 * time.Minute
+	deletionRetryDelay  = 10 * time.Minute
+	deletionBatchSize   = 5
+	mailTimeFormat      = "2006-01-02 15:04 UTC"
+)
+
+type Options struct {
+	// GracePeriod is how long a request can be cancelled before the
+	// account is purged.
+	GracePeriod  time.Duration
+	MaxAttempts  int
+	PollInterval time.Duration
+}
+
+func DefaultOptions() Options {
+	return Options{
+		GracePeriod:  7 * 24 * time.Hour,
+		MaxAttempts:  5,
+		PollInterval: time.Minute,
+	}
+}
+
+var (
+	optionsMu          sync.RWMutex
+	currentOptions     = DefaultOptions()
+	accountDeletionNow = time.Now
+)
+
+// Configure replaces the options read by the request handlers. It is called
+// once at startup.
+func Configure(options Options) {
+	defaults := DefaultOptions()
+	if options.GracePeriod < 0 {
+		options.GracePeriod = 0
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaults.MaxAttempts
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaults.PollInterval
+	}
+	optionsMu.Lock()
+	currentOptions = options
+	optionsMu.Unlock()
+}
+
+func CurrentOptions() Options {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return currentOptions
+}
+
+// Worker purges accounts whose grace period has ended and mails the
+// deletion receipt.
+type Worker struct {
+	apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
+	logger    *harukiLogger.Logger
+}
+
+func NewWorker(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger) *Worker {
+	return &Worker{apiHelper: apiHelper, logger: logger}
+}
+
+// Start runs the worker every PollInterval until ctx is cancelled. Call the
+// returned wait before closing the database.
+func (w *Worker) Start(ctx context.Context) func() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(CurrentOptions().PollInterval)
+		defer ticker.Stop()
+		for {
+			w.RunOnce(ctx)
+			select {
+			case <-ctx.Done():
+				w.logger.Infof("account deletion worker stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+// RunOnce purges the due accounts one after another and returns how many
+// were attempted.
+func (w *Worker) RunOnce(ctx context.Context) int {
+	db := w.apiHelper.DBManager.DB
+	now := accountDeletionNow().UTC()
+	due, err := db.ListDueAccountDeletions(ctx, now, deletionBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Errorf("Failed to list due account deletions: %v", err)
+		}
+		return 0
+	}
+	attempted := 0
+	for _, deletion := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		claimed, err := db.ClaimAccountDeletion(ctx, deletion, now, now.Add(deletionLease))
+		if err != nil {
+			w.logger.Errorf("Failed to claim account deletion %s: %v", deletion.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		deletion.Attempts++
+		attempted++
+		w.process(ctx, deletion)
+	}
+	return attempted
+}
+
+func (w *Worker) process(ctx context.Context, deletion *postgresql.AccountDeletion) {
+	stepCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deletionStepTimeout)
+	defer cancel()
+	db := w.apiHelper.DBManager.DB
+
+	email := ""
+	report := &purgeReport{}
+	userRow, err := db.User.Get(stepCtx, deletion.UserID)
+	switch {
+	case postgresql.IsNotFound(err):
+		// A retry after the user row was already deleted, or an account
+		// removed some other way: there is nothing left to purge here.
+		report.note("the account had already been removed")
+		err = nil
+	case err == nil:
+		email = userRow.Email
+		var gameData gameDataPurger
+		if mongo := w.apiHelper.DBManager.Mongo; mongo != nil {
+			gameData = mongo
+		}
+		report, err = purgeUser(stepCtx, w.apiHelper, gameData, userRow)
+	}
+	now := accountDeletionNow().UTC()
+	if err != nil {
+		if deletion.Attempts >= deletion.MaxAttempts {
+			w.logger.Errorf("Account deletion %s of user %s failed after %d attempts: %v", deletion.ID, deletion.UserID, deletion.Attempts, err)
+			if err := db.FailAccountDeletion(stepCtx, deletion.ID, err.Error(), now); err != nil {
+				w.logger.Errorf("Failed to mark account deletion %s as failed: %v", deletion.ID, err)
+			}
+			w.writeSystemLog(stepCtx, deletion, harukiAPIHelper.SystemLogResultFailure, map[string]any{"deletionId": deletion.ID, "reason": "purge_failed", "attempts": deletion.Attempts})
+			return
+		}
+		w.logger.Warnf("Account deletion %s of user %s failed, retrying: %v", deletion.ID, deletion.UserID, err)
+		if err := db.RetryAccountDeletion(stepCtx, deletion.ID, err.Error(), now.Add(time.Duration(deletion.Attempts)*deletionRetryDelay)); err != nil {
+			w.logger.Errorf("Failed to schedule retry of account deletion %s: %v", deletion.ID, err)
+		}
+		return
+	}
+
+	receipt := buildReceipt(deletion, report, now)
+	if err := db.CompleteAccountDeletion(stepCtx, deletion.ID, receipt, now); err != nil {
+		// The purge is repeatable, so the next attempt after the lease
+		// only has to record the receipt.
+		w.logger.Errorf("Failed to record receipt of account deletion %s: %v", deletion.ID, err)
+		return
+	}
+	w.writeSystemLog(stepCtx, deletion, harukiAPIHelper.SystemLogResultSuccess, receipt)
+	w.sendCompletedMail(deletion, email, now)
+}
+
+// buildReceipt lists what the purge removed. It is stored with the request
+// and written to the system log, and its id is mailed to the user.
+func buildReceipt(deletion *postgresql.AccountDeletion, report *purgeReport, completedAt time.Time) map[string]any {
+	receipt := map[string]any{
+		"receiptId":             deletion.ID,
+		"userId":                deletion.UserID,
+		"requestedAt":           deletion.CreatedAt.UTC().Format(time.RFC3339),
+		"scheduledFor":          deletion.ScheduledFor.UTC().Format(time.RFC3339),
+		"completedAt":           completedAt.UTC().Format(time.RFC3339),
+		"attempts":              deletion.Attempts,
+		"gameAccounts":          report.GameAccounts,
+		"gameDataDocuments":     report.GameDataDocuments,
+		"webhookSubscriptions":  report.WebhookSubscriptions,
+		"dataGrants":            report.DataGrants,
+		"tickets":               report.Tickets,
+		"ticketAttachments":     report.TicketAttachments,
+		"dataExports":           report.DataExports,
+		"secondFactors":         report.SecondFactors,
+		"uploadLogsAnonymized":  report.UploadLogsAnonymized,
+		"oauth2ConsentsRevoked": report.OAuth2ConsentsRevoked,
+		"identityDeleted":       report.IdentityDeleted,
+		"sessionsCleared":       report.SessionsCleared,
+		"avatarRemoved":         report.AvatarRemoved,
+	}
+	if len(report.Notes) > 0 {
+		receipt["notes"] = report.Notes
+	}
+	return receipt
+}
+
+// sendCompletedMail sends the receipt id to the address the account had. It
+// goes out after the purge, so the address is only held in memory.
+func (w *Worker) sendCompletedMail(deletion *postgresql.AccountDeletion, email string, completedAt time.Time) {
+	mailer := w.apiHelper.SMTPClient
+	if mailer == nil || strings.TrimSpace(email) == "" {
+		return
+	}
+	vars := map[string]string{
+		"RECEIPT_ID":   deletion.ID,
+		"COMPLETED_AT": completedAt.UTC().Format(mailTimeFormat),
+	}
+	if err := mailer.SendTemplate([]string{email}, harukiSMTP.TemplateAccountDeletionCompleted, deletion.Locale, vars, harukiConfig.Cfg.UserSystem.SMTP.MailName); err != nil {
+		w.logger.Warnf("Failed to send account deletion receipt for %s: %v", deletion.ID, err)
+	}
+}
+
+func (w *Worker) writeSystemLog(ctx context.Context, deletion *postgresql.AccountDeletion, result string, metadata map[string]any) {
+	targetType := "user"
+	targetID := deletion.UserID
+	if err := harukiAPIHelper.WriteSystemLog(ctx, w.apiHelper, harukiAPIHelper.SystemLogEntry{
+		ActorType:  harukiAPIHelper.SystemLogActorTypeSystem,
+		Action:     "user.account_deletion.purge",
+		TargetType: &targetType,
+		TargetID:   &targetID,
+		Result:     result,
+		Metadata:   metadata,
+	}); err != nil {
+		w.logger.Warnf("Failed to write system log for account deletion %s: %v", deletion.ID, err)
+	}
+}
+
+func generateDeletionID() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -7,6 +7,7 @@ import (
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/redact"
 	"math"
 	"strings"
 	"time"
@@ -29,7 +30,7 @@ const (
 	userActivityLogSortIDAsc             = "id_asc"
 	maxUserActivityLogActionFilterLength = 128
 	maxUserActivityMetadataStringLength  = 256
-	redactedMetadataValue                = "[redacted]"
+	redactedMetadataValue                = redact.Placeholder
 )
 
 var validUserActivityLogResults = []string{
@@ -170,28 +171,7 @@ func applyOwnActivityLogSort(query *postgresql.SystemLogQuery, sortValue string)
 }
 
 func isSensitiveOwnActivityMetadataKey(key string) bool {
-	normalized := strings.ToLower(strings.TrimSpace(key))
-	if normalized == "" {
-		return false
-	}
-
-	sensitiveKeywords := []string{
-		"token",
-		"secret",
-		"password",
-		"authorization",
-		"cookie",
-		"session",
-		"otp",
-		"one_time",
-		"one-time",
-	}
-	for _, kw := range sensitiveKeywords {
-		if strings.Contains(normalized, kw) {
-			return true
-		}
-	}
-	return false
+	return redact.IsSensitiveKey(key)
 }
 
 func truncateOwnActivityMetadataString(raw string) string {
@@ -1,6 +1,7 @@
 package userauth
 
 import (
+	"context"
 	platformIdentity "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/identity"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/cloudflare"
@@ -15,7 +16,9 @@ import (
 func handleLogin(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		var payload harukiAPIHelper.LoginPayload
 		logLogin := func(result string, targetUserID string, actorRole string, reason string) {
+			publishLoginRiskSignal(c, apiHelper, payload.Email, targetUserID, result, reason)
 			targetType := "user"
 			var targetIDPtr *string
 			if targetUserID != "" {
@@ -36,7 +39,6 @@ func handleLogin(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Ha
 			_ = harukiAPIHelper.WriteSystemLog(ctx, apiHelper, entry)
 		}
 
-		var payload harukiAPIHelper.LoginPayload
 		if err := c.Bind().Body(&payload); err != nil {
 			logLogin(harukiAPIHelper.SystemLogResultFailure, "", "", "invalid_payload")
 			return harukiAPIHelper.ErrorBadRequest(c, "Invalid request")
@@ -73,6 +75,20 @@ func handleLogin(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Ha
 	}
 }
 
+func publishLoginRiskSignal(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, email, userID, result, reason string) {
+	if !harukiAPIHelper.IsLoginRiskSignalReason(reason) {
+		return
+	}
+	harukiAPIHelper.PublishRiskSignal(apiHelper, harukiAPIHelper.RiskSignal{
+		Kind:     harukiAPIHelper.RiskSignalKindLogin,
+		Success:  result == harukiAPIHelper.SystemLogResultSuccess,
+		UserID:   userID,
+		Email:    email,
+		IP:       c.IP(),
+		Metadata: map[string]any{"reason": reason},
+	})
+}
+
 func normalizeAuditRole(actorRole string) string {
 	roleLower := strings.ToLower(strings.TrimSpace(actorRole))
 	if roleLower == "" {
@@ -149,14 +165,7 @@ func handleLoginViaKratos(
 		return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
 	}
 
-	user, err := apiHelper.DBManager.DB.User.
-		Query().
-		Where(userSchema.IDEQ(userID)).
-		WithSocialPlatformInfo().
-		WithAuthorizedSocialPlatforms().
-		WithGameAccountBindings().
-		WithIosScriptCode().
-		Only(ctx)
+	user, err := queryLoginUser(ctx, apiHelper, userID)
 	if err != nil {
 		revokeIssuedSession("load local user")
 		if postgresql.IsNotFound(err) {
@@ -179,9 +188,34 @@ func handleLoginViaKratos(
 		return harukiAPIHelper.ErrorForbidden(c, banMessage)
 	}
 
+	challenge, err := beginLoginSecondFactor(ctx, apiHelper, user.ID, sessionToken, payload.Email)
+	if err != nil {
+		revokeIssuedSession("second factor check")
+		rollbackLoginRateLimitReservation(c, apiHelper, payload.Email)
+		harukiLogger.Errorf("Failed to start second factor login for user %s: %v", user.ID, err)
+		logLogin(harukiAPIHelper.SystemLogResultFailure, user.ID, string(user.Role), "second_factor_check_failed")
+		return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+	}
+	if challenge != nil {
+		finalizeLoginRateLimitReservation(c, apiHelper, payload.Email)
+		logLogin(harukiAPIHelper.SystemLogResultSuccess, user.ID, string(user.Role), "second_factor_required")
+		return harukiAPIHelper.UpdatedDataResponse(c, fiber.StatusAccepted, "second factor required", challenge)
+	}
+
 	finalizeLoginRateLimitReservation(c, apiHelper, payload.Email)
 	logLogin(harukiAPIHelper.SystemLogResultSuccess, user.ID, string(user.Role), "ok")
 	ud := harukiAPIHelper.BuildUserDataFromDBUser(user, &sessionToken)
 	resp := harukiAPIHelper.RegisterOrLoginSuccessResponse{Status: fiber.StatusOK, Message: "login success", UserData: ud}
 	return harukiAPIHelper.ResponseWithStruct(c, fiber.StatusOK, &resp)
 }
+
+func queryLoginUser(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID string) (*postgresql.User, error) {
+	return apiHelper.DBManager.DB.User.
+		Query().
+		Where(userSchema.IDEQ(userID)).
+		WithSocialPlatformInfo().
+		WithAuthorizedSocialPlatforms().
+		WithGameAccountBindings().
+		WithIosScriptCode().
+		Only(ctx)
+}
@@ -0,0 +1,372 @@
+package userauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// loginSecondFactorTTL bounds the time between the password step and the
+	// second factor step. The Kratos session issued by the password step is
+	// revoked when the pending login runs out of attempts or expires.
+	loginSecondFactorTTL         = 5 * time.Minute
+	loginSecondFactorMaxAttempts = 5
+	loginSecondFactorKeyPrefix   = "login:second-factor:"
+	// loginSecondFactorPendingKey is a sorted set of the session tokens of
+	// pending logins, scored by when they expire. Whoever removes a token
+	// from it owns the session: the second factor step hands it out, the
+	// sweeper and abandoned logins revoke it.
+	loginSecondFactorPendingKey = loginSecondFactorKeyPrefix + "pending"
+	// loginSecondFactorSweepGrace keeps the sweeper away from logins that
+	// are completing right as they expire.
+	loginSecondFactorSweepGrace     = time.Minute
+	loginSecondFactorSweepInterval  = time.Minute
+	loginSecondFactorSweepBatchSize = 100
+)
+
+var loginSecondFactorNow = time.Now
+
+// pendingSecondFactorLogin is a password login waiting for its second
+// factor. The session token is only handed out once that factor passed.
+type pendingSecondFactorLogin struct {
+	UserID       string `json:"userId"`
+	SessionToken string `json:"sessionToken"`
+	Email        string `json:"email"`
+}
+
+type loginSecondFactorChallenge struct {
+	Token     string                        `json:"token"`
+	Methods   []string                      `json:"methods"`
+	WebAuthn  *protocol.CredentialAssertion `json:"webauthn,omitempty"`
+	ExpiresAt time.Time                     `json:"expiresAt"`
+}
+
+type loginSecondFactorPayload struct {
+	Token string `json:"token"`
+	secondfactor.Assertion
+}
+
+func loginSecondFactorKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return loginSecondFactorKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+func loginSecondFactorAttemptsKey(token string) string {
+	return loginSecondFactorKey(token) + ":attempts"
+}
+
+// beginLoginSecondFactor parks the login when userID has a second factor
+// and returns the challenge for the client. It returns nil when the user
+// has none.
+func beginLoginSecondFactor(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID, sessionToken, email string) (*loginSecondFactorChallenge, error) {
+	status, err := secondfactor.GetStatus(ctx, apiHelper.DBManager.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Enrolled {
+		return nil, nil
+	}
+	rdb := apiHelper.RedisClient()
+	if rdb == nil {
+		return nil, errors.New("redis unavailable")
+	}
+	methods, err := secondfactor.Methods(ctx, apiHelper.DBManager.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(raw)
+	payload, err := json.Marshal(pendingSecondFactorLogin{UserID: userID, SessionToken: sessionToken, Email: email})
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := &loginSecondFactorChallenge{
+		Token:     token,
+		Methods:   methods,
+		ExpiresAt: loginSecondFactorNow().UTC().Add(loginSecondFactorTTL),
+	}
+	challenge.WebAuthn, err = secondfactor.BeginWebAuthnAssertion(ctx, apiHelper.DBManager.DB, rdb, userID, secondfactor.PurposeLogin)
+	if err != nil {
+		return nil, err
+	}
+	// Track the session before parking the login, so a login that is parked
+	// is always one the sweeper can find.
+	if err := rdb.ZAdd(ctx, loginSecondFactorPendingKey, redis.Z{Score: float64(challenge.ExpiresAt.Unix()), Member: sessionToken}).Err(); err != nil {
+		return nil, err
+	}
+	if err := rdb.Set(ctx, loginSecondFactorKey(token), payload, loginSecondFactorTTL).Err(); err != nil {
+		_ = rdb.ZRem(ctx, loginSecondFactorPendingKey, sessionToken).Err()
+		return nil, err
+	}
+	return challenge, nil
+}
+
+func loadPendingSecondFactorLogin(ctx context.Context, rdb *redis.Client, token string) (*pendingSecondFactorLogin, error) {
+	raw, err := rdb.Get(ctx, loginSecondFactorKey(token)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var pending pendingSecondFactorLogin
+	if err := json.Unmarshal(raw, &pending); err != nil {
+		return nil, err
+	}
+	return &pending, nil
+}
+
+// reserveLoginSecondFactorAttempt counts one attempt against the pending
+// login and reports whether the attempt is still allowed.
+func reserveLoginSecondFactorAttempt(ctx context.Context, rdb *redis.Client, token string) (bool, error) {
+	key := loginSecondFactorAttemptsKey(token)
+	attempts, err := rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	if attempts == 1 {
+		if err := rdb.Expire(ctx, key, loginSecondFactorTTL).Err(); err != nil {
+			return false, err
+		}
+	}
+	return attempts <= loginSecondFactorMaxAttempts, nil
+}
+
+func handleLoginSecondFactor(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		var pending *pendingSecondFactorLogin
+		logStep := func(result string, actorRole string, reason string, method string) {
+			email, targetUserID := "", ""
+			if pending != nil {
+				email, targetUserID = pending.Email, pending.UserID
+			}
+			publishLoginRiskSignal(c, apiHelper, email, targetUserID, result, reason)
+			targetType := "user"
+			var targetIDPtr *string
+			if targetUserID != "" {
+				targetIDPtr = &targetUserID
+			}
+			metadata := map[string]any{"reason": reason}
+			if method != "" {
+				metadata["method"] = method
+			}
+			entry := harukiAPIHelper.BuildSystemLogEntryFromFiber(c, "user.login.second_factor", result, &targetType, targetIDPtr, metadata)
+			if targetUserID != "" {
+				entry.ActorUserID = &targetUserID
+				roleLower := normalizeAuditRole(actorRole)
+				entry.ActorRole = &roleLower
+				if isAdminAuditRole(roleLower) {
+					entry.ActorType = harukiAPIHelper.SystemLogActorTypeAdmin
+				} else {
+					entry.ActorType = harukiAPIHelper.SystemLogActorTypeUser
+				}
+			}
+			_ = harukiAPIHelper.WriteSystemLog(ctx, apiHelper, entry)
+		}
+
+		var payload loginSecondFactorPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "invalid_payload", "")
+			return harukiAPIHelper.ErrorBadRequest(c, "Invalid request")
+		}
+		payload.Token = strings.TrimSpace(payload.Token)
+		if payload.Token == "" || payload.Assertion.Empty() {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "invalid_payload", "")
+			return harukiAPIHelper.ErrorBadRequest(c, "token and a second factor are required")
+		}
+		if apiHelper == nil || apiHelper.SessionHandler == nil || !apiHelper.SessionHandler.UsesKratosProvider() {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "managed_identity_required", "")
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusGone, ManagedIdentityMessage, nil)
+		}
+		rdb := apiHelper.RedisClient()
+		if rdb == nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "session_store_unavailable", "")
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+
+		var err error
+		pending, err = loadPendingSecondFactorLogin(ctx, rdb, payload.Token)
+		if err != nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "session_store_unavailable", "")
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+		if pending == nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "login_expired", "")
+			return harukiAPIHelper.ErrorUnauthorized(c, "login expired, please sign in again")
+		}
+		revokePending := func() {
+			if revokeErr := apiHelper.SessionHandler.RevokeKratosSessionByToken(ctx, pending.SessionToken); revokeErr != nil {
+				harukiLogger.Warnf("Failed to revoke pending Kratos session for user %s: %v", pending.UserID, revokeErr)
+			}
+		}
+		abandon := func() {
+			_ = rdb.Del(ctx, loginSecondFactorKey(payload.Token), loginSecondFactorAttemptsKey(payload.Token)).Err()
+			if removed, err := rdb.ZRem(ctx, loginSecondFactorPendingKey, pending.SessionToken).Result(); err == nil && removed == 0 {
+				// The sweeper already revoked it.
+				return
+			}
+			revokePending()
+		}
+
+		allowed, err := reserveLoginSecondFactorAttempt(ctx, rdb, payload.Token)
+		if err != nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "session_store_unavailable", "")
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+		if !allowed {
+			abandon()
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "rate_limited", "")
+			return harukiAPIHelper.ErrorUnauthorized(c, "too many attempts, please sign in again")
+		}
+
+		method, err := secondfactor.Verify(ctx, apiHelper.DBManager.DB, rdb, pending.UserID, secondfactor.PurposeLogin, payload.Assertion, time.Now().UTC())
+		if err != nil {
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) && fiberErr.Code < fiber.StatusInternalServerError {
+				logStep(harukiAPIHelper.SystemLogResultFailure, "", "invalid_second_factor", "")
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiberErr.Code, fiberErr.Message, nil)
+			}
+			harukiLogger.Errorf("Failed to verify second factor for user %s: %v", pending.UserID, err)
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "second_factor_check_failed", "")
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+
+		// Only one request may turn the pending login into a session.
+		deleted, err := rdb.Del(ctx, loginSecondFactorKey(payload.Token)).Result()
+		if err != nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "session_store_unavailable", "")
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+		if deleted == 0 {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "login_expired", "")
+			return harukiAPIHelper.ErrorUnauthorized(c, "login expired, please sign in again")
+		}
+		_ = rdb.Del(ctx, loginSecondFactorAttemptsKey(payload.Token)).Err()
+		claimed, err := rdb.ZRem(ctx, loginSecondFactorPendingKey, pending.SessionToken).Result()
+		if err != nil {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "session_store_unavailable", "")
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+		if claimed == 0 {
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "login_expired", "")
+			return harukiAPIHelper.ErrorUnauthorized(c, "login expired, please sign in again")
+		}
+
+		user, err := queryLoginUser(ctx, apiHelper, pending.UserID)
+		if err != nil {
+			revokePending()
+			if postgresql.IsNotFound(err) {
+				logStep(harukiAPIHelper.SystemLogResultFailure, "", "local_user_not_found", method)
+				return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+			}
+			logStep(harukiAPIHelper.SystemLogResultFailure, "", "query_user_failed", method)
+			return harukiAPIHelper.ErrorInternal(c, "login service unavailable")
+		}
+		if user.Banned {
+			revokePending()
+			logStep(harukiAPIHelper.SystemLogResultFailure, string(user.Role), "banned", method)
+			return harukiAPIHelper.ErrorForbidden(c, "Your account has been banned")
+		}
+
+		if sessionID, err := apiHelper.SessionHandler.ResolveKratosSessionID(ctx, pending.SessionToken, ""); err != nil || strings.TrimSpace(sessionID) == "" {
+			harukiLogger.Warnf("Failed to resolve Kratos session after second factor for user %s: %v", user.ID, err)
+		} else if err := secondfactor.MarkSessionVerified(ctx, rdb, user.ID, "kratos:"+strings.TrimSpace(sessionID)); err != nil {
+			harukiLogger.Warnf("Failed to mark session second factor verified for user %s: %v", user.ID, err)
+		}
+
+		logStep(harukiAPIHelper.SystemLogResultSuccess, string(user.Role), "ok", method)
+		sessionToken := pending.SessionToken
+		ud := harukiAPIHelper.BuildUserDataFromDBUser(user, &sessionToken)
+		resp := harukiAPIHelper.RegisterOrLoginSuccessResponse{Status: fiber.StatusOK, Message: "login success", UserData: ud}
+		return harukiAPIHelper.ResponseWithStruct(c, fiber.StatusOK, &resp)
+	}
+}
+
+// sweepAbandonedLoginSecondFactors revokes the Kratos sessions of pending
+// logins that expired without a second factor and returns how many it
+// revoked.
+func sweepAbandonedLoginSecondFactors(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, now time.Time) (int, error) {
+	rdb := apiHelper.RedisClient()
+	if rdb == nil || apiHelper.SessionHandler == nil {
+		return 0, nil
+	}
+	cutoff := now.Add(-loginSecondFactorSweepGrace).Unix()
+	sessionTokens, err := rdb.ZRangeByScore(ctx, loginSecondFactorPendingKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(cutoff, 10),
+		Count: loginSecondFactorSweepBatchSize,
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, sessionToken := range sessionTokens {
+		removed, err := rdb.ZRem(ctx, loginSecondFactorPendingKey, sessionToken).Result()
+		if err != nil {
+			return revoked, err
+		}
+		if removed == 0 {
+			continue
+		}
+		if err := apiHelper.SessionHandler.RevokeKratosSessionByToken(ctx, sessionToken); err != nil {
+			harukiLogger.Warnf("Failed to revoke expired pending Kratos session: %v", err)
+			continue
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// StartLoginSecondFactorSweeper revokes the sessions of expired pending
+// logins until ctx is cancelled. Every replica can run it: a session is only
+// revoked by the replica that removed it from the pending set.
+func StartLoginSecondFactorSweeper(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger) func() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(loginSecondFactorSweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			revoked, err := sweepAbandonedLoginSecondFactors(ctx, apiHelper, loginSecondFactorNow())
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Warnf("Failed to sweep expired second factor logins: %v", err)
+				}
+				continue
+			}
+			if revoked > 0 {
+				logger.Infof("Revoked %d session(s) of expired second factor logins", revoked)
+			}
+		}
+	}()
+	return wg.Wait
+}
@@ -0,0 +1,133 @@
+package userauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+func TestLoginSecondFactorStep(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:userauth-second-factor-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	redisClient := goredis.NewClient(&goredis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+
+	revoked := 0
+	kratosServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/sessions/whoami":
+			_, _ = w.Write([]byte(`{"id":"session-1","active":true,"identity":{"id":"identity-1","traits":{"email":"u1@example.com"}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/admin/sessions/session-1":
+			revoked++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(kratosServer.Close)
+	sessionHandler := harukiAPIHelper.NewSessionHandler(redisClient, "local-sign-key")
+	sessionHandler.ConfigureIdentityProvider("kratos", kratosServer.URL, kratosServer.URL, "X-Session-Token", "ory_kratos_session", true, true, 2*time.Second, nil)
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:      &database.HarukiToolboxDBManager{DB: client, Redis: &harukiRedis.HarukiRedisManager{Redis: redisClient}},
+		SessionHandler: sessionHandler,
+	}
+
+	ctx := context.Background()
+	if _, err := client.User.Create().SetID("u1").SetName("u1").SetEmail("u1@example.com").SetKratosIdentityID("identity-1").Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	if challenge, err := beginLoginSecondFactor(ctx, helper, "u1", "token-1", "u1@example.com"); err != nil || challenge != nil {
+		t.Fatalf("beginLoginSecondFactor without factors = %v, %v; want nil", challenge, err)
+	}
+	now := time.Now().UTC()
+	secret, err := secondfactor.BeginTOTPEnrollment(ctx, client, "u1", now.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("BeginTOTPEnrollment returned error: %v", err)
+	}
+	enrollCode, _ := secondfactor.TOTPCode(secret, now.Add(-2*time.Minute))
+	codes, err := secondfactor.ConfirmTOTPEnrollment(ctx, client, "u1", enrollCode, now.Add(-2*time.Minute))
+	if err != nil {
+		t.Fatalf("ConfirmTOTPEnrollment returned error: %v", err)
+	}
+
+	app := fiber.New()
+	app.Post("/api/user/login/second-factor", handleLoginSecondFactor(helper))
+	do := func(body string) int {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/api/user/login/second-factor", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		return resp.StatusCode
+	}
+
+	challenge, err := beginLoginSecondFactor(ctx, helper, "u1", "token-1", "u1@example.com")
+	if err != nil || challenge == nil || challenge.WebAuthn != nil {
+		t.Fatalf("beginLoginSecondFactor = %+v, %v", challenge, err)
+	}
+	if len(challenge.Methods) != 2 || challenge.Methods[0] != secondfactor.MethodTOTP {
+		t.Fatalf("unexpected methods %v", challenge.Methods)
+	}
+	if status := do(`{"token":"` + challenge.Token + `","totpCode":"12"}`); status != fiber.StatusForbidden {
+		t.Fatalf("wrong code status = %d, want 403", status)
+	}
+	if status := do(`{"token":"unknown","recoveryCode":"` + codes[0] + `"}`); status != fiber.StatusUnauthorized {
+		t.Fatalf("unknown token status = %d, want 401", status)
+	}
+	if status := do(`{"token":"` + challenge.Token + `","recoveryCode":"` + codes[0] + `"}`); status != fiber.StatusOK {
+		t.Fatalf("recovery code status = %d, want 200", status)
+	}
+	if verified, _ := secondfactor.SessionVerified(ctx, redisClient, "u1", "kratos:session-1"); !verified {
+		t.Fatalf("expected the Kratos session to be marked as verified")
+	}
+	if status := do(`{"token":"` + challenge.Token + `","recoveryCode":"` + codes[1] + `"}`); status != fiber.StatusUnauthorized {
+		t.Fatalf("reused token status = %d, want 401", status)
+	}
+
+	// Too many wrong answers abandon the login and revoke its session.
+	challenge, _ = beginLoginSecondFactor(ctx, helper, "u1", "token-1", "u1@example.com")
+	for i := 0; i < loginSecondFactorMaxAttempts; i++ {
+		if status := do(`{"token":"` + challenge.Token + `","totpCode":"000000"}`); status != fiber.StatusForbidden {
+			t.Fatalf("attempt %d status = %d, want 403", i+1, status)
+		}
+	}
+	if status := do(`{"token":"` + challenge.Token + `","recoveryCode":"` + codes[1] + `"}`); status != fiber.StatusUnauthorized || revoked != 1 {
+		t.Fatalf("status after too many attempts = %d with %d revocations, want 401 and 1", status, revoked)
+	}
+
+	// A login left waiting has its session revoked once it expires, and can
+	// no longer be completed afterwards.
+	challenge, _ = beginLoginSecondFactor(ctx, helper, "u1", "token-1", "u1@example.com")
+	if swept, err := sweepAbandonedLoginSecondFactors(ctx, helper, time.Now()); err != nil || swept != 0 {
+		t.Fatalf("sweep before expiry = %d, %v; want 0", swept, err)
+	}
+	expired := challenge.ExpiresAt.Add(loginSecondFactorSweepGrace + time.Second)
+	if swept, err := sweepAbandonedLoginSecondFactors(ctx, helper, expired); err != nil || swept != 1 || revoked != 2 {
+		t.Fatalf("sweep after expiry = %d, %v with %d revocations; want 1 and 2", swept, err, revoked)
+	}
+	if status := do(`{"token":"` + challenge.Token + `","recoveryCode":"` + codes[1] + `"}`); status != fiber.StatusUnauthorized || revoked != 2 {
+		t.Fatalf("status after sweep = %d with %d revocations, want 401 and 2", status, revoked)
+	}
+	if swept, err := sweepAbandonedLoginSecondFactors(ctx, helper, expired); err != nil || swept != 0 {
+		t.Fatalf("second sweep = %d, %v; want 0", swept, err)
+	}
+}
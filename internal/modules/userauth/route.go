@@ -6,9 +6,11 @@ func RegisterUserAuthRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelper
 	if apiHelper != nil && apiHelper.SessionHandler != nil && apiHelper.SessionHandler.UsesManagedBrowserAuth() {
 		disabled := LegacyAuthDisabledHandler()
 		apiHelper.Router.Post("/api/user/login", disabled)
+		apiHelper.Router.Post("/api/user/login/second-factor", disabled)
 		apiHelper.Router.Post("/api/user/register", disabled)
 		return
 	}
 	apiHelper.Router.Post("/api/user/login", handleLogin(apiHelper))
+	apiHelper.Router.Post("/api/user/login/second-factor", handleLoginSecondFactor(apiHelper))
 	apiHelper.Router.Post("/api/user/register", handleRegister(apiHelper))
 }
@@ -22,7 +22,7 @@ func TestRegisterUserAuthRoutesDisablesLegacyEndpointsWhenManagedIdentityEnabled
 	helper.Router = app
 	RegisterUserAuthRoutes(helper)
 
-	for _, path := range []string{"/api/user/login", "/api/user/register"} {
+	for _, path := range []string{"/api/user/login", "/api/user/login/second-factor", "/api/user/register"} {
 		req := httptest.NewRequest(http.MethodPost, path, nil)
 		resp, err := app.Test(req)
 		if err != nil {
@@ -0,0 +1,45 @@
+package usercore
+
+import (
+	"context"
+	"strings"
+
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Reauthenticate confirms the password of the signed-in user. On failure it
+// returns the audit reason and a function writing the response.
+func Reauthenticate(c fiber.Ctx, ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userRow *postgresql.User, password string) (string, func() error) {
+	if apiHelper.SessionHandler == nil || !apiHelper.SessionHandler.UsesKratosProvider() {
+		return "managed_identity_required", func() error {
+			return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+		}
+	}
+	if userRow.KratosIdentityID == nil || strings.TrimSpace(*userRow.KratosIdentityID) == "" {
+		return "identity_not_linked", func() error {
+			return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+		}
+	}
+	err := apiHelper.SessionHandler.VerifyKratosPasswordByIdentityID(ctx, strings.TrimSpace(*userRow.KratosIdentityID), password)
+	switch {
+	case err == nil:
+		return "", nil
+	case harukiAPIHelper.IsKratosInvalidCredentialsError(err) || harukiAPIHelper.IsKratosInvalidInputError(err):
+		return "password_mismatch", func() error {
+			return harukiAPIHelper.ErrorForbidden(c, "password mismatch")
+		}
+	case harukiAPIHelper.IsKratosIdentityUnmappedError(err):
+		return "identity_not_found", func() error {
+			return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+		}
+	default:
+		harukiLogger.Errorf("Password verification for user %s failed: %v", userRow.ID, err)
+		return "identity_provider_unavailable", func() error {
+			return harukiAPIHelper.ErrorInternal(c, "failed to verify account")
+		}
+	}
+}
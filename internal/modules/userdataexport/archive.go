@@ -0,0 +1,589 @@
+package userdataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	oauth2Module "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/oauth2"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/authorizesocialplatforminfo"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountdatagrant"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/redact"
+
+	sql "entgo.io/ent/dialect/sql"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	archiveFormatVersion = 1
+	// maxArchiveLogRows caps the activity and upload logs of one archive;
+	// the manifest says when a section was cut short.
+	maxArchiveLogRows = 50000
+)
+
+// gameDataReader is the part of the Mongo manager the archive needs.
+type gameDataReader interface {
+	GetData(ctx context.Context, userID int64, server string, dataType harukiUtils.UploadDataType) (bson.D, error)
+}
+
+var (
+	oauthAuthorizationsEnabled = oauth2Module.HydraOAuthManagementEnabled
+	listOAuthAuthorizations    = oauth2Module.ListHydraConsentSessionsForSubjects
+)
+
+var archiveGameDataTypes = []harukiUtils.UploadDataType{
+	harukiUtils.UploadDataTypeSuite,
+	harukiUtils.UploadDataTypeMysekai,
+}
+
+type archiveManifest struct {
+	FormatVersion int                   `json:"formatVersion"`
+	ExportID      string                `json:"exportId"`
+	UserID        string                `json:"userId"`
+	GeneratedAt   time.Time             `json:"generatedAt"`
+	Files         []archiveManifestFile `json:"files"`
+	Notes         []string              `json:"notes,omitempty"`
+}
+
+type archiveManifestFile struct {
+	Name    string `json:"name"`
+	Records int    `json:"records"`
+}
+
+type archiveProfile struct {
+	ID                              string                           `json:"id"`
+	Name                            string                           `json:"name"`
+	Email                           string                           `json:"email"`
+	AvatarPath                      *string                          `json:"avatarPath,omitempty"`
+	Role                            string                           `json:"role"`
+	AllowCNMysekai                  bool                             `json:"allowCnMysekai"`
+	TicketEmailNotificationsEnabled bool                             `json:"ticketEmailNotificationsEnabled"`
+	Banned                          bool                             `json:"banned"`
+	BanReason                       *string                          `json:"banReason,omitempty"`
+	BanExpiresAt                    *time.Time                       `json:"banExpiresAt,omitempty"`
+	UploadBlockedUntil              *time.Time                       `json:"uploadBlockedUntil,omitempty"`
+	KratosIdentityID                *string                          `json:"kratosIdentityId,omitempty"`
+	CreatedAt                       *time.Time                       `json:"createdAt,omitempty"`
+	SocialPlatform                  *archiveSocialPlatform           `json:"socialPlatform,omitempty"`
+	AuthorizedSocialPlatforms       []archiveAuthorizedSocialAccount `json:"authorizedSocialPlatforms"`
+}
+
+type archiveSocialPlatform struct {
+	Platform       string `json:"platform"`
+	PlatformUserID string `json:"platformUserId"`
+	Verified       bool   `json:"verified"`
+}
+
+type archiveAuthorizedSocialAccount struct {
+	Platform              string `json:"platform"`
+	PlatformUserID        string `json:"platformUserId"`
+	Comment               string `json:"comment,omitempty"`
+	AllowFastVerification bool   `json:"allowFastVerification"`
+}
+
+type archiveGameAccountBinding struct {
+	Server     string `json:"server"`
+	GameUserID string `json:"gameUserId"`
+	Verified   bool   `json:"verified"`
+	// Suite and Mysekai are the privacy settings of the binding.
+	Suite   any `json:"suite,omitempty"`
+	Mysekai any `json:"mysekai,omitempty"`
+}
+
+type archiveDataGrant struct {
+	ID            int       `json:"id"`
+	Direction     string    `json:"direction"`
+	OwnerUserID   string    `json:"ownerUserId"`
+	GranteeUserID string    `json:"granteeUserId"`
+	Server        string    `json:"server"`
+	GameUserID    string    `json:"gameUserId"`
+	DataType      string    `json:"dataType"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type archiveOAuthAuthorization struct {
+	ClientID   string     `json:"clientId"`
+	ClientName string     `json:"clientName"`
+	Scopes     []string   `json:"scopes"`
+	GrantedAt  *time.Time `json:"grantedAt,omitempty"`
+}
+
+type archiveTicket struct {
+	TicketID   string                   `json:"ticketId"`
+	Subject    string                   `json:"subject"`
+	Category   *string                  `json:"category,omitempty"`
+	Priority   string                   `json:"priority"`
+	Status     string                   `json:"status"`
+	CreatedAt  time.Time                `json:"createdAt"`
+	UpdatedAt  time.Time                `json:"updatedAt"`
+	ClosedAt   *time.Time               `json:"closedAt,omitempty"`
+	ResolvedAt *time.Time               `json:"resolvedAt,omitempty"`
+	Messages   []archiveTicketMessage   `json:"messages"`
+	Files      []archiveTicketFileEntry `json:"attachments"`
+}
+
+type archiveTicketMessage struct {
+	ID         int       `json:"id"`
+	SenderRole string    `json:"senderRole"`
+	Message    string    `json:"message"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// archiveTicketFileEntry lists an attachment without its content, which
+// stays downloadable from the ticket.
+type archiveTicketFileEntry struct {
+	ID          string    `json:"id"`
+	MessageID   int       `json:"messageId"`
+	FileName    string    `json:"fileName"`
+	ContentType string    `json:"contentType"`
+	SizeBytes   int64     `json:"sizeBytes"`
+	SHA256      string    `json:"sha256"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type archiveActivityLog struct {
+	ID         int            `json:"id"`
+	EventTime  time.Time      `json:"eventTime"`
+	ActorType  string         `json:"actorType"`
+	Action     string         `json:"action"`
+	TargetType *string        `json:"targetType,omitempty"`
+	TargetID   *string        `json:"targetId,omitempty"`
+	Result     string         `json:"result"`
+	IP         *string        `json:"ip,omitempty"`
+	UserAgent  *string        `json:"userAgent,omitempty"`
+	Method     *string        `json:"method,omitempty"`
+	Path       *string        `json:"path,omitempty"`
+	Metadata   map[string]any `json:"metadata,omitempty"`
+}
+
+type archiveUploadLog struct {
+	ID           int       `json:"id"`
+	Server       string    `json:"server"`
+	GameUserID   string    `json:"gameUserId"`
+	DataType     string    `json:"dataType"`
+	UploadMethod string    `json:"uploadMethod"`
+	Success      bool      `json:"success"`
+	ErrorMessage *string   `json:"errorMessage,omitempty"`
+	UploadTime   time.Time `json:"uploadTime"`
+	// UploadedBySelf is false for uploads of a bound game account made
+	// without signing in as this user.
+	UploadedBySelf bool `json:"uploadedBySelf"`
+}
+
+type archiveWriter struct {
+	zip      *zip.Writer
+	manifest *archiveManifest
+}
+
+func (w *archiveWriter) writeJSON(name string, records int, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+	return w.writeFile(name, records, data)
+}
+
+func (w *archiveWriter) writeFile(name string, records int, data []byte) error {
+	file, err := w.zip.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: w.manifest.GeneratedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	w.manifest.Files = append(w.manifest.Files, archiveManifestFile{Name: name, Records: records})
+	return nil
+}
+
+func (w *archiveWriter) note(format string, args ...any) {
+	w.manifest.Notes = append(w.manifest.Notes, fmt.Sprintf(format, args...))
+}
+
+// buildArchive collects everything held about userID into a zip archive.
+// gameData may be nil when MongoDB is not configured.
+func buildArchive(ctx context.Context, db *postgresql.Client, gameData gameDataReader, exportID, userID string, now time.Time) ([]byte, error) {
+	userRow, err := db.User.Query().
+		Where(user.IDEQ(userID)).
+		WithSocialPlatformInfo().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := &archiveWriter{
+		zip:      zip.NewWriter(&buf),
+		manifest: &archiveManifest{FormatVersion: archiveFormatVersion, ExportID: exportID, UserID: userID, GeneratedAt: now.UTC()},
+	}
+	if err := writeProfile(ctx, db, w, userRow); err != nil {
+		return nil, err
+	}
+	bindings, err := writeGameAccountBindings(ctx, db, w, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeDataGrants(ctx, db, w, userID); err != nil {
+		return nil, err
+	}
+	writeOAuthAuthorizations(ctx, w, userRow)
+	if err := writeTickets(ctx, db, w, userID); err != nil {
+		return nil, err
+	}
+	if err := writeActivityLogs(ctx, db, w, userID); err != nil {
+		return nil, err
+	}
+	if err := writeUploadLogs(ctx, db, w, userID, bindings); err != nil {
+		return nil, err
+	}
+	if err := writeGameData(ctx, gameData, w, bindings); err != nil {
+		return nil, err
+	}
+
+	manifest := w.manifest
+	if err := w.writeJSON("manifest.json", len(manifest.Files), manifest); err != nil {
+		return nil, err
+	}
+	if err := w.zip.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeProfile(ctx context.Context, db *postgresql.Client, w *archiveWriter, userRow *postgresql.User) error {
+	profile := archiveProfile{
+		ID:                              userRow.ID,
+		Name:                            userRow.Name,
+		Email:                           userRow.Email,
+		AvatarPath:                      userRow.AvatarPath,
+		Role:                            string(userRow.Role),
+		AllowCNMysekai:                  userRow.AllowCnMysekai,
+		TicketEmailNotificationsEnabled: userRow.TicketEmailNotificationsEnabled,
+		Banned:                          userRow.Banned,
+		BanReason:                       userRow.BanReason,
+		BanExpiresAt:                    userRow.BanExpiresAt,
+		UploadBlockedUntil:              userRow.UploadBlockedUntil,
+		KratosIdentityID:                userRow.KratosIdentityID,
+		CreatedAt:                       userRow.CreatedAt,
+		AuthorizedSocialPlatforms:       []archiveAuthorizedSocialAccount{},
+	}
+	if info := userRow.Edges.SocialPlatformInfo; info != nil {
+		profile.SocialPlatform = &archiveSocialPlatform{
+			Platform:       info.Platform,
+			PlatformUserID: info.PlatformUserID,
+			Verified:       info.Verified,
+		}
+	}
+	authorized, err := db.AuthorizeSocialPlatformInfo.Query().
+		Where(authorizesocialplatforminfo.UserIDEQ(userRow.ID)).
+		Order(authorizesocialplatforminfo.ByID()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range authorized {
+		profile.AuthorizedSocialPlatforms = append(profile.AuthorizedSocialPlatforms, archiveAuthorizedSocialAccount{
+			Platform:              row.Platform,
+			PlatformUserID:        row.PlatformUserID,
+			Comment:               row.Comment,
+			AllowFastVerification: row.AllowFastVerification,
+		})
+	}
+	return w.writeJSON("profile.json", 1, profile)
+}
+
+func writeGameAccountBindings(ctx context.Context, db *postgresql.Client, w *archiveWriter, userID string) ([]*postgresql.GameAccountBinding, error) {
+	rows, err := db.GameAccountBinding.Query().
+		Where(gameaccountbinding.HasUserWith(user.IDEQ(userID))).
+		Order(gameaccountbinding.ByServer(), gameaccountbinding.ByGameUserID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]archiveGameAccountBinding, 0, len(rows))
+	for _, row := range rows {
+		item := archiveGameAccountBinding{Server: row.Server, GameUserID: row.GameUserID, Verified: row.Verified}
+		if row.Suite != nil {
+			item.Suite = row.Suite
+		}
+		if row.Mysekai != nil {
+			item.Mysekai = row.Mysekai
+		}
+		items = append(items, item)
+	}
+	return rows, w.writeJSON("game_account_bindings.json", len(items), items)
+}
+
+func writeDataGrants(ctx context.Context, db *postgresql.Client, w *archiveWriter, userID string) error {
+	rows, err := db.GameAccountDataGrant.Query().
+		Where(gameaccountdatagrant.Or(
+			gameaccountdatagrant.OwnerUserIDEQ(userID),
+			gameaccountdatagrant.GranteeUserIDEQ(userID),
+		)).
+		Order(gameaccountdatagrant.ByID()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	items := make([]archiveDataGrant, 0, len(rows))
+	for _, row := range rows {
+		direction := "received"
+		if row.OwnerUserID == userID {
+			direction = "granted"
+		}
+		items = append(items, archiveDataGrant{
+			ID:            row.ID,
+			Direction:     direction,
+			OwnerUserID:   row.OwnerUserID,
+			GranteeUserID: row.GranteeUserID,
+			Server:        row.Server,
+			GameUserID:    row.GameUserID,
+			DataType:      row.DataType,
+			ExpiresAt:     row.ExpiresAt.UTC(),
+			CreatedAt:     row.CreatedAt.UTC(),
+		})
+	}
+	return w.writeJSON("data_grants.json", len(items), items)
+}
+
+// writeOAuthAuthorizations never fails the export: the authorizations live
+// in Hydra, and a Hydra outage is noted in the manifest instead.
+func writeOAuthAuthorizations(ctx context.Context, w *archiveWriter, userRow *postgresql.User) {
+	items := []archiveOAuthAuthorization{}
+	if !oauthAuthorizationsEnabled() {
+		w.note("oauth2_authorizations.json is empty because OAuth2 authorization management is not enabled")
+		_ = w.writeJSON("oauth2_authorizations.json", 0, items)
+		return
+	}
+	sessions, err := listOAuthAuthorizations(ctx, oauth2Module.HydraSubjectsForUser(userRow.ID, userRow.KratosIdentityID))
+	if err != nil {
+		w.note("oauth2_authorizations.json is empty because the authorization server could not be reached")
+		_ = w.writeJSON("oauth2_authorizations.json", 0, items)
+		return
+	}
+	for _, session := range sessions {
+		items = append(items, archiveOAuthAuthorization{
+			ClientID:   session.ConsentRequest.Client.ClientID,
+			ClientName: session.ConsentRequest.Client.ClientName,
+			Scopes:     append([]string(nil), session.GrantScope...),
+			GrantedAt:  session.HandledAt,
+		})
+	}
+	_ = w.writeJSON("oauth2_authorizations.json", len(items), items)
+}
+
+// writeTickets exports the user's tickets with the messages they can see;
+// internal admin notes are left out.
+func writeTickets(ctx context.Context, db *postgresql.Client, w *archiveWriter, userID string) error {
+	tickets, err := db.Ticket.Query().
+		Where(ticket.CreatorUserIDEQ(userID)).
+		Order(ticket.ByCreatedAt(), ticket.ByID()).
+		WithMessages(func(q *postgresql.TicketMessageQuery) {
+			q.Where(ticketmessage.InternalEQ(false)).Order(ticketmessage.ByCreatedAt(), ticketmessage.ByID())
+		}).
+		WithAttachments(func(q *postgresql.TicketAttachmentQuery) {
+			q.Order(ticketattachment.ByCreatedAt(), ticketattachment.ByID())
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	items := make([]archiveTicket, 0, len(tickets))
+	for _, row := range tickets {
+		item := archiveTicket{
+			TicketID:   row.TicketID,
+			Subject:    row.Subject,
+			Category:   row.Category,
+			Priority:   string(row.Priority),
+			Status:     string(row.Status),
+			CreatedAt:  row.CreatedAt.UTC(),
+			UpdatedAt:  row.UpdatedAt.UTC(),
+			ClosedAt:   row.ClosedAt,
+			ResolvedAt: row.ResolvedAt,
+			Messages:   make([]archiveTicketMessage, 0, len(row.Edges.Messages)),
+			Files:      []archiveTicketFileEntry{},
+		}
+		visible := make(map[int]struct{}, len(row.Edges.Messages))
+		for _, message := range row.Edges.Messages {
+			visible[message.ID] = struct{}{}
+			item.Messages = append(item.Messages, archiveTicketMessage{
+				ID:         message.ID,
+				SenderRole: string(message.SenderRole),
+				Message:    message.Message,
+				CreatedAt:  message.CreatedAt.UTC(),
+			})
+		}
+		for _, attachment := range row.Edges.Attachments {
+			if _, ok := visible[attachment.MessageID]; !ok {
+				continue
+			}
+			item.Files = append(item.Files, archiveTicketFileEntry{
+				ID:          attachment.ID,
+				MessageID:   attachment.MessageID,
+				FileName:    attachment.FileName,
+				ContentType: attachment.ContentType,
+				SizeBytes:   attachment.SizeBytes,
+				SHA256:      attachment.Sha256,
+				CreatedAt:   attachment.CreatedAt.UTC(),
+			})
+		}
+		items = append(items, item)
+	}
+	return w.writeJSON("tickets.json", len(items), items)
+}
+
+// writeActivityLogs exports the same system log rows as the activity log
+// endpoint, i.e. everything the user did or that was done to their account.
+func writeActivityLogs(ctx context.Context, db *postgresql.Client, w *archiveWriter, userID string) error {
+	rows, err := db.SystemLog.Query().
+		Where(systemlog.Or(
+			systemlog.ActorUserIDEQ(userID),
+			systemlog.And(systemlog.TargetTypeEQ("user"), systemlog.TargetIDEQ(userID)),
+		)).
+		Order(systemlog.ByEventTime(sql.OrderDesc()), systemlog.ByID(sql.OrderDesc())).
+		Limit(maxArchiveLogRows + 1).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rows) > maxArchiveLogRows {
+		rows = rows[:maxArchiveLogRows]
+		w.note("activity_logs.json holds the latest %d entries only", maxArchiveLogRows)
+	}
+	items := make([]archiveActivityLog, 0, len(rows))
+	for _, row := range rows {
+		item := archiveActivityLog{
+			ID:         row.ID,
+			EventTime:  row.EventTime.UTC(),
+			ActorType:  string(row.ActorType),
+			Action:     row.Action,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			Result:     string(row.Result),
+			Metadata:   redactArchiveMetadata(row.Metadata),
+		}
+		// Request details of admin actions on the account belong to the
+		// admin, not to the user.
+		if row.ActorUserID != nil && *row.ActorUserID == userID {
+			item.IP = row.IP
+			item.UserAgent = row.UserAgent
+			item.Method = row.Method
+			item.Path = row.Path
+		}
+		items = append(items, item)
+	}
+	return w.writeJSON("activity_logs.json", len(items), items)
+}
+
+func redactArchiveMetadata(metadata map[string]any) map[string]any {
+	if len(metadata) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(metadata))
+	for key, value := range metadata {
+		if redact.IsSensitiveKey(key) {
+			out[key] = redact.Placeholder
+			continue
+		}
+		if nested, ok := value.(map[string]any); ok {
+			out[key] = redactArchiveMetadata(nested)
+			continue
+		}
+		out[key] = value
+	}
+	return out
+}
+
+// writeUploadLogs exports uploads made while signed in as the user and
+// uploads of the user's verified game accounts.
+func writeUploadLogs(ctx context.Context, db *postgresql.Client, w *archiveWriter, userID string, bindings []*postgresql.GameAccountBinding) error {
+	preds := []predicate.UploadLog{uploadlog.ToolboxUserIDEQ(userID)}
+	for _, binding := range bindings {
+		if binding.Verified {
+			preds = append(preds, uploadlog.And(uploadlog.ServerEQ(binding.Server), uploadlog.GameUserIDEQ(binding.GameUserID)))
+		}
+	}
+	rows, err := db.UploadLog.Query().
+		Where(uploadlog.Or(preds...)).
+		Order(uploadlog.ByUploadTime(sql.OrderDesc()), uploadlog.ByID(sql.OrderDesc())).
+		Limit(maxArchiveLogRows + 1).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rows) > maxArchiveLogRows {
+		rows = rows[:maxArchiveLogRows]
+		w.note("upload_logs.json holds the latest %d entries only", maxArchiveLogRows)
+	}
+	items := make([]archiveUploadLog, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, archiveUploadLog{
+			ID:             row.ID,
+			Server:         row.Server,
+			GameUserID:     row.GameUserID,
+			DataType:       row.DataType,
+			UploadMethod:   row.UploadMethod,
+			Success:        row.Success,
+			ErrorMessage:   row.ErrorMessage,
+			UploadTime:     row.UploadTime.UTC(),
+			UploadedBySelf: row.ToolboxUserID == userID,
+		})
+	}
+	return w.writeJSON("upload_logs.json", len(items), items)
+}
+
+// writeGameData adds the stored suite and mysekai documents of every
+// verified binding as relaxed extended JSON.
+func writeGameData(ctx context.Context, gameData gameDataReader, w *archiveWriter, bindings []*postgresql.GameAccountBinding) error {
+	if gameData == nil {
+		w.note("game_data is missing because the game data store is not configured")
+		return nil
+	}
+	for _, binding := range bindings {
+		if !binding.Verified {
+			continue
+		}
+		gameUserID, err := strconv.ParseInt(strings.TrimSpace(binding.GameUserID), 10, 64)
+		if err != nil {
+			w.note("game_data skips %s account %s because its id is not numeric", binding.Server, binding.GameUserID)
+			continue
+		}
+		for _, dataType := range archiveGameDataTypes {
+			doc, err := gameData.GetData(ctx, gameUserID, binding.Server, dataType)
+			if err != nil {
+				return fmt.Errorf("read %s %s data of %s: %w", binding.Server, dataType, binding.GameUserID, err)
+			}
+			if doc == nil {
+				continue
+			}
+			data, err := bson.MarshalExtJSONIndent(doc, false, false, "", "  ")
+			if err != nil {
+				return fmt.Errorf("encode %s %s data of %s: %w", binding.Server, dataType, binding.GameUserID, err)
+			}
+			name := fmt.Sprintf("game_data/%s_%d_%s.json", binding.Server, gameUserID, dataType)
+			if err := w.writeFile(name, 1, data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package userdataexport
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	maxListedDataExports = 10
+	maxTokenLength       = 128
+)
+
+type dataExportItem struct {
+	ID               string     `json:"id"`
+	Status           string     `json:"status"`
+	Attempts         int        `json:"attempts"`
+	SizeBytes        *int64     `json:"sizeBytes,omitempty"`
+	SHA256           *string    `json:"sha256,omitempty"`
+	DownloadCount    int        `json:"downloadCount"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	ReadyAt          *time.Time `json:"readyAt,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+	LastDownloadedAt *time.Time `json:"lastDownloadedAt,omitempty"`
+	// NextRequestAt is when the user may request another export.
+	NextRequestAt *time.Time `json:"nextRequestAt,omitempty"`
+}
+
+type dataExportListResponse struct {
+	Items         []dataExportItem `json:"items"`
+	NextRequestAt *time.Time       `json:"nextRequestAt,omitempty"`
+}
+
+func buildDataExportItem(row *postgresql.DataExport) dataExportItem {
+	return dataExportItem{
+		ID:               row.ID,
+		Status:           string(row.Status),
+		Attempts:         row.Attempts,
+		SizeBytes:        row.SizeBytes,
+		SHA256:           row.Sha256,
+		DownloadCount:    row.DownloadCount,
+		CreatedAt:        row.CreatedAt.UTC(),
+		ReadyAt:          row.ReadyAt,
+		ExpiresAt:        row.ExpiresAt,
+		LastDownloadedAt: row.LastDownloadedAt,
+	}
+}
+
+// nextRequestAt returns when the user may request another export, or nil
+// when they may do so now. Failed exports do not count.
+func nextRequestAt(rows []*postgresql.DataExport, minInterval time.Duration, now time.Time) *time.Time {
+	for _, row := range rows {
+		if row.Status == dataexport.StatusFailed {
+			continue
+		}
+		next := row.CreatedAt.UTC().Add(minInterval)
+		if next.After(now) {
+			return &next
+		}
+		return nil
+	}
+	return nil
+}
+
+func hasQueuedExport(rows []*postgresql.DataExport) bool {
+	for _, row := range rows {
+		if row.Status == dataexport.StatusQueued {
+			return true
+		}
+	}
+	return false
+}
+
+func handleRequestDataExport(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		exportID := ""
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.data_export.request", result, userID, map[string]any{
+				"reason":   reason,
+				"exportId": exportID,
+			})
+		}()
+
+		if apiHelper.BlobStore == nil {
+			reason = "storage_unavailable"
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusServiceUnavailable, "data export is not available", nil)
+		}
+		db := apiHelper.DBManager.DB
+		options := CurrentOptions()
+		now := dataExportNow().UTC()
+		rows, err := db.ListUserDataExports(c.Context(), userID, maxListedDataExports)
+		if err != nil {
+			reason = "query_exports_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data exports")
+		}
+		if hasQueuedExport(rows) {
+			reason = "export_in_progress"
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, "a data export is already in progress", nil)
+		}
+		if next := nextRequestAt(rows, options.MinInterval, now); next != nil {
+			reason = "rate_limited"
+			c.Set(fiber.HeaderRetryAfter, fmt.Sprintf("%d", int(next.Sub(now).Seconds())+1))
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusTooManyRequests, "you can request another data export at "+next.Format(time.RFC3339), nil)
+		}
+
+		exportID, err = generateExportID()
+		if err != nil {
+			reason = "generate_id_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to create data export")
+		}
+		locale := harukiSMTP.LocaleFromAcceptLanguage(c.Get(fiber.HeaderAcceptLanguage))
+		row, err := db.CreateDataExport(c.Context(), exportID, userID, locale, options.MaxAttempts, now)
+		if err != nil {
+			reason = "create_export_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to create data export")
+		}
+		notify()
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		item := buildDataExportItem(row)
+		if options.MinInterval > 0 {
+			next := row.CreatedAt.UTC().Add(options.MinInterval)
+			item.NextRequestAt = &next
+		}
+		return harukiAPIHelper.UpdatedDataResponse(c, fiber.StatusAccepted, "data export requested, a download link will be sent by email", &item)
+	}
+}
+
+func handleListDataExports(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		rows, err := apiHelper.DBManager.DB.ListUserDataExports(c.Context(), userID, maxListedDataExports)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data exports")
+		}
+		resp := dataExportListResponse{Items: make([]dataExportItem, 0, len(rows))}
+		for _, row := range rows {
+			resp.Items = append(resp.Items, buildDataExportItem(row))
+		}
+		resp.NextRequestAt = nextRequestAt(rows, CurrentOptions().MinInterval, dataExportNow().UTC())
+		return harukiAPIHelper.SuccessResponse(c, "ok", &resp)
+	}
+}
+
+func handleDownloadOwnDataExport(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		exportID := strings.TrimSpace(c.Params("export_id"))
+		row, err := apiHelper.DBManager.DB.GetUserDataExport(c.Context(), userID, exportID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				return harukiAPIHelper.ErrorNotFound(c, "data export not found")
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data export")
+		}
+		return sendArchive(c, apiHelper, row, "account")
+	}
+}
+
+// handleDownloadDataExportByToken serves the link from the mail. The token
+// is the only credential, so the route needs no session.
+func handleDownloadDataExportByToken(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		token := strings.TrimSpace(c.Params("token"))
+		if token == "" || len(token) > maxTokenLength {
+			return harukiAPIHelper.ErrorNotFound(c, "data export not found")
+		}
+		row, err := apiHelper.DBManager.DB.GetDataExportByTokenHash(c.Context(), hashToken(token))
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				return harukiAPIHelper.ErrorNotFound(c, "data export not found")
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to query data export")
+		}
+		return sendArchive(c, apiHelper, row, "link")
+	}
+}
+
+func sendArchive(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, row *postgresql.DataExport, via string) error {
+	result := harukiAPIHelper.SystemLogResultFailure
+	reason := "unknown"
+	defer func() {
+		userCoreModule.WriteUserAuditLog(c, apiHelper, "user.data_export.download", result, row.UserID, map[string]any{
+			"reason":   reason,
+			"exportId": row.ID,
+			"via":      via,
+		})
+	}()
+
+	now := dataExportNow().UTC()
+	if row.Status == dataexport.StatusExpired || (row.ExpiresAt != nil && !now.Before(*row.ExpiresAt)) {
+		reason = "expired"
+		return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusGone, "data export has expired", nil)
+	}
+	if row.Status != dataexport.StatusReady || row.StorageKey == nil {
+		reason = "not_ready"
+		return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusConflict, "data export is not ready", nil)
+	}
+	if apiHelper.BlobStore == nil {
+		reason = "storage_unavailable"
+		return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusServiceUnavailable, "data export storage is not configured", nil)
+	}
+	reader, size, err := apiHelper.BlobStore.Get(c.Context(), *row.StorageKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			reason = "archive_missing"
+			return harukiAPIHelper.UpdatedDataResponse[string](c, fiber.StatusGone, "data export has expired", nil)
+		}
+		reason = "read_archive_failed"
+		return harukiAPIHelper.ErrorInternal(c, "failed to read data export")
+	}
+	if err := apiHelper.DBManager.DB.RecordDataExportDownload(c.Context(), row.ID, now); err != nil {
+		_ = reader.Close()
+		reason = "record_download_failed"
+		return harukiAPIHelper.ErrorInternal(c, "failed to read data export")
+	}
+	result = harukiAPIHelper.SystemLogResultSuccess
+	reason = "ok"
+
+	fileName := "haruki-toolbox-export-" + row.ID + ".zip"
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	if size < 0 {
+		size = -1
+	}
+	return c.SendStream(reader, int(size))
+}
@@ -0,0 +1,312 @@
+package userdataexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/toolbox/schema"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type recordingMailer struct {
+	mu   sync.Mutex
+	sent []map[string]string
+}
+
+func (m *recordingMailer) Send([]string, string, string, string) error { return nil }
+
+func (m *recordingMailer) SendTemplate(to []string, template, locale string, vars map[string]string, _ string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, map[string]string{"to": strings.Join(to, ","), "template": template, "locale": locale, "link": vars["LINK"]})
+	return nil
+}
+
+type stubGameData struct{}
+
+func (stubGameData) GetData(_ context.Context, userID int64, server string, dataType harukiUtils.UploadDataType) (bson.D, error) {
+	if userID != 123456 || server != "jp" || dataType != harukiUtils.UploadDataTypeSuite {
+		return nil, nil
+	}
+	return bson.D{{Key: "_id", Value: userID}, {Key: "userGamedata", Value: bson.D{{Key: "name", Value: "Haruki"}}}}, nil
+}
+
+func seedDataExportUser(t *testing.T, client *postgresql.Client) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := client.User.Create().SetID("u1").SetName("u1").SetEmail("u1@example.com").Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	if _, err := client.GameAccountBinding.Create().
+		SetServer("jp").
+		SetGameUserID("123456").
+		SetVerified(true).
+		SetSuite(&harukiSchema.SuiteDataPrivacySettings{AllowPublicApi: true}).
+		SetUserID("u1").
+		Save(ctx); err != nil {
+		t.Fatalf("create binding returned error: %v", err)
+	}
+	ticketRow, err := client.Ticket.Create().SetTicketID("T-1").SetCreatorUserID("u1").SetSubject("help").Save(ctx)
+	if err != nil {
+		t.Fatalf("create ticket returned error: %v", err)
+	}
+	if _, err := client.TicketMessage.Create().SetTicketID(ticketRow.ID).SetSenderUserID("u1").SetMessage("question").Save(ctx); err != nil {
+		t.Fatalf("create message returned error: %v", err)
+	}
+	if _, err := client.TicketMessage.Create().SetTicketID(ticketRow.ID).SetSenderUserID("admin-1").SetSenderRole("admin").SetInternal(true).SetMessage("internal note").Save(ctx); err != nil {
+		t.Fatalf("create internal message returned error: %v", err)
+	}
+	if _, err := client.SystemLog.Create().
+		SetAction("admin.user.ban").
+		SetActorType(systemlog.ActorTypeAdmin).
+		SetActorUserID("admin-1").
+		SetIP("10.0.0.1").
+		SetTargetType("user").
+		SetTargetID("u1").
+		SetMetadata(map[string]any{"token": "secret", "reason": "spam"}).
+		Save(ctx); err != nil {
+		t.Fatalf("create system log returned error: %v", err)
+	}
+	if _, err := client.UploadLog.Create().
+		SetServer("jp").
+		SetGameUserID("123456").
+		SetDataType("suite").
+		SetUploadMethod("manual").
+		SetSuccess(true).
+		SetUploadTime(time.Now()).
+		Save(ctx); err != nil {
+		t.Fatalf("create upload log returned error: %v", err)
+	}
+}
+
+func readArchive(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader returned error: %v", err)
+	}
+	files := make(map[string]string, len(reader.File))
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s returned error: %v", file.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		_ = rc.Close()
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+func TestDataExportLifecycle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:user-data-export-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore returned error: %v", err)
+	}
+	seedDataExportUser(t, client)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	originalNow := dataExportNow
+	dataExportNow = func() time.Time { return now }
+	originalEnabled := oauthAuthorizationsEnabled
+	oauthAuthorizationsEnabled = func() bool { return false }
+	originalBackendURL := harukiConfig.Cfg.Backend.BackendURL
+	harukiConfig.Cfg.Backend.BackendURL = "https://toolbox.example.com/"
+	originalOptions := CurrentOptions()
+	Configure(Options{Retention: time.Hour, MinInterval: 24 * time.Hour, MaxAttempts: 2})
+	t.Cleanup(func() {
+		dataExportNow = originalNow
+		oauthAuthorizationsEnabled = originalEnabled
+		harukiConfig.Cfg.Backend.BackendURL = originalBackendURL
+		Configure(originalOptions)
+	})
+
+	mailer := &recordingMailer{}
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:  &database.HarukiToolboxDBManager{DB: client},
+		SMTPClient: mailer,
+		BlobStore:  store,
+	}
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		if c.Get("X-Test-User") != "" {
+			c.Locals("userID", c.Get("X-Test-User"))
+			c.Locals("userRole", "user")
+		}
+		return c.Next()
+	})
+	app.Get("/exports", handleListDataExports(helper))
+	app.Post("/exports", handleRequestDataExport(helper))
+	app.Get("/exports/:export_id/download", handleDownloadOwnDataExport(helper))
+	app.Get("/download/:token", handleDownloadDataExportByToken(helper))
+
+	do := func(method, target, userID string) *http.Response {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+		if userID != "" {
+			req.Header.Set("X-Test-User", userID)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		return resp
+	}
+
+	resp := do(http.MethodPost, "/exports", "u1")
+	if resp.StatusCode != fiber.StatusAccepted {
+		t.Fatalf("request status code = %d, want %d", resp.StatusCode, fiber.StatusAccepted)
+	}
+	var body map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+	exportID := body["updatedData"].(map[string]any)["id"].(string)
+	if resp := do(http.MethodPost, "/exports", "u1"); resp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("second request while queued status code = %d, want %d", resp.StatusCode, fiber.StatusConflict)
+	}
+	if resp := do(http.MethodGet, "/exports/"+exportID+"/download", "u1"); resp.StatusCode != fiber.StatusConflict {
+		t.Fatalf("download before ready status code = %d, want %d", resp.StatusCode, fiber.StatusConflict)
+	}
+
+	worker := NewWorker(helper, harukiLogger.NewLogger("DataExportTest", "ERROR", nil))
+	if attempted := worker.RunOnce(context.Background()); attempted != 1 {
+		t.Fatalf("RunOnce attempted %d, want 1", attempted)
+	}
+	row, err := client.DataExport.Get(context.Background(), exportID)
+	if err != nil {
+		t.Fatalf("get export returned error: %v", err)
+	}
+	if row.Status != dataexport.StatusReady || row.ExpiresAt == nil || !row.ExpiresAt.Equal(now.Add(time.Hour)) || row.DownloadTokenHash == nil {
+		t.Fatalf("export after build = %+v, want ready for an hour", row)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0]["to"] != "u1@example.com" || mailer.sent[0]["locale"] != harukiSMTP.LocaleEN || mailer.sent[0]["template"] != harukiSMTP.TemplateDataExportReady {
+		t.Fatalf("sent mail = %v", mailer.sent)
+	}
+	link := mailer.sent[0]["link"]
+	token, ok := strings.CutPrefix(link, "https://toolbox.example.com"+downloadPath)
+	if !ok || hashToken(token) != *row.DownloadTokenHash {
+		t.Fatalf("mailed link = %q does not carry the download token", link)
+	}
+
+	if resp := do(http.MethodGet, "/download/not-the-token", ""); resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("download with a wrong token status code = %d, want %d", resp.StatusCode, fiber.StatusNotFound)
+	}
+	resp = do(http.MethodGet, "/download/"+token, "")
+	if resp.StatusCode != fiber.StatusOK || resp.Header.Get(fiber.HeaderContentType) != "application/zip" {
+		t.Fatalf("download status = %d %q, want a zip", resp.StatusCode, resp.Header.Get(fiber.HeaderContentType))
+	}
+	data, _ := io.ReadAll(resp.Body)
+	files := readArchive(t, data)
+	for _, name := range []string{"manifest.json", "profile.json", "game_account_bindings.json", "data_grants.json", "oauth2_authorizations.json", "tickets.json", "activity_logs.json", "upload_logs.json"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("archive is missing %s, has %v", name, files)
+		}
+	}
+	if !strings.Contains(files["tickets.json"], "question") || strings.Contains(files["tickets.json"], "internal note") {
+		t.Fatalf("tickets.json = %s, want only the visible message", files["tickets.json"])
+	}
+	if strings.Contains(files["activity_logs.json"], "secret") || strings.Contains(files["activity_logs.json"], "10.0.0.1") {
+		t.Fatalf("activity_logs.json leaks redacted data: %s", files["activity_logs.json"])
+	}
+	if !strings.Contains(files["manifest.json"], "OAuth2 authorization management is not enabled") || !strings.Contains(files["manifest.json"], "game data store is not configured") {
+		t.Fatalf("manifest.json = %s, want notes for the skipped sources", files["manifest.json"])
+	}
+	if !strings.Contains(files["upload_logs.json"], `"uploadedBySelf": false`) {
+		t.Fatalf("upload_logs.json = %s", files["upload_logs.json"])
+	}
+
+	if resp := do(http.MethodPost, "/exports", "u1"); resp.StatusCode != fiber.StatusTooManyRequests {
+		t.Fatalf("request within the interval status code = %d, want %d", resp.StatusCode, fiber.StatusTooManyRequests)
+	}
+
+	now = now.Add(2 * time.Hour)
+	worker.RunOnce(context.Background())
+	row, err = client.DataExport.Get(context.Background(), exportID)
+	if err != nil {
+		t.Fatalf("get export returned error: %v", err)
+	}
+	if row.Status != dataexport.StatusExpired || row.StorageKey != nil || row.DownloadCount != 1 {
+		t.Fatalf("export after retention = %+v, want expired after one download", row)
+	}
+	if _, _, err := store.Get(context.Background(), storageKeyPrefix+"/u1/"+exportID+".zip"); err != blobstore.ErrNotFound {
+		t.Fatalf("archive after expiry error = %v, want ErrNotFound", err)
+	}
+	if resp := do(http.MethodGet, "/download/"+token, ""); resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("download after expiry status code = %d, want %d", resp.StatusCode, fiber.StatusNotFound)
+	}
+	if resp := do(http.MethodGet, "/exports/"+exportID+"/download", "u1"); resp.StatusCode != fiber.StatusGone {
+		t.Fatalf("own download after expiry status code = %d, want %d", resp.StatusCode, fiber.StatusGone)
+	}
+
+	actions, err := client.SystemLog.Query().
+		Where(systemlog.ActionHasPrefix("user.data_export.")).
+		Order(systemlog.ByID()).
+		Select(systemlog.FieldAction).
+		Strings(context.Background())
+	if err != nil {
+		t.Fatalf("query system logs returned error: %v", err)
+	}
+	for _, want := range []string{"user.data_export.request", "user.data_export.build", "user.data_export.download", "user.data_export.expire"} {
+		found := false
+		for _, action := range actions {
+			if action == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("system log actions = %v, missing %s", actions, want)
+		}
+	}
+}
+
+func TestBuildArchiveIncludesGameData(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:user-data-export-archive-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	seedDataExportUser(t, client)
+	originalEnabled := oauthAuthorizationsEnabled
+	oauthAuthorizationsEnabled = func() bool { return false }
+	t.Cleanup(func() { oauthAuthorizationsEnabled = originalEnabled })
+
+	data, err := buildArchive(context.Background(), client, stubGameData{}, "e1", "u1", time.Now())
+	if err != nil {
+		t.Fatalf("buildArchive returned error: %v", err)
+	}
+	files := readArchive(t, data)
+	if !strings.Contains(files["game_data/jp_123456_suite.json"], `"name": "Haruki"`) {
+		t.Fatalf("suite data = %q", files["game_data/jp_123456_suite.json"])
+	}
+	if _, ok := files["game_data/jp_123456_mysekai.json"]; ok {
+		t.Fatalf("archive has mysekai data that was never stored")
+	}
+	if strings.Contains(files["manifest.json"], "game data store is not configured") {
+		t.Fatalf("manifest.json = %s, want no game data note", files["manifest.json"])
+	}
+}
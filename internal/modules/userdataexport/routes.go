@@ -0,0 +1,15 @@
+package userdataexport
+
+import (
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+)
+
+func RegisterUserDataExportRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	r := apiHelper.Router.Group("/api/user/:toolbox_user_id/data-exports", userCoreModule.RouteHandlers(userCoreModule.RequireAuthenticatedVerifiedSelf(apiHelper, "toolbox_user_id"))...)
+	r.Get("/", handleListDataExports(apiHelper))
+	r.Post("/", handleRequestDataExport(apiHelper))
+	r.Get("/:export_id/download", handleDownloadOwnDataExport(apiHelper))
+
+	apiHelper.Router.Get(downloadPath+":token", handleDownloadDataExportByToken(apiHelper))
+}
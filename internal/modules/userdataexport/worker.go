@@ -0,0 +1,343 @@
+package userdataexport
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+const (
+	// exportLease must outlive building and uploading one archive, so
+	// another instance does not pick the export up meanwhile.
+	exportLease       = 30 * time.Minute
+	exportStepTimeout = 20 * time.Minute
+	exportRetryDelay  = 5 * time.Minute
+	exportBatchSize   = 5
+	expireBatchSize   = 50
+	storageKeyPrefix  = "data-exports"
+	downloadPath      = "/api/user/data-exports/download/"
+)
+
+var errUserGone = errors.New("user no longer exists")
+
+type Options struct {
+	// Retention is how long a finished archive can be downloaded.
+	Retention time.Duration
+	// MinInterval is the minimum time between two exports of one user.
+	MinInterval  time.Duration
+	MaxAttempts  int
+	PollInterval time.Duration
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Retention:    7 * 24 * time.Hour,
+		MinInterval:  24 * time.Hour,
+		MaxAttempts:  3,
+		PollInterval: 30 * time.Second,
+	}
+}
+
+var (
+	optionsMu      sync.RWMutex
+	currentOptions = DefaultOptions()
+	wake           = make(chan struct{}, 1)
+	dataExportNow  = time.Now
+)
+
+// Configure replaces the options read by the request handlers. It is called
+// once at startup.
+func Configure(options Options) {
+	defaults := DefaultOptions()
+	if options.Retention <= 0 {
+		options.Retention = defaults.Retention
+	}
+	if options.MinInterval < 0 {
+		options.MinInterval = 0
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaults.MaxAttempts
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaults.PollInterval
+	}
+	optionsMu.Lock()
+	currentOptions = options
+	optionsMu.Unlock()
+}
+
+func CurrentOptions() Options {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return currentOptions
+}
+
+// notify wakes the worker early after an export was requested.
+func notify() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Worker builds queued exports, mails the download link and removes
+// archives once they expire.
+type Worker struct {
+	apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
+	logger    *harukiLogger.Logger
+}
+
+func NewWorker(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, logger *harukiLogger.Logger) *Worker {
+	return &Worker{apiHelper: apiHelper, logger: logger}
+}
+
+// Start runs the worker every PollInterval, or sooner when an export is
+// requested, until ctx is cancelled. Call the returned wait before closing
+// the database.
+func (w *Worker) Start(ctx context.Context) func() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(CurrentOptions().PollInterval)
+		defer ticker.Stop()
+		for {
+			w.RunOnce(ctx)
+			select {
+			case <-ctx.Done():
+				w.logger.Infof("data export worker stopped")
+				return
+			case <-ticker.C:
+			case <-wake:
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+// RunOnce builds the due exports one after another, then expires old
+// archives. It returns how many exports were attempted.
+func (w *Worker) RunOnce(ctx context.Context) int {
+	db := w.apiHelper.DBManager.DB
+	now := dataExportNow().UTC()
+	due, err := db.ListDueDataExports(ctx, now, exportBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Errorf("Failed to list due data exports: %v", err)
+		}
+		return 0
+	}
+	attempted := 0
+	for _, export := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		claimed, err := db.ClaimDataExport(ctx, export, now, now.Add(exportLease))
+		if err != nil {
+			w.logger.Errorf("Failed to claim data export %s: %v", export.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		export.Attempts++
+		attempted++
+		w.process(ctx, export)
+	}
+	w.expire(ctx)
+	return attempted
+}
+
+func (w *Worker) process(ctx context.Context, export *postgresql.DataExport) {
+	stepCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), exportStepTimeout)
+	defer cancel()
+	db := w.apiHelper.DBManager.DB
+	options := CurrentOptions()
+
+	email, token, archive, err := w.build(stepCtx, export, options)
+	now := dataExportNow().UTC()
+	if err != nil {
+		if errors.Is(err, errUserGone) || export.Attempts >= export.MaxAttempts {
+			w.logger.Warnf("Data export %s of user %s failed after %d attempts: %v", export.ID, export.UserID, export.Attempts, err)
+			if err := db.FailDataExport(stepCtx, export.ID, err.Error(), now); err != nil {
+				w.logger.Errorf("Failed to mark data export %s as failed: %v", export.ID, err)
+			}
+			w.writeSystemLog(stepCtx, export, "user.data_export.build", harukiAPIHelper.SystemLogResultFailure, map[string]any{"exportId": export.ID, "reason": "build_failed", "attempts": export.Attempts})
+			return
+		}
+		w.logger.Warnf("Data export %s of user %s failed, retrying: %v", export.ID, export.UserID, err)
+		if err := db.RetryDataExport(stepCtx, export.ID, err.Error(), now.Add(time.Duration(export.Attempts)*exportRetryDelay)); err != nil {
+			w.logger.Errorf("Failed to schedule retry of data export %s: %v", export.ID, err)
+		}
+		return
+	}
+
+	if err := db.CompleteDataExport(stepCtx, export.ID, archive, now); err != nil {
+		w.logger.Errorf("Failed to mark data export %s as ready: %v", export.ID, err)
+		if err := w.apiHelper.BlobStore.Delete(stepCtx, archive.StorageKey); err != nil {
+			w.logger.Warnf("Failed to remove archive of data export %s: %v", export.ID, err)
+		}
+		return
+	}
+	w.writeSystemLog(stepCtx, export, "user.data_export.build", harukiAPIHelper.SystemLogResultSuccess, map[string]any{"exportId": export.ID, "sizeBytes": archive.SizeBytes, "attempts": export.Attempts})
+	w.sendReadyMail(export, email, token, archive.ExpiresAt)
+}
+
+// build writes the archive to the blob storage and returns the user's email
+// and the raw download token.
+func (w *Worker) build(ctx context.Context, export *postgresql.DataExport, options Options) (string, string, postgresql.DataExportArchive, error) {
+	store := w.apiHelper.BlobStore
+	if store == nil {
+		return "", "", postgresql.DataExportArchive{}, fmt.Errorf("blob storage is not configured")
+	}
+	db := w.apiHelper.DBManager.DB
+	userRow, err := db.User.Get(ctx, export.UserID)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return "", "", postgresql.DataExportArchive{}, errUserGone
+		}
+		return "", "", postgresql.DataExportArchive{}, err
+	}
+
+	var gameData gameDataReader
+	if mongo := w.apiHelper.DBManager.Mongo; mongo != nil {
+		gameData = mongo
+	}
+	now := dataExportNow().UTC()
+	data, err := buildArchive(ctx, db, gameData, export.ID, export.UserID, now)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return "", "", postgresql.DataExportArchive{}, errUserGone
+		}
+		return "", "", postgresql.DataExportArchive{}, err
+	}
+	key := storageKeyPrefix + "/" + export.UserID + "/" + export.ID + ".zip"
+	if err := store.Put(ctx, key, "application/zip", data); err != nil {
+		return "", "", postgresql.DataExportArchive{}, fmt.Errorf("store archive: %w", err)
+	}
+	token, err := generateToken()
+	if err != nil {
+		return "", "", postgresql.DataExportArchive{}, err
+	}
+	sum := sha256.Sum256(data)
+	return userRow.Email, token, postgresql.DataExportArchive{
+		StorageKey:        key,
+		SizeBytes:         int64(len(data)),
+		SHA256:            hex.EncodeToString(sum[:]),
+		DownloadTokenHash: hashToken(token),
+		ExpiresAt:         now.Add(options.Retention),
+	}, nil
+}
+
+// sendReadyMail mails the download link. A mail that cannot be sent is only
+// logged: the archive can still be downloaded from the account page.
+func (w *Worker) sendReadyMail(export *postgresql.DataExport, email, token string, expiresAt time.Time) {
+	mailer := w.apiHelper.SMTPClient
+	if mailer == nil || strings.TrimSpace(email) == "" {
+		return
+	}
+	link := buildDownloadURL(token)
+	if link == "" {
+		w.logger.Warnf("No backend_url or frontend_url configured, data export %s is only downloadable from the account page", export.ID)
+		return
+	}
+	vars := map[string]string{
+		"LINK":       link,
+		"EXPIRES_AT": expiresAt.UTC().Format("2006-01-02 15:04 UTC"),
+	}
+	if err := mailer.SendTemplate([]string{email}, harukiSMTP.TemplateDataExportReady, export.Locale, vars, harukiConfig.Cfg.UserSystem.SMTP.MailName); err != nil {
+		w.logger.Warnf("Failed to send data export mail for %s: %v", export.ID, err)
+	}
+}
+
+// expire removes archives past their retention. The row is marked first so
+// a concurrent instance does not delete the same archive twice.
+func (w *Worker) expire(ctx context.Context) {
+	db := w.apiHelper.DBManager.DB
+	now := dataExportNow().UTC()
+	rows, err := db.ListExpiredDataExports(ctx, now, expireBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Warnf("Failed to list expired data exports: %v", err)
+		}
+		return
+	}
+	for _, export := range rows {
+		expired, err := db.ExpireDataExport(ctx, export.ID, now)
+		if err != nil {
+			w.logger.Warnf("Failed to expire data export %s: %v", export.ID, err)
+			continue
+		}
+		if !expired {
+			continue
+		}
+		if export.StorageKey != nil && w.apiHelper.BlobStore != nil {
+			if err := w.apiHelper.BlobStore.Delete(ctx, *export.StorageKey); err != nil {
+				w.logger.Warnf("Failed to remove archive of expired data export %s: %v", export.ID, err)
+			}
+		}
+		w.writeSystemLog(ctx, export, "user.data_export.expire", harukiAPIHelper.SystemLogResultSuccess, map[string]any{"exportId": export.ID, "downloads": export.DownloadCount})
+	}
+}
+
+func (w *Worker) writeSystemLog(ctx context.Context, export *postgresql.DataExport, action, result string, metadata map[string]any) {
+	targetType := "user"
+	targetID := export.UserID
+	if err := harukiAPIHelper.WriteSystemLog(ctx, w.apiHelper, harukiAPIHelper.SystemLogEntry{
+		ActorType:  harukiAPIHelper.SystemLogActorTypeSystem,
+		Action:     action,
+		TargetType: &targetType,
+		TargetID:   &targetID,
+		Result:     result,
+		Metadata:   metadata,
+	}); err != nil {
+		w.logger.Warnf("Failed to write system log for data export %s: %v", export.ID, err)
+	}
+}
+
+// buildDownloadURL points at the backend when its public URL is known and
+// falls back to the frontend origin, which usually proxies /api.
+func buildDownloadURL(token string) string {
+	base := strings.TrimSpace(harukiConfig.Cfg.Backend.BackendURL)
+	if base == "" {
+		base = strings.TrimSpace(harukiConfig.Cfg.UserSystem.FrontendURL)
+	}
+	if base == "" {
+		return ""
+	}
+	return strings.TrimRight(base, "/") + downloadPath + url.PathEscape(token)
+}
+
+func generateExportID() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func generateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -3,6 +3,7 @@ package useremail
 import (
 	"crypto/rand"
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	platformIdentity "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/identity"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
@@ -169,11 +170,15 @@ func sendVerificationCode(c fiber.Ctx, email string, helper *harukiAPIHelper.Har
 		harukiLogger.Errorf("Failed to set redis cache: %v", err)
 		return harukiAPIHelper.ErrorInternal(c, "failed to save code")
 	}
-	body := strings.ReplaceAll(smtp.VerificationCodeTemplate, "{{CODE}}", code)
-	if err := helper.SMTPClient.Send([]string{email}, "您的验证码 | Haruki工具箱", body, "Haruki工具箱 | 星云科技"); err != nil {
+	vars := map[string]string{"CODE": code, "PRODUCT": "Haruki工具箱"}
+	locale := smtp.LocaleFromAcceptLanguage(c.Get("Accept-Language"))
+	if err := helper.SMTPClient.SendTemplate([]string{email}, smtp.TemplateVerificationCode, locale, vars, "Haruki工具箱 | 星云科技"); err != nil {
 		if delErr := helper.DBManager.Redis.DeleteCache(ctx, redisKey); delErr != nil {
 			harukiLogger.Warnf("Failed to rollback verification code for %s: %v", email, delErr)
 		}
+		if errors.Is(err, smtp.ErrRecipientSuppressed) {
+			return harukiAPIHelper.ErrorBadRequest(c, "email address cannot receive mail")
+		}
 		harukiLogger.Errorf("Failed to send email: %v", err)
 		return harukiAPIHelper.ErrorInternal(c, "failed to send email")
 	}
@@ -40,43 +40,66 @@ func buildPublicAPIAllowedKeySet(apiHelper *harukiAPIHelper.HarukiToolboxRouterH
 	return allowedKeySet, allowedKeys
 }
 
-func handleGetOwnedGameAccountData(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
-	return func(c fiber.Ctx) error {
-		ctx := c.Context()
+type ownedGameAccountDataTarget struct {
+	Server        harukiUtils.SupportedDataUploadServer
+	GameUserID    int64
+	GameUserIDStr string
+	DataType      ownedGameAccountDataType
+	ViaGrant      bool
+}
 
-		authUserID, err := userCoreModule.CurrentUserID(c)
-		if err != nil {
-			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
-		}
+// resolveOwnedGameAccountDataTarget parses the route parameters and checks
+// that the current user owns the binding or holds a grant for the data type.
+func resolveOwnedGameAccountDataTarget(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) (*ownedGameAccountDataTarget, error) {
+	authUserID, err := userCoreModule.CurrentUserID(c)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+	}
 
-		serverStr := c.Params("server")
-		server, err := harukiUtils.ParseSupportedDataUploadServer(serverStr)
-		if err != nil {
-			return harukiAPIHelper.ErrorBadRequest(c, "invalid server")
-		}
+	serverStr := c.Params("server")
+	server, err := harukiUtils.ParseSupportedDataUploadServer(serverStr)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorBadRequest(c, "invalid server")
+	}
 
-		gameUserIDStr := strings.TrimSpace(c.Params("game_user_id"))
-		gameUserID, err := strconv.ParseInt(gameUserIDStr, 10, 64)
-		if err != nil {
-			return harukiAPIHelper.ErrorBadRequest(c, "game_user_id must be numeric")
-		}
+	gameUserIDStr := strings.TrimSpace(c.Params("game_user_id"))
+	gameUserID, err := strconv.ParseInt(gameUserIDStr, 10, 64)
+	if err != nil {
+		return nil, harukiAPIHelper.ErrorBadRequest(c, "game_user_id must be numeric")
+	}
 
-		dataType, parseErr := parseOwnedGameAccountDataType(c.Params("data_type"))
-		if parseErr != nil {
-			return harukiAPIHelper.ErrorBadRequest(c, parseErr.Message)
-		}
+	dataType, parseErr := parseOwnedGameAccountDataType(c.Params("data_type"))
+	if parseErr != nil {
+		return nil, harukiAPIHelper.ErrorBadRequest(c, parseErr.Message)
+	}
 
-		access, err := apiHelper.DBManager.DB.CanAccessGameAccountData(ctx, authUserID, string(server), gameUserIDStr, string(dataType), time.Now().UTC())
-		if err != nil {
-			harukiLogger.Errorf("Failed to verify game account data access: %v", err)
-			return harukiAPIHelper.ErrorInternal(c, "failed to verify game account data access")
+	access, err := apiHelper.DBManager.DB.CanAccessGameAccountData(c.Context(), authUserID, string(server), gameUserIDStr, string(dataType), time.Now().UTC())
+	if err != nil {
+		harukiLogger.Errorf("Failed to verify game account data access: %v", err)
+		return nil, harukiAPIHelper.ErrorInternal(c, "failed to verify game account data access")
+	}
+	if access == nil || !access.Allowed {
+		if access == nil || access.OwnerUserID == "" {
+			return nil, harukiAPIHelper.ErrorNotFound(c, "binding not found")
 		}
-		if access == nil || !access.Allowed {
-			if access == nil || access.OwnerUserID == "" {
-				return harukiAPIHelper.ErrorNotFound(c, "binding not found")
-			}
-			return harukiAPIHelper.ErrorForbidden(c, "not authorized to access this binding")
+		return nil, harukiAPIHelper.ErrorForbidden(c, "not authorized to access this binding")
+	}
+	return &ownedGameAccountDataTarget{
+		Server:        server,
+		GameUserID:    gameUserID,
+		GameUserIDStr: gameUserIDStr,
+		DataType:      dataType,
+		ViaGrant:      access.ViaGrant,
+	}, nil
+}
+
+func handleGetOwnedGameAccountData(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, err := resolveOwnedGameAccountDataTarget(c, apiHelper)
+		if target == nil {
+			return err
 		}
+		server, gameUserID, gameUserIDStr, dataType := target.Server, target.GameUserID, target.GameUserIDStr, target.DataType
 
 		switch dataType {
 		case ownedGameAccountDataTypeSuite:
@@ -92,7 +115,7 @@ func handleGetOwnedGameAccountData(apiHelper *harukiAPIHelper.HarukiToolboxRoute
 			}
 			return c.JSON(resp)
 		case ownedGameAccountDataTypeProfile:
-			if access.ViaGrant {
+			if target.ViaGrant {
 				return harukiAPIHelper.ErrorForbidden(c, "profile access cannot be granted")
 			}
 			return sendOwnedGameAccountProfile(c, apiHelper, gameUserIDStr, server)
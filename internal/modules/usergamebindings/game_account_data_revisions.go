@@ -0,0 +1,47 @@
+package usergamebindings
+
+import (
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/data"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func handleListOwnedGameAccountDataRevisions(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, err := resolveOwnedGameAccountDataTarget(c, apiHelper)
+		if target == nil {
+			return err
+		}
+		resp, err := data.HandleRevisionListRequest(c, apiHelper, target.GameUserID, target.Server, harukiUtils.UploadDataType(target.DataType))
+		if err != nil {
+			return respondVerifiedGameAccountDataError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
+
+func handleGetOwnedGameAccountDataRevision(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, err := resolveOwnedGameAccountDataTarget(c, apiHelper)
+		if target == nil {
+			return err
+		}
+		timestamp := c.Params("timestamp")
+		var resp *data.SnapshotRevisionResponse
+		switch target.DataType {
+		case ownedGameAccountDataTypeSuite:
+			allowedKeySet, allowedKeys := buildPublicAPIAllowedKeySet(apiHelper)
+			resp, err = data.HandleSuiteRevisionRequest(c, apiHelper, target.GameUserID, target.Server, timestamp, c.Query("key"), allowedKeySet, allowedKeys)
+		case ownedGameAccountDataTypeMysekai:
+			resp, err = data.HandleMysekaiRevisionRequest(c, apiHelper, target.GameUserID, target.Server, timestamp, c.Query("key"))
+		default:
+			return harukiAPIHelper.ErrorBadRequest(c, "revisions are only available for suite and mysekai")
+		}
+		if err != nil {
+			return respondVerifiedGameAccountDataError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
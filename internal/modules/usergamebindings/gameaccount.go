@@ -23,6 +23,14 @@ func RegisterUserGameAccountBindingRoutes(apiHelper *harukiAPIHelper.HarukiToolb
 		"/:server/:game_user_id/:data_type",
 		handleGetOwnedGameAccountData(apiHelper),
 	)
+	r.Get(
+		"/:server/:game_user_id/:data_type/revisions",
+		handleListOwnedGameAccountDataRevisions(apiHelper),
+	)
+	r.Get(
+		"/:server/:game_user_id/:data_type/revisions/:timestamp",
+		handleGetOwnedGameAccountDataRevision(apiHelper),
+	)
 
 	r.RouteChain("/:server/:game_user_id").
 		Post(
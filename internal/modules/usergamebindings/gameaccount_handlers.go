@@ -76,6 +76,7 @@ func handleCreateGameAccountBinding(apiHelper *harukiAPIHelper.HarukiToolboxRout
 				"gameUserID":          gameUserIDStr,
 				"previousOwnerUserID": previousOwnerUserID,
 			})
+			publishGameBindingVerificationRiskSignal(c, apiHelper, userID, serverStr, gameUserIDStr, reason)
 		}()
 		harukiLogger.Infof("[GameAccountBinding] START: userID=%s, server=%s, gameUserID=%s", userID, serverStr, gameUserIDStr)
 
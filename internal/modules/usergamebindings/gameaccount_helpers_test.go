@@ -291,9 +291,13 @@ func TestNotifyPreviousGameAccountBindingOwnerBestEffort(t *testing.T) {
 	var sentTo string
 	var sentBody string
 	originalSend := sendGameAccountBindingTransferMail
-	sendGameAccountBindingTransferMail = func(_ *harukiAPIHelper.HarukiToolboxRouterHelpers, email, body string) error {
+	sendGameAccountBindingTransferMail = func(_ *harukiAPIHelper.HarukiToolboxRouterHelpers, email string, vars map[string]string) error {
 		sentTo = email
-		sentBody = body
+		rendered, err := smtp.Render(smtp.TemplateGameAccountBindingTransfer, "", vars)
+		if err != nil {
+			return err
+		}
+		sentBody = rendered.Body
 		return errors.New("smtp down")
 	}
 	t.Cleanup(func() {
@@ -3,7 +3,6 @@ package usergamebindings
 import (
 	"context"
 	"errors"
-	"html"
 	"strconv"
 	"strings"
 	"time"
@@ -80,8 +79,10 @@ type gameAccountBindingSaveResult struct {
 
 var errGameAccountBindingOwnerBanned = errors.New("game account binding owner is banned")
 
-var sendGameAccountBindingTransferMail = func(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, email, body string) error {
-	return apiHelper.SMTPClient.Send([]string{email}, "游戏账号绑定变更通知 | Haruki工具箱", body, "Haruki工具箱 | 星云科技")
+// The previous owner is not the one making the request, so the notice goes
+// out in the default locale.
+var sendGameAccountBindingTransferMail = func(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, email string, vars map[string]string) error {
+	return apiHelper.SMTPClient.SendTemplate([]string{email}, smtp.TemplateGameAccountBindingTransfer, "", vars, "Haruki工具箱 | 星云科技")
 }
 
 func saveGameAccountBinding(ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, existing *postgresql.GameAccountBinding, serverStr, gameUserIDStr, userID string, req harukiAPIHelper.CreateGameAccountBindingPayload) (*gameAccountBindingSaveResult, error) {
@@ -159,21 +160,16 @@ func notifyPreviousGameAccountBindingOwner(apiHelper *harukiAPIHelper.HarukiTool
 		harukiLogger.Warnf("Skip game account binding transfer notification because SMTP client is unavailable: userID=%s server=%s gameUserID=%s", transfer.PreviousOwnerUserID, serverStr, gameUserIDStr)
 		return
 	}
-	body := buildGameAccountBindingTransferMailBody(serverStr, gameUserIDStr, transferTime)
-	if err := sendGameAccountBindingTransferMail(apiHelper, email, body); err != nil {
+	vars := buildGameAccountBindingTransferMailVars(serverStr, gameUserIDStr, transferTime)
+	if err := sendGameAccountBindingTransferMail(apiHelper, email, vars); err != nil {
 		harukiLogger.Warnf("Failed to send game account binding transfer notification to previous owner %s: %v", transfer.PreviousOwnerUserID, err)
 	}
 }
 
-func buildGameAccountBindingTransferMailBody(serverStr, gameUserIDStr string, transferTime time.Time) string {
-	body := smtp.GameAccountBindingTransferTemplate
-	replacements := map[string]string{
-		"{{SERVER}}":        html.EscapeString(strings.ToUpper(strings.TrimSpace(serverStr))),
-		"{{GAME_USER_ID}}":  html.EscapeString(strings.TrimSpace(gameUserIDStr)),
-		"{{TRANSFER_TIME}}": html.EscapeString(transferTime.UTC().Format(time.RFC3339)),
+func buildGameAccountBindingTransferMailVars(serverStr, gameUserIDStr string, transferTime time.Time) map[string]string {
+	return map[string]string{
+		"SERVER":        strings.ToUpper(strings.TrimSpace(serverStr)),
+		"GAME_USER_ID":  strings.TrimSpace(gameUserIDStr),
+		"TRANSFER_TIME": transferTime.UTC().Format(time.RFC3339),
 	}
-	for old, newValue := range replacements {
-		body = strings.ReplaceAll(body, old, newValue)
-	}
-	return body
 }
@@ -71,6 +71,24 @@ func consumeGameAccountVerificationCode(ctx context.Context, apiHelper *harukiAP
 	return nil
 }
 
+// publishGameBindingVerificationRiskSignal reports ownership checks that ran
+// against the game profile; earlier rejections are not verification attempts.
+func publishGameBindingVerificationRiskSignal(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID, server, gameUserID, reason string) {
+	success, ok := harukiAPIHelper.GameBindingVerificationRiskSignalResult(reason)
+	if !ok {
+		return
+	}
+	harukiAPIHelper.PublishRiskSignal(apiHelper, harukiAPIHelper.RiskSignal{
+		Kind:       harukiAPIHelper.RiskSignalKindGameBindingVerification,
+		Success:    success,
+		UserID:     userID,
+		IP:         c.IP(),
+		Server:     server,
+		GameUserID: gameUserID,
+		Metadata:   map[string]any{"reason": reason},
+	})
+}
+
 func shouldIncrementGameAccountVerificationAttempt(err error) bool {
 	return errors.Is(err, errGameAccountVerificationCodeMissing) || errors.Is(err, errGameAccountVerificationCodeMismatch)
 }
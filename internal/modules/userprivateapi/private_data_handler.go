@@ -8,6 +8,7 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 	"strconv"
 	"time"
 
@@ -15,96 +16,126 @@ import (
 	"github.com/gofiber/fiber/v3"
 )
 
-func handleGetPrivateData(apiHelper *harukiApiHelper.HarukiToolboxRouterHelpers) fiber.Handler {
-	return func(c fiber.Ctx) error {
-		ctx := c.Context()
-		serverStr := c.Params("server")
-		dataTypeStr := c.Params("data_type")
-		userIDStr := c.Params("user_id")
-		platform := c.Query("platform")
-		platformUserID := c.Query("platform_user_id")
-		if platform == "" || platformUserID == "" {
-			return harukiApiHelper.ErrorBadRequest(c, "both platform and platform_user_id are required")
-		}
-		server, err := harukiUtils.ParseSupportedDataUploadServer(serverStr)
-		if err != nil {
-			return harukiApiHelper.ErrorBadRequest(c, "invalid server")
-		}
-		dataType, err := harukiUtils.ParseUploadDataType(dataTypeStr)
-		if err != nil {
-			return harukiApiHelper.ErrorBadRequest(c, "invalid data_type")
-		}
-		userID, err := strconv.ParseInt(userIDStr, 10, 64)
-		if err != nil {
-			return harukiApiHelper.ErrorBadRequest(c, "invalid user_id")
-		}
-		// Resolve the data owner from the verified binding first; the authorization
-		// check below must be scoped to that owner, so the two queries cannot run
-		// concurrently.
-		gameAccountBinding, bindingErr := apiHelper.DBManager.DB.GameAccountBinding.Query().
-			Where(
-				gameaccountbinding.ServerEQ(string(server)),
-				gameaccountbinding.GameUserIDEQ(userIDStr),
-				gameaccountbinding.VerifiedEQ(true),
-			).
-			WithUser(func(query *postgresql.UserQuery) {
-				query.WithSocialPlatformInfo()
-			}).
-			Only(ctx)
-		if bindingErr != nil {
-			if lookupErr := mapPrivateGameAccountLookupError(bindingErr); lookupErr != nil {
-				if lookupErr.Code == fiber.StatusNotFound {
-					return harukiApiHelper.ErrorNotFound(c, lookupErr.Message)
-				}
+type privateDataTarget struct {
+	Server   harukiUtils.SupportedDataUploadServer
+	DataType harukiUtils.UploadDataType
+	UserID   int64
+}
+
+// resolvePrivateDataTarget parses the game-data route parameters and checks
+// that the requesting platform account may read the bound owner's data.
+func resolvePrivateDataTarget(c fiber.Ctx, apiHelper *harukiApiHelper.HarukiToolboxRouterHelpers) (*privateDataTarget, *fiber.Error) {
+	ctx := c.Context()
+	serverStr := c.Params("server")
+	dataTypeStr := c.Params("data_type")
+	userIDStr := c.Params("user_id")
+	platform := c.Query("platform")
+	platformUserID := c.Query("platform_user_id")
+	if platform == "" || platformUserID == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "both platform and platform_user_id are required")
+	}
+	server, err := harukiUtils.ParseSupportedDataUploadServer(serverStr)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid server")
+	}
+	dataType, err := harukiUtils.ParseUploadDataType(dataTypeStr)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid data_type")
+	}
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid user_id")
+	}
+	// Resolve the data owner from the verified binding first; the authorization
+	// check below must be scoped to that owner, so the two queries cannot run
+	// concurrently.
+	gameAccountBinding, bindingErr := apiHelper.DBManager.DB.GameAccountBinding.Query().
+		Where(
+			gameaccountbinding.ServerEQ(string(server)),
+			gameaccountbinding.GameUserIDEQ(userIDStr),
+			gameaccountbinding.VerifiedEQ(true),
+		).
+		WithUser(func(query *postgresql.UserQuery) {
+			query.WithSocialPlatformInfo()
+		}).
+		Only(ctx)
+	if bindingErr != nil {
+		if lookupErr := mapPrivateGameAccountLookupError(bindingErr); lookupErr != nil {
+			if lookupErr.Code != fiber.StatusNotFound {
 				harukiLogger.Errorf("Failed to query game account binding (server=%s,user_id=%s): %v", server, userIDStr, bindingErr)
-				return harukiApiHelper.ErrorInternal(c, lookupErr.Message)
 			}
-			harukiLogger.Errorf("Failed to query game account binding (server=%s,user_id=%s): %v", server, userIDStr, bindingErr)
-			return harukiApiHelper.ErrorInternal(c, "failed to query game account binding")
+			return nil, lookupErr
 		}
+		harukiLogger.Errorf("Failed to query game account binding (server=%s,user_id=%s): %v", server, userIDStr, bindingErr)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "failed to query game account binding")
+	}
 
-		if ownerErr := mapPrivateBindingOwnerError(gameAccountBinding); ownerErr != nil {
-			switch ownerErr.Code {
-			case fiber.StatusNotFound:
-				return harukiApiHelper.ErrorNotFound(c, ownerErr.Message)
-			case fiber.StatusForbidden:
-				return harukiApiHelper.ErrorForbidden(c, ownerErr.Message)
-			default:
-				harukiLogger.Errorf("Failed to query game account owner (server=%s,user_id=%s): %s", server, userIDStr, ownerErr.Message)
-				return harukiApiHelper.ErrorInternal(c, ownerErr.Message)
-			}
+	if ownerErr := mapPrivateBindingOwnerError(gameAccountBinding); ownerErr != nil {
+		if ownerErr.Code == fiber.StatusInternalServerError {
+			harukiLogger.Errorf("Failed to query game account owner (server=%s,user_id=%s): %s", server, userIDStr, ownerErr.Message)
 		}
-		dbUser := gameAccountBinding.Edges.User
+		return nil, ownerErr
+	}
+	dbUser := gameAccountBinding.Edges.User
 
-		// The requester is authorized if either: they are the owner's own bound
-		// social account, or the owner authorized this platform account (any
-		// authorize-social grant — the direct data fetch intentionally does NOT
-		// require allow_fast_verification; that flag gates the bindings query API
-		// only). Both must be scoped to the resolved owner (dbUser.ID); querying
-		// the authorize table without the owner constraint is a cross-user IDOR.
-		authorized := dbUser.Edges.SocialPlatformInfo != nil &&
-			dbUser.Edges.SocialPlatformInfo.Platform == platform &&
-			dbUser.Edges.SocialPlatformInfo.PlatformUserID == platformUserID
-		if !authorized {
-			exists, authErr := apiHelper.DBManager.DB.AuthorizeSocialPlatformInfo.Query().
-				Where(
-					authorizesocialplatforminfo.UserIDEQ(dbUser.ID),
-					authorizesocialplatforminfo.PlatformEQ(platform),
-					authorizesocialplatforminfo.PlatformUserIDEQ(platformUserID),
-				).
-				Exist(ctx)
-			if authErr != nil {
-				harukiLogger.Errorf("Failed to verify private api authorization (platform=%s,platform_user_id=%s): %v", platform, platformUserID, authErr)
-				return harukiApiHelper.ErrorInternal(c, "failed to verify authorization")
-			}
-			authorized = exists
+	// The requester is authorized if either: they are the owner's own bound
+	// social account, or the owner authorized this platform account (any
+	// authorize-social grant — the direct data fetch intentionally does NOT
+	// require allow_fast_verification; that flag gates the bindings query API
+	// only). Both must be scoped to the resolved owner (dbUser.ID); querying
+	// the authorize table without the owner constraint is a cross-user IDOR.
+	authorized := dbUser.Edges.SocialPlatformInfo != nil &&
+		dbUser.Edges.SocialPlatformInfo.Platform == platform &&
+		dbUser.Edges.SocialPlatformInfo.PlatformUserID == platformUserID
+	if !authorized {
+		exists, authErr := apiHelper.DBManager.DB.AuthorizeSocialPlatformInfo.Query().
+			Where(
+				authorizesocialplatforminfo.UserIDEQ(dbUser.ID),
+				authorizesocialplatforminfo.PlatformEQ(platform),
+				authorizesocialplatforminfo.PlatformUserIDEQ(platformUserID),
+			).
+			Exist(ctx)
+		if authErr != nil {
+			harukiLogger.Errorf("Failed to verify private api authorization (platform=%s,platform_user_id=%s): %v", platform, platformUserID, authErr)
+			return nil, mapPrivateAuthorizationLookupError(authErr)
 		}
-		if !authorized {
-			return harukiApiHelper.ErrorForbidden(c, "forbidden: invalid platform or platform_user_id for this user")
+		authorized = exists
+	}
+	if !authorized {
+		return nil, fiber.NewError(fiber.StatusForbidden, "forbidden: invalid platform or platform_user_id for this user")
+	}
+	return &privateDataTarget{Server: server, DataType: dataType, UserID: userID}, nil
+}
+
+func respondPrivateDataError(c fiber.Ctx, err *fiber.Error) error {
+	switch err.Code {
+	case fiber.StatusBadRequest:
+		return harukiApiHelper.ErrorBadRequest(c, err.Message)
+	case fiber.StatusNotFound:
+		return harukiApiHelper.ErrorNotFound(c, err.Message)
+	case fiber.StatusForbidden:
+		return harukiApiHelper.ErrorForbidden(c, err.Message)
+	case fiber.StatusInternalServerError:
+		return harukiApiHelper.ErrorInternal(c, err.Message)
+	default:
+		return harukiApiHelper.UpdatedDataResponse[string](c, err.Code, err.Message, nil)
+	}
+}
+
+func handleGetPrivateData(apiHelper *harukiApiHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := c.Context()
+		target, targetErr := resolvePrivateDataTarget(c, apiHelper)
+		if targetErr != nil {
+			return respondPrivateDataError(c, targetErr)
 		}
+		server, dataType, userID := target.Server, target.DataType, target.UserID
+		userIDStr := strconv.FormatInt(userID, 10)
 		requestKey := c.Query("key")
 		cacheKey := harukiRedis.BuildGameDataCacheKey("private", string(server), string(dataType), userID, requestKey)
-		if cached, found, cErr := apiHelper.DBManager.Redis.GetRawCache(ctx, cacheKey); cErr == nil && found {
+		cached, found, cErr := apiHelper.DBManager.Redis.GetRawCache(ctx, cacheKey)
+		harukiMetrics.RecordCacheLookup(harukiMetrics.CachePrivate, found, cErr)
+		if cErr == nil && found {
 			c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
 			return c.SendString(cached)
 		} else if cErr != nil {
@@ -0,0 +1,60 @@
+package userprivateapi
+
+import (
+	harukiApiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/data"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func handleListPrivateDataRevisions(apiHelper *harukiApiHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, targetErr := resolvePrivateDataTarget(c, apiHelper)
+		if targetErr != nil {
+			return respondPrivateDataError(c, targetErr)
+		}
+		resp, err := data.HandleRevisionListRequest(c, apiHelper, target.UserID, target.Server, target.DataType)
+		if err != nil {
+			return respondPrivateRevisionError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
+
+func handleGetPrivateDataRevision(apiHelper *harukiApiHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, targetErr := resolvePrivateDataTarget(c, apiHelper)
+		if targetErr != nil {
+			return respondPrivateDataError(c, targetErr)
+		}
+		revision, doc, err := data.LoadRevisionAt(c, apiHelper, target.UserID, target.Server, target.DataType, c.Params("timestamp"))
+		if err != nil {
+			return respondPrivateRevisionError(c, err)
+		}
+		return c.JSON(data.SnapshotRevisionResponse{
+			Revision: data.NewSnapshotRevisionItem(*revision),
+			Data:     buildPrivateDataResponse(c.Query("key"), doc),
+		})
+	}
+}
+
+func handleDiffPrivateDataRevisions(apiHelper *harukiApiHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		target, targetErr := resolvePrivateDataTarget(c, apiHelper)
+		if targetErr != nil {
+			return respondPrivateDataError(c, targetErr)
+		}
+		resp, err := data.HandleRevisionDiffRequest(c, apiHelper, target.UserID, target.Server, target.DataType, c.Query("key"))
+		if err != nil {
+			return respondPrivateRevisionError(c, err)
+		}
+		return c.JSON(resp)
+	}
+}
+
+func respondPrivateRevisionError(c fiber.Ctx, err error) error {
+	if fErr, ok := err.(*fiber.Error); ok {
+		return respondPrivateDataError(c, fErr)
+	}
+	return harukiApiHelper.ErrorInternal(c, "failed to query user data")
+}
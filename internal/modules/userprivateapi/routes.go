@@ -6,5 +6,8 @@ func RegisterUserPrivateAPIRoutes(apiHelper *harukiApiHelper.HarukiToolboxRouter
 	privateAPI := apiHelper.Router.Group("/api/private", ValidateUserPermission(apiHelper))
 
 	privateAPI.Get("/game-data/:server/:data_type/:user_id", handleGetPrivateData(apiHelper))
+	privateAPI.Get("/game-data/:server/:data_type/:user_id/revisions", handleListPrivateDataRevisions(apiHelper))
+	privateAPI.Get("/game-data/:server/:data_type/:user_id/revisions/diff", handleDiffPrivateDataRevisions(apiHelper))
+	privateAPI.Get("/game-data/:server/:data_type/:user_id/revisions/:timestamp", handleGetPrivateDataRevision(apiHelper))
 	privateAPI.Get("/game-binding", handleGetGameBindings(apiHelper))
 }
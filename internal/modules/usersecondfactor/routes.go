@@ -0,0 +1,18 @@
+package usersecondfactor
+
+import (
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+)
+
+func RegisterUserSecondFactorRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	r := apiHelper.Router.Group("/api/user/:toolbox_user_id/second-factor", userCoreModule.RouteHandlers(userCoreModule.RequireAuthenticatedVerifiedSelf(apiHelper, "toolbox_user_id"))...)
+	r.Get("/", handleGetSecondFactorStatus(apiHelper))
+	r.Post("/totp", handleBeginTOTPEnrollment(apiHelper))
+	r.Post("/totp/confirm", handleConfirmTOTPEnrollment(apiHelper))
+	r.Delete("/totp", handleDisableTOTP(apiHelper))
+	r.Post("/recovery-codes", handleRegenerateRecoveryCodes(apiHelper))
+	r.Post("/webauthn/register", handleBeginWebAuthnRegistration(apiHelper))
+	r.Post("/webauthn/register/finish", handleFinishWebAuthnRegistration(apiHelper))
+	r.Delete("/webauthn/:credential_id", handleDeleteWebAuthnCredential(apiHelper))
+}
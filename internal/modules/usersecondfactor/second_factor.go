@@ -0,0 +1,403 @@
+package usersecondfactor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+var secondFactorNow = time.Now
+
+type passwordPayload struct {
+	Password string `json:"password"`
+}
+
+type confirmTOTPPayload struct {
+	Code string `json:"code"`
+}
+
+type registerWebAuthnPayload struct {
+	Password string `json:"password"`
+	Name     string `json:"name"`
+}
+
+type totpEnrollmentResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type recoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type webAuthnRegistrationResponse struct {
+	Credential    secondfactor.WebAuthnCredential `json:"credential"`
+	RecoveryCodes []string                        `json:"recoveryCodes,omitempty"`
+}
+
+// respondFactorError writes the response for an error of the secondfactor
+// package and returns the audit reason. Errors meant for the user carry
+// their own status.
+func respondFactorError(c fiber.Ctx, err error, fallbackReason, fallbackMessage string) (string, error) {
+	var fiberErr *fiber.Error
+	if !errors.As(err, &fiberErr) || fiberErr.Code >= fiber.StatusInternalServerError && fiberErr.Code != fiber.StatusServiceUnavailable {
+		harukiLogger.Errorf("Second factor operation failed: %v", err)
+		return fallbackReason, harukiAPIHelper.ErrorInternal(c, fallbackMessage)
+	}
+	reason := "rejected"
+	switch fiberErr.Code {
+	case fiber.StatusBadRequest:
+		reason = "invalid_request"
+	case fiber.StatusNotFound:
+		reason = "not_found"
+	case fiber.StatusConflict:
+		reason = "conflict"
+	case fiber.StatusServiceUnavailable:
+		reason = "webauthn_unavailable"
+	}
+	return reason, harukiAPIHelper.UpdatedDataResponse[string](c, fiberErr.Code, fiberErr.Message, nil)
+}
+
+// loadVerifiedUser loads the signed-in user and confirms the password.
+// Changing second factors always asks for the password, so a stolen session
+// cannot replace them.
+func loadVerifiedUser(c fiber.Ctx, ctx context.Context, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID, password string) (*postgresql.User, string, func() error) {
+	if strings.TrimSpace(password) == "" {
+		return nil, "missing_password", func() error {
+			return harukiAPIHelper.ErrorBadRequest(c, "password is required")
+		}
+	}
+	userRow, err := apiHelper.DBManager.DB.User.Query().Where(userSchema.IDEQ(userID)).Only(ctx)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return nil, "user_not_found", func() error {
+				return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+			}
+		}
+		return nil, "query_user_failed", func() error {
+			return harukiAPIHelper.ErrorInternal(c, "failed to verify account")
+		}
+	}
+	if failure, respond := userCoreModule.Reauthenticate(c, ctx, apiHelper, userRow, password); respond != nil {
+		return nil, failure, respond
+	}
+	return userRow, "", nil
+}
+
+func handleGetSecondFactorStatus(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		status, err := secondfactor.GetStatus(c.Context(), apiHelper.DBManager.DB, userID)
+		if err != nil {
+			return harukiAPIHelper.ErrorInternal(c, "failed to query second factors")
+		}
+		return harukiAPIHelper.SuccessResponse(c, "ok", &status)
+	}
+}
+
+func handleBeginTOTPEnrollment(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.totp.begin", result, userID, map[string]any{
+				"reason": reason,
+			})
+		}()
+
+		var payload passwordPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		userRow, failure, respond := loadVerifiedUser(c, ctx, apiHelper, userID, payload.Password)
+		if respond != nil {
+			reason = failure
+			return respond()
+		}
+
+		secret, err := secondfactor.BeginTOTPEnrollment(ctx, apiHelper.DBManager.DB, userID, secondFactorNow().UTC())
+		if err != nil {
+			var respondErr error
+			reason, respondErr = respondFactorError(c, err, "begin_totp_failed", "failed to start authenticator enrollment")
+			return respondErr
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		resp := totpEnrollmentResponse{
+			Secret: secret,
+			URI:    secondfactor.TOTPURI(secondfactor.CurrentOptions().Issuer, userRow.Email, secret),
+		}
+		return harukiAPIHelper.SuccessResponse(c, "authenticator enrollment started", &resp)
+	}
+}
+
+func handleConfirmTOTPEnrollment(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.totp.confirm", result, userID, map[string]any{
+				"reason": reason,
+			})
+		}()
+
+		var payload confirmTOTPPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		if strings.TrimSpace(payload.Code) == "" {
+			reason = "missing_code"
+			return harukiAPIHelper.ErrorBadRequest(c, "code is required")
+		}
+
+		codes, err := secondfactor.ConfirmTOTPEnrollment(c.Context(), apiHelper.DBManager.DB, userID, payload.Code, secondFactorNow().UTC())
+		if err != nil {
+			var respondErr error
+			reason, respondErr = respondFactorError(c, err, "confirm_totp_failed", "failed to confirm authenticator")
+			return respondErr
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		resp := recoveryCodesResponse{RecoveryCodes: codes}
+		return harukiAPIHelper.SuccessResponse(c, "authenticator enrolled", &resp)
+	}
+}
+
+func handleDisableTOTP(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.totp.disable", result, userID, map[string]any{
+				"reason": reason,
+			})
+		}()
+
+		var payload passwordPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		if _, failure, respond := loadVerifiedUser(c, ctx, apiHelper, userID, payload.Password); respond != nil {
+			reason = failure
+			return respond()
+		}
+
+		removed, err := secondfactor.DisableTOTP(ctx, apiHelper.DBManager.DB, userID)
+		if err != nil {
+			reason = "disable_totp_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to remove authenticator")
+		}
+		if !removed {
+			reason = "not_found"
+			return harukiAPIHelper.ErrorNotFound(c, "no authenticator enrolled")
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		return harukiAPIHelper.SuccessResponse[string](c, "authenticator removed", nil)
+	}
+}
+
+func handleRegenerateRecoveryCodes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.recovery_codes.regenerate", result, userID, map[string]any{
+				"reason": reason,
+			})
+		}()
+
+		var payload passwordPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		if _, failure, respond := loadVerifiedUser(c, ctx, apiHelper, userID, payload.Password); respond != nil {
+			reason = failure
+			return respond()
+		}
+
+		codes, err := secondfactor.RegenerateRecoveryCodes(ctx, apiHelper.DBManager.DB, userID, secondFactorNow().UTC())
+		if err != nil {
+			var respondErr error
+			reason, respondErr = respondFactorError(c, err, "regenerate_codes_failed", "failed to generate recovery codes")
+			return respondErr
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		resp := recoveryCodesResponse{RecoveryCodes: codes}
+		return harukiAPIHelper.SuccessResponse(c, "recovery codes generated", &resp)
+	}
+}
+
+func handleBeginWebAuthnRegistration(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.webauthn.begin", result, userID, map[string]any{
+				"reason": reason,
+			})
+		}()
+
+		var payload registerWebAuthnPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		name, err := secondfactor.NormalizeCredentialName(payload.Name)
+		if err != nil {
+			var respondErr error
+			reason, respondErr = respondFactorError(c, err, "invalid_name", "invalid name")
+			return respondErr
+		}
+		rdb := apiHelper.RedisClient()
+		if rdb == nil {
+			reason = "session_store_unavailable"
+			return harukiAPIHelper.ErrorInternal(c, "session store unavailable")
+		}
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		userRow, failure, respond := loadVerifiedUser(c, ctx, apiHelper, userID, payload.Password)
+		if respond != nil {
+			reason = failure
+			return respond()
+		}
+
+		options, err := secondfactor.BeginWebAuthnRegistration(ctx, apiHelper.DBManager.DB, rdb, userRow, name)
+		if err != nil {
+			var respondErr error
+			reason, respondErr = respondFactorError(c, err, "begin_webauthn_failed", "failed to start security key registration")
+			return respondErr
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		return harukiAPIHelper.SuccessResponse(c, "security key registration started", options)
+	}
+}
+
+func handleFinishWebAuthnRegistration(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		credentialID := ""
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.webauthn.register", result, userID, map[string]any{
+				"reason":       reason,
+				"credentialId": credentialID,
+			})
+		}()
+
+		rdb := apiHelper.RedisClient()
+		if rdb == nil {
+			reason = "session_store_unavailable"
+			return harukiAPIHelper.ErrorInternal(c, "session store unavailable")
+		}
+		userRow, err := apiHelper.DBManager.DB.User.Query().Where(userSchema.IDEQ(userID)).Only(c.Context())
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				reason = "user_not_found"
+				return harukiAPIHelper.ErrorUnauthorized(c, "invalid user session")
+			}
+			reason = "query_user_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to verify account")
+		}
+
+		// The body is the PublicKeyCredential produced by the browser.
+		credential, codes, err := secondfactor.FinishWebAuthnRegistration(c.Context(), apiHelper.DBManager.DB, rdb, userRow, c.Body(), secondFactorNow().UTC())
+		if err != nil {
+			var respondErr error
+			reason, respondErr = respondFactorError(c, err, "finish_webauthn_failed", "failed to register security key")
+			return respondErr
+		}
+		credentialID = credential.ID
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		resp := webAuthnRegistrationResponse{Credential: credential, RecoveryCodes: codes}
+		return harukiAPIHelper.SuccessResponse(c, "security key registered", &resp)
+	}
+}
+
+func handleDeleteWebAuthnCredential(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		credentialID := strings.TrimSpace(c.Params("credential_id"))
+		result := harukiAPIHelper.SystemLogResultFailure
+		reason := "unknown"
+		defer func() {
+			userCoreModule.WriteUserAuditLog(c, apiHelper, "user.second_factor.webauthn.delete", result, userID, map[string]any{
+				"reason":       reason,
+				"credentialId": credentialID,
+			})
+		}()
+
+		var payload passwordPayload
+		if err := c.Bind().Body(&payload); err != nil {
+			reason = "invalid_payload"
+			return harukiAPIHelper.ErrorBadRequest(c, "invalid request payload")
+		}
+		ctx := harukiAPIHelper.WithHTTPRequestMetadata(c.Context(), c.Get("User-Agent"), c.IP())
+		if _, failure, respond := loadVerifiedUser(c, ctx, apiHelper, userID, payload.Password); respond != nil {
+			reason = failure
+			return respond()
+		}
+
+		removed, err := secondfactor.DeleteWebAuthnCredential(ctx, apiHelper.DBManager.DB, userID, credentialID)
+		if err != nil {
+			reason = "delete_webauthn_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to remove security key")
+		}
+		if !removed {
+			reason = "not_found"
+			return harukiAPIHelper.ErrorNotFound(c, "security key not found")
+		}
+		result = harukiAPIHelper.SystemLogResultSuccess
+		reason = "ok"
+		return harukiAPIHelper.SuccessResponse[string](c, "security key removed", nil)
+	}
+}
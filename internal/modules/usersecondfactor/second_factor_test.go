@@ -0,0 +1,136 @@
+package usersecondfactor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/secondfactor"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// newKratosServer accepts the password "secret" for identity-1.
+func newKratosServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/identities/identity-1":
+			_, _ = w.Write([]byte(`{"id":"identity-1","traits":{"email":"u1@example.com"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/self-service/login/api":
+			_, _ = w.Write([]byte(`{"id":"flow-1"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/self-service/login":
+			var payload map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if payload["password"] != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"reason":"the provided credentials are invalid"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"session_token":"token-1"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/sessions/whoami":
+			_, _ = w.Write([]byte(`{"id":"session-1","active":true,"identity":{"id":"identity-1","traits":{"email":"u1@example.com"}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/admin/sessions/session-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTOTPEnrollmentRoutes(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:user-second-factor-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	redisClient := goredis.NewClient(&goredis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+	if _, err := client.User.Create().SetID("u1").SetName("u1").SetEmail("u1@example.com").SetKratosIdentityID("identity-1").Save(t.Context()); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	kratosServer := newKratosServer(t)
+	sessionHandler := harukiAPIHelper.NewSessionHandler(redisClient, "local-sign-key")
+	sessionHandler.ConfigureIdentityProvider("kratos", kratosServer.URL, kratosServer.URL, "X-Session-Token", "ory_kratos_session", true, true, 2*time.Second, nil)
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	originalNow := secondFactorNow
+	secondFactorNow = func() time.Time { return now }
+	t.Cleanup(func() { secondFactorNow = originalNow })
+
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager:      &database.HarukiToolboxDBManager{DB: client, Redis: &harukiRedis.HarukiRedisManager{Redis: redisClient}},
+		SessionHandler: sessionHandler,
+	}
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", "u1")
+		return c.Next()
+	})
+	app.Get("/second-factor", handleGetSecondFactorStatus(helper))
+	app.Post("/second-factor/totp", handleBeginTOTPEnrollment(helper))
+	app.Post("/second-factor/totp/confirm", handleConfirmTOTPEnrollment(helper))
+	app.Delete("/second-factor/totp", handleDisableTOTP(helper))
+	app.Post("/second-factor/recovery-codes", handleRegenerateRecoveryCodes(helper))
+	app.Post("/second-factor/webauthn/register", handleBeginWebAuthnRegistration(helper))
+
+	do := func(method, path, body string) (int, map[string]any) {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test returned error: %v", err)
+		}
+		var decoded map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&decoded)
+		data, _ := decoded["updatedData"].(map[string]any)
+		return resp.StatusCode, data
+	}
+
+	if status, _ := do(http.MethodPost, "/second-factor/totp", `{"password":"wrong"}`); status != fiber.StatusForbidden {
+		t.Fatalf("begin with wrong password status = %d, want 403", status)
+	}
+	status, data := do(http.MethodPost, "/second-factor/totp", `{"password":"secret"}`)
+	secret, _ := data["secret"].(string)
+	if status != fiber.StatusOK || secret == "" || !strings.HasPrefix(data["uri"].(string), "otpauth://totp/") {
+		t.Fatalf("begin status = %d, data = %v", status, data)
+	}
+	if status, _ := do(http.MethodPost, "/second-factor/recovery-codes", `{"password":"secret"}`); status != fiber.StatusConflict {
+		t.Fatalf("regenerate before enrollment status = %d, want 409", status)
+	}
+
+	code, _ := secondfactor.TOTPCode(secret, now)
+	status, data = do(http.MethodPost, "/second-factor/totp/confirm", `{"code":"`+code+`"}`)
+	codes, _ := data["recoveryCodes"].([]any)
+	if status != fiber.StatusOK || len(codes) != secondfactor.RecoveryCodeCount {
+		t.Fatalf("confirm status = %d, data = %v", status, data)
+	}
+
+	status, data = do(http.MethodGet, "/second-factor", "")
+	if status != fiber.StatusOK || data["enrolled"] != true || data["totpEnabled"] != true || data["webauthnAvailable"] != false {
+		t.Fatalf("status = %d, data = %v", status, data)
+	}
+	if status, _ := do(http.MethodPost, "/second-factor/webauthn/register", `{"password":"secret","name":"key"}`); status != fiber.StatusServiceUnavailable {
+		t.Fatalf("webauthn register without configuration status = %d, want 503", status)
+	}
+
+	if status, _ := do(http.MethodDelete, "/second-factor/totp", `{"password":"secret"}`); status != fiber.StatusOK {
+		t.Fatalf("disable status = %d, want 200", status)
+	}
+	if status, _ := do(http.MethodDelete, "/second-factor/totp", `{"password":"secret"}`); status != fiber.StatusNotFound {
+		t.Fatalf("second disable status = %d, want 404", status)
+	}
+}
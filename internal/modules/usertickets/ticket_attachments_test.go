@@ -0,0 +1,140 @@
+package usertickets
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newUserTicketAttachmentTestApp(t *testing.T) (*fiber.App, *harukiAPIHelper.HarukiToolboxRouterHelpers) {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:user-ticket-attachment-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore returned error: %v", err)
+	}
+	helper := &harukiAPIHelper.HarukiToolboxRouterHelpers{
+		DBManager: &database.HarukiToolboxDBManager{DB: client},
+		BlobStore: store,
+	}
+	for _, id := range []string{"user-1", "user-2"} {
+		if _, err := client.User.Create().SetID(id).SetName(id).SetEmail(id + "@example.com").Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed user: %v", err)
+		}
+	}
+
+	app := fiber.New()
+	app.Use(func(c fiber.Ctx) error {
+		c.Locals("userID", c.Get("X-Test-User"))
+		return c.Next()
+	})
+	app.Post("/tickets", handleCreateOwnTicket(helper))
+	app.Get("/tickets/:ticket_id", handleGetOwnTicketDetail(helper))
+	app.Get("/tickets/:ticket_id/attachments/:attachment_id", handleDownloadOwnTicketAttachment(helper, false))
+	app.Get("/tickets/:ticket_id/attachments/:attachment_id/thumbnail", handleDownloadOwnTicketAttachment(helper, true))
+	return app, helper
+}
+
+func doUserTicketRequest(t *testing.T, app *fiber.App, req *http.Request, userID string) *http.Response {
+	t.Helper()
+	req.Header.Set("X-Test-User", userID)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test returned error: %v", err)
+	}
+	return resp
+}
+
+func TestUserTicketAttachmentsAreGatedToCreator(t *testing.T) {
+	ticketattachments.Configure(ticketattachments.DefaultOptions())
+	app, helper := newUserTicketAttachmentTestApp(t)
+
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatalf("png.Encode returned error: %v", err)
+	}
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	_ = writer.WriteField("subject", "upload fails")
+	_ = writer.WriteField("message", "see screenshot")
+	part, _ := writer.CreateFormFile(ticketattachments.FormFieldFiles, "screen.png")
+	_, _ = part.Write(pngData.Bytes())
+	_ = writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/tickets", &body)
+	req.Header.Set(fiber.HeaderContentType, writer.FormDataContentType())
+	resp := doUserTicketRequest(t, app, req, "user-1")
+	if resp.StatusCode != fiber.StatusOK {
+		raw, _ := io.ReadAll(resp.Body)
+		t.Fatalf("create status = %d, body = %s", resp.StatusCode, raw)
+	}
+	var created struct {
+		UpdatedData createUserTicketResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode create response: %v", err)
+	}
+	ticketID := created.UpdatedData.TicketID
+
+	resp = doUserTicketRequest(t, app, httptest.NewRequest(http.MethodGet, "/tickets/"+ticketID, nil), "user-1")
+	var detail struct {
+		UpdatedData userTicketDetailResponse `json:"updatedData"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&detail); err != nil {
+		t.Fatalf("decode detail response: %v", err)
+	}
+	if len(detail.UpdatedData.Messages) != 1 || len(detail.UpdatedData.Messages[0].Attachments) != 1 {
+		t.Fatalf("unexpected detail messages: %+v", detail.UpdatedData.Messages)
+	}
+	attachment := detail.UpdatedData.Messages[0].Attachments[0]
+	if attachment.ContentType != "image/png" || !attachment.HasThumbnail || attachment.FileName != "screen.png" {
+		t.Fatalf("unexpected attachment item: %+v", attachment)
+	}
+
+	downloadPath := "/tickets/" + ticketID + "/attachments/" + attachment.ID
+	resp = doUserTicketRequest(t, app, httptest.NewRequest(http.MethodGet, downloadPath, nil), "user-1")
+	if resp.StatusCode != fiber.StatusOK || resp.Header.Get(fiber.HeaderContentType) != "image/png" {
+		t.Fatalf("creator download = %d %q", resp.StatusCode, resp.Header.Get(fiber.HeaderContentType))
+	}
+	resp = doUserTicketRequest(t, app, httptest.NewRequest(http.MethodGet, downloadPath+"/thumbnail", nil), "user-1")
+	if resp.StatusCode != fiber.StatusOK || resp.Header.Get(fiber.HeaderContentType) != "image/jpeg" {
+		t.Fatalf("creator thumbnail = %d %q", resp.StatusCode, resp.Header.Get(fiber.HeaderContentType))
+	}
+
+	resp = doUserTicketRequest(t, app, httptest.NewRequest(http.MethodGet, downloadPath, nil), "user-2")
+	if resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("other user download status = %d, want 404", resp.StatusCode)
+	}
+
+	// Attachments on internal admin notes stay hidden from the creator.
+	if _, err := helper.DBManager.DB.TicketMessage.Update().
+		Where(ticketmessage.HasAttachments()).
+		SetInternal(true).
+		Save(t.Context()); err != nil {
+		t.Fatalf("failed to mark message internal: %v", err)
+	}
+	resp = doUserTicketRequest(t, app, httptest.NewRequest(http.MethodGet, downloadPath, nil), "user-1")
+	if resp.StatusCode != fiber.StatusNotFound {
+		t.Fatalf("internal attachment download status = %d, want 404", resp.StatusCode)
+	}
+}
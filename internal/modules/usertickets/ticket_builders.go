@@ -3,6 +3,7 @@ package usertickets
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"time"
 )
@@ -41,10 +42,11 @@ func buildUserTicketMessageItems(rows []*postgresql.TicketMessage) []userTicketM
 	items := make([]userTicketMessageItem, 0, len(rows))
 	for _, row := range rows {
 		items = append(items, userTicketMessageItem{
-			ID:         row.ID,
-			SenderRole: string(row.SenderRole),
-			Message:    row.Message,
-			CreatedAt:  row.CreatedAt.UTC(),
+			ID:          row.ID,
+			SenderRole:  string(row.SenderRole),
+			Message:     row.Message,
+			Attachments: ticketattachments.BuildItems(row.Edges.Attachments),
+			CreatedAt:   row.CreatedAt.UTC(),
 		})
 	}
 	return items
@@ -3,10 +3,13 @@ package usertickets
 import (
 	userCoreModule "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/usercore"
 	platformPagination "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
 	platformTicketNotifications "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketnotifications"
+	platformTicketSLA "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketsla"
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 	"math"
 	"strings"
@@ -64,6 +67,11 @@ func handleCreateOwnTicket(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
 			}
 			return harukiAPIHelper.ErrorBadRequest(c, "invalid category")
 		}
+		attachments, err := ticketattachments.PrepareRequest(c)
+		if err != nil {
+			reason = "invalid_attachments"
+			return respondUserTicketBadRequest(c, err, "invalid attachments")
+		}
 
 		ticketID, err := generateTicketPublicID()
 		if err != nil {
@@ -96,20 +104,30 @@ func handleCreateOwnTicket(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers
 			return harukiAPIHelper.ErrorInternal(c, "failed to create ticket")
 		}
 
-		if _, err := tx.TicketMessage.Create().
+		createdMessage, err := tx.TicketMessage.Create().
 			SetTicketID(createdTicket.ID).
 			SetSenderUserID(userID).
 			SetSenderRole(ticketmessage.SenderRoleUser).
 			SetInternal(false).
 			SetMessage(message).
-			Save(c.Context()); err != nil {
+			Save(c.Context())
+		if err != nil {
 			_ = tx.Rollback()
 			reason = "create_ticket_message_failed"
 			return harukiAPIHelper.ErrorInternal(c, "failed to create ticket")
 		}
 
+		_, blobKeys, err := ticketattachments.Save(c.Context(), apiHelper.BlobStore, tx, createdTicket.ID, createdMessage.ID, userID, attachments)
+		if err != nil {
+			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
+			reason = "save_attachments_failed"
+			return harukiAPIHelper.ErrorInternal(c, "failed to save ticket attachments")
+		}
+
 		if err := tx.Commit(); err != nil {
 			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
 			reason = "commit_failed"
 			return harukiAPIHelper.ErrorInternal(c, "failed to create ticket")
 		}
@@ -211,7 +229,11 @@ func handleGetOwnTicketDetail(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 				ticket.CreatorUserIDEQ(userID),
 			).
 			WithMessages(func(q *postgresql.TicketMessageQuery) {
-				q.Where(ticketmessage.InternalEQ(false)).Order(ticketmessage.ByCreatedAt(sql.OrderAsc()), ticketmessage.ByID(sql.OrderAsc()))
+				q.Where(ticketmessage.InternalEQ(false)).
+					Order(ticketmessage.ByCreatedAt(sql.OrderAsc()), ticketmessage.ByID(sql.OrderAsc())).
+					WithAttachments(func(aq *postgresql.TicketAttachmentQuery) {
+						aq.Order(ticketattachment.ByCreatedAt(sql.OrderAsc()), ticketattachment.ByID(sql.OrderAsc()))
+					})
 			}).
 			Only(c.Context())
 		if err != nil {
@@ -248,6 +270,10 @@ func handleAppendOwnTicketMessage(apiHelper *harukiAPIHelper.HarukiToolboxRouter
 		if err != nil {
 			return respondUserTicketBadRequest(c, err, "invalid message")
 		}
+		attachments, err := ticketattachments.PrepareRequest(c)
+		if err != nil {
+			return respondUserTicketBadRequest(c, err, "invalid attachments")
+		}
 
 		row, err := queryOwnTicketByPublicID(c, apiHelper, userID, publicTicketID)
 		if err != nil {
@@ -277,18 +303,29 @@ func handleAppendOwnTicketMessage(apiHelper *harukiAPIHelper.HarukiToolboxRouter
 			return harukiAPIHelper.ErrorInternal(c, "failed to append ticket message")
 		}
 
+		savedAttachments, blobKeys, err := ticketattachments.Save(c.Context(), apiHelper.BlobStore, tx, row.ID, createdMessage.ID, userID, attachments)
+		if err != nil {
+			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
+			return harukiAPIHelper.ErrorInternal(c, "failed to save ticket attachments")
+		}
+		createdMessage.Edges.Attachments = savedAttachments
+
 		update := tx.Ticket.UpdateOneID(row.ID).
 			SetStatus(ticket.StatusPendingAdmin)
 		if row.ClosedAt != nil {
 			update.ClearClosedAt()
 		}
+		platformTicketSLA.ApplyStatusChange(update, row, ticket.StatusPendingAdmin, time.Now().UTC())
 		if _, err := update.Save(c.Context()); err != nil {
 			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
 			return harukiAPIHelper.ErrorInternal(c, "failed to update ticket status")
 		}
 
 		if err := tx.Commit(); err != nil {
 			_ = tx.Rollback()
+			ticketattachments.Discard(c.Context(), apiHelper.BlobStore, blobKeys)
 			return harukiAPIHelper.ErrorInternal(c, "failed to append ticket message")
 		}
 
@@ -319,10 +356,12 @@ func handleCloseOwnTicket(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 			return harukiAPIHelper.ErrorInternal(c, "failed to query ticket")
 		}
 
+		now := time.Now().UTC()
 		update := row.Update().SetStatus(ticket.StatusClosed)
 		if row.ClosedAt == nil {
-			update.SetClosedAt(time.Now().UTC())
+			update.SetClosedAt(now)
 		}
+		platformTicketSLA.ApplyStatusChange(update, row, ticket.StatusClosed, now)
 		updated, err := update.Save(c.Context())
 		if err != nil {
 			return harukiAPIHelper.ErrorInternal(c, "failed to close ticket")
@@ -331,3 +370,32 @@ func handleCloseOwnTicket(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers)
 		return harukiAPIHelper.SuccessResponse(c, "ticket closed", &resp)
 	}
 }
+
+func handleDownloadOwnTicketAttachment(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, thumbnail bool) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		userID, err := userCoreModule.CurrentUserID(c)
+		if err != nil {
+			return harukiAPIHelper.ErrorUnauthorized(c, "user not authenticated")
+		}
+		publicTicketID := strings.TrimSpace(c.Params("ticket_id"))
+		attachmentID := strings.TrimSpace(c.Params("attachment_id"))
+		if publicTicketID == "" || attachmentID == "" {
+			return harukiAPIHelper.ErrorBadRequest(c, "ticket_id and attachment_id are required")
+		}
+
+		row, err := queryOwnTicketAttachment(c, apiHelper, userID, publicTicketID, attachmentID)
+		if err != nil {
+			if postgresql.IsNotFound(err) {
+				return harukiAPIHelper.ErrorNotFound(c, "attachment not found")
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to query attachment")
+		}
+		if err := ticketattachments.Send(c, apiHelper.BlobStore, row, thumbnail); err != nil {
+			if fiberErr, ok := err.(*fiber.Error); ok {
+				return harukiAPIHelper.UpdatedDataResponse[string](c, fiberErr.Code, fiberErr.Message, nil)
+			}
+			return harukiAPIHelper.ErrorInternal(c, "failed to read attachment")
+		}
+		return nil
+	}
+}
@@ -4,6 +4,8 @@ import (
 	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -16,3 +18,16 @@ func queryOwnTicketByPublicID(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiTool
 		).
 		Only(c.Context())
 }
+
+func queryOwnTicketAttachment(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID, publicTicketID, attachmentID string) (*postgresql.TicketAttachment, error) {
+	return apiHelper.DBManager.DB.TicketAttachment.Query().
+		Where(
+			ticketattachment.IDEQ(attachmentID),
+			ticketattachment.HasTicketWith(
+				ticket.TicketIDEQ(publicTicketID),
+				ticket.CreatorUserIDEQ(userID),
+			),
+			ticketattachment.HasMessageWith(ticketmessage.InternalEQ(false)),
+		).
+		Only(c.Context())
+}
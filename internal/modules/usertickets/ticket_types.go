@@ -1,6 +1,10 @@
 package usertickets
 
-import "time"
+import (
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketattachments"
+)
 
 type userTicketListItem struct {
 	TicketID        string     `json:"ticketId"`
@@ -25,10 +29,11 @@ type userTicketListResponse struct {
 }
 
 type userTicketMessageItem struct {
-	ID         int       `json:"id"`
-	SenderRole string    `json:"senderRole"`
-	Message    string    `json:"message"`
-	CreatedAt  time.Time `json:"createdAt"`
+	ID          int                      `json:"id"`
+	SenderRole  string                   `json:"senderRole"`
+	Message     string                   `json:"message"`
+	Attachments []ticketattachments.Item `json:"attachments,omitempty"`
+	CreatedAt   time.Time                `json:"createdAt"`
 }
 
 type userTicketDetailResponse struct {
@@ -37,11 +42,11 @@ type userTicketDetailResponse struct {
 }
 
 type createUserTicketPayload struct {
-	Subject  string         `json:"subject"`
-	Category string         `json:"category,omitempty"`
-	Priority string         `json:"priority,omitempty"`
-	Message  string         `json:"message"`
-	Metadata map[string]any `json:"metadata,omitempty"`
+	Subject  string         `json:"subject" form:"subject"`
+	Category string         `json:"category,omitempty" form:"category"`
+	Priority string         `json:"priority,omitempty" form:"priority"`
+	Message  string         `json:"message" form:"message"`
+	Metadata map[string]any `json:"metadata,omitempty" form:"-"`
 }
 
 type createUserTicketResponse struct {
@@ -49,5 +54,5 @@ type createUserTicketResponse struct {
 }
 
 type appendUserTicketMessagePayload struct {
-	Message string `json:"message"`
+	Message string `json:"message" form:"message"`
 }
@@ -12,4 +12,6 @@ func RegisterUserTicketRoutes(apiHelper *harukiAPIHelper.HarukiToolboxRouterHelp
 	r.Get("/:ticket_id", handleGetOwnTicketDetail(apiHelper))
 	r.Post("/:ticket_id/messages", handleAppendOwnTicketMessage(apiHelper))
 	r.Post("/:ticket_id/close", handleCloseOwnTicket(apiHelper))
+	r.Get("/:ticket_id/attachments/:attachment_id", handleDownloadOwnTicketAttachment(apiHelper, false))
+	r.Get("/:ticket_id/attachments/:attachment_id/thumbnail", handleDownloadOwnTicketAttachment(apiHelper, true))
 }
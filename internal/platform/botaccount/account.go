@@ -0,0 +1,256 @@
+package botaccount
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	botUser "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
+
+	sql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	StatusActive    = string(botUser.StatusActive)
+	StatusRevoked   = string(botUser.StatusRevoked)
+	StatusSuspended = string(botUser.StatusSuspended)
+
+	MaxBotsPerOwner = 5
+	MaxNameLength   = 64
+	MaxReasonLength = 512
+
+	credentialBytes = 32
+)
+
+// Bot is the owner and admin view of a bot registration. The credential
+// hash never leaves the package.
+type Bot struct {
+	BotID               int        `json:"botId"`
+	OwnerUserID         int64      `json:"ownerUserId"`
+	Name                string     `json:"name"`
+	Status              string     `json:"status"`
+	StatusReason        string     `json:"statusReason,omitempty"`
+	StatusChangedAt     *time.Time `json:"statusChangedAt,omitempty"`
+	HasCredential       bool       `json:"hasCredential"`
+	CredentialRotatedAt *time.Time `json:"credentialRotatedAt,omitempty"`
+	LastLoginIP         string     `json:"lastLoginIp,omitempty"`
+	LastLoginLocation   string     `json:"lastLoginLocation,omitempty"`
+	LastLoginAt         *time.Time `json:"lastLoginAt,omitempty"`
+	CreatedAt           *time.Time `json:"createdAt,omitempty"`
+}
+
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.UTC()
+	return &value
+}
+
+// BotFromRow converts a bot user row for API responses.
+func BotFromRow(row *neopg.User) Bot {
+	return Bot{
+		BotID:               row.BotID,
+		OwnerUserID:         row.OwnerUserID,
+		Name:                row.Name,
+		Status:              string(row.Status),
+		StatusReason:        row.StatusReason,
+		StatusChangedAt:     utcPtr(row.StatusChangedAt),
+		HasCredential:       row.Credential != "",
+		CredentialRotatedAt: utcPtr(row.CredentialRotatedAt),
+		LastLoginIP:         row.LastLoginIP,
+		LastLoginLocation:   row.LastLoginLocation,
+		LastLoginAt:         utcPtr(row.LastLoginAt),
+		CreatedAt:           utcPtr(row.CreatedAt),
+	}
+}
+
+// NormalizeName trims a bot label and checks its length.
+func NormalizeName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if len([]rune(name)) > MaxNameLength {
+		return "", fiber.NewError(fiber.StatusBadRequest, "name is too long")
+	}
+	return name, nil
+}
+
+// NormalizeReason trims a revoke or suspend reason and checks its length.
+func NormalizeReason(raw string) (string, error) {
+	reason := strings.TrimSpace(raw)
+	if len([]rune(reason)) > MaxReasonLength {
+		return "", fiber.NewError(fiber.StatusBadRequest, "reason is too long")
+	}
+	return reason, nil
+}
+
+// NewCredential returns a random plaintext credential and the bcrypt hash
+// stored for it. The plaintext is only ever shown once.
+func NewCredential() (string, string, error) {
+	raw := make([]byte, credentialBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	plain := base64.URLEncoding.EncodeToString(raw)
+	hashed, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+	return plain, string(hashed), nil
+}
+
+// GetBot loads a bot by bot_id, or returns a 404 fiber error.
+func GetBot(ctx context.Context, db *neopg.Client, botID int) (*neopg.User, error) {
+	row, err := db.User.Query().Where(botUser.BotIDEQ(botID)).Only(ctx)
+	if err != nil {
+		if neopg.IsNotFound(err) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "bot not found")
+		}
+		return nil, err
+	}
+	return row, nil
+}
+
+// ListBots returns the given bots in ascending bot_id order.
+func ListBots(ctx context.Context, db *neopg.Client, botIDs []int) ([]Bot, error) {
+	if len(botIDs) == 0 {
+		return []Bot{}, nil
+	}
+	rows, err := db.User.Query().
+		Where(botUser.BotIDIn(botIDs...)).
+		Order(botUser.ByBotID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bots := make([]Bot, 0, len(rows))
+	for _, row := range rows {
+		bots = append(bots, BotFromRow(row))
+	}
+	return bots, nil
+}
+
+// ListOwnerBots returns the bots registered with ownerQQ that have not been
+// revoked, in ascending bot_id order.
+func ListOwnerBots(ctx context.Context, db *neopg.Client, ownerQQ int64) ([]*neopg.User, error) {
+	return db.User.Query().
+		Where(
+			botUser.OwnerUserIDEQ(ownerQQ),
+			botUser.StatusNEQ(botUser.StatusRevoked),
+		).
+		Order(botUser.ByBotID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func statusConflict(row *neopg.User) error {
+	switch row.Status {
+	case botUser.StatusRevoked:
+		return fiber.NewError(fiber.StatusConflict, "bot has been revoked")
+	case botUser.StatusSuspended:
+		return fiber.NewError(fiber.StatusForbidden, "bot is suspended by an administrator")
+	}
+	return fiber.NewError(fiber.StatusConflict, "bot is already active")
+}
+
+// updateIfStatus applies update to botID only while it still has status
+// from, so two concurrent transitions cannot both succeed.
+func updateIfStatus(ctx context.Context, db *neopg.Client, botID int, from botUser.Status, update func(*neopg.UserUpdate) *neopg.UserUpdate) error {
+	affected, err := update(db.User.Update().Where(botUser.BotIDEQ(botID), botUser.StatusEQ(from))).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fiber.NewError(fiber.StatusConflict, "bot status changed, please retry")
+	}
+	return nil
+}
+
+// RotateCredential replaces the credential of an active bot and returns the
+// new plaintext. The previous credential stops working immediately.
+func RotateCredential(ctx context.Context, db *neopg.Client, botID int, now time.Time) (string, error) {
+	row, err := GetBot(ctx, db, botID)
+	if err != nil {
+		return "", err
+	}
+	if row.Status != botUser.StatusActive {
+		return "", statusConflict(row)
+	}
+	plain, hashed, err := NewCredential()
+	if err != nil {
+		return "", err
+	}
+	err = updateIfStatus(ctx, db, botID, botUser.StatusActive, func(update *neopg.UserUpdate) *neopg.UserUpdate {
+		return update.SetCredential(hashed).SetCredentialRotatedAt(now)
+	})
+	if err != nil {
+		return "", err
+	}
+	return plain, nil
+}
+
+// Revoke permanently retires an active bot on its owner's request and
+// clears its credential. A revoked bot no longer counts towards
+// MaxBotsPerOwner.
+func Revoke(ctx context.Context, db *neopg.Client, botID int, actorID, reason string, now time.Time) error {
+	row, err := GetBot(ctx, db, botID)
+	if err != nil {
+		return err
+	}
+	if row.Status != botUser.StatusActive {
+		return statusConflict(row)
+	}
+	return updateIfStatus(ctx, db, botID, botUser.StatusActive, func(update *neopg.UserUpdate) *neopg.UserUpdate {
+		return update.
+			SetStatus(botUser.StatusRevoked).
+			SetStatusReason(reason).
+			SetStatusChangedBy(actorID).
+			SetStatusChangedAt(now).
+			SetCredential("")
+	})
+}
+
+// Suspend blocks an active bot and clears its credential, so the bot server
+// rejects it as well.
+func Suspend(ctx context.Context, db *neopg.Client, botID int, actorID, reason string, now time.Time) error {
+	row, err := GetBot(ctx, db, botID)
+	if err != nil {
+		return err
+	}
+	if row.Status != botUser.StatusActive {
+		if row.Status == botUser.StatusSuspended {
+			return fiber.NewError(fiber.StatusConflict, "bot is already suspended")
+		}
+		return statusConflict(row)
+	}
+	return updateIfStatus(ctx, db, botID, botUser.StatusActive, func(update *neopg.UserUpdate) *neopg.UserUpdate {
+		return update.
+			SetStatus(botUser.StatusSuspended).
+			SetStatusReason(reason).
+			SetStatusChangedBy(actorID).
+			SetStatusChangedAt(now).
+			SetCredential("")
+	})
+}
+
+// Unsuspend makes a suspended bot active again. Its credential stays empty
+// until the owner rotates or registers again.
+func Unsuspend(ctx context.Context, db *neopg.Client, botID int, actorID string, now time.Time) error {
+	row, err := GetBot(ctx, db, botID)
+	if err != nil {
+		return err
+	}
+	if row.Status != botUser.StatusSuspended {
+		return fiber.NewError(fiber.StatusConflict, "bot is not suspended")
+	}
+	return updateIfStatus(ctx, db, botID, botUser.StatusSuspended, func(update *neopg.UserUpdate) *neopg.UserUpdate {
+		return update.
+			SetStatus(botUser.StatusActive).
+			SetStatusReason("").
+			SetStatusChangedBy(actorID).
+			SetStatusChangedAt(now)
+	})
+}
@@ -0,0 +1,98 @@
+package botaccount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	neopgEnttest "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func expectFiberStatus(t *testing.T, err error, want int) {
+	t.Helper()
+	fiberErr, ok := err.(*fiber.Error)
+	if !ok || fiberErr.Code != want {
+		t.Fatalf("error = %v, want fiber error %d", err, want)
+	}
+}
+
+func TestCredentialLifecycle(t *testing.T) {
+	db := neopgEnttest.Open(t, "sqlite3", "file:bot-account-lifecycle-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	ctx := t.Context()
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, botID := range []int{10000001, 10000002} {
+		if _, err := db.User.Create().SetOwnerUserID(111).SetBotID(botID).SetCredential("old-hash").Save(ctx); err != nil {
+			t.Fatalf("failed to seed bot: %v", err)
+		}
+	}
+
+	owned, err := ListOwnerBots(ctx, db, 111)
+	if err != nil || len(owned) != 2 {
+		t.Fatalf("ListOwnerBots = %d bots, %v; want 2 bots of one owner", len(owned), err)
+	}
+
+	plain, err := RotateCredential(ctx, db, 10000001, now)
+	if err != nil {
+		t.Fatalf("RotateCredential returned error: %v", err)
+	}
+	row, err := GetBot(ctx, db, 10000001)
+	if err != nil {
+		t.Fatalf("GetBot returned error: %v", err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(row.Credential), []byte(plain)) != nil {
+		t.Fatalf("rotated credential does not match stored hash")
+	}
+	if row.CredentialRotatedAt == nil || !row.CredentialRotatedAt.Equal(now) {
+		t.Fatalf("credential_rotated_at = %v, want %v", row.CredentialRotatedAt, now)
+	}
+
+	if err := Suspend(ctx, db, 10000001, "admin", "spam", now); err != nil {
+		t.Fatalf("Suspend returned error: %v", err)
+	}
+	bot := BotFromRow(mustGetBot(t, db, 10000001))
+	if bot.Status != StatusSuspended || bot.HasCredential || bot.StatusReason != "spam" {
+		t.Fatalf("suspended bot = %+v", bot)
+	}
+	_, err = RotateCredential(ctx, db, 10000001, now)
+	expectFiberStatus(t, err, fiber.StatusForbidden)
+	expectFiberStatus(t, Revoke(ctx, db, 10000001, "owner", "", now), fiber.StatusForbidden)
+	expectFiberStatus(t, Suspend(ctx, db, 10000001, "admin", "again", now), fiber.StatusConflict)
+
+	if err := Unsuspend(ctx, db, 10000001, "admin", now); err != nil {
+		t.Fatalf("Unsuspend returned error: %v", err)
+	}
+	bot = BotFromRow(mustGetBot(t, db, 10000001))
+	if bot.Status != StatusActive || bot.HasCredential {
+		t.Fatalf("unsuspended bot should be active without a credential, got %+v", bot)
+	}
+	expectFiberStatus(t, Unsuspend(ctx, db, 10000001, "admin", now), fiber.StatusConflict)
+
+	if err := Revoke(ctx, db, 10000002, "owner", "leaked", now); err != nil {
+		t.Fatalf("Revoke returned error: %v", err)
+	}
+	_, err = RotateCredential(ctx, db, 10000002, now)
+	expectFiberStatus(t, err, fiber.StatusConflict)
+	owned, err = ListOwnerBots(ctx, db, 111)
+	if err != nil || len(owned) != 1 || owned[0].BotID != 10000001 {
+		t.Fatalf("revoked bot should no longer be listed for registration, got %d bots, %v", len(owned), err)
+	}
+
+	_, err = GetBot(ctx, db, 10000003)
+	expectFiberStatus(t, err, fiber.StatusNotFound)
+}
+
+func mustGetBot(t *testing.T, db *neopg.Client, botID int) *neopg.User {
+	t.Helper()
+	row, err := GetBot(t.Context(), db, botID)
+	if err != nil {
+		t.Fatalf("GetBot(%d) returned error: %v", botID, err)
+	}
+	return row
+}
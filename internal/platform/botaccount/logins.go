@@ -0,0 +1,127 @@
+package botaccount
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const (
+	// LoginSourceReported is a login the bot client reported to the bot
+	// server, copied from user.last_login_*.
+	LoginSourceReported = "reported"
+	// LoginSourceToolbox is a bot authenticating against the toolbox
+	// backend, with the IP the backend saw.
+	LoginSourceToolbox = "toolbox"
+
+	DefaultLoginLimit = 20
+	MaxLoginLimit     = 100
+
+	// loginDedupWindow keeps a polling bot from writing a row per request:
+	// a login from the same IP and source is recorded at most this often.
+	loginDedupWindow = time.Hour
+)
+
+type Login struct {
+	IP         string    `json:"ip"`
+	Location   string    `json:"location,omitempty"`
+	Source     string    `json:"source"`
+	LoggedInAt time.Time `json:"loggedInAt"`
+}
+
+func truncateRunes(value string, max int) string {
+	runes := []rune(strings.TrimSpace(value))
+	if len(runes) > max {
+		runes = runes[:max]
+	}
+	return string(runes)
+}
+
+// RecordLogin stores a login unless the same IP already logged in from the
+// same source within the last hour. It reports whether a row was written.
+func RecordLogin(ctx context.Context, db *neopg.Client, botID int, ip, location, source string, at time.Time) (bool, error) {
+	ip = truncateRunes(ip, 64)
+	exists, err := db.BotLoginHistory.Query().
+		Where(
+			botloginhistory.BotIDEQ(botID),
+			botloginhistory.SourceEQ(source),
+			botloginhistory.IPEQ(ip),
+			botloginhistory.LoggedInAtGT(at.Add(-loginDedupWindow)),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return false, err
+	}
+	_, err = db.BotLoginHistory.Create().
+		SetBotID(botID).
+		SetIP(ip).
+		SetLocation(truncateRunes(location, 256)).
+		SetSource(source).
+		SetLoggedInAt(at).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// importReportedLogin copies the login the bot server last wrote to the user
+// row into the history, unless it is already there.
+func importReportedLogin(ctx context.Context, db *neopg.Client, row *neopg.User) error {
+	if row.LastLoginAt == nil {
+		return nil
+	}
+	exists, err := db.BotLoginHistory.Query().
+		Where(
+			botloginhistory.BotIDEQ(row.BotID),
+			botloginhistory.SourceEQ(LoginSourceReported),
+			botloginhistory.LoggedInAtGTE(*row.LastLoginAt),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	_, err = db.BotLoginHistory.Create().
+		SetBotID(row.BotID).
+		SetIP(truncateRunes(row.LastLoginIP, 64)).
+		SetLocation(truncateRunes(row.LastLoginLocation, 256)).
+		SetSource(LoginSourceReported).
+		SetLoggedInAt(*row.LastLoginAt).
+		Save(ctx)
+	return err
+}
+
+// ListLogins returns the most recent logins of a bot, newest first. The
+// bot server only keeps the last login on the user row, so it is folded into
+// the history every time the history is read.
+func ListLogins(ctx context.Context, db *neopg.Client, row *neopg.User, limit int) ([]Login, error) {
+	if err := importReportedLogin(ctx, db, row); err != nil {
+		return nil, err
+	}
+	rows, err := db.BotLoginHistory.Query().
+		Where(botloginhistory.BotIDEQ(row.BotID)).
+		Order(
+			botloginhistory.ByLoggedInAt(sql.OrderDesc()),
+			botloginhistory.ByID(sql.OrderDesc()),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logins := make([]Login, 0, len(rows))
+	for _, entry := range rows {
+		logins = append(logins, Login{
+			IP:         entry.IP,
+			Location:   entry.Location,
+			Source:     entry.Source,
+			LoggedInAt: entry.LoggedInAt.UTC(),
+		})
+	}
+	return logins, nil
+}
@@ -0,0 +1,66 @@
+package botaccount
+
+import (
+	"testing"
+	"time"
+
+	neopgEnttest "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+)
+
+func TestRecordLoginDeduplicatesAndListImportsReportedLogin(t *testing.T) {
+	db := neopgEnttest.Open(t, "sqlite3", "file:bot-account-logins-test?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	ctx := t.Context()
+	base := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, tc := range []struct {
+		ip    string
+		at    time.Time
+		wrote bool
+	}{
+		{ip: "203.0.113.1", at: base, wrote: true},
+		{ip: "203.0.113.1", at: base.Add(30 * time.Minute), wrote: false},
+		{ip: "198.51.100.7", at: base.Add(40 * time.Minute), wrote: true},
+		{ip: "203.0.113.1", at: base.Add(2 * time.Hour), wrote: true},
+	} {
+		wrote, err := RecordLogin(ctx, db, 10000001, tc.ip, "", LoginSourceToolbox, tc.at)
+		if err != nil {
+			t.Fatalf("RecordLogin #%d returned error: %v", i, err)
+		}
+		if wrote != tc.wrote {
+			t.Fatalf("RecordLogin #%d wrote = %v, want %v", i, wrote, tc.wrote)
+		}
+	}
+
+	reportedAt := base.Add(3 * time.Hour)
+	row, err := db.User.Create().
+		SetOwnerUserID(111).
+		SetBotID(10000001).
+		SetLastLoginIP("192.0.2.9").
+		SetLastLoginLocation("Tokyo").
+		SetLastLoginAt(reportedAt).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to seed bot: %v", err)
+	}
+
+	for range 2 {
+		logins, err := ListLogins(ctx, db, row, DefaultLoginLimit)
+		if err != nil {
+			t.Fatalf("ListLogins returned error: %v", err)
+		}
+		if len(logins) != 4 {
+			t.Fatalf("len(logins) = %d, want 4 (reported login imported once)", len(logins))
+		}
+		if logins[0].Source != LoginSourceReported || logins[0].IP != "192.0.2.9" || !logins[0].LoggedInAt.Equal(reportedAt) {
+			t.Fatalf("newest login = %+v, want the reported login", logins[0])
+		}
+	}
+
+	limited, err := ListLogins(ctx, db, row, 2)
+	if err != nil || len(limited) != 2 {
+		t.Fatalf("ListLogins with limit = %d, %v", len(limited), err)
+	}
+}
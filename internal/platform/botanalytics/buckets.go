@@ -0,0 +1,105 @@
+package botanalytics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	BucketHour  = "hour"
+	BucketDay   = "day"
+	BucketWeek  = "week"
+	BucketMonth = "month"
+
+	DefaultTimezone = "UTC"
+)
+
+// ParseBucket accepts hour, day, week or month and defaults to hour.
+func ParseBucket(raw string) (string, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(raw))
+	if trimmed == "" {
+		return BucketHour, nil
+	}
+	switch trimmed {
+	case BucketHour, BucketDay, BucketWeek, BucketMonth:
+		return trimmed, nil
+	default:
+		return "", fiber.NewError(fiber.StatusBadRequest, "bucket must be one of: hour, day, week, month")
+	}
+}
+
+// ParseTimezone resolves the IANA timezone buckets are aligned to; an empty
+// value means UTC.
+func ParseTimezone(raw string) (string, *time.Location, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return DefaultTimezone, time.UTC, nil
+	}
+	loc, err := time.LoadLocation(trimmed)
+	if err != nil {
+		return "", nil, fiber.NewError(fiber.StatusBadRequest, "invalid timezone")
+	}
+	return trimmed, loc, nil
+}
+
+// truncateToBucket aligns t to the start of its bucket in loc. Weeks start on
+// Monday to match Postgres date_trunc('week', ...), so the result equals the
+// bucket_unix computed by the SQL queries for the same timezone.
+func truncateToBucket(t time.Time, bucket string, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	switch bucket {
+	case BucketDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case BucketWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case BucketMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	}
+}
+
+func nextBucket(t time.Time, bucket string) time.Time {
+	switch bucket {
+	case BucketDay:
+		return t.AddDate(0, 0, 1)
+	case BucketWeek:
+		return t.AddDate(0, 0, 7)
+	case BucketMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.Add(time.Hour)
+	}
+}
+
+// bucketStarts lists every bucket between from and to so empty buckets are
+// reported as zero instead of being missing from the curve.
+func bucketStarts(from, to time.Time, bucket string, loc *time.Location) []time.Time {
+	start := truncateToBucket(from, bucket, loc)
+	end := truncateToBucket(to, bucket, loc)
+	starts := make([]time.Time, 0)
+	for ts := start; !ts.After(end); ts = nextBucket(ts, bucket) {
+		starts = append(starts, ts)
+	}
+	return starts
+}
+
+// bucketExpressionSQL converts the timestamptz column to wall time in the
+// timezone bound at tzPlaceholder, truncates it and re-anchors it to an
+// instant, matching truncateToBucket.
+func bucketExpressionSQL(bucket, column, tzPlaceholder string) (string, error) {
+	switch bucket {
+	case BucketHour, BucketDay, BucketWeek, BucketMonth:
+	default:
+		return "", fmt.Errorf("invalid bucket %q", bucket)
+	}
+	return fmt.Sprintf("date_trunc('%s', %s AT TIME ZONE %s) AT TIME ZONE %s", bucket, column, tzPlaceholder, tzPlaceholder), nil
+}
@@ -0,0 +1,322 @@
+package botanalytics
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+
+	"entgo.io/ent/dialect"
+	"github.com/gofiber/fiber/v3"
+	"github.com/lib/pq"
+)
+
+const (
+	GroupByCommand  = "command"
+	GroupByPlatform = "platform"
+	GroupByGroup    = "group"
+
+	DefaultSeriesLimit = 10
+	MaxSeriesLimit     = 50
+
+	// MaxFallbackRows caps how many command logs the non-Postgres path reads
+	// to aggregate in memory.
+	MaxFallbackRows = 200000
+
+	// UnknownKey stands in for logs recorded without a platform, group or
+	// command.
+	UnknownKey = "unknown"
+)
+
+// ParseGroupBy accepts command, platform or group and defaults to command.
+func ParseGroupBy(raw string) (string, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(raw))
+	if trimmed == "" {
+		return GroupByCommand, nil
+	}
+	switch trimmed {
+	case GroupByCommand, GroupByPlatform, GroupByGroup:
+		return trimmed, nil
+	default:
+		return "", fiber.NewError(fiber.StatusBadRequest, "group_by must be one of: command, platform, group")
+	}
+}
+
+func groupByColumn(groupBy string) string {
+	switch groupBy {
+	case GroupByPlatform:
+		return commandlog.FieldPlatform
+	case GroupByGroup:
+		return commandlog.FieldGid
+	default:
+		return commandlog.FieldCommand
+	}
+}
+
+// CommandVolumeQuery selects the command logs created in [From, To].
+// PIDs restricts the logs to those bot instances; an empty slice means all.
+type CommandVolumeQuery struct {
+	From     time.Time
+	To       time.Time
+	Bucket   string
+	Timezone string
+	Location *time.Location
+	GroupBy  string
+	Limit    int
+	Platform string
+	Command  string
+	PIDs     []string
+}
+
+type CountPoint struct {
+	Time  time.Time `json:"time"`
+	Count int64     `json:"count"`
+}
+
+// CommandSeries is the volume of one command, platform or group.
+type CommandSeries struct {
+	Key    string       `json:"key"`
+	Total  int64        `json:"total"`
+	Points []CountPoint `json:"points"`
+}
+
+// CommandVolume reports the overall curve plus the busiest Limit keys.
+// Keys past the limit only count towards OtherTotal.
+type CommandVolume struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	From        time.Time       `json:"from"`
+	To          time.Time       `json:"to"`
+	Bucket      string          `json:"bucket"`
+	Timezone    string          `json:"timezone"`
+	GroupBy     string          `json:"groupBy"`
+	Total       int64           `json:"total"`
+	OtherTotal  int64           `json:"otherTotal"`
+	Keys        int             `json:"keys"`
+	Truncated   bool            `json:"truncated"`
+	Points      []CountPoint    `json:"points"`
+	Series      []CommandSeries `json:"series"`
+}
+
+type commandBucketCount struct {
+	bucketUnix int64
+	key        string
+	count      int64
+}
+
+// BuildCommandVolume aggregates command_logs by time bucket and the grouping
+// key. Postgres does the grouping in SQL; other dialects aggregate at most
+// MaxFallbackRows logs in memory and set Truncated past that.
+func BuildCommandVolume(ctx context.Context, db *neopg.Client, query CommandVolumeQuery, now time.Time) (*CommandVolume, error) {
+	if query.Location == nil {
+		query.Location = time.UTC
+	}
+	if query.Timezone == "" {
+		query.Timezone = DefaultTimezone
+	}
+	if query.Limit <= 0 {
+		query.Limit = DefaultSeriesLimit
+	}
+	if query.Limit > MaxSeriesLimit {
+		query.Limit = MaxSeriesLimit
+	}
+
+	var (
+		counts    []commandBucketCount
+		truncated bool
+		err       error
+	)
+	if sqlDB := db.SQLDB(); sqlDB != nil && db.Dialect() == dialect.Postgres {
+		counts, err = queryCommandCountsRawSQL(ctx, sqlDB, query)
+	} else {
+		counts, truncated, err = queryCommandCountsFallback(ctx, db, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	starts := bucketStarts(query.From, query.To, query.Bucket, query.Location)
+	index := make(map[int64]int, len(starts))
+	for i, start := range starts {
+		index[start.Unix()] = i
+	}
+	newPoints := func() []CountPoint {
+		points := make([]CountPoint, len(starts))
+		for i, start := range starts {
+			points[i] = CountPoint{Time: start}
+		}
+		return points
+	}
+
+	report := &CommandVolume{
+		GeneratedAt: now.UTC(),
+		From:        query.From.UTC(),
+		To:          query.To.UTC(),
+		Bucket:      query.Bucket,
+		Timezone:    query.Timezone,
+		GroupBy:     query.GroupBy,
+		Truncated:   truncated,
+		Points:      newPoints(),
+		Series:      []CommandSeries{},
+	}
+	totals := map[string]int64{}
+	for _, row := range counts {
+		key := row.key
+		if key == "" {
+			key = UnknownKey
+		}
+		totals[key] += row.count
+		report.Total += row.count
+		if i, ok := index[row.bucketUnix]; ok {
+			report.Points[i].Count += row.count
+		}
+	}
+	report.Keys = len(totals)
+
+	keys := make([]string, 0, len(totals))
+	for key := range totals {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if totals[keys[i]] != totals[keys[j]] {
+			return totals[keys[i]] > totals[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	top := map[string]int{}
+	for _, key := range keys {
+		if len(report.Series) >= query.Limit {
+			report.OtherTotal += totals[key]
+			continue
+		}
+		top[key] = len(report.Series)
+		report.Series = append(report.Series, CommandSeries{Key: key, Total: totals[key], Points: newPoints()})
+	}
+	for _, row := range counts {
+		key := row.key
+		if key == "" {
+			key = UnknownKey
+		}
+		seriesIndex, ok := top[key]
+		if !ok {
+			continue
+		}
+		if i, ok := index[row.bucketUnix]; ok {
+			report.Series[seriesIndex].Points[i].Count += row.count
+		}
+	}
+	return report, nil
+}
+
+func queryCommandCountsRawSQL(ctx context.Context, sqlDB *stdsql.DB, query CommandVolumeQuery) ([]commandBucketCount, error) {
+	bucketExpr, err := bucketExpressionSQL(query.Bucket, commandlog.FieldCreatedAt, "$3")
+	if err != nil {
+		return nil, err
+	}
+	conditions := []string{
+		fmt.Sprintf("%s >= $1", commandlog.FieldCreatedAt),
+		fmt.Sprintf("%s <= $2", commandlog.FieldCreatedAt),
+	}
+	args := []any{query.From.UTC(), query.To.UTC(), query.Timezone}
+	if query.Platform != "" {
+		args = append(args, query.Platform)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", commandlog.FieldPlatform, len(args)))
+	}
+	if query.Command != "" {
+		args = append(args, query.Command)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", commandlog.FieldCommand, len(args)))
+	}
+	if len(query.PIDs) > 0 {
+		args = append(args, pq.Array(query.PIDs))
+		conditions = append(conditions, fmt.Sprintf("%s = ANY($%d)", commandlog.FieldPid, len(args)))
+	}
+
+	statement := fmt.Sprintf(
+		"SELECT EXTRACT(EPOCH FROM %s)::bigint AS bucket_unix, %s AS group_key, COUNT(*)::bigint AS count FROM %s WHERE %s GROUP BY bucket_unix, group_key",
+		bucketExpr,
+		groupByColumn(query.GroupBy),
+		commandlog.Table,
+		strings.Join(conditions, " AND "),
+	)
+	rows, err := sqlDB.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var counts []commandBucketCount
+	for rows.Next() {
+		var row commandBucketCount
+		if err := rows.Scan(&row.bucketUnix, &row.key, &row.count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func queryCommandCountsFallback(ctx context.Context, db *neopg.Client, query CommandVolumeQuery) ([]commandBucketCount, bool, error) {
+	predicates := []predicate.CommandLog{
+		commandlog.CreatedAtGTE(query.From),
+		commandlog.CreatedAtLTE(query.To),
+	}
+	if query.Platform != "" {
+		predicates = append(predicates, commandlog.PlatformEQ(query.Platform))
+	}
+	if query.Command != "" {
+		predicates = append(predicates, commandlog.CommandEQ(query.Command))
+	}
+	if len(query.PIDs) > 0 {
+		predicates = append(predicates, commandlog.PidIn(query.PIDs...))
+	}
+	column := groupByColumn(query.GroupBy)
+	rows, err := db.CommandLog.Query().
+		Where(predicates...).
+		Limit(MaxFallbackRows+1).
+		Select(commandlog.FieldCreatedAt, column).
+		All(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	truncated := len(rows) > MaxFallbackRows
+	if truncated {
+		rows = rows[:MaxFallbackRows]
+	}
+
+	grouped := map[commandBucketCount]int64{}
+	for _, row := range rows {
+		key := row.Command
+		switch column {
+		case commandlog.FieldPlatform:
+			key = row.Platform
+		case commandlog.FieldGid:
+			key = row.Gid
+		}
+		bucketUnix := truncateToBucket(row.CreatedAt, query.Bucket, query.Location).Unix()
+		grouped[commandBucketCount{bucketUnix: bucketUnix, key: key}]++
+	}
+	counts := make([]commandBucketCount, 0, len(grouped))
+	for row, count := range grouped {
+		row.count = count
+		counts = append(counts, row)
+	}
+	return counts, truncated, nil
+}
+
+// PIDForBotID returns the command_logs pid a registered bot writes. HarukiBot
+// reports its bot_id as the platform instance identifier, which is what ties
+// command logs to the owner in the bot user table.
+func PIDForBotID(botID int) string {
+	return strconv.Itoa(botID)
+}
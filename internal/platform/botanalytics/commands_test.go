@@ -0,0 +1,111 @@
+package botanalytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newBotAnalyticsTestClient(t *testing.T, name string) *neopg.Client {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func TestBuildCommandVolumeGroupsByKeyAndBucket(t *testing.T) {
+	client := newBotAnalyticsTestClient(t, "bot-analytics-commands-test")
+	base := time.Date(2026, 6, 1, 10, 0, 0, 0, time.UTC)
+	seed := func(pid, gid, command string, at time.Time) {
+		t.Helper()
+		if _, err := client.CommandLog.Create().
+			SetPlatform("qq").
+			SetPid(pid).
+			SetGid(gid).
+			SetUID("u").
+			SetCommand(command).
+			SetCreatedAt(at).
+			Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed command log: %v", err)
+		}
+	}
+	seed("10000001", "g1", "/pjsk", base.Add(5*time.Minute))
+	seed("10000001", "g1", "/pjsk", base.Add(65*time.Minute))
+	seed("10000001", "g2", "/pjsk", base.Add(70*time.Minute))
+	seed("10000002", "g1", "/help", base.Add(10*time.Minute))
+	seed("10000002", "", "/event", base.Add(20*time.Minute))
+	seed("10000001", "g1", "/pjsk", base.Add(-48*time.Hour))
+
+	report, err := BuildCommandVolume(t.Context(), client, CommandVolumeQuery{
+		From:    base,
+		To:      base.Add(2 * time.Hour),
+		Bucket:  BucketHour,
+		GroupBy: GroupByCommand,
+		Limit:   2,
+	}, base)
+	if err != nil {
+		t.Fatalf("BuildCommandVolume returned error: %v", err)
+	}
+	if report.Total != 5 || report.Keys != 3 || report.OtherTotal != 1 {
+		t.Fatalf("totals = %d/%d keys/%d other, want 5/3/1", report.Total, report.Keys, report.OtherTotal)
+	}
+	if len(report.Points) != 3 || report.Points[0].Count != 3 || report.Points[1].Count != 2 || report.Points[2].Count != 0 {
+		t.Fatalf("unexpected overall points: %+v", report.Points)
+	}
+	if len(report.Series) != 2 || report.Series[0].Key != "/pjsk" || report.Series[0].Total != 3 {
+		t.Fatalf("unexpected series: %+v", report.Series)
+	}
+	if report.Series[0].Points[1].Count != 2 {
+		t.Fatalf("/pjsk second bucket = %d, want 2", report.Series[0].Points[1].Count)
+	}
+	if report.Series[1].Key != "/event" {
+		t.Fatalf("ties should order by key, got %q", report.Series[1].Key)
+	}
+
+	byGroup, err := BuildCommandVolume(t.Context(), client, CommandVolumeQuery{
+		From:    base,
+		To:      base.Add(2 * time.Hour),
+		Bucket:  BucketDay,
+		GroupBy: GroupByGroup,
+		PIDs:    []string{"10000002"},
+	}, base)
+	if err != nil {
+		t.Fatalf("BuildCommandVolume returned error: %v", err)
+	}
+	if byGroup.Total != 2 || len(byGroup.Series) != 2 || len(byGroup.Points) != 1 {
+		t.Fatalf("unexpected pid filtered report: %+v", byGroup)
+	}
+	keys := map[string]int64{}
+	for _, series := range byGroup.Series {
+		keys[series.Key] = series.Total
+	}
+	if keys["g1"] != 1 || keys[UnknownKey] != 1 {
+		t.Fatalf("unexpected group keys: %+v", keys)
+	}
+}
+
+func TestParseCommandVolumeParameters(t *testing.T) {
+	if got, err := ParseGroupBy(""); err != nil || got != GroupByCommand {
+		t.Fatalf("ParseGroupBy(\"\") = %q, %v", got, err)
+	}
+	if _, err := ParseGroupBy("uid"); err == nil {
+		t.Fatalf("expected uid grouping to be rejected")
+	}
+	if got, err := ParseBucket("DAY"); err != nil || got != BucketDay {
+		t.Fatalf("ParseBucket(DAY) = %q, %v", got, err)
+	}
+	if _, _, err := ParseTimezone("Mars/Olympus"); err == nil {
+		t.Fatalf("expected unknown timezone to be rejected")
+	}
+	expr, err := bucketExpressionSQL(BucketWeek, "created_at", "$3")
+	if err != nil || expr != "date_trunc('week', created_at AT TIME ZONE $3) AT TIME ZONE $3" {
+		t.Fatalf("bucketExpressionSQL = %q, %v", expr, err)
+	}
+}
@@ -0,0 +1,187 @@
+package botanalytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/dailyrequests"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/hourlyrequests"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/requestsranking"
+	botUser "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const (
+	DefaultTopBotsLimit = 20
+	MaxTopBotsLimit     = 100
+
+	GranularityHourly = "hourly"
+	GranularityDaily  = "daily"
+)
+
+// BotRank is one bot's lifetime request count from requests_ranking. Rank
+// is 1 plus the number of bots with strictly more requests, so ties share it.
+type BotRank struct {
+	Rank        int        `json:"rank"`
+	BotID       int        `json:"botId"`
+	Counts      int64      `json:"counts"`
+	OwnerUserID int64      `json:"ownerUserId,omitempty"`
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+}
+
+type TopBots struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	TotalBots   int       `json:"totalBots"`
+	Items       []BotRank `json:"items"`
+}
+
+// RequestCurve holds the pre-aggregated rows of hourly_requests or
+// daily_requests. Hours and days the bot never wrote are absent.
+type RequestCurve struct {
+	GeneratedAt time.Time    `json:"generatedAt"`
+	From        time.Time    `json:"from"`
+	To          time.Time    `json:"to"`
+	Granularity string       `json:"granularity"`
+	Total       int64        `json:"total"`
+	Points      []CountPoint `json:"points"`
+}
+
+// BuildTopBots returns the limit bots with the most requests.
+func BuildTopBots(ctx context.Context, db *neopg.Client, limit int, now time.Time) (*TopBots, error) {
+	if limit <= 0 {
+		limit = DefaultTopBotsLimit
+	}
+	if limit > MaxTopBotsLimit {
+		limit = MaxTopBotsLimit
+	}
+	totalBots, err := db.RequestsRanking.Query().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.RequestsRanking.Query().
+		Order(requestsranking.ByCounts(sql.OrderDesc()), requestsranking.ByBotID(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]BotRank, 0, len(rows))
+	for i, row := range rows {
+		rank := i + 1
+		if i > 0 && row.Counts == rows[i-1].Counts {
+			rank = items[i-1].Rank
+		}
+		items = append(items, BotRank{Rank: rank, BotID: row.BotID, Counts: row.Counts})
+	}
+	if err := attachBotOwners(ctx, db, items); err != nil {
+		return nil, err
+	}
+	return &TopBots{GeneratedAt: now.UTC(), TotalBots: totalBots, Items: items}, nil
+}
+
+// BuildBotRanks returns the rank of each bot in botIDs. Bots that never made
+// a request are reported with zero counts and no rank.
+func BuildBotRanks(ctx context.Context, db *neopg.Client, botIDs []int) ([]BotRank, error) {
+	rows, err := db.RequestsRanking.Query().
+		Where(requestsranking.BotIDIn(botIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int]int64, len(rows))
+	for _, row := range rows {
+		counts[row.BotID] = row.Counts
+	}
+
+	items := make([]BotRank, 0, len(botIDs))
+	for _, botID := range botIDs {
+		item := BotRank{BotID: botID}
+		if count, ok := counts[botID]; ok {
+			ahead, err := db.RequestsRanking.Query().
+				Where(requestsranking.CountsGT(count)).
+				Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			item.Counts = count
+			item.Rank = ahead + 1
+		}
+		items = append(items, item)
+	}
+	if err := attachBotOwners(ctx, db, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func attachBotOwners(ctx context.Context, db *neopg.Client, items []BotRank) error {
+	if len(items) == 0 {
+		return nil
+	}
+	botIDs := make([]int, len(items))
+	for i := range items {
+		botIDs[i] = items[i].BotID
+	}
+	users, err := db.User.Query().
+		Where(botUser.BotIDIn(botIDs...)).
+		Select(botUser.FieldBotID, botUser.FieldOwnerUserID, botUser.FieldLastLoginAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	byBotID := make(map[int]*neopg.User, len(users))
+	for _, user := range users {
+		byBotID[user.BotID] = user
+	}
+	for i := range items {
+		if user, ok := byBotID[items[i].BotID]; ok {
+			items[i].OwnerUserID = user.OwnerUserID
+			items[i].LastLoginAt = user.LastLoginAt
+		}
+	}
+	return nil
+}
+
+// BuildRequestCurve reads hourly_requests or daily_requests between from and
+// to, both inclusive.
+func BuildRequestCurve(ctx context.Context, db *neopg.Client, granularity string, from, to, now time.Time) (*RequestCurve, error) {
+	curve := &RequestCurve{
+		GeneratedAt: now.UTC(),
+		From:        from.UTC(),
+		To:          to.UTC(),
+		Granularity: granularity,
+		Points:      []CountPoint{},
+	}
+	if granularity == GranularityDaily {
+		rows, err := db.DailyRequests.Query().
+			Where(dailyrequests.DateKeyGTE(from), dailyrequests.DateKeyLTE(to)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			curve.Points = append(curve.Points, CountPoint{Time: row.DateKey, Count: int64(row.Count)})
+		}
+	} else {
+		rows, err := db.HourlyRequests.Query().
+			Where(hourlyrequests.HourKeyGTE(from), hourlyrequests.HourKeyLTE(to)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			curve.Points = append(curve.Points, CountPoint{Time: row.HourKey, Count: int64(row.Count)})
+		}
+	}
+	sort.Slice(curve.Points, func(i, j int) bool {
+		return curve.Points[i].Time.Before(curve.Points[j].Time)
+	})
+	for _, point := range curve.Points {
+		curve.Total += point.Count
+	}
+	return curve, nil
+}
@@ -0,0 +1,82 @@
+package botanalytics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildTopBotsAndRanks(t *testing.T) {
+	client := newBotAnalyticsTestClient(t, "bot-analytics-ranking-test")
+	for botID, counts := range map[int]int64{10000001: 500, 10000002: 900, 10000003: 500, 10000004: 10} {
+		if _, err := client.RequestsRanking.Create().SetBotID(botID).SetCounts(counts).Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed ranking: %v", err)
+		}
+	}
+	lastLogin := time.Date(2026, 5, 30, 0, 0, 0, 0, time.UTC)
+	if _, err := client.User.Create().
+		SetOwnerUserID(123456).
+		SetBotID(10000003).
+		SetCredential("hash").
+		SetLastLoginAt(lastLogin).
+		Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed bot user: %v", err)
+	}
+
+	top, err := BuildTopBots(t.Context(), client, 3, lastLogin)
+	if err != nil {
+		t.Fatalf("BuildTopBots returned error: %v", err)
+	}
+	if top.TotalBots != 4 || len(top.Items) != 3 {
+		t.Fatalf("unexpected top bots: %+v", top)
+	}
+	want := []BotRank{
+		{Rank: 1, BotID: 10000002, Counts: 900},
+		{Rank: 2, BotID: 10000001, Counts: 500},
+		{Rank: 2, BotID: 10000003, Counts: 500, OwnerUserID: 123456},
+	}
+	for i, item := range top.Items {
+		if item.Rank != want[i].Rank || item.BotID != want[i].BotID || item.Counts != want[i].Counts || item.OwnerUserID != want[i].OwnerUserID {
+			t.Fatalf("top[%d] = %+v, want %+v", i, item, want[i])
+		}
+	}
+	if top.Items[2].LastLoginAt == nil || !top.Items[2].LastLoginAt.Equal(lastLogin) {
+		t.Fatalf("expected last login to be attached, got %+v", top.Items[2])
+	}
+
+	ranks, err := BuildBotRanks(t.Context(), client, []int{10000004, 10009999})
+	if err != nil {
+		t.Fatalf("BuildBotRanks returned error: %v", err)
+	}
+	if ranks[0].Rank != 4 || ranks[0].Counts != 10 || ranks[1].Rank != 0 || ranks[1].Counts != 0 {
+		t.Fatalf("unexpected ranks: %+v", ranks)
+	}
+}
+
+func TestBuildRequestCurve(t *testing.T) {
+	client := newBotAnalyticsTestClient(t, "bot-analytics-curve-test")
+	day := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	for i, count := range []int{30, 10, 20} {
+		if _, err := client.HourlyRequests.Create().SetHourKey(day.Add(time.Duration(2-i) * time.Hour)).SetCount(count).Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed hourly requests: %v", err)
+		}
+	}
+	if _, err := client.DailyRequests.Create().SetDateKey(day).SetCount(60).Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed daily requests: %v", err)
+	}
+
+	hourly, err := BuildRequestCurve(t.Context(), client, GranularityHourly, day, day.Add(time.Hour), day)
+	if err != nil {
+		t.Fatalf("BuildRequestCurve returned error: %v", err)
+	}
+	if hourly.Total != 30 || len(hourly.Points) != 2 || !hourly.Points[0].Time.Equal(day) || hourly.Points[0].Count != 20 {
+		t.Fatalf("unexpected hourly curve: %+v", hourly)
+	}
+
+	daily, err := BuildRequestCurve(t.Context(), client, GranularityDaily, day.Add(-24*time.Hour), day, day)
+	if err != nil {
+		t.Fatalf("BuildRequestCurve returned error: %v", err)
+	}
+	if daily.Total != 60 || len(daily.Points) != 1 {
+		t.Fatalf("unexpected daily curve: %+v", daily)
+	}
+}
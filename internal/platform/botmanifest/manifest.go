@@ -0,0 +1,272 @@
+package botmanifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	MaxPrefixes         = 32
+	MaxPrefixLength     = 64
+	MaxAdditionalParams = 32
+	MinPriority         = -10000
+	MaxPriority         = 10000
+
+	ConflictDuplicateEndpoint = "duplicate_endpoint"
+	ConflictDuplicatePrefix   = "duplicate_prefix"
+	ConflictShadowedPrefix    = "shadowed_prefix"
+)
+
+var (
+	allowedModes = map[string]struct{}{
+		"GET":    {},
+		"POST":   {},
+		"PUT":    {},
+		"PATCH":  {},
+		"DELETE": {},
+	}
+	modulePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+	pathPattern   = regexp.MustCompile(`^[A-Za-z0-9_.{}-]+(/[A-Za-z0-9_.{}-]+)*$`)
+	paramPattern  = regexp.MustCompile(`^[A-Za-z0-9_]{1,64}$`)
+)
+
+// Entry is one command_manifests row: the prefixes that route a command to
+// module/path on the bot API.
+type Entry struct {
+	ID               int      `json:"id,omitempty"`
+	Prefixes         []string `json:"prefixes"`
+	Priority         int      `json:"priority"`
+	Mode             string   `json:"mode"`
+	Module           string   `json:"module"`
+	Path             string   `json:"path"`
+	AdditionalParams []string `json:"additionalParams"`
+}
+
+// Endpoint identifies the entry the way the unique index does.
+func (e Entry) Endpoint() string {
+	return e.Module + "/" + e.Path
+}
+
+// Normalize trims and validates entry. Prefixes and parameters keep their
+// order with duplicates removed, and mode is upper-cased into a canonical
+// comma-separated list.
+func Normalize(entry Entry) (Entry, error) {
+	out := Entry{ID: entry.ID, Priority: entry.Priority}
+
+	out.Prefixes = make([]string, 0, len(entry.Prefixes))
+	seen := map[string]struct{}{}
+	for _, raw := range entry.Prefixes {
+		prefix := strings.TrimSpace(raw)
+		if prefix == "" {
+			return Entry{}, fiber.NewError(fiber.StatusBadRequest, "prefixes cannot contain empty values")
+		}
+		if len([]rune(prefix)) > MaxPrefixLength {
+			return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("prefix %q is too long", prefix))
+		}
+		if strings.IndexFunc(prefix, unicode.IsSpace) >= 0 {
+			return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("prefix %q cannot contain whitespace", prefix))
+		}
+		if _, ok := seen[prefix]; ok {
+			continue
+		}
+		seen[prefix] = struct{}{}
+		out.Prefixes = append(out.Prefixes, prefix)
+	}
+	if len(out.Prefixes) == 0 {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, "at least one prefix is required")
+	}
+	if len(out.Prefixes) > MaxPrefixes {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("at most %d prefixes are allowed", MaxPrefixes))
+	}
+
+	if entry.Priority < MinPriority || entry.Priority > MaxPriority {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("priority must be between %d and %d", MinPriority, MaxPriority))
+	}
+
+	modes := make([]string, 0, 2)
+	for _, raw := range strings.Split(entry.Mode, ",") {
+		mode := strings.ToUpper(strings.TrimSpace(raw))
+		if mode == "" {
+			continue
+		}
+		if _, ok := allowedModes[mode]; !ok {
+			return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("unsupported mode %q", mode))
+		}
+		if !containsString(modes, mode) {
+			modes = append(modes, mode)
+		}
+	}
+	if len(modes) == 0 {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, "mode is required")
+	}
+	out.Mode = strings.Join(modes, ",")
+	if len(out.Mode) > 16 {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, "mode is too long")
+	}
+
+	out.Module = strings.ToLower(strings.TrimSpace(entry.Module))
+	if !modulePattern.MatchString(out.Module) {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, "module must be lowercase letters, digits, '-' or '_'")
+	}
+	out.Path = strings.TrimSpace(entry.Path)
+	if len(out.Path) > 256 || !pathPattern.MatchString(out.Path) {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, "path must be a relative path without a leading or trailing slash")
+	}
+	for _, segment := range strings.Split(out.Path, "/") {
+		if segment == "." || segment == ".." {
+			return Entry{}, fiber.NewError(fiber.StatusBadRequest, "path cannot contain '.' or '..' segments")
+		}
+	}
+
+	out.AdditionalParams = make([]string, 0, len(entry.AdditionalParams))
+	for _, raw := range entry.AdditionalParams {
+		param := strings.TrimSpace(raw)
+		if !paramPattern.MatchString(param) {
+			return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid additional param %q", raw))
+		}
+		if !containsString(out.AdditionalParams, param) {
+			out.AdditionalParams = append(out.AdditionalParams, param)
+		}
+	}
+	if len(out.AdditionalParams) > MaxAdditionalParams {
+		return Entry{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("at most %d additional params are allowed", MaxAdditionalParams))
+	}
+	return out, nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Conflict describes a routing problem between two entries. Entry is the
+// endpoint that loses: its prefix can never be matched as intended.
+type Conflict struct {
+	Kind          string `json:"kind"`
+	Entry         string `json:"entry"`
+	Prefix        string `json:"prefix,omitempty"`
+	ConflictsWith string `json:"conflictsWith"`
+	ShadowedBy    string `json:"shadowedBy,omitempty"`
+	Message       string `json:"message"`
+}
+
+// ConflictError is returned when a change would leave the manifest with
+// conflicts.
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (e *ConflictError) Error() string {
+	if len(e.Conflicts) == 0 {
+		return "command manifest has conflicts"
+	}
+	return e.Conflicts[0].Message
+}
+
+// DetectConflicts checks entries the way the bot matches commands: entries
+// are tried by descending priority and the first prefix the message starts
+// with wins. It reports endpoints used twice, prefixes claimed by two
+// endpoints, and prefixes that a shorter prefix of another endpoint with the
+// same or higher priority always matches first.
+func DetectConflicts(entries []Entry) []Conflict {
+	var conflicts []Conflict
+	for i := range entries {
+		for j := range entries {
+			if i == j {
+				continue
+			}
+			a, b := entries[i], entries[j]
+			if i < j && a.Endpoint() == b.Endpoint() {
+				conflicts = append(conflicts, Conflict{
+					Kind:          ConflictDuplicateEndpoint,
+					Entry:         b.Endpoint(),
+					ConflictsWith: a.Endpoint(),
+					Message:       fmt.Sprintf("endpoint %s is defined more than once", b.Endpoint()),
+				})
+				continue
+			}
+			for _, winner := range a.Prefixes {
+				for _, loser := range b.Prefixes {
+					switch {
+					case winner == loser && i < j:
+						conflicts = append(conflicts, Conflict{
+							Kind:          ConflictDuplicatePrefix,
+							Entry:         b.Endpoint(),
+							Prefix:        loser,
+							ConflictsWith: a.Endpoint(),
+							Message:       fmt.Sprintf("prefix %s is used by both %s and %s", loser, a.Endpoint(), b.Endpoint()),
+						})
+					case winner != loser && strings.HasPrefix(loser, winner) && a.Priority >= b.Priority:
+						conflicts = append(conflicts, Conflict{
+							Kind:          ConflictShadowedPrefix,
+							Entry:         b.Endpoint(),
+							Prefix:        loser,
+							ConflictsWith: a.Endpoint(),
+							ShadowedBy:    winner,
+							Message:       fmt.Sprintf("prefix %s of %s is matched by %s of %s first; give %s a higher priority than %d", loser, b.Endpoint(), winner, a.Endpoint(), b.Endpoint(), a.Priority),
+						})
+					}
+				}
+			}
+		}
+	}
+	return conflicts
+}
+
+// conflictsInvolving keeps the conflicts touching endpoint, so a change is
+// not blocked by problems elsewhere in a manifest that was edited by hand.
+func conflictsInvolving(conflicts []Conflict, endpoint string) []Conflict {
+	var out []Conflict
+	for _, conflict := range conflicts {
+		if conflict.Entry == endpoint || conflict.ConflictsWith == endpoint {
+			out = append(out, conflict)
+		}
+	}
+	return out
+}
+
+// Canonical returns entries without IDs in matching order: priority
+// descending, then module and path.
+func Canonical(entries []Entry) []Entry {
+	out := make([]Entry, len(entries))
+	for i, entry := range entries {
+		entry.ID = 0
+		if entry.AdditionalParams == nil {
+			entry.AdditionalParams = []string{}
+		}
+		out[i] = entry
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Priority != out[j].Priority {
+			return out[i].Priority > out[j].Priority
+		}
+		if out[i].Module != out[j].Module {
+			return out[i].Module < out[j].Module
+		}
+		return out[i].Path < out[j].Path
+	})
+	return out
+}
+
+// Encode returns the canonical JSON of entries and its ETag, the hex SHA-256
+// of that JSON. Row IDs do not affect either.
+func Encode(entries []Entry) ([]byte, string, error) {
+	content, err := json.Marshal(Canonical(entries))
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
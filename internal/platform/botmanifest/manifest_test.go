@@ -0,0 +1,97 @@
+package botmanifest
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func TestNormalize(t *testing.T) {
+	entry, err := Normalize(Entry{
+		Prefixes:         []string{" /card ", "/card", "/查卡"},
+		Priority:         5,
+		Mode:             "get, post,GET",
+		Module:           " PJSK ",
+		Path:             "card/detail",
+		AdditionalParams: []string{"server", "server", "rank"},
+	})
+	if err != nil {
+		t.Fatalf("Normalize returned error: %v", err)
+	}
+	if len(entry.Prefixes) != 2 || entry.Prefixes[0] != "/card" || entry.Prefixes[1] != "/查卡" {
+		t.Fatalf("prefixes = %v", entry.Prefixes)
+	}
+	if entry.Mode != "GET,POST" || entry.Module != "pjsk" || len(entry.AdditionalParams) != 2 {
+		t.Fatalf("unexpected normalized entry: %+v", entry)
+	}
+
+	valid := Entry{Prefixes: []string{"/card"}, Mode: "GET", Module: "pjsk", Path: "card"}
+	for name, mutate := range map[string]func(*Entry){
+		"no prefixes":     func(e *Entry) { e.Prefixes = nil },
+		"spaced prefix":   func(e *Entry) { e.Prefixes = []string{"/card detail"} },
+		"bad mode":        func(e *Entry) { e.Mode = "HEAD" },
+		"leading slash":   func(e *Entry) { e.Path = "/card" },
+		"parent segment":  func(e *Entry) { e.Path = "card/../admin" },
+		"bad module":      func(e *Entry) { e.Module = "pj sk" },
+		"bad param":       func(e *Entry) { e.AdditionalParams = []string{"a-b"} },
+		"priority bounds": func(e *Entry) { e.Priority = MaxPriority + 1 },
+	} {
+		entry := valid
+		mutate(&entry)
+		_, err := Normalize(entry)
+		fiberErr, ok := err.(*fiber.Error)
+		if !ok || fiberErr.Code != fiber.StatusBadRequest {
+			t.Fatalf("%s: expected a 400 error, got %v", name, err)
+		}
+	}
+}
+
+func TestDetectConflicts(t *testing.T) {
+	card := Entry{Prefixes: []string{"/card"}, Priority: 0, Module: "pjsk", Path: "card"}
+	cards := Entry{Prefixes: []string{"/cards"}, Priority: 0, Module: "pjsk", Path: "cards"}
+	conflicts := DetectConflicts([]Entry{card, cards})
+	if len(conflicts) != 1 || conflicts[0].Kind != ConflictShadowedPrefix || conflicts[0].Entry != "pjsk/cards" || conflicts[0].ShadowedBy != "/card" {
+		t.Fatalf("unexpected conflicts: %+v", conflicts)
+	}
+
+	cards.Priority = 1
+	if conflicts := DetectConflicts([]Entry{card, cards}); len(conflicts) != 0 {
+		t.Fatalf("higher priority should resolve the shadowing, got %+v", conflicts)
+	}
+
+	duplicate := Entry{Prefixes: []string{"/card"}, Priority: 9, Module: "chunithm", Path: "card"}
+	conflicts = DetectConflicts([]Entry{card, duplicate})
+	if len(conflicts) != 1 || conflicts[0].Kind != ConflictDuplicatePrefix {
+		t.Fatalf("unexpected duplicate prefix conflicts: %+v", conflicts)
+	}
+
+	conflicts = DetectConflicts([]Entry{card, {Prefixes: []string{"/c"}, Priority: 2, Module: "pjsk", Path: "card"}})
+	kinds := map[string]bool{}
+	for _, conflict := range conflicts {
+		kinds[conflict.Kind] = true
+	}
+	if !kinds[ConflictDuplicateEndpoint] {
+		t.Fatalf("expected a duplicate endpoint conflict, got %+v", conflicts)
+	}
+}
+
+func TestEncodeIgnoresOrderAndIDs(t *testing.T) {
+	a := Entry{ID: 1, Prefixes: []string{"/a"}, Priority: 1, Mode: "GET", Module: "pjsk", Path: "a"}
+	b := Entry{ID: 2, Prefixes: []string{"/b"}, Priority: 0, Mode: "GET", Module: "pjsk", Path: "b"}
+	_, first, err := Encode([]Entry{a, b})
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	a.ID, b.ID = 7, 8
+	_, second, err := Encode([]Entry{b, a})
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	if first != second || len(first) != 64 {
+		t.Fatalf("etags differ: %s vs %s", first, second)
+	}
+	b.Priority = 2
+	if _, third, _ := Encode([]Entry{a, b}); third == first {
+		t.Fatalf("etag should change with the manifest")
+	}
+}
@@ -0,0 +1,371 @@
+package botmanifest
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
+
+	sql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	ActionBaseline = "baseline"
+	ActionCreate   = "create"
+	ActionUpdate   = "update"
+	ActionDelete   = "delete"
+	ActionRollback = "rollback"
+
+	DefaultVersionLimit = 20
+	MaxVersionLimit     = 100
+)
+
+// Snapshot is one recorded manifest version. Entries is only filled when a
+// single version is requested.
+type Snapshot struct {
+	Version        int       `json:"version"`
+	ETag           string    `json:"etag"`
+	EntryCount     int       `json:"entryCount"`
+	Action         string    `json:"action"`
+	CreatedBy      string    `json:"createdBy,omitempty"`
+	RolledBackFrom *int      `json:"rolledBackFrom,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	Entries        []Entry   `json:"entries,omitempty"`
+}
+
+// Manifest is the live command_manifests table in matching order. Version
+// is the latest snapshot when it still matches the table, and 0 after a
+// direct DB edit.
+type Manifest struct {
+	Version int     `json:"version"`
+	ETag    string  `json:"etag"`
+	Entries []Entry `json:"entries"`
+}
+
+func entryFromRow(row *neopg.CommandManifest) Entry {
+	params := row.CommandAdditionalParams
+	if params == nil {
+		params = []string{}
+	}
+	return Entry{
+		ID:               row.ID,
+		Prefixes:         row.CommandPrefixes,
+		Priority:         row.CommandPriority,
+		Mode:             row.CommandMode,
+		Module:           row.CommandModule,
+		Path:             row.CommandPath,
+		AdditionalParams: params,
+	}
+}
+
+func snapshotFromRow(row *neopg.CommandManifestSnapshot) Snapshot {
+	return Snapshot{
+		Version:        row.Version,
+		ETag:           row.Etag,
+		EntryCount:     row.EntryCount,
+		Action:         row.Action,
+		CreatedBy:      row.CreatedBy,
+		RolledBackFrom: row.RolledBackFrom,
+		CreatedAt:      row.CreatedAt.UTC(),
+	}
+}
+
+func loadEntries(ctx context.Context, db *neopg.Client) ([]Entry, error) {
+	rows, err := db.CommandManifest.Query().
+		Order(
+			commandmanifest.ByCommandPriority(sql.OrderDesc()),
+			commandmanifest.ByCommandModule(),
+			commandmanifest.ByCommandPath(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, entryFromRow(row))
+	}
+	return entries, nil
+}
+
+// Load returns the live manifest.
+func Load(ctx context.Context, db *neopg.Client) (*Manifest, error) {
+	entries, err := loadEntries(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	_, etag, err := Encode(entries)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{ETag: etag, Entries: entries}
+	latest, err := db.CommandManifestSnapshot.Query().
+		Order(commandmanifestsnapshot.ByVersion(sql.OrderDesc())).
+		Select(commandmanifestsnapshot.FieldVersion, commandmanifestsnapshot.FieldEtag).
+		First(ctx)
+	if err != nil && !neopg.IsNotFound(err) {
+		return nil, err
+	}
+	if latest != nil && latest.Etag == etag {
+		manifest.Version = latest.Version
+	}
+	return manifest, nil
+}
+
+// Create adds entry and records the resulting manifest as a new version.
+func Create(ctx context.Context, db *neopg.Client, entry Entry, actorID string) (*Entry, *Snapshot, error) {
+	entry, err := Normalize(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	var created Entry
+	snapshot, err := change(ctx, db, ActionCreate, actorID, nil, func(tx *neopg.Tx, entries []Entry) ([]Entry, error) {
+		if err := checkConflicts(append(entries, entry), entry.Endpoint()); err != nil {
+			return nil, err
+		}
+		row, err := tx.CommandManifest.Create().
+			SetCommandPrefixes(entry.Prefixes).
+			SetCommandPriority(entry.Priority).
+			SetCommandMode(entry.Mode).
+			SetCommandModule(entry.Module).
+			SetCommandPath(entry.Path).
+			SetCommandAdditionalParams(entry.AdditionalParams).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		created = entryFromRow(row)
+		return append(entries, created), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &created, snapshot, nil
+}
+
+// Update replaces the entry with the given ID.
+func Update(ctx context.Context, db *neopg.Client, id int, entry Entry, actorID string) (*Entry, *Snapshot, error) {
+	entry, err := Normalize(entry)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.ID = id
+	var updated Entry
+	snapshot, err := change(ctx, db, ActionUpdate, actorID, nil, func(tx *neopg.Tx, entries []Entry) ([]Entry, error) {
+		index := indexOfEntry(entries, id)
+		if index < 0 {
+			return nil, fiber.NewError(fiber.StatusNotFound, "command manifest entry not found")
+		}
+		next := append([]Entry(nil), entries...)
+		next[index] = entry
+		if err := checkConflicts(next, entry.Endpoint()); err != nil {
+			return nil, err
+		}
+		row, err := tx.CommandManifest.UpdateOneID(id).
+			SetCommandPrefixes(entry.Prefixes).
+			SetCommandPriority(entry.Priority).
+			SetCommandMode(entry.Mode).
+			SetCommandModule(entry.Module).
+			SetCommandPath(entry.Path).
+			SetCommandAdditionalParams(entry.AdditionalParams).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		updated = entryFromRow(row)
+		next[index] = updated
+		return next, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &updated, snapshot, nil
+}
+
+// Delete removes the entry with the given ID.
+func Delete(ctx context.Context, db *neopg.Client, id int, actorID string) (*Snapshot, error) {
+	return change(ctx, db, ActionDelete, actorID, nil, func(tx *neopg.Tx, entries []Entry) ([]Entry, error) {
+		index := indexOfEntry(entries, id)
+		if index < 0 {
+			return nil, fiber.NewError(fiber.StatusNotFound, "command manifest entry not found")
+		}
+		if err := tx.CommandManifest.DeleteOneID(id).Exec(ctx); err != nil {
+			return nil, err
+		}
+		return append(entries[:index:index], entries[index+1:]...), nil
+	})
+}
+
+// Rollback replaces the live manifest with the entries of version and
+// records the result as a new version.
+func Rollback(ctx context.Context, db *neopg.Client, version int, actorID string) (*Snapshot, error) {
+	target, err := GetVersion(ctx, db, version)
+	if err != nil {
+		return nil, err
+	}
+	return change(ctx, db, ActionRollback, actorID, &version, func(tx *neopg.Tx, _ []Entry) ([]Entry, error) {
+		if _, err := tx.CommandManifest.Delete().Exec(ctx); err != nil {
+			return nil, err
+		}
+		restored := make([]Entry, 0, len(target.Entries))
+		for _, entry := range target.Entries {
+			row, err := tx.CommandManifest.Create().
+				SetCommandPrefixes(entry.Prefixes).
+				SetCommandPriority(entry.Priority).
+				SetCommandMode(entry.Mode).
+				SetCommandModule(entry.Module).
+				SetCommandPath(entry.Path).
+				SetCommandAdditionalParams(entry.AdditionalParams).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			restored = append(restored, entryFromRow(row))
+		}
+		return restored, nil
+	})
+}
+
+// ListVersions returns the newest limit versions without their entries.
+func ListVersions(ctx context.Context, db *neopg.Client, limit int) ([]Snapshot, error) {
+	if limit <= 0 {
+		limit = DefaultVersionLimit
+	}
+	if limit > MaxVersionLimit {
+		limit = MaxVersionLimit
+	}
+	rows, err := db.CommandManifestSnapshot.Query().
+		Order(commandmanifestsnapshot.ByVersion(sql.OrderDesc())).
+		Limit(limit).
+		Select(
+			commandmanifestsnapshot.FieldVersion,
+			commandmanifestsnapshot.FieldEtag,
+			commandmanifestsnapshot.FieldEntryCount,
+			commandmanifestsnapshot.FieldAction,
+			commandmanifestsnapshot.FieldCreatedBy,
+			commandmanifestsnapshot.FieldRolledBackFrom,
+			commandmanifestsnapshot.FieldCreatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshots = append(snapshots, snapshotFromRow(row))
+	}
+	return snapshots, nil
+}
+
+// GetVersion returns one version with its entries.
+func GetVersion(ctx context.Context, db *neopg.Client, version int) (*Snapshot, error) {
+	row, err := db.CommandManifestSnapshot.Query().
+		Where(commandmanifestsnapshot.VersionEQ(version)).
+		Only(ctx)
+	if err != nil {
+		if neopg.IsNotFound(err) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "command manifest version not found")
+		}
+		return nil, err
+	}
+	snapshot := snapshotFromRow(row)
+	if err := json.Unmarshal([]byte(row.Content), &snapshot.Entries); err != nil {
+		return nil, err
+	}
+	if snapshot.Entries == nil {
+		snapshot.Entries = []Entry{}
+	}
+	return &snapshot, nil
+}
+
+func indexOfEntry(entries []Entry, id int) int {
+	for i, entry := range entries {
+		if entry.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func checkConflicts(entries []Entry, endpoint string) error {
+	if conflicts := conflictsInvolving(DetectConflicts(entries), endpoint); len(conflicts) > 0 {
+		return &ConflictError{Conflicts: conflicts}
+	}
+	return nil
+}
+
+// change runs apply in a transaction and snapshots the entries it returns.
+// The first change through the toolbox also records the manifest as it was
+// before, so that state can be rolled back to as well. Two concurrent changes
+// race for the same version number; the loser fails with a constraint error.
+func change(ctx context.Context, db *neopg.Client, action, actorID string, rolledBackFrom *int, apply func(tx *neopg.Tx, entries []Entry) ([]Entry, error)) (*Snapshot, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := applyChange(ctx, tx, action, actorID, rolledBackFrom, apply)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func applyChange(ctx context.Context, tx *neopg.Tx, action, actorID string, rolledBackFrom *int, apply func(tx *neopg.Tx, entries []Entry) ([]Entry, error)) (*Snapshot, error) {
+	client := tx.Client()
+	entries, err := loadEntries(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := client.CommandManifestSnapshot.Query().
+		Order(commandmanifestsnapshot.ByVersion(sql.OrderDesc())).
+		Select(commandmanifestsnapshot.FieldVersion).
+		First(ctx)
+	if err != nil && !neopg.IsNotFound(err) {
+		return nil, err
+	}
+	version := 1
+	if latest != nil {
+		version = latest.Version + 1
+	} else {
+		if _, err := saveSnapshot(ctx, tx, version, ActionBaseline, "", nil, entries); err != nil {
+			return nil, err
+		}
+		version++
+	}
+
+	next, err := apply(tx, entries)
+	if err != nil {
+		return nil, err
+	}
+	return saveSnapshot(ctx, tx, version, action, actorID, rolledBackFrom, next)
+}
+
+func saveSnapshot(ctx context.Context, tx *neopg.Tx, version int, action, actorID string, rolledBackFrom *int, entries []Entry) (*Snapshot, error) {
+	content, etag, err := Encode(entries)
+	if err != nil {
+		return nil, err
+	}
+	row, err := tx.CommandManifestSnapshot.Create().
+		SetVersion(version).
+		SetEtag(etag).
+		SetContent(string(content)).
+		SetEntryCount(len(entries)).
+		SetAction(action).
+		SetCreatedBy(actorID).
+		SetNillableRolledBackFrom(rolledBackFrom).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := snapshotFromRow(row)
+	return &snapshot, nil
+}
@@ -0,0 +1,101 @@
+package botmanifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newManifestTestClient(t *testing.T, name string) *neopg.Client {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func TestManifestChangesAreVersioned(t *testing.T) {
+	client := newManifestTestClient(t, "bot-manifest-store-test")
+	if _, err := client.CommandManifest.Create().
+		SetCommandPrefixes([]string{"/event"}).
+		SetCommandMode("GET").
+		SetCommandModule("pjsk").
+		SetCommandPath("event").
+		Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed manifest: %v", err)
+	}
+	before, err := Load(t.Context(), client)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if before.Version != 0 || len(before.Entries) != 1 {
+		t.Fatalf("unexpected manifest before any change: %+v", before)
+	}
+
+	card, snapshot, err := Create(t.Context(), client, Entry{Prefixes: []string{"/card"}, Mode: "GET", Module: "pjsk", Path: "card"}, "admin-1")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if snapshot.Version != 2 || snapshot.EntryCount != 2 || snapshot.CreatedBy != "admin-1" {
+		t.Fatalf("unexpected snapshot: %+v", snapshot)
+	}
+	baseline, err := GetVersion(t.Context(), client, 1)
+	if err != nil {
+		t.Fatalf("GetVersion returned error: %v", err)
+	}
+	if baseline.Action != ActionBaseline || baseline.ETag != before.ETag || len(baseline.Entries) != 1 {
+		t.Fatalf("unexpected baseline: %+v", baseline)
+	}
+
+	_, _, err = Create(t.Context(), client, Entry{Prefixes: []string{"/cards"}, Mode: "GET", Module: "pjsk", Path: "cards"}, "admin-1")
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) || conflictErr.Conflicts[0].Kind != ConflictShadowedPrefix {
+		t.Fatalf("expected a shadowed prefix conflict, got %v", err)
+	}
+
+	if _, snapshot, err = Update(t.Context(), client, card.ID, Entry{Prefixes: []string{"/card", "/c"}, Priority: 3, Mode: "GET", Module: "pjsk", Path: "card"}, "admin-2"); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if snapshot.Version != 3 {
+		t.Fatalf("update version = %d, want 3", snapshot.Version)
+	}
+	if snapshot, err = Delete(t.Context(), client, card.ID, "admin-2"); err != nil || snapshot.Version != 4 || snapshot.EntryCount != 1 {
+		t.Fatalf("Delete = %+v, %v", snapshot, err)
+	}
+
+	snapshot, err = Rollback(t.Context(), client, 3, "admin-1")
+	if err != nil {
+		t.Fatalf("Rollback returned error: %v", err)
+	}
+	if snapshot.Version != 5 || snapshot.RolledBackFrom == nil || *snapshot.RolledBackFrom != 3 || snapshot.EntryCount != 2 {
+		t.Fatalf("unexpected rollback snapshot: %+v", snapshot)
+	}
+	current, err := Load(t.Context(), client)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if current.Version != 5 || current.Entries[0].Path != "card" || current.Entries[0].Priority != 3 {
+		t.Fatalf("unexpected manifest after rollback: %+v", current)
+	}
+	restored, _ := GetVersion(t.Context(), client, 3)
+	if current.ETag != restored.ETag {
+		t.Fatalf("rollback etag %s, want %s", current.ETag, restored.ETag)
+	}
+
+	versions, err := ListVersions(t.Context(), client, 2)
+	if err != nil {
+		t.Fatalf("ListVersions returned error: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 5 || versions[0].Action != ActionRollback || versions[0].Entries != nil {
+		t.Fatalf("unexpected versions: %+v", versions)
+	}
+	if _, err := GetVersion(t.Context(), client, 99); err == nil {
+		t.Fatalf("expected missing version to fail")
+	}
+}
@@ -0,0 +1,228 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func openTestRedis(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	t.Helper()
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	return rdb, srv
+}
+
+func TestLockExcludesOtherHolders(t *testing.T) {
+	rdb, srv := openTestRedis(t)
+	ctx := context.Background()
+
+	first, err := TryLock(ctx, rdb, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock returned error: %v", err)
+	}
+	if _, err := TryLock(ctx, rdb, "job", time.Second); !errors.Is(err, ErrLockNotAcquired) {
+		t.Fatalf("second TryLock error = %v, want ErrLockNotAcquired", err)
+	}
+	if err := first.Extend(ctx); err != nil {
+		t.Fatalf("Extend returned error: %v", err)
+	}
+	if err := first.Release(ctx); err != nil {
+		t.Fatalf("Release returned error: %v", err)
+	}
+	second, err := TryLock(ctx, rdb, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock after release returned error: %v", err)
+	}
+
+	// An expired lock taken by someone else must not be released by the
+	// previous holder.
+	srv.FastForward(2 * time.Second)
+	third, err := TryLock(ctx, rdb, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock after expiry returned error: %v", err)
+	}
+	if err := second.Release(ctx); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("stale Release error = %v, want ErrLockLost", err)
+	}
+	if err := second.Extend(ctx); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("stale Extend error = %v, want ErrLockLost", err)
+	}
+	if !srv.Exists(harukiRedis.BuildCoordinationLockKey("job")) {
+		t.Fatalf("stale release removed the new holder's lock")
+	}
+	if err := third.Release(ctx); err != nil {
+		t.Fatalf("Release returned error: %v", err)
+	}
+}
+
+func TestAcquireLockWaitsForRelease(t *testing.T) {
+	rdb, _ := openTestRedis(t)
+	ctx := context.Background()
+
+	held, err := TryLock(ctx, rdb, "job", time.Minute)
+	if err != nil {
+		t.Fatalf("TryLock returned error: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = held.Release(context.Background())
+	}()
+	waitCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	lock, err := AcquireLock(waitCtx, rdb, "job", time.Minute)
+	if err != nil {
+		t.Fatalf("AcquireLock returned error: %v", err)
+	}
+	_ = lock.Release(ctx)
+
+	if _, err := TryLock(ctx, rdb, "other", time.Minute); err != nil {
+		t.Fatalf("TryLock returned error: %v", err)
+	}
+	shortCtx, cancelShort := context.WithTimeout(ctx, 60*time.Millisecond)
+	defer cancelShort()
+	if _, err := AcquireLock(shortCtx, rdb, "other", time.Minute); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("AcquireLock error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestElectorSingleLeaderAndFailover(t *testing.T) {
+	rdb, srv := openTestRedis(t)
+	ctx := context.Background()
+	logger := harukiLogger.NewLogger("CoordinationTest", "ERROR", nil)
+
+	first := NewElector(rdb, "instance-a", 3*time.Second, logger)
+	second := NewElector(rdb, "instance-b", 3*time.Second, logger)
+	first.Register(ctx, "sync")
+	second.Register(ctx, "sync")
+	if !first.IsLeader("sync") || second.IsLeader("sync") {
+		t.Fatalf("leaders = %t/%t, want only the first instance", first.IsLeader("sync"), second.IsLeader("sync"))
+	}
+	if second.IsLeader("unregistered") {
+		t.Fatalf("an unregistered role must not be led")
+	}
+
+	// The leader keeps its lease by renewing it.
+	srv.FastForward(2 * time.Second)
+	first.campaign(ctx, "sync")
+	srv.FastForward(2 * time.Second)
+	second.campaign(ctx, "sync")
+	if !first.IsLeader("sync") || second.IsLeader("sync") {
+		t.Fatalf("renewed lease was taken over")
+	}
+
+	// Without renewal the lease lapses and the other instance takes over.
+	srv.FastForward(4 * time.Second)
+	second.campaign(ctx, "sync")
+	first.campaign(ctx, "sync")
+	if first.IsLeader("sync") || !second.IsLeader("sync") {
+		t.Fatalf("leaders after expiry = %t/%t, want only the second instance", first.IsLeader("sync"), second.IsLeader("sync"))
+	}
+}
+
+func TestElectorResignsOnStop(t *testing.T) {
+	rdb, srv := openTestRedis(t)
+	logger := harukiLogger.NewLogger("CoordinationTest", "ERROR", nil)
+	elector := NewElector(rdb, "instance-a", 3*time.Second, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	elector.Register(ctx, "sync")
+	wait := elector.Start(ctx)
+	if !elector.IsLeader("sync") {
+		t.Fatalf("expected to lead after Register")
+	}
+	cancel()
+	wait()
+	if elector.IsLeader("sync") {
+		t.Fatalf("expected to step down after stop")
+	}
+	if srv.Exists(harukiRedis.BuildCoordinationLeaderKey("sync")) {
+		t.Fatalf("expected the lease to be released")
+	}
+}
+
+func TestNilElectorAlwaysLeads(t *testing.T) {
+	var elector *Elector
+	elector.Register(context.Background(), "sync")
+	if !elector.IsLeader("sync") {
+		t.Fatalf("nil elector should lead every role")
+	}
+	elector.Start(context.Background())()
+}
+
+func testRateLimitKey(windowSlot int64, bucket string) string {
+	return harukiRedis.BuildCoordinationRateLimitKey("test", windowSlot, bucket)
+}
+
+func TestRateLimiterSharesBucketsAcrossInstances(t *testing.T) {
+	rdb, _ := openTestRedis(t)
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0).UTC()
+	// Two limiters stand in for two instances.
+	first := NewRateLimiter(2, time.Minute, testRateLimitKey)
+	second := NewRateLimiter(2, time.Minute, testRateLimitKey)
+
+	for i, limiter := range []*RateLimiter{first, second} {
+		decision, err := limiter.Allow(ctx, rdb, "ip-a", now)
+		if err != nil || !decision.Allowed {
+			t.Fatalf("request %d = %+v, %v; want allowed", i+1, decision, err)
+		}
+	}
+	decision, err := first.Allow(ctx, rdb, "ip-a", now.Add(10*time.Second))
+	if err != nil {
+		t.Fatalf("Allow returned error: %v", err)
+	}
+	if decision.Allowed {
+		t.Fatalf("third request across instances should be refused")
+	}
+	if decision.RetryAfter <= 0 || decision.RetryAfter > time.Minute {
+		t.Fatalf("RetryAfter = %s, want within the window", decision.RetryAfter)
+	}
+	if decision, _ := second.Allow(ctx, rdb, "ip-b", now); !decision.Allowed {
+		t.Fatalf("a different bucket should be allowed")
+	}
+	next := now.Add(time.Minute)
+	if decision, _ := second.Allow(ctx, rdb, "ip-a", next); !decision.Allowed {
+		t.Fatalf("request in the next window should be allowed")
+	}
+	if ttl := rdb.PTTL(ctx, testRateLimitKey(next.Unix()/60, "ip-a")).Val(); ttl <= 0 || ttl > 2*time.Minute {
+		t.Fatalf("bucket ttl = %s, want a positive ttl of at most two windows", ttl)
+	}
+}
+
+func TestRateLimiterFallsBackToLocalBuckets(t *testing.T) {
+	rdb, srv := openTestRedis(t)
+	srv.Close()
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0).UTC()
+	limiter := NewRateLimiter(2, time.Minute, testRateLimitKey)
+
+	for i := 0; i < 2; i++ {
+		decision, err := limiter.Allow(ctx, rdb, "ip-a", now.Add(time.Duration(i)*10*time.Second))
+		if err == nil {
+			t.Fatalf("expected the redis error to be reported")
+		}
+		if !decision.Allowed {
+			t.Fatalf("request %d should pass the local bucket", i+1)
+		}
+	}
+	if decision, _ := limiter.Allow(ctx, rdb, "ip-a", now.Add(20*time.Second)); decision.Allowed || decision.RetryAfter != 40*time.Second {
+		t.Fatalf("third local request = %+v, want refused with 40s retry", decision)
+	}
+	if decision, _ := limiter.Allow(ctx, nil, "ip-b", now); !decision.Allowed {
+		t.Fatalf("local buckets should be isolated by key")
+	}
+	if decision, _ := limiter.Allow(ctx, rdb, "ip-a", now.Add(61*time.Second)); !decision.Allowed {
+		t.Fatalf("local bucket should reset after the window")
+	}
+}
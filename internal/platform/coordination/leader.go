@@ -0,0 +1,179 @@
+package coordination
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultLeaderLease = 30 * time.Second
+
+	campaignTimeout = 5 * time.Second
+)
+
+// Elector runs one leader election per registered role. Every role is a
+// lease on a Redis key holding the instance ID; the holder renews it every
+// third of the lease, so a crashed leader is replaced within one lease.
+//
+// A nil *Elector treats every instance as the leader, which keeps single
+// instance setups and tests working without Redis.
+type Elector struct {
+	rdb        *redis.Client
+	instanceID string
+	lease      time.Duration
+	logger     *harukiLogger.Logger
+
+	mu      sync.RWMutex
+	leading map[string]bool
+}
+
+// DefaultInstanceID returns the host name plus a random suffix, so two
+// processes on the same host still get different IDs.
+func DefaultInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil || strings.TrimSpace(host) == "" {
+		host = "instance"
+	}
+	suffix, err := newToken()
+	if err != nil {
+		return host
+	}
+	return host + "-" + suffix[:8]
+}
+
+func NewElector(rdb *redis.Client, instanceID string, lease time.Duration, logger *harukiLogger.Logger) *Elector {
+	if strings.TrimSpace(instanceID) == "" {
+		instanceID = DefaultInstanceID()
+	}
+	if lease <= 0 {
+		lease = DefaultLeaderLease
+	}
+	return &Elector{
+		rdb:        rdb,
+		instanceID: strings.TrimSpace(instanceID),
+		lease:      lease,
+		logger:     logger,
+		leading:    make(map[string]bool),
+	}
+}
+
+func (e *Elector) InstanceID() string {
+	if e == nil {
+		return ""
+	}
+	return e.instanceID
+}
+
+// Register adds role to the election and campaigns for it right away, so
+// the caller can check IsLeader before its first run.
+func (e *Elector) Register(ctx context.Context, role string) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	if _, ok := e.leading[role]; !ok {
+		e.leading[role] = false
+	}
+	e.mu.Unlock()
+	e.campaign(ctx, role)
+}
+
+// IsLeader reports whether this instance held the role's lease at the last
+// campaign.
+func (e *Elector) IsLeader(role string) bool {
+	if e == nil {
+		return true
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leading[role]
+}
+
+func (e *Elector) roles() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	roles := make([]string, 0, len(e.leading))
+	for role := range e.leading {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+func (e *Elector) campaign(ctx context.Context, role string) {
+	campaignCtx, cancel := context.WithTimeout(ctx, campaignTimeout)
+	defer cancel()
+	held, err := claim(campaignCtx, e.rdb, harukiRedis.BuildCoordinationLeaderKey(role), e.instanceID, e.lease)
+	if err != nil {
+		// Step down when the lease cannot be renewed; another instance may
+		// take over once it expires.
+		held = false
+		if ctx.Err() == nil {
+			e.logger.Warnf("Leader election for %s failed: %v", role, err)
+		}
+	}
+	e.mu.Lock()
+	was := e.leading[role]
+	e.leading[role] = held
+	e.mu.Unlock()
+	if held != was {
+		if held {
+			e.logger.Infof("Instance %s is now the leader for %s", e.instanceID, role)
+		} else {
+			e.logger.Infof("Instance %s is no longer the leader for %s", e.instanceID, role)
+		}
+	}
+}
+
+// Start renews the leases until ctx is cancelled and then releases the ones
+// this instance holds, so a restart hands leadership over without waiting
+// for the lease to expire.
+func (e *Elector) Start(ctx context.Context) func() {
+	if e == nil {
+		return func() {}
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(e.lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				e.resign()
+				return
+			case <-ticker.C:
+				for _, role := range e.roles() {
+					e.campaign(ctx, role)
+				}
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+func (e *Elector) resign() {
+	ctx, cancel := context.WithTimeout(context.Background(), campaignTimeout)
+	defer cancel()
+	for _, role := range e.roles() {
+		if !e.IsLeader(role) {
+			continue
+		}
+		e.mu.Lock()
+		e.leading[role] = false
+		e.mu.Unlock()
+		if _, err := release(ctx, e.rdb, harukiRedis.BuildCoordinationLeaderKey(role), e.instanceID); err != nil {
+			e.logger.Warnf("Failed to release leadership for %s: %v", role, err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Package coordination lets several backend instances share one Redis for
+// work that used to assume a single process: mutual exclusion, leadership of
+// background schedulers and rate limit buckets.
+package coordination
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	lockRetryInterval = 25 * time.Millisecond
+
+	// acquireScript takes the key when it is free and renews it when the
+	// caller already owns it.
+	acquireScript = `
+local current = redis.call('GET', KEYS[1])
+if current == ARGV[1] then
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+  return 1
+end
+if current then
+  return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`
+
+	// extendScript renews the key only while the caller still owns it.
+	extendScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+  return 1
+end
+return 0
+`
+
+	releaseScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0
+`
+)
+
+var (
+	ErrLockNotAcquired = errors.New("lock is held by another instance")
+	ErrLockLost        = errors.New("lock is no longer held")
+)
+
+// Lock is a lease on a Redis key. It expires on its own when the holder dies,
+// so critical sections must finish, or Extend the lock, within its TTL.
+type Lock struct {
+	rdb   *redis.Client
+	key   string
+	token string
+	ttl   time.Duration
+}
+
+func newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func claim(ctx context.Context, rdb *redis.Client, key, token string, ttl time.Duration) (bool, error) {
+	held, err := rdb.Eval(ctx, acquireScript, []string{key}, token, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	return held == 1, nil
+}
+
+func release(ctx context.Context, rdb *redis.Client, key, token string) (bool, error) {
+	deleted, err := rdb.Eval(ctx, releaseScript, []string{key}, token).Int64()
+	if err != nil {
+		return false, err
+	}
+	return deleted == 1, nil
+}
+
+// TryLock takes the named lock once and returns ErrLockNotAcquired when
+// another holder has it.
+func TryLock(ctx context.Context, rdb *redis.Client, name string, ttl time.Duration) (*Lock, error) {
+	if rdb == nil {
+		return nil, errors.New("redis client is nil")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("lock ttl must be positive")
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	key := harukiRedis.BuildCoordinationLockKey(name)
+	held, err := claim(ctx, rdb, key, token, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if !held {
+		return nil, ErrLockNotAcquired
+	}
+	return &Lock{rdb: rdb, key: key, token: token, ttl: ttl}, nil
+}
+
+// AcquireLock retries TryLock until it succeeds or ctx is done.
+func AcquireLock(ctx context.Context, rdb *redis.Client, name string, ttl time.Duration) (*Lock, error) {
+	for {
+		lock, err := TryLock(ctx, rdb, name, ttl)
+		if !errors.Is(err, ErrLockNotAcquired) {
+			return lock, err
+		}
+		timer := time.NewTimer(lockRetryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Extend renews the lock for another TTL. It returns ErrLockLost when the
+// lock expired and was possibly taken by someone else.
+func (l *Lock) Extend(ctx context.Context) error {
+	extended, err := l.rdb.Eval(ctx, extendScript, []string{l.key}, l.token, l.ttl.Milliseconds()).Int64()
+	if err != nil {
+		return err
+	}
+	if extended != 1 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Release drops the lock if it is still held by l; a lock that already
+// expired is left to its new owner.
+func (l *Lock) Release(ctx context.Context) error {
+	released, err := release(ctx, l.rdb, l.key, l.token)
+	if err != nil {
+		return err
+	}
+	if !released {
+		return ErrLockLost
+	}
+	return nil
+}
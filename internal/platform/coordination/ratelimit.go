@@ -0,0 +1,112 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// rateLimitScript counts a hit in the current window; only the first hit
+	// sets the expiry so later hits cannot keep the bucket alive.
+	rateLimitScript = `
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`
+
+	localBucketCleanupEvery = 256
+)
+
+// KeyFunc builds the Redis key of one bucket in one window.
+type KeyFunc func(windowSlot int64, bucket string) string
+
+// Decision is the outcome of one RateLimiter.Allow call.
+type Decision struct {
+	Allowed bool
+	// RetryAfter is set when the request was refused.
+	RetryAfter time.Duration
+}
+
+// RateLimiter is a fixed window limiter whose buckets live in Redis, so all
+// instances count against the same limit. When Redis is unavailable it keeps
+// going with per-instance buckets until Redis is back.
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+	key    KeyFunc
+
+	mu    sync.Mutex
+	local map[string]localBucket
+	hits  uint64
+}
+
+type localBucket struct {
+	windowStart time.Time
+	count       int
+}
+
+func NewRateLimiter(limit int, window time.Duration, key KeyFunc) *RateLimiter {
+	return &RateLimiter{
+		limit:  limit,
+		window: window,
+		key:    key,
+		local:  make(map[string]localBucket),
+	}
+}
+
+// Allow counts one request against bucket. A non-nil error means Redis could
+// not be reached and the decision came from the local fallback bucket.
+func (l *RateLimiter) Allow(ctx context.Context, rdb *redis.Client, bucket string, now time.Time) (Decision, error) {
+	if rdb == nil {
+		return l.allowLocal(now, bucket), errors.New("redis client is nil")
+	}
+	windowSlot := now.UnixNano() / int64(l.window)
+	// Keep the key around for a second window so a skewed clock on another
+	// instance still lands in a live bucket.
+	count, err := rdb.Eval(ctx, rateLimitScript, []string{l.key(windowSlot, bucket)}, (2 * l.window).Milliseconds()).Int64()
+	if err != nil {
+		return l.allowLocal(now, bucket), err
+	}
+	if count <= int64(l.limit) {
+		return Decision{Allowed: true}, nil
+	}
+	return Decision{RetryAfter: untilWindowReset(now, l.window)}, nil
+}
+
+func (l *RateLimiter) allowLocal(now time.Time, bucket string) Decision {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, ok := l.local[bucket]
+	if !ok || now.Sub(current.windowStart) >= l.window {
+		l.local[bucket] = localBucket{windowStart: now, count: 1}
+		l.hits++
+		if l.hits%localBucketCleanupEvery == 0 {
+			for key, stale := range l.local {
+				if now.Sub(stale.windowStart) >= l.window {
+					delete(l.local, key)
+				}
+			}
+		}
+		return Decision{Allowed: true}
+	}
+	if current.count >= l.limit {
+		return Decision{RetryAfter: current.windowStart.Add(l.window).Sub(now)}
+	}
+	current.count++
+	l.local[bucket] = current
+	return Decision{Allowed: true}
+}
+
+func untilWindowReset(now time.Time, window time.Duration) time.Duration {
+	if window <= 0 {
+		return 0
+	}
+	return window - time.Duration(now.UnixNano()%int64(window))
+}
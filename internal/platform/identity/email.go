@@ -1,8 +1,27 @@
 package identity
 
-import "strings"
+import (
+	"net/mail"
+	"strings"
+)
+
+const maxEmailLength = 320
 
 // NormalizeEmail trims surrounding spaces and lower-cases the address.
 func NormalizeEmail(raw string) string {
 	return strings.ToLower(strings.TrimSpace(raw))
 }
+
+// ParseEmail normalizes raw and reports whether it is a single bare address,
+// without a display name.
+func ParseEmail(raw string) (string, bool) {
+	email := NormalizeEmail(raw)
+	if email == "" || len(email) > maxEmailLength {
+		return "", false
+	}
+	parsed, err := mail.ParseAddress(email)
+	if err != nil || parsed == nil || NormalizeEmail(parsed.Address) != email {
+		return "", false
+	}
+	return email, true
+}
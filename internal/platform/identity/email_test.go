@@ -9,3 +9,14 @@ func TestNormalizeEmail(t *testing.T) {
 		t.Fatalf("NormalizeEmail() = %q, want %q", got, want)
 	}
 }
+
+func TestParseEmail(t *testing.T) {
+	if got, ok := ParseEmail("  Owner@Example.COM "); !ok || got != "owner@example.com" {
+		t.Fatalf("ParseEmail() = %q, %v", got, ok)
+	}
+	for _, raw := range []string{"", "not-an-email", "Owner <owner@example.com>", "a@b.com, c@d.com"} {
+		if _, ok := ParseEmail(raw); ok {
+			t.Fatalf("ParseEmail(%q) accepted an invalid address", raw)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package mailoutbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	platformIdentity "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/identity"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+const enqueueTimeout = 5 * time.Second
+
+var errNoRecipients = errors.New("mail has no recipients")
+
+// Sender hands one message to an SMTP relay and returns the name of the
+// relay that accepted it. *smtp.HarukiSMTPClient implements it.
+type Sender interface {
+	SendMessage(msg harukiSMTP.Message) (string, error)
+}
+
+type Options struct {
+	MaxAttempts   int
+	BaseBackoff   time.Duration
+	MaxBackoff    time.Duration
+	PollInterval  time.Duration
+	BatchSize     int
+	Concurrency   int
+	Retention     time.Duration
+	DefaultLocale string
+}
+
+func DefaultOptions() Options {
+	return Options{
+		MaxAttempts:   8,
+		BaseBackoff:   30 * time.Second,
+		MaxBackoff:    30 * time.Minute,
+		PollInterval:  5 * time.Second,
+		BatchSize:     50,
+		Concurrency:   4,
+		Retention:     30 * 24 * time.Hour,
+		DefaultLocale: harukiSMTP.DefaultLocale,
+	}
+}
+
+var (
+	optionsMu      sync.RWMutex
+	currentOptions = DefaultOptions()
+	wake           = make(chan struct{}, 1)
+	outboxNow      = time.Now
+)
+
+// Configure replaces the options read when an admin re-queues a message. It
+// is called once at startup.
+func Configure(options Options) {
+	optionsMu.Lock()
+	currentOptions = options
+	optionsMu.Unlock()
+}
+
+func CurrentOptions() Options {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return currentOptions
+}
+
+// Notify wakes the worker early, e.g. after mail is queued or an admin
+// re-queues a message.
+func Notify() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Outbox queues mail in PostgreSQL and sends it from a background worker,
+// one message per recipient. It implements smtp.Mailer, so handlers do not
+// notice the difference except that a relay outage no longer fails them.
+type Outbox struct {
+	db      *postgresql.Client
+	sender  Sender
+	logger  *harukiLogger.Logger
+	options Options
+}
+
+var _ harukiSMTP.Mailer = (*Outbox)(nil)
+
+func New(db *postgresql.Client, sender Sender, logger *harukiLogger.Logger, options Options) *Outbox {
+	defaults := DefaultOptions()
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaults.MaxAttempts
+	}
+	if options.BaseBackoff <= 0 {
+		options.BaseBackoff = defaults.BaseBackoff
+	}
+	if options.MaxBackoff < options.BaseBackoff {
+		options.MaxBackoff = options.BaseBackoff
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaults.PollInterval
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaults.BatchSize
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = defaults.Concurrency
+	}
+	if options.Retention <= 0 {
+		options.Retention = defaults.Retention
+	}
+	if options.DefaultLocale = harukiSMTP.SupportedLocale(options.DefaultLocale); options.DefaultLocale == "" {
+		options.DefaultLocale = defaults.DefaultLocale
+	}
+	return &Outbox{db: db, sender: sender, logger: logger, options: options}
+}
+
+func (o *Outbox) Send(to []string, subject, body string, displayName string) error {
+	return o.enqueue(to, harukiSMTP.Rendered{Subject: subject, Body: body}, "", displayName)
+}
+
+func (o *Outbox) SendTemplate(to []string, template, locale string, vars map[string]string, displayName string) error {
+	if locale == "" {
+		locale = o.options.DefaultLocale
+	}
+	rendered, err := harukiSMTP.Render(template, locale, vars)
+	if err != nil {
+		return err
+	}
+	return o.enqueue(to, rendered, template, displayName)
+}
+
+// RelayStatuses reports the relay health of the sender, when it tracks any.
+func (o *Outbox) RelayStatuses() []harukiSMTP.RelayStatus {
+	if reporter, ok := o.sender.(interface {
+		RelayStatuses() []harukiSMTP.RelayStatus
+	}); ok {
+		return reporter.RelayStatuses()
+	}
+	return nil
+}
+
+// enqueue stores one message per recipient. Suppressed recipients are
+// recorded as such and skipped; ErrRecipientSuppressed is returned when
+// none is left. If the queue cannot be written the mail is sent directly,
+// as before the outbox existed.
+func (o *Outbox) enqueue(to []string, rendered harukiSMTP.Rendered, template, displayName string) error {
+	recipients := normalizeRecipients(to)
+	if len(recipients) == 0 {
+		return errNoRecipients
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), enqueueTimeout)
+	defer cancel()
+	now := outboxNow().UTC()
+
+	suppressed, err := o.db.SuppressedEmailAddresses(ctx, recipients)
+	if err != nil {
+		o.logger.Warnf("Failed to check mail suppressions, sending directly: %v", err)
+		return o.sendDirect(recipients, rendered, displayName)
+	}
+	var expiresAt *time.Time
+	if rendered.TTL > 0 {
+		expires := now.Add(rendered.TTL)
+		expiresAt = &expires
+	}
+	records := make([]postgresql.EmailMessageRecord, 0, len(recipients))
+	deliverable := 0
+	for _, recipient := range recipients {
+		id, err := newMessageID()
+		if err != nil {
+			return err
+		}
+		if !suppressed[recipient] {
+			deliverable++
+		}
+		records = append(records, postgresql.EmailMessageRecord{
+			ID:          id,
+			Recipient:   recipient,
+			Subject:     rendered.Subject,
+			Body:        rendered.Body,
+			DisplayName: displayName,
+			Template:    template,
+			Locale:      rendered.Locale,
+			MaxAttempts: o.options.MaxAttempts,
+			ExpiresAt:   expiresAt,
+			Suppressed:  suppressed[recipient],
+		})
+	}
+	if err := o.db.EnqueueEmailMessages(ctx, records, now); err != nil {
+		o.logger.Warnf("Failed to queue mail, sending directly: %v", err)
+		deliverableRecipients := make([]string, 0, deliverable)
+		for _, recipient := range recipients {
+			if !suppressed[recipient] {
+				deliverableRecipients = append(deliverableRecipients, recipient)
+			}
+		}
+		if len(deliverableRecipients) == 0 {
+			return harukiSMTP.ErrRecipientSuppressed
+		}
+		return o.sendDirect(deliverableRecipients, rendered, displayName)
+	}
+	if deliverable == 0 {
+		return harukiSMTP.ErrRecipientSuppressed
+	}
+	Notify()
+	return nil
+}
+
+func (o *Outbox) sendDirect(recipients []string, rendered harukiSMTP.Rendered, displayName string) error {
+	_, err := o.sender.SendMessage(harukiSMTP.Message{
+		To:          recipients,
+		Subject:     rendered.Subject,
+		Body:        rendered.Body,
+		DisplayName: displayName,
+	})
+	return err
+}
+
+func normalizeRecipients(to []string) []string {
+	seen := make(map[string]struct{}, len(to))
+	recipients := make([]string, 0, len(to))
+	for _, raw := range to {
+		recipient := platformIdentity.NormalizeEmail(raw)
+		if recipient == "" {
+			continue
+		}
+		if _, ok := seen[recipient]; ok {
+			continue
+		}
+		seen[recipient] = struct{}{}
+		recipients = append(recipients, recipient)
+	}
+	return recipients
+}
+
+func newMessageID() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,212 @@
+package mailoutbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp/smtptest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestOutboxRetriesBouncesAndExpires(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:mail-outbox-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+	server, err := smtptest.NewServer()
+	if err != nil {
+		t.Fatalf("smtptest.NewServer returned error: %v", err)
+	}
+	defer server.Close()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	originalNow := outboxNow
+	outboxNow = func() time.Time { return now }
+	defer func() {
+		outboxNow = originalNow
+	}()
+
+	ctx := context.Background()
+	if _, err := client.AddEmailSuppression(ctx, "blocked@example.com", emailsuppression.ReasonManual, "", "admin", now); err != nil {
+		t.Fatalf("AddEmailSuppression returned error: %v", err)
+	}
+	sender := harukiSMTP.NewSMTPClient(harukiConfig.SMTPConfig{
+		SMTPAddr: server.Host,
+		SMTPPort: server.Port,
+		SMTPMail: "noreply@example.com",
+		TLS:      harukiSMTP.TLSModeNone,
+	})
+	outbox := New(client, sender, harukiLogger.NewLogger("MailOutboxTest", "ERROR", nil), Options{MaxAttempts: 3, BaseBackoff: time.Minute})
+
+	if err := outbox.Send([]string{"Blocked@example.com"}, "Hi", "body", ""); !errors.Is(err, harukiSMTP.ErrRecipientSuppressed) {
+		t.Fatalf("Send to a suppressed address returned %v, want ErrRecipientSuppressed", err)
+	}
+	server.FailMail("451 4.3.0 try again later")
+	if err := outbox.Send([]string{"a@example.com", "b@example.com", "blocked@example.com"}, "Hi", "body", "Haruki"); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if attempted := outbox.RunOnce(ctx); attempted != 2 {
+		t.Fatalf("RunOnce attempted %d, want 2", attempted)
+	}
+	queued, err := client.EmailMessage.Query().Where(emailmessage.StatusEQ(emailmessage.StatusQueued)).All(ctx)
+	if err != nil {
+		t.Fatalf("query queued mail returned error: %v", err)
+	}
+	if len(queued) != 2 || queued[0].Attempts != 1 || !queued[0].NextAttemptAt.Equal(now.Add(time.Minute)) || queued[0].LastError == nil {
+		t.Fatalf("queued mail after failure = %+v, want two retries in a minute", queued)
+	}
+	if suppressedCount, _ := client.EmailMessage.Query().Where(emailmessage.StatusEQ(emailmessage.StatusSuppressed)).Count(ctx); suppressedCount != 2 {
+		t.Fatalf("suppressed mail = %d, want 2", suppressedCount)
+	}
+
+	server.FailMail("")
+	server.RejectRecipient("b@example.com", "550 5.1.1 user unknown")
+	now = now.Add(time.Minute)
+	if attempted := outbox.RunOnce(ctx); attempted != 2 {
+		t.Fatalf("second RunOnce attempted %d, want 2", attempted)
+	}
+	sent, err := client.EmailMessage.Query().Where(emailmessage.RecipientEQ("a@example.com")).Only(ctx)
+	if err != nil {
+		t.Fatalf("query sent mail returned error: %v", err)
+	}
+	if sent.Status != emailmessage.StatusSent || sent.Relay == nil || *sent.Relay != "primary" || sent.SentAt == nil || sent.LastError != nil {
+		t.Fatalf("mail to a = %+v, want sent through primary", sent)
+	}
+	messages := server.Messages()
+	if len(messages) != 1 || !strings.Contains(messages[0].Data, "Message-ID: <"+sent.ID+"@example.com>") {
+		t.Fatalf("relay received %+v", messages)
+	}
+	bounced, err := client.EmailMessage.Query().Where(emailmessage.RecipientEQ("b@example.com")).Only(ctx)
+	if err != nil {
+		t.Fatalf("query bounced mail returned error: %v", err)
+	}
+	if bounced.Status != emailmessage.StatusBounced {
+		t.Fatalf("mail to b = %+v, want bounced", bounced)
+	}
+	suppression, err := client.EmailSuppression.Query().Where(emailsuppression.EmailEQ("b@example.com")).Only(ctx)
+	if err != nil {
+		t.Fatalf("query suppression returned error: %v", err)
+	}
+	if suppression.Reason != emailsuppression.ReasonHardBounce || suppression.BounceCount != 1 {
+		t.Fatalf("suppression = %+v, want a hard bounce", suppression)
+	}
+
+	// A verification code that could not go out in time is dropped.
+	server.FailMail("421 4.7.0 too busy")
+	if err := outbox.SendTemplate([]string{"c@example.com"}, harukiSMTP.TemplateVerificationCode, "en", map[string]string{"CODE": "123456", "PRODUCT": "Haruki"}, ""); err != nil {
+		t.Fatalf("SendTemplate returned error: %v", err)
+	}
+	code, err := client.EmailMessage.Query().Where(emailmessage.RecipientEQ("c@example.com")).Only(ctx)
+	if err != nil {
+		t.Fatalf("query templated mail returned error: %v", err)
+	}
+	if code.Locale != harukiSMTP.LocaleEN || code.Template != harukiSMTP.TemplateVerificationCode || code.Subject != "Your verification code | Haruki" || code.ExpiresAt == nil {
+		t.Fatalf("templated mail = %+v", code)
+	}
+	now = now.Add(6 * time.Minute)
+	outbox.RunOnce(ctx)
+	code, err = client.EmailMessage.Get(ctx, code.ID)
+	if err != nil {
+		t.Fatalf("get templated mail returned error: %v", err)
+	}
+	if code.Status != emailmessage.StatusExpired {
+		t.Fatalf("stale verification mail = %+v, want expired", code)
+	}
+
+	requeued, err := client.RequeueEmailMessage(ctx, bounced.ID, 3, now)
+	if err != nil || !requeued {
+		t.Fatalf("RequeueEmailMessage = %v, %v; want true, nil", requeued, err)
+	}
+	outbox.RunOnce(ctx)
+	bounced, err = client.EmailMessage.Get(ctx, bounced.ID)
+	if err != nil {
+		t.Fatalf("get requeued mail returned error: %v", err)
+	}
+	if bounced.Status != emailmessage.StatusSuppressed {
+		t.Fatalf("requeued mail to a suppressed address = %+v, want suppressed", bounced)
+	}
+}
+
+// slowSender stands in for a slow relay: every send moves the clock forward
+// and records the lease the message was claimed with.
+type slowSender struct {
+	client *postgresql.Client
+	mu     *sync.Mutex
+	now    *time.Time
+	leases map[string]time.Time
+}
+
+func (s *slowSender) SendMessage(message harukiSMTP.Message) (string, error) {
+	if row, err := s.client.EmailMessage.Get(context.Background(), message.ID); err == nil {
+		s.leases[row.Recipient] = row.NextAttemptAt
+	}
+	s.mu.Lock()
+	*s.now = s.now.Add(time.Minute)
+	s.mu.Unlock()
+	return "primary", nil
+}
+
+func TestOutboxLeasesAtClaimTime(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:mail-outbox-lease-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var mu sync.Mutex
+	now := base
+	originalNow := outboxNow
+	outboxNow = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	defer func() {
+		outboxNow = originalNow
+	}()
+
+	sender := &slowSender{client: client, mu: &mu, now: &now, leases: make(map[string]time.Time)}
+	outbox := New(client, sender, harukiLogger.NewLogger("MailOutboxTest", "ERROR", nil), Options{Concurrency: 1})
+	if err := outbox.Send([]string{"a@example.com", "b@example.com"}, "Hi", "body", ""); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if attempted := outbox.RunOnce(context.Background()); attempted != 2 {
+		t.Fatalf("RunOnce attempted %d, want 2", attempted)
+	}
+	if want := base.Add(messageLease); !sender.leases["a@example.com"].Equal(want) {
+		t.Fatalf("first lease = %s, want %s", sender.leases["a@example.com"], want)
+	}
+	// The second message waited for the only slot; its lease must start
+	// when it was claimed, not when the batch was listed.
+	if want := base.Add(time.Minute + messageLease); !sender.leases["b@example.com"].Equal(want) {
+		t.Fatalf("second lease = %s, want %s", sender.leases["b@example.com"], want)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	base := 30 * time.Second
+	max := 10 * time.Minute
+	if got := backoff(0, base, max); got != base {
+		t.Fatalf("backoff(0) = %s, want %s", got, base)
+	}
+	if got := backoff(3, base, max); got != 2*time.Minute {
+		t.Fatalf("backoff(3) = %s, want 2m", got)
+	}
+	if got := backoff(10, base, max); got != max {
+		t.Fatalf("backoff(10) = %s, want %s", got, max)
+	}
+}
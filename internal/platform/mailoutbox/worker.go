@@ -0,0 +1,191 @@
+package mailoutbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+const (
+	// messageLease must outlive a send that walks through every relay, so
+	// another instance does not pick the same message up meanwhile.
+	messageLease       = 5 * time.Minute
+	pruneInterval      = time.Hour
+	workerStepTimeout  = time.Minute
+	expiredErrorReason = "expired before it could be sent"
+)
+
+// Start sends queued mail every PollInterval, or sooner when Notify is
+// called, until ctx is cancelled. Call the returned wait before closing the
+// database.
+func (o *Outbox) Start(ctx context.Context) func() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(o.options.PollInterval)
+		defer ticker.Stop()
+		var lastPrunedAt time.Time
+		for {
+			o.RunOnce(ctx)
+			if now := outboxNow(); now.Sub(lastPrunedAt) >= pruneInterval {
+				o.prune(ctx, now)
+				lastPrunedAt = now
+			}
+			select {
+			case <-ctx.Done():
+				o.logger.Infof("mail outbox worker stopped")
+				return
+			case <-ticker.C:
+			case <-wake:
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+// RunOnce claims and sends one batch of due messages and returns how many
+// were attempted.
+func (o *Outbox) RunOnce(ctx context.Context) int {
+	now := outboxNow().UTC()
+	due, err := o.db.ListDueEmailMessages(ctx, now, o.options.BatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			o.logger.Errorf("Failed to list due mail: %v", err)
+		}
+		return 0
+	}
+
+	sem := make(chan struct{}, o.options.Concurrency)
+	var wg sync.WaitGroup
+	attempted := 0
+	for _, message := range due {
+		// Take a sending slot before claiming so a claimed message never
+		// waits in line while its lease runs out.
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return attempted
+		}
+		claimedAt := outboxNow().UTC()
+		claimed, err := o.db.ClaimEmailMessage(ctx, message, claimedAt, claimedAt.Add(messageLease))
+		if err != nil || !claimed {
+			if err != nil {
+				o.logger.Errorf("Failed to claim mail %s: %v", message.ID, err)
+			}
+			<-sem
+			continue
+		}
+		message.Attempts++
+		attempted++
+		wg.Add(1)
+		go func(m *postgresql.EmailMessage) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			o.deliver(ctx, m)
+		}(message)
+	}
+	wg.Wait()
+	return attempted
+}
+
+func (o *Outbox) deliver(ctx context.Context, message *postgresql.EmailMessage) {
+	stepCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), workerStepTimeout)
+	defer cancel()
+	now := outboxNow().UTC()
+	if message.ExpiresAt != nil && !now.Before(*message.ExpiresAt) {
+		o.finish(stepCtx, message, emailmessage.StatusExpired, "", expiredErrorReason)
+		return
+	}
+	suppressed, err := o.db.SuppressedEmailAddresses(stepCtx, []string{message.Recipient})
+	if err != nil {
+		o.retry(stepCtx, message, err, now)
+		return
+	}
+	if suppressed[message.Recipient] {
+		// Suppressed after the message was queued.
+		o.finish(stepCtx, message, emailmessage.StatusSuppressed, "", "")
+		return
+	}
+
+	relay, err := o.sender.SendMessage(harukiSMTP.Message{
+		ID:          message.ID,
+		To:          []string{message.Recipient},
+		Subject:     message.Subject,
+		Body:        message.Body,
+		DisplayName: message.DisplayName,
+	})
+	now = outboxNow().UTC()
+	if err == nil {
+		o.finish(stepCtx, message, emailmessage.StatusSent, relay, "")
+		return
+	}
+	var rejected *harukiSMTP.RecipientRejectedError
+	if errors.As(err, &rejected) {
+		o.finish(stepCtx, message, emailmessage.StatusBounced, relay, err.Error())
+		if err := o.db.RecordEmailBounce(stepCtx, message.Recipient, err.Error(), now); err != nil {
+			o.logger.Errorf("Failed to suppress bounced address of mail %s: %v", message.ID, err)
+		}
+		return
+	}
+	o.retry(stepCtx, message, err, now)
+}
+
+func (o *Outbox) retry(ctx context.Context, message *postgresql.EmailMessage, cause error, now time.Time) {
+	if message.Attempts >= message.MaxAttempts {
+		o.logger.Warnf("Mail %s to %s failed after %d attempts: %v", message.ID, message.Recipient, message.Attempts, cause)
+		o.finish(ctx, message, emailmessage.StatusFailed, "", cause.Error())
+		return
+	}
+	next := now.Add(backoff(message.Attempts, o.options.BaseBackoff, o.options.MaxBackoff))
+	if message.ExpiresAt != nil && !next.Before(*message.ExpiresAt) {
+		o.finish(ctx, message, emailmessage.StatusExpired, "", cause.Error())
+		return
+	}
+	if err := o.db.RetryEmailMessage(ctx, message.ID, cause.Error(), next); err != nil {
+		o.logger.Errorf("Failed to schedule retry of mail %s: %v", message.ID, err)
+	}
+}
+
+func (o *Outbox) finish(ctx context.Context, message *postgresql.EmailMessage, status emailmessage.Status, relay, reason string) {
+	if err := o.db.FinishEmailMessage(ctx, message.ID, status, relay, reason, outboxNow().UTC()); err != nil {
+		o.logger.Errorf("Failed to mark mail %s as %s: %v", message.ID, status, err)
+	}
+}
+
+func (o *Outbox) prune(ctx context.Context, now time.Time) {
+	deleted, err := o.db.PurgeFinishedEmailMessages(ctx, now.UTC().Add(-o.options.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			o.logger.Warnf("Failed to prune sent mail: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		o.logger.Infof("pruned %d finished mail message(s)", deleted)
+	}
+}
+
+// backoff returns base * 2^(attempt-1), capped at max.
+func backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
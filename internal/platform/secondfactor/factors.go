@@ -0,0 +1,341 @@
+package secondfactor
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/userrecoverycode"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/usertotp"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webauthncredential"
+
+	sql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+// Status is what a user sees about their own second factors. Secrets and
+// public keys never leave the package.
+type Status struct {
+	Enrolled               bool                 `json:"enrolled"`
+	TOTPEnabled            bool                 `json:"totpEnabled"`
+	TOTPPending            bool                 `json:"totpPending"`
+	RecoveryCodesRemaining int                  `json:"recoveryCodesRemaining"`
+	WebAuthnAvailable      bool                 `json:"webauthnAvailable"`
+	WebAuthnCredentials    []WebAuthnCredential `json:"webauthnCredentials"`
+}
+
+type WebAuthnCredential struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// WebAuthnCredentialFromRow converts a credential row for API responses.
+func WebAuthnCredentialFromRow(row *postgresql.WebAuthnCredential) WebAuthnCredential {
+	item := WebAuthnCredential{ID: row.ID, Name: row.Name, CreatedAt: row.CreatedAt.UTC()}
+	if row.LastUsedAt != nil {
+		lastUsedAt := row.LastUsedAt.UTC()
+		item.LastUsedAt = &lastUsedAt
+	}
+	return item
+}
+
+// GetStatus returns the second factors of userID.
+func GetStatus(ctx context.Context, db *postgresql.Client, userID string) (Status, error) {
+	status := Status{WebAuthnAvailable: WebAuthnEnabled(), WebAuthnCredentials: []WebAuthnCredential{}}
+	totp, err := db.UserTOTP.Query().Where(usertotp.UserIDEQ(userID)).Only(ctx)
+	if err != nil && !postgresql.IsNotFound(err) {
+		return status, err
+	}
+	if totp != nil {
+		status.TOTPEnabled = totp.ConfirmedAt != nil
+		status.TOTPPending = totp.ConfirmedAt == nil
+	}
+	rows, err := db.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userID)).
+		Order(webauthncredential.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return status, err
+	}
+	for _, row := range rows {
+		status.WebAuthnCredentials = append(status.WebAuthnCredentials, WebAuthnCredentialFromRow(row))
+	}
+	if status.RecoveryCodesRemaining, err = countRecoveryCodes(ctx, db, userID); err != nil {
+		return status, err
+	}
+	status.Enrolled = status.TOTPEnabled || len(rows) > 0
+	return status, nil
+}
+
+// Enrolled reports whether userID has a confirmed authenticator app or a
+// registered security key.
+func Enrolled(ctx context.Context, db *postgresql.Client, userID string) (bool, error) {
+	totp, err := db.UserTOTP.Query().
+		Where(usertotp.UserIDEQ(userID), usertotp.ConfirmedAtNotNil()).
+		Exist(ctx)
+	if err != nil || totp {
+		return totp, err
+	}
+	return db.WebAuthnCredential.Query().Where(webauthncredential.UserIDEQ(userID)).Exist(ctx)
+}
+
+// Methods lists the ways userID can pass the second factor right now.
+func Methods(ctx context.Context, db *postgresql.Client, userID string) ([]string, error) {
+	status, err := GetStatus(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	methods := make([]string, 0, 3)
+	if status.TOTPEnabled {
+		methods = append(methods, MethodTOTP)
+	}
+	if len(status.WebAuthnCredentials) > 0 && status.WebAuthnAvailable {
+		methods = append(methods, MethodWebAuthn)
+	}
+	if status.RecoveryCodesRemaining > 0 {
+		methods = append(methods, MethodRecoveryCode)
+	}
+	return methods, nil
+}
+
+// RequiredForRole applies the admin policy to one user. Regular users are
+// never required to present a second factor for admin routes because they
+// cannot reach them.
+func RequiredForRole(ctx context.Context, db *postgresql.Client, userID, role string) (required bool, enrolled bool, err error) {
+	if role != string(userSchema.RoleAdmin) && role != string(userSchema.RoleSuperAdmin) {
+		return false, false, nil
+	}
+	policy := CurrentOptions().AdminPolicy
+	if policy == PolicyOff {
+		return false, false, nil
+	}
+	enrolled, err = Enrolled(ctx, db, userID)
+	if err != nil {
+		return false, false, err
+	}
+	return policy == PolicyRequired || enrolled, enrolled, nil
+}
+
+// BeginTOTPEnrollment stores a new unconfirmed secret for userID and
+// returns it. An unfinished earlier enrollment is replaced.
+func BeginTOTPEnrollment(ctx context.Context, db *postgresql.Client, userID string, now time.Time) (string, error) {
+	existing, err := db.UserTOTP.Query().Where(usertotp.UserIDEQ(userID)).Only(ctx)
+	if err != nil && !postgresql.IsNotFound(err) {
+		return "", err
+	}
+	if existing != nil && existing.ConfirmedAt != nil {
+		return "", fiber.NewError(fiber.StatusConflict, "an authenticator app is already enrolled")
+	}
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return secret, db.UserTOTP.UpdateOne(existing).SetSecret(secret).SetLastUsedStep(0).SetUpdatedAt(now).Exec(ctx)
+	}
+	return secret, db.UserTOTP.Create().SetUserID(userID).SetSecret(secret).SetCreatedAt(now).SetUpdatedAt(now).Exec(ctx)
+}
+
+// ConfirmTOTPEnrollment activates the pending secret once the user proves
+// their app produces the right code. It returns new recovery codes when
+// this is the user's first second factor.
+func ConfirmTOTPEnrollment(ctx context.Context, db *postgresql.Client, userID, code string, now time.Time) ([]string, error) {
+	row, err := db.UserTOTP.Query().Where(usertotp.UserIDEQ(userID)).Only(ctx)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "no authenticator app enrollment in progress")
+		}
+		return nil, err
+	}
+	if row.ConfirmedAt != nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "an authenticator app is already enrolled")
+	}
+	step, ok := matchTOTP(row.Secret, code, now, row.LastUsedStep)
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid verification code")
+	}
+	affected, err := db.UserTOTP.Update().
+		Where(usertotp.IDEQ(row.ID), usertotp.ConfirmedAtIsNil()).
+		SetConfirmedAt(now).
+		SetLastUsedStep(step).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, fiber.NewError(fiber.StatusConflict, "an authenticator app is already enrolled")
+	}
+	return ensureRecoveryCodes(ctx, db, userID, now)
+}
+
+// DisableTOTP removes the authenticator app of userID.
+func DisableTOTP(ctx context.Context, db *postgresql.Client, userID string) (bool, error) {
+	deleted, err := db.UserTOTP.Delete().Where(usertotp.UserIDEQ(userID)).Exec(ctx)
+	if err != nil || deleted == 0 {
+		return false, err
+	}
+	return true, dropOrphanedRecoveryCodes(ctx, db, userID)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of an enrolled user.
+func RegenerateRecoveryCodes(ctx context.Context, db *postgresql.Client, userID string, now time.Time) ([]string, error) {
+	enrolled, err := Enrolled(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !enrolled {
+		return nil, fiber.NewError(fiber.StatusConflict, "no second factor enrolled")
+	}
+	return replaceRecoveryCodes(ctx, db, userID, now)
+}
+
+func countRecoveryCodes(ctx context.Context, db *postgresql.Client, userID string) (int, error) {
+	return db.UserRecoveryCode.Query().
+		Where(userrecoverycode.UserIDEQ(userID), userrecoverycode.UsedAtIsNil()).
+		Count(ctx)
+}
+
+func ensureRecoveryCodes(ctx context.Context, db *postgresql.Client, userID string, now time.Time) ([]string, error) {
+	remaining, err := countRecoveryCodes(ctx, db, userID)
+	if err != nil || remaining > 0 {
+		return nil, err
+	}
+	return replaceRecoveryCodes(ctx, db, userID, now)
+}
+
+func replaceRecoveryCodes(ctx context.Context, db *postgresql.Client, userID string, now time.Time) ([]string, error) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.UserRecoveryCode.Delete().Where(userrecoverycode.UserIDEQ(userID)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	builders := make([]*postgresql.UserRecoveryCodeCreate, 0, len(hashes))
+	for _, hash := range hashes {
+		builders = append(builders, tx.UserRecoveryCode.Create().SetUserID(userID).SetCodeHash(hash).SetCreatedAt(now))
+	}
+	if err := tx.UserRecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// dropOrphanedRecoveryCodes removes the recovery codes once the last
+// factor is gone, so they cannot outlive what they recover.
+func dropOrphanedRecoveryCodes(ctx context.Context, db *postgresql.Client, userID string) error {
+	enrolled, err := Enrolled(ctx, db, userID)
+	if err != nil || enrolled {
+		return err
+	}
+	_, err = db.UserRecoveryCode.Delete().Where(userrecoverycode.UserIDEQ(userID)).Exec(ctx)
+	return err
+}
+
+// Assertion is the second-factor proof sent with a login or a
+// reauthentication. Exactly one field is expected.
+type Assertion struct {
+	TOTPCode     string          `json:"totpCode,omitempty"`
+	RecoveryCode string          `json:"recoveryCode,omitempty"`
+	WebAuthn     json.RawMessage `json:"webauthn,omitempty"`
+}
+
+func (a Assertion) Empty() bool {
+	return strings.TrimSpace(a.TOTPCode) == "" && strings.TrimSpace(a.RecoveryCode) == "" && len(a.WebAuthn) == 0
+}
+
+var errInvalidSecondFactor = fiber.NewError(fiber.StatusForbidden, "invalid second factor")
+
+// Verify checks an assertion of userID and returns the method it used.
+// TOTP steps, recovery codes and WebAuthn challenges are all single use.
+// purpose must match the one given to BeginWebAuthnAssertion.
+func Verify(ctx context.Context, db *postgresql.Client, rdb *redis.Client, userID, purpose string, assertion Assertion, now time.Time) (string, error) {
+	switch {
+	case len(assertion.WebAuthn) > 0:
+		return MethodWebAuthn, finishWebAuthnAssertion(ctx, db, rdb, userID, purpose, assertion.WebAuthn, now)
+	case strings.TrimSpace(assertion.TOTPCode) != "":
+		return MethodTOTP, verifyTOTP(ctx, db, userID, assertion.TOTPCode, now)
+	case strings.TrimSpace(assertion.RecoveryCode) != "":
+		return MethodRecoveryCode, consumeRecoveryCode(ctx, db, userID, assertion.RecoveryCode, now)
+	default:
+		return "", fiber.NewError(fiber.StatusBadRequest, "second factor is required")
+	}
+}
+
+func verifyTOTP(ctx context.Context, db *postgresql.Client, userID, code string, now time.Time) error {
+	row, err := db.UserTOTP.Query().Where(usertotp.UserIDEQ(userID), usertotp.ConfirmedAtNotNil()).Only(ctx)
+	if err != nil {
+		if postgresql.IsNotFound(err) {
+			return errInvalidSecondFactor
+		}
+		return err
+	}
+	step, ok := matchTOTP(row.Secret, code, now, row.LastUsedStep)
+	if !ok {
+		return errInvalidSecondFactor
+	}
+	// Only one request can move last_used_step past this step.
+	affected, err := db.UserTOTP.Update().
+		Where(usertotp.IDEQ(row.ID), usertotp.LastUsedStepLT(step)).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errInvalidSecondFactor
+	}
+	return nil
+}
+
+func consumeRecoveryCode(ctx context.Context, db *postgresql.Client, userID, code string, now time.Time) error {
+	affected, err := db.UserRecoveryCode.Update().
+		Where(
+			userrecoverycode.UserIDEQ(userID),
+			userrecoverycode.CodeHashEQ(HashRecoveryCode(code)),
+			userrecoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errInvalidSecondFactor
+	}
+	return nil
+}
+
+// DeleteAll removes every second factor of userID inside tx and returns
+// how many rows went. Account deletion uses it.
+func DeleteAll(ctx context.Context, tx *postgresql.Tx, userID string) (int, error) {
+	totp, err := tx.UserTOTP.Delete().Where(usertotp.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	credentials, err := tx.WebAuthnCredential.Delete().Where(webauthncredential.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	codes, err := tx.UserRecoveryCode.Delete().Where(userrecoverycode.UserIDEQ(userID)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return totp + credentials + codes, nil
+}
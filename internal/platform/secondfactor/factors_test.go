@@ -0,0 +1,206 @@
+package secondfactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v3"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+)
+
+func expectFiberStatus(t *testing.T, err error, want int) {
+	t.Helper()
+	fiberErr, ok := err.(*fiber.Error)
+	if !ok || fiberErr.Code != want {
+		t.Fatalf("error = %v, want fiber error %d", err, want)
+	}
+}
+
+func openTestStores(t *testing.T, name string) (*postgresql.Client, *redis.Client) {
+	t.Helper()
+	db := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	srv := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	return db, rdb
+}
+
+func configureForTest(t *testing.T, options Options) {
+	t.Helper()
+	if err := Configure(options); err != nil {
+		t.Fatalf("Configure returned error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = Configure(DefaultOptions())
+	})
+}
+
+func TestTOTPEnrollmentAndVerification(t *testing.T) {
+	db, rdb := openTestStores(t, "second-factor-totp-test")
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	secret, err := BeginTOTPEnrollment(ctx, db, "u1", now)
+	if err != nil {
+		t.Fatalf("BeginTOTPEnrollment returned error: %v", err)
+	}
+	if enrolled, _ := Enrolled(ctx, db, "u1"); enrolled {
+		t.Fatalf("expected a pending enrollment not to count as enrolled")
+	}
+	code, _ := TOTPCode(secret, now)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	_, err = ConfirmTOTPEnrollment(ctx, db, "u1", wrong, now)
+	expectFiberStatus(t, err, fiber.StatusBadRequest)
+
+	codes, err := ConfirmTOTPEnrollment(ctx, db, "u1", code, now)
+	if err != nil || len(codes) != RecoveryCodeCount {
+		t.Fatalf("ConfirmTOTPEnrollment = %d codes, %v; want %d codes", len(codes), err, RecoveryCodeCount)
+	}
+	_, err = BeginTOTPEnrollment(ctx, db, "u1", now)
+	expectFiberStatus(t, err, fiber.StatusConflict)
+
+	// The code that confirmed the enrollment cannot be replayed.
+	_, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{TOTPCode: code}, now)
+	expectFiberStatus(t, err, fiber.StatusForbidden)
+
+	later := now.Add(time.Minute)
+	nextCode, _ := TOTPCode(secret, later)
+	method, err := Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{TOTPCode: nextCode}, later)
+	if err != nil || method != MethodTOTP {
+		t.Fatalf("Verify = %q, %v; want totp", method, err)
+	}
+	_, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{TOTPCode: nextCode}, later)
+	expectFiberStatus(t, err, fiber.StatusForbidden)
+
+	// Recovery codes work once, in any case and with or without the dash.
+	method, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{RecoveryCode: " " + codes[0][:5] + codes[0][6:] + " "}, later)
+	if err != nil || method != MethodRecoveryCode {
+		t.Fatalf("Verify recovery code = %q, %v", method, err)
+	}
+	_, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{RecoveryCode: codes[0]}, later)
+	expectFiberStatus(t, err, fiber.StatusForbidden)
+
+	status, err := GetStatus(ctx, db, "u1")
+	if err != nil || !status.Enrolled || !status.TOTPEnabled || status.RecoveryCodesRemaining != RecoveryCodeCount-1 {
+		t.Fatalf("unexpected status %+v, %v", status, err)
+	}
+
+	regenerated, err := RegenerateRecoveryCodes(ctx, db, "u1", later)
+	if err != nil || len(regenerated) != RecoveryCodeCount {
+		t.Fatalf("RegenerateRecoveryCodes = %d codes, %v", len(regenerated), err)
+	}
+	_, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{RecoveryCode: codes[1]}, later)
+	expectFiberStatus(t, err, fiber.StatusForbidden)
+
+	removed, err := DisableTOTP(ctx, db, "u1")
+	if err != nil || !removed {
+		t.Fatalf("DisableTOTP = %v, %v", removed, err)
+	}
+	status, err = GetStatus(ctx, db, "u1")
+	if err != nil || status.Enrolled || status.RecoveryCodesRemaining != 0 {
+		t.Fatalf("expected recovery codes to go with the last factor, got %+v, %v", status, err)
+	}
+	_, err = RegenerateRecoveryCodes(ctx, db, "u1", later)
+	expectFiberStatus(t, err, fiber.StatusConflict)
+}
+
+func TestRequiredForRoleFollowsPolicy(t *testing.T) {
+	db, _ := openTestStores(t, "second-factor-policy-test")
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	secret, err := BeginTOTPEnrollment(ctx, db, "enrolled-admin", now)
+	if err != nil {
+		t.Fatalf("BeginTOTPEnrollment returned error: %v", err)
+	}
+	code, _ := TOTPCode(secret, now)
+	if _, err := ConfirmTOTPEnrollment(ctx, db, "enrolled-admin", code, now); err != nil {
+		t.Fatalf("ConfirmTOTPEnrollment returned error: %v", err)
+	}
+
+	cases := []struct {
+		policy           string
+		userID           string
+		role             string
+		wantRequired     bool
+		wantEnrolledFlag bool
+	}{
+		{PolicyOff, "enrolled-admin", "admin", false, false},
+		{PolicyEnrolled, "enrolled-admin", "admin", true, true},
+		{PolicyEnrolled, "plain-admin", "super_admin", false, false},
+		{PolicyRequired, "plain-admin", "admin", true, false},
+		{PolicyRequired, "enrolled-admin", "user", false, false},
+	}
+	for _, tc := range cases {
+		configureForTest(t, Options{AdminPolicy: tc.policy})
+		required, enrolled, err := RequiredForRole(ctx, db, tc.userID, tc.role)
+		if err != nil || required != tc.wantRequired || enrolled != tc.wantEnrolledFlag {
+			t.Fatalf("RequiredForRole(%s, %s, %s) = %v, %v, %v", tc.policy, tc.userID, tc.role, required, enrolled, err)
+		}
+	}
+}
+
+func TestSessionVerification(t *testing.T) {
+	_, rdb := openTestStores(t, "second-factor-session-test")
+	ctx := context.Background()
+	configureForTest(t, Options{VerifiedSessionTTL: time.Hour})
+
+	if verified, err := SessionVerified(ctx, rdb, "u1", "kratos:s1"); err != nil || verified {
+		t.Fatalf("SessionVerified before marking = %v, %v", verified, err)
+	}
+	if err := MarkSessionVerified(ctx, rdb, "u1", "kratos:s1"); err != nil {
+		t.Fatalf("MarkSessionVerified returned error: %v", err)
+	}
+	if verified, err := SessionVerified(ctx, rdb, "u1", "kratos:s1"); err != nil || !verified {
+		t.Fatalf("SessionVerified after marking = %v, %v", verified, err)
+	}
+	if verified, _ := SessionVerified(ctx, rdb, "u1", "kratos:s2"); verified {
+		t.Fatalf("expected another session to stay unverified")
+	}
+	if ttl := rdb.TTL(ctx, verifiedSessionKey("u1", "kratos:s1")).Val(); ttl <= 0 || ttl > time.Hour {
+		t.Fatalf("unexpected verified session TTL %s", ttl)
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	db, _ := openTestStores(t, "second-factor-delete-test")
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	secret, _ := BeginTOTPEnrollment(ctx, db, "u1", now)
+	code, _ := TOTPCode(secret, now)
+	if _, err := ConfirmTOTPEnrollment(ctx, db, "u1", code, now); err != nil {
+		t.Fatalf("ConfirmTOTPEnrollment returned error: %v", err)
+	}
+	if _, err := BeginTOTPEnrollment(ctx, db, "u2", now); err != nil {
+		t.Fatalf("BeginTOTPEnrollment returned error: %v", err)
+	}
+
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		t.Fatalf("Tx returned error: %v", err)
+	}
+	deleted, err := DeleteAll(ctx, tx, "u1")
+	if err != nil || deleted != 1+RecoveryCodeCount {
+		_ = tx.Rollback()
+		t.Fatalf("DeleteAll = %d, %v; want %d", deleted, err, 1+RecoveryCodeCount)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit returned error: %v", err)
+	}
+	if count := db.UserTOTP.Query().CountX(ctx); count != 1 {
+		t.Fatalf("expected other users' factors to stay, got %d", count)
+	}
+}
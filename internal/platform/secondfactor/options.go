@@ -0,0 +1,114 @@
+package secondfactor
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+const (
+	PolicyOff      = "off"
+	PolicyEnrolled = "enrolled"
+	PolicyRequired = "required"
+
+	MethodTOTP         = "totp"
+	MethodWebAuthn     = "webauthn"
+	MethodRecoveryCode = "recovery_code"
+)
+
+type Options struct {
+	// Issuer is the account label shown in authenticator apps.
+	Issuer string
+	// AdminPolicy decides when admin routes require a session that passed
+	// the second factor; see RequiredForRole.
+	AdminPolicy        string
+	VerifiedSessionTTL time.Duration
+	// WebAuthn stays disabled while RPID is empty; TOTP works either way.
+	WebAuthnRPID          string
+	WebAuthnRPDisplayName string
+	WebAuthnOrigins       []string
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Issuer:             "Haruki Toolbox",
+		AdminPolicy:        PolicyOff,
+		VerifiedSessionTTL: 24 * time.Hour,
+	}
+}
+
+var (
+	optionsMu      sync.RWMutex
+	currentOptions = DefaultOptions()
+	relyingParty   *webauthn.WebAuthn
+)
+
+// NormalizePolicy maps a configured admin policy to one of the Policy
+// constants; unknown values fall back to PolicyOff.
+func NormalizePolicy(policy string) string {
+	switch strings.ToLower(strings.TrimSpace(policy)) {
+	case PolicyEnrolled:
+		return PolicyEnrolled
+	case PolicyRequired:
+		return PolicyRequired
+	default:
+		return PolicyOff
+	}
+}
+
+// Configure replaces the options and the WebAuthn relying party. It is
+// called once at startup and fails on an invalid WebAuthn setup.
+func Configure(options Options) error {
+	defaults := DefaultOptions()
+	options.Issuer = strings.TrimSpace(options.Issuer)
+	if options.Issuer == "" {
+		options.Issuer = defaults.Issuer
+	}
+	options.AdminPolicy = NormalizePolicy(options.AdminPolicy)
+	if options.VerifiedSessionTTL <= 0 {
+		options.VerifiedSessionTTL = defaults.VerifiedSessionTTL
+	}
+	options.WebAuthnRPID = strings.TrimSpace(options.WebAuthnRPID)
+
+	var rp *webauthn.WebAuthn
+	if options.WebAuthnRPID != "" {
+		displayName := strings.TrimSpace(options.WebAuthnRPDisplayName)
+		if displayName == "" {
+			displayName = options.Issuer
+		}
+		var err error
+		rp, err = webauthn.New(&webauthn.Config{
+			RPID:          options.WebAuthnRPID,
+			RPDisplayName: displayName,
+			RPOrigins:     options.WebAuthnOrigins,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	optionsMu.Lock()
+	currentOptions = options
+	relyingParty = rp
+	optionsMu.Unlock()
+	return nil
+}
+
+func CurrentOptions() Options {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return currentOptions
+}
+
+// WebAuthnEnabled reports whether security keys and passkeys can be used.
+func WebAuthnEnabled() bool {
+	return currentRelyingParty() != nil
+}
+
+func currentRelyingParty() *webauthn.WebAuthn {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return relyingParty
+}
@@ -0,0 +1,31 @@
+package secondfactor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const verifiedSessionKeyPrefix = "second-factor:session:"
+
+func verifiedSessionKey(userID, sessionMarker string) string {
+	return verifiedSessionKeyPrefix + strings.TrimSpace(userID) + ":" + strings.TrimSpace(sessionMarker)
+}
+
+// MarkSessionVerified records that the session identified by sessionMarker
+// passed the second factor. The marker has the same form as the one used
+// for admin reauthentication ("kratos:<session id>").
+func MarkSessionVerified(ctx context.Context, rdb *redis.Client, userID, sessionMarker string) error {
+	return rdb.Set(ctx, verifiedSessionKey(userID, sessionMarker), "1", CurrentOptions().VerifiedSessionTTL).Err()
+}
+
+// SessionVerified reports whether the session passed the second factor
+// within VerifiedSessionTTL.
+func SessionVerified(ctx context.Context, rdb *redis.Client, userID, sessionMarker string) (bool, error) {
+	exists, err := rdb.Exists(ctx, verifiedSessionKey(userID, sessionMarker)).Result()
+	if err != nil {
+		return false, err
+	}
+	return exists > 0, nil
+}
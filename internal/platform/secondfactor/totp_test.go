@@ -0,0 +1,63 @@
+package secondfactor
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTOTPCodeMatchesRFC6238(t *testing.T) {
+	// RFC 6238 appendix B, SHA-1 secret, truncated to six digits.
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range cases {
+		got, err := TOTPCode(secret, time.Unix(unix, 0))
+		if err != nil || got != want {
+			t.Fatalf("TOTPCode(%d) = %q, %v; want %q", unix, got, err, want)
+		}
+	}
+}
+
+func TestMatchTOTPRejectsUsedAndDistantSteps(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("GenerateTOTPSecret returned error: %v", err)
+	}
+	now := time.Unix(1_800_000_000, 0)
+	code, _ := TOTPCode(secret, now)
+
+	step, ok := matchTOTP(secret, code, now, 0)
+	if !ok || step != totpStep(now) {
+		t.Fatalf("matchTOTP = %d, %v; want current step", step, ok)
+	}
+	if _, ok := matchTOTP(secret, code, now, step); ok {
+		t.Fatalf("expected a used step to be rejected")
+	}
+	if _, ok := matchTOTP(secret, code, now.Add(30*time.Second), 0); !ok {
+		t.Fatalf("expected the previous step to be accepted")
+	}
+	if _, ok := matchTOTP(secret, code, now.Add(2*time.Minute), 0); ok {
+		t.Fatalf("expected a distant step to be rejected")
+	}
+	if _, ok := matchTOTP(secret, "12345", now, 0); ok {
+		t.Fatalf("expected a short code to be rejected")
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	raw := TOTPURI("Haruki Toolbox", "user@example.com", "JBSWY3DPEHPK3PXP")
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("TOTPURI returned invalid URL %q: %v", raw, err)
+	}
+	query := parsed.Query()
+	if parsed.Scheme != "otpauth" || parsed.Host != "totp" || query.Get("secret") != "JBSWY3DPEHPK3PXP" || query.Get("issuer") != "Haruki Toolbox" {
+		t.Fatalf("unexpected TOTP URI %q", raw)
+	}
+}
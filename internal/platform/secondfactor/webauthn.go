@@ -0,0 +1,254 @@
+package secondfactor
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webauthncredential"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	PurposeLogin  = "login"
+	PurposeReauth = "reauth"
+
+	purposeRegister = "register"
+
+	MaxWebAuthnCredentials = 10
+	MaxCredentialNameRunes = 64
+
+	// ceremonyTTL is how long a WebAuthn challenge can be answered.
+	ceremonyTTL       = 5 * time.Minute
+	ceremonyKeyPrefix = "second-factor:webauthn:"
+)
+
+// webAuthnUser adapts a toolbox user to the WebAuthn library. The user
+// handle is the toolbox user id.
+type webAuthnUser struct {
+	id          string
+	name        string
+	displayName string
+	credentials []webauthn.Credential
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte                         { return []byte(u.id) }
+func (u *webAuthnUser) WebAuthnName() string                       { return u.name }
+func (u *webAuthnUser) WebAuthnDisplayName() string                { return u.displayName }
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential { return u.credentials }
+
+// ceremony is what is kept in Redis between the begin and finish step.
+type ceremony struct {
+	Session webauthn.SessionData `json:"session"`
+	Name    string               `json:"name,omitempty"`
+}
+
+func ceremonyKey(purpose, userID string) string {
+	return ceremonyKeyPrefix + purpose + ":" + userID
+}
+
+func saveCeremony(ctx context.Context, rdb *redis.Client, purpose, userID string, state ceremony) error {
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return rdb.Set(ctx, ceremonyKey(purpose, userID), payload, ceremonyTTL).Err()
+}
+
+// takeCeremony loads and deletes the pending ceremony, so every challenge
+// can be answered once.
+func takeCeremony(ctx context.Context, rdb *redis.Client, purpose, userID string) (ceremony, error) {
+	var state ceremony
+	payload, err := rdb.GetDel(ctx, ceremonyKey(purpose, userID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return state, fiber.NewError(fiber.StatusBadRequest, "security key challenge expired, please try again")
+		}
+		return state, err
+	}
+	if err := json.Unmarshal(payload, &state); err != nil {
+		return state, err
+	}
+	return state, nil
+}
+
+func loadWebAuthnUser(ctx context.Context, db *postgresql.Client, userID, name, displayName string) (*webAuthnUser, []*postgresql.WebAuthnCredential, error) {
+	rows, err := db.WebAuthnCredential.Query().Where(webauthncredential.UserIDEQ(userID)).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	user := &webAuthnUser{id: userID, name: name, displayName: displayName}
+	for _, row := range rows {
+		var credential webauthn.Credential
+		if err := json.Unmarshal(row.Credential, &credential); err != nil {
+			return nil, nil, err
+		}
+		user.credentials = append(user.credentials, credential)
+	}
+	return user, rows, nil
+}
+
+func requireRelyingParty() (*webauthn.WebAuthn, error) {
+	rp := currentRelyingParty()
+	if rp == nil {
+		return nil, fiber.NewError(fiber.StatusServiceUnavailable, "security keys are not configured")
+	}
+	return rp, nil
+}
+
+// NormalizeCredentialName trims a security key label and checks its length.
+func NormalizeCredentialName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if len([]rune(name)) > MaxCredentialNameRunes {
+		return "", fiber.NewError(fiber.StatusBadRequest, "name is too long")
+	}
+	return name, nil
+}
+
+// BeginWebAuthnRegistration returns the options passed to
+// navigator.credentials.create(). Keys the user already registered are
+// excluded.
+func BeginWebAuthnRegistration(ctx context.Context, db *postgresql.Client, rdb *redis.Client, userRow *postgresql.User, name string) (*protocol.CredentialCreation, error) {
+	rp, err := requireRelyingParty()
+	if err != nil {
+		return nil, err
+	}
+	user, rows, err := loadWebAuthnUser(ctx, db, userRow.ID, userRow.Email, userRow.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) >= MaxWebAuthnCredentials {
+		return nil, fiber.NewError(fiber.StatusConflict, "too many security keys registered")
+	}
+	creation, session, err := rp.BeginRegistration(user, webauthn.WithExclusions(webauthn.Credentials(user.credentials).CredentialDescriptors()))
+	if err != nil {
+		return nil, err
+	}
+	if err := saveCeremony(ctx, rdb, purposeRegister, userRow.ID, ceremony{Session: *session, Name: name}); err != nil {
+		return nil, err
+	}
+	return creation, nil
+}
+
+// FinishWebAuthnRegistration verifies the response of the authenticator
+// and stores the credential. It returns new recovery codes when this is
+// the user's first second factor.
+func FinishWebAuthnRegistration(ctx context.Context, db *postgresql.Client, rdb *redis.Client, userRow *postgresql.User, response []byte, now time.Time) (WebAuthnCredential, []string, error) {
+	rp, err := requireRelyingParty()
+	if err != nil {
+		return WebAuthnCredential{}, nil, err
+	}
+	state, err := takeCeremony(ctx, rdb, purposeRegister, userRow.ID)
+	if err != nil {
+		return WebAuthnCredential{}, nil, err
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return WebAuthnCredential{}, nil, fiber.NewError(fiber.StatusBadRequest, "invalid security key response")
+	}
+	user, _, err := loadWebAuthnUser(ctx, db, userRow.ID, userRow.Email, userRow.Name)
+	if err != nil {
+		return WebAuthnCredential{}, nil, err
+	}
+	credential, err := rp.CreateCredential(user, state.Session, parsed)
+	if err != nil {
+		return WebAuthnCredential{}, nil, fiber.NewError(fiber.StatusBadRequest, "security key verification failed")
+	}
+	record, err := json.Marshal(credential)
+	if err != nil {
+		return WebAuthnCredential{}, nil, err
+	}
+	row, err := db.WebAuthnCredential.Create().
+		SetID(base64.RawURLEncoding.EncodeToString(credential.ID)).
+		SetUserID(userRow.ID).
+		SetName(state.Name).
+		SetCredential(record).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		if postgresql.IsConstraintError(err) {
+			return WebAuthnCredential{}, nil, fiber.NewError(fiber.StatusConflict, "security key is already registered")
+		}
+		return WebAuthnCredential{}, nil, err
+	}
+	codes, err := ensureRecoveryCodes(ctx, db, userRow.ID, now)
+	if err != nil {
+		return WebAuthnCredential{}, nil, err
+	}
+	return WebAuthnCredentialFromRow(row), codes, nil
+}
+
+// DeleteWebAuthnCredential removes one security key of userID.
+func DeleteWebAuthnCredential(ctx context.Context, db *postgresql.Client, userID, credentialID string) (bool, error) {
+	deleted, err := db.WebAuthnCredential.Delete().
+		Where(webauthncredential.IDEQ(credentialID), webauthncredential.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil || deleted == 0 {
+		return false, err
+	}
+	return true, dropOrphanedRecoveryCodes(ctx, db, userID)
+}
+
+// BeginWebAuthnAssertion returns the options passed to
+// navigator.credentials.get(), or nil when the user has no usable key.
+func BeginWebAuthnAssertion(ctx context.Context, db *postgresql.Client, rdb *redis.Client, userID, purpose string) (*protocol.CredentialAssertion, error) {
+	rp := currentRelyingParty()
+	if rp == nil {
+		return nil, nil
+	}
+	user, _, err := loadWebAuthnUser(ctx, db, userID, userID, userID)
+	if err != nil || len(user.credentials) == 0 {
+		return nil, err
+	}
+	assertion, session, err := rp.BeginLogin(user)
+	if err != nil {
+		return nil, err
+	}
+	if err := saveCeremony(ctx, rdb, purpose, userID, ceremony{Session: *session}); err != nil {
+		return nil, err
+	}
+	return assertion, nil
+}
+
+func finishWebAuthnAssertion(ctx context.Context, db *postgresql.Client, rdb *redis.Client, userID, purpose string, response []byte, now time.Time) error {
+	rp := currentRelyingParty()
+	if rp == nil {
+		return errInvalidSecondFactor
+	}
+	state, err := takeCeremony(ctx, rdb, purpose, userID)
+	if err != nil {
+		return err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid security key response")
+	}
+	user, rows, err := loadWebAuthnUser(ctx, db, userID, userID, userID)
+	if err != nil {
+		return err
+	}
+	credential, err := rp.ValidateLogin(user, state.Session, parsed)
+	if err != nil {
+		return errInvalidSecondFactor
+	}
+	// Keep the sign counter and flags the library updated.
+	credentialID := base64.RawURLEncoding.EncodeToString(credential.ID)
+	record, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if row.ID == credentialID {
+			return db.WebAuthnCredential.UpdateOne(row).SetCredential(record).SetLastUsedAt(now).Exec(ctx)
+		}
+	}
+	return errInvalidSecondFactor
+}
@@ -0,0 +1,181 @@
+package secondfactor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gofiber/fiber/v3"
+)
+
+const (
+	testRPID   = "toolbox.example"
+	testOrigin = "https://toolbox.example"
+)
+
+// softAuthenticator is a minimal ES256 authenticator with "none"
+// attestation, enough to drive the relying party in tests.
+type softAuthenticator struct {
+	t         *testing.T
+	key       *ecdsa.PrivateKey
+	id        []byte
+	signCount uint32
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey returned error: %v", err)
+	}
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return &softAuthenticator{t: t, key: key, id: id}
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (a *softAuthenticator) clientData(kind string, challenge []byte) []byte {
+	data, _ := json.Marshal(map[string]string{"type": kind, "challenge": b64(challenge), "origin": testOrigin})
+	return data
+}
+
+func (a *softAuthenticator) authData(flags byte, attested []byte) []byte {
+	rpHash := sha256.Sum256([]byte(testRPID))
+	out := append([]byte{}, rpHash[:]...)
+	out = append(out, flags)
+	out = binary.BigEndian.AppendUint32(out, a.signCount)
+	return append(out, attested...)
+}
+
+func (a *softAuthenticator) register(challenge []byte) []byte {
+	enc, err := cbor.CTAP2EncOptions().EncMode()
+	if err != nil {
+		a.t.Fatalf("EncMode returned error: %v", err)
+	}
+	x := a.key.PublicKey.X.FillBytes(make([]byte, 32))
+	y := a.key.PublicKey.Y.FillBytes(make([]byte, 32))
+	publicKey, _ := enc.Marshal(map[int]any{1: 2, 3: -7, -1: 1, -2: x, -3: y})
+	attested := make([]byte, 16)
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.id)))
+	attested = append(attested, a.id...)
+	attested = append(attested, publicKey...)
+	attestation, _ := enc.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(0x45, attested),
+	})
+	body, _ := json.Marshal(map[string]any{
+		"id":    b64(a.id),
+		"rawId": b64(a.id),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    b64(a.clientData("webauthn.create", challenge)),
+			"attestationObject": b64(attestation),
+		},
+	})
+	return body
+}
+
+func (a *softAuthenticator) assert(challenge []byte, userHandle string) []byte {
+	a.signCount++
+	clientData := a.clientData("webauthn.get", challenge)
+	authData := a.authData(0x05, nil)
+	clientHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatalf("SignASN1 returned error: %v", err)
+	}
+	body, _ := json.Marshal(map[string]any{
+		"id":    b64(a.id),
+		"rawId": b64(a.id),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    b64(clientData),
+			"authenticatorData": b64(authData),
+			"signature":         b64(signature),
+			"userHandle":        b64([]byte(userHandle)),
+		},
+	})
+	return body
+}
+
+func TestWebAuthnRegistrationAndAssertion(t *testing.T) {
+	db, rdb := openTestStores(t, "second-factor-webauthn-test")
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	user := &postgresql.User{ID: "u1", Name: "User One", Email: "u1@example.com"}
+
+	_, err := BeginWebAuthnRegistration(ctx, db, rdb, user, "key")
+	expectFiberStatus(t, err, fiber.StatusServiceUnavailable)
+	configureForTest(t, Options{WebAuthnRPID: testRPID, WebAuthnOrigins: []string{testOrigin}})
+
+	authenticator := newSoftAuthenticator(t)
+	creation, err := BeginWebAuthnRegistration(ctx, db, rdb, user, "YubiKey")
+	if err != nil {
+		t.Fatalf("BeginWebAuthnRegistration returned error: %v", err)
+	}
+	credential, codes, err := FinishWebAuthnRegistration(ctx, db, rdb, user, authenticator.register(creation.Response.Challenge), now)
+	if err != nil {
+		t.Fatalf("FinishWebAuthnRegistration returned error: %v", err)
+	}
+	if credential.ID != b64(authenticator.id) || credential.Name != "YubiKey" || len(codes) != RecoveryCodeCount {
+		t.Fatalf("unexpected registration result %+v, %d codes", credential, len(codes))
+	}
+	// The registration challenge is single use.
+	_, _, err = FinishWebAuthnRegistration(ctx, db, rdb, user, authenticator.register(creation.Response.Challenge), now)
+	expectFiberStatus(t, err, fiber.StatusBadRequest)
+
+	methods, err := Methods(ctx, db, "u1")
+	if err != nil || len(methods) != 2 || methods[0] != MethodWebAuthn {
+		t.Fatalf("Methods = %v, %v; want webauthn and recovery codes", methods, err)
+	}
+
+	assertion, err := BeginWebAuthnAssertion(ctx, db, rdb, "u1", PurposeReauth)
+	if err != nil || assertion == nil {
+		t.Fatalf("BeginWebAuthnAssertion = %v, %v", assertion, err)
+	}
+	// A response for another purpose has no pending challenge.
+	_, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{WebAuthn: authenticator.assert(assertion.Response.Challenge, "u1")}, now)
+	expectFiberStatus(t, err, fiber.StatusBadRequest)
+
+	method, err := Verify(ctx, db, rdb, "u1", PurposeReauth, Assertion{WebAuthn: authenticator.assert(assertion.Response.Challenge, "u1")}, now)
+	if err != nil || method != MethodWebAuthn {
+		t.Fatalf("Verify = %q, %v; want webauthn", method, err)
+	}
+	status, err := GetStatus(ctx, db, "u1")
+	if err != nil || len(status.WebAuthnCredentials) != 1 || status.WebAuthnCredentials[0].LastUsedAt == nil {
+		t.Fatalf("expected last use to be recorded, got %+v, %v", status, err)
+	}
+
+	// A signature made with another key is rejected.
+	assertion, _ = BeginWebAuthnAssertion(ctx, db, rdb, "u1", PurposeLogin)
+	forged := newSoftAuthenticator(t)
+	forged.id = authenticator.id
+	_, err = Verify(ctx, db, rdb, "u1", PurposeLogin, Assertion{WebAuthn: forged.assert(assertion.Response.Challenge, "u1")}, now)
+	expectFiberStatus(t, err, fiber.StatusForbidden)
+
+	removed, err := DeleteWebAuthnCredential(ctx, db, "u1", credential.ID)
+	if err != nil || !removed {
+		t.Fatalf("DeleteWebAuthnCredential = %v, %v", removed, err)
+	}
+	if assertion, err := BeginWebAuthnAssertion(ctx, db, rdb, "u1", PurposeLogin); err != nil || assertion != nil {
+		t.Fatalf("expected no assertion without keys, got %v, %v", assertion, err)
+	}
+	if remaining, _ := countRecoveryCodes(ctx, db, "u1"); remaining != 0 {
+		t.Fatalf("expected recovery codes to go with the last key, %d left", remaining)
+	}
+}
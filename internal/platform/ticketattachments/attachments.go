@@ -0,0 +1,277 @@
+package ticketattachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+const (
+	// FormFieldFiles is the multipart field carrying attachment files.
+	FormFieldFiles = "attachments"
+
+	storageKeyPrefix = "ticket-attachments"
+)
+
+type Options struct {
+	Enabled            bool
+	MaxFileSizeBytes   int64
+	MaxFilesPerMessage int
+	MaxImagePixels     int64
+	ThumbnailMaxSize   int
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Enabled:            true,
+		MaxFileSizeBytes:   10 * 1024 * 1024,
+		MaxFilesPerMessage: 5,
+		MaxImagePixels:     8192 * 8192,
+		ThumbnailMaxSize:   320,
+	}
+}
+
+var (
+	optionsMu      sync.RWMutex
+	currentOptions = DefaultOptions()
+)
+
+// Configure replaces the attachment limits. It is called once at startup.
+func Configure(options Options) {
+	defaults := DefaultOptions()
+	if options.MaxFileSizeBytes <= 0 {
+		options.MaxFileSizeBytes = defaults.MaxFileSizeBytes
+	}
+	if options.MaxFilesPerMessage <= 0 {
+		options.MaxFilesPerMessage = defaults.MaxFilesPerMessage
+	}
+	if options.MaxImagePixels <= 0 {
+		options.MaxImagePixels = defaults.MaxImagePixels
+	}
+	if options.ThumbnailMaxSize <= 0 {
+		options.ThumbnailMaxSize = defaults.ThumbnailMaxSize
+	}
+	optionsMu.Lock()
+	currentOptions = options
+	optionsMu.Unlock()
+}
+
+func CurrentOptions() Options {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return currentOptions
+}
+
+// Item is the attachment shape returned with ticket messages.
+type Item struct {
+	ID           string    `json:"id"`
+	FileName     string    `json:"fileName"`
+	ContentType  string    `json:"contentType"`
+	SizeBytes    int64     `json:"sizeBytes"`
+	SHA256       string    `json:"sha256"`
+	HasThumbnail bool      `json:"hasThumbnail"`
+	Width        *int      `json:"width,omitempty"`
+	Height       *int      `json:"height,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func BuildItems(rows []*postgresql.TicketAttachment) []Item {
+	items := make([]Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, Item{
+			ID:           row.ID,
+			FileName:     row.FileName,
+			ContentType:  row.ContentType,
+			SizeBytes:    row.SizeBytes,
+			SHA256:       row.Sha256,
+			HasThumbnail: row.ThumbnailKey != nil,
+			Width:        row.Width,
+			Height:       row.Height,
+			CreatedAt:    row.CreatedAt.UTC(),
+		})
+	}
+	return items
+}
+
+func isMultipart(c fiber.Ctx) bool {
+	mediaType, _, err := mime.ParseMediaType(c.Get(fiber.HeaderContentType))
+	return err == nil && mediaType == fiber.MIMEMultipartForm
+}
+
+// PrepareRequest validates the files sent in the attachments field of a
+// multipart request. JSON requests carry no attachments.
+func PrepareRequest(c fiber.Ctx) ([]PreparedFile, error) {
+	if !isMultipart(c) {
+		return nil, nil
+	}
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid multipart form")
+	}
+	return Prepare(form.File[FormFieldFiles])
+}
+
+// PreparedFile is a validated attachment that has not been stored yet.
+type PreparedFile struct {
+	processed *processedFile
+}
+
+// Prepare reads and validates the uploaded files. Errors are *fiber.Error
+// values ready to be returned to the client.
+func Prepare(files []*multipart.FileHeader) ([]PreparedFile, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	options := CurrentOptions()
+	if !options.Enabled {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "ticket attachments are disabled")
+	}
+	if len(files) > options.MaxFilesPerMessage {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("at most %d attachments are allowed per message", options.MaxFilesPerMessage))
+	}
+	prepared := make([]PreparedFile, 0, len(files))
+	for _, header := range files {
+		if header.Size > options.MaxFileSizeBytes {
+			return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, "attachment is too large")
+		}
+		data, err := readFileHeader(header, options.MaxFileSizeBytes)
+		if err != nil {
+			return nil, err
+		}
+		processed, err := processFile(header.Filename, data, options)
+		if err != nil {
+			return nil, err
+		}
+		prepared = append(prepared, PreparedFile{processed: processed})
+	}
+	return prepared, nil
+}
+
+func readFileHeader(header *multipart.FileHeader, limit int64) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "failed to read attachment")
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	data, err := io.ReadAll(io.LimitReader(file, limit+1))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "failed to read attachment")
+	}
+	if int64(len(data)) > limit {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, "attachment is too large")
+	}
+	return data, nil
+}
+
+// Save uploads the prepared files and records them on messageID inside tx.
+// It returns the stored blob keys so the caller can call Discard if the
+// transaction does not commit.
+func Save(ctx context.Context, store blobstore.Store, tx *postgresql.Tx, ticketID, messageID int, uploaderUserID string, files []PreparedFile) ([]*postgresql.TicketAttachment, []string, error) {
+	if len(files) == 0 {
+		return nil, nil, nil
+	}
+	if store == nil {
+		return nil, nil, errors.New("blob store is not configured")
+	}
+	rows := make([]*postgresql.TicketAttachment, 0, len(files))
+	keys := make([]string, 0, len(files)*2)
+	for _, file := range files {
+		processed := file.processed
+		id := uuid.NewString()
+		key := fmt.Sprintf("%s/%d/%s", storageKeyPrefix, ticketID, id)
+		if err := store.Put(ctx, key, processed.ContentType, processed.Data); err != nil {
+			return nil, keys, fmt.Errorf("store attachment: %w", err)
+		}
+		keys = append(keys, key)
+		builder := tx.TicketAttachment.Create().
+			SetID(id).
+			SetTicketID(ticketID).
+			SetMessageID(messageID).
+			SetUploaderUserID(uploaderUserID).
+			SetFileName(processed.FileName).
+			SetContentType(processed.ContentType).
+			SetSizeBytes(int64(len(processed.Data))).
+			SetSha256(processed.SHA256).
+			SetStorageKey(key)
+		if processed.IsImage() {
+			thumbnailKey := key + "-thumb"
+			if err := store.Put(ctx, thumbnailKey, "image/jpeg", processed.Thumbnail); err != nil {
+				return nil, keys, fmt.Errorf("store attachment thumbnail: %w", err)
+			}
+			keys = append(keys, thumbnailKey)
+			builder.SetThumbnailKey(thumbnailKey).SetWidth(processed.Width).SetHeight(processed.Height)
+		}
+		row, err := builder.Save(ctx)
+		if err != nil {
+			return nil, keys, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, keys, nil
+}
+
+// Discard removes blobs written by Save for a transaction that was rolled
+// back. Failures are only logged.
+func Discard(ctx context.Context, store blobstore.Store, keys []string) {
+	if store == nil {
+		return
+	}
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			harukiLogger.Warnf("Failed to discard ticket attachment blob %s: %v", key, err)
+		}
+	}
+}
+
+// Send streams the attachment, or its thumbnail, with headers that stop the
+// browser from sniffing or rendering anything but images inline.
+func Send(c fiber.Ctx, store blobstore.Store, row *postgresql.TicketAttachment, thumbnail bool) error {
+	if store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "attachment storage is not configured")
+	}
+	key := row.StorageKey
+	contentType := row.ContentType
+	if thumbnail {
+		if row.ThumbnailKey == nil {
+			return fiber.NewError(fiber.StatusNotFound, "attachment has no thumbnail")
+		}
+		key = *row.ThumbnailKey
+		contentType = "image/jpeg"
+	}
+	reader, size, err := store.Get(c.Context(), key)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "attachment not found")
+		}
+		return err
+	}
+
+	disposition := "attachment"
+	if strings.HasPrefix(contentType, "image/") {
+		disposition = "inline"
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": row.FileName}))
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	c.Set(fiber.HeaderContentSecurityPolicy, "default-src 'none'; sandbox")
+	c.Set(fiber.HeaderCacheControl, "private, max-age=300")
+	if size < 0 {
+		size = -1
+	}
+	return c.SendStream(reader, int(size))
+}
@@ -0,0 +1,217 @@
+package ticketattachments
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v3"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	maxAttachmentFileNameBytes = 255
+	thumbnailJPEGQuality       = 80
+	imageJPEGQuality           = 90
+)
+
+// allowedBinaryTypes are the sniffed types kept as uploaded. Anything that
+// could render as active content (HTML, SVG, XML) is not on the list.
+var allowedBinaryTypes = map[string]string{
+	"application/pdf":          ".pdf",
+	"application/zip":          ".zip",
+	"application/x-gzip":       ".gz",
+	"application/octet-stream": ".bin",
+}
+
+// textExtensions maps the extensions accepted for sniffed plain text to the
+// stored content type. HAR captures are JSON.
+var textExtensions = map[string]string{
+	".har":  "application/json",
+	".json": "application/json",
+	".txt":  "text/plain; charset=utf-8",
+	".log":  "text/plain; charset=utf-8",
+}
+
+type processedFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+	SHA256      string
+	Thumbnail   []byte
+	Width       int
+	Height      int
+}
+
+func (p *processedFile) IsImage() bool {
+	return strings.HasPrefix(p.ContentType, "image/")
+}
+
+// processFile sniffs data, rejects types that are not allowed and re-encodes
+// images so EXIF, XMP and text chunks are dropped.
+func processFile(fileName string, data []byte, options Options) (*processedFile, error) {
+	if len(data) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "attachment is empty")
+	}
+	if int64(len(data)) > options.MaxFileSizeBytes {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, "attachment is too large")
+	}
+	ext := strings.ToLower(filepath.Ext(fileName))
+	sniffed := http.DetectContentType(data)
+
+	var out *processedFile
+	var err error
+	switch mediaType, _, _ := strings.Cut(sniffed, ";"); {
+	case mediaType == "image/png", mediaType == "image/jpeg", mediaType == "image/gif", mediaType == "image/webp":
+		out, err = processImage(mediaType, data, options)
+		if err != nil {
+			return nil, err
+		}
+		ext = imageExtensions[out.ContentType]
+	case mediaType == "text/plain":
+		contentType, ok := textExtensions[ext]
+		if !ok || !utf8.Valid(data) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "unsupported attachment type")
+		}
+		if contentType == "application/json" && !json.Valid(data) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "attachment is not valid JSON")
+		}
+		out = &processedFile{ContentType: contentType, Data: data}
+	default:
+		canonicalExt, ok := allowedBinaryTypes[mediaType]
+		if !ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "unsupported attachment type")
+		}
+		if ext == "" {
+			ext = canonicalExt
+		}
+		out = &processedFile{ContentType: mediaType, Data: data}
+	}
+
+	out.FileName = sanitizeFileName(fileName, ext)
+	sum := sha256.Sum256(out.Data)
+	out.SHA256 = hex.EncodeToString(sum[:])
+	return out, nil
+}
+
+var imageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+}
+
+// processImage re-encodes the image and renders its thumbnail. WebP has no
+// encoder in x/image, so WebP uploads are stored as PNG.
+func processImage(mediaType string, data []byte, options Options) (*processedFile, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid or corrupted image data")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > options.MaxImagePixels {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "image dimensions are too large")
+	}
+
+	var buf bytes.Buffer
+	var first image.Image
+	contentType := mediaType
+	switch mediaType {
+	case "image/gif":
+		animation, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil || len(animation.Image) == 0 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "invalid or corrupted image data")
+		}
+		if int64(len(animation.Image))*int64(cfg.Width)*int64(cfg.Height) > options.MaxImagePixels*4 {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "animated image is too large")
+		}
+		if err := gif.EncodeAll(&buf, animation); err != nil {
+			return nil, err
+		}
+		first = animation.Image[0]
+	default:
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "invalid or corrupted image data")
+		}
+		if mediaType == "image/jpeg" {
+			err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: imageJPEGQuality})
+		} else {
+			contentType = "image/png"
+			err = png.Encode(&buf, img)
+		}
+		if err != nil {
+			return nil, err
+		}
+		first = img
+	}
+
+	thumbnail, err := renderThumbnail(first, options.ThumbnailMaxSize)
+	if err != nil {
+		return nil, err
+	}
+	return &processedFile{
+		ContentType: contentType,
+		Data:        buf.Bytes(),
+		Thumbnail:   thumbnail,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+	}, nil
+}
+
+// renderThumbnail scales img to fit within maxSize and encodes it as JPEG on
+// a white background.
+func renderThumbnail(img image.Image, maxSize int) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxSize || height > maxSize {
+		if width >= height {
+			height = max(1, height*maxSize/width)
+			width = maxSize
+		} else {
+			width = max(1, width*maxSize/height)
+			height = maxSize
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sanitizeFileName keeps the base name for display, drops control and path
+// characters, and makes the extension match the stored type.
+func sanitizeFileName(fileName, ext string) string {
+	base := filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	base = strings.TrimSuffix(base, filepath.Ext(base))
+	base = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '/' || r == '"' || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, base)
+	base = strings.TrimSpace(base)
+	if base == "" || base == "." || base == ".." {
+		base = "attachment"
+	}
+	limit := maxAttachmentFileNameBytes - len(ext)
+	for len(base) > limit {
+		_, size := utf8.DecodeLastRuneInString(base)
+		base = base[:len(base)-size]
+	}
+	return base + ext
+}
@@ -0,0 +1,189 @@
+package ticketattachments
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+// pngWithText inserts a tEXt chunk after IHDR, the way cameras and editors
+// embed metadata.
+func pngWithText(t *testing.T, img image.Image, text string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode returned error: %v", err)
+	}
+	data := buf.Bytes()
+	ihdrEnd := 8 + 4 + 4 + 13 + 4
+	payload := append([]byte("Comment\x00"), text...)
+	chunk := make([]byte, 0, len(payload)+12)
+	chunk = binary.BigEndian.AppendUint32(chunk, uint32(len(payload)))
+	typed := append([]byte("tEXt"), payload...)
+	chunk = append(chunk, typed...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(typed))
+	out := append([]byte{}, data[:ihdrEnd]...)
+	out = append(out, chunk...)
+	return append(out, data[ihdrEnd:]...)
+}
+
+func jpegWithExif(t *testing.T, img image.Image, text string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode returned error: %v", err)
+	}
+	data := buf.Bytes()
+	payload := append([]byte("Exif\x00\x00"), text...)
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func requireStatus(t *testing.T, err error, code int) {
+	t.Helper()
+	var fiberErr *fiber.Error
+	if !errors.As(err, &fiberErr) || fiberErr.Code != code {
+		t.Fatalf("error = %v, want fiber error %d", err, code)
+	}
+}
+
+func TestProcessFileStripsImageMetadata(t *testing.T) {
+	t.Parallel()
+
+	options := DefaultOptions()
+	options.ThumbnailMaxSize = 32
+
+	pngData := pngWithText(t, testImage(100, 50), "gps=35.68,139.76")
+	got, err := processFile("screen shot.PNG", pngData, options)
+	if err != nil {
+		t.Fatalf("processFile png returned error: %v", err)
+	}
+	if bytes.Contains(got.Data, []byte("gps=")) {
+		t.Fatalf("png metadata was not stripped")
+	}
+	if got.ContentType != "image/png" || got.FileName != "screen shot.png" || got.Width != 100 || got.Height != 50 {
+		t.Fatalf("unexpected png result: %+v", got)
+	}
+	thumb, err := jpeg.DecodeConfig(bytes.NewReader(got.Thumbnail))
+	if err != nil {
+		t.Fatalf("thumbnail is not a jpeg: %v", err)
+	}
+	if thumb.Width != 32 || thumb.Height != 16 {
+		t.Fatalf("thumbnail size = %dx%d, want 32x16", thumb.Width, thumb.Height)
+	}
+
+	jpegData := jpegWithExif(t, testImage(20, 40), "SerialNumber=XYZ")
+	got, err = processFile("photo.jpeg", jpegData, options)
+	if err != nil {
+		t.Fatalf("processFile jpeg returned error: %v", err)
+	}
+	if bytes.Contains(got.Data, []byte("SerialNumber")) {
+		t.Fatalf("jpeg exif was not stripped")
+	}
+	if got.ContentType != "image/jpeg" || got.FileName != "photo.jpg" {
+		t.Fatalf("unexpected jpeg result: %+v", got)
+	}
+}
+
+func TestProcessFileSniffsContent(t *testing.T) {
+	t.Parallel()
+
+	options := DefaultOptions()
+
+	har := []byte(`{"log":{"version":"1.2","entries":[]}}`)
+	got, err := processFile("capture.har", har, options)
+	if err != nil {
+		t.Fatalf("processFile har returned error: %v", err)
+	}
+	if got.ContentType != "application/json" || got.FileName != "capture.har" || got.IsImage() {
+		t.Fatalf("unexpected har result: %+v", got)
+	}
+
+	_, err = processFile("capture.har", []byte(`{"log":`), options)
+	requireStatus(t, err, fiber.StatusBadRequest)
+
+	// HTML renamed to an image or text extension is still rejected.
+	_, err = processFile("image.png", []byte("<html><script>alert(1)</script></html>"), options)
+	requireStatus(t, err, fiber.StatusBadRequest)
+	_, err = processFile("notes.txt", []byte("<!DOCTYPE html><p>x</p>"), options)
+	requireStatus(t, err, fiber.StatusBadRequest)
+
+	// Plain text needs a known text extension.
+	_, err = processFile("run.sh", []byte("echo hi\n"), options)
+	requireStatus(t, err, fiber.StatusBadRequest)
+
+	raw := []byte{0x00, 0x01, 0x02, 0xFE, 0xFF}
+	got, err = processFile("upload.bin", raw, options)
+	if err != nil {
+		t.Fatalf("processFile binary returned error: %v", err)
+	}
+	if got.ContentType != "application/octet-stream" {
+		t.Fatalf("binary content type = %q", got.ContentType)
+	}
+}
+
+func TestProcessFileLimits(t *testing.T) {
+	t.Parallel()
+
+	options := DefaultOptions()
+	options.MaxFileSizeBytes = 8
+	_, err := processFile("a.txt", []byte("123456789"), options)
+	requireStatus(t, err, fiber.StatusRequestEntityTooLarge)
+
+	_, err = processFile("a.txt", nil, options)
+	requireStatus(t, err, fiber.StatusBadRequest)
+
+	options = DefaultOptions()
+	options.MaxImagePixels = 100
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(20, 20)); err != nil {
+		t.Fatalf("png.Encode returned error: %v", err)
+	}
+	_, err = processFile("big.png", buf.Bytes(), options)
+	requireStatus(t, err, fiber.StatusBadRequest)
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"../../etc/passwd.png": "passwd.png",
+		"C:\\Users\\me\\a.png": "a.png",
+		"bad\"na\x00me.png":    "badname.png",
+		"   ":                  "attachment.png",
+		"..":                   "attachment.png",
+		"report.final.PNG":     "report.final.png",
+	}
+	for input, want := range cases {
+		if got := sanitizeFileName(input, ".png"); got != want {
+			t.Fatalf("sanitizeFileName(%q) = %q, want %q", input, got, want)
+		}
+	}
+	long := sanitizeFileName(strings.Repeat("あ", 200)+".txt", ".txt")
+	if len(long) > maxAttachmentFileNameBytes || !strings.HasSuffix(long, ".txt") {
+		t.Fatalf("long file name not truncated: %d bytes", len(long))
+	}
+}
@@ -54,6 +54,46 @@ func NotifyAdminsOfUserReply(ctx context.Context, db *postgresql.Client, event E
 }
 
 func NotifyUserOfAdminReply(ctx context.Context, db *postgresql.Client, event Event) {
+	notifyUser(ctx, db, event, "工单有新回复")
+}
+
+func NotifyUserOfAutoClose(ctx context.Context, db *postgresql.Client, event Event) {
+	notifyUser(ctx, db, event, "工单已自动关闭")
+}
+
+// NotifyAdminsOfSLABreach escalates a missed SLA target. An assigned ticket
+// goes to its assignee and the super admins; an unassigned one to every
+// admin. The usual notification preference still applies.
+func NotifyAdminsOfSLABreach(ctx context.Context, db *postgresql.Client, event Event, action string) {
+	if db == nil || event.MailSender == nil {
+		return
+	}
+
+	query := db.User.Query().
+		Where(
+			userSchema.TicketEmailNotificationsEnabledEQ(true),
+			userSchema.BannedEQ(false),
+			userSchema.RoleIn(userSchema.RoleAdmin, userSchema.RoleSuperAdmin),
+		)
+	if assigneeAdminID := strings.TrimSpace(event.Ticket.AssigneeAdminID); assigneeAdminID != "" {
+		query = query.Where(userSchema.Or(
+			userSchema.IDEQ(assigneeAdminID),
+			userSchema.RoleEQ(userSchema.RoleSuperAdmin),
+		))
+	}
+
+	rows, err := query.
+		Order(userSchema.ByID(sql.OrderAsc())).
+		Select(userSchema.FieldID, userSchema.FieldEmail).
+		All(ctx)
+	if err != nil {
+		harukiLogger.Warnf("Failed to query ticket escalation recipients for ticket %s: %v", event.Ticket.PublicID, err)
+		return
+	}
+	sendTicketMail(event, normalizeRecipientEmails(rows), action)
+}
+
+func notifyUser(ctx context.Context, db *postgresql.Client, event Event, action string) {
 	if db == nil || event.MailSender == nil {
 		return
 	}
@@ -78,7 +118,7 @@ func NotifyUserOfAdminReply(ctx context.Context, db *postgresql.Client, event Ev
 	if email == "" || !strings.Contains(email, "@") {
 		return
 	}
-	sendTicketMail(event, []string{email}, "工单有新回复")
+	sendTicketMail(event, []string{email}, action)
 }
 
 func notifyAdmins(ctx context.Context, db *postgresql.Client, event Event, action string) {
@@ -189,6 +189,47 @@ func TestNotifyUserOfAdminReplySendsToTicketCreator(t *testing.T) {
 	}
 }
 
+func TestNotifyAdminsOfSLABreachEscalatesToAssigneeAndSuperAdmins(t *testing.T) {
+	client := newTicketNotificationTestClient(t)
+	seedTicketNotificationUser(t, client, "assigned-admin", "assigned@example.com", userSchema.RoleAdmin, true, false)
+	seedTicketNotificationUser(t, client, "other-admin", "other@example.com", userSchema.RoleAdmin, true, false)
+	seedTicketNotificationUser(t, client, "super-admin", "super@example.com", userSchema.RoleSuperAdmin, true, false)
+
+	event := Event{
+		Ticket: TicketContext{
+			PublicID:        "TK-7",
+			Subject:         "Slow",
+			Status:          ticket.StatusPendingAdmin,
+			AssigneeAdminID: "assigned-admin",
+		},
+		Message:     "missed",
+		FrontendURL: "https://haruki.example",
+	}
+	sender := &recordingMailSender{}
+	event.MailSender = sender
+	NotifyAdminsOfSLABreach(context.Background(), client, event, "SLA 解决超时")
+	if len(sender.calls) != 1 {
+		t.Fatalf("mail calls = %d, want 1", len(sender.calls))
+	}
+	if got := strings.Join(sender.calls[0].to, ","); got != "assigned@example.com,super@example.com" {
+		t.Fatalf("recipients = %q, want assignee and super admins", got)
+	}
+	if !strings.Contains(sender.calls[0].subject, "SLA 解决超时") {
+		t.Fatalf("subject = %q, want escalation action", sender.calls[0].subject)
+	}
+
+	sender = &recordingMailSender{}
+	event.MailSender = sender
+	event.Ticket.AssigneeAdminID = ""
+	NotifyAdminsOfSLABreach(context.Background(), client, event, "SLA 首次响应超时")
+	if len(sender.calls) != 1 {
+		t.Fatalf("mail calls = %d, want 1", len(sender.calls))
+	}
+	if got := strings.Join(sender.calls[0].to, ","); got != "assigned@example.com,other@example.com,super@example.com" {
+		t.Fatalf("recipients = %q, want every admin for unassigned tickets", got)
+	}
+}
+
 func TestTicketNotificationSendFailureDoesNotPanic(t *testing.T) {
 	client := newTicketNotificationTestClient(t)
 	seedTicketNotificationUser(t, client, "creator", "creator@example.com", userSchema.RoleUser, false, false)
@@ -0,0 +1,217 @@
+package ticketsla
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const (
+	// MaxReportTickets caps how many tickets one report reads.
+	MaxReportTickets = 20000
+
+	UnassignedKey    = "unassigned"
+	UncategorizedKey = "uncategorized"
+)
+
+// ReportBucket aggregates the tickets sharing one assignee, category or
+// priority. Met and breached only count clocks that stopped or ran past
+// their due time; pending clocks are still inside the target.
+type ReportBucket struct {
+	Key                     string  `json:"key"`
+	Name                    string  `json:"name,omitempty"`
+	Tickets                 int     `json:"tickets"`
+	FirstResponseMet        int     `json:"firstResponseMet"`
+	FirstResponseBreached   int     `json:"firstResponseBreached"`
+	FirstResponsePending    int     `json:"firstResponsePending"`
+	FirstResponseBreachRate float64 `json:"firstResponseBreachRate"`
+	AvgFirstResponseSeconds int64   `json:"avgFirstResponseSeconds"`
+	ResolutionMet           int     `json:"resolutionMet"`
+	ResolutionBreached      int     `json:"resolutionBreached"`
+	ResolutionPending       int     `json:"resolutionPending"`
+	ResolutionBreachRate    float64 `json:"resolutionBreachRate"`
+	AvgResolutionSeconds    int64   `json:"avgResolutionSeconds"`
+
+	firstResponseTotal time.Duration
+	firstResponseCount int
+	resolutionTotal    time.Duration
+	resolutionCount    int
+}
+
+type ReportTarget struct {
+	Priority             string `json:"priority"`
+	FirstResponseMinutes int    `json:"firstResponseMinutes"`
+	ResolutionMinutes    int    `json:"resolutionMinutes"`
+}
+
+// Report covers tickets created between From and To.
+type Report struct {
+	GeneratedAt time.Time      `json:"generatedAt"`
+	From        time.Time      `json:"from"`
+	To          time.Time      `json:"to"`
+	Truncated   bool           `json:"truncated"`
+	Targets     []ReportTarget `json:"targets"`
+	Overall     ReportBucket   `json:"overall"`
+	ByAssignee  []ReportBucket `json:"byAssignee"`
+	ByCategory  []ReportBucket `json:"byCategory"`
+	ByPriority  []ReportBucket `json:"byPriority"`
+}
+
+var reportPriorities = []ticket.Priority{ticket.PriorityUrgent, ticket.PriorityHigh, ticket.PriorityNormal, ticket.PriorityLow}
+
+// BuildReport measures tickets created in [from, to) against the targets in
+// options. Tickets whose priority has no target count towards Tickets only.
+func BuildReport(ctx context.Context, db *postgresql.Client, options Options, from, to, now time.Time) (*Report, error) {
+	rows, err := db.Ticket.Query().
+		Where(ticket.CreatedAtGTE(from), ticket.CreatedAtLT(to)).
+		Order(ticket.ByCreatedAt(sql.OrderAsc()), ticket.ByID(sql.OrderAsc())).
+		Limit(MaxReportTickets+1).
+		Select(
+			ticket.FieldPriority,
+			ticket.FieldCategory,
+			ticket.FieldAssigneeAdminID,
+			ticket.FieldCreatedAt,
+			ticket.FieldFirstResponseAt,
+			ticket.FieldResolvedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		GeneratedAt: now.UTC(),
+		From:        from.UTC(),
+		To:          to.UTC(),
+		Overall:     ReportBucket{Key: "all"},
+	}
+	if len(rows) > MaxReportTickets {
+		rows = rows[:MaxReportTickets]
+		report.Truncated = true
+	}
+	for _, priority := range reportPriorities {
+		target := options.Targets[priority]
+		report.Targets = append(report.Targets, ReportTarget{
+			Priority:             string(priority),
+			FirstResponseMinutes: int(target.FirstResponse / time.Minute),
+			ResolutionMinutes:    int(target.Resolution / time.Minute),
+		})
+	}
+
+	assignees := map[string]*ReportBucket{}
+	categories := map[string]*ReportBucket{}
+	priorities := map[string]*ReportBucket{}
+	for _, row := range rows {
+		assigneeKey := UnassignedKey
+		if row.AssigneeAdminID != nil && *row.AssigneeAdminID != "" {
+			assigneeKey = *row.AssigneeAdminID
+		}
+		categoryKey := UncategorizedKey
+		if row.Category != nil && *row.Category != "" {
+			categoryKey = *row.Category
+		}
+		buckets := []*ReportBucket{
+			&report.Overall,
+			reportBucket(assignees, assigneeKey),
+			reportBucket(categories, categoryKey),
+			reportBucket(priorities, string(row.Priority)),
+		}
+		target := options.Targets[row.Priority]
+		_, firstResponse := measure(row.CreatedAt, target.FirstResponse, firstResponseStop(row), now)
+		_, resolution := measure(row.CreatedAt, target.Resolution, row.ResolvedAt, now)
+		for _, bucket := range buckets {
+			bucket.Tickets++
+			bucket.addFirstResponse(firstResponse, row)
+			bucket.addResolution(resolution, row)
+		}
+	}
+
+	report.Overall.finish()
+	report.ByAssignee = sortedBuckets(assignees)
+	report.ByCategory = sortedBuckets(categories)
+	report.ByPriority = sortedBuckets(priorities)
+	return report, nil
+}
+
+func reportBucket(buckets map[string]*ReportBucket, key string) *ReportBucket {
+	bucket, ok := buckets[key]
+	if !ok {
+		bucket = &ReportBucket{Key: key}
+		buckets[key] = bucket
+	}
+	return bucket
+}
+
+func (b *ReportBucket) addFirstResponse(result outcome, row *postgresql.Ticket) {
+	switch result {
+	case outcomeMet:
+		b.FirstResponseMet++
+	case outcomeBreached:
+		b.FirstResponseBreached++
+	case outcomePending:
+		b.FirstResponsePending++
+	}
+	if row.FirstResponseAt != nil {
+		b.firstResponseTotal += row.FirstResponseAt.Sub(row.CreatedAt)
+		b.firstResponseCount++
+	}
+}
+
+func (b *ReportBucket) addResolution(result outcome, row *postgresql.Ticket) {
+	switch result {
+	case outcomeMet:
+		b.ResolutionMet++
+	case outcomeBreached:
+		b.ResolutionBreached++
+	case outcomePending:
+		b.ResolutionPending++
+	}
+	if row.ResolvedAt != nil {
+		b.resolutionTotal += row.ResolvedAt.Sub(row.CreatedAt)
+		b.resolutionCount++
+	}
+}
+
+func (b *ReportBucket) finish() {
+	b.FirstResponseBreachRate = breachRate(b.FirstResponseMet, b.FirstResponseBreached)
+	b.ResolutionBreachRate = breachRate(b.ResolutionMet, b.ResolutionBreached)
+	if b.firstResponseCount > 0 {
+		b.AvgFirstResponseSeconds = int64((b.firstResponseTotal / time.Duration(b.firstResponseCount)).Seconds())
+	}
+	if b.resolutionCount > 0 {
+		b.AvgResolutionSeconds = int64((b.resolutionTotal / time.Duration(b.resolutionCount)).Seconds())
+	}
+}
+
+func breachRate(met, breached int) float64 {
+	if met+breached == 0 {
+		return 0
+	}
+	return float64(breached) / float64(met+breached)
+}
+
+// sortedBuckets orders buckets by breaches, worst first.
+func sortedBuckets(buckets map[string]*ReportBucket) []ReportBucket {
+	out := make([]ReportBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		bucket.finish()
+		out = append(out, *bucket)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		left := out[i].FirstResponseBreached + out[i].ResolutionBreached
+		right := out[j].FirstResponseBreached + out[j].ResolutionBreached
+		if left != right {
+			return left > right
+		}
+		if out[i].Tickets != out[j].Tickets {
+			return out[i].Tickets > out[j].Tickets
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
@@ -0,0 +1,82 @@
+package ticketsla
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+)
+
+func TestBuildReportGroupsByAssigneeCategoryAndPriority(t *testing.T) {
+	client := newSLAWorkerTestClient(t, "ticket-sla-report-test")
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	options := Options{
+		Enabled: true,
+		Targets: map[ticket.Priority]Target{
+			ticket.PriorityHigh: {FirstResponse: time.Hour, Resolution: 24 * time.Hour},
+		},
+	}
+	created := now.Add(-72 * time.Hour)
+	seed := func(publicID, assignee, category string, firstResponse, resolved time.Duration) {
+		t.Helper()
+		builder := client.Ticket.Create().
+			SetTicketID(publicID).
+			SetCreatorUserID("creator").
+			SetSubject(publicID).
+			SetPriority(ticket.PriorityHigh).
+			SetCreatedAt(created)
+		if assignee != "" {
+			builder.SetAssigneeAdminID(assignee)
+		}
+		if category != "" {
+			builder.SetCategory(category)
+		}
+		if firstResponse > 0 {
+			builder.SetFirstResponseAt(created.Add(firstResponse))
+		}
+		if resolved > 0 {
+			builder.SetResolvedAt(created.Add(resolved)).SetStatus(ticket.StatusResolved)
+		}
+		if _, err := builder.Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed ticket: %v", err)
+		}
+	}
+	seed("TK-1", "admin-1", "upload", 30*time.Minute, 10*time.Hour)
+	seed("TK-2", "admin-1", "upload", 2*time.Hour, 30*time.Hour)
+	seed("TK-3", "", "", 0, 0)
+	if _, err := client.Ticket.Create().
+		SetTicketID("TK-OLD").
+		SetCreatorUserID("creator").
+		SetSubject("old").
+		SetCreatedAt(now.Add(-90 * 24 * time.Hour)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed ticket: %v", err)
+	}
+
+	report, err := BuildReport(t.Context(), client, options, now.Add(-7*24*time.Hour), now, now)
+	if err != nil {
+		t.Fatalf("BuildReport returned error: %v", err)
+	}
+	overall := report.Overall
+	if overall.Tickets != 3 || overall.FirstResponseMet != 1 || overall.FirstResponseBreached != 2 || overall.ResolutionMet != 1 || overall.ResolutionBreached != 2 {
+		t.Fatalf("unexpected overall bucket: %+v", overall)
+	}
+	if overall.AvgFirstResponseSeconds != int64((75*time.Minute).Seconds()) || overall.AvgResolutionSeconds != int64((20*time.Hour).Seconds()) {
+		t.Fatalf("unexpected averages: %+v", overall)
+	}
+	if len(report.ByAssignee) != 2 || report.ByAssignee[0].Key != "admin-1" || report.ByAssignee[1].Key != UnassignedKey {
+		t.Fatalf("unexpected assignee buckets: %+v", report.ByAssignee)
+	}
+	if report.ByAssignee[0].FirstResponseBreachRate != 0.5 {
+		t.Fatalf("admin-1 first response breach rate = %v, want 0.5", report.ByAssignee[0].FirstResponseBreachRate)
+	}
+	if len(report.ByCategory) != 2 || report.ByCategory[0].Key != "upload" || report.ByCategory[1].Key != UncategorizedKey {
+		t.Fatalf("unexpected category buckets: %+v", report.ByCategory)
+	}
+	if len(report.ByPriority) != 1 || report.ByPriority[0].Key != "high" {
+		t.Fatalf("unexpected priority buckets: %+v", report.ByPriority)
+	}
+	if len(report.Targets) != 4 || report.Targets[1].Priority != "high" || report.Targets[1].FirstResponseMinutes != 60 {
+		t.Fatalf("unexpected targets: %+v", report.Targets)
+	}
+}
@@ -0,0 +1,147 @@
+package ticketsla
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+)
+
+// Target holds the SLA durations for one priority, measured from ticket
+// creation. A zero duration means that clock is not tracked.
+type Target struct {
+	FirstResponse time.Duration
+	Resolution    time.Duration
+}
+
+type Options struct {
+	Enabled          bool
+	Targets          map[ticket.Priority]Target
+	SweepInterval    time.Duration
+	AutoCloseEnabled bool
+	AutoCloseAfter   time.Duration
+	BatchSize        int
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Enabled: true,
+		Targets: map[ticket.Priority]Target{
+			ticket.PriorityUrgent: {FirstResponse: time.Hour, Resolution: 24 * time.Hour},
+			ticket.PriorityHigh:   {FirstResponse: 4 * time.Hour, Resolution: 72 * time.Hour},
+			ticket.PriorityNormal: {FirstResponse: 24 * time.Hour, Resolution: 7 * 24 * time.Hour},
+			ticket.PriorityLow:    {FirstResponse: 72 * time.Hour, Resolution: 14 * 24 * time.Hour},
+		},
+		SweepInterval:    5 * time.Minute,
+		AutoCloseEnabled: true,
+		AutoCloseAfter:   7 * 24 * time.Hour,
+		BatchSize:        200,
+	}
+}
+
+var (
+	optionsMu      sync.RWMutex
+	currentOptions = DefaultOptions()
+)
+
+// Configure replaces the options read by Evaluate and the report. It is
+// called once at startup.
+func Configure(options Options) {
+	optionsMu.Lock()
+	currentOptions = options
+	optionsMu.Unlock()
+}
+
+func CurrentOptions() Options {
+	optionsMu.RLock()
+	defer optionsMu.RUnlock()
+	return currentOptions
+}
+
+// IsResolvedStatus reports whether status stops the resolution clock.
+func IsResolvedStatus(status ticket.Status) bool {
+	return status == ticket.StatusResolved || status == ticket.StatusClosed
+}
+
+// ApplyStatusChange keeps resolved_at in step with a status change made by
+// update. Reopening a ticket clears it so the resolution clock runs again.
+func ApplyStatusChange(update *postgresql.TicketUpdateOne, row *postgresql.Ticket, next ticket.Status, now time.Time) {
+	switch {
+	case IsResolvedStatus(next) && row.ResolvedAt == nil:
+		update.SetResolvedAt(now)
+	case !IsResolvedStatus(next) && row.ResolvedAt != nil:
+		update.ClearResolvedAt()
+	}
+}
+
+// Status is the SLA state of one ticket as shown to admins.
+type Status struct {
+	FirstResponseDueAt    *time.Time `json:"firstResponseDueAt,omitempty"`
+	FirstResponseBreached bool       `json:"firstResponseBreached"`
+	ResolutionDueAt       *time.Time `json:"resolutionDueAt,omitempty"`
+	ResolutionBreached    bool       `json:"resolutionBreached"`
+}
+
+// outcome is the state of a single SLA clock.
+type outcome int
+
+const (
+	outcomeUntracked outcome = iota
+	outcomePending
+	outcomeMet
+	outcomeBreached
+)
+
+// measure compares the moment a clock stopped, or now while it still runs,
+// against the due time.
+func measure(start time.Time, target time.Duration, stoppedAt *time.Time, now time.Time) (time.Time, outcome) {
+	if target <= 0 {
+		return time.Time{}, outcomeUntracked
+	}
+	due := start.Add(target)
+	if stoppedAt == nil {
+		if now.After(due) {
+			return due, outcomeBreached
+		}
+		return due, outcomePending
+	}
+	if stoppedAt.After(due) {
+		return due, outcomeBreached
+	}
+	return due, outcomeMet
+}
+
+// firstResponseStop is when the first response clock stopped. Closing a
+// ticket without answering stops it too, so the ticket is judged by when it
+// was closed.
+func firstResponseStop(row *postgresql.Ticket) *time.Time {
+	if row.FirstResponseAt != nil {
+		return row.FirstResponseAt
+	}
+	return row.ResolvedAt
+}
+
+// Evaluate returns the SLA state of row, or nil when SLA tracking is off or
+// its priority has no targets.
+func Evaluate(row *postgresql.Ticket, options Options, now time.Time) *Status {
+	if !options.Enabled || row == nil {
+		return nil
+	}
+	target, ok := options.Targets[row.Priority]
+	if !ok || (target.FirstResponse <= 0 && target.Resolution <= 0) {
+		return nil
+	}
+	status := &Status{}
+	if due, result := measure(row.CreatedAt, target.FirstResponse, firstResponseStop(row), now); result != outcomeUntracked {
+		due = due.UTC()
+		status.FirstResponseDueAt = &due
+		status.FirstResponseBreached = result == outcomeBreached
+	}
+	if due, result := measure(row.CreatedAt, target.Resolution, row.ResolvedAt, now); result != outcomeUntracked {
+		due = due.UTC()
+		status.ResolutionDueAt = &due
+		status.ResolutionBreached = result == outcomeBreached
+	}
+	return status
+}
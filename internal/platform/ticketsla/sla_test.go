@@ -0,0 +1,98 @@
+package ticketsla
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	options := Options{
+		Enabled: true,
+		Targets: map[ticket.Priority]Target{
+			ticket.PriorityHigh: {FirstResponse: time.Hour, Resolution: 24 * time.Hour},
+		},
+	}
+	at := func(d time.Duration) *time.Time {
+		v := created.Add(d)
+		return &v
+	}
+
+	tests := []struct {
+		name               string
+		row                *postgresql.Ticket
+		now                time.Time
+		wantFirstBreached  bool
+		wantResolvedBreach bool
+	}{
+		{
+			name: "open within targets",
+			row:  &postgresql.Ticket{Priority: ticket.PriorityHigh, CreatedAt: created},
+			now:  created.Add(30 * time.Minute),
+		},
+		{
+			name:              "open without reply past first response target",
+			row:               &postgresql.Ticket{Priority: ticket.PriorityHigh, CreatedAt: created},
+			now:               created.Add(2 * time.Hour),
+			wantFirstBreached: true,
+		},
+		{
+			name: "answered in time",
+			row:  &postgresql.Ticket{Priority: ticket.PriorityHigh, CreatedAt: created, FirstResponseAt: at(10 * time.Minute)},
+			now:  created.Add(2 * time.Hour),
+		},
+		{
+			name:               "answered late and resolved late",
+			row:                &postgresql.Ticket{Priority: ticket.PriorityHigh, CreatedAt: created, FirstResponseAt: at(2 * time.Hour), ResolvedAt: at(30 * time.Hour)},
+			now:                created.Add(40 * time.Hour),
+			wantFirstBreached:  true,
+			wantResolvedBreach: true,
+		},
+		{
+			name: "closed by user before first response target",
+			row:  &postgresql.Ticket{Priority: ticket.PriorityHigh, CreatedAt: created, ResolvedAt: at(30 * time.Minute)},
+			now:  created.Add(40 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		status := Evaluate(tt.row, options, tt.now)
+		if status == nil {
+			t.Fatalf("%s: Evaluate returned nil", tt.name)
+		}
+		if status.FirstResponseBreached != tt.wantFirstBreached || status.ResolutionBreached != tt.wantResolvedBreach {
+			t.Fatalf("%s: breached = (%t, %t), want (%t, %t)", tt.name, status.FirstResponseBreached, status.ResolutionBreached, tt.wantFirstBreached, tt.wantResolvedBreach)
+		}
+		if !status.FirstResponseDueAt.Equal(created.Add(time.Hour)) || !status.ResolutionDueAt.Equal(created.Add(24*time.Hour)) {
+			t.Fatalf("%s: unexpected due times %+v", tt.name, status)
+		}
+	}
+
+	if Evaluate(&postgresql.Ticket{Priority: ticket.PriorityLow, CreatedAt: created}, options, created) != nil {
+		t.Fatalf("expected nil status for a priority without targets")
+	}
+	options.Enabled = false
+	if Evaluate(&postgresql.Ticket{Priority: ticket.PriorityHigh, CreatedAt: created}, options, created) != nil {
+		t.Fatalf("expected nil status when sla tracking is disabled")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	cases := map[time.Duration]string{
+		7 * 24 * time.Hour: "7 days",
+		24 * time.Hour:     "1 day",
+		4 * time.Hour:      "4 hours",
+		90 * time.Minute:   "90 minutes",
+	}
+	for d, want := range cases {
+		if got := formatDuration(d); got != want {
+			t.Fatalf("formatDuration(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
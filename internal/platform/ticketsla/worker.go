@@ -0,0 +1,336 @@
+package ticketsla
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	platformTicketNotifications "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/ticketnotifications"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const workerOperationTimeout = time.Minute
+
+var slaNow = time.Now
+
+var resolvedStatuses = []ticket.Status{ticket.StatusResolved, ticket.StatusClosed}
+
+// Worker marks SLA breaches, sends the escalation mails and auto-closes
+// tickets that waited on the user for too long. Breach markers are written
+// with a conditional update, so running it on several instances only sends
+// each escalation once.
+type Worker struct {
+	db      *postgresql.Client
+	mailer  platformTicketNotifications.MailSender
+	logger  *harukiLogger.Logger
+	options Options
+}
+
+func NewWorker(db *postgresql.Client, mailer platformTicketNotifications.MailSender, logger *harukiLogger.Logger, options Options) *Worker {
+	defaults := DefaultOptions()
+	if options.SweepInterval <= 0 {
+		options.SweepInterval = defaults.SweepInterval
+	}
+	if options.AutoCloseAfter <= 0 {
+		options.AutoCloseAfter = defaults.AutoCloseAfter
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaults.BatchSize
+	}
+	return &Worker{db: db, mailer: mailer, logger: logger, options: options}
+}
+
+// Start sweeps once and then every SweepInterval until ctx is cancelled.
+// Call the returned wait before closing the database.
+func (w *Worker) Start(ctx context.Context) func() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.Sweep(ctx)
+		ticker := time.NewTicker(w.options.SweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.Sweep(ctx)
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+type sweepStep struct {
+	name string
+	run  func(context.Context, time.Time) error
+}
+
+// Sweep runs every enabled step once. A failing step stops the sweep; the
+// next one starts over.
+func (w *Worker) Sweep(ctx context.Context) {
+	sweepCtx, cancel := context.WithTimeout(ctx, workerOperationTimeout)
+	defer cancel()
+	now := slaNow().UTC()
+
+	steps := []sweepStep{
+		{"backfill first responses", w.backfillFirstResponses},
+		{"backfill resolutions", w.backfillResolutions},
+	}
+	if w.options.Enabled {
+		steps = append(steps,
+			sweepStep{"escalate first response breaches", w.escalateFirstResponseBreaches},
+			sweepStep{"escalate resolution breaches", w.escalateResolutionBreaches},
+		)
+	}
+	if w.options.AutoCloseEnabled {
+		steps = append(steps, sweepStep{"auto-close idle tickets", w.autoCloseIdleTickets})
+	}
+	for _, step := range steps {
+		if err := step.run(sweepCtx, now); err != nil {
+			if ctx.Err() == nil {
+				w.logger.Warnf("Ticket SLA sweep failed to %s: %v", step.name, err)
+			}
+			return
+		}
+	}
+}
+
+// backfillFirstResponses fills first_response_at for tickets answered before
+// the field existed.
+func (w *Worker) backfillFirstResponses(ctx context.Context, _ time.Time) error {
+	rows, err := w.db.Ticket.Query().
+		Where(
+			ticket.FirstResponseAtIsNil(),
+			ticket.HasMessagesWith(isPublicAdminMessage()...),
+		).
+		Limit(w.options.BatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		first, err := w.db.TicketMessage.Query().
+			Where(append(isPublicAdminMessage(), ticketmessage.TicketIDEQ(row.ID))...).
+			Order(ticketmessage.ByCreatedAt(sql.OrderAsc()), ticketmessage.ByID(sql.OrderAsc())).
+			First(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := w.db.Ticket.Update().
+			Where(ticket.IDEQ(row.ID), ticket.FirstResponseAtIsNil()).
+			SetFirstResponseAt(first.CreatedAt).
+			SetUpdatedAt(row.UpdatedAt).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillResolutions fills resolved_at for tickets resolved or closed before
+// the field existed.
+func (w *Worker) backfillResolutions(ctx context.Context, _ time.Time) error {
+	rows, err := w.db.Ticket.Query().
+		Where(ticket.StatusIn(resolvedStatuses...), ticket.ResolvedAtIsNil()).
+		Limit(w.options.BatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		resolvedAt := row.UpdatedAt
+		if row.ClosedAt != nil {
+			resolvedAt = *row.ClosedAt
+		}
+		if _, err := w.db.Ticket.Update().
+			Where(ticket.IDEQ(row.ID), ticket.ResolvedAtIsNil()).
+			SetResolvedAt(resolvedAt).
+			SetUpdatedAt(row.UpdatedAt).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Worker) escalateFirstResponseBreaches(ctx context.Context, now time.Time) error {
+	for priority, target := range w.options.Targets {
+		if target.FirstResponse <= 0 {
+			continue
+		}
+		rows, err := w.db.Ticket.Query().
+			Where(
+				ticket.PriorityEQ(priority),
+				ticket.StatusNotIn(resolvedStatuses...),
+				ticket.FirstResponseAtIsNil(),
+				ticket.FirstResponseBreachedAtIsNil(),
+				ticket.CreatedAtLT(now.Add(-target.FirstResponse)),
+			).
+			Order(ticket.ByCreatedAt(sql.OrderAsc())).
+			Limit(w.options.BatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			marked, err := w.db.Ticket.Update().
+				Where(ticket.IDEQ(row.ID), ticket.FirstResponseBreachedAtIsNil()).
+				SetFirstResponseBreachedAt(now).
+				SetUpdatedAt(row.UpdatedAt).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if marked == 0 {
+				continue
+			}
+			message := fmt.Sprintf("No admin reply within the %s first response target.", formatDuration(target.FirstResponse))
+			platformTicketNotifications.NotifyAdminsOfSLABreach(ctx, w.db, platformTicketNotifications.BuildEvent(row, "", message, w.mailer), "SLA 首次响应超时")
+		}
+	}
+	return nil
+}
+
+func (w *Worker) escalateResolutionBreaches(ctx context.Context, now time.Time) error {
+	for priority, target := range w.options.Targets {
+		if target.Resolution <= 0 {
+			continue
+		}
+		rows, err := w.db.Ticket.Query().
+			Where(
+				ticket.PriorityEQ(priority),
+				ticket.StatusNotIn(resolvedStatuses...),
+				ticket.ResolutionBreachedAtIsNil(),
+				ticket.CreatedAtLT(now.Add(-target.Resolution)),
+			).
+			Order(ticket.ByCreatedAt(sql.OrderAsc())).
+			Limit(w.options.BatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			marked, err := w.db.Ticket.Update().
+				Where(ticket.IDEQ(row.ID), ticket.ResolutionBreachedAtIsNil()).
+				SetResolutionBreachedAt(now).
+				SetUpdatedAt(row.UpdatedAt).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if marked == 0 {
+				continue
+			}
+			message := fmt.Sprintf("Not resolved within the %s resolution target.", formatDuration(target.Resolution))
+			platformTicketNotifications.NotifyAdminsOfSLABreach(ctx, w.db, platformTicketNotifications.BuildEvent(row, "", message, w.mailer), "SLA 解决超时")
+		}
+	}
+	return nil
+}
+
+// autoCloseIdleTickets closes tickets that stayed in pending_user without
+// any update for AutoCloseAfter. The close only applies if the ticket is
+// unchanged since it was read, so a reply racing the sweep wins.
+func (w *Worker) autoCloseIdleTickets(ctx context.Context, now time.Time) error {
+	rows, err := w.db.Ticket.Query().
+		Where(
+			ticket.StatusEQ(ticket.StatusPendingUser),
+			ticket.UpdatedAtLT(now.Add(-w.options.AutoCloseAfter)),
+		).
+		Order(ticket.ByUpdatedAt(sql.OrderAsc())).
+		Limit(w.options.BatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Ticket closed automatically after %s without a reply.", formatDuration(w.options.AutoCloseAfter))
+	for _, row := range rows {
+		closed, err := w.closeIdleTicket(ctx, row, message, now)
+		if err != nil {
+			return err
+		}
+		if !closed {
+			continue
+		}
+		event := platformTicketNotifications.BuildEvent(row, "", message, w.mailer)
+		event.Ticket.Status = ticket.StatusClosed
+		platformTicketNotifications.NotifyUserOfAutoClose(ctx, w.db, event)
+	}
+	return nil
+}
+
+func (w *Worker) closeIdleTicket(ctx context.Context, row *postgresql.Ticket, message string, now time.Time) (bool, error) {
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	update := tx.Ticket.Update().
+		Where(
+			ticket.IDEQ(row.ID),
+			ticket.StatusEQ(ticket.StatusPendingUser),
+			ticket.UpdatedAtEQ(row.UpdatedAt),
+		).
+		SetStatus(ticket.StatusClosed).
+		SetClosedAt(now)
+	if row.ResolvedAt == nil {
+		update.SetResolvedAt(now)
+	}
+	closed, err := update.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if closed == 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+	if _, err := tx.TicketMessage.Create().
+		SetTicketID(row.ID).
+		SetSenderRole(ticketmessage.SenderRoleSystem).
+		SetInternal(false).
+		SetMessage(message).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	return true, nil
+}
+
+func isPublicAdminMessage() []predicate.TicketMessage {
+	return []predicate.TicketMessage{
+		ticketmessage.SenderRoleEQ(ticketmessage.SenderRoleAdmin),
+		ticketmessage.InternalEQ(false),
+	}
+}
+
+// formatDuration renders whole days or hours, falling back to minutes.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour && d%(24*time.Hour) == 0:
+		return plural(int(d/(24*time.Hour)), "day")
+	case d >= time.Hour && d%time.Hour == 0:
+		return plural(int(d/time.Hour), "hour")
+	default:
+		return plural(int(d/time.Minute), "minute")
+	}
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,206 @@
+package ticketsla
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
+	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type recordingMailer struct {
+	mu       sync.Mutex
+	subjects []string
+	to       []string
+}
+
+func (m *recordingMailer) Send(to []string, subject, _ string, _ string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subjects = append(m.subjects, subject)
+	m.to = append(m.to, strings.Join(to, ","))
+	return nil
+}
+
+func newSLAWorkerTestClient(t *testing.T, name string) *postgresql.Client {
+	t.Helper()
+
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	for _, user := range []struct {
+		id   string
+		role userSchema.Role
+	}{{"creator", userSchema.RoleUser}, {"admin-1", userSchema.RoleAdmin}} {
+		if _, err := client.User.Create().
+			SetID(user.id).
+			SetName(user.id).
+			SetEmail(user.id + "@example.com").
+			SetRole(user.role).
+			SetTicketEmailNotificationsEnabled(true).
+			Save(t.Context()); err != nil {
+			t.Fatalf("failed to seed user: %v", err)
+		}
+	}
+	return client
+}
+
+func seedSLATicket(t *testing.T, client *postgresql.Client, publicID string, priority ticket.Priority, status ticket.Status, createdAt, updatedAt time.Time) *postgresql.Ticket {
+	t.Helper()
+
+	row, err := client.Ticket.Create().
+		SetTicketID(publicID).
+		SetCreatorUserID("creator").
+		SetSubject("subject " + publicID).
+		SetPriority(priority).
+		SetStatus(status).
+		SetCreatedAt(createdAt).
+		SetUpdatedAt(updatedAt).
+		Save(t.Context())
+	if err != nil {
+		t.Fatalf("failed to seed ticket: %v", err)
+	}
+	return row
+}
+
+func withSLANow(t *testing.T, now time.Time) {
+	t.Helper()
+	original := slaNow
+	slaNow = func() time.Time { return now }
+	t.Cleanup(func() { slaNow = original })
+}
+
+func newTestWorker(client *postgresql.Client, mailer *recordingMailer) *Worker {
+	options := DefaultOptions()
+	options.Targets = map[ticket.Priority]Target{
+		ticket.PriorityHigh: {FirstResponse: time.Hour, Resolution: 24 * time.Hour},
+	}
+	options.AutoCloseAfter = 72 * time.Hour
+	return NewWorker(client, mailer, harukiLogger.NewLogger("TicketSLATest", "DEBUG", io.Discard), options)
+}
+
+func TestWorkerEscalatesBreachesOnce(t *testing.T) {
+	client := newSLAWorkerTestClient(t, "ticket-sla-breach-test")
+	now := time.Date(2026, 5, 2, 12, 0, 0, 0, time.UTC)
+	withSLANow(t, now)
+
+	created := now.Add(-2 * time.Hour)
+	late := seedSLATicket(t, client, "TK-LATE", ticket.PriorityHigh, ticket.StatusOpen, created, created)
+	answered := seedSLATicket(t, client, "TK-ANSWERED", ticket.PriorityHigh, ticket.StatusPendingUser, created, created)
+	if _, err := client.TicketMessage.Create().
+		SetTicketID(answered.ID).
+		SetSenderUserID("admin-1").
+		SetSenderRole(ticketmessage.SenderRoleAdmin).
+		SetMessage("on it").
+		SetCreatedAt(created.Add(10 * time.Minute)).
+		Save(t.Context()); err != nil {
+		t.Fatalf("failed to seed admin reply: %v", err)
+	}
+	seedSLATicket(t, client, "TK-LOW", ticket.PriorityLow, ticket.StatusOpen, created, created)
+
+	mailer := &recordingMailer{}
+	worker := newTestWorker(client, mailer)
+	worker.Sweep(context.Background())
+	worker.Sweep(context.Background())
+
+	if len(mailer.subjects) != 1 || !strings.Contains(mailer.subjects[0], "TK-LATE") || !strings.Contains(mailer.subjects[0], "首次响应") {
+		t.Fatalf("escalation mails = %v, want one first response breach for TK-LATE", mailer.subjects)
+	}
+	reloaded, err := client.Ticket.Get(t.Context(), late.ID)
+	if err != nil {
+		t.Fatalf("failed to reload ticket: %v", err)
+	}
+	if reloaded.FirstResponseBreachedAt == nil || !reloaded.FirstResponseBreachedAt.Equal(now) {
+		t.Fatalf("first_response_breached_at = %v, want %v", reloaded.FirstResponseBreachedAt, now)
+	}
+	if !reloaded.UpdatedAt.Equal(created) {
+		t.Fatalf("updated_at = %v, want it preserved at %v", reloaded.UpdatedAt, created)
+	}
+
+	reloaded, err = client.Ticket.Get(t.Context(), answered.ID)
+	if err != nil {
+		t.Fatalf("failed to reload ticket: %v", err)
+	}
+	if reloaded.FirstResponseAt == nil || !reloaded.FirstResponseAt.Equal(created.Add(10*time.Minute)) {
+		t.Fatalf("first_response_at = %v, want backfilled from the admin reply", reloaded.FirstResponseAt)
+	}
+	if reloaded.FirstResponseBreachedAt != nil {
+		t.Fatalf("answered ticket should not be marked as breached")
+	}
+}
+
+func TestWorkerAutoClosesIdlePendingUserTickets(t *testing.T) {
+	client := newSLAWorkerTestClient(t, "ticket-sla-auto-close-test")
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	withSLANow(t, now)
+
+	idleSince := now.Add(-4 * 24 * time.Hour)
+	idle := seedSLATicket(t, client, "TK-IDLE", ticket.PriorityNormal, ticket.StatusPendingUser, idleSince, idleSince)
+	recent := seedSLATicket(t, client, "TK-RECENT", ticket.PriorityNormal, ticket.StatusPendingUser, idleSince, now.Add(-time.Hour))
+	waiting := seedSLATicket(t, client, "TK-ADMIN", ticket.PriorityNormal, ticket.StatusPendingAdmin, idleSince, idleSince)
+
+	mailer := &recordingMailer{}
+	worker := newTestWorker(client, mailer)
+	worker.Sweep(context.Background())
+
+	reloaded, err := client.Ticket.Get(t.Context(), idle.ID)
+	if err != nil {
+		t.Fatalf("failed to reload ticket: %v", err)
+	}
+	if reloaded.Status != ticket.StatusClosed || reloaded.ClosedAt == nil || reloaded.ResolvedAt == nil {
+		t.Fatalf("idle ticket = %+v, want closed with closed_at and resolved_at", reloaded)
+	}
+	messages, err := client.TicketMessage.Query().Where(ticketmessage.TicketIDEQ(idle.ID)).All(t.Context())
+	if err != nil {
+		t.Fatalf("failed to query messages: %v", err)
+	}
+	if len(messages) != 1 || messages[0].SenderRole != ticketmessage.SenderRoleSystem || messages[0].Internal || !strings.Contains(messages[0].Message, "3 days") {
+		t.Fatalf("unexpected auto-close messages: %+v", messages)
+	}
+	if len(mailer.subjects) != 1 || !strings.Contains(mailer.subjects[0], "自动关闭") || mailer.to[0] != "creator@example.com" {
+		t.Fatalf("auto-close mails = %v to %v, want one to the creator", mailer.subjects, mailer.to)
+	}
+
+	for _, id := range []int{recent.ID, waiting.ID} {
+		row, err := client.Ticket.Get(t.Context(), id)
+		if err != nil {
+			t.Fatalf("failed to reload ticket: %v", err)
+		}
+		if row.Status == ticket.StatusClosed {
+			t.Fatalf("ticket %s should stay open", row.TicketID)
+		}
+	}
+}
+
+func TestCloseIdleTicketSkipsTicketsChangedSinceRead(t *testing.T) {
+	client := newSLAWorkerTestClient(t, "ticket-sla-auto-close-race-test")
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	idleSince := now.Add(-4 * 24 * time.Hour)
+	row := seedSLATicket(t, client, "TK-RACE", ticket.PriorityNormal, ticket.StatusPendingUser, idleSince, idleSince)
+
+	// A user reply lands between the sweep's read and its update.
+	if _, err := client.Ticket.UpdateOneID(row.ID).SetStatus(ticket.StatusPendingAdmin).Save(t.Context()); err != nil {
+		t.Fatalf("failed to update ticket: %v", err)
+	}
+	closed, err := newTestWorker(client, &recordingMailer{}).closeIdleTicket(t.Context(), row, "closed", now)
+	if err != nil {
+		t.Fatalf("closeIdleTicket returned error: %v", err)
+	}
+	if closed {
+		t.Fatalf("closeIdleTicket closed a ticket that changed after it was read")
+	}
+	if count, _ := client.TicketMessage.Query().Where(ticketmessage.TicketIDEQ(row.ID)).Count(t.Context()); count != 0 {
+		t.Fatalf("system messages = %d, want 0", count)
+	}
+}
@@ -0,0 +1,576 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/bytedance/sonic"
+)
+
+const (
+	SnapshotChangeAdded   = "added"
+	SnapshotChangeRemoved = "removed"
+	SnapshotChangeChanged = "changed"
+)
+
+// snapshotRowKeyCandidates lists the natural identifiers of suite and mysekai
+// rows, in the same spirit as the eventId/gachaId matching done when uploads
+// are merged. Alternatives cover field names that differ between servers.
+var snapshotRowKeyCandidates = map[string][][]string{
+	"userEvents":                  {{"eventId"}},
+	"userWorldBlooms":             {{"eventId", "gameCharacterId"}},
+	"userGachas":                  {{"gachaId", "gachaBehaviorId"}},
+	"userCards":                   {{"cardId"}},
+	"userCardEpisodes":            {{"cardEpisodeId"}},
+	"userMusics":                  {{"musicId"}},
+	"userMusicDifficultyStatuses": {{"musicDifficultyType"}, {"musicDifficulty"}},
+	"userMusicResults": {
+		{"musicId", "musicDifficultyType", "playType"},
+		{"musicId", "musicDifficulty", "playType"},
+	},
+	"userMusicAchievements":          {{"musicId", "musicAchievementId"}},
+	"userHonors":                     {{"honorId"}},
+	"userBondsHonors":                {{"bondsHonorId"}},
+	"userCharacters":                 {{"characterId"}},
+	"userAreas":                      {{"areaId"}},
+	"userDecks":                      {{"deckId"}},
+	"userShops":                      {{"shopId"}},
+	"userShopItems":                  {{"shopItemId"}},
+	"userVirtualShops":               {{"virtualShopId"}},
+	"userVirtualShopItems":           {{"virtualShopItemId"}},
+	"userMysekaiGates":               {{"mysekaiGateId"}},
+	"userMysekaiMaterials":           {{"mysekaiMaterialId"}},
+	"userMysekaiHarvestMaps":         {{"mysekaiSiteId"}},
+	"userMysekaiSiteHarvestFixtures": {{"mysekaiSiteHarvestFixtureId"}},
+	"userMysekaiFixtureGameCharacterPerformanceBonuses": {{"gameCharacterId"}},
+	"userChallengeLiveSoloDecks":                        {{"characterId"}},
+	"userChallengeLiveSoloResults":                      {{"characterId"}},
+}
+
+// JSONPatchOperation is a single RFC 6902 operation.
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// MarshalJSON drops the value member from remove operations while keeping
+// explicit nulls for add and replace.
+func (o JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	if o.Op == "remove" {
+		return sonic.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{Op: o.Op, Path: o.Path})
+	}
+	type plain JSONPatchOperation
+	return sonic.Marshal(plain(o))
+}
+
+type SnapshotFieldChange struct {
+	Path string `json:"path"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+}
+
+type SnapshotRowDiff struct {
+	Key    map[string]any        `json:"key"`
+	Change string                `json:"change"`
+	Fields []SnapshotFieldChange `json:"fields,omitempty"`
+}
+
+type SnapshotKeyDiff struct {
+	Key     string                `json:"key"`
+	Change  string                `json:"change"`
+	Added   int                   `json:"added"`
+	Removed int                   `json:"removed"`
+	Changed int                   `json:"changed"`
+	Rows    []SnapshotRowDiff     `json:"rows,omitempty"`
+	Fields  []SnapshotFieldChange `json:"fields,omitempty"`
+	Text    string                `json:"text"`
+}
+
+type SnapshotDiffSummary struct {
+	ChangedKeys []string          `json:"changedKeys"`
+	Keys        []SnapshotKeyDiff `json:"keys"`
+}
+
+type SnapshotDiff struct {
+	Patch   []JSONPatchOperation `json:"patch"`
+	Summary SnapshotDiffSummary  `json:"summary"`
+}
+
+type snapshotChange struct {
+	op   string
+	path []string
+	from any
+	to   any
+}
+
+// DiffSnapshots compares two normalized snapshot documents key by key. Arrays
+// of rows are matched on their natural identifiers, so the patch describes
+// row-level changes instead of positional churn.
+func DiffSnapshots(from, to map[string]any) SnapshotDiff {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	result := SnapshotDiff{
+		Patch:   []JSONPatchOperation{},
+		Summary: SnapshotDiffSummary{ChangedKeys: []string{}, Keys: []SnapshotKeyDiff{}},
+	}
+	for _, key := range keys {
+		if key == fieldID || key == fieldIDString {
+			continue
+		}
+		fromValue, inFrom := from[key]
+		toValue, inTo := to[key]
+		var changes []snapshotChange
+		keyDiff := SnapshotKeyDiff{Key: key, Change: SnapshotChangeChanged}
+		switch {
+		case !inFrom:
+			changes = append(changes, snapshotChange{op: "add", path: []string{key}, to: toValue})
+			keyDiff.Change = SnapshotChangeAdded
+		case !inTo:
+			changes = append(changes, snapshotChange{op: "remove", path: []string{key}, from: fromValue})
+			keyDiff.Change = SnapshotChangeRemoved
+		default:
+			if rows := diffSnapshotTopLevel([]string{key}, key, fromValue, toValue, &changes); rows != nil {
+				keyDiff.Rows = rows
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		summarizeSnapshotKey(&keyDiff, fromValue, toValue, changes)
+		result.Summary.ChangedKeys = append(result.Summary.ChangedKeys, key)
+		result.Summary.Keys = append(result.Summary.Keys, keyDiff)
+		for _, change := range changes {
+			result.Patch = append(result.Patch, change.patchOperation())
+		}
+	}
+	return result
+}
+
+// diffSnapshotTopLevel diffs one top-level key and returns the row summary
+// when the key holds rows with a natural identifier.
+func diffSnapshotTopLevel(path []string, name string, from, to any, changes *[]snapshotChange) []SnapshotRowDiff {
+	fromRows, fromIsSlice := from.([]any)
+	toRows, toIsSlice := to.([]any)
+	if fromIsSlice && toIsSlice {
+		if keyFields := resolveSnapshotRowKey(name, fromRows, toRows); keyFields != nil {
+			rows := diffSnapshotRows(path, keyFields, fromRows, toRows, changes)
+			if rows == nil {
+				rows = []SnapshotRowDiff{}
+			}
+			return rows
+		}
+	}
+	diffSnapshotValue(path, name, from, to, changes)
+	return nil
+}
+
+func diffSnapshotValue(path []string, name string, from, to any, changes *[]snapshotChange) {
+	switch fromTyped := from.(type) {
+	case map[string]any:
+		toTyped, ok := to.(map[string]any)
+		if !ok {
+			break
+		}
+		diffSnapshotObject(path, fromTyped, toTyped, changes)
+		return
+	case []any:
+		toTyped, ok := to.([]any)
+		if !ok {
+			break
+		}
+		if keyFields := resolveSnapshotRowKey(name, fromTyped, toTyped); keyFields != nil {
+			diffSnapshotRows(path, keyFields, fromTyped, toTyped, changes)
+			return
+		}
+		if len(fromTyped) == len(toTyped) {
+			for i := range fromTyped {
+				diffSnapshotValue(appendSnapshotPath(path, fmt.Sprint(i)), "", fromTyped[i], toTyped[i], changes)
+			}
+			return
+		}
+	}
+	if !snapshotValuesEqual(from, to) {
+		*changes = append(*changes, snapshotChange{op: "replace", path: path, from: from, to: to})
+	}
+}
+
+func diffSnapshotObject(path []string, from, to map[string]any, changes *[]snapshotChange) {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		fromValue, inFrom := from[key]
+		toValue, inTo := to[key]
+		childPath := appendSnapshotPath(path, key)
+		switch {
+		case !inFrom:
+			*changes = append(*changes, snapshotChange{op: "add", path: childPath, to: toValue})
+		case !inTo:
+			*changes = append(*changes, snapshotChange{op: "remove", path: childPath, from: fromValue})
+		default:
+			diffSnapshotValue(childPath, key, fromValue, toValue, changes)
+		}
+	}
+}
+
+// diffSnapshotRows emits changes in an order that stays valid when applied as
+// a JSON Patch: in-place edits first, then removals from the highest index
+// down, then appends.
+func diffSnapshotRows(path []string, keyFields []string, from, to []any, changes *[]snapshotChange) []SnapshotRowDiff {
+	fromIndex := make(map[string]int, len(from))
+	for i, row := range from {
+		identity, _ := snapshotRowIdentity(row, keyFields)
+		fromIndex[identity] = i
+	}
+
+	var rows []SnapshotRowDiff
+	matched := make([]bool, len(from))
+	var added []any
+	for _, row := range to {
+		identity, _ := snapshotRowIdentity(row, keyFields)
+		index, ok := fromIndex[identity]
+		if !ok {
+			added = append(added, row)
+			continue
+		}
+		matched[index] = true
+		rowPath := appendSnapshotPath(path, fmt.Sprint(index))
+		start := len(*changes)
+		diffSnapshotValue(rowPath, "", from[index], row, changes)
+		if len(*changes) == start {
+			continue
+		}
+		rows = append(rows, SnapshotRowDiff{
+			Key:    snapshotRowKey(row, keyFields),
+			Change: SnapshotChangeChanged,
+			Fields: snapshotFieldChanges(len(rowPath), (*changes)[start:]),
+		})
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		if matched[i] {
+			continue
+		}
+		*changes = append(*changes, snapshotChange{op: "remove", path: appendSnapshotPath(path, fmt.Sprint(i)), from: from[i]})
+		rows = append(rows, SnapshotRowDiff{Key: snapshotRowKey(from[i], keyFields), Change: SnapshotChangeRemoved})
+	}
+	for _, row := range added {
+		*changes = append(*changes, snapshotChange{op: "add", path: appendSnapshotPath(path, "-"), to: row})
+		rows = append(rows, SnapshotRowDiff{Key: snapshotRowKey(row, keyFields), Change: SnapshotChangeAdded})
+	}
+	return rows
+}
+
+func summarizeSnapshotKey(keyDiff *SnapshotKeyDiff, from, to any, changes []snapshotChange) {
+	switch {
+	case keyDiff.Change == SnapshotChangeAdded:
+		keyDiff.Text = fmt.Sprintf("%s: added", keyDiff.Key)
+		return
+	case keyDiff.Change == SnapshotChangeRemoved:
+		keyDiff.Text = fmt.Sprintf("%s: removed", keyDiff.Key)
+		return
+	case keyDiff.Rows != nil:
+		for _, row := range keyDiff.Rows {
+			switch row.Change {
+			case SnapshotChangeAdded:
+				keyDiff.Added++
+			case SnapshotChangeRemoved:
+				keyDiff.Removed++
+			default:
+				keyDiff.Changed++
+			}
+		}
+		parts := make([]string, 0, 3)
+		if keyDiff.Added > 0 {
+			parts = append(parts, fmt.Sprintf("%d added", keyDiff.Added))
+		}
+		if keyDiff.Removed > 0 {
+			parts = append(parts, fmt.Sprintf("%d removed", keyDiff.Removed))
+		}
+		if keyDiff.Changed > 0 {
+			parts = append(parts, fmt.Sprintf("%d changed", keyDiff.Changed))
+		}
+		keyDiff.Text = fmt.Sprintf("%s: %s", keyDiff.Key, strings.Join(parts, ", "))
+		return
+	}
+
+	keyDiff.Fields = snapshotFieldChanges(1, changes)
+	for _, change := range changes {
+		switch change.op {
+		case "add":
+			keyDiff.Added++
+		case "remove":
+			keyDiff.Removed++
+		default:
+			keyDiff.Changed++
+		}
+	}
+	if len(changes) == 1 && len(changes[0].path) == 1 && isSnapshotScalar(from) && isSnapshotScalar(to) {
+		keyDiff.Text = fmt.Sprintf("%s: %v -> %v", keyDiff.Key, from, to)
+		return
+	}
+	keyDiff.Text = fmt.Sprintf("%s: %d field(s) changed", keyDiff.Key, len(changes))
+}
+
+func snapshotFieldChanges(prefixLen int, changes []snapshotChange) []SnapshotFieldChange {
+	fields := make([]SnapshotFieldChange, 0, len(changes))
+	for _, change := range changes {
+		fields = append(fields, SnapshotFieldChange{
+			Path: strings.Join(change.path[prefixLen:], "."),
+			From: change.from,
+			To:   change.to,
+		})
+	}
+	return fields
+}
+
+func (c snapshotChange) patchOperation() JSONPatchOperation {
+	var pointer strings.Builder
+	for _, segment := range c.path {
+		pointer.WriteByte('/')
+		pointer.WriteString(escapeJSONPointer(segment))
+	}
+	op := JSONPatchOperation{Op: c.op, Path: pointer.String()}
+	if c.op != "remove" {
+		op.Value = c.to
+	}
+	return op
+}
+
+func escapeJSONPointer(segment string) string {
+	if !strings.ContainsAny(segment, "~/") {
+		return segment
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+func appendSnapshotPath(path []string, segment string) []string {
+	out := make([]string, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, segment)
+}
+
+// resolveSnapshotRowKey picks the identifier fields for an array of rows:
+// the known natural key for the array name, then the <name>Id field derived
+// from the array name, then any single or paired *Id field that is unique on
+// both sides. It returns nil when rows have to be compared by position.
+func resolveSnapshotRowKey(name string, from, to []any) []string {
+	if len(from) == 0 && len(to) == 0 {
+		return nil
+	}
+	for _, candidate := range snapshotRowKeyCandidates[name] {
+		if snapshotRowsKeyedBy(candidate, from, to) {
+			return candidate
+		}
+	}
+	if derived := derivedSnapshotRowKey(name); derived != "" {
+		if candidate := []string{derived}; snapshotRowsKeyedBy(candidate, from, to) {
+			return candidate
+		}
+	}
+	return inferSnapshotRowKey(from, to)
+}
+
+func derivedSnapshotRowKey(name string) string {
+	trimmed, ok := strings.CutPrefix(name, "user")
+	if !ok || trimmed == "" {
+		return ""
+	}
+	trimmed = strings.TrimSuffix(trimmed, "s")
+	if trimmed == "" {
+		return ""
+	}
+	return strings.ToLower(trimmed[:1]) + trimmed[1:] + "Id"
+}
+
+func inferSnapshotRowKey(from, to []any) []string {
+	var common []string
+	first := true
+	for _, rows := range [][]any{from, to} {
+		for _, row := range rows {
+			doc, ok := row.(map[string]any)
+			if !ok {
+				return nil
+			}
+			if first {
+				for key := range doc {
+					if strings.HasSuffix(key, "Id") && isSnapshotScalar(doc[key]) {
+						common = append(common, key)
+					}
+				}
+				first = false
+				continue
+			}
+			common = slices.DeleteFunc(common, func(key string) bool {
+				value, exists := doc[key]
+				return !exists || !isSnapshotScalar(value)
+			})
+		}
+	}
+	slices.Sort(common)
+	for _, key := range common {
+		if candidate := []string{key}; snapshotRowsKeyedBy(candidate, from, to) {
+			return candidate
+		}
+	}
+	for i := range common {
+		for j := i + 1; j < len(common); j++ {
+			if candidate := []string{common[i], common[j]}; snapshotRowsKeyedBy(candidate, from, to) {
+				return candidate
+			}
+		}
+	}
+	return nil
+}
+
+func snapshotRowsKeyedBy(keyFields []string, from, to []any) bool {
+	for _, rows := range [][]any{from, to} {
+		seen := make(map[string]struct{}, len(rows))
+		for _, row := range rows {
+			identity, ok := snapshotRowIdentity(row, keyFields)
+			if !ok {
+				return false
+			}
+			if _, duplicate := seen[identity]; duplicate {
+				return false
+			}
+			seen[identity] = struct{}{}
+		}
+	}
+	return true
+}
+
+func snapshotRowIdentity(row any, keyFields []string) (string, bool) {
+	doc, ok := row.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	parts := make([]string, 0, len(keyFields))
+	for _, field := range keyFields {
+		value, exists := doc[field]
+		if !exists || !isSnapshotScalar(value) || value == nil {
+			return "", false
+		}
+		if integer, ok := snapshotInteger(value); ok {
+			value = integer
+		}
+		parts = append(parts, fmt.Sprint(value))
+	}
+	return strings.Join(parts, "\x00"), true
+}
+
+func snapshotRowKey(row any, keyFields []string) map[string]any {
+	doc, _ := row.(map[string]any)
+	key := make(map[string]any, len(keyFields))
+	for _, field := range keyFields {
+		key[field] = doc[field]
+	}
+	return key
+}
+
+func isSnapshotScalar(value any) bool {
+	switch value.(type) {
+	case map[string]any, []any:
+		return false
+	default:
+		return true
+	}
+}
+
+// snapshotInteger keeps 64-bit identifiers exact, since game user and row IDs
+// can exceed the float64 mantissa.
+func snapshotInteger(value any) (int64, bool) {
+	switch typed := value.(type) {
+	case int:
+		return int64(typed), true
+	case int32:
+		return int64(typed), true
+	case int64:
+		return typed, true
+	case float64:
+		if math.Trunc(typed) != typed || math.Abs(typed) > 1<<53 {
+			return 0, false
+		}
+		return int64(typed), true
+	default:
+		return 0, false
+	}
+}
+
+func snapshotNumber(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case int:
+		return float64(typed), true
+	case int32:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case float32:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	default:
+		return 0, false
+	}
+}
+
+func snapshotValuesEqual(a, b any) bool {
+	if aInteger, ok := snapshotInteger(a); ok {
+		if bInteger, ok := snapshotInteger(b); ok {
+			return aInteger == bInteger
+		}
+	}
+	if aNumber, ok := snapshotNumber(a); ok {
+		bNumber, ok := snapshotNumber(b)
+		return ok && (aNumber == bNumber || (math.IsNaN(aNumber) && math.IsNaN(bNumber)))
+	}
+	switch aTyped := a.(type) {
+	case map[string]any:
+		bTyped, ok := b.(map[string]any)
+		if !ok || len(aTyped) != len(bTyped) {
+			return false
+		}
+		for key, aValue := range aTyped {
+			bValue, exists := bTyped[key]
+			if !exists || !snapshotValuesEqual(aValue, bValue) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bTyped, ok := b.([]any)
+		if !ok || len(aTyped) != len(bTyped) {
+			return false
+		}
+		for i := range aTyped {
+			if !snapshotValuesEqual(aTyped[i], bTyped[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
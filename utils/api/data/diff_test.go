@@ -0,0 +1,160 @@
+package data
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffSnapshotsMatchesRowsOnNaturalKey(t *testing.T) {
+	from := map[string]any{
+		"userCards": []any{
+			map[string]any{"cardId": int32(1), "level": int32(50), "masterRank": int32(0)},
+			map[string]any{"cardId": int32(2), "level": int32(60), "masterRank": int32(1)},
+			map[string]any{"cardId": int32(3), "level": int32(30), "masterRank": int32(0)},
+		},
+	}
+	to := map[string]any{
+		"userCards": []any{
+			map[string]any{"cardId": int64(3), "level": int64(30), "masterRank": int64(0)},
+			map[string]any{"cardId": int64(1), "level": int64(50), "masterRank": int64(2)},
+			map[string]any{"cardId": int64(4), "level": int64(1), "masterRank": int64(0)},
+		},
+	}
+
+	diff := DiffSnapshots(from, to)
+
+	wantPatch := []JSONPatchOperation{
+		{Op: "replace", Path: "/userCards/0/masterRank", Value: int64(2)},
+		{Op: "remove", Path: "/userCards/1"},
+		{Op: "add", Path: "/userCards/-", Value: map[string]any{"cardId": int64(4), "level": int64(1), "masterRank": int64(0)}},
+	}
+	if !reflect.DeepEqual(diff.Patch, wantPatch) {
+		t.Fatalf("patch = %#v, want %#v", diff.Patch, wantPatch)
+	}
+	if len(diff.Summary.Keys) != 1 {
+		t.Fatalf("summary keys = %d, want 1", len(diff.Summary.Keys))
+	}
+	cards := diff.Summary.Keys[0]
+	if cards.Added != 1 || cards.Removed != 1 || cards.Changed != 1 {
+		t.Fatalf("counts = +%d -%d ~%d, want +1 -1 ~1", cards.Added, cards.Removed, cards.Changed)
+	}
+	if cards.Text != "userCards: 1 added, 1 removed, 1 changed" {
+		t.Fatalf("text = %q", cards.Text)
+	}
+	changed := cards.Rows[0]
+	if changed.Change != SnapshotChangeChanged || changed.Key["cardId"] != int64(1) {
+		t.Fatalf("unexpected changed row: %#v", changed)
+	}
+	if len(changed.Fields) != 1 || changed.Fields[0].Path != "masterRank" || changed.Fields[0].From != int32(0) {
+		t.Fatalf("unexpected row fields: %#v", changed.Fields)
+	}
+}
+
+func TestDiffSnapshotsCompositeAndDerivedKeys(t *testing.T) {
+	from := map[string]any{
+		"userGachas": []any{
+			map[string]any{"gachaId": 1, "gachaBehaviorId": 1, "count": 1},
+			map[string]any{"gachaId": 1, "gachaBehaviorId": 2, "count": 1},
+		},
+		"userStamps": []any{
+			map[string]any{"stampId": 10},
+		},
+	}
+	to := map[string]any{
+		"userGachas": []any{
+			map[string]any{"gachaId": 1, "gachaBehaviorId": 2, "count": 3},
+			map[string]any{"gachaId": 1, "gachaBehaviorId": 1, "count": 1},
+		},
+		"userStamps": []any{
+			map[string]any{"stampId": 11},
+			map[string]any{"stampId": 10},
+		},
+	}
+
+	diff := DiffSnapshots(from, to)
+
+	if !reflect.DeepEqual(diff.Summary.ChangedKeys, []string{"userGachas", "userStamps"}) {
+		t.Fatalf("changed keys = %#v", diff.Summary.ChangedKeys)
+	}
+	wantPatch := []JSONPatchOperation{
+		{Op: "replace", Path: "/userGachas/1/count", Value: 3},
+		{Op: "add", Path: "/userStamps/-", Value: map[string]any{"stampId": 11}},
+	}
+	if !reflect.DeepEqual(diff.Patch, wantPatch) {
+		t.Fatalf("patch = %#v, want %#v", diff.Patch, wantPatch)
+	}
+}
+
+func TestDiffSnapshotsObjectsAndScalars(t *testing.T) {
+	from := map[string]any{
+		"_id":          int64(1),
+		"upload_time":  int64(100),
+		"userGamedata": map[string]any{"rank": 10, "name": "a/b"},
+		"removedKey":   true,
+	}
+	to := map[string]any{
+		"_id":          int64(1),
+		"upload_time":  int64(200),
+		"userGamedata": map[string]any{"rank": 11, "name": "a/b", "deck": nil},
+		"addedKey":     []any{1, 2},
+	}
+
+	diff := DiffSnapshots(from, to)
+
+	if !reflect.DeepEqual(diff.Summary.ChangedKeys, []string{"addedKey", "removedKey", "upload_time", "userGamedata"}) {
+		t.Fatalf("changed keys = %#v", diff.Summary.ChangedKeys)
+	}
+	byKey := make(map[string]SnapshotKeyDiff, len(diff.Summary.Keys))
+	for _, key := range diff.Summary.Keys {
+		byKey[key.Key] = key
+	}
+	if byKey["upload_time"].Text != "upload_time: 100 -> 200" {
+		t.Fatalf("upload_time text = %q", byKey["upload_time"].Text)
+	}
+	if byKey["userGamedata"].Added != 1 || byKey["userGamedata"].Changed != 1 {
+		t.Fatalf("userGamedata summary = %#v", byKey["userGamedata"])
+	}
+	if byKey["addedKey"].Change != SnapshotChangeAdded || byKey["removedKey"].Change != SnapshotChangeRemoved {
+		t.Fatalf("unexpected key changes: %#v", diff.Summary.Keys)
+	}
+}
+
+func TestDiffSnapshotsLargeIdentifiersStayDistinct(t *testing.T) {
+	from := map[string]any{
+		"userFriends": []any{
+			map[string]any{"friendUserId": int64(9007199254740993), "level": 1},
+		},
+	}
+	to := map[string]any{
+		"userFriends": []any{
+			map[string]any{"friendUserId": int64(9007199254740992), "level": 1},
+		},
+	}
+
+	diff := DiffSnapshots(from, to)
+
+	if len(diff.Patch) != 2 || diff.Patch[0].Op != "remove" || diff.Patch[1].Op != "add" {
+		t.Fatalf("patch = %#v, want remove and add", diff.Patch)
+	}
+}
+
+func TestJSONPatchOperationMarshal(t *testing.T) {
+	removeJSON, err := json.Marshal(JSONPatchOperation{Op: "remove", Path: "/a"})
+	if err != nil {
+		t.Fatalf("marshal remove: %v", err)
+	}
+	if string(removeJSON) != `{"op":"remove","path":"/a"}` {
+		t.Fatalf("remove = %s", removeJSON)
+	}
+	replaceJSON, err := json.Marshal(JSONPatchOperation{Op: "replace", Path: "/a~1b", Value: nil})
+	if err != nil {
+		t.Fatalf("marshal replace: %v", err)
+	}
+	if string(replaceJSON) != `{"op":"replace","path":"/a~1b","value":null}` {
+		t.Fatalf("replace = %s", replaceJSON)
+	}
+	if got := escapeJSONPointer("a~/b"); got != "a~0~1b" {
+		t.Fatalf("escapeJSONPointer() = %q", got)
+	}
+}
@@ -61,63 +61,81 @@ func buildSuiteResponse(result bson.D, keys []string) bson.D {
 	return resp
 }
 
-func HandleSuiteRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, requestKey string, allowedKeySet map[string]struct{}, allowedKeys []string) (any, error) {
-	ctx := c.Context()
-
-	var keys []string
+func resolveSuiteRequestKeys(requestKey string, allowedKeySet map[string]struct{}, allowedKeys []string) ([]string, error) {
 	if requestKey == "" {
-		keys = allowedKeys
-	} else {
-		keys = strings.Split(requestKey, ",")
-		for _, key := range keys {
-			if key == "userGamedata" {
-				continue
-			}
-			if _, ok := allowedKeySet[key]; !ok {
-				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid request key: %s", key))
-			}
+		return allowedKeys, nil
+	}
+	keys := strings.Split(requestKey, ",")
+	for _, key := range keys {
+		if key == "userGamedata" {
+			continue
+		}
+		if _, ok := allowedKeySet[key]; !ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid request key: %s", key))
 		}
 	}
+	return keys, nil
+}
 
-	projection := buildSuiteProjection(keys)
-	result, err := apiHelper.DBManager.Mongo.GetDataWithProjection(ctx, userID, string(server), harukiUtils.UploadDataTypeSuite, projection)
-	if err != nil {
-		harukiLogger.Errorf("Failed to fetch mongo data: %v", err)
-		return nil, fiber.NewError(fiber.StatusInternalServerError, "failed to get user data")
+func resolveMysekaiRequestKeys(requestKey string) ([]string, error) {
+	if requestKey == "" {
+		return nil, nil
 	}
-	if len(result) == 0 {
-		return nil, fiber.NewError(fiber.StatusNotFound, "Player data not found.")
+	keys := strings.Split(requestKey, ",")
+	for _, key := range keys {
+		// Reject keys Mongo would treat as a dotted projection path or operator,
+		// so a caller cannot probe nested document structure.
+		if strings.TrimSpace(key) == "" || strings.ContainsAny(key, ".$") {
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid request key: %s", key))
+		}
 	}
+	return keys, nil
+}
 
+func buildSuiteRequestResponse(result bson.D, requestKey string, keys []string) any {
 	if requestKey != "" && len(keys) == 1 {
 		key := keys[0]
 		if key == "userGamedata" {
 			for _, elem := range result {
 				if elem.Key == "userGamedata" {
-					return NormalizeProviderResponse(elem.Value), nil
+					return NormalizeProviderResponse(elem.Value)
 				}
 			}
-			return NormalizeProviderResponse(bson.D{}), nil
+			return NormalizeProviderResponse(bson.D{})
 		}
-		return NormalizeProviderResponse(GetValueFromResult(result, key)), nil
+		return NormalizeProviderResponse(GetValueFromResult(result, key))
 	}
 
-	return NormalizeProviderResponse(buildSuiteResponse(result, keys)), nil
+	return NormalizeProviderResponse(buildSuiteResponse(result, keys))
+}
+
+func HandleSuiteRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, requestKey string, allowedKeySet map[string]struct{}, allowedKeys []string) (any, error) {
+	ctx := c.Context()
+
+	keys, err := resolveSuiteRequestKeys(requestKey, allowedKeySet, allowedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	projection := buildSuiteProjection(keys)
+	result, err := apiHelper.DBManager.Mongo.GetDataWithProjection(ctx, userID, string(server), harukiUtils.UploadDataTypeSuite, projection)
+	if err != nil {
+		harukiLogger.Errorf("Failed to fetch mongo data: %v", err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "failed to get user data")
+	}
+	if len(result) == 0 {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Player data not found.")
+	}
+
+	return buildSuiteRequestResponse(result, requestKey, keys), nil
 }
 
 func HandleMysekaiRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, requestKey string) (any, error) {
 	ctx := c.Context()
 
-	var keys []string
-	if requestKey != "" {
-		keys = strings.Split(requestKey, ",")
-		for _, key := range keys {
-			// Reject keys Mongo would treat as a dotted projection path or operator,
-			// so a caller cannot probe nested document structure.
-			if strings.TrimSpace(key) == "" || strings.ContainsAny(key, ".$") {
-				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid request key: %s", key))
-			}
-		}
+	keys, err := resolveMysekaiRequestKeys(requestKey)
+	if err != nil {
+		return nil, err
 	}
 
 	projection := buildMysekaiProjection(keys)
@@ -0,0 +1,311 @@
+package data
+
+import (
+	"errors"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/pagination"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/timeutil"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiAPIHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	harukiMongo "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/mongo"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	defaultRevisionPageSize = 20
+	maxRevisionPageSize     = 100
+)
+
+type SnapshotRevisionItem struct {
+	UploadTime     int64     `json:"uploadTime"`
+	RecordedAt     time.Time `json:"recordedAt"`
+	Size           int       `json:"size"`
+	CompressedSize int       `json:"compressedSize"`
+}
+
+type SnapshotRevisionListResponse struct {
+	Server     string                 `json:"server"`
+	DataType   string                 `json:"dataType"`
+	UserID     string                 `json:"userId"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"pageSize"`
+	Total      int                    `json:"total"`
+	TotalPages int                    `json:"totalPages"`
+	HasMore    bool                   `json:"hasMore"`
+	Items      []SnapshotRevisionItem `json:"items"`
+}
+
+type SnapshotRevisionResponse struct {
+	Revision SnapshotRevisionItem `json:"revision"`
+	Data     any                  `json:"data"`
+}
+
+func ValidateRevisionDataType(dataType harukiUtils.UploadDataType) error {
+	if dataType != harukiUtils.UploadDataTypeSuite && dataType != harukiUtils.UploadDataTypeMysekai {
+		return fiber.NewError(fiber.StatusBadRequest, "revisions are only available for suite and mysekai")
+	}
+	return nil
+}
+
+func NewSnapshotRevisionItem(revision harukiMongo.SnapshotRevision) SnapshotRevisionItem {
+	return SnapshotRevisionItem{
+		UploadTime:     revision.UploadTime,
+		RecordedAt:     revision.RecordedAt.UTC(),
+		Size:           revision.Size,
+		CompressedSize: revision.CompressedSize,
+	}
+}
+
+func mapSnapshotHistoryError(err error, message string) error {
+	if errors.Is(err, harukiMongo.ErrSnapshotHistoryDisabled) {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "snapshot history is disabled")
+	}
+	return fiber.NewError(fiber.StatusInternalServerError, message)
+}
+
+// HandleRevisionListRequest lists archived revisions, newest first, using the
+// page/page_size query parameters.
+func HandleRevisionListRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, dataType harukiUtils.UploadDataType) (*SnapshotRevisionListResponse, error) {
+	if err := ValidateRevisionDataType(dataType); err != nil {
+		return nil, err
+	}
+	page, pageSize, err := pagination.ParsePageAndPageSize(c, 1, defaultRevisionPageSize, maxRevisionPageSize)
+	if err != nil {
+		return nil, err
+	}
+	revisions, total, err := apiHelper.DBManager.Mongo.ListSnapshotRevisions(c.Context(), userID, string(server), dataType, page, pageSize)
+	if err != nil {
+		harukiLogger.Errorf("Failed to list snapshot revisions: %v", err)
+		return nil, mapSnapshotHistoryError(err, "failed to list revisions")
+	}
+	items := make([]SnapshotRevisionItem, 0, len(revisions))
+	for _, revision := range revisions {
+		items = append(items, NewSnapshotRevisionItem(revision))
+	}
+	return &SnapshotRevisionListResponse{
+		Server:     string(server),
+		DataType:   string(dataType),
+		UserID:     strconv.FormatInt(userID, 10),
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      int(total),
+		TotalPages: pagination.CalculateTotalPages(int(total), pageSize),
+		HasMore:    pagination.HasMoreByOffset(page, pageSize, int(total)),
+		Items:      items,
+	}, nil
+}
+
+// LoadRevisionAt returns the newest revision uploaded at or before the
+// :timestamp route parameter (unix seconds/milliseconds or RFC3339).
+func LoadRevisionAt(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, dataType harukiUtils.UploadDataType, rawTimestamp string) (*harukiMongo.SnapshotRevision, bson.D, error) {
+	if err := ValidateRevisionDataType(dataType); err != nil {
+		return nil, nil, err
+	}
+	at, err := timeutil.ParseFlexibleTime(rawTimestamp)
+	if err != nil {
+		return nil, nil, err
+	}
+	if at == nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, "timestamp is required")
+	}
+	return loadRevisionAt(c, apiHelper, userID, server, dataType, *at)
+}
+
+func loadRevisionAt(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, dataType harukiUtils.UploadDataType, at time.Time) (*harukiMongo.SnapshotRevision, bson.D, error) {
+	revision, doc, err := apiHelper.DBManager.Mongo.GetSnapshotRevisionAt(c.Context(), userID, string(server), dataType, at)
+	if err != nil {
+		harukiLogger.Errorf("Failed to load snapshot revision: %v", err)
+		return nil, nil, mapSnapshotHistoryError(err, "failed to get revision")
+	}
+	if revision == nil {
+		return nil, nil, fiber.NewError(fiber.StatusNotFound, "no revision found at or before the requested time")
+	}
+	return revision, doc, nil
+}
+
+// HandleSuiteRevisionRequest applies the same key rules as HandleSuiteRequest
+// to an archived suite revision.
+func HandleSuiteRevisionRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, rawTimestamp string, requestKey string, allowedKeySet map[string]struct{}, allowedKeys []string) (*SnapshotRevisionResponse, error) {
+	keys, err := resolveSuiteRequestKeys(requestKey, allowedKeySet, allowedKeys)
+	if err != nil {
+		return nil, err
+	}
+	revision, doc, err := LoadRevisionAt(c, apiHelper, userID, server, harukiUtils.UploadDataTypeSuite, rawTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotRevisionResponse{
+		Revision: NewSnapshotRevisionItem(*revision),
+		Data:     buildSuiteRequestResponse(filterUserGamedata(doc), requestKey, keys),
+	}, nil
+}
+
+// HandleMysekaiRevisionRequest applies the same key rules as
+// HandleMysekaiRequest to an archived mysekai revision.
+func HandleMysekaiRevisionRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, rawTimestamp string, requestKey string) (*SnapshotRevisionResponse, error) {
+	keys, err := resolveMysekaiRequestKeys(requestKey)
+	if err != nil {
+		return nil, err
+	}
+	revision, doc, err := LoadRevisionAt(c, apiHelper, userID, server, harukiUtils.UploadDataTypeMysekai, rawTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotRevisionResponse{
+		Revision: NewSnapshotRevisionItem(*revision),
+		Data:     NormalizeProviderResponse(filterMysekaiDocument(doc, keys)),
+	}, nil
+}
+
+// filterUserGamedata mirrors the userGamedata projection used for live suite
+// reads, since revisions are decoded whole.
+func filterUserGamedata(doc bson.D) bson.D {
+	out := make(bson.D, 0, len(doc))
+	for _, elem := range doc {
+		if elem.Key != fieldUserGamedata {
+			out = append(out, elem)
+			continue
+		}
+		gamedata, ok := elem.Value.(bson.D)
+		if !ok {
+			continue
+		}
+		filtered := make(bson.D, 0, len(userGamedataAllowedFields))
+		for _, field := range gamedata {
+			if slices.Contains(userGamedataAllowedFields, field.Key) {
+				filtered = append(filtered, field)
+			}
+		}
+		out = append(out, bson.E{Key: fieldUserGamedata, Value: filtered})
+	}
+	return out
+}
+
+// filterMysekaiDocument mirrors buildMysekaiProjection for decoded revisions.
+func filterMysekaiDocument(doc bson.D, keys []string) bson.D {
+	out := make(bson.D, 0, len(doc))
+	for _, elem := range doc {
+		if elem.Key == fieldID {
+			continue
+		}
+		if len(keys) == 0 {
+			if elem.Key != "server" {
+				out = append(out, elem)
+			}
+			continue
+		}
+		if slices.Contains(keys, elem.Key) {
+			out = append(out, elem)
+		}
+	}
+	return out
+}
+
+type SnapshotRevisionDiffResponse struct {
+	From SnapshotRevisionItem `json:"from"`
+	To   SnapshotRevisionItem `json:"to"`
+	SnapshotDiff
+}
+
+// HandleRevisionDiffRequest diffs the revisions in effect at the from and to
+// query parameters. to defaults to now, so omitting it compares against the
+// latest upload. format=patch returns the bare RFC 6902 document.
+func HandleRevisionDiffRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, dataType harukiUtils.UploadDataType, requestKey string) (any, error) {
+	keys, err := resolveMysekaiRequestKeys(requestKey)
+	if err != nil {
+		return nil, err
+	}
+	return handleRevisionDiff(c, apiHelper, userID, server, dataType, func(doc bson.D) any {
+		return NormalizeProviderResponse(selectDocumentKeys(doc, keys))
+	})
+}
+
+// HandleSuiteRevisionDiffRequest is HandleRevisionDiffRequest restricted to
+// the same keys HandleSuiteRequest would expose.
+func HandleSuiteRevisionDiffRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, requestKey string, allowedKeySet map[string]struct{}, allowedKeys []string) (any, error) {
+	keys, err := resolveSuiteRequestKeys(requestKey, allowedKeySet, allowedKeys)
+	if err != nil {
+		return nil, err
+	}
+	return handleRevisionDiff(c, apiHelper, userID, server, harukiUtils.UploadDataTypeSuite, func(doc bson.D) any {
+		return NormalizeProviderResponse(buildSuiteResponse(filterUserGamedata(doc), keys))
+	})
+}
+
+// HandleMysekaiRevisionDiffRequest is HandleRevisionDiffRequest with the key
+// rules of HandleMysekaiRequest.
+func HandleMysekaiRevisionDiffRequest(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, requestKey string) (any, error) {
+	keys, err := resolveMysekaiRequestKeys(requestKey)
+	if err != nil {
+		return nil, err
+	}
+	return handleRevisionDiff(c, apiHelper, userID, server, harukiUtils.UploadDataTypeMysekai, func(doc bson.D) any {
+		return NormalizeProviderResponse(filterMysekaiDocument(doc, keys))
+	})
+}
+
+func handleRevisionDiff(c fiber.Ctx, apiHelper *harukiAPIHelper.HarukiToolboxRouterHelpers, userID int64, server harukiUtils.SupportedDataUploadServer, dataType harukiUtils.UploadDataType, view func(bson.D) any) (any, error) {
+	if err := ValidateRevisionDataType(dataType); err != nil {
+		return nil, err
+	}
+	format := c.Query("format")
+	if format != "" && format != "full" && format != "patch" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "format must be full or patch")
+	}
+	fromAt, err := timeutil.ParseFlexibleTime(c.Query("from"))
+	if err != nil {
+		return nil, err
+	}
+	if fromAt == nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "from is required")
+	}
+	toAt, err := timeutil.ParseFlexibleTime(c.Query("to"))
+	if err != nil {
+		return nil, err
+	}
+	if toAt == nil {
+		now := time.Now().UTC()
+		toAt = &now
+	}
+	if fromAt.After(*toAt) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "from must not be later than to")
+	}
+
+	fromRevision, fromDoc, err := loadRevisionAt(c, apiHelper, userID, server, dataType, *fromAt)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, toDoc, err := loadRevisionAt(c, apiHelper, userID, server, dataType, *toAt)
+	if err != nil {
+		return nil, err
+	}
+	fromView, _ := view(fromDoc).(map[string]any)
+	toView, _ := view(toDoc).(map[string]any)
+	diff := DiffSnapshots(fromView, toView)
+	if format == "patch" {
+		return diff.Patch, nil
+	}
+	return &SnapshotRevisionDiffResponse{
+		From:         NewSnapshotRevisionItem(*fromRevision),
+		To:           NewSnapshotRevisionItem(*toRevision),
+		SnapshotDiff: diff,
+	}, nil
+}
+
+func selectDocumentKeys(doc bson.D, keys []string) bson.D {
+	if len(keys) == 0 {
+		return doc
+	}
+	out := make(bson.D, 0, len(keys))
+	for _, elem := range doc {
+		if slices.Contains(keys, elem.Key) {
+			out = append(out, elem)
+		}
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"testing"
+
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestFilterUserGamedataKeepsAllowedFields(t *testing.T) {
+	doc := bson.D{
+		{Key: "userCards", Value: bson.A{}},
+		{Key: "userGamedata", Value: bson.D{
+			{Key: "userId", Value: int64(1)},
+			{Key: "name", Value: "haruki"},
+			{Key: "deviceId", Value: "secret"},
+		}},
+	}
+
+	filtered := filterUserGamedata(doc)
+	if len(filtered) != 2 {
+		t.Fatalf("filterUserGamedata() length = %d, want 2", len(filtered))
+	}
+	gamedata, ok := filtered[1].Value.(bson.D)
+	if !ok {
+		t.Fatalf("userGamedata has unexpected type %T", filtered[1].Value)
+	}
+	for _, field := range gamedata {
+		if field.Key == "deviceId" {
+			t.Fatalf("filterUserGamedata() should drop fields outside the allow list")
+		}
+	}
+	if len(gamedata) != 2 {
+		t.Fatalf("userGamedata length = %d, want 2", len(gamedata))
+	}
+}
+
+func TestFilterMysekaiDocument(t *testing.T) {
+	doc := bson.D{
+		{Key: "_id", Value: int64(1)},
+		{Key: "server", Value: "jp"},
+		{Key: "updatedResources", Value: bson.D{}},
+		{Key: "upload_time", Value: int64(2)},
+	}
+
+	all := filterMysekaiDocument(doc, nil)
+	if len(all) != 2 {
+		t.Fatalf("filterMysekaiDocument(nil) length = %d, want 2", len(all))
+	}
+	selected := filterMysekaiDocument(doc, []string{"server"})
+	if len(selected) != 1 || selected[0].Key != "server" {
+		t.Fatalf("filterMysekaiDocument(server) = %#v, want only server", selected)
+	}
+}
+
+func TestValidateRevisionDataType(t *testing.T) {
+	if err := ValidateRevisionDataType(harukiUtils.UploadDataTypeSuite); err != nil {
+		t.Fatalf("suite should be supported, got %v", err)
+	}
+	if err := ValidateRevisionDataType(harukiUtils.UploadDataTypeMysekaiBirthdayParty); err == nil {
+		t.Fatalf("birthday party should be rejected")
+	}
+}
@@ -2,8 +2,13 @@ package api
 
 import (
 	"context"
+	"fmt"
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/platform/coordination"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/blobstore"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekaiapi"
 	smtp2 "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
 	"sync"
@@ -13,7 +18,12 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-const runtimeConfigStoreTimeout = 500 * time.Millisecond
+const (
+	runtimeConfigStoreTimeout = 500 * time.Millisecond
+	runtimeConfigLockName     = "runtime-config"
+	runtimeConfigLockTTL      = 5 * time.Second
+	runtimeConfigLockWait     = 2 * time.Second
+)
 
 var runtimeConfigStoreUpdateMu sync.Mutex
 
@@ -27,6 +37,8 @@ type RuntimeConfigUpdate struct {
 	HarukiProxyUnpackKey *string
 	WebhookJWTSecret     *string
 	WebhookEnabled       *bool
+	ModuleLogLevels      *map[string]string
+	DataProviders        *[]harukiConfig.DataProviderConfig
 }
 
 type runtimeConfigSnapshot struct {
@@ -39,12 +51,18 @@ type runtimeConfigSnapshot struct {
 	HarukiProxyUnpackKey string   `json:"harukiProxyUnpackKey"`
 	WebhookJWTSecret     string   `json:"webhookJwtSecret"`
 	WebhookEnabled       *bool    `json:"webhookEnabled,omitempty"`
+	// ModuleLogLevels is nil in snapshots written before it existed, which
+	// leaves the levels from the config file in place.
+	ModuleLogLevels map[string]string `json:"moduleLogLevels"`
+	// DataProviders is nil in snapshots written before it existed, which
+	// leaves the providers from the config file in place.
+	DataProviders []harukiConfig.DataProviderConfig `json:"dataProviders"`
 }
 
 type HarukiToolboxRouterHelpers struct {
 	Router                 fiber.Router
 	DBManager              *database.HarukiToolboxDBManager
-	SMTPClient             *smtp2.HarukiSMTPClient
+	SMTPClient             smtp2.Mailer
 	SessionHandler         *SessionHandler
 	SekaiAPIClient         *sekaiapi.HarukiSekaiAPIClient
 	PublicAPIAllowedKeys   []string
@@ -58,6 +76,9 @@ type HarukiToolboxRouterHelpers struct {
 	WebhookEnabled         *bool
 	BotRegistrationEnabled bool
 	BotCredentialSignToken string
+	RiskSignals            RiskSignalSink
+	BlobStore              blobstore.Store
+	DataProviders          []harukiConfig.DataProviderConfig
 	publicAPIKeysMu        sync.RWMutex
 	runtimeConfigMu        sync.RWMutex
 }
@@ -65,7 +86,7 @@ type HarukiToolboxRouterHelpers struct {
 func NewHarukiToolboxRouterHelpers(
 	router fiber.Router,
 	dbManager *database.HarukiToolboxDBManager,
-	smtpClient *smtp2.HarukiSMTPClient,
+	smtpClient smtp2.Mailer,
 	sessionHandler *SessionHandler,
 	sekaiAPIClient *sekaiapi.HarukiSekaiAPIClient,
 	publicAPIAllowedKeys []string,
@@ -102,7 +123,7 @@ func NewHarukiToolboxRouterHelpers(
 func NewHarukiToolboxDBHelpers(
 	router fiber.Router,
 	dbManager *database.HarukiToolboxDBManager,
-	smtpClient *smtp2.HarukiSMTPClient,
+	smtpClient smtp2.Mailer,
 	sessionHandler *SessionHandler,
 	sekaiAPIClient *sekaiapi.HarukiSekaiAPIClient,
 	publicAPIAllowedKeys []string,
@@ -161,6 +182,8 @@ func (h *HarukiToolboxRouterHelpers) currentRuntimeConfigSnapshot() runtimeConfi
 		HarukiProxyUnpackKey: h.HarukiProxyUnpackKey,
 		WebhookJWTSecret:     h.WebhookJWTSecret,
 		WebhookEnabled:       webhookEnabled,
+		ModuleLogLevels:      harukiLogger.GetModuleLevels(),
+		DataProviders:        cloneDataProviders(h.DataProviders),
 	}
 }
 
@@ -179,11 +202,20 @@ func (h *HarukiToolboxRouterHelpers) applyRuntimeConfigSnapshot(snapshot runtime
 		webhookEnabled := *snapshot.WebhookEnabled
 		h.WebhookEnabled = &webhookEnabled
 	}
+	if snapshot.DataProviders != nil {
+		h.DataProviders = cloneDataProviders(snapshot.DataProviders)
+	}
 	h.runtimeConfigMu.Unlock()
 
 	h.publicAPIKeysMu.Lock()
 	h.PublicAPIAllowedKeys = append([]string(nil), snapshot.PublicAPIAllowedKeys...)
 	h.publicAPIKeysMu.Unlock()
+
+	if snapshot.ModuleLogLevels != nil {
+		if err := harukiLogger.SetModuleLevels(snapshot.ModuleLogLevels); err != nil {
+			harukiLogger.Warnf("Ignoring stored module log levels: %v", err)
+		}
+	}
 }
 
 func (h *HarukiToolboxRouterHelpers) loadRuntimeConfigSnapshotFromStore(ctx context.Context) (*runtimeConfigSnapshot, bool, error) {
@@ -229,6 +261,23 @@ func (h *HarukiToolboxRouterHelpers) UpdateRuntimeConfig(update RuntimeConfigUpd
 	}
 	runtimeConfigStoreUpdateMu.Lock()
 	defer runtimeConfigStoreUpdateMu.Unlock()
+	// The mutex orders writers in this process; the Redis lock orders them
+	// across instances, which all read-modify-write the same snapshot.
+	if h.DBManager != nil && h.DBManager.Redis != nil && h.DBManager.Redis.Redis != nil {
+		lockCtx, cancelLock := context.WithTimeout(context.Background(), runtimeConfigLockWait)
+		lock, err := coordination.AcquireLock(lockCtx, h.DBManager.Redis.Redis, runtimeConfigLockName, runtimeConfigLockTTL)
+		cancelLock()
+		if err != nil {
+			return fmt.Errorf("lock runtime config: %w", err)
+		}
+		defer func() {
+			releaseCtx, cancelRelease := context.WithTimeout(context.Background(), runtimeConfigStoreTimeout)
+			defer cancelRelease()
+			if err := lock.Release(releaseCtx); err != nil {
+				harukiLogger.Warnf("Failed to release runtime config lock: %v", err)
+			}
+		}()
+	}
 
 	h.syncRuntimeConfigFromStore()
 	snapshot := h.currentRuntimeConfigSnapshot()
@@ -261,6 +310,19 @@ func (h *HarukiToolboxRouterHelpers) UpdateRuntimeConfig(update RuntimeConfigUpd
 		webhookEnabled := *update.WebhookEnabled
 		snapshot.WebhookEnabled = &webhookEnabled
 	}
+	if update.ModuleLogLevels != nil {
+		moduleLogLevels := make(map[string]string, len(*update.ModuleLogLevels))
+		for module, level := range *update.ModuleLogLevels {
+			moduleLogLevels[module] = level
+		}
+		snapshot.ModuleLogLevels = moduleLogLevels
+	}
+	if update.DataProviders != nil {
+		snapshot.DataProviders = cloneDataProviders(*update.DataProviders)
+		if snapshot.DataProviders == nil {
+			snapshot.DataProviders = []harukiConfig.DataProviderConfig{}
+		}
+	}
 
 	if h.DBManager != nil && h.DBManager.Redis != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), runtimeConfigStoreTimeout)
@@ -353,6 +415,31 @@ func (h *HarukiToolboxRouterHelpers) SetWebhookEnabled(enabled bool) {
 	_ = h.UpdateRuntimeConfig(RuntimeConfigUpdate{WebhookEnabled: &enabled})
 }
 
+// GetDataProviders returns a copy of the third-party data providers uploads
+// are forwarded to.
+func (h *HarukiToolboxRouterHelpers) GetDataProviders() []harukiConfig.DataProviderConfig {
+	if h == nil {
+		return nil
+	}
+	h.syncRuntimeConfigFromStore()
+	h.runtimeConfigMu.RLock()
+	defer h.runtimeConfigMu.RUnlock()
+	return cloneDataProviders(h.DataProviders)
+}
+
+func cloneDataProviders(providers []harukiConfig.DataProviderConfig) []harukiConfig.DataProviderConfig {
+	if providers == nil {
+		return nil
+	}
+	cloned := make([]harukiConfig.DataProviderConfig, len(providers))
+	for i, provider := range providers {
+		provider.Servers = append([]string(nil), provider.Servers...)
+		provider.DataTypes = append([]string(nil), provider.DataTypes...)
+		cloned[i] = provider
+	}
+	return cloned
+}
+
 func (h *HarukiToolboxRouterHelpers) RedisClient() *redis.Client {
 	if h == nil || h.DBManager == nil || h.DBManager.Redis == nil {
 		return nil
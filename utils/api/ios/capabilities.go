@@ -0,0 +1,51 @@
+package ios
+
+import "fmt"
+
+// AppCapabilities describes which upload setups an app can run. Every app
+// with script mode can run chunked uploads, so the chunk size is not part of
+// the matrix.
+type AppCapabilities struct {
+	DisplayName string
+	ProxyMode   bool
+	ScriptMode  bool
+}
+
+var appCapabilities = map[ProxyApp]AppCapabilities{
+	ProxyAppSurge:        {DisplayName: "Surge", ProxyMode: true, ScriptMode: true},
+	ProxyAppLoon:         {DisplayName: "Loon", ProxyMode: true, ScriptMode: true},
+	ProxyAppQuantumultX:  {DisplayName: "Quantumult X", ProxyMode: true},
+	ProxyAppStash:        {DisplayName: "Stash", ProxyMode: true, ScriptMode: true},
+	ProxyAppShadowrocket: {DisplayName: "Shadowrocket", ProxyMode: true, ScriptMode: true},
+	ProxyAppEgern:        {DisplayName: "Egern", ProxyMode: true, ScriptMode: true},
+	// The rule set uses Quantumult X's rewrite syntax, whose script rules
+	// cannot run the upload script either. A script rule set for Android
+	// tools is not generated.
+	ProxyAppRuleSet: {DisplayName: "Rule set", ProxyMode: true},
+}
+
+func (app ProxyApp) Capabilities() AppCapabilities {
+	return appCapabilities[app]
+}
+
+// Validate rejects a request the target app cannot run. The error message is
+// meant for the user.
+func (req *ModuleRequest) Validate() error {
+	capabilities, ok := appCapabilities[req.App]
+	if !ok {
+		return fmt.Errorf("unsupported proxy app: %s", req.App)
+	}
+	switch req.Mode {
+	case UploadModeProxy:
+		if !capabilities.ProxyMode {
+			return fmt.Errorf("%s does not support proxy upload mode. Use script mode instead.", capabilities.DisplayName)
+		}
+	case UploadModeScript:
+		if !capabilities.ScriptMode {
+			return fmt.Errorf("%s does not support script upload mode. Use proxy mode instead.", capabilities.DisplayName)
+		}
+	default:
+		return fmt.Errorf("unsupported mode: %s", req.Mode)
+	}
+	return nil
+}
@@ -6,6 +6,9 @@ import (
 	"time"
 )
 
+// now is replaced in tests so generated dates are stable.
+var now = time.Now
+
 func generateModuleNameAndDesc(req *ModuleRequest) (name, desc string) {
 	var regionParts []string
 	for _, r := range req.Regions {
@@ -27,6 +30,9 @@ func generateModuleNameAndDesc(req *ModuleRequest) (name, desc string) {
 }
 
 func GenerateModule(req *ModuleRequest, endpoint string, endpointType string) (string, error) {
+	if err := req.Validate(); err != nil {
+		return "", err
+	}
 	ruleSet := GenerateRuleSet(req, endpoint, endpointType)
 	switch req.App {
 	case ProxyAppSurge:
@@ -37,6 +43,12 @@ func GenerateModule(req *ModuleRequest, endpoint string, endpointType string) (s
 		return generateQuantumultXModule(req, ruleSet), nil
 	case ProxyAppStash:
 		return generateStashModule(req, ruleSet), nil
+	case ProxyAppShadowrocket:
+		return generateShadowrocketModule(req, ruleSet), nil
+	case ProxyAppEgern:
+		return generateEgernModule(req, ruleSet), nil
+	case ProxyAppRuleSet:
+		return generateRuleSetFile(req, ruleSet), nil
 	default:
 		return "", fmt.Errorf("unsupported proxy app: %s", req.App)
 	}
@@ -47,7 +59,7 @@ func GenerateScript(uploadCode string, chunkSizeMB int, uploadURL string) string
 	script = strings.ReplaceAll(script, "{{UPLOAD_URL}}", uploadURL+"/ios/script/"+uploadCode+"/upload")
 	script = strings.ReplaceAll(script, "{{CHUNK_SIZE}}", fmt.Sprintf("%d", chunkSizeMB))
 	script = strings.ReplaceAll(script, "{{UPLOAD_CODE}}", uploadCode)
-	script = strings.ReplaceAll(script, "{{GENERATE_DATE}}", time.Now().Format("2006-01-02 15:04:05"))
+	script = strings.ReplaceAll(script, "{{GENERATE_DATE}}", now().Format("2006-01-02 15:04:05"))
 	script = strings.ReplaceAll(script, "\\u0060", "`")
 	return script
 }
@@ -59,7 +71,7 @@ func generateSurgeModule(req *ModuleRequest, rs *RuleSet) string {
 	sb.WriteString(fmt.Sprintf("#!desc=%s\n", desc))
 	sb.WriteString("#!homepage=https://haruki.seiunx.com/ios-modules\n")
 	sb.WriteString("#!author=Haruki Dev Team\n")
-	sb.WriteString(fmt.Sprintf("#!date=%s\n", time.Now().Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("#!date=%s\n", now().Format("2006-01-02")))
 	sb.WriteString("\n")
 	sb.WriteString("[MITM]\n")
 	sb.WriteString(fmt.Sprintf("hostname = %%APPEND%% %s, submit.backtrace.io\n", strings.Join(rs.Hostnames, ", ")))
@@ -92,7 +104,7 @@ func generateLoonModule(req *ModuleRequest, rs *RuleSet) string {
 	sb.WriteString(fmt.Sprintf("#!desc=%s\n", desc))
 	sb.WriteString("#!homepage=https://haruki.seiunx.com/ios-modules\n")
 	sb.WriteString("#!author=Haruki Dev Team\n")
-	sb.WriteString(fmt.Sprintf("#!date=%s\n", time.Now().Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("#!date=%s\n", now().Format("2006-01-02")))
 	sb.WriteString("\n")
 	sb.WriteString("[Rewrite]\n")
 	for _, rule := range rs.RewriteRules {
@@ -123,7 +135,7 @@ func generateQuantumultXModule(req *ModuleRequest, rs *RuleSet) string {
 	name, desc := generateModuleNameAndDesc(req)
 	sb.WriteString(fmt.Sprintf("; %s\n", name))
 	sb.WriteString(fmt.Sprintf("; %s\n", desc))
-	sb.WriteString(fmt.Sprintf("; Date: %s\n", time.Now().Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("; Date: %s\n", now().Format("2006-01-02")))
 	sb.WriteString("\n")
 	for _, rule := range rs.RewriteRules {
 		target := rule.Target
@@ -143,7 +155,7 @@ func generateStashModule(req *ModuleRequest, rs *RuleSet) string {
 	name, desc := generateModuleNameAndDesc(req)
 	sb.WriteString(fmt.Sprintf("name: %s\n", name))
 	sb.WriteString(fmt.Sprintf("desc: %s\n", desc))
-	sb.WriteString(fmt.Sprintf("# date: %s\n", time.Now().Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("# date: %s\n", now().Format("2006-01-02")))
 	sb.WriteString("\n")
 	sb.WriteString("http:\n")
 	if len(rs.RewriteRules) > 0 || len(rs.ScriptRules) > 0 {
@@ -177,3 +189,106 @@ func generateStashModule(req *ModuleRequest, rs *RuleSet) string {
 	sb.WriteString("    - \"submit.backtrace.io\"\n")
 	return sb.String()
 }
+
+func generateShadowrocketModule(req *ModuleRequest, rs *RuleSet) string {
+	var sb strings.Builder
+	name, desc := generateModuleNameAndDesc(req)
+	sb.WriteString(fmt.Sprintf("#!name=%s\n", name))
+	sb.WriteString(fmt.Sprintf("#!desc=%s\n", desc))
+	sb.WriteString("#!homepage=https://haruki.seiunx.com/ios-modules\n")
+	sb.WriteString("#!author=Haruki Dev Team\n")
+	sb.WriteString(fmt.Sprintf("#!date=%s\n", now().Format("2006-01-02")))
+	sb.WriteString("\n")
+	sb.WriteString("[URL Rewrite]\n")
+	for _, rule := range rs.RewriteRules {
+		switch rule.RuleType {
+		case "redirect":
+			sb.WriteString(fmt.Sprintf("%s %s\n", rule.Pattern, rule.Target))
+		case "rewrite":
+			sb.WriteString(fmt.Sprintf("%s %s 307\n", rule.Pattern, rule.Target))
+		}
+	}
+	sb.WriteString("^https:\\/\\/submit\\.backtrace\\.io\\/ - reject\n")
+	sb.WriteString("\n")
+	if len(rs.ScriptRules) > 0 {
+		sb.WriteString("[Script]\n")
+		for i, rule := range rs.ScriptRules {
+			sb.WriteString(fmt.Sprintf("haruki-upload-%d = type=http-response,pattern=%s,requires-body=1,binary-body-mode=1,max-size=100000000,timeout=60,script-path=%s\n",
+				i+1, rule.Pattern, rule.Target))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("[MITM]\n")
+	sb.WriteString(fmt.Sprintf("hostname = %%APPEND%% %s, submit.backtrace.io\n", strings.Join(rs.Hostnames, ", ")))
+	return sb.String()
+}
+
+func generateEgernModule(req *ModuleRequest, rs *RuleSet) string {
+	var sb strings.Builder
+	name, desc := generateModuleNameAndDesc(req)
+	sb.WriteString(fmt.Sprintf("name: %s\n", name))
+	sb.WriteString(fmt.Sprintf("description: %s\n", desc))
+	sb.WriteString("author: Haruki Dev Team\n")
+	sb.WriteString("homepage: https://haruki.seiunx.com/ios-modules\n")
+	sb.WriteString(fmt.Sprintf("# date: %s\n", now().Format("2006-01-02")))
+	sb.WriteString("\n")
+	sb.WriteString("url_rewrites:\n")
+	for _, rule := range rs.RewriteRules {
+		target := strings.TrimSuffix(rule.Target, " 307")
+		if rule.RuleType == "redirect" || rule.RuleType == "rewrite" {
+			sb.WriteString(fmt.Sprintf("  - match: %s\n", yamlSingleQuoted(rule.Pattern)))
+			sb.WriteString(fmt.Sprintf("    location: %s\n", yamlSingleQuoted(target)))
+			sb.WriteString("    status_code: 307\n")
+		}
+	}
+	sb.WriteString("  - match: '^https:\\/\\/submit\\.backtrace\\.io\\/'\n")
+	sb.WriteString("    reject: true\n")
+	if len(rs.ScriptRules) > 0 {
+		sb.WriteString("scriptings:\n")
+		for i, rule := range rs.ScriptRules {
+			sb.WriteString("  - http_response:\n")
+			sb.WriteString(fmt.Sprintf("      name: haruki-upload-%d\n", i+1))
+			sb.WriteString(fmt.Sprintf("      match: %s\n", yamlSingleQuoted(rule.Pattern)))
+			sb.WriteString(fmt.Sprintf("      script_url: %s\n", yamlSingleQuoted(rule.Target)))
+			sb.WriteString("      body_required: true\n")
+			sb.WriteString("      binary_body: true\n")
+			sb.WriteString("      max_size: 100000000\n")
+			sb.WriteString("      timeout: 60\n")
+		}
+	}
+	sb.WriteString("mitm:\n")
+	sb.WriteString("  hostnames:\n")
+	sb.WriteString("    includes:\n")
+	for _, h := range rs.Hostnames {
+		sb.WriteString(fmt.Sprintf("      - %s\n", yamlSingleQuoted(h)))
+	}
+	sb.WriteString("      - 'submit.backtrace.io'\n")
+	return sb.String()
+}
+
+// yamlSingleQuoted quotes s so regex backslashes are kept as written.
+func yamlSingleQuoted(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// generateRuleSetFile writes a plain rule set: one "pattern url action" line
+// per rewrite rule and a hostname line for MITM. It only carries proxy mode
+// redirects, see appCapabilities.
+func generateRuleSetFile(req *ModuleRequest, rs *RuleSet) string {
+	var sb strings.Builder
+	name, desc := generateModuleNameAndDesc(req)
+	sb.WriteString(fmt.Sprintf("# %s\n", name))
+	sb.WriteString(fmt.Sprintf("# %s\n", desc))
+	sb.WriteString(fmt.Sprintf("# Date: %s\n", now().Format("2006-01-02")))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("hostname = %s, submit.backtrace.io\n", strings.Join(rs.Hostnames, ", ")))
+	sb.WriteString("\n")
+	for _, rule := range rs.RewriteRules {
+		target := strings.TrimSuffix(rule.Target, " 307")
+		if rule.RuleType == "redirect" || rule.RuleType == "rewrite" {
+			sb.WriteString(fmt.Sprintf("%s url 307 %s\n", rule.Pattern, target))
+		}
+	}
+	sb.WriteString("^https:\\/\\/submit\\.backtrace\\.io\\/ url reject\n")
+	return sb.String()
+}
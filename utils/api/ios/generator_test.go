@@ -0,0 +1,144 @@
+package ios
+
+import (
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateScriptResumesThroughStatusQuery(t *testing.T) {
+	script := GenerateScript("code-1", 4, "https://toolbox.example.com")
+
+	for _, want := range []string{
+		`const upload_url = "https://toolbox.example.com/ios/script/code-1/upload";`,
+		`const status_url = upload_url + "/status";`,
+		`const chunkSizeMB = 4;`,
+		`"X-Upload-Code": "code-1",`,
+		`headers["X-Chunk-CRC32"] = crc32(chunk);`,
+		"status.missingChunks",
+	} {
+		if !strings.Contains(script, want) {
+			t.Fatalf("generated script does not contain %q", want)
+		}
+	}
+	for _, leftover := range []string{"{{", "\\u0060"} {
+		if strings.Contains(script, leftover) {
+			t.Fatalf("generated script still contains %q", leftover)
+		}
+	}
+}
+
+func useGoldenModuleConfig(t *testing.T) {
+	t.Helper()
+	originalCfg := harukiConfig.Cfg
+	originalNow := now
+	t.Cleanup(func() {
+		harukiConfig.Cfg = originalCfg
+		now = originalNow
+	})
+	harukiConfig.Cfg.SekaiClient.JPServerAPIHost = "jp.example.com"
+	harukiConfig.Cfg.SekaiClient.TWServerAPIHost = "tw.example.com"
+	harukiConfig.Cfg.SekaiClient.TWServerAPIHost2 = "tw2.example.com"
+	now = func() time.Time {
+		return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+}
+
+func TestGenerateModuleMatchesGoldenFiles(t *testing.T) {
+	useGoldenModuleConfig(t)
+
+	tests := []struct {
+		golden      string
+		app         ProxyApp
+		mode        UploadMode
+		chunkSizeMB int
+	}{
+		{golden: "surge_proxy.golden.sgmodule", app: ProxyAppSurge, mode: UploadModeProxy, chunkSizeMB: 1},
+		{golden: "surge_script.golden.sgmodule", app: ProxyAppSurge, mode: UploadModeScript, chunkSizeMB: 2},
+		{golden: "loon_script.golden.lnplugin", app: ProxyAppLoon, mode: UploadModeScript, chunkSizeMB: 2},
+		{golden: "quantumultx_proxy.golden.conf", app: ProxyAppQuantumultX, mode: UploadModeProxy, chunkSizeMB: 1},
+		{golden: "stash_script.golden.stoverride", app: ProxyAppStash, mode: UploadModeScript, chunkSizeMB: 2},
+		{golden: "shadowrocket_proxy.golden.srmodule", app: ProxyAppShadowrocket, mode: UploadModeProxy, chunkSizeMB: 1},
+		{golden: "shadowrocket_script.golden.srmodule", app: ProxyAppShadowrocket, mode: UploadModeScript, chunkSizeMB: 2},
+		{golden: "egern_proxy.golden.yaml", app: ProxyAppEgern, mode: UploadModeProxy, chunkSizeMB: 1},
+		{golden: "egern_script.golden.yaml", app: ProxyAppEgern, mode: UploadModeScript, chunkSizeMB: 2},
+		{golden: "ruleset_proxy.golden.ruleset", app: ProxyAppRuleSet, mode: UploadModeProxy, chunkSizeMB: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			req := &ModuleRequest{
+				UploadCode:  "code-1",
+				Regions:     []harukiUtils.SupportedDataUploadServer{harukiUtils.SupportedDataUploadServerJP, harukiUtils.SupportedDataUploadServerTW},
+				DataTypes:   []DataType{DataTypeSuite, DataTypeMysekaiForce},
+				App:         tt.app,
+				Mode:        tt.mode,
+				ChunkSizeMB: tt.chunkSizeMB,
+			}
+			got, err := GenerateModule(req, "https://toolbox.example.com", string(EndpointTypeDirect))
+			if err != nil {
+				t.Fatalf("GenerateModule returned error: %v", err)
+			}
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			if err != nil {
+				t.Fatalf("read golden %s: %v", tt.golden, err)
+			}
+			if got != string(want) {
+				t.Fatalf("module differs from golden %s\n got:\n%s\nwant:\n%s", tt.golden, got, want)
+			}
+		})
+	}
+}
+
+func TestModuleRequestValidateRejectsUnsupportedCombinations(t *testing.T) {
+	tests := []struct {
+		name        string
+		app         ProxyApp
+		mode        UploadMode
+		chunkSizeMB int
+		wantErr     string
+	}{
+		{name: "quantumult x script", app: ProxyAppQuantumultX, mode: UploadModeScript, chunkSizeMB: 1, wantErr: "Quantumult X does not support script upload mode"},
+		{name: "rule set script", app: ProxyAppRuleSet, mode: UploadModeScript, chunkSizeMB: 0, wantErr: "Rule set does not support script upload mode"},
+		{name: "unchunked script", app: ProxyAppShadowrocket, mode: UploadModeScript, chunkSizeMB: 0},
+		{name: "rule set proxy ignores chunk", app: ProxyAppRuleSet, mode: UploadModeProxy, chunkSizeMB: 1},
+		{name: "egern chunked script", app: ProxyAppEgern, mode: UploadModeScript, chunkSizeMB: 10},
+		{name: "unknown app", app: ProxyApp("pac"), mode: UploadModeProxy, wantErr: "unsupported proxy app"},
+		{name: "unknown mode", app: ProxyAppSurge, mode: UploadMode("mirror"), wantErr: "unsupported mode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&ModuleRequest{App: tt.app, Mode: tt.mode, ChunkSizeMB: tt.chunkSizeMB}).Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate returned error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := GenerateModule(&ModuleRequest{App: ProxyAppQuantumultX, Mode: UploadModeScript}, "https://toolbox.example.com", string(EndpointTypeDirect)); err == nil {
+		t.Fatalf("GenerateModule should refuse a combination the app cannot run")
+	}
+}
+
+func TestEveryProxyAppHasCapabilities(t *testing.T) {
+	for _, app := range SupportedProxyApps {
+		if app.Capabilities().DisplayName == "" {
+			t.Fatalf("%s has no capability entry", app)
+		}
+		if parsed, ok := ParseProxyApp(string(app)); !ok || parsed != app {
+			t.Fatalf("ParseProxyApp(%q) = %q, %t", app, parsed, ok)
+		}
+	}
+	if _, ok := ParseProxyApp("pac"); ok {
+		t.Fatalf("ParseProxyApp should reject unknown extensions")
+	}
+}
@@ -246,13 +246,16 @@ function HarukiUploadClient() {
 // Generated at {{GENERATE_DATE}}
 const $ = HarukiUploadClient();
 const scriptName = "haruki_toolbox_uploader.js";
-const version = "1.0.0";
+const version = "1.1.0";
 
-const upload_id = Math.random().toString(36).substr(2, 9);
 const upload_url = "{{UPLOAD_URL}}";
-const chunkSize = {{CHUNK_SIZE}} * 1024 * 1024; // {{CHUNK_SIZE}}MB per chunk
+const status_url = upload_url + "/status";
+const chunkSizeMB = {{CHUNK_SIZE}};
+const maxRounds = 3;
 const body = (typeof $response !== 'undefined' && $response.body) ? $response.body : '';
 const url = $.isRequest ? $request.url : '';
+// A chunk size of 0 uploads the whole body in one request
+const chunkSize = chunkSizeMB > 0 ? chunkSizeMB * 1024 * 1024 : Math.max(body.length, 1);
 
 // Early return if no body
 if (!body || body.length === 0) {
@@ -260,10 +263,33 @@ if (!body || body.length === 0) {
     $done({});
 }
 
-const totalChunks = Math.ceil(body.length / chunkSize);
+// CRC-32 (IEEE), the same checksum the server verifies in X-Chunk-CRC32
+const crcTable = (() => {
+    const table = new Array(256);
+    for (let n = 0; n < 256; n++) {
+        let c = n;
+        for (let k = 0; k < 8; k++) {
+            c = (c & 1) ? (0xEDB88320 ^ (c >>> 1)) : (c >>> 1);
+        }
+        table[n] = c >>> 0;
+    }
+    return table;
+})();
+
+function crc32(data) {
+    let crc = 0xFFFFFFFF;
+    const isString = typeof data === 'string';
+    for (let i = 0; i < data.length; i++) {
+        const byte = isString ? data.charCodeAt(i) & 0xFF : data[i];
+        crc = crcTable[(crc ^ byte) & 0xFF] ^ (crc >>> 8);
+    }
+    return ((crc ^ 0xFFFFFFFF) >>> 0).toString(16).padStart(8, '0');
+}
 
-let sentChunks = 0;
-let failedChunks = 0;
+const totalChunks = Math.ceil(body.length / chunkSize);
+// The upload id is derived from the body, so running the script again for the
+// same response resumes the upload instead of starting over.
+const upload_id = \u0060${crc32(body)}-${body.length.toString(36)}-${chunkSizeMB}\u0060;
 
 function log(message) {
     console.log(\u0060[${scriptName}-v${version}] [${upload_id}] ${message}\u0060);
@@ -273,49 +299,112 @@ log(\u0060start to upload response body with ${totalChunks} chunks\u0060);
 log(\u0060body length: ${body.length}\u0060);
 log(\u0060original url: ${url}\u0060);
 
-function sendChunk(index) {
+function baseHeaders() {
+    return {
+        "X-Script-Version": version,
+        "X-Original-Url": url,
+        "X-Upload-Id": upload_id,
+        "X-Total-Chunks": totalChunks,
+        "X-Upload-Code": "{{UPLOAD_CODE}}",
+    };
+}
+
+function queryStatus(callback) {
+    $.get({ url: status_url, headers: baseHeaders() }, (error, resp, data) => {
+        if (error || !resp || resp.status !== 200) {
+            log(\u0060status query failed: ${error || (resp && resp.status)}\u0060);
+            return callback(null);
+        }
+        try {
+            callback(JSON.parse(data).updatedData);
+        } catch (e) {
+            log(\u0060status query returned invalid JSON: ${e}\u0060);
+            callback(null);
+        }
+    });
+}
+
+function sendChunk(index, callback) {
     const start = index * chunkSize;
     const end = Math.min(start + chunkSize, body.length);
     const chunk = body.slice(start, end);
 
-    const options = {
-        url: upload_url,
-        headers: {
-            "X-Script-Version": version,
-            "X-Original-Url": url,
-            "X-Upload-Id": upload_id,
-            "X-Chunk-Index": index,
-            "X-Total-Chunks": totalChunks,
-            "X-Upload-Code": "{{UPLOAD_CODE}}",
-            "Content-Type": "application/octet-stream",
-        },
-        body: chunk
-    };
+    const headers = baseHeaders();
+    headers["X-Chunk-Index"] = index;
+    headers["Content-Type"] = "application/octet-stream";
+    // String bodies are re-encoded on the way out, so only binary chunks can
+    // be checksummed byte for byte.
+    if (typeof chunk !== 'string') {
+        headers["X-Chunk-CRC32"] = crc32(chunk);
+    }
 
     log(\u0060uploading chunk ${index + 1}/${totalChunks} (${chunk.length} bytes)\u0060);
 
-    $.post(options, (error, resp, data) => {
-        sentChunks++;
+    $.post({ url: upload_url, headers: headers, body: chunk }, (error, resp, data) => {
         if (error) {
             log(\u0060chunk ${index + 1} upload failed: ${error}\u0060);
-            failedChunks++;
-        } else if (resp.status !== 200) {
+            return callback(false);
+        }
+        if (resp.status !== 200) {
             log(\u0060chunk ${index + 1} upload failed: HTTP ${resp.status}\u0060);
-            failedChunks++;
-        } else {
-            log(\u0060chunk ${index + 1} upload success\u0060);
+            return callback(false);
         }
+        log(\u0060chunk ${index + 1} upload success\u0060);
+        callback(true);
+    });
+}
 
-        if (sentChunks === totalChunks) {
-            log(\u0060upload completed: ${failedChunks}/${sentChunks} chunks failed\u0060);
-            $.done({});
-        } else {
-            sendChunk(index + 1);
+function sendChunks(indexes, callback) {
+    let failed = 0;
+    const next = (i) => {
+        if (i >= indexes.length) return callback(failed);
+        sendChunk(indexes[i], (ok) => {
+            if (!ok) failed++;
+            next(i + 1);
+        });
+    };
+    next(0);
+}
+
+function allChunks() {
+    const indexes = [];
+    for (let i = 0; i < totalChunks; i++) indexes.push(i);
+    return indexes;
+}
+
+function upload(round) {
+    queryStatus((status) => {
+        if (status && status.completed) {
+            log('upload completed');
+            return $.done({});
         }
+        let missing = allChunks();
+        if (status && status.totalChunks === totalChunks && Array.isArray(status.missingChunks)) {
+            missing = status.missingChunks;
+            if (missing.length === 0) {
+                // Every chunk arrived but none completed the upload; the last
+                // one is sent again to finish it.
+                missing = [totalChunks - 1];
+            } else if (missing.length < totalChunks) {
+                log(\u0060resuming upload: ${missing.length}/${totalChunks} chunks missing\u0060);
+            }
+        }
+        sendChunks(missing, (failed) => {
+            if (failed === 0) {
+                log(\u0060upload completed: ${missing.length} chunks sent\u0060);
+                return $.done({});
+            }
+            if (round >= maxRounds) {
+                log(\u0060upload incomplete: ${failed}/${missing.length} chunks failed after ${round} rounds\u0060);
+                return $.done({});
+            }
+            log(\u0060${failed}/${missing.length} chunks failed, retrying missing chunks\u0060);
+            upload(round + 1);
+        });
     });
 }
 
 if ($.isRequest && typeof $response !== 'undefined') {
-    sendChunk(0);
+    upload(1);
 }
 `
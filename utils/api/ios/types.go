@@ -8,25 +8,46 @@ import (
 type ProxyApp string
 
 const (
-	ProxyAppSurge       ProxyApp = "sgmodule"
-	ProxyAppLoon        ProxyApp = "lnplugin"
-	ProxyAppQuantumultX ProxyApp = "conf"
-	ProxyAppStash       ProxyApp = "stoverride"
+	ProxyAppSurge        ProxyApp = "sgmodule"
+	ProxyAppLoon         ProxyApp = "lnplugin"
+	ProxyAppQuantumultX  ProxyApp = "conf"
+	ProxyAppStash        ProxyApp = "stoverride"
+	ProxyAppShadowrocket ProxyApp = "srmodule"
+	ProxyAppEgern        ProxyApp = "yaml"
+	// ProxyAppRuleSet is a plain rewrite rule set in the widely copied
+	// "pattern url action" syntax, for Android tools that import rule sets
+	// rather than full modules.
+	ProxyAppRuleSet ProxyApp = "ruleset"
 )
 
+// SupportedProxyApps lists the apps in the order they are offered to users.
+var SupportedProxyApps = []ProxyApp{
+	ProxyAppSurge,
+	ProxyAppLoon,
+	ProxyAppQuantumultX,
+	ProxyAppStash,
+	ProxyAppShadowrocket,
+	ProxyAppEgern,
+	ProxyAppRuleSet,
+}
+
 func ParseProxyApp(ext string) (ProxyApp, bool) {
-	switch ext {
-	case "sgmodule":
-		return ProxyAppSurge, true
-	case "lnplugin":
-		return ProxyAppLoon, true
-	case "conf":
-		return ProxyAppQuantumultX, true
-	case "stoverride":
-		return ProxyAppStash, true
-	default:
-		return "", false
+	for _, app := range SupportedProxyApps {
+		if string(app) == ext {
+			return app, true
+		}
+	}
+	return "", false
+}
+
+// SupportedProxyAppExtensions returns the module file extensions for error
+// messages, e.g. "sgmodule, lnplugin, conf".
+func SupportedProxyAppExtensions() string {
+	exts := make([]string, len(SupportedProxyApps))
+	for i, app := range SupportedProxyApps {
+		exts[i] = string(app)
 	}
+	return strings.Join(exts, ", ")
 }
 
 type UploadMode string
@@ -89,11 +110,11 @@ type ModuleRequest struct {
 
 func (app ProxyApp) ContentType() string {
 	switch app {
-	case ProxyAppSurge, ProxyAppLoon:
+	case ProxyAppSurge, ProxyAppLoon, ProxyAppShadowrocket, ProxyAppRuleSet:
 		return "text/plain; charset=utf-8"
 	case ProxyAppQuantumultX:
 		return "text/plain; charset=utf-8"
-	case ProxyAppStash:
+	case ProxyAppStash, ProxyAppEgern:
 		return "text/yaml; charset=utf-8"
 	default:
 		return "text/plain; charset=utf-8"
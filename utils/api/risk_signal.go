@@ -0,0 +1,80 @@
+package api
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	RiskSignalKindLogin                   = "login"
+	RiskSignalKindUpload                  = "upload"
+	RiskSignalKindGameBindingVerification = "game_binding_verification"
+	RiskSignalKindOAuth2TokenGrant        = "oauth2_token_grant"
+)
+
+// loginRiskSignalReasons are the login outcomes that say something about the
+// caller; service failures are left out so an outage cannot trip risk rules.
+var loginRiskSignalReasons = map[string]struct{}{
+	"invalid_credentials":   {},
+	"invalid_input":         {},
+	"invalid_second_factor": {},
+	"rate_limited":          {},
+	"banned":                {},
+	"ok":                    {},
+}
+
+// IsLoginRiskSignalReason reports whether a user.login audit reason is
+// reported to the risk engine.
+func IsLoginRiskSignalReason(reason string) bool {
+	_, ok := loginRiskSignalReasons[reason]
+	return ok
+}
+
+// GameBindingVerificationRiskSignalResult maps a game account binding audit
+// reason to a verification outcome. ok is false for reasons that are not an
+// ownership verification result.
+func GameBindingVerificationRiskSignalResult(reason string) (success bool, ok bool) {
+	switch reason {
+	case "verify_ownership_failed":
+		return false, true
+	case "ok", "transferred":
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// RiskSignal is a security-relevant event handed to the risk engine. Only
+// the identifiers that apply to the event kind are set.
+type RiskSignal struct {
+	Kind       string
+	Success    bool
+	OccurredAt time.Time
+	UserID     string
+	Email      string
+	IP         string
+	Server     string
+	GameUserID string
+	ClientID   string
+	Metadata   map[string]any
+}
+
+// RiskSignalSink receives risk signals. Implementations must not block the
+// caller.
+type RiskSignalSink interface {
+	PublishRiskSignal(signal RiskSignal)
+}
+
+// PublishRiskSignal forwards signal to the configured sink, if any.
+func PublishRiskSignal(apiHelper *HarukiToolboxRouterHelpers, signal RiskSignal) {
+	if apiHelper == nil || apiHelper.RiskSignals == nil {
+		return
+	}
+	if signal.OccurredAt.IsZero() {
+		signal.OccurredAt = time.Now()
+	}
+	signal.UserID = strings.TrimSpace(signal.UserID)
+	signal.Email = strings.ToLower(strings.TrimSpace(signal.Email))
+	signal.IP = strings.TrimSpace(signal.IP)
+	apiHelper.RiskSignals.PublishRiskSignal(signal)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 
 	"net/http"
 
@@ -161,7 +162,7 @@ func (s *SessionHandler) kratosHTTPClient() *http.Client {
 	return &http.Client{
 		Timeout: timeout,
 		Transport: &kratosRequestMetadataTransport{
-			base: http.DefaultTransport,
+			base: harukiTracing.Transport(nil),
 		},
 	}
 }
@@ -297,3 +297,41 @@ func TestRevokeKratosSessionByIDNotFoundError(t *testing.T) {
 		t.Fatalf("session-not-found should not be classified as invalid input")
 	}
 }
+
+func TestDeleteKratosIdentityByID(t *testing.T) {
+	t.Parallel()
+
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		switch r.URL.Path {
+		case "/admin/identities/gone":
+			w.WriteHeader(http.StatusNotFound)
+		case "/admin/identities/broken":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	handler := NewSessionHandler(newSessionTestRedisClient(t), "local-sign-key")
+	handler.ConfigureIdentityProvider("kratos", "http://kratos.example", server.URL, "X-Session-Token", "ory_kratos_session", true, true, 2*time.Second, nil)
+
+	if err := handler.DeleteKratosIdentityByID(context.Background(), "identity-1"); err != nil {
+		t.Fatalf("DeleteKratosIdentityByID returned error: %v", err)
+	}
+	if err := handler.DeleteKratosIdentityByID(context.Background(), "gone"); err != nil {
+		t.Fatalf("DeleteKratosIdentityByID for a missing identity returned error: %v", err)
+	}
+	if err := handler.DeleteKratosIdentityByID(context.Background(), "broken"); !IsIdentityProviderUnavailableError(err) {
+		t.Fatalf("DeleteKratosIdentityByID on a 502 error = %v, want identity provider unavailable", err)
+	}
+	if len(deleted) != 3 || deleted[0] != "/admin/identities/identity-1" {
+		t.Fatalf("deleted paths = %v", deleted)
+	}
+}
@@ -293,6 +293,51 @@ func (s *SessionHandler) RevokeKratosSessionsByIdentityID(ctx context.Context, i
 	}
 }
 
+// DeleteKratosIdentityByID removes the identity together with its
+// credentials and sessions. An identity that is already gone counts as
+// deleted.
+func (s *SessionHandler) DeleteKratosIdentityByID(ctx context.Context, identityID string) error {
+	identityID = strings.TrimSpace(identityID)
+	if identityID == "" {
+		return fmt.Errorf("%w: empty identity id", errKratosInvalidInput)
+	}
+	if strings.TrimSpace(s.KratosAdminURL) == "" {
+		return fmt.Errorf("%w: kratos admin url is not configured", errIdentityProviderUnavailable)
+	}
+
+	endpoint, err := buildProviderEndpoint(s.KratosAdminURL, "/admin/identities/"+url.PathEscape(identityID))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errIdentityProviderUnavailable, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("%w: build delete identity request: %v", errIdentityProviderUnavailable, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.kratosHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: delete identity request failed: %v", errIdentityProviderUnavailable, err)
+	}
+	defer func(body io.ReadCloser) {
+		_ = body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: read delete identity response: %v", errIdentityProviderUnavailable, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("%w: delete identity failed status=%d body=%s", errIdentityProviderUnavailable, resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+		return fmt.Errorf("%w: delete identity failed status=%d body=%s", errKratosInvalidInput, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+}
+
 func (s *SessionHandler) fetchKratosIdentityByID(ctx context.Context, identityID string) (*kratosIdentityRecord, error) {
 	identityID = strings.TrimSpace(identityID)
 	if identityID == "" {
@@ -26,6 +26,7 @@ func (s *SessionHandler) VerifySessionToken(c fiber.Ctx) error {
 			return UpdatedDataResponse[string](c, fiber.StatusUnauthorized, "user ID mismatch", nil)
 		}
 		c.Locals("userID", userID)
+		c.SetContext(harukiLogger.WithContextFields(c.Context(), harukiLogger.KV(harukiLogger.FieldUserID, userID)))
 		if trimmedIdentityID := strings.TrimSpace(identityID); trimmedIdentityID != "" {
 			c.Locals("identityID", trimmedIdentityID)
 		}
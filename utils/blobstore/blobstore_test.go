@@ -0,0 +1,163 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+)
+
+func TestCleanKey(t *testing.T) {
+	t.Parallel()
+
+	for _, key := range []string{"", "/abs", "a/../b", "../a", "a\\b", "a//b", "a/./b", "."} {
+		if _, err := cleanKey(key); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("cleanKey(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+	}
+	if got, err := cleanKey("ticket-attachments/1/abc"); err != nil || got != "ticket-attachments/1/abc" {
+		t.Fatalf("cleanKey valid = (%q, %v)", got, err)
+	}
+}
+
+func TestLocalStoreRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore returned error: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Put(ctx, "a/b/c", "text/plain", []byte("hello")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	reader, size, err := store.Get(ctx, "a/b/c")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	_ = reader.Close()
+	if string(data) != "hello" || size != 5 {
+		t.Fatalf("Get = (%q, %d), want (hello, 5)", data, size)
+	}
+
+	if err := store.Delete(ctx, "a/b/c"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if err := store.Delete(ctx, "a/b/c"); err != nil {
+		t.Fatalf("second Delete returned error: %v", err)
+	}
+	if _, _, err := store.Get(ctx, "a/b/c"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get after delete error = %v, want ErrNotFound", err)
+	}
+	if err := store.Put(ctx, "../escape", "", []byte("x")); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("Put escaping key error = %v, want ErrInvalidKey", err)
+	}
+}
+
+func TestS3StorePathStyleRoundTrip(t *testing.T) {
+	originalNow := s3Now
+	s3Now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { s3Now = originalNow })
+
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20260301/auto/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+			http.Error(w, "bad authorization "+auth, http.StatusForbidden)
+			return
+		}
+		if r.Header.Get("X-Amz-Date") != "20260301T120000Z" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+			http.Error(w, "missing amz headers", http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			objects[r.URL.EscapedPath()] = body
+		case http.MethodGet:
+			body, ok := objects[r.URL.EscapedPath()]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			delete(objects, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	store, err := NewS3Store(config.S3BlobStorageConfig{
+		Endpoint:        server.URL,
+		Region:          "auto",
+		Bucket:          "toolbox",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+		ForcePathStyle:  true,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Store returned error: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Put(ctx, "ticket-attachments/1/a b", "image/png", []byte("png")); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+	if _, ok := objects["/toolbox/ticket-attachments/1/a%20b"]; !ok {
+		t.Fatalf("object stored under unexpected path: %v", objects)
+	}
+	reader, _, err := store.Get(ctx, "ticket-attachments/1/a b")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	_ = reader.Close()
+	if string(data) != "png" {
+		t.Fatalf("Get body = %q, want png", data)
+	}
+	if err := store.Delete(ctx, "ticket-attachments/1/a b"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if _, _, err := store.Get(ctx, "ticket-attachments/1/a b"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get after delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestS3StoreVirtualHostedURL(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewS3Store(config.S3BlobStorageConfig{
+		Endpoint:        "https://s3.example.com",
+		Bucket:          "toolbox",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewS3Store returned error: %v", err)
+	}
+	if got := store.objectURL("a/b").String(); got != "https://toolbox.s3.example.com/a/b" {
+		t.Fatalf("objectURL = %q", got)
+	}
+	if _, err := NewS3Store(config.S3BlobStorageConfig{Endpoint: "https://s3.example.com", Bucket: "toolbox"}); err == nil {
+		t.Fatalf("expected missing credentials to fail")
+	}
+}
+
+func TestNewRejectsUnknownBackend(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(config.BlobStorageConfig{Backend: "ftp"}); err == nil {
+		t.Fatalf("expected unknown backend to fail")
+	}
+}
@@ -0,0 +1,226 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+)
+
+const (
+	s3DefaultRegion  = "us-east-1"
+	s3DefaultTimeout = 30 * time.Second
+	s3SigningAlgo    = "AWS4-HMAC-SHA256"
+	s3EmptyHash      = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+var s3Now = time.Now
+
+// S3Store talks to an S3-compatible object store with SigV4 signed requests.
+// Only the object operations the Store interface needs are implemented.
+type S3Store struct {
+	endpoint     *url.URL
+	region       string
+	bucket       string
+	accessKeyID  string
+	secretKey    string
+	pathStyle    bool
+	client       *http.Client
+	maxErrorBody int64
+}
+
+func NewS3Store(cfg config.S3BlobStorageConfig) (*S3Store, error) {
+	endpoint, err := url.Parse(strings.TrimSpace(cfg.Endpoint))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	bucket := strings.TrimSpace(cfg.Bucket)
+	if bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is empty")
+	}
+	if strings.TrimSpace(cfg.AccessKeyID) == "" || strings.TrimSpace(cfg.SecretAccessKey) == "" {
+		return nil, fmt.Errorf("s3 credentials are empty")
+	}
+	region := strings.TrimSpace(cfg.Region)
+	if region == "" {
+		region = s3DefaultRegion
+	}
+	timeout := s3DefaultTimeout
+	if cfg.RequestTimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.RequestTimeoutSeconds) * time.Second
+	}
+	endpoint.Path = strings.TrimRight(endpoint.Path, "/")
+	return &S3Store{
+		endpoint:     endpoint,
+		region:       region,
+		bucket:       bucket,
+		accessKeyID:  strings.TrimSpace(cfg.AccessKeyID),
+		secretKey:    strings.TrimSpace(cfg.SecretAccessKey),
+		pathStyle:    cfg.ForcePathStyle,
+		client:       &http.Client{Timeout: timeout},
+		maxErrorBody: 4096,
+	}, nil
+}
+
+func (s *S3Store) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	escapedKey := escapeS3Path(key)
+	if s.pathStyle {
+		u.Path = s.endpoint.Path + "/" + s.bucket + "/" + key
+		u.RawPath = s.endpoint.Path + "/" + escapeS3Path(s.bucket) + "/" + escapedKey
+	} else {
+		u.Host = s.bucket + "." + s.endpoint.Host
+		u.Path = s.endpoint.Path + "/" + key
+		u.RawPath = s.endpoint.Path + "/" + escapedKey
+	}
+	return &u
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(cleaned).String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(data))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	sum := sha256.Sum256(data)
+	s.sign(req, hex.EncodeToString(sum[:]))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		return s.responseError(resp)
+	}
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(cleaned).String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.sign(req, s3EmptyHash)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		_ = resp.Body.Close()
+		return nil, 0, ErrNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		return nil, 0, s.responseError(resp)
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(cleaned).String(), nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, s3EmptyHash)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return s.responseError(resp)
+	}
+	return nil
+}
+
+func (s *S3Store) responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, s.maxErrorBody))
+	return fmt.Errorf("s3 %s %s returned %d: %s", resp.Request.Method, resp.Request.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// sign adds a SigV4 Authorization header covering host and the x-amz-*
+// headers.
+func (s *S3Store) sign(req *http.Request, payloadHash string) {
+	now := s3Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := s3SigningAlgo + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgo, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// escapeS3Path percent-encodes everything except unreserved characters and
+// the slash separators, as SigV4 expects for S3 object keys.
+func escapeS3Path(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		if ('A' <= ch && ch <= 'Z') || ('a' <= ch && ch <= 'z') || ('0' <= ch && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' || ch == '/' {
+			b.WriteByte(ch)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", ch)
+	}
+	return b.String()
+}
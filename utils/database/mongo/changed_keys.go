@@ -0,0 +1,102 @@
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// uploadBookkeepingKeys are set on every upload and say nothing about the
+// game data, so they are never reported as changed.
+var uploadBookkeepingKeys = map[string]struct{}{
+	fieldID:         {},
+	fieldServer:     {},
+	fieldUploadTime: {},
+}
+
+// mergedRowKeys identifies the rows of the fields the suite merge rebuilds.
+// The merge returns them in no particular order, so they are compared row by
+// row instead of as a whole.
+var mergedRowKeys = map[string]func(map[string]any) (string, bool){
+	fieldUserEvents:      eventBackfillKey,
+	fieldUserWorldBlooms: worldBloomBackfillKey,
+	fieldUserGachas:      gachaBackfillKey,
+}
+
+// changedUploadKeys returns the sorted top-level keys of setDoc whose value
+// differs from stored. Dotted paths are reported by their top-level key.
+func changedUploadKeys(stored map[string]any, setDoc bson.M) []string {
+	seen := make(map[string]struct{}, len(setDoc))
+	changed := make([]string, 0)
+	for path, value := range setDoc {
+		key, _, _ := strings.Cut(path, ".")
+		if _, ignored := uploadBookkeepingKeys[key]; ignored {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		previous, found := storedValue(stored, path)
+		if found && storedValueEqual(key, previous, value) {
+			continue
+		}
+		seen[key] = struct{}{}
+		changed = append(changed, key)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+func storedValue(stored map[string]any, path string) (any, bool) {
+	var current any = stored
+	for _, segment := range strings.Split(path, ".") {
+		doc, ok := normalizeDocument(current)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = doc[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func storedValueEqual(key string, stored, uploaded any) bool {
+	if rowKey, ok := mergedRowKeys[key]; ok {
+		storedRows := extractAnySlice(canonicalStoredValue(stored))
+		uploadedRows := extractAnySlice(canonicalStoredValue(uploaded))
+		return len(storedRows) == len(uploadedRows) && !suiteFieldChanged(storedRows, uploadedRows, rowKey)
+	}
+	storedJSON, storedErr := json.Marshal(canonicalStoredValue(stored))
+	uploadedJSON, uploadedErr := json.Marshal(canonicalStoredValue(uploaded))
+	if storedErr != nil || uploadedErr != nil {
+		return false
+	}
+	return bytes.Equal(storedJSON, uploadedJSON)
+}
+
+// canonicalStoredValue turns the ordered documents the driver decodes into
+// maps, so a stored value and the uploaded one marshal to the same JSON.
+func canonicalStoredValue(value any) any {
+	switch typed := value.(type) {
+	case bson.D, bson.M, map[string]any:
+		doc, _ := normalizeDocument(typed)
+		converted := make(map[string]any, len(doc))
+		for key, item := range doc {
+			converted[key] = canonicalStoredValue(item)
+		}
+		return converted
+	case bson.A, []any:
+		items := extractAnySlice(typed)
+		converted := make([]any, len(items))
+		for i, item := range items {
+			converted[i] = canonicalStoredValue(item)
+		}
+		return converted
+	default:
+		return value
+	}
+}
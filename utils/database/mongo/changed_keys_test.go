@@ -0,0 +1,59 @@
+package manager
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestChangedUploadKeysComparesAgainstStoredValues(t *testing.T) {
+	// Stored values come back from the driver as ordered documents and
+	// narrower integer types than the upload uses.
+	stored := map[string]any{
+		fieldServer:     "jp",
+		fieldUploadTime: int64(1700000000),
+		"userGamedata":  bson.D{{Key: "rank", Value: int32(120)}, {Key: "name", Value: "haruki"}},
+		"userCards":     bson.A{bson.D{{Key: "cardId", Value: int32(1)}}},
+		"userStamps":    bson.A{int32(1), int32(2)},
+		fieldUserEvents: bson.A{
+			bson.D{{Key: fieldEventID, Value: int32(10)}, {Key: fieldEventPoint, Value: int64(500)}},
+			bson.D{{Key: fieldEventID, Value: int32(11)}, {Key: fieldEventPoint, Value: int64(800)}},
+		},
+		fieldUserGachas: bson.A{
+			bson.D{{Key: fieldGachaID, Value: int32(1)}, {Key: fieldGachaBehaviorID, Value: int32(2)}},
+		},
+		"updatedResources": bson.D{{Key: "userMysekaiHarvestMaps", Value: bson.A{"a"}}},
+	}
+	setDoc := bson.M{
+		fieldServer:     "jp",
+		fieldUploadTime: int64(1700000100),
+		"userGamedata":  map[string]any{"name": "haruki", "rank": int64(120)},
+		"userCards":     []any{map[string]any{"cardId": int64(1)}},
+		"userStamps":    []any{int64(1), int64(2), int64(3)},
+		"userMusics":    []any{},
+		// The merge returns rows in no particular order.
+		fieldUserEvents: []any{
+			map[string]any{fieldEventID: int64(11), fieldEventPoint: int64(800)},
+			map[string]any{fieldEventID: int64(10), fieldEventPoint: int64(500)},
+		},
+		fieldUserGachas: []any{
+			map[string]any{fieldGachaID: int64(1), fieldGachaBehaviorID: int64(2)},
+			map[string]any{fieldGachaID: int64(3), fieldGachaBehaviorID: int64(4)},
+		},
+		fieldUpdatedResourcesHarvestMap: []any{"a"},
+	}
+
+	got := changedUploadKeys(stored, setDoc)
+	want := []string{"userGachas", "userMusics", "userStamps"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("changed keys = %#v, want %#v", got, want)
+	}
+
+	if got := changedUploadKeys(map[string]any{}, bson.M{fieldServer: "jp", "userCards": []any{}}); !reflect.DeepEqual(got, []string{"userCards"}) {
+		t.Fatalf("changed keys for a new document = %#v", got)
+	}
+	if got := changedUploadKeys(map[string]any{}, bson.M{fieldUpdatedResourcesHarvestMap: []any{"b"}}); !reflect.DeepEqual(got, []string{"updatedResources"}) {
+		t.Fatalf("changed keys for a dotted path = %#v", got)
+	}
+}
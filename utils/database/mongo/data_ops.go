@@ -38,51 +38,80 @@ func validateNoMongoOperatorKeys(value any) error {
 	return nil
 }
 
+// UpdateData stores an upload and returns the top-level keys whose stored
+// value it changed. The stored values are read in the same query that loads
+// the fields the suite merge needs.
 func (m *MongoDBManager) UpdateData(
 	ctx context.Context,
 	server string,
 	userID int64,
 	data map[string]any,
 	dataType utils.UploadDataType,
-) (*mongo.UpdateResult, error) {
+) ([]string, error) {
 	if err := validateNoMongoOperatorKeys(data); err != nil {
 		harukiLogger.Warnf("Rejected upload with invalid field name for user %d: %v", userID, err)
 		return nil, err
 	}
 	collection := m.getCollectionByDataType(dataType)
-	var updateDoc bson.M
+	var setDoc bson.M
+	var fields []string
 
 	switch dataType {
 	case utils.UploadDataTypeSuite:
-		oldData, err := m.fetchOldData(ctx, collection, userID)
-		if err != nil {
-			return nil, err
+		for key := range data {
+			fields = append(fields, key)
 		}
-		finalData := m.buildFinalData(oldData, data)
-		finalData[fieldServer] = server
-		updateDoc = bson.M{"$set": finalData}
+		fields = append(fields, fieldServer, fieldUserEvents, fieldUserWorldBlooms, fieldUserGachas)
 	case utils.UploadDataTypeMysekai:
 		data[fieldServer] = server
-		updateDoc = bson.M{"$set": data}
+		setDoc = bson.M(data)
 	default:
 		updatedResources, _ := data["updatedResources"].(map[string]any)
-		updateDoc = bson.M{"$set": bson.M{
+		setDoc = bson.M{
 			fieldServer:                     server,
 			fieldUploadTime:                 data[fieldUploadTime],
 			fieldUpdatedResourcesHarvestMap: updatedResources["userMysekaiHarvestMaps"],
-		}}
+		}
+	}
+	for key := range setDoc {
+		fields = append(fields, key)
 	}
+	stored, err := m.fetchStoredFields(ctx, collection, userID, fields)
+	if err != nil {
+		return nil, err
+	}
+	if dataType == utils.UploadDataTypeSuite {
+		setDoc = m.buildFinalData(stored, data)
+		setDoc[fieldServer] = server
+	}
+	changedKeys := changedUploadKeys(stored, setDoc)
+	updateDoc := bson.M{"$set": setDoc}
 
-	res, err := collection.UpdateOne(
+	filter := bson.M{fieldID: userID}
+	if !m.SnapshotHistoryEnabled() || !supportsSnapshotHistory(dataType) {
+		if _, err := collection.UpdateOne(ctx, filter, updateDoc, options.UpdateOne().SetUpsert(true)); err != nil {
+			harukiLogger.Errorf("Failed to update data for user %d: %v", userID, err)
+			return nil, err
+		}
+		return changedKeys, nil
+	}
+	// The snapshot is taken from the document this update wrote, so an upload
+	// for the same user that lands right after cannot end up in it.
+	raw, err := collection.FindOneAndUpdate(
 		ctx,
-		bson.M{fieldID: userID},
+		filter,
 		updateDoc,
-		options.UpdateOne().SetUpsert(true),
-	)
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Raw()
 	if err != nil {
 		harukiLogger.Errorf("Failed to update data for user %d: %v", userID, err)
+		return nil, err
+	}
+	// History is best-effort: the upload itself already succeeded.
+	if historyErr := m.recordSnapshotRevision(ctx, server, userID, dataType, raw); historyErr != nil {
+		harukiLogger.Errorf("Failed to record snapshot revision for user %d: %v", userID, historyErr)
 	}
-	return res, err
+	return changedKeys, nil
 }
 
 func (m *MongoDBManager) getCollectionByDataType(dataType utils.UploadDataType) *mongo.Collection {
@@ -92,32 +121,35 @@ func (m *MongoDBManager) getCollectionByDataType(dataType utils.UploadDataType)
 	return m.mysekaiCollection
 }
 
-func (m *MongoDBManager) fetchOldData(
+// fetchStoredFields reads the stored values of the fields an upload sets.
+// A user without a document yields an empty map.
+func (m *MongoDBManager) fetchStoredFields(
 	ctx context.Context,
 	collection *mongo.Collection,
 	userID int64,
+	fields []string,
 ) (map[string]any, error) {
-	projection := bson.M{
-		fieldUserEvents:      1,
-		fieldUserWorldBlooms: 1,
-		fieldUserGachas:      1,
-		fieldID:              0,
+	projection := bson.M{fieldID: 0}
+	for _, field := range fields {
+		if field != fieldID {
+			projection[field] = 1
+		}
 	}
 
-	var oldData map[string]any
+	var stored map[string]any
 	err := collection.FindOne(
 		ctx,
 		bson.M{fieldID: userID},
 		options.FindOne().SetProjection(projection),
-	).Decode(&oldData)
+	).Decode(&stored)
 	if errors.Is(err, mongo.ErrNoDocuments) {
 		return make(map[string]any), nil
 	}
 	if err != nil {
-		harukiLogger.Errorf("Failed to fetch old data for user %d: %v", userID, err)
+		harukiLogger.Errorf("Failed to fetch stored data for user %d: %v", userID, err)
 		return nil, err
 	}
-	return oldData, nil
+	return stored, nil
 }
 
 func (m *MongoDBManager) buildFinalData(oldData, data map[string]any) bson.M {
@@ -371,3 +403,39 @@ func (m *MongoDBManager) GetDataWithProjection(
 	}
 	return result, err
 }
+
+// DeleteData removes every stored document for the game account on server:
+// the suite document, the mysekai document (which also carries the birthday
+// party harvest map) and, when enabled, all snapshot revisions. It returns
+// how many documents were deleted.
+func (m *MongoDBManager) DeleteData(
+	ctx context.Context,
+	userID int64,
+	server string,
+) (int64, error) {
+	if m == nil || m.suiteCollection == nil || m.mysekaiCollection == nil {
+		return 0, fmt.Errorf("mongo database is not initialized")
+	}
+	filter := bson.M{fieldID: userID, fieldServer: server}
+	var deleted int64
+	for _, collection := range []*mongo.Collection{m.suiteCollection, m.mysekaiCollection} {
+		res, err := collection.DeleteMany(ctx, filter)
+		if err != nil {
+			harukiLogger.Errorf("Failed to delete data for user %d: %v", userID, err)
+			return deleted, err
+		}
+		deleted += res.DeletedCount
+	}
+	if m.SnapshotHistoryEnabled() {
+		res, err := m.history.collection.DeleteMany(ctx, bson.M{
+			historyFieldUserID: userID,
+			historyFieldServer: server,
+		})
+		if err != nil {
+			harukiLogger.Errorf("Failed to delete snapshot revisions for user %d: %v", userID, err)
+			return deleted, err
+		}
+		deleted += res.DeletedCount
+	}
+	return deleted, nil
+}
@@ -0,0 +1,315 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+
+	"github.com/klauspost/compress/zstd"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	historyFieldUserID     = "user_id"
+	historyFieldServer     = "server"
+	historyFieldDataType   = "data_type"
+	historyFieldUploadTime = "upload_time"
+	historyFieldPayload    = "payload"
+
+	// SnapshotEncodingBSONZstd marks a revision payload as a zstd-compressed
+	// BSON document, exactly as it was stored after the upload was merged.
+	SnapshotEncodingBSONZstd = "bson+zstd"
+)
+
+var ErrSnapshotHistoryDisabled = errors.New("snapshot history is disabled")
+
+var (
+	snapshotEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+	snapshotDecoder, _ = zstd.NewReader(nil)
+)
+
+// SnapshotRetentionPolicy bounds how many revisions are kept per user, server
+// and data type. Zero values disable the corresponding limit.
+type SnapshotRetentionPolicy struct {
+	MaxRevisions int
+	MaxAge       time.Duration
+}
+
+type SnapshotRetentionOverride struct {
+	Server   string
+	DataType string
+	Policy   SnapshotRetentionPolicy
+}
+
+type SnapshotHistoryOptions struct {
+	Collection string
+	Default    SnapshotRetentionPolicy
+	Overrides  []SnapshotRetentionOverride
+}
+
+// PolicyFor resolves the retention policy for server/dataType. The most
+// specific override wins: server+data_type, then server, then data_type.
+func (o SnapshotHistoryOptions) PolicyFor(server string, dataType utils.UploadDataType) SnapshotRetentionPolicy {
+	bestScore := -1
+	policy := o.Default
+	for _, override := range o.Overrides {
+		score := 0
+		if override.Server != "" {
+			if !strings.EqualFold(override.Server, server) {
+				continue
+			}
+			score += 2
+		}
+		if override.DataType != "" {
+			if !strings.EqualFold(override.DataType, string(dataType)) {
+				continue
+			}
+			score++
+		}
+		if score > bestScore {
+			bestScore = score
+			policy = override.Policy
+		}
+	}
+	return policy
+}
+
+type SnapshotRevision struct {
+	ID             bson.ObjectID `bson:"_id"`
+	UserID         int64         `bson:"user_id"`
+	Server         string        `bson:"server"`
+	DataType       string        `bson:"data_type"`
+	UploadTime     int64         `bson:"upload_time"`
+	RecordedAt     time.Time     `bson:"recorded_at"`
+	Encoding       string        `bson:"encoding"`
+	Size           int           `bson:"size"`
+	CompressedSize int           `bson:"compressed_size"`
+	Payload        []byte        `bson:"payload,omitempty"`
+}
+
+type snapshotHistory struct {
+	collection *mongo.Collection
+	options    SnapshotHistoryOptions
+}
+
+// EnableSnapshotHistory turns on revision archiving for suite and mysekai
+// uploads and makes sure the lookup index exists.
+func (m *MongoDBManager) EnableSnapshotHistory(ctx context.Context, opts SnapshotHistoryOptions) error {
+	if m == nil || m.database == nil {
+		return fmt.Errorf("mongo database is not initialized")
+	}
+	name := strings.TrimSpace(opts.Collection)
+	if name == "" {
+		return fmt.Errorf("snapshot history collection is empty")
+	}
+	collection := m.database.Collection(name)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: historyFieldUserID, Value: 1},
+			{Key: historyFieldServer, Value: 1},
+			{Key: historyFieldDataType, Value: 1},
+			{Key: historyFieldUploadTime, Value: -1},
+			{Key: fieldID, Value: -1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create snapshot history index: %w", err)
+	}
+	m.history = &snapshotHistory{collection: collection, options: opts}
+	return nil
+}
+
+func (m *MongoDBManager) SnapshotHistoryEnabled() bool {
+	return m != nil && m.history != nil
+}
+
+func supportsSnapshotHistory(dataType utils.UploadDataType) bool {
+	return dataType == utils.UploadDataTypeSuite || dataType == utils.UploadDataTypeMysekai
+}
+
+func compressSnapshot(raw []byte) []byte {
+	return snapshotEncoder.EncodeAll(raw, make([]byte, 0, len(raw)/4))
+}
+
+func decompressSnapshot(payload []byte) (bson.D, error) {
+	raw, err := snapshotDecoder.DecodeAll(payload, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decompress snapshot: %w", err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+	return doc, nil
+}
+
+// recordSnapshotRevision archives raw, the document as the upload left it,
+// so merged arrays and keys left over from earlier uploads are captured too.
+func (m *MongoDBManager) recordSnapshotRevision(
+	ctx context.Context,
+	server string,
+	userID int64,
+	dataType utils.UploadDataType,
+	raw bson.Raw,
+) error {
+	var uploadTime int64
+	if value, lookupErr := raw.LookupErr(fieldUploadTime); lookupErr == nil {
+		uploadTime, _ = value.AsInt64OK()
+	}
+	now := time.Now().UTC()
+	if uploadTime == 0 {
+		uploadTime = now.Unix()
+	}
+	payload := compressSnapshot(raw)
+	revision := SnapshotRevision{
+		ID:             bson.NewObjectID(),
+		UserID:         userID,
+		Server:         server,
+		DataType:       string(dataType),
+		UploadTime:     uploadTime,
+		RecordedAt:     now,
+		Encoding:       SnapshotEncodingBSONZstd,
+		Size:           len(raw),
+		CompressedSize: len(payload),
+		Payload:        payload,
+	}
+	if _, err := m.history.collection.InsertOne(ctx, revision); err != nil {
+		return fmt.Errorf("insert snapshot revision: %w", err)
+	}
+	return m.pruneSnapshotRevisions(ctx, server, userID, dataType, now)
+}
+
+func (m *MongoDBManager) pruneSnapshotRevisions(
+	ctx context.Context,
+	server string,
+	userID int64,
+	dataType utils.UploadDataType,
+	now time.Time,
+) error {
+	policy := m.history.options.PolicyFor(server, dataType)
+	filter := snapshotRevisionFilter(userID, server, dataType)
+	if policy.MaxAge > 0 {
+		ageFilter := snapshotRevisionFilter(userID, server, dataType)
+		ageFilter[historyFieldUploadTime] = bson.M{"$lt": now.Add(-policy.MaxAge).Unix()}
+		if _, err := m.history.collection.DeleteMany(ctx, ageFilter); err != nil {
+			return fmt.Errorf("prune expired snapshot revisions: %w", err)
+		}
+	}
+	if policy.MaxRevisions <= 0 {
+		return nil
+	}
+	cursor, err := m.history.collection.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: historyFieldUploadTime, Value: -1}, {Key: fieldID, Value: -1}}).
+		SetSkip(int64(policy.MaxRevisions)).
+		SetProjection(bson.M{fieldID: 1}))
+	if err != nil {
+		return fmt.Errorf("find surplus snapshot revisions: %w", err)
+	}
+	defer closeCursor(ctx, cursor)
+	var surplus []struct {
+		ID bson.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &surplus); err != nil {
+		return fmt.Errorf("decode surplus snapshot revisions: %w", err)
+	}
+	if len(surplus) == 0 {
+		return nil
+	}
+	ids := make(bson.A, 0, len(surplus))
+	for _, item := range surplus {
+		ids = append(ids, item.ID)
+	}
+	if _, err := m.history.collection.DeleteMany(ctx, bson.M{fieldID: bson.M{"$in": ids}}); err != nil {
+		return fmt.Errorf("prune surplus snapshot revisions: %w", err)
+	}
+	return nil
+}
+
+func snapshotRevisionFilter(userID int64, server string, dataType utils.UploadDataType) bson.M {
+	return bson.M{
+		historyFieldUserID:   userID,
+		historyFieldServer:   server,
+		historyFieldDataType: string(dataType),
+	}
+}
+
+// ListSnapshotRevisions returns revision metadata (without payloads), newest
+// first, together with the total revision count.
+func (m *MongoDBManager) ListSnapshotRevisions(
+	ctx context.Context,
+	userID int64,
+	server string,
+	dataType utils.UploadDataType,
+	page int,
+	pageSize int,
+) ([]SnapshotRevision, int64, error) {
+	if !m.SnapshotHistoryEnabled() {
+		return nil, 0, ErrSnapshotHistoryDisabled
+	}
+	filter := snapshotRevisionFilter(userID, server, dataType)
+	total, err := m.history.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		harukiLogger.Errorf("Failed to count snapshot revisions for user %d: %v", userID, err)
+		return nil, 0, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	cursor, err := m.history.collection.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: historyFieldUploadTime, Value: -1}, {Key: fieldID, Value: -1}}).
+		SetSkip(int64((page-1)*pageSize)).
+		SetLimit(int64(pageSize)).
+		SetProjection(bson.M{historyFieldPayload: 0}))
+	if err != nil {
+		harukiLogger.Errorf("Failed to list snapshot revisions for user %d: %v", userID, err)
+		return nil, 0, err
+	}
+	defer closeCursor(ctx, cursor)
+	revisions := make([]SnapshotRevision, 0, pageSize)
+	if err := cursor.All(ctx, &revisions); err != nil {
+		harukiLogger.Errorf("Failed to decode snapshot revisions for user %d: %v", userID, err)
+		return nil, 0, err
+	}
+	return revisions, total, nil
+}
+
+// GetSnapshotRevisionAt returns the newest revision uploaded at or before at,
+// along with its decoded document. It returns nil values when none exists.
+func (m *MongoDBManager) GetSnapshotRevisionAt(
+	ctx context.Context,
+	userID int64,
+	server string,
+	dataType utils.UploadDataType,
+	at time.Time,
+) (*SnapshotRevision, bson.D, error) {
+	if !m.SnapshotHistoryEnabled() {
+		return nil, nil, ErrSnapshotHistoryDisabled
+	}
+	filter := snapshotRevisionFilter(userID, server, dataType)
+	filter[historyFieldUploadTime] = bson.M{"$lte": at.Unix()}
+	var revision SnapshotRevision
+	err := m.history.collection.FindOne(ctx, filter, options.FindOne().
+		SetSort(bson.D{{Key: historyFieldUploadTime, Value: -1}, {Key: fieldID, Value: -1}}),
+	).Decode(&revision)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		harukiLogger.Errorf("Failed to get snapshot revision for user %d: %v", userID, err)
+		return nil, nil, err
+	}
+	doc, err := decompressSnapshot(revision.Payload)
+	if err != nil {
+		harukiLogger.Errorf("Failed to restore snapshot revision %s for user %d: %v", revision.ID.Hex(), userID, err)
+		return nil, nil, err
+	}
+	revision.Payload = nil
+	return &revision, doc, nil
+}
@@ -0,0 +1,69 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestSnapshotHistoryOptionsPolicyFor(t *testing.T) {
+	opts := SnapshotHistoryOptions{
+		Default: SnapshotRetentionPolicy{MaxRevisions: 30, MaxAge: 90 * 24 * time.Hour},
+		Overrides: []SnapshotRetentionOverride{
+			{DataType: "mysekai", Policy: SnapshotRetentionPolicy{MaxRevisions: 5}},
+			{Server: "jp", Policy: SnapshotRetentionPolicy{MaxRevisions: 40}},
+			{Server: "jp", DataType: "suite", Policy: SnapshotRetentionPolicy{MaxRevisions: 60}},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		server   string
+		dataType utils.UploadDataType
+		want     int
+	}{
+		{name: "server and data type", server: "jp", dataType: utils.UploadDataTypeSuite, want: 60},
+		{name: "server beats data type", server: "jp", dataType: utils.UploadDataTypeMysekai, want: 40},
+		{name: "data type only", server: "en", dataType: utils.UploadDataTypeMysekai, want: 5},
+		{name: "default", server: "tw", dataType: utils.UploadDataTypeSuite, want: 30},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := opts.PolicyFor(tc.server, tc.dataType)
+			if got.MaxRevisions != tc.want {
+				t.Fatalf("PolicyFor(%q, %q).MaxRevisions = %d, want %d", tc.server, tc.dataType, got.MaxRevisions, tc.want)
+			}
+		})
+	}
+}
+
+func TestSnapshotCompressionRoundTrip(t *testing.T) {
+	raw, err := bson.Marshal(bson.D{
+		{Key: "_id", Value: int64(42)},
+		{Key: "server", Value: "jp"},
+		{Key: "userCards", Value: bson.A{bson.D{{Key: "cardId", Value: int32(1)}}}},
+	})
+	if err != nil {
+		t.Fatalf("bson.Marshal returned error: %v", err)
+	}
+
+	doc, err := decompressSnapshot(compressSnapshot(raw))
+	if err != nil {
+		t.Fatalf("decompressSnapshot returned error: %v", err)
+	}
+	if len(doc) != 3 || doc[0].Key != "_id" || doc[1].Value != "jp" {
+		t.Fatalf("decompressSnapshot() = %#v, want original document", doc)
+	}
+}
+
+func TestSupportsSnapshotHistory(t *testing.T) {
+	if !supportsSnapshotHistory(utils.UploadDataTypeSuite) || !supportsSnapshotHistory(utils.UploadDataTypeMysekai) {
+		t.Fatalf("suite and mysekai uploads should be archived")
+	}
+	if supportsSnapshotHistory(utils.UploadDataTypeMysekaiBirthdayParty) {
+		t.Fatalf("birthday party uploads should not be archived")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -11,8 +12,10 @@ import (
 
 type MongoDBManager struct {
 	client            *mongo.Client
+	database          *mongo.Database
 	suiteCollection   *mongo.Collection
 	mysekaiCollection *mongo.Collection
+	history           *snapshotHistory
 }
 
 func (m *MongoDBManager) Ping(ctx context.Context) error {
@@ -33,7 +36,7 @@ func NewMongoDBManager(
 	ctx context.Context,
 	dbURL, db, suite, mysekai string,
 ) (*MongoDBManager, error) {
-	client, err := mongo.Connect(options.Client().ApplyURI(dbURL))
+	client, err := mongo.Connect(options.Client().ApplyURI(dbURL).SetMonitor(harukiTracing.MongoMonitor()))
 	if err != nil {
 		harukiLogger.Errorf("Failed to connect to MongoDB: %v", err)
 		return nil, err
@@ -47,6 +50,7 @@ func NewMongoDBManager(
 
 	return &MongoDBManager{
 		client:            client,
+		database:          client.Database(db),
 		suiteCollection:   client.Database(db).Collection(suite),
 		mysekaiCollection: client.Database(db).Collection(mysekai),
 	}, nil
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
+)
+
+// BotLoginHistory is the model entity for the BotLoginHistory schema.
+type BotLoginHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BotID holds the value of the "bot_id" field.
+	BotID int `json:"bot_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// reported: self-reported by the bot client; toolbox: seen by the toolbox backend
+	Source string `json:"source,omitempty"`
+	// LoggedInAt holds the value of the "logged_in_at" field.
+	LoggedInAt   time.Time `json:"logged_in_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BotLoginHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case botloginhistory.FieldID, botloginhistory.FieldBotID:
+			values[i] = new(sql.NullInt64)
+		case botloginhistory.FieldIP, botloginhistory.FieldLocation, botloginhistory.FieldSource:
+			values[i] = new(sql.NullString)
+		case botloginhistory.FieldLoggedInAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BotLoginHistory fields.
+func (_m *BotLoginHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case botloginhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case botloginhistory.FieldBotID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bot_id", values[i])
+			} else if value.Valid {
+				_m.BotID = int(value.Int64)
+			}
+		case botloginhistory.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case botloginhistory.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
+		case botloginhistory.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case botloginhistory.FieldLoggedInAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field logged_in_at", values[i])
+			} else if value.Valid {
+				_m.LoggedInAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BotLoginHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *BotLoginHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BotLoginHistory.
+// Note that you need to call BotLoginHistory.Unwrap() before calling this method if this BotLoginHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BotLoginHistory) Update() *BotLoginHistoryUpdateOne {
+	return NewBotLoginHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BotLoginHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BotLoginHistory) Unwrap() *BotLoginHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("neopg: BotLoginHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BotLoginHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("BotLoginHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("bot_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BotID))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("logged_in_at=")
+	builder.WriteString(_m.LoggedInAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BotLoginHistories is a parsable slice of BotLoginHistory.
+type BotLoginHistories []*BotLoginHistory
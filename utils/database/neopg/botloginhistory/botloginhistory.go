@@ -0,0 +1,96 @@
+// Code generated by ent, DO NOT EDIT.
+
+package botloginhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the botloginhistory type in the database.
+	Label = "bot_login_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBotID holds the string denoting the bot_id field in the database.
+	FieldBotID = "bot_id"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldLoggedInAt holds the string denoting the logged_in_at field in the database.
+	FieldLoggedInAt = "logged_in_at"
+	// Table holds the table name of the botloginhistory in the database.
+	Table = "bot_login_histories"
+)
+
+// Columns holds all SQL columns for botloginhistory fields.
+var Columns = []string{
+	FieldID,
+	FieldBotID,
+	FieldIP,
+	FieldLocation,
+	FieldSource,
+	FieldLoggedInAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIP holds the default value on creation for the "ip" field.
+	DefaultIP string
+	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	IPValidator func(string) error
+	// DefaultLocation holds the default value on creation for the "location" field.
+	DefaultLocation string
+	// LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	LocationValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// DefaultLoggedInAt holds the default value on creation for the "logged_in_at" field.
+	DefaultLoggedInAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BotLoginHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBotID orders the results by the bot_id field.
+func ByBotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBotID, opts...).ToFunc()
+}
+
+// ByIP orders the results by the ip field.
+func ByIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIP, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByLoggedInAt orders the results by the logged_in_at field.
+func ByLoggedInAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoggedInAt, opts...).ToFunc()
+}
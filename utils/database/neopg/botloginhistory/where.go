@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package botloginhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLTE(FieldID, id))
+}
+
+// BotID applies equality check predicate on the "bot_id" field. It's identical to BotIDEQ.
+func BotID(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldBotID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldIP, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldLocation, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldSource, v))
+}
+
+// LoggedInAt applies equality check predicate on the "logged_in_at" field. It's identical to LoggedInAtEQ.
+func LoggedInAt(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldLoggedInAt, v))
+}
+
+// BotIDEQ applies the EQ predicate on the "bot_id" field.
+func BotIDEQ(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldBotID, v))
+}
+
+// BotIDNEQ applies the NEQ predicate on the "bot_id" field.
+func BotIDNEQ(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNEQ(FieldBotID, v))
+}
+
+// BotIDIn applies the In predicate on the "bot_id" field.
+func BotIDIn(vs ...int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIn(FieldBotID, vs...))
+}
+
+// BotIDNotIn applies the NotIn predicate on the "bot_id" field.
+func BotIDNotIn(vs ...int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotIn(FieldBotID, vs...))
+}
+
+// BotIDGT applies the GT predicate on the "bot_id" field.
+func BotIDGT(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGT(FieldBotID, v))
+}
+
+// BotIDGTE applies the GTE predicate on the "bot_id" field.
+func BotIDGTE(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGTE(FieldBotID, v))
+}
+
+// BotIDLT applies the LT predicate on the "bot_id" field.
+func BotIDLT(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLT(FieldBotID, v))
+}
+
+// BotIDLTE applies the LTE predicate on the "bot_id" field.
+func BotIDLTE(v int) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLTE(FieldBotID, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPIsNil applies the IsNil predicate on the "ip" field.
+func IPIsNil() predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIsNull(FieldIP))
+}
+
+// IPNotNil applies the NotNil predicate on the "ip" field.
+func IPNotNil() predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotNull(FieldIP))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldContainsFold(FieldIP, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldContainsFold(FieldSource, v))
+}
+
+// LoggedInAtEQ applies the EQ predicate on the "logged_in_at" field.
+func LoggedInAtEQ(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldEQ(FieldLoggedInAt, v))
+}
+
+// LoggedInAtNEQ applies the NEQ predicate on the "logged_in_at" field.
+func LoggedInAtNEQ(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNEQ(FieldLoggedInAt, v))
+}
+
+// LoggedInAtIn applies the In predicate on the "logged_in_at" field.
+func LoggedInAtIn(vs ...time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldIn(FieldLoggedInAt, vs...))
+}
+
+// LoggedInAtNotIn applies the NotIn predicate on the "logged_in_at" field.
+func LoggedInAtNotIn(vs ...time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldNotIn(FieldLoggedInAt, vs...))
+}
+
+// LoggedInAtGT applies the GT predicate on the "logged_in_at" field.
+func LoggedInAtGT(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGT(FieldLoggedInAt, v))
+}
+
+// LoggedInAtGTE applies the GTE predicate on the "logged_in_at" field.
+func LoggedInAtGTE(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldGTE(FieldLoggedInAt, v))
+}
+
+// LoggedInAtLT applies the LT predicate on the "logged_in_at" field.
+func LoggedInAtLT(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLT(FieldLoggedInAt, v))
+}
+
+// LoggedInAtLTE applies the LTE predicate on the "logged_in_at" field.
+func LoggedInAtLTE(v time.Time) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.FieldLTE(FieldLoggedInAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BotLoginHistory) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BotLoginHistory) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BotLoginHistory) predicate.BotLoginHistory {
+	return predicate.BotLoginHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
+)
+
+// BotLoginHistoryCreate is the builder for creating a BotLoginHistory entity.
+type BotLoginHistoryCreate struct {
+	config
+	mutation *BotLoginHistoryMutation
+	hooks    []Hook
+}
+
+// SetBotID sets the "bot_id" field.
+func (_c *BotLoginHistoryCreate) SetBotID(v int) *BotLoginHistoryCreate {
+	_c.mutation.SetBotID(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *BotLoginHistoryCreate) SetIP(v string) *BotLoginHistoryCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *BotLoginHistoryCreate) SetNillableIP(v *string) *BotLoginHistoryCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *BotLoginHistoryCreate) SetLocation(v string) *BotLoginHistoryCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *BotLoginHistoryCreate) SetNillableLocation(v *string) *BotLoginHistoryCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BotLoginHistoryCreate) SetSource(v string) *BotLoginHistoryCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetLoggedInAt sets the "logged_in_at" field.
+func (_c *BotLoginHistoryCreate) SetLoggedInAt(v time.Time) *BotLoginHistoryCreate {
+	_c.mutation.SetLoggedInAt(v)
+	return _c
+}
+
+// SetNillableLoggedInAt sets the "logged_in_at" field if the given value is not nil.
+func (_c *BotLoginHistoryCreate) SetNillableLoggedInAt(v *time.Time) *BotLoginHistoryCreate {
+	if v != nil {
+		_c.SetLoggedInAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BotLoginHistoryMutation object of the builder.
+func (_c *BotLoginHistoryCreate) Mutation() *BotLoginHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the BotLoginHistory in the database.
+func (_c *BotLoginHistoryCreate) Save(ctx context.Context) (*BotLoginHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BotLoginHistoryCreate) SaveX(ctx context.Context) *BotLoginHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BotLoginHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BotLoginHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BotLoginHistoryCreate) defaults() {
+	if _, ok := _c.mutation.IP(); !ok {
+		v := botloginhistory.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.Location(); !ok {
+		v := botloginhistory.DefaultLocation
+		_c.mutation.SetLocation(v)
+	}
+	if _, ok := _c.mutation.LoggedInAt(); !ok {
+		v := botloginhistory.DefaultLoggedInAt()
+		_c.mutation.SetLoggedInAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BotLoginHistoryCreate) check() error {
+	if _, ok := _c.mutation.BotID(); !ok {
+		return &ValidationError{Name: "bot_id", err: errors.New(`neopg: missing required field "BotLoginHistory.bot_id"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := botloginhistory.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`neopg: validator failed for field "BotLoginHistory.ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Location(); ok {
+		if err := botloginhistory.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`neopg: validator failed for field "BotLoginHistory.location": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`neopg: missing required field "BotLoginHistory.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := botloginhistory.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`neopg: validator failed for field "BotLoginHistory.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LoggedInAt(); !ok {
+		return &ValidationError{Name: "logged_in_at", err: errors.New(`neopg: missing required field "BotLoginHistory.logged_in_at"`)}
+	}
+	return nil
+}
+
+func (_c *BotLoginHistoryCreate) sqlSave(ctx context.Context) (*BotLoginHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BotLoginHistoryCreate) createSpec() (*BotLoginHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BotLoginHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(botloginhistory.Table, sqlgraph.NewFieldSpec(botloginhistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.BotID(); ok {
+		_spec.SetField(botloginhistory.FieldBotID, field.TypeInt, value)
+		_node.BotID = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(botloginhistory.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(botloginhistory.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(botloginhistory.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.LoggedInAt(); ok {
+		_spec.SetField(botloginhistory.FieldLoggedInAt, field.TypeTime, value)
+		_node.LoggedInAt = value
+	}
+	return _node, _spec
+}
+
+// BotLoginHistoryCreateBulk is the builder for creating many BotLoginHistory entities in bulk.
+type BotLoginHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*BotLoginHistoryCreate
+}
+
+// Save creates the BotLoginHistory entities in the database.
+func (_c *BotLoginHistoryCreateBulk) Save(ctx context.Context) ([]*BotLoginHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BotLoginHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BotLoginHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BotLoginHistoryCreateBulk) SaveX(ctx context.Context) []*BotLoginHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BotLoginHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BotLoginHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+)
+
+// BotLoginHistoryDelete is the builder for deleting a BotLoginHistory entity.
+type BotLoginHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *BotLoginHistoryMutation
+}
+
+// Where appends a list predicates to the BotLoginHistoryDelete builder.
+func (_d *BotLoginHistoryDelete) Where(ps ...predicate.BotLoginHistory) *BotLoginHistoryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BotLoginHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BotLoginHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BotLoginHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(botloginhistory.Table, sqlgraph.NewFieldSpec(botloginhistory.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BotLoginHistoryDeleteOne is the builder for deleting a single BotLoginHistory entity.
+type BotLoginHistoryDeleteOne struct {
+	_d *BotLoginHistoryDelete
+}
+
+// Where appends a list predicates to the BotLoginHistoryDelete builder.
+func (_d *BotLoginHistoryDeleteOne) Where(ps ...predicate.BotLoginHistory) *BotLoginHistoryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BotLoginHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{botloginhistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BotLoginHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
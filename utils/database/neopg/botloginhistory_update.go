@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+)
+
+// BotLoginHistoryUpdate is the builder for updating BotLoginHistory entities.
+type BotLoginHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BotLoginHistoryMutation
+}
+
+// Where appends a list predicates to the BotLoginHistoryUpdate builder.
+func (_u *BotLoginHistoryUpdate) Where(ps ...predicate.BotLoginHistory) *BotLoginHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the BotLoginHistoryMutation object of the builder.
+func (_u *BotLoginHistoryUpdate) Mutation() *BotLoginHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BotLoginHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BotLoginHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BotLoginHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BotLoginHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BotLoginHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(botloginhistory.Table, botloginhistory.Columns, sqlgraph.NewFieldSpec(botloginhistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(botloginhistory.FieldIP, field.TypeString)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(botloginhistory.FieldLocation, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{botloginhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BotLoginHistoryUpdateOne is the builder for updating a single BotLoginHistory entity.
+type BotLoginHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BotLoginHistoryMutation
+}
+
+// Mutation returns the BotLoginHistoryMutation object of the builder.
+func (_u *BotLoginHistoryUpdateOne) Mutation() *BotLoginHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BotLoginHistoryUpdate builder.
+func (_u *BotLoginHistoryUpdateOne) Where(ps ...predicate.BotLoginHistory) *BotLoginHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BotLoginHistoryUpdateOne) Select(field string, fields ...string) *BotLoginHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BotLoginHistory entity.
+func (_u *BotLoginHistoryUpdateOne) Save(ctx context.Context) (*BotLoginHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BotLoginHistoryUpdateOne) SaveX(ctx context.Context) *BotLoginHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BotLoginHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BotLoginHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BotLoginHistoryUpdateOne) sqlSave(ctx context.Context) (_node *BotLoginHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(botloginhistory.Table, botloginhistory.Columns, sqlgraph.NewFieldSpec(botloginhistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`neopg: missing "BotLoginHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, botloginhistory.FieldID)
+		for _, f := range fields {
+			if !botloginhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("neopg: invalid field %q for query", f)}
+			}
+			if f != botloginhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(botloginhistory.FieldIP, field.TypeString)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(botloginhistory.FieldLocation, field.TypeString)
+	}
+	_node = &BotLoginHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{botloginhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,16 +11,17 @@ import (
 
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/migrate"
 
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/dailyrequests"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/hourlyrequests"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/requestsranking"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
-
-	"entgo.io/ent"
-	"entgo.io/ent/dialect"
-	"entgo.io/ent/dialect/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -28,10 +29,14 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BotLoginHistory is the client for interacting with the BotLoginHistory builders.
+	BotLoginHistory *BotLoginHistoryClient
 	// CommandLog is the client for interacting with the CommandLog builders.
 	CommandLog *CommandLogClient
 	// CommandManifest is the client for interacting with the CommandManifest builders.
 	CommandManifest *CommandManifestClient
+	// CommandManifestSnapshot is the client for interacting with the CommandManifestSnapshot builders.
+	CommandManifestSnapshot *CommandManifestSnapshotClient
 	// DailyRequests is the client for interacting with the DailyRequests builders.
 	DailyRequests *DailyRequestsClient
 	// HourlyRequests is the client for interacting with the HourlyRequests builders.
@@ -51,8 +56,10 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BotLoginHistory = NewBotLoginHistoryClient(c.config)
 	c.CommandLog = NewCommandLogClient(c.config)
 	c.CommandManifest = NewCommandManifestClient(c.config)
+	c.CommandManifestSnapshot = NewCommandManifestSnapshotClient(c.config)
 	c.DailyRequests = NewDailyRequestsClient(c.config)
 	c.HourlyRequests = NewHourlyRequestsClient(c.config)
 	c.RequestsRanking = NewRequestsRankingClient(c.config)
@@ -147,14 +154,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		CommandLog:      NewCommandLogClient(cfg),
-		CommandManifest: NewCommandManifestClient(cfg),
-		DailyRequests:   NewDailyRequestsClient(cfg),
-		HourlyRequests:  NewHourlyRequestsClient(cfg),
-		RequestsRanking: NewRequestsRankingClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		BotLoginHistory:         NewBotLoginHistoryClient(cfg),
+		CommandLog:              NewCommandLogClient(cfg),
+		CommandManifest:         NewCommandManifestClient(cfg),
+		CommandManifestSnapshot: NewCommandManifestSnapshotClient(cfg),
+		DailyRequests:           NewDailyRequestsClient(cfg),
+		HourlyRequests:          NewHourlyRequestsClient(cfg),
+		RequestsRanking:         NewRequestsRankingClient(cfg),
+		User:                    NewUserClient(cfg),
 	}, nil
 }
 
@@ -172,21 +181,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		CommandLog:      NewCommandLogClient(cfg),
-		CommandManifest: NewCommandManifestClient(cfg),
-		DailyRequests:   NewDailyRequestsClient(cfg),
-		HourlyRequests:  NewHourlyRequestsClient(cfg),
-		RequestsRanking: NewRequestsRankingClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		BotLoginHistory:         NewBotLoginHistoryClient(cfg),
+		CommandLog:              NewCommandLogClient(cfg),
+		CommandManifest:         NewCommandManifestClient(cfg),
+		CommandManifestSnapshot: NewCommandManifestSnapshotClient(cfg),
+		DailyRequests:           NewDailyRequestsClient(cfg),
+		HourlyRequests:          NewHourlyRequestsClient(cfg),
+		RequestsRanking:         NewRequestsRankingClient(cfg),
+		User:                    NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		CommandLog.
+//		BotLoginHistory.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -209,8 +220,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CommandLog, c.CommandManifest, c.DailyRequests, c.HourlyRequests,
-		c.RequestsRanking, c.User,
+		c.BotLoginHistory, c.CommandLog, c.CommandManifest, c.CommandManifestSnapshot,
+		c.DailyRequests, c.HourlyRequests, c.RequestsRanking, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -220,8 +231,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CommandLog, c.CommandManifest, c.DailyRequests, c.HourlyRequests,
-		c.RequestsRanking, c.User,
+		c.BotLoginHistory, c.CommandLog, c.CommandManifest, c.CommandManifestSnapshot,
+		c.DailyRequests, c.HourlyRequests, c.RequestsRanking, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -230,10 +241,14 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BotLoginHistoryMutation:
+		return c.BotLoginHistory.mutate(ctx, m)
 	case *CommandLogMutation:
 		return c.CommandLog.mutate(ctx, m)
 	case *CommandManifestMutation:
 		return c.CommandManifest.mutate(ctx, m)
+	case *CommandManifestSnapshotMutation:
+		return c.CommandManifestSnapshot.mutate(ctx, m)
 	case *DailyRequestsMutation:
 		return c.DailyRequests.mutate(ctx, m)
 	case *HourlyRequestsMutation:
@@ -247,6 +262,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// BotLoginHistoryClient is a client for the BotLoginHistory schema.
+type BotLoginHistoryClient struct {
+	config
+}
+
+// NewBotLoginHistoryClient returns a client for the BotLoginHistory from the given config.
+func NewBotLoginHistoryClient(c config) *BotLoginHistoryClient {
+	return &BotLoginHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `botloginhistory.Hooks(f(g(h())))`.
+func (c *BotLoginHistoryClient) Use(hooks ...Hook) {
+	c.hooks.BotLoginHistory = append(c.hooks.BotLoginHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `botloginhistory.Intercept(f(g(h())))`.
+func (c *BotLoginHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BotLoginHistory = append(c.inters.BotLoginHistory, interceptors...)
+}
+
+// Create returns a builder for creating a BotLoginHistory entity.
+func (c *BotLoginHistoryClient) Create() *BotLoginHistoryCreate {
+	mutation := newBotLoginHistoryMutation(c.config, OpCreate)
+	return &BotLoginHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BotLoginHistory entities.
+func (c *BotLoginHistoryClient) CreateBulk(builders ...*BotLoginHistoryCreate) *BotLoginHistoryCreateBulk {
+	return &BotLoginHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BotLoginHistoryClient) MapCreateBulk(slice any, setFunc func(*BotLoginHistoryCreate, int)) *BotLoginHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BotLoginHistoryCreateBulk{err: fmt.Errorf("calling to BotLoginHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BotLoginHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BotLoginHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BotLoginHistory.
+func (c *BotLoginHistoryClient) Update() *BotLoginHistoryUpdate {
+	mutation := newBotLoginHistoryMutation(c.config, OpUpdate)
+	return &BotLoginHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BotLoginHistoryClient) UpdateOne(_m *BotLoginHistory) *BotLoginHistoryUpdateOne {
+	mutation := newBotLoginHistoryMutation(c.config, OpUpdateOne, withBotLoginHistory(_m))
+	return &BotLoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BotLoginHistoryClient) UpdateOneID(id int) *BotLoginHistoryUpdateOne {
+	mutation := newBotLoginHistoryMutation(c.config, OpUpdateOne, withBotLoginHistoryID(id))
+	return &BotLoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BotLoginHistory.
+func (c *BotLoginHistoryClient) Delete() *BotLoginHistoryDelete {
+	mutation := newBotLoginHistoryMutation(c.config, OpDelete)
+	return &BotLoginHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BotLoginHistoryClient) DeleteOne(_m *BotLoginHistory) *BotLoginHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BotLoginHistoryClient) DeleteOneID(id int) *BotLoginHistoryDeleteOne {
+	builder := c.Delete().Where(botloginhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BotLoginHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for BotLoginHistory.
+func (c *BotLoginHistoryClient) Query() *BotLoginHistoryQuery {
+	return &BotLoginHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBotLoginHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BotLoginHistory entity by its id.
+func (c *BotLoginHistoryClient) Get(ctx context.Context, id int) (*BotLoginHistory, error) {
+	return c.Query().Where(botloginhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BotLoginHistoryClient) GetX(ctx context.Context, id int) *BotLoginHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BotLoginHistoryClient) Hooks() []Hook {
+	return c.hooks.BotLoginHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *BotLoginHistoryClient) Interceptors() []Interceptor {
+	return c.inters.BotLoginHistory
+}
+
+func (c *BotLoginHistoryClient) mutate(ctx context.Context, m *BotLoginHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BotLoginHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BotLoginHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BotLoginHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BotLoginHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("neopg: unknown BotLoginHistory mutation op: %q", m.Op())
+	}
+}
+
 // CommandLogClient is a client for the CommandLog schema.
 type CommandLogClient struct {
 	config
@@ -513,6 +661,139 @@ func (c *CommandManifestClient) mutate(ctx context.Context, m *CommandManifestMu
 	}
 }
 
+// CommandManifestSnapshotClient is a client for the CommandManifestSnapshot schema.
+type CommandManifestSnapshotClient struct {
+	config
+}
+
+// NewCommandManifestSnapshotClient returns a client for the CommandManifestSnapshot from the given config.
+func NewCommandManifestSnapshotClient(c config) *CommandManifestSnapshotClient {
+	return &CommandManifestSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commandmanifestsnapshot.Hooks(f(g(h())))`.
+func (c *CommandManifestSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.CommandManifestSnapshot = append(c.hooks.CommandManifestSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commandmanifestsnapshot.Intercept(f(g(h())))`.
+func (c *CommandManifestSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommandManifestSnapshot = append(c.inters.CommandManifestSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a CommandManifestSnapshot entity.
+func (c *CommandManifestSnapshotClient) Create() *CommandManifestSnapshotCreate {
+	mutation := newCommandManifestSnapshotMutation(c.config, OpCreate)
+	return &CommandManifestSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommandManifestSnapshot entities.
+func (c *CommandManifestSnapshotClient) CreateBulk(builders ...*CommandManifestSnapshotCreate) *CommandManifestSnapshotCreateBulk {
+	return &CommandManifestSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommandManifestSnapshotClient) MapCreateBulk(slice any, setFunc func(*CommandManifestSnapshotCreate, int)) *CommandManifestSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommandManifestSnapshotCreateBulk{err: fmt.Errorf("calling to CommandManifestSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommandManifestSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommandManifestSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommandManifestSnapshot.
+func (c *CommandManifestSnapshotClient) Update() *CommandManifestSnapshotUpdate {
+	mutation := newCommandManifestSnapshotMutation(c.config, OpUpdate)
+	return &CommandManifestSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommandManifestSnapshotClient) UpdateOne(_m *CommandManifestSnapshot) *CommandManifestSnapshotUpdateOne {
+	mutation := newCommandManifestSnapshotMutation(c.config, OpUpdateOne, withCommandManifestSnapshot(_m))
+	return &CommandManifestSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommandManifestSnapshotClient) UpdateOneID(id int) *CommandManifestSnapshotUpdateOne {
+	mutation := newCommandManifestSnapshotMutation(c.config, OpUpdateOne, withCommandManifestSnapshotID(id))
+	return &CommandManifestSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommandManifestSnapshot.
+func (c *CommandManifestSnapshotClient) Delete() *CommandManifestSnapshotDelete {
+	mutation := newCommandManifestSnapshotMutation(c.config, OpDelete)
+	return &CommandManifestSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommandManifestSnapshotClient) DeleteOne(_m *CommandManifestSnapshot) *CommandManifestSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommandManifestSnapshotClient) DeleteOneID(id int) *CommandManifestSnapshotDeleteOne {
+	builder := c.Delete().Where(commandmanifestsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommandManifestSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for CommandManifestSnapshot.
+func (c *CommandManifestSnapshotClient) Query() *CommandManifestSnapshotQuery {
+	return &CommandManifestSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommandManifestSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommandManifestSnapshot entity by its id.
+func (c *CommandManifestSnapshotClient) Get(ctx context.Context, id int) (*CommandManifestSnapshot, error) {
+	return c.Query().Where(commandmanifestsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommandManifestSnapshotClient) GetX(ctx context.Context, id int) *CommandManifestSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommandManifestSnapshotClient) Hooks() []Hook {
+	return c.hooks.CommandManifestSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommandManifestSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.CommandManifestSnapshot
+}
+
+func (c *CommandManifestSnapshotClient) mutate(ctx context.Context, m *CommandManifestSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommandManifestSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommandManifestSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommandManifestSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommandManifestSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("neopg: unknown CommandManifestSnapshot mutation op: %q", m.Op())
+	}
+}
+
 // DailyRequestsClient is a client for the DailyRequests schema.
 type DailyRequestsClient struct {
 	config
@@ -1048,11 +1329,11 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CommandLog, CommandManifest, DailyRequests, HourlyRequests, RequestsRanking,
-		User []ent.Hook
+		BotLoginHistory, CommandLog, CommandManifest, CommandManifestSnapshot,
+		DailyRequests, HourlyRequests, RequestsRanking, User []ent.Hook
 	}
 	inters struct {
-		CommandLog, CommandManifest, DailyRequests, HourlyRequests, RequestsRanking,
-		User []ent.Interceptor
+		BotLoginHistory, CommandLog, CommandManifest, CommandManifestSnapshot,
+		DailyRequests, HourlyRequests, RequestsRanking, User []ent.Interceptor
 	}
 )
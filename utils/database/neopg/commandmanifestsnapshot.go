@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
+)
+
+// CommandManifestSnapshot is the model entity for the CommandManifestSnapshot schema.
+type CommandManifestSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Monotonic manifest version
+	Version int `json:"version,omitempty"`
+	// Hex SHA-256 of the canonical manifest JSON
+	Etag string `json:"etag,omitempty"`
+	// Canonical manifest JSON
+	Content string `json:"content,omitempty"`
+	// EntryCount holds the value of the "entry_count" field.
+	EntryCount int `json:"entry_count,omitempty"`
+	// Change that produced this version, e.g. create, update, delete, rollback
+	Action string `json:"action,omitempty"`
+	// Toolbox user ID of the admin who made the change
+	CreatedBy string `json:"created_by,omitempty"`
+	// Version restored by a rollback
+	RolledBackFrom *int `json:"rolled_back_from,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommandManifestSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commandmanifestsnapshot.FieldID, commandmanifestsnapshot.FieldVersion, commandmanifestsnapshot.FieldEntryCount, commandmanifestsnapshot.FieldRolledBackFrom:
+			values[i] = new(sql.NullInt64)
+		case commandmanifestsnapshot.FieldEtag, commandmanifestsnapshot.FieldContent, commandmanifestsnapshot.FieldAction, commandmanifestsnapshot.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case commandmanifestsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommandManifestSnapshot fields.
+func (_m *CommandManifestSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commandmanifestsnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case commandmanifestsnapshot.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case commandmanifestsnapshot.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				_m.Etag = value.String
+			}
+		case commandmanifestsnapshot.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case commandmanifestsnapshot.FieldEntryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_count", values[i])
+			} else if value.Valid {
+				_m.EntryCount = int(value.Int64)
+			}
+		case commandmanifestsnapshot.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case commandmanifestsnapshot.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case commandmanifestsnapshot.FieldRolledBackFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rolled_back_from", values[i])
+			} else if value.Valid {
+				_m.RolledBackFrom = new(int)
+				*_m.RolledBackFrom = int(value.Int64)
+			}
+		case commandmanifestsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommandManifestSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *CommandManifestSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommandManifestSnapshot.
+// Note that you need to call CommandManifestSnapshot.Unwrap() before calling this method if this CommandManifestSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CommandManifestSnapshot) Update() *CommandManifestSnapshotUpdateOne {
+	return NewCommandManifestSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CommandManifestSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CommandManifestSnapshot) Unwrap() *CommandManifestSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("neopg: CommandManifestSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CommandManifestSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommandManifestSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(_m.Etag)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("entry_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntryCount))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	if v := _m.RolledBackFrom; v != nil {
+		builder.WriteString("rolled_back_from=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommandManifestSnapshots is a parsable slice of CommandManifestSnapshot.
+type CommandManifestSnapshots []*CommandManifestSnapshot
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commandmanifestsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the commandmanifestsnapshot type in the database.
+	Label = "command_manifest_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldEtag holds the string denoting the etag field in the database.
+	FieldEtag = "etag"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldEntryCount holds the string denoting the entry_count field in the database.
+	FieldEntryCount = "entry_count"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldRolledBackFrom holds the string denoting the rolled_back_from field in the database.
+	FieldRolledBackFrom = "rolled_back_from"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the commandmanifestsnapshot in the database.
+	Table = "command_manifest_snapshots"
+)
+
+// Columns holds all SQL columns for commandmanifestsnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldVersion,
+	FieldEtag,
+	FieldContent,
+	FieldEntryCount,
+	FieldAction,
+	FieldCreatedBy,
+	FieldRolledBackFrom,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EtagValidator is a validator for the "etag" field. It is called by the builders before save.
+	EtagValidator func(string) error
+	// DefaultEntryCount holds the default value on creation for the "entry_count" field.
+	DefaultEntryCount int
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CommandManifestSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByEtag orders the results by the etag field.
+func ByEtag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEtag, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByEntryCount orders the results by the entry_count field.
+func ByEntryCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntryCount, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByRolledBackFrom orders the results by the rolled_back_from field.
+func ByRolledBackFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolledBackFrom, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
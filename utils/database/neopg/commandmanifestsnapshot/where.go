@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commandmanifestsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldVersion, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldEtag, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldContent, v))
+}
+
+// EntryCount applies equality check predicate on the "entry_count" field. It's identical to EntryCountEQ.
+func EntryCount(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldEntryCount, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldAction, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// RolledBackFrom applies equality check predicate on the "rolled_back_from" field. It's identical to RolledBackFromEQ.
+func RolledBackFrom(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldRolledBackFrom, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldVersion, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContainsFold(FieldContent, v))
+}
+
+// EntryCountEQ applies the EQ predicate on the "entry_count" field.
+func EntryCountEQ(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldEntryCount, v))
+}
+
+// EntryCountNEQ applies the NEQ predicate on the "entry_count" field.
+func EntryCountNEQ(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldEntryCount, v))
+}
+
+// EntryCountIn applies the In predicate on the "entry_count" field.
+func EntryCountIn(vs ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldEntryCount, vs...))
+}
+
+// EntryCountNotIn applies the NotIn predicate on the "entry_count" field.
+func EntryCountNotIn(vs ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldEntryCount, vs...))
+}
+
+// EntryCountGT applies the GT predicate on the "entry_count" field.
+func EntryCountGT(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldEntryCount, v))
+}
+
+// EntryCountGTE applies the GTE predicate on the "entry_count" field.
+func EntryCountGTE(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldEntryCount, v))
+}
+
+// EntryCountLT applies the LT predicate on the "entry_count" field.
+func EntryCountLT(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldEntryCount, v))
+}
+
+// EntryCountLTE applies the LTE predicate on the "entry_count" field.
+func EntryCountLTE(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldEntryCount, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContainsFold(FieldAction, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// RolledBackFromEQ applies the EQ predicate on the "rolled_back_from" field.
+func RolledBackFromEQ(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldRolledBackFrom, v))
+}
+
+// RolledBackFromNEQ applies the NEQ predicate on the "rolled_back_from" field.
+func RolledBackFromNEQ(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldRolledBackFrom, v))
+}
+
+// RolledBackFromIn applies the In predicate on the "rolled_back_from" field.
+func RolledBackFromIn(vs ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldRolledBackFrom, vs...))
+}
+
+// RolledBackFromNotIn applies the NotIn predicate on the "rolled_back_from" field.
+func RolledBackFromNotIn(vs ...int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldRolledBackFrom, vs...))
+}
+
+// RolledBackFromGT applies the GT predicate on the "rolled_back_from" field.
+func RolledBackFromGT(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldRolledBackFrom, v))
+}
+
+// RolledBackFromGTE applies the GTE predicate on the "rolled_back_from" field.
+func RolledBackFromGTE(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldRolledBackFrom, v))
+}
+
+// RolledBackFromLT applies the LT predicate on the "rolled_back_from" field.
+func RolledBackFromLT(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldRolledBackFrom, v))
+}
+
+// RolledBackFromLTE applies the LTE predicate on the "rolled_back_from" field.
+func RolledBackFromLTE(v int) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldRolledBackFrom, v))
+}
+
+// RolledBackFromIsNil applies the IsNil predicate on the "rolled_back_from" field.
+func RolledBackFromIsNil() predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIsNull(FieldRolledBackFrom))
+}
+
+// RolledBackFromNotNil applies the NotNil predicate on the "rolled_back_from" field.
+func RolledBackFromNotNil() predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotNull(FieldRolledBackFrom))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommandManifestSnapshot) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommandManifestSnapshot) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommandManifestSnapshot) predicate.CommandManifestSnapshot {
+	return predicate.CommandManifestSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
+)
+
+// CommandManifestSnapshotCreate is the builder for creating a CommandManifestSnapshot entity.
+type CommandManifestSnapshotCreate struct {
+	config
+	mutation *CommandManifestSnapshotMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *CommandManifestSnapshotCreate) SetVersion(v int) *CommandManifestSnapshotCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetEtag sets the "etag" field.
+func (_c *CommandManifestSnapshotCreate) SetEtag(v string) *CommandManifestSnapshotCreate {
+	_c.mutation.SetEtag(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *CommandManifestSnapshotCreate) SetContent(v string) *CommandManifestSnapshotCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetEntryCount sets the "entry_count" field.
+func (_c *CommandManifestSnapshotCreate) SetEntryCount(v int) *CommandManifestSnapshotCreate {
+	_c.mutation.SetEntryCount(v)
+	return _c
+}
+
+// SetNillableEntryCount sets the "entry_count" field if the given value is not nil.
+func (_c *CommandManifestSnapshotCreate) SetNillableEntryCount(v *int) *CommandManifestSnapshotCreate {
+	if v != nil {
+		_c.SetEntryCount(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *CommandManifestSnapshotCreate) SetAction(v string) *CommandManifestSnapshotCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *CommandManifestSnapshotCreate) SetCreatedBy(v string) *CommandManifestSnapshotCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *CommandManifestSnapshotCreate) SetNillableCreatedBy(v *string) *CommandManifestSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetRolledBackFrom sets the "rolled_back_from" field.
+func (_c *CommandManifestSnapshotCreate) SetRolledBackFrom(v int) *CommandManifestSnapshotCreate {
+	_c.mutation.SetRolledBackFrom(v)
+	return _c
+}
+
+// SetNillableRolledBackFrom sets the "rolled_back_from" field if the given value is not nil.
+func (_c *CommandManifestSnapshotCreate) SetNillableRolledBackFrom(v *int) *CommandManifestSnapshotCreate {
+	if v != nil {
+		_c.SetRolledBackFrom(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CommandManifestSnapshotCreate) SetCreatedAt(v time.Time) *CommandManifestSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CommandManifestSnapshotCreate) SetNillableCreatedAt(v *time.Time) *CommandManifestSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CommandManifestSnapshotMutation object of the builder.
+func (_c *CommandManifestSnapshotCreate) Mutation() *CommandManifestSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the CommandManifestSnapshot in the database.
+func (_c *CommandManifestSnapshotCreate) Save(ctx context.Context) (*CommandManifestSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CommandManifestSnapshotCreate) SaveX(ctx context.Context) *CommandManifestSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommandManifestSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommandManifestSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CommandManifestSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.EntryCount(); !ok {
+		v := commandmanifestsnapshot.DefaultEntryCount
+		_c.mutation.SetEntryCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := commandmanifestsnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CommandManifestSnapshotCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`neopg: missing required field "CommandManifestSnapshot.version"`)}
+	}
+	if _, ok := _c.mutation.Etag(); !ok {
+		return &ValidationError{Name: "etag", err: errors.New(`neopg: missing required field "CommandManifestSnapshot.etag"`)}
+	}
+	if v, ok := _c.mutation.Etag(); ok {
+		if err := commandmanifestsnapshot.EtagValidator(v); err != nil {
+			return &ValidationError{Name: "etag", err: fmt.Errorf(`neopg: validator failed for field "CommandManifestSnapshot.etag": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`neopg: missing required field "CommandManifestSnapshot.content"`)}
+	}
+	if _, ok := _c.mutation.EntryCount(); !ok {
+		return &ValidationError{Name: "entry_count", err: errors.New(`neopg: missing required field "CommandManifestSnapshot.entry_count"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`neopg: missing required field "CommandManifestSnapshot.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := commandmanifestsnapshot.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`neopg: validator failed for field "CommandManifestSnapshot.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := commandmanifestsnapshot.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`neopg: validator failed for field "CommandManifestSnapshot.created_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`neopg: missing required field "CommandManifestSnapshot.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CommandManifestSnapshotCreate) sqlSave(ctx context.Context) (*CommandManifestSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CommandManifestSnapshotCreate) createSpec() (*CommandManifestSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommandManifestSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(commandmanifestsnapshot.Table, sqlgraph.NewFieldSpec(commandmanifestsnapshot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Etag(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.EntryCount(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldEntryCount, field.TypeInt, value)
+		_node.EntryCount = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.RolledBackFrom(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldRolledBackFrom, field.TypeInt, value)
+		_node.RolledBackFrom = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(commandmanifestsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CommandManifestSnapshotCreateBulk is the builder for creating many CommandManifestSnapshot entities in bulk.
+type CommandManifestSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*CommandManifestSnapshotCreate
+}
+
+// Save creates the CommandManifestSnapshot entities in the database.
+func (_c *CommandManifestSnapshotCreateBulk) Save(ctx context.Context) ([]*CommandManifestSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CommandManifestSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommandManifestSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CommandManifestSnapshotCreateBulk) SaveX(ctx context.Context) []*CommandManifestSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommandManifestSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommandManifestSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+)
+
+// CommandManifestSnapshotDelete is the builder for deleting a CommandManifestSnapshot entity.
+type CommandManifestSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommandManifestSnapshotMutation
+}
+
+// Where appends a list predicates to the CommandManifestSnapshotDelete builder.
+func (_d *CommandManifestSnapshotDelete) Where(ps ...predicate.CommandManifestSnapshot) *CommandManifestSnapshotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CommandManifestSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CommandManifestSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CommandManifestSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commandmanifestsnapshot.Table, sqlgraph.NewFieldSpec(commandmanifestsnapshot.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CommandManifestSnapshotDeleteOne is the builder for deleting a single CommandManifestSnapshot entity.
+type CommandManifestSnapshotDeleteOne struct {
+	_d *CommandManifestSnapshotDelete
+}
+
+// Where appends a list predicates to the CommandManifestSnapshotDelete builder.
+func (_d *CommandManifestSnapshotDeleteOne) Where(ps ...predicate.CommandManifestSnapshot) *CommandManifestSnapshotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CommandManifestSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commandmanifestsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CommandManifestSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neopg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+)
+
+// CommandManifestSnapshotUpdate is the builder for updating CommandManifestSnapshot entities.
+type CommandManifestSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommandManifestSnapshotMutation
+}
+
+// Where appends a list predicates to the CommandManifestSnapshotUpdate builder.
+func (_u *CommandManifestSnapshotUpdate) Where(ps ...predicate.CommandManifestSnapshot) *CommandManifestSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the CommandManifestSnapshotMutation object of the builder.
+func (_u *CommandManifestSnapshotUpdate) Mutation() *CommandManifestSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CommandManifestSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommandManifestSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CommandManifestSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommandManifestSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CommandManifestSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(commandmanifestsnapshot.Table, commandmanifestsnapshot.Columns, sqlgraph.NewFieldSpec(commandmanifestsnapshot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(commandmanifestsnapshot.FieldCreatedBy, field.TypeString)
+	}
+	if _u.mutation.RolledBackFromCleared() {
+		_spec.ClearField(commandmanifestsnapshot.FieldRolledBackFrom, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commandmanifestsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CommandManifestSnapshotUpdateOne is the builder for updating a single CommandManifestSnapshot entity.
+type CommandManifestSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommandManifestSnapshotMutation
+}
+
+// Mutation returns the CommandManifestSnapshotMutation object of the builder.
+func (_u *CommandManifestSnapshotUpdateOne) Mutation() *CommandManifestSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CommandManifestSnapshotUpdate builder.
+func (_u *CommandManifestSnapshotUpdateOne) Where(ps ...predicate.CommandManifestSnapshot) *CommandManifestSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommandManifestSnapshotUpdateOne) Select(field string, fields ...string) *CommandManifestSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CommandManifestSnapshot entity.
+func (_u *CommandManifestSnapshotUpdateOne) Save(ctx context.Context) (*CommandManifestSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommandManifestSnapshotUpdateOne) SaveX(ctx context.Context) *CommandManifestSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CommandManifestSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommandManifestSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *CommandManifestSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *CommandManifestSnapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(commandmanifestsnapshot.Table, commandmanifestsnapshot.Columns, sqlgraph.NewFieldSpec(commandmanifestsnapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`neopg: missing "CommandManifestSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commandmanifestsnapshot.FieldID)
+		for _, f := range fields {
+			if !commandmanifestsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("neopg: invalid field %q for query", f)}
+			}
+			if f != commandmanifestsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(commandmanifestsnapshot.FieldCreatedBy, field.TypeString)
+	}
+	if _u.mutation.RolledBackFromCleared() {
+		_spec.ClearField(commandmanifestsnapshot.FieldRolledBackFrom, field.TypeInt)
+	}
+	_node = &CommandManifestSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commandmanifestsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,18 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandlog"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifest"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/dailyrequests"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/hourlyrequests"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/requestsranking"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 	"reflect"
 	"sync"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandlog"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/dailyrequests"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/hourlyrequests"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/requestsranking"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,12 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			commandlog.Table:      commandlog.ValidColumn,
-			commandmanifest.Table: commandmanifest.ValidColumn,
-			dailyrequests.Table:   dailyrequests.ValidColumn,
-			hourlyrequests.Table:  hourlyrequests.ValidColumn,
-			requestsranking.Table: requestsranking.ValidColumn,
-			user.Table:            user.ValidColumn,
+			botloginhistory.Table:         botloginhistory.ValidColumn,
+			commandlog.Table:              commandlog.ValidColumn,
+			commandmanifest.Table:         commandmanifest.ValidColumn,
+			commandmanifestsnapshot.Table: commandmanifestsnapshot.ValidColumn,
+			dailyrequests.Table:           dailyrequests.ValidColumn,
+			hourlyrequests.Table:          hourlyrequests.ValidColumn,
+			requestsranking.Table:         requestsranking.ValidColumn,
+			user.Table:                    user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -5,9 +5,22 @@ package hook
 import (
 	"context"
 	"fmt"
+
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg"
 )
 
+// The BotLoginHistoryFunc type is an adapter to allow the use of ordinary
+// function as BotLoginHistory mutator.
+type BotLoginHistoryFunc func(context.Context, *neopg.BotLoginHistoryMutation) (neopg.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BotLoginHistoryFunc) Mutate(ctx context.Context, m neopg.Mutation) (neopg.Value, error) {
+	if mv, ok := m.(*neopg.BotLoginHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *neopg.BotLoginHistoryMutation", m)
+}
+
 // The CommandLogFunc type is an adapter to allow the use of ordinary
 // function as CommandLog mutator.
 type CommandLogFunc func(context.Context, *neopg.CommandLogMutation) (neopg.Value, error)
@@ -32,6 +45,18 @@ func (f CommandManifestFunc) Mutate(ctx context.Context, m neopg.Mutation) (neop
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *neopg.CommandManifestMutation", m)
 }
 
+// The CommandManifestSnapshotFunc type is an adapter to allow the use of ordinary
+// function as CommandManifestSnapshot mutator.
+type CommandManifestSnapshotFunc func(context.Context, *neopg.CommandManifestSnapshotMutation) (neopg.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommandManifestSnapshotFunc) Mutate(ctx context.Context, m neopg.Mutation) (neopg.Value, error) {
+	if mv, ok := m.(*neopg.CommandManifestSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *neopg.CommandManifestSnapshotMutation", m)
+}
+
 // The DailyRequestsFunc type is an adapter to allow the use of ordinary
 // function as DailyRequests mutator.
 type DailyRequestsFunc func(context.Context, *neopg.DailyRequestsMutation) (neopg.Value, error)
@@ -9,6 +9,28 @@ import (
 )
 
 var (
+	// BotLoginHistoriesColumns holds the columns for the "bot_login_histories" table.
+	BotLoginHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "bot_id", Type: field.TypeInt},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64, Default: ""},
+		{Name: "location", Type: field.TypeString, Nullable: true, Size: 256, Default: ""},
+		{Name: "source", Type: field.TypeString, Size: 32},
+		{Name: "logged_in_at", Type: field.TypeTime},
+	}
+	// BotLoginHistoriesTable holds the schema information for the "bot_login_histories" table.
+	BotLoginHistoriesTable = &schema.Table{
+		Name:       "bot_login_histories",
+		Columns:    BotLoginHistoriesColumns,
+		PrimaryKey: []*schema.Column{BotLoginHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "botloginhistory_bot_id_logged_in_at",
+				Unique:  false,
+				Columns: []*schema.Column{BotLoginHistoriesColumns[1], BotLoginHistoriesColumns[5]},
+			},
+		},
+	}
 	// CommandLogsColumns holds the columns for the "command_logs" table.
 	CommandLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -80,6 +102,24 @@ var (
 			},
 		},
 	}
+	// CommandManifestSnapshotsColumns holds the columns for the "command_manifest_snapshots" table.
+	CommandManifestSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt, Unique: true},
+		{Name: "etag", Type: field.TypeString, Size: 64},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "entry_count", Type: field.TypeInt, Default: 0},
+		{Name: "action", Type: field.TypeString, Size: 32},
+		{Name: "created_by", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "rolled_back_from", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CommandManifestSnapshotsTable holds the schema information for the "command_manifest_snapshots" table.
+	CommandManifestSnapshotsTable = &schema.Table{
+		Name:       "command_manifest_snapshots",
+		Columns:    CommandManifestSnapshotsColumns,
+		PrimaryKey: []*schema.Column{CommandManifestSnapshotsColumns[0]},
+	}
 	// DailyRequestsColumns holds the columns for the "daily_requests" table.
 	DailyRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -125,6 +165,13 @@ var (
 		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Size: 64, Default: ""},
 		{Name: "last_login_location", Type: field.TypeString, Nullable: true, Size: 256, Default: ""},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 64, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "revoked", "suspended"}, Default: "active"},
+		{Name: "status_reason", Type: field.TypeString, Nullable: true, Size: 512, Default: ""},
+		{Name: "status_changed_by", Type: field.TypeString, Nullable: true, Size: 64, Default: ""},
+		{Name: "status_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "credential_rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UserTable holds the schema information for the "user" table.
 	UserTable = &schema.Table{
@@ -134,15 +181,17 @@ var (
 		Indexes: []*schema.Index{
 			{
 				Name:    "user_owner_user_id",
-				Unique:  true,
+				Unique:  false,
 				Columns: []*schema.Column{UserColumns[1]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BotLoginHistoriesTable,
 		CommandLogsTable,
 		CommandManifestsTable,
+		CommandManifestSnapshotsTable,
 		DailyRequestsTable,
 		HourlyRequestsTable,
 		RequestsRankingTable,
@@ -151,12 +200,18 @@ var (
 )
 
 func init() {
+	BotLoginHistoriesTable.Annotation = &entsql.Annotation{
+		Table: "bot_login_histories",
+	}
 	CommandLogsTable.Annotation = &entsql.Annotation{
 		Table: "command_logs",
 	}
 	CommandManifestsTable.Annotation = &entsql.Annotation{
 		Table: "command_manifests",
 	}
+	CommandManifestSnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "command_manifest_snapshots",
+	}
 	DailyRequestsTable.Annotation = &entsql.Annotation{
 		Table: "daily_requests",
 	}
@@ -6,18 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/dailyrequests"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/hourlyrequests"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/requestsranking"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
-	"sync"
-	"time"
-
-	"entgo.io/ent"
-	"entgo.io/ent/dialect/sql"
 )
 
 const (
@@ -29,43 +31,45 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCommandLog      = "CommandLog"
-	TypeCommandManifest = "CommandManifest"
-	TypeDailyRequests   = "DailyRequests"
-	TypeHourlyRequests  = "HourlyRequests"
-	TypeRequestsRanking = "RequestsRanking"
-	TypeUser            = "User"
+	TypeBotLoginHistory         = "BotLoginHistory"
+	TypeCommandLog              = "CommandLog"
+	TypeCommandManifest         = "CommandManifest"
+	TypeCommandManifestSnapshot = "CommandManifestSnapshot"
+	TypeDailyRequests           = "DailyRequests"
+	TypeHourlyRequests          = "HourlyRequests"
+	TypeRequestsRanking         = "RequestsRanking"
+	TypeUser                    = "User"
 )
 
-// CommandLogMutation represents an operation that mutates the CommandLog nodes in the graph.
-type CommandLogMutation struct {
+// BotLoginHistoryMutation represents an operation that mutates the BotLoginHistory nodes in the graph.
+type BotLoginHistoryMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	platform      *string
-	pid           *string
-	gid           *string
-	uid           *string
-	command       *string
-	created_at    *time.Time
+	bot_id        *int
+	addbot_id     *int
+	ip            *string
+	location      *string
+	source        *string
+	logged_in_at  *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*CommandLog, error)
-	predicates    []predicate.CommandLog
+	oldValue      func(context.Context) (*BotLoginHistory, error)
+	predicates    []predicate.BotLoginHistory
 }
 
-var _ ent.Mutation = (*CommandLogMutation)(nil)
+var _ ent.Mutation = (*BotLoginHistoryMutation)(nil)
 
-// commandlogOption allows management of the mutation configuration using functional options.
-type commandlogOption func(*CommandLogMutation)
+// botloginhistoryOption allows management of the mutation configuration using functional options.
+type botloginhistoryOption func(*BotLoginHistoryMutation)
 
-// newCommandLogMutation creates new mutation for the CommandLog entity.
-func newCommandLogMutation(c config, op Op, opts ...commandlogOption) *CommandLogMutation {
-	m := &CommandLogMutation{
+// newBotLoginHistoryMutation creates new mutation for the BotLoginHistory entity.
+func newBotLoginHistoryMutation(c config, op Op, opts ...botloginhistoryOption) *BotLoginHistoryMutation {
+	m := &BotLoginHistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCommandLog,
+		typ:           TypeBotLoginHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -74,20 +78,20 @@ func newCommandLogMutation(c config, op Op, opts ...commandlogOption) *CommandLo
 	return m
 }
 
-// withCommandLogID sets the ID field of the mutation.
-func withCommandLogID(id int) commandlogOption {
-	return func(m *CommandLogMutation) {
+// withBotLoginHistoryID sets the ID field of the mutation.
+func withBotLoginHistoryID(id int) botloginhistoryOption {
+	return func(m *BotLoginHistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CommandLog
+			value *BotLoginHistory
 		)
-		m.oldValue = func(ctx context.Context) (*CommandLog, error) {
+		m.oldValue = func(ctx context.Context) (*BotLoginHistory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CommandLog.Get(ctx, id)
+					value, err = m.Client().BotLoginHistory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -96,10 +100,10 @@ func withCommandLogID(id int) commandlogOption {
 	}
 }
 
-// withCommandLog sets the old CommandLog of the mutation.
-func withCommandLog(node *CommandLog) commandlogOption {
-	return func(m *CommandLogMutation) {
-		m.oldValue = func(context.Context) (*CommandLog, error) {
+// withBotLoginHistory sets the old BotLoginHistory of the mutation.
+func withBotLoginHistory(node *BotLoginHistory) botloginhistoryOption {
+	return func(m *BotLoginHistoryMutation) {
+		m.oldValue = func(context.Context) (*BotLoginHistory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -108,7 +112,7 @@ func withCommandLog(node *CommandLog) commandlogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommandLogMutation) Client() *Client {
+func (m BotLoginHistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -116,7 +120,7 @@ func (m CommandLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommandLogMutation) Tx() (*Tx, error) {
+func (m BotLoginHistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("neopg: mutation is not running in a transaction")
 	}
@@ -127,7 +131,7 @@ func (m CommandLogMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommandLogMutation) ID() (id int, exists bool) {
+func (m *BotLoginHistoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -138,7 +142,7 @@ func (m *CommandLogMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommandLogMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BotLoginHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -147,237 +151,247 @@ func (m *CommandLogMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CommandLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BotLoginHistory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPlatform sets the "platform" field.
-func (m *CommandLogMutation) SetPlatform(s string) {
-	m.platform = &s
+// SetBotID sets the "bot_id" field.
+func (m *BotLoginHistoryMutation) SetBotID(i int) {
+	m.bot_id = &i
+	m.addbot_id = nil
 }
 
-// Platform returns the value of the "platform" field in the mutation.
-func (m *CommandLogMutation) Platform() (r string, exists bool) {
-	v := m.platform
+// BotID returns the value of the "bot_id" field in the mutation.
+func (m *BotLoginHistoryMutation) BotID() (r int, exists bool) {
+	v := m.bot_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlatform returns the old "platform" field's value of the CommandLog entity.
-// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// OldBotID returns the old "bot_id" field's value of the BotLoginHistory entity.
+// If the BotLoginHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandLogMutation) OldPlatform(ctx context.Context) (v string, err error) {
+func (m *BotLoginHistoryMutation) OldBotID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+		return v, errors.New("OldBotID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatform requires an ID field in the mutation")
+		return v, errors.New("OldBotID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+		return v, fmt.Errorf("querying old value for OldBotID: %w", err)
 	}
-	return oldValue.Platform, nil
-}
-
-// ResetPlatform resets all changes to the "platform" field.
-func (m *CommandLogMutation) ResetPlatform() {
-	m.platform = nil
+	return oldValue.BotID, nil
 }
 
-// SetPid sets the "pid" field.
-func (m *CommandLogMutation) SetPid(s string) {
-	m.pid = &s
+// AddBotID adds i to the "bot_id" field.
+func (m *BotLoginHistoryMutation) AddBotID(i int) {
+	if m.addbot_id != nil {
+		*m.addbot_id += i
+	} else {
+		m.addbot_id = &i
+	}
 }
 
-// Pid returns the value of the "pid" field in the mutation.
-func (m *CommandLogMutation) Pid() (r string, exists bool) {
-	v := m.pid
+// AddedBotID returns the value that was added to the "bot_id" field in this mutation.
+func (m *BotLoginHistoryMutation) AddedBotID() (r int, exists bool) {
+	v := m.addbot_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPid returns the old "pid" field's value of the CommandLog entity.
-// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandLogMutation) OldPid(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPid is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPid requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPid: %w", err)
-	}
-	return oldValue.Pid, nil
-}
-
-// ResetPid resets all changes to the "pid" field.
-func (m *CommandLogMutation) ResetPid() {
-	m.pid = nil
+// ResetBotID resets all changes to the "bot_id" field.
+func (m *BotLoginHistoryMutation) ResetBotID() {
+	m.bot_id = nil
+	m.addbot_id = nil
 }
 
-// SetGid sets the "gid" field.
-func (m *CommandLogMutation) SetGid(s string) {
-	m.gid = &s
+// SetIP sets the "ip" field.
+func (m *BotLoginHistoryMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// Gid returns the value of the "gid" field in the mutation.
-func (m *CommandLogMutation) Gid() (r string, exists bool) {
-	v := m.gid
+// IP returns the value of the "ip" field in the mutation.
+func (m *BotLoginHistoryMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGid returns the old "gid" field's value of the CommandLog entity.
-// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the BotLoginHistory entity.
+// If the BotLoginHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandLogMutation) OldGid(ctx context.Context) (v string, err error) {
+func (m *BotLoginHistoryMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGid is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGid requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGid: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.Gid, nil
+	return oldValue.IP, nil
 }
 
-// ResetGid resets all changes to the "gid" field.
-func (m *CommandLogMutation) ResetGid() {
-	m.gid = nil
+// ClearIP clears the value of the "ip" field.
+func (m *BotLoginHistoryMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[botloginhistory.FieldIP] = struct{}{}
 }
 
-// SetUID sets the "uid" field.
-func (m *CommandLogMutation) SetUID(s string) {
-	m.uid = &s
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *BotLoginHistoryMutation) IPCleared() bool {
+	_, ok := m.clearedFields[botloginhistory.FieldIP]
+	return ok
 }
 
-// UID returns the value of the "uid" field in the mutation.
-func (m *CommandLogMutation) UID() (r string, exists bool) {
-	v := m.uid
+// ResetIP resets all changes to the "ip" field.
+func (m *BotLoginHistoryMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, botloginhistory.FieldIP)
+}
+
+// SetLocation sets the "location" field.
+func (m *BotLoginHistoryMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *BotLoginHistoryMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUID returns the old "uid" field's value of the CommandLog entity.
-// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the BotLoginHistory entity.
+// If the BotLoginHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandLogMutation) OldUID(ctx context.Context) (v string, err error) {
+func (m *BotLoginHistoryMutation) OldLocation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUID requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.UID, nil
+	return oldValue.Location, nil
 }
 
-// ResetUID resets all changes to the "uid" field.
-func (m *CommandLogMutation) ResetUID() {
-	m.uid = nil
+// ClearLocation clears the value of the "location" field.
+func (m *BotLoginHistoryMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[botloginhistory.FieldLocation] = struct{}{}
 }
 
-// SetCommand sets the "command" field.
-func (m *CommandLogMutation) SetCommand(s string) {
-	m.command = &s
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *BotLoginHistoryMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[botloginhistory.FieldLocation]
+	return ok
 }
 
-// Command returns the value of the "command" field in the mutation.
-func (m *CommandLogMutation) Command() (r string, exists bool) {
-	v := m.command
+// ResetLocation resets all changes to the "location" field.
+func (m *BotLoginHistoryMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, botloginhistory.FieldLocation)
+}
+
+// SetSource sets the "source" field.
+func (m *BotLoginHistoryMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *BotLoginHistoryMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommand returns the old "command" field's value of the CommandLog entity.
-// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the BotLoginHistory entity.
+// If the BotLoginHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandLogMutation) OldCommand(ctx context.Context) (v string, err error) {
+func (m *BotLoginHistoryMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommand is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommand requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommand: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Command, nil
+	return oldValue.Source, nil
 }
 
-// ResetCommand resets all changes to the "command" field.
-func (m *CommandLogMutation) ResetCommand() {
-	m.command = nil
+// ResetSource resets all changes to the "source" field.
+func (m *BotLoginHistoryMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CommandLogMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLoggedInAt sets the "logged_in_at" field.
+func (m *BotLoginHistoryMutation) SetLoggedInAt(t time.Time) {
+	m.logged_in_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CommandLogMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LoggedInAt returns the value of the "logged_in_at" field in the mutation.
+func (m *BotLoginHistoryMutation) LoggedInAt() (r time.Time, exists bool) {
+	v := m.logged_in_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CommandLog entity.
-// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// OldLoggedInAt returns the old "logged_in_at" field's value of the BotLoginHistory entity.
+// If the BotLoginHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BotLoginHistoryMutation) OldLoggedInAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLoggedInAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLoggedInAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLoggedInAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LoggedInAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CommandLogMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetLoggedInAt resets all changes to the "logged_in_at" field.
+func (m *BotLoginHistoryMutation) ResetLoggedInAt() {
+	m.logged_in_at = nil
 }
 
-// Where appends a list predicates to the CommandLogMutation builder.
-func (m *CommandLogMutation) Where(ps ...predicate.CommandLog) {
+// Where appends a list predicates to the BotLoginHistoryMutation builder.
+func (m *BotLoginHistoryMutation) Where(ps ...predicate.BotLoginHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CommandLogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BotLoginHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommandLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CommandLog, len(ps))
+func (m *BotLoginHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BotLoginHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -385,42 +399,39 @@ func (m *CommandLogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CommandLogMutation) Op() Op {
+func (m *BotLoginHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CommandLogMutation) SetOp(op Op) {
+func (m *BotLoginHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CommandLog).
-func (m *CommandLogMutation) Type() string {
+// Type returns the node type of this mutation (BotLoginHistory).
+func (m *BotLoginHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CommandLogMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.platform != nil {
-		fields = append(fields, commandlog.FieldPlatform)
-	}
-	if m.pid != nil {
-		fields = append(fields, commandlog.FieldPid)
+func (m *BotLoginHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.bot_id != nil {
+		fields = append(fields, botloginhistory.FieldBotID)
 	}
-	if m.gid != nil {
-		fields = append(fields, commandlog.FieldGid)
+	if m.ip != nil {
+		fields = append(fields, botloginhistory.FieldIP)
 	}
-	if m.uid != nil {
-		fields = append(fields, commandlog.FieldUID)
+	if m.location != nil {
+		fields = append(fields, botloginhistory.FieldLocation)
 	}
-	if m.command != nil {
-		fields = append(fields, commandlog.FieldCommand)
+	if m.source != nil {
+		fields = append(fields, botloginhistory.FieldSource)
 	}
-	if m.created_at != nil {
-		fields = append(fields, commandlog.FieldCreatedAt)
+	if m.logged_in_at != nil {
+		fields = append(fields, botloginhistory.FieldLoggedInAt)
 	}
 	return fields
 }
@@ -428,20 +439,18 @@ func (m *CommandLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommandLogMutation) Field(name string) (ent.Value, bool) {
+func (m *BotLoginHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case commandlog.FieldPlatform:
-		return m.Platform()
-	case commandlog.FieldPid:
-		return m.Pid()
-	case commandlog.FieldGid:
-		return m.Gid()
-	case commandlog.FieldUID:
-		return m.UID()
-	case commandlog.FieldCommand:
-		return m.Command()
-	case commandlog.FieldCreatedAt:
-		return m.CreatedAt()
+	case botloginhistory.FieldBotID:
+		return m.BotID()
+	case botloginhistory.FieldIP:
+		return m.IP()
+	case botloginhistory.FieldLocation:
+		return m.Location()
+	case botloginhistory.FieldSource:
+		return m.Source()
+	case botloginhistory.FieldLoggedInAt:
+		return m.LoggedInAt()
 	}
 	return nil, false
 }
@@ -449,65 +458,679 @@ func (m *CommandLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommandLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BotLoginHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case commandlog.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case commandlog.FieldPid:
-		return m.OldPid(ctx)
-	case commandlog.FieldGid:
-		return m.OldGid(ctx)
-	case commandlog.FieldUID:
-		return m.OldUID(ctx)
-	case commandlog.FieldCommand:
-		return m.OldCommand(ctx)
-	case commandlog.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case botloginhistory.FieldBotID:
+		return m.OldBotID(ctx)
+	case botloginhistory.FieldIP:
+		return m.OldIP(ctx)
+	case botloginhistory.FieldLocation:
+		return m.OldLocation(ctx)
+	case botloginhistory.FieldSource:
+		return m.OldSource(ctx)
+	case botloginhistory.FieldLoggedInAt:
+		return m.OldLoggedInAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CommandLog field %s", name)
+	return nil, fmt.Errorf("unknown BotLoginHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommandLogMutation) SetField(name string, value ent.Value) error {
+func (m *BotLoginHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case commandlog.FieldPlatform:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatform(v)
-		return nil
-	case commandlog.FieldPid:
-		v, ok := value.(string)
+	case botloginhistory.FieldBotID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPid(v)
+		m.SetBotID(v)
 		return nil
-	case commandlog.FieldGid:
+	case botloginhistory.FieldIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGid(v)
+		m.SetIP(v)
 		return nil
-	case commandlog.FieldUID:
+	case botloginhistory.FieldLocation:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUID(v)
+		m.SetLocation(v)
 		return nil
-	case commandlog.FieldCommand:
+	case botloginhistory.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommand(v)
+		m.SetSource(v)
 		return nil
-	case commandlog.FieldCreatedAt:
+	case botloginhistory.FieldLoggedInAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoggedInAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BotLoginHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BotLoginHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addbot_id != nil {
+		fields = append(fields, botloginhistory.FieldBotID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BotLoginHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case botloginhistory.FieldBotID:
+		return m.AddedBotID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BotLoginHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case botloginhistory.FieldBotID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBotID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BotLoginHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BotLoginHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(botloginhistory.FieldIP) {
+		fields = append(fields, botloginhistory.FieldIP)
+	}
+	if m.FieldCleared(botloginhistory.FieldLocation) {
+		fields = append(fields, botloginhistory.FieldLocation)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BotLoginHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BotLoginHistoryMutation) ClearField(name string) error {
+	switch name {
+	case botloginhistory.FieldIP:
+		m.ClearIP()
+		return nil
+	case botloginhistory.FieldLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown BotLoginHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BotLoginHistoryMutation) ResetField(name string) error {
+	switch name {
+	case botloginhistory.FieldBotID:
+		m.ResetBotID()
+		return nil
+	case botloginhistory.FieldIP:
+		m.ResetIP()
+		return nil
+	case botloginhistory.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case botloginhistory.FieldSource:
+		m.ResetSource()
+		return nil
+	case botloginhistory.FieldLoggedInAt:
+		m.ResetLoggedInAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BotLoginHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BotLoginHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BotLoginHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BotLoginHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BotLoginHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BotLoginHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BotLoginHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BotLoginHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BotLoginHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BotLoginHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BotLoginHistory edge %s", name)
+}
+
+// CommandLogMutation represents an operation that mutates the CommandLog nodes in the graph.
+type CommandLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	platform      *string
+	pid           *string
+	gid           *string
+	uid           *string
+	command       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*CommandLog, error)
+	predicates    []predicate.CommandLog
+}
+
+var _ ent.Mutation = (*CommandLogMutation)(nil)
+
+// commandlogOption allows management of the mutation configuration using functional options.
+type commandlogOption func(*CommandLogMutation)
+
+// newCommandLogMutation creates new mutation for the CommandLog entity.
+func newCommandLogMutation(c config, op Op, opts ...commandlogOption) *CommandLogMutation {
+	m := &CommandLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCommandLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommandLogID sets the ID field of the mutation.
+func withCommandLogID(id int) commandlogOption {
+	return func(m *CommandLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CommandLog
+		)
+		m.oldValue = func(ctx context.Context) (*CommandLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CommandLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCommandLog sets the old CommandLog of the mutation.
+func withCommandLog(node *CommandLog) commandlogOption {
+	return func(m *CommandLogMutation) {
+		m.oldValue = func(context.Context) (*CommandLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommandLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommandLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("neopg: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommandLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommandLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CommandLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlatform sets the "platform" field.
+func (m *CommandLogMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *CommandLogMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the CommandLog entity.
+// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandLogMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *CommandLogMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetPid sets the "pid" field.
+func (m *CommandLogMutation) SetPid(s string) {
+	m.pid = &s
+}
+
+// Pid returns the value of the "pid" field in the mutation.
+func (m *CommandLogMutation) Pid() (r string, exists bool) {
+	v := m.pid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPid returns the old "pid" field's value of the CommandLog entity.
+// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandLogMutation) OldPid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPid: %w", err)
+	}
+	return oldValue.Pid, nil
+}
+
+// ResetPid resets all changes to the "pid" field.
+func (m *CommandLogMutation) ResetPid() {
+	m.pid = nil
+}
+
+// SetGid sets the "gid" field.
+func (m *CommandLogMutation) SetGid(s string) {
+	m.gid = &s
+}
+
+// Gid returns the value of the "gid" field in the mutation.
+func (m *CommandLogMutation) Gid() (r string, exists bool) {
+	v := m.gid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGid returns the old "gid" field's value of the CommandLog entity.
+// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandLogMutation) OldGid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGid: %w", err)
+	}
+	return oldValue.Gid, nil
+}
+
+// ResetGid resets all changes to the "gid" field.
+func (m *CommandLogMutation) ResetGid() {
+	m.gid = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *CommandLogMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *CommandLogMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the CommandLog entity.
+// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandLogMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *CommandLogMutation) ResetUID() {
+	m.uid = nil
+}
+
+// SetCommand sets the "command" field.
+func (m *CommandLogMutation) SetCommand(s string) {
+	m.command = &s
+}
+
+// Command returns the value of the "command" field in the mutation.
+func (m *CommandLogMutation) Command() (r string, exists bool) {
+	v := m.command
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommand returns the old "command" field's value of the CommandLog entity.
+// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandLogMutation) OldCommand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommand: %w", err)
+	}
+	return oldValue.Command, nil
+}
+
+// ResetCommand resets all changes to the "command" field.
+func (m *CommandLogMutation) ResetCommand() {
+	m.command = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommandLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommandLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CommandLog entity.
+// If the CommandLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommandLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the CommandLogMutation builder.
+func (m *CommandLogMutation) Where(ps ...predicate.CommandLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommandLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommandLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommandLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommandLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommandLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CommandLog).
+func (m *CommandLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommandLogMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.platform != nil {
+		fields = append(fields, commandlog.FieldPlatform)
+	}
+	if m.pid != nil {
+		fields = append(fields, commandlog.FieldPid)
+	}
+	if m.gid != nil {
+		fields = append(fields, commandlog.FieldGid)
+	}
+	if m.uid != nil {
+		fields = append(fields, commandlog.FieldUID)
+	}
+	if m.command != nil {
+		fields = append(fields, commandlog.FieldCommand)
+	}
+	if m.created_at != nil {
+		fields = append(fields, commandlog.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommandLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commandlog.FieldPlatform:
+		return m.Platform()
+	case commandlog.FieldPid:
+		return m.Pid()
+	case commandlog.FieldGid:
+		return m.Gid()
+	case commandlog.FieldUID:
+		return m.UID()
+	case commandlog.FieldCommand:
+		return m.Command()
+	case commandlog.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommandLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commandlog.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case commandlog.FieldPid:
+		return m.OldPid(ctx)
+	case commandlog.FieldGid:
+		return m.OldGid(ctx)
+	case commandlog.FieldUID:
+		return m.OldUID(ctx)
+	case commandlog.FieldCommand:
+		return m.OldCommand(ctx)
+	case commandlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CommandLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommandLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commandlog.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case commandlog.FieldPid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPid(v)
+		return nil
+	case commandlog.FieldGid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGid(v)
+		return nil
+	case commandlog.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case commandlog.FieldCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommand(v)
+		return nil
+	case commandlog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -515,156 +1138,845 @@ func (m *CommandLogMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CommandLog field %s", name)
+	return fmt.Errorf("unknown CommandLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommandLogMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommandLogMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommandLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CommandLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommandLogMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommandLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommandLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CommandLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommandLogMutation) ResetField(name string) error {
+	switch name {
+	case commandlog.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case commandlog.FieldPid:
+		m.ResetPid()
+		return nil
+	case commandlog.FieldGid:
+		m.ResetGid()
+		return nil
+	case commandlog.FieldUID:
+		m.ResetUID()
+		return nil
+	case commandlog.FieldCommand:
+		m.ResetCommand()
+		return nil
+	case commandlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CommandLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommandLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommandLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommandLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommandLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommandLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommandLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommandLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CommandLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommandLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CommandLog edge %s", name)
+}
+
+// CommandManifestMutation represents an operation that mutates the CommandManifest nodes in the graph.
+type CommandManifestMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *int
+	command_prefixes                *[]string
+	appendcommand_prefixes          []string
+	command_priority                *int
+	addcommand_priority             *int
+	command_mode                    *string
+	command_module                  *string
+	command_path                    *string
+	command_additional_params       *[]string
+	appendcommand_additional_params []string
+	clearedFields                   map[string]struct{}
+	done                            bool
+	oldValue                        func(context.Context) (*CommandManifest, error)
+	predicates                      []predicate.CommandManifest
+}
+
+var _ ent.Mutation = (*CommandManifestMutation)(nil)
+
+// commandmanifestOption allows management of the mutation configuration using functional options.
+type commandmanifestOption func(*CommandManifestMutation)
+
+// newCommandManifestMutation creates new mutation for the CommandManifest entity.
+func newCommandManifestMutation(c config, op Op, opts ...commandmanifestOption) *CommandManifestMutation {
+	m := &CommandManifestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCommandManifest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommandManifestID sets the ID field of the mutation.
+func withCommandManifestID(id int) commandmanifestOption {
+	return func(m *CommandManifestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CommandManifest
+		)
+		m.oldValue = func(ctx context.Context) (*CommandManifest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CommandManifest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCommandManifest sets the old CommandManifest of the mutation.
+func withCommandManifest(node *CommandManifest) commandmanifestOption {
+	return func(m *CommandManifestMutation) {
+		m.oldValue = func(context.Context) (*CommandManifest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommandManifestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommandManifestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("neopg: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommandManifestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommandManifestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CommandManifest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCommandPrefixes sets the "command_prefixes" field.
+func (m *CommandManifestMutation) SetCommandPrefixes(s []string) {
+	m.command_prefixes = &s
+	m.appendcommand_prefixes = nil
+}
+
+// CommandPrefixes returns the value of the "command_prefixes" field in the mutation.
+func (m *CommandManifestMutation) CommandPrefixes() (r []string, exists bool) {
+	v := m.command_prefixes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommandPrefixes returns the old "command_prefixes" field's value of the CommandManifest entity.
+// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestMutation) OldCommandPrefixes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommandPrefixes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommandPrefixes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommandPrefixes: %w", err)
+	}
+	return oldValue.CommandPrefixes, nil
+}
+
+// AppendCommandPrefixes adds s to the "command_prefixes" field.
+func (m *CommandManifestMutation) AppendCommandPrefixes(s []string) {
+	m.appendcommand_prefixes = append(m.appendcommand_prefixes, s...)
+}
+
+// AppendedCommandPrefixes returns the list of values that were appended to the "command_prefixes" field in this mutation.
+func (m *CommandManifestMutation) AppendedCommandPrefixes() ([]string, bool) {
+	if len(m.appendcommand_prefixes) == 0 {
+		return nil, false
+	}
+	return m.appendcommand_prefixes, true
+}
+
+// ResetCommandPrefixes resets all changes to the "command_prefixes" field.
+func (m *CommandManifestMutation) ResetCommandPrefixes() {
+	m.command_prefixes = nil
+	m.appendcommand_prefixes = nil
+}
+
+// SetCommandPriority sets the "command_priority" field.
+func (m *CommandManifestMutation) SetCommandPriority(i int) {
+	m.command_priority = &i
+	m.addcommand_priority = nil
+}
+
+// CommandPriority returns the value of the "command_priority" field in the mutation.
+func (m *CommandManifestMutation) CommandPriority() (r int, exists bool) {
+	v := m.command_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommandPriority returns the old "command_priority" field's value of the CommandManifest entity.
+// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestMutation) OldCommandPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommandPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommandPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommandPriority: %w", err)
+	}
+	return oldValue.CommandPriority, nil
+}
+
+// AddCommandPriority adds i to the "command_priority" field.
+func (m *CommandManifestMutation) AddCommandPriority(i int) {
+	if m.addcommand_priority != nil {
+		*m.addcommand_priority += i
+	} else {
+		m.addcommand_priority = &i
+	}
+}
+
+// AddedCommandPriority returns the value that was added to the "command_priority" field in this mutation.
+func (m *CommandManifestMutation) AddedCommandPriority() (r int, exists bool) {
+	v := m.addcommand_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommandPriority resets all changes to the "command_priority" field.
+func (m *CommandManifestMutation) ResetCommandPriority() {
+	m.command_priority = nil
+	m.addcommand_priority = nil
+}
+
+// SetCommandMode sets the "command_mode" field.
+func (m *CommandManifestMutation) SetCommandMode(s string) {
+	m.command_mode = &s
+}
+
+// CommandMode returns the value of the "command_mode" field in the mutation.
+func (m *CommandManifestMutation) CommandMode() (r string, exists bool) {
+	v := m.command_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommandMode returns the old "command_mode" field's value of the CommandManifest entity.
+// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestMutation) OldCommandMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommandMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommandMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommandMode: %w", err)
+	}
+	return oldValue.CommandMode, nil
+}
+
+// ResetCommandMode resets all changes to the "command_mode" field.
+func (m *CommandManifestMutation) ResetCommandMode() {
+	m.command_mode = nil
+}
+
+// SetCommandModule sets the "command_module" field.
+func (m *CommandManifestMutation) SetCommandModule(s string) {
+	m.command_module = &s
+}
+
+// CommandModule returns the value of the "command_module" field in the mutation.
+func (m *CommandManifestMutation) CommandModule() (r string, exists bool) {
+	v := m.command_module
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommandModule returns the old "command_module" field's value of the CommandManifest entity.
+// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestMutation) OldCommandModule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommandModule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommandModule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommandModule: %w", err)
+	}
+	return oldValue.CommandModule, nil
+}
+
+// ResetCommandModule resets all changes to the "command_module" field.
+func (m *CommandManifestMutation) ResetCommandModule() {
+	m.command_module = nil
+}
+
+// SetCommandPath sets the "command_path" field.
+func (m *CommandManifestMutation) SetCommandPath(s string) {
+	m.command_path = &s
+}
+
+// CommandPath returns the value of the "command_path" field in the mutation.
+func (m *CommandManifestMutation) CommandPath() (r string, exists bool) {
+	v := m.command_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommandPath returns the old "command_path" field's value of the CommandManifest entity.
+// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestMutation) OldCommandPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommandPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommandPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommandPath: %w", err)
+	}
+	return oldValue.CommandPath, nil
+}
+
+// ResetCommandPath resets all changes to the "command_path" field.
+func (m *CommandManifestMutation) ResetCommandPath() {
+	m.command_path = nil
+}
+
+// SetCommandAdditionalParams sets the "command_additional_params" field.
+func (m *CommandManifestMutation) SetCommandAdditionalParams(s []string) {
+	m.command_additional_params = &s
+	m.appendcommand_additional_params = nil
+}
+
+// CommandAdditionalParams returns the value of the "command_additional_params" field in the mutation.
+func (m *CommandManifestMutation) CommandAdditionalParams() (r []string, exists bool) {
+	v := m.command_additional_params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommandAdditionalParams returns the old "command_additional_params" field's value of the CommandManifest entity.
+// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestMutation) OldCommandAdditionalParams(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommandAdditionalParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommandAdditionalParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommandAdditionalParams: %w", err)
+	}
+	return oldValue.CommandAdditionalParams, nil
+}
+
+// AppendCommandAdditionalParams adds s to the "command_additional_params" field.
+func (m *CommandManifestMutation) AppendCommandAdditionalParams(s []string) {
+	m.appendcommand_additional_params = append(m.appendcommand_additional_params, s...)
+}
+
+// AppendedCommandAdditionalParams returns the list of values that were appended to the "command_additional_params" field in this mutation.
+func (m *CommandManifestMutation) AppendedCommandAdditionalParams() ([]string, bool) {
+	if len(m.appendcommand_additional_params) == 0 {
+		return nil, false
+	}
+	return m.appendcommand_additional_params, true
+}
+
+// ClearCommandAdditionalParams clears the value of the "command_additional_params" field.
+func (m *CommandManifestMutation) ClearCommandAdditionalParams() {
+	m.command_additional_params = nil
+	m.appendcommand_additional_params = nil
+	m.clearedFields[commandmanifest.FieldCommandAdditionalParams] = struct{}{}
+}
+
+// CommandAdditionalParamsCleared returns if the "command_additional_params" field was cleared in this mutation.
+func (m *CommandManifestMutation) CommandAdditionalParamsCleared() bool {
+	_, ok := m.clearedFields[commandmanifest.FieldCommandAdditionalParams]
+	return ok
+}
+
+// ResetCommandAdditionalParams resets all changes to the "command_additional_params" field.
+func (m *CommandManifestMutation) ResetCommandAdditionalParams() {
+	m.command_additional_params = nil
+	m.appendcommand_additional_params = nil
+	delete(m.clearedFields, commandmanifest.FieldCommandAdditionalParams)
+}
+
+// Where appends a list predicates to the CommandManifestMutation builder.
+func (m *CommandManifestMutation) Where(ps ...predicate.CommandManifest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommandManifestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommandManifestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommandManifest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommandManifestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommandManifestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CommandManifest).
+func (m *CommandManifestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommandManifestMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.command_prefixes != nil {
+		fields = append(fields, commandmanifest.FieldCommandPrefixes)
+	}
+	if m.command_priority != nil {
+		fields = append(fields, commandmanifest.FieldCommandPriority)
+	}
+	if m.command_mode != nil {
+		fields = append(fields, commandmanifest.FieldCommandMode)
+	}
+	if m.command_module != nil {
+		fields = append(fields, commandmanifest.FieldCommandModule)
+	}
+	if m.command_path != nil {
+		fields = append(fields, commandmanifest.FieldCommandPath)
+	}
+	if m.command_additional_params != nil {
+		fields = append(fields, commandmanifest.FieldCommandAdditionalParams)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommandManifestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commandmanifest.FieldCommandPrefixes:
+		return m.CommandPrefixes()
+	case commandmanifest.FieldCommandPriority:
+		return m.CommandPriority()
+	case commandmanifest.FieldCommandMode:
+		return m.CommandMode()
+	case commandmanifest.FieldCommandModule:
+		return m.CommandModule()
+	case commandmanifest.FieldCommandPath:
+		return m.CommandPath()
+	case commandmanifest.FieldCommandAdditionalParams:
+		return m.CommandAdditionalParams()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommandManifestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commandmanifest.FieldCommandPrefixes:
+		return m.OldCommandPrefixes(ctx)
+	case commandmanifest.FieldCommandPriority:
+		return m.OldCommandPriority(ctx)
+	case commandmanifest.FieldCommandMode:
+		return m.OldCommandMode(ctx)
+	case commandmanifest.FieldCommandModule:
+		return m.OldCommandModule(ctx)
+	case commandmanifest.FieldCommandPath:
+		return m.OldCommandPath(ctx)
+	case commandmanifest.FieldCommandAdditionalParams:
+		return m.OldCommandAdditionalParams(ctx)
+	}
+	return nil, fmt.Errorf("unknown CommandManifest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommandManifestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commandmanifest.FieldCommandPrefixes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommandPrefixes(v)
+		return nil
+	case commandmanifest.FieldCommandPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommandPriority(v)
+		return nil
+	case commandmanifest.FieldCommandMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommandMode(v)
+		return nil
+	case commandmanifest.FieldCommandModule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommandModule(v)
+		return nil
+	case commandmanifest.FieldCommandPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommandPath(v)
+		return nil
+	case commandmanifest.FieldCommandAdditionalParams:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommandAdditionalParams(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommandManifest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommandLogMutation) AddedFields() []string {
-	return nil
+func (m *CommandManifestMutation) AddedFields() []string {
+	var fields []string
+	if m.addcommand_priority != nil {
+		fields = append(fields, commandmanifest.FieldCommandPriority)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommandLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommandManifestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case commandmanifest.FieldCommandPriority:
+		return m.AddedCommandPriority()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommandLogMutation) AddField(name string, value ent.Value) error {
+func (m *CommandManifestMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case commandmanifest.FieldCommandPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommandPriority(v)
+		return nil
 	}
-	return fmt.Errorf("unknown CommandLog numeric field %s", name)
+	return fmt.Errorf("unknown CommandManifest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommandLogMutation) ClearedFields() []string {
-	return nil
+func (m *CommandManifestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(commandmanifest.FieldCommandAdditionalParams) {
+		fields = append(fields, commandmanifest.FieldCommandAdditionalParams)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommandLogMutation) FieldCleared(name string) bool {
+func (m *CommandManifestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommandLogMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown CommandLog nullable field %s", name)
+func (m *CommandManifestMutation) ClearField(name string) error {
+	switch name {
+	case commandmanifest.FieldCommandAdditionalParams:
+		m.ClearCommandAdditionalParams()
+		return nil
+	}
+	return fmt.Errorf("unknown CommandManifest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CommandLogMutation) ResetField(name string) error {
+func (m *CommandManifestMutation) ResetField(name string) error {
 	switch name {
-	case commandlog.FieldPlatform:
-		m.ResetPlatform()
+	case commandmanifest.FieldCommandPrefixes:
+		m.ResetCommandPrefixes()
 		return nil
-	case commandlog.FieldPid:
-		m.ResetPid()
+	case commandmanifest.FieldCommandPriority:
+		m.ResetCommandPriority()
 		return nil
-	case commandlog.FieldGid:
-		m.ResetGid()
+	case commandmanifest.FieldCommandMode:
+		m.ResetCommandMode()
 		return nil
-	case commandlog.FieldUID:
-		m.ResetUID()
+	case commandmanifest.FieldCommandModule:
+		m.ResetCommandModule()
 		return nil
-	case commandlog.FieldCommand:
-		m.ResetCommand()
+	case commandmanifest.FieldCommandPath:
+		m.ResetCommandPath()
 		return nil
-	case commandlog.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case commandmanifest.FieldCommandAdditionalParams:
+		m.ResetCommandAdditionalParams()
 		return nil
 	}
-	return fmt.Errorf("unknown CommandLog field %s", name)
+	return fmt.Errorf("unknown CommandManifest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommandLogMutation) AddedEdges() []string {
+func (m *CommandManifestMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommandLogMutation) AddedIDs(name string) []ent.Value {
+func (m *CommandManifestMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommandLogMutation) RemovedEdges() []string {
+func (m *CommandManifestMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommandLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommandManifestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommandLogMutation) ClearedEdges() []string {
+func (m *CommandManifestMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommandLogMutation) EdgeCleared(name string) bool {
+func (m *CommandManifestMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommandLogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CommandLog unique edge %s", name)
+func (m *CommandManifestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CommandManifest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommandLogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CommandLog edge %s", name)
+func (m *CommandManifestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CommandManifest edge %s", name)
 }
 
-// CommandManifestMutation represents an operation that mutates the CommandManifest nodes in the graph.
-type CommandManifestMutation struct {
+// CommandManifestSnapshotMutation represents an operation that mutates the CommandManifestSnapshot nodes in the graph.
+type CommandManifestSnapshotMutation struct {
 	config
-	op                              Op
-	typ                             string
-	id                              *int
-	command_prefixes                *[]string
-	appendcommand_prefixes          []string
-	command_priority                *int
-	addcommand_priority             *int
-	command_mode                    *string
-	command_module                  *string
-	command_path                    *string
-	command_additional_params       *[]string
-	appendcommand_additional_params []string
-	clearedFields                   map[string]struct{}
-	done                            bool
-	oldValue                        func(context.Context) (*CommandManifest, error)
-	predicates                      []predicate.CommandManifest
+	op                  Op
+	typ                 string
+	id                  *int
+	version             *int
+	addversion          *int
+	etag                *string
+	content             *string
+	entry_count         *int
+	addentry_count      *int
+	action              *string
+	created_by          *string
+	rolled_back_from    *int
+	addrolled_back_from *int
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*CommandManifestSnapshot, error)
+	predicates          []predicate.CommandManifestSnapshot
 }
 
-var _ ent.Mutation = (*CommandManifestMutation)(nil)
+var _ ent.Mutation = (*CommandManifestSnapshotMutation)(nil)
 
-// commandmanifestOption allows management of the mutation configuration using functional options.
-type commandmanifestOption func(*CommandManifestMutation)
+// commandmanifestsnapshotOption allows management of the mutation configuration using functional options.
+type commandmanifestsnapshotOption func(*CommandManifestSnapshotMutation)
 
-// newCommandManifestMutation creates new mutation for the CommandManifest entity.
-func newCommandManifestMutation(c config, op Op, opts ...commandmanifestOption) *CommandManifestMutation {
-	m := &CommandManifestMutation{
+// newCommandManifestSnapshotMutation creates new mutation for the CommandManifestSnapshot entity.
+func newCommandManifestSnapshotMutation(c config, op Op, opts ...commandmanifestsnapshotOption) *CommandManifestSnapshotMutation {
+	m := &CommandManifestSnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCommandManifest,
+		typ:           TypeCommandManifestSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -673,20 +1985,20 @@ func newCommandManifestMutation(c config, op Op, opts ...commandmanifestOption)
 	return m
 }
 
-// withCommandManifestID sets the ID field of the mutation.
-func withCommandManifestID(id int) commandmanifestOption {
-	return func(m *CommandManifestMutation) {
+// withCommandManifestSnapshotID sets the ID field of the mutation.
+func withCommandManifestSnapshotID(id int) commandmanifestsnapshotOption {
+	return func(m *CommandManifestSnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CommandManifest
+			value *CommandManifestSnapshot
 		)
-		m.oldValue = func(ctx context.Context) (*CommandManifest, error) {
+		m.oldValue = func(ctx context.Context) (*CommandManifestSnapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CommandManifest.Get(ctx, id)
+					value, err = m.Client().CommandManifestSnapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -695,10 +2007,10 @@ func withCommandManifestID(id int) commandmanifestOption {
 	}
 }
 
-// withCommandManifest sets the old CommandManifest of the mutation.
-func withCommandManifest(node *CommandManifest) commandmanifestOption {
-	return func(m *CommandManifestMutation) {
-		m.oldValue = func(context.Context) (*CommandManifest, error) {
+// withCommandManifestSnapshot sets the old CommandManifestSnapshot of the mutation.
+func withCommandManifestSnapshot(node *CommandManifestSnapshot) commandmanifestsnapshotOption {
+	return func(m *CommandManifestSnapshotMutation) {
+		m.oldValue = func(context.Context) (*CommandManifestSnapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -707,7 +2019,7 @@ func withCommandManifest(node *CommandManifest) commandmanifestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommandManifestMutation) Client() *Client {
+func (m CommandManifestSnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -715,7 +2027,7 @@ func (m CommandManifestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommandManifestMutation) Tx() (*Tx, error) {
+func (m CommandManifestSnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("neopg: mutation is not running in a transaction")
 	}
@@ -726,7 +2038,7 @@ func (m CommandManifestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommandManifestMutation) ID() (id int, exists bool) {
+func (m *CommandManifestSnapshotMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -737,7 +2049,7 @@ func (m *CommandManifestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommandManifestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CommandManifestSnapshotMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -746,301 +2058,396 @@ func (m *CommandManifestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CommandManifest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CommandManifestSnapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCommandPrefixes sets the "command_prefixes" field.
-func (m *CommandManifestMutation) SetCommandPrefixes(s []string) {
-	m.command_prefixes = &s
-	m.appendcommand_prefixes = nil
+// SetVersion sets the "version" field.
+func (m *CommandManifestSnapshotMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// CommandPrefixes returns the value of the "command_prefixes" field in the mutation.
-func (m *CommandManifestMutation) CommandPrefixes() (r []string, exists bool) {
-	v := m.command_prefixes
+// Version returns the value of the "version" field in the mutation.
+func (m *CommandManifestSnapshotMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommandPrefixes returns the old "command_prefixes" field's value of the CommandManifest entity.
-// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandManifestMutation) OldCommandPrefixes(ctx context.Context) (v []string, err error) {
+func (m *CommandManifestSnapshotMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommandPrefixes is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommandPrefixes requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommandPrefixes: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.CommandPrefixes, nil
+	return oldValue.Version, nil
 }
 
-// AppendCommandPrefixes adds s to the "command_prefixes" field.
-func (m *CommandManifestMutation) AppendCommandPrefixes(s []string) {
-	m.appendcommand_prefixes = append(m.appendcommand_prefixes, s...)
+// AddVersion adds i to the "version" field.
+func (m *CommandManifestSnapshotMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// AppendedCommandPrefixes returns the list of values that were appended to the "command_prefixes" field in this mutation.
-func (m *CommandManifestMutation) AppendedCommandPrefixes() ([]string, bool) {
-	if len(m.appendcommand_prefixes) == 0 {
-		return nil, false
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *CommandManifestSnapshotMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
 	}
-	return m.appendcommand_prefixes, true
+	return *v, true
 }
 
-// ResetCommandPrefixes resets all changes to the "command_prefixes" field.
-func (m *CommandManifestMutation) ResetCommandPrefixes() {
-	m.command_prefixes = nil
-	m.appendcommand_prefixes = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *CommandManifestSnapshotMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetCommandPriority sets the "command_priority" field.
-func (m *CommandManifestMutation) SetCommandPriority(i int) {
-	m.command_priority = &i
-	m.addcommand_priority = nil
+// SetEtag sets the "etag" field.
+func (m *CommandManifestSnapshotMutation) SetEtag(s string) {
+	m.etag = &s
 }
 
-// CommandPriority returns the value of the "command_priority" field in the mutation.
-func (m *CommandManifestMutation) CommandPriority() (r int, exists bool) {
-	v := m.command_priority
+// Etag returns the value of the "etag" field in the mutation.
+func (m *CommandManifestSnapshotMutation) Etag() (r string, exists bool) {
+	v := m.etag
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommandPriority returns the old "command_priority" field's value of the CommandManifest entity.
-// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// OldEtag returns the old "etag" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandManifestMutation) OldCommandPriority(ctx context.Context) (v int, err error) {
+func (m *CommandManifestSnapshotMutation) OldEtag(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommandPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommandPriority requires an ID field in the mutation")
+		return v, errors.New("OldEtag requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommandPriority: %w", err)
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
 	}
-	return oldValue.CommandPriority, nil
+	return oldValue.Etag, nil
 }
 
-// AddCommandPriority adds i to the "command_priority" field.
-func (m *CommandManifestMutation) AddCommandPriority(i int) {
-	if m.addcommand_priority != nil {
-		*m.addcommand_priority += i
-	} else {
-		m.addcommand_priority = &i
-	}
+// ResetEtag resets all changes to the "etag" field.
+func (m *CommandManifestSnapshotMutation) ResetEtag() {
+	m.etag = nil
 }
 
-// AddedCommandPriority returns the value that was added to the "command_priority" field in this mutation.
-func (m *CommandManifestMutation) AddedCommandPriority() (r int, exists bool) {
-	v := m.addcommand_priority
+// SetContent sets the "content" field.
+func (m *CommandManifestSnapshotMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *CommandManifestSnapshotMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCommandPriority resets all changes to the "command_priority" field.
-func (m *CommandManifestMutation) ResetCommandPriority() {
-	m.command_priority = nil
-	m.addcommand_priority = nil
+// OldContent returns the old "content" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestSnapshotMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// SetCommandMode sets the "command_mode" field.
-func (m *CommandManifestMutation) SetCommandMode(s string) {
-	m.command_mode = &s
+// ResetContent resets all changes to the "content" field.
+func (m *CommandManifestSnapshotMutation) ResetContent() {
+	m.content = nil
 }
 
-// CommandMode returns the value of the "command_mode" field in the mutation.
-func (m *CommandManifestMutation) CommandMode() (r string, exists bool) {
-	v := m.command_mode
+// SetEntryCount sets the "entry_count" field.
+func (m *CommandManifestSnapshotMutation) SetEntryCount(i int) {
+	m.entry_count = &i
+	m.addentry_count = nil
+}
+
+// EntryCount returns the value of the "entry_count" field in the mutation.
+func (m *CommandManifestSnapshotMutation) EntryCount() (r int, exists bool) {
+	v := m.entry_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommandMode returns the old "command_mode" field's value of the CommandManifest entity.
-// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// OldEntryCount returns the old "entry_count" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandManifestMutation) OldCommandMode(ctx context.Context) (v string, err error) {
+func (m *CommandManifestSnapshotMutation) OldEntryCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommandMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntryCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommandMode requires an ID field in the mutation")
+		return v, errors.New("OldEntryCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommandMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntryCount: %w", err)
 	}
-	return oldValue.CommandMode, nil
+	return oldValue.EntryCount, nil
 }
 
-// ResetCommandMode resets all changes to the "command_mode" field.
-func (m *CommandManifestMutation) ResetCommandMode() {
-	m.command_mode = nil
+// AddEntryCount adds i to the "entry_count" field.
+func (m *CommandManifestSnapshotMutation) AddEntryCount(i int) {
+	if m.addentry_count != nil {
+		*m.addentry_count += i
+	} else {
+		m.addentry_count = &i
+	}
 }
 
-// SetCommandModule sets the "command_module" field.
-func (m *CommandManifestMutation) SetCommandModule(s string) {
-	m.command_module = &s
+// AddedEntryCount returns the value that was added to the "entry_count" field in this mutation.
+func (m *CommandManifestSnapshotMutation) AddedEntryCount() (r int, exists bool) {
+	v := m.addentry_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntryCount resets all changes to the "entry_count" field.
+func (m *CommandManifestSnapshotMutation) ResetEntryCount() {
+	m.entry_count = nil
+	m.addentry_count = nil
+}
+
+// SetAction sets the "action" field.
+func (m *CommandManifestSnapshotMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// CommandModule returns the value of the "command_module" field in the mutation.
-func (m *CommandManifestMutation) CommandModule() (r string, exists bool) {
-	v := m.command_module
+// Action returns the value of the "action" field in the mutation.
+func (m *CommandManifestSnapshotMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommandModule returns the old "command_module" field's value of the CommandManifest entity.
-// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandManifestMutation) OldCommandModule(ctx context.Context) (v string, err error) {
+func (m *CommandManifestSnapshotMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommandModule is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommandModule requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommandModule: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.CommandModule, nil
+	return oldValue.Action, nil
 }
 
-// ResetCommandModule resets all changes to the "command_module" field.
-func (m *CommandManifestMutation) ResetCommandModule() {
-	m.command_module = nil
+// ResetAction resets all changes to the "action" field.
+func (m *CommandManifestSnapshotMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetCommandPath sets the "command_path" field.
-func (m *CommandManifestMutation) SetCommandPath(s string) {
-	m.command_path = &s
+// SetCreatedBy sets the "created_by" field.
+func (m *CommandManifestSnapshotMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// CommandPath returns the value of the "command_path" field in the mutation.
-func (m *CommandManifestMutation) CommandPath() (r string, exists bool) {
-	v := m.command_path
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *CommandManifestSnapshotMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommandPath returns the old "command_path" field's value of the CommandManifest entity.
-// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandManifestMutation) OldCommandPath(ctx context.Context) (v string, err error) {
+func (m *CommandManifestSnapshotMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommandPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommandPath requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommandPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.CommandPath, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetCommandPath resets all changes to the "command_path" field.
-func (m *CommandManifestMutation) ResetCommandPath() {
-	m.command_path = nil
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *CommandManifestSnapshotMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[commandmanifestsnapshot.FieldCreatedBy] = struct{}{}
 }
 
-// SetCommandAdditionalParams sets the "command_additional_params" field.
-func (m *CommandManifestMutation) SetCommandAdditionalParams(s []string) {
-	m.command_additional_params = &s
-	m.appendcommand_additional_params = nil
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *CommandManifestSnapshotMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[commandmanifestsnapshot.FieldCreatedBy]
+	return ok
 }
 
-// CommandAdditionalParams returns the value of the "command_additional_params" field in the mutation.
-func (m *CommandManifestMutation) CommandAdditionalParams() (r []string, exists bool) {
-	v := m.command_additional_params
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *CommandManifestSnapshotMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, commandmanifestsnapshot.FieldCreatedBy)
+}
+
+// SetRolledBackFrom sets the "rolled_back_from" field.
+func (m *CommandManifestSnapshotMutation) SetRolledBackFrom(i int) {
+	m.rolled_back_from = &i
+	m.addrolled_back_from = nil
+}
+
+// RolledBackFrom returns the value of the "rolled_back_from" field in the mutation.
+func (m *CommandManifestSnapshotMutation) RolledBackFrom() (r int, exists bool) {
+	v := m.rolled_back_from
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCommandAdditionalParams returns the old "command_additional_params" field's value of the CommandManifest entity.
-// If the CommandManifest object wasn't provided to the builder, the object is fetched from the database.
+// OldRolledBackFrom returns the old "rolled_back_from" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommandManifestMutation) OldCommandAdditionalParams(ctx context.Context) (v []string, err error) {
+func (m *CommandManifestSnapshotMutation) OldRolledBackFrom(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCommandAdditionalParams is only allowed on UpdateOne operations")
+		return v, errors.New("OldRolledBackFrom is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCommandAdditionalParams requires an ID field in the mutation")
+		return v, errors.New("OldRolledBackFrom requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCommandAdditionalParams: %w", err)
+		return v, fmt.Errorf("querying old value for OldRolledBackFrom: %w", err)
 	}
-	return oldValue.CommandAdditionalParams, nil
+	return oldValue.RolledBackFrom, nil
 }
 
-// AppendCommandAdditionalParams adds s to the "command_additional_params" field.
-func (m *CommandManifestMutation) AppendCommandAdditionalParams(s []string) {
-	m.appendcommand_additional_params = append(m.appendcommand_additional_params, s...)
+// AddRolledBackFrom adds i to the "rolled_back_from" field.
+func (m *CommandManifestSnapshotMutation) AddRolledBackFrom(i int) {
+	if m.addrolled_back_from != nil {
+		*m.addrolled_back_from += i
+	} else {
+		m.addrolled_back_from = &i
+	}
 }
 
-// AppendedCommandAdditionalParams returns the list of values that were appended to the "command_additional_params" field in this mutation.
-func (m *CommandManifestMutation) AppendedCommandAdditionalParams() ([]string, bool) {
-	if len(m.appendcommand_additional_params) == 0 {
-		return nil, false
+// AddedRolledBackFrom returns the value that was added to the "rolled_back_from" field in this mutation.
+func (m *CommandManifestSnapshotMutation) AddedRolledBackFrom() (r int, exists bool) {
+	v := m.addrolled_back_from
+	if v == nil {
+		return
 	}
-	return m.appendcommand_additional_params, true
+	return *v, true
 }
 
-// ClearCommandAdditionalParams clears the value of the "command_additional_params" field.
-func (m *CommandManifestMutation) ClearCommandAdditionalParams() {
-	m.command_additional_params = nil
-	m.appendcommand_additional_params = nil
-	m.clearedFields[commandmanifest.FieldCommandAdditionalParams] = struct{}{}
+// ClearRolledBackFrom clears the value of the "rolled_back_from" field.
+func (m *CommandManifestSnapshotMutation) ClearRolledBackFrom() {
+	m.rolled_back_from = nil
+	m.addrolled_back_from = nil
+	m.clearedFields[commandmanifestsnapshot.FieldRolledBackFrom] = struct{}{}
 }
 
-// CommandAdditionalParamsCleared returns if the "command_additional_params" field was cleared in this mutation.
-func (m *CommandManifestMutation) CommandAdditionalParamsCleared() bool {
-	_, ok := m.clearedFields[commandmanifest.FieldCommandAdditionalParams]
+// RolledBackFromCleared returns if the "rolled_back_from" field was cleared in this mutation.
+func (m *CommandManifestSnapshotMutation) RolledBackFromCleared() bool {
+	_, ok := m.clearedFields[commandmanifestsnapshot.FieldRolledBackFrom]
 	return ok
 }
 
-// ResetCommandAdditionalParams resets all changes to the "command_additional_params" field.
-func (m *CommandManifestMutation) ResetCommandAdditionalParams() {
-	m.command_additional_params = nil
-	m.appendcommand_additional_params = nil
-	delete(m.clearedFields, commandmanifest.FieldCommandAdditionalParams)
+// ResetRolledBackFrom resets all changes to the "rolled_back_from" field.
+func (m *CommandManifestSnapshotMutation) ResetRolledBackFrom() {
+	m.rolled_back_from = nil
+	m.addrolled_back_from = nil
+	delete(m.clearedFields, commandmanifestsnapshot.FieldRolledBackFrom)
 }
 
-// Where appends a list predicates to the CommandManifestMutation builder.
-func (m *CommandManifestMutation) Where(ps ...predicate.CommandManifest) {
+// SetCreatedAt sets the "created_at" field.
+func (m *CommandManifestSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommandManifestSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CommandManifestSnapshot entity.
+// If the CommandManifestSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandManifestSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommandManifestSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the CommandManifestSnapshotMutation builder.
+func (m *CommandManifestSnapshotMutation) Where(ps ...predicate.CommandManifestSnapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CommandManifestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CommandManifestSnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommandManifestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CommandManifest, len(ps))
+func (m *CommandManifestSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommandManifestSnapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1048,42 +2455,48 @@ func (m *CommandManifestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CommandManifestMutation) Op() Op {
+func (m *CommandManifestSnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CommandManifestMutation) SetOp(op Op) {
+func (m *CommandManifestSnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CommandManifest).
-func (m *CommandManifestMutation) Type() string {
+// Type returns the node type of this mutation (CommandManifestSnapshot).
+func (m *CommandManifestSnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CommandManifestMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.command_prefixes != nil {
-		fields = append(fields, commandmanifest.FieldCommandPrefixes)
+func (m *CommandManifestSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.version != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldVersion)
 	}
-	if m.command_priority != nil {
-		fields = append(fields, commandmanifest.FieldCommandPriority)
+	if m.etag != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldEtag)
 	}
-	if m.command_mode != nil {
-		fields = append(fields, commandmanifest.FieldCommandMode)
+	if m.content != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldContent)
 	}
-	if m.command_module != nil {
-		fields = append(fields, commandmanifest.FieldCommandModule)
+	if m.entry_count != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldEntryCount)
 	}
-	if m.command_path != nil {
-		fields = append(fields, commandmanifest.FieldCommandPath)
+	if m.action != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldAction)
 	}
-	if m.command_additional_params != nil {
-		fields = append(fields, commandmanifest.FieldCommandAdditionalParams)
+	if m.created_by != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldCreatedBy)
+	}
+	if m.rolled_back_from != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldRolledBackFrom)
+	}
+	if m.created_at != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1091,20 +2504,24 @@ func (m *CommandManifestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommandManifestMutation) Field(name string) (ent.Value, bool) {
+func (m *CommandManifestSnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case commandmanifest.FieldCommandPrefixes:
-		return m.CommandPrefixes()
-	case commandmanifest.FieldCommandPriority:
-		return m.CommandPriority()
-	case commandmanifest.FieldCommandMode:
-		return m.CommandMode()
-	case commandmanifest.FieldCommandModule:
-		return m.CommandModule()
-	case commandmanifest.FieldCommandPath:
-		return m.CommandPath()
-	case commandmanifest.FieldCommandAdditionalParams:
-		return m.CommandAdditionalParams()
+	case commandmanifestsnapshot.FieldVersion:
+		return m.Version()
+	case commandmanifestsnapshot.FieldEtag:
+		return m.Etag()
+	case commandmanifestsnapshot.FieldContent:
+		return m.Content()
+	case commandmanifestsnapshot.FieldEntryCount:
+		return m.EntryCount()
+	case commandmanifestsnapshot.FieldAction:
+		return m.Action()
+	case commandmanifestsnapshot.FieldCreatedBy:
+		return m.CreatedBy()
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		return m.RolledBackFrom()
+	case commandmanifestsnapshot.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1112,81 +2529,105 @@ func (m *CommandManifestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommandManifestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommandManifestSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case commandmanifest.FieldCommandPrefixes:
-		return m.OldCommandPrefixes(ctx)
-	case commandmanifest.FieldCommandPriority:
-		return m.OldCommandPriority(ctx)
-	case commandmanifest.FieldCommandMode:
-		return m.OldCommandMode(ctx)
-	case commandmanifest.FieldCommandModule:
-		return m.OldCommandModule(ctx)
-	case commandmanifest.FieldCommandPath:
-		return m.OldCommandPath(ctx)
-	case commandmanifest.FieldCommandAdditionalParams:
-		return m.OldCommandAdditionalParams(ctx)
+	case commandmanifestsnapshot.FieldVersion:
+		return m.OldVersion(ctx)
+	case commandmanifestsnapshot.FieldEtag:
+		return m.OldEtag(ctx)
+	case commandmanifestsnapshot.FieldContent:
+		return m.OldContent(ctx)
+	case commandmanifestsnapshot.FieldEntryCount:
+		return m.OldEntryCount(ctx)
+	case commandmanifestsnapshot.FieldAction:
+		return m.OldAction(ctx)
+	case commandmanifestsnapshot.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		return m.OldRolledBackFrom(ctx)
+	case commandmanifestsnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CommandManifest field %s", name)
+	return nil, fmt.Errorf("unknown CommandManifestSnapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommandManifestMutation) SetField(name string, value ent.Value) error {
+func (m *CommandManifestSnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case commandmanifest.FieldCommandPrefixes:
-		v, ok := value.([]string)
+	case commandmanifestsnapshot.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommandPrefixes(v)
+		m.SetVersion(v)
 		return nil
-	case commandmanifest.FieldCommandPriority:
-		v, ok := value.(int)
+	case commandmanifestsnapshot.FieldEtag:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommandPriority(v)
+		m.SetEtag(v)
 		return nil
-	case commandmanifest.FieldCommandMode:
+	case commandmanifestsnapshot.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommandMode(v)
+		m.SetContent(v)
 		return nil
-	case commandmanifest.FieldCommandModule:
+	case commandmanifestsnapshot.FieldEntryCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryCount(v)
+		return nil
+	case commandmanifestsnapshot.FieldAction:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommandModule(v)
+		m.SetAction(v)
 		return nil
-	case commandmanifest.FieldCommandPath:
+	case commandmanifestsnapshot.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommandPath(v)
+		m.SetCreatedBy(v)
 		return nil
-	case commandmanifest.FieldCommandAdditionalParams:
-		v, ok := value.([]string)
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCommandAdditionalParams(v)
+		m.SetRolledBackFrom(v)
+		return nil
+	case commandmanifestsnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CommandManifest field %s", name)
+	return fmt.Errorf("unknown CommandManifestSnapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommandManifestMutation) AddedFields() []string {
+func (m *CommandManifestSnapshotMutation) AddedFields() []string {
 	var fields []string
-	if m.addcommand_priority != nil {
-		fields = append(fields, commandmanifest.FieldCommandPriority)
+	if m.addversion != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldVersion)
+	}
+	if m.addentry_count != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldEntryCount)
+	}
+	if m.addrolled_back_from != nil {
+		fields = append(fields, commandmanifestsnapshot.FieldRolledBackFrom)
 	}
 	return fields
 }
@@ -1194,10 +2635,14 @@ func (m *CommandManifestMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommandManifestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommandManifestSnapshotMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case commandmanifest.FieldCommandPriority:
-		return m.AddedCommandPriority()
+	case commandmanifestsnapshot.FieldVersion:
+		return m.AddedVersion()
+	case commandmanifestsnapshot.FieldEntryCount:
+		return m.AddedEntryCount()
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		return m.AddedRolledBackFrom()
 	}
 	return nil, false
 }
@@ -1205,119 +2650,145 @@ func (m *CommandManifestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommandManifestMutation) AddField(name string, value ent.Value) error {
+func (m *CommandManifestSnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case commandmanifest.FieldCommandPriority:
+	case commandmanifestsnapshot.FieldVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCommandPriority(v)
+		m.AddVersion(v)
+		return nil
+	case commandmanifestsnapshot.FieldEntryCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntryCount(v)
+		return nil
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRolledBackFrom(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CommandManifest numeric field %s", name)
+	return fmt.Errorf("unknown CommandManifestSnapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommandManifestMutation) ClearedFields() []string {
+func (m *CommandManifestSnapshotMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(commandmanifest.FieldCommandAdditionalParams) {
-		fields = append(fields, commandmanifest.FieldCommandAdditionalParams)
+	if m.FieldCleared(commandmanifestsnapshot.FieldCreatedBy) {
+		fields = append(fields, commandmanifestsnapshot.FieldCreatedBy)
+	}
+	if m.FieldCleared(commandmanifestsnapshot.FieldRolledBackFrom) {
+		fields = append(fields, commandmanifestsnapshot.FieldRolledBackFrom)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommandManifestMutation) FieldCleared(name string) bool {
+func (m *CommandManifestSnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommandManifestMutation) ClearField(name string) error {
+func (m *CommandManifestSnapshotMutation) ClearField(name string) error {
 	switch name {
-	case commandmanifest.FieldCommandAdditionalParams:
-		m.ClearCommandAdditionalParams()
+	case commandmanifestsnapshot.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		m.ClearRolledBackFrom()
 		return nil
 	}
-	return fmt.Errorf("unknown CommandManifest nullable field %s", name)
+	return fmt.Errorf("unknown CommandManifestSnapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CommandManifestMutation) ResetField(name string) error {
+func (m *CommandManifestSnapshotMutation) ResetField(name string) error {
 	switch name {
-	case commandmanifest.FieldCommandPrefixes:
-		m.ResetCommandPrefixes()
+	case commandmanifestsnapshot.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case commandmanifest.FieldCommandPriority:
-		m.ResetCommandPriority()
+	case commandmanifestsnapshot.FieldEtag:
+		m.ResetEtag()
 		return nil
-	case commandmanifest.FieldCommandMode:
-		m.ResetCommandMode()
+	case commandmanifestsnapshot.FieldContent:
+		m.ResetContent()
 		return nil
-	case commandmanifest.FieldCommandModule:
-		m.ResetCommandModule()
+	case commandmanifestsnapshot.FieldEntryCount:
+		m.ResetEntryCount()
 		return nil
-	case commandmanifest.FieldCommandPath:
-		m.ResetCommandPath()
+	case commandmanifestsnapshot.FieldAction:
+		m.ResetAction()
 		return nil
-	case commandmanifest.FieldCommandAdditionalParams:
-		m.ResetCommandAdditionalParams()
+	case commandmanifestsnapshot.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case commandmanifestsnapshot.FieldRolledBackFrom:
+		m.ResetRolledBackFrom()
+		return nil
+	case commandmanifestsnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CommandManifest field %s", name)
+	return fmt.Errorf("unknown CommandManifestSnapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommandManifestMutation) AddedEdges() []string {
+func (m *CommandManifestSnapshotMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommandManifestMutation) AddedIDs(name string) []ent.Value {
+func (m *CommandManifestSnapshotMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommandManifestMutation) RemovedEdges() []string {
+func (m *CommandManifestSnapshotMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommandManifestMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommandManifestSnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommandManifestMutation) ClearedEdges() []string {
+func (m *CommandManifestSnapshotMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommandManifestMutation) EdgeCleared(name string) bool {
+func (m *CommandManifestSnapshotMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommandManifestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CommandManifest unique edge %s", name)
+func (m *CommandManifestSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CommandManifestSnapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommandManifestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CommandManifest edge %s", name)
+func (m *CommandManifestSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CommandManifestSnapshot edge %s", name)
 }
 
 // DailyRequestsMutation represents an operation that mutates the DailyRequests nodes in the graph.
@@ -2625,23 +4096,30 @@ func (m *RequestsRankingMutation) ResetEdge(name string) error {
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *int
-	owner_user_id       *int64
-	addowner_user_id    *int64
-	bot_id              *int
-	addbot_id           *int
-	credential          *string
-	last_login_ip       *string
-	last_login_location *string
-	last_login_at       *time.Time
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
+type UserMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	owner_user_id         *int64
+	addowner_user_id      *int64
+	bot_id                *int
+	addbot_id             *int
+	credential            *string
+	last_login_ip         *string
+	last_login_location   *string
+	last_login_at         *time.Time
+	name                  *string
+	status                *user.Status
+	status_reason         *string
+	status_changed_by     *string
+	status_changed_at     *time.Time
+	credential_rotated_at *time.Time
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*User, error)
+	predicates            []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -3050,6 +4528,336 @@ func (m *UserMutation) ResetLastLoginAt() {
 	delete(m.clearedFields, user.FieldLastLoginAt)
 }
 
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *UserMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[user.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *UserMutation) NameCleared() bool {
+	_, ok := m.clearedFields[user.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, user.FieldName)
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(u user.Status) {
+	m.status = &u
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r user.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (m *UserMutation) SetStatusReason(s string) {
+	m.status_reason = &s
+}
+
+// StatusReason returns the value of the "status_reason" field in the mutation.
+func (m *UserMutation) StatusReason() (r string, exists bool) {
+	v := m.status_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusReason returns the old "status_reason" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusReason: %w", err)
+	}
+	return oldValue.StatusReason, nil
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (m *UserMutation) ClearStatusReason() {
+	m.status_reason = nil
+	m.clearedFields[user.FieldStatusReason] = struct{}{}
+}
+
+// StatusReasonCleared returns if the "status_reason" field was cleared in this mutation.
+func (m *UserMutation) StatusReasonCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatusReason]
+	return ok
+}
+
+// ResetStatusReason resets all changes to the "status_reason" field.
+func (m *UserMutation) ResetStatusReason() {
+	m.status_reason = nil
+	delete(m.clearedFields, user.FieldStatusReason)
+}
+
+// SetStatusChangedBy sets the "status_changed_by" field.
+func (m *UserMutation) SetStatusChangedBy(s string) {
+	m.status_changed_by = &s
+}
+
+// StatusChangedBy returns the value of the "status_changed_by" field in the mutation.
+func (m *UserMutation) StatusChangedBy() (r string, exists bool) {
+	v := m.status_changed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusChangedBy returns the old "status_changed_by" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusChangedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusChangedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusChangedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusChangedBy: %w", err)
+	}
+	return oldValue.StatusChangedBy, nil
+}
+
+// ClearStatusChangedBy clears the value of the "status_changed_by" field.
+func (m *UserMutation) ClearStatusChangedBy() {
+	m.status_changed_by = nil
+	m.clearedFields[user.FieldStatusChangedBy] = struct{}{}
+}
+
+// StatusChangedByCleared returns if the "status_changed_by" field was cleared in this mutation.
+func (m *UserMutation) StatusChangedByCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatusChangedBy]
+	return ok
+}
+
+// ResetStatusChangedBy resets all changes to the "status_changed_by" field.
+func (m *UserMutation) ResetStatusChangedBy() {
+	m.status_changed_by = nil
+	delete(m.clearedFields, user.FieldStatusChangedBy)
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (m *UserMutation) SetStatusChangedAt(t time.Time) {
+	m.status_changed_at = &t
+}
+
+// StatusChangedAt returns the value of the "status_changed_at" field in the mutation.
+func (m *UserMutation) StatusChangedAt() (r time.Time, exists bool) {
+	v := m.status_changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusChangedAt returns the old "status_changed_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusChangedAt: %w", err)
+	}
+	return oldValue.StatusChangedAt, nil
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (m *UserMutation) ClearStatusChangedAt() {
+	m.status_changed_at = nil
+	m.clearedFields[user.FieldStatusChangedAt] = struct{}{}
+}
+
+// StatusChangedAtCleared returns if the "status_changed_at" field was cleared in this mutation.
+func (m *UserMutation) StatusChangedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatusChangedAt]
+	return ok
+}
+
+// ResetStatusChangedAt resets all changes to the "status_changed_at" field.
+func (m *UserMutation) ResetStatusChangedAt() {
+	m.status_changed_at = nil
+	delete(m.clearedFields, user.FieldStatusChangedAt)
+}
+
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (m *UserMutation) SetCredentialRotatedAt(t time.Time) {
+	m.credential_rotated_at = &t
+}
+
+// CredentialRotatedAt returns the value of the "credential_rotated_at" field in the mutation.
+func (m *UserMutation) CredentialRotatedAt() (r time.Time, exists bool) {
+	v := m.credential_rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialRotatedAt returns the old "credential_rotated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCredentialRotatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialRotatedAt: %w", err)
+	}
+	return oldValue.CredentialRotatedAt, nil
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (m *UserMutation) ClearCredentialRotatedAt() {
+	m.credential_rotated_at = nil
+	m.clearedFields[user.FieldCredentialRotatedAt] = struct{}{}
+}
+
+// CredentialRotatedAtCleared returns if the "credential_rotated_at" field was cleared in this mutation.
+func (m *UserMutation) CredentialRotatedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldCredentialRotatedAt]
+	return ok
+}
+
+// ResetCredentialRotatedAt resets all changes to the "credential_rotated_at" field.
+func (m *UserMutation) ResetCredentialRotatedAt() {
+	m.credential_rotated_at = nil
+	delete(m.clearedFields, user.FieldCredentialRotatedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *UserMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[user.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *UserMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, user.FieldCreatedAt)
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -3084,7 +4892,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 13)
 	if m.owner_user_id != nil {
 		fields = append(fields, user.FieldOwnerUserID)
 	}
@@ -3103,6 +4911,27 @@ func (m *UserMutation) Fields() []string {
 	if m.last_login_at != nil {
 		fields = append(fields, user.FieldLastLoginAt)
 	}
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.status_reason != nil {
+		fields = append(fields, user.FieldStatusReason)
+	}
+	if m.status_changed_by != nil {
+		fields = append(fields, user.FieldStatusChangedBy)
+	}
+	if m.status_changed_at != nil {
+		fields = append(fields, user.FieldStatusChangedAt)
+	}
+	if m.credential_rotated_at != nil {
+		fields = append(fields, user.FieldCredentialRotatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
 	return fields
 }
 
@@ -3123,6 +4952,20 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.LastLoginLocation()
 	case user.FieldLastLoginAt:
 		return m.LastLoginAt()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldStatusReason:
+		return m.StatusReason()
+	case user.FieldStatusChangedBy:
+		return m.StatusChangedBy()
+	case user.FieldStatusChangedAt:
+		return m.StatusChangedAt()
+	case user.FieldCredentialRotatedAt:
+		return m.CredentialRotatedAt()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3144,6 +4987,20 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldLastLoginLocation(ctx)
 	case user.FieldLastLoginAt:
 		return m.OldLastLoginAt(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldStatusReason:
+		return m.OldStatusReason(ctx)
+	case user.FieldStatusChangedBy:
+		return m.OldStatusChangedBy(ctx)
+	case user.FieldStatusChangedAt:
+		return m.OldStatusChangedAt(ctx)
+	case user.FieldCredentialRotatedAt:
+		return m.OldCredentialRotatedAt(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -3195,6 +5052,55 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastLoginAt(v)
 		return nil
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(user.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldStatusReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusReason(v)
+		return nil
+	case user.FieldStatusChangedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusChangedBy(v)
+		return nil
+	case user.FieldStatusChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusChangedAt(v)
+		return nil
+	case user.FieldCredentialRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialRotatedAt(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -3264,6 +5170,24 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldLastLoginAt) {
 		fields = append(fields, user.FieldLastLoginAt)
 	}
+	if m.FieldCleared(user.FieldName) {
+		fields = append(fields, user.FieldName)
+	}
+	if m.FieldCleared(user.FieldStatusReason) {
+		fields = append(fields, user.FieldStatusReason)
+	}
+	if m.FieldCleared(user.FieldStatusChangedBy) {
+		fields = append(fields, user.FieldStatusChangedBy)
+	}
+	if m.FieldCleared(user.FieldStatusChangedAt) {
+		fields = append(fields, user.FieldStatusChangedAt)
+	}
+	if m.FieldCleared(user.FieldCredentialRotatedAt) {
+		fields = append(fields, user.FieldCredentialRotatedAt)
+	}
+	if m.FieldCleared(user.FieldCreatedAt) {
+		fields = append(fields, user.FieldCreatedAt)
+	}
 	return fields
 }
 
@@ -3290,6 +5214,24 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldLastLoginAt:
 		m.ClearLastLoginAt()
 		return nil
+	case user.FieldName:
+		m.ClearName()
+		return nil
+	case user.FieldStatusReason:
+		m.ClearStatusReason()
+		return nil
+	case user.FieldStatusChangedBy:
+		m.ClearStatusChangedBy()
+		return nil
+	case user.FieldStatusChangedAt:
+		m.ClearStatusChangedAt()
+		return nil
+	case user.FieldCredentialRotatedAt:
+		m.ClearCredentialRotatedAt()
+		return nil
+	case user.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -3316,6 +5258,27 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldLastLoginAt:
 		m.ResetLastLoginAt()
 		return nil
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldStatusReason:
+		m.ResetStatusReason()
+		return nil
+	case user.FieldStatusChangedBy:
+		m.ResetStatusChangedBy()
+		return nil
+	case user.FieldStatusChangedAt:
+		m.ResetStatusChangedAt()
+		return nil
+	case user.FieldCredentialRotatedAt:
+		m.ResetCredentialRotatedAt()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -6,12 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BotLoginHistory is the predicate function for botloginhistory builders.
+type BotLoginHistory func(*sql.Selector)
+
 // CommandLog is the predicate function for commandlog builders.
 type CommandLog func(*sql.Selector)
 
 // CommandManifest is the predicate function for commandmanifest builders.
 type CommandManifest func(*sql.Selector)
 
+// CommandManifestSnapshot is the predicate function for commandmanifestsnapshot builders.
+type CommandManifestSnapshot func(*sql.Selector)
+
 // DailyRequests is the predicate function for dailyrequests builders.
 type DailyRequests func(*sql.Selector)
 
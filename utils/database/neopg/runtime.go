@@ -3,19 +3,44 @@
 package neopg
 
 import (
+	"time"
+
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/bot/schema"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/botloginhistory"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/commandmanifestsnapshot"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/dailyrequests"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/hourlyrequests"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
-	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	botloginhistoryFields := schema.BotLoginHistory{}.Fields()
+	_ = botloginhistoryFields
+	// botloginhistoryDescIP is the schema descriptor for ip field.
+	botloginhistoryDescIP := botloginhistoryFields[1].Descriptor()
+	// botloginhistory.DefaultIP holds the default value on creation for the ip field.
+	botloginhistory.DefaultIP = botloginhistoryDescIP.Default.(string)
+	// botloginhistory.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	botloginhistory.IPValidator = botloginhistoryDescIP.Validators[0].(func(string) error)
+	// botloginhistoryDescLocation is the schema descriptor for location field.
+	botloginhistoryDescLocation := botloginhistoryFields[2].Descriptor()
+	// botloginhistory.DefaultLocation holds the default value on creation for the location field.
+	botloginhistory.DefaultLocation = botloginhistoryDescLocation.Default.(string)
+	// botloginhistory.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	botloginhistory.LocationValidator = botloginhistoryDescLocation.Validators[0].(func(string) error)
+	// botloginhistoryDescSource is the schema descriptor for source field.
+	botloginhistoryDescSource := botloginhistoryFields[3].Descriptor()
+	// botloginhistory.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	botloginhistory.SourceValidator = botloginhistoryDescSource.Validators[0].(func(string) error)
+	// botloginhistoryDescLoggedInAt is the schema descriptor for logged_in_at field.
+	botloginhistoryDescLoggedInAt := botloginhistoryFields[4].Descriptor()
+	// botloginhistory.DefaultLoggedInAt holds the default value on creation for the logged_in_at field.
+	botloginhistory.DefaultLoggedInAt = botloginhistoryDescLoggedInAt.Default.(func() time.Time)
 	commandlogFields := schema.CommandLog{}.Fields()
 	_ = commandlogFields
 	// commandlogDescPlatform is the schema descriptor for platform field.
@@ -70,6 +95,28 @@ func init() {
 	commandmanifestDescCommandPath := commandmanifestFields[4].Descriptor()
 	// commandmanifest.CommandPathValidator is a validator for the "command_path" field. It is called by the builders before save.
 	commandmanifest.CommandPathValidator = commandmanifestDescCommandPath.Validators[0].(func(string) error)
+	commandmanifestsnapshotFields := schema.CommandManifestSnapshot{}.Fields()
+	_ = commandmanifestsnapshotFields
+	// commandmanifestsnapshotDescEtag is the schema descriptor for etag field.
+	commandmanifestsnapshotDescEtag := commandmanifestsnapshotFields[1].Descriptor()
+	// commandmanifestsnapshot.EtagValidator is a validator for the "etag" field. It is called by the builders before save.
+	commandmanifestsnapshot.EtagValidator = commandmanifestsnapshotDescEtag.Validators[0].(func(string) error)
+	// commandmanifestsnapshotDescEntryCount is the schema descriptor for entry_count field.
+	commandmanifestsnapshotDescEntryCount := commandmanifestsnapshotFields[3].Descriptor()
+	// commandmanifestsnapshot.DefaultEntryCount holds the default value on creation for the entry_count field.
+	commandmanifestsnapshot.DefaultEntryCount = commandmanifestsnapshotDescEntryCount.Default.(int)
+	// commandmanifestsnapshotDescAction is the schema descriptor for action field.
+	commandmanifestsnapshotDescAction := commandmanifestsnapshotFields[4].Descriptor()
+	// commandmanifestsnapshot.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	commandmanifestsnapshot.ActionValidator = commandmanifestsnapshotDescAction.Validators[0].(func(string) error)
+	// commandmanifestsnapshotDescCreatedBy is the schema descriptor for created_by field.
+	commandmanifestsnapshotDescCreatedBy := commandmanifestsnapshotFields[5].Descriptor()
+	// commandmanifestsnapshot.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	commandmanifestsnapshot.CreatedByValidator = commandmanifestsnapshotDescCreatedBy.Validators[0].(func(string) error)
+	// commandmanifestsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	commandmanifestsnapshotDescCreatedAt := commandmanifestsnapshotFields[7].Descriptor()
+	// commandmanifestsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commandmanifestsnapshot.DefaultCreatedAt = commandmanifestsnapshotDescCreatedAt.Default.(func() time.Time)
 	dailyrequestsFields := schema.DailyRequests{}.Fields()
 	_ = dailyrequestsFields
 	// dailyrequestsDescCount is the schema descriptor for count field.
@@ -100,4 +147,26 @@ func init() {
 	user.DefaultLastLoginLocation = userDescLastLoginLocation.Default.(string)
 	// user.LastLoginLocationValidator is a validator for the "last_login_location" field. It is called by the builders before save.
 	user.LastLoginLocationValidator = userDescLastLoginLocation.Validators[0].(func(string) error)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[6].Descriptor()
+	// user.DefaultName holds the default value on creation for the name field.
+	user.DefaultName = userDescName.Default.(string)
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescStatusReason is the schema descriptor for status_reason field.
+	userDescStatusReason := userFields[8].Descriptor()
+	// user.DefaultStatusReason holds the default value on creation for the status_reason field.
+	user.DefaultStatusReason = userDescStatusReason.Default.(string)
+	// user.StatusReasonValidator is a validator for the "status_reason" field. It is called by the builders before save.
+	user.StatusReasonValidator = userDescStatusReason.Validators[0].(func(string) error)
+	// userDescStatusChangedBy is the schema descriptor for status_changed_by field.
+	userDescStatusChangedBy := userFields[9].Descriptor()
+	// user.DefaultStatusChangedBy holds the default value on creation for the status_changed_by field.
+	user.DefaultStatusChangedBy = userDescStatusChangedBy.Default.(string)
+	// user.StatusChangedByValidator is a validator for the "status_changed_by" field. It is called by the builders before save.
+	user.StatusChangedByValidator = userDescStatusChangedBy.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[12].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -12,10 +12,14 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BotLoginHistory is the client for interacting with the BotLoginHistory builders.
+	BotLoginHistory *BotLoginHistoryClient
 	// CommandLog is the client for interacting with the CommandLog builders.
 	CommandLog *CommandLogClient
 	// CommandManifest is the client for interacting with the CommandManifest builders.
 	CommandManifest *CommandManifestClient
+	// CommandManifestSnapshot is the client for interacting with the CommandManifestSnapshot builders.
+	CommandManifestSnapshot *CommandManifestSnapshotClient
 	// DailyRequests is the client for interacting with the DailyRequests builders.
 	DailyRequests *DailyRequestsClient
 	// HourlyRequests is the client for interacting with the HourlyRequests builders.
@@ -155,8 +159,10 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BotLoginHistory = NewBotLoginHistoryClient(tx.config)
 	tx.CommandLog = NewCommandLogClient(tx.config)
 	tx.CommandManifest = NewCommandManifestClient(tx.config)
+	tx.CommandManifestSnapshot = NewCommandManifestSnapshotClient(tx.config)
 	tx.DailyRequests = NewDailyRequestsClient(tx.config)
 	tx.HourlyRequests = NewHourlyRequestsClient(tx.config)
 	tx.RequestsRanking = NewRequestsRankingClient(tx.config)
@@ -170,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: CommandLog.QueryXXX(), the query will be executed
+// applies a query, for example: BotLoginHistory.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -4,12 +4,12 @@ package neopg
 
 import (
 	"fmt"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 )
 
 // User is the model entity for the User schema.
@@ -28,7 +28,21 @@ type User struct {
 	// Client self-reported location from myip.ipip.net
 	LastLoginLocation string `json:"last_login_location,omitempty"`
 	// Last successful login time
-	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	// Owner-chosen label to tell bots of the same owner apart
+	Name string `json:"name,omitempty"`
+	// Only active bots may authenticate
+	Status user.Status `json:"status,omitempty"`
+	// Why the bot was revoked or suspended
+	StatusReason string `json:"status_reason,omitempty"`
+	// Toolbox user ID that last changed the status
+	StatusChangedBy string `json:"status_changed_by,omitempty"`
+	// StatusChangedAt holds the value of the "status_changed_at" field.
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	// Last time a new credential was issued
+	CredentialRotatedAt *time.Time `json:"credential_rotated_at,omitempty"`
+	// Registration time; empty for bots registered before it was recorded
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -39,9 +53,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID, user.FieldOwnerUserID, user.FieldBotID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldCredential, user.FieldLastLoginIP, user.FieldLastLoginLocation:
+		case user.FieldCredential, user.FieldLastLoginIP, user.FieldLastLoginLocation, user.FieldName, user.FieldStatus, user.FieldStatusReason, user.FieldStatusChangedBy:
 			values[i] = new(sql.NullString)
-		case user.FieldLastLoginAt:
+		case user.FieldLastLoginAt, user.FieldStatusChangedAt, user.FieldCredentialRotatedAt, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -101,6 +115,51 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.LastLoginAt = new(time.Time)
 				*_m.LastLoginAt = value.Time
 			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case user.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = user.Status(value.String)
+			}
+		case user.FieldStatusReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_reason", values[i])
+			} else if value.Valid {
+				_m.StatusReason = value.String
+			}
+		case user.FieldStatusChangedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_changed_by", values[i])
+			} else if value.Valid {
+				_m.StatusChangedBy = value.String
+			}
+		case user.FieldStatusChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field status_changed_at", values[i])
+			} else if value.Valid {
+				_m.StatusChangedAt = new(time.Time)
+				*_m.StatusChangedAt = value.Time
+			}
+		case user.FieldCredentialRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_rotated_at", values[i])
+			} else if value.Valid {
+				_m.CredentialRotatedAt = new(time.Time)
+				*_m.CredentialRotatedAt = value.Time
+			}
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +215,33 @@ func (_m *User) String() string {
 		builder.WriteString("last_login_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("status_reason=")
+	builder.WriteString(_m.StatusReason)
+	builder.WriteString(", ")
+	builder.WriteString("status_changed_by=")
+	builder.WriteString(_m.StatusChangedBy)
+	builder.WriteString(", ")
+	if v := _m.StatusChangedAt; v != nil {
+		builder.WriteString("status_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CredentialRotatedAt; v != nil {
+		builder.WriteString("credential_rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,9 @@
 package user
 
 import (
+	"fmt"
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -23,6 +26,20 @@ const (
 	FieldLastLoginLocation = "last_login_location"
 	// FieldLastLoginAt holds the string denoting the last_login_at field in the database.
 	FieldLastLoginAt = "last_login_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStatusReason holds the string denoting the status_reason field in the database.
+	FieldStatusReason = "status_reason"
+	// FieldStatusChangedBy holds the string denoting the status_changed_by field in the database.
+	FieldStatusChangedBy = "status_changed_by"
+	// FieldStatusChangedAt holds the string denoting the status_changed_at field in the database.
+	FieldStatusChangedAt = "status_changed_at"
+	// FieldCredentialRotatedAt holds the string denoting the credential_rotated_at field in the database.
+	FieldCredentialRotatedAt = "credential_rotated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// Table holds the table name of the user in the database.
 	Table = "user"
 )
@@ -36,6 +53,13 @@ var Columns = []string{
 	FieldLastLoginIP,
 	FieldLastLoginLocation,
 	FieldLastLoginAt,
+	FieldName,
+	FieldStatus,
+	FieldStatusReason,
+	FieldStatusChangedBy,
+	FieldStatusChangedAt,
+	FieldCredentialRotatedAt,
+	FieldCreatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -59,8 +83,49 @@ var (
 	DefaultLastLoginLocation string
 	// LastLoginLocationValidator is a validator for the "last_login_location" field. It is called by the builders before save.
 	LastLoginLocationValidator func(string) error
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultStatusReason holds the default value on creation for the "status_reason" field.
+	DefaultStatusReason string
+	// StatusReasonValidator is a validator for the "status_reason" field. It is called by the builders before save.
+	StatusReasonValidator func(string) error
+	// DefaultStatusChangedBy holds the default value on creation for the "status_changed_by" field.
+	DefaultStatusChangedBy string
+	// StatusChangedByValidator is a validator for the "status_changed_by" field. It is called by the builders before save.
+	StatusChangedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "active"
+	StatusRevoked   Status = "revoked"
+	StatusSuspended Status = "suspended"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusRevoked, StatusSuspended:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -98,3 +163,38 @@ func ByLastLoginLocation(opts ...sql.OrderTermOption) OrderOption {
 func ByLastLoginAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastLoginAt, opts...).ToFunc()
 }
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStatusReason orders the results by the status_reason field.
+func ByStatusReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusReason, opts...).ToFunc()
+}
+
+// ByStatusChangedBy orders the results by the status_changed_by field.
+func ByStatusChangedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusChangedBy, opts...).ToFunc()
+}
+
+// ByStatusChangedAt orders the results by the status_changed_at field.
+func ByStatusChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusChangedAt, opts...).ToFunc()
+}
+
+// ByCredentialRotatedAt orders the results by the credential_rotated_at field.
+func ByCredentialRotatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredentialRotatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
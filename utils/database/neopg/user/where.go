@@ -3,10 +3,10 @@
 package user
 
 import (
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
 )
 
 // ID filters vertices based on their ID field.
@@ -84,6 +84,36 @@ func LastLoginAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastLoginAt, v))
 }
 
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldName, v))
+}
+
+// StatusReason applies equality check predicate on the "status_reason" field. It's identical to StatusReasonEQ.
+func StatusReason(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// StatusChangedBy applies equality check predicate on the "status_changed_by" field. It's identical to StatusChangedByEQ.
+func StatusChangedBy(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusChangedBy, v))
+}
+
+// StatusChangedAt applies equality check predicate on the "status_changed_at" field. It's identical to StatusChangedAtEQ.
+func StatusChangedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusChangedAt, v))
+}
+
+// CredentialRotatedAt applies equality check predicate on the "credential_rotated_at" field. It's identical to CredentialRotatedAtEQ.
+func CredentialRotatedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCredentialRotatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // OwnerUserIDEQ applies the EQ predicate on the "owner_user_id" field.
 func OwnerUserIDEQ(v int64) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldOwnerUserID, v))
@@ -439,6 +469,401 @@ func LastLoginAtNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldLastLoginAt))
 }
 
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusReasonEQ applies the EQ predicate on the "status_reason" field.
+func StatusReasonEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// StatusReasonNEQ applies the NEQ predicate on the "status_reason" field.
+func StatusReasonNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatusReason, v))
+}
+
+// StatusReasonIn applies the In predicate on the "status_reason" field.
+func StatusReasonIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonNotIn applies the NotIn predicate on the "status_reason" field.
+func StatusReasonNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonGT applies the GT predicate on the "status_reason" field.
+func StatusReasonGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStatusReason, v))
+}
+
+// StatusReasonGTE applies the GTE predicate on the "status_reason" field.
+func StatusReasonGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStatusReason, v))
+}
+
+// StatusReasonLT applies the LT predicate on the "status_reason" field.
+func StatusReasonLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStatusReason, v))
+}
+
+// StatusReasonLTE applies the LTE predicate on the "status_reason" field.
+func StatusReasonLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStatusReason, v))
+}
+
+// StatusReasonContains applies the Contains predicate on the "status_reason" field.
+func StatusReasonContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldStatusReason, v))
+}
+
+// StatusReasonHasPrefix applies the HasPrefix predicate on the "status_reason" field.
+func StatusReasonHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldStatusReason, v))
+}
+
+// StatusReasonHasSuffix applies the HasSuffix predicate on the "status_reason" field.
+func StatusReasonHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldStatusReason, v))
+}
+
+// StatusReasonIsNil applies the IsNil predicate on the "status_reason" field.
+func StatusReasonIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStatusReason))
+}
+
+// StatusReasonNotNil applies the NotNil predicate on the "status_reason" field.
+func StatusReasonNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStatusReason))
+}
+
+// StatusReasonEqualFold applies the EqualFold predicate on the "status_reason" field.
+func StatusReasonEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldStatusReason, v))
+}
+
+// StatusReasonContainsFold applies the ContainsFold predicate on the "status_reason" field.
+func StatusReasonContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldStatusReason, v))
+}
+
+// StatusChangedByEQ applies the EQ predicate on the "status_changed_by" field.
+func StatusChangedByEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByNEQ applies the NEQ predicate on the "status_changed_by" field.
+func StatusChangedByNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByIn applies the In predicate on the "status_changed_by" field.
+func StatusChangedByIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatusChangedBy, vs...))
+}
+
+// StatusChangedByNotIn applies the NotIn predicate on the "status_changed_by" field.
+func StatusChangedByNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatusChangedBy, vs...))
+}
+
+// StatusChangedByGT applies the GT predicate on the "status_changed_by" field.
+func StatusChangedByGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByGTE applies the GTE predicate on the "status_changed_by" field.
+func StatusChangedByGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByLT applies the LT predicate on the "status_changed_by" field.
+func StatusChangedByLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByLTE applies the LTE predicate on the "status_changed_by" field.
+func StatusChangedByLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByContains applies the Contains predicate on the "status_changed_by" field.
+func StatusChangedByContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByHasPrefix applies the HasPrefix predicate on the "status_changed_by" field.
+func StatusChangedByHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByHasSuffix applies the HasSuffix predicate on the "status_changed_by" field.
+func StatusChangedByHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByIsNil applies the IsNil predicate on the "status_changed_by" field.
+func StatusChangedByIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStatusChangedBy))
+}
+
+// StatusChangedByNotNil applies the NotNil predicate on the "status_changed_by" field.
+func StatusChangedByNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStatusChangedBy))
+}
+
+// StatusChangedByEqualFold applies the EqualFold predicate on the "status_changed_by" field.
+func StatusChangedByEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldStatusChangedBy, v))
+}
+
+// StatusChangedByContainsFold applies the ContainsFold predicate on the "status_changed_by" field.
+func StatusChangedByContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldStatusChangedBy, v))
+}
+
+// StatusChangedAtEQ applies the EQ predicate on the "status_changed_at" field.
+func StatusChangedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtNEQ applies the NEQ predicate on the "status_changed_at" field.
+func StatusChangedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtIn applies the In predicate on the "status_changed_at" field.
+func StatusChangedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatusChangedAt, vs...))
+}
+
+// StatusChangedAtNotIn applies the NotIn predicate on the "status_changed_at" field.
+func StatusChangedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatusChangedAt, vs...))
+}
+
+// StatusChangedAtGT applies the GT predicate on the "status_changed_at" field.
+func StatusChangedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtGTE applies the GTE predicate on the "status_changed_at" field.
+func StatusChangedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtLT applies the LT predicate on the "status_changed_at" field.
+func StatusChangedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtLTE applies the LTE predicate on the "status_changed_at" field.
+func StatusChangedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtIsNil applies the IsNil predicate on the "status_changed_at" field.
+func StatusChangedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStatusChangedAt))
+}
+
+// StatusChangedAtNotNil applies the NotNil predicate on the "status_changed_at" field.
+func StatusChangedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStatusChangedAt))
+}
+
+// CredentialRotatedAtEQ applies the EQ predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCredentialRotatedAt, v))
+}
+
+// CredentialRotatedAtNEQ applies the NEQ predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldCredentialRotatedAt, v))
+}
+
+// CredentialRotatedAtIn applies the In predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldCredentialRotatedAt, vs...))
+}
+
+// CredentialRotatedAtNotIn applies the NotIn predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldCredentialRotatedAt, vs...))
+}
+
+// CredentialRotatedAtGT applies the GT predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldCredentialRotatedAt, v))
+}
+
+// CredentialRotatedAtGTE applies the GTE predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldCredentialRotatedAt, v))
+}
+
+// CredentialRotatedAtLT applies the LT predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldCredentialRotatedAt, v))
+}
+
+// CredentialRotatedAtLTE applies the LTE predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldCredentialRotatedAt, v))
+}
+
+// CredentialRotatedAtIsNil applies the IsNil predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldCredentialRotatedAt))
+}
+
+// CredentialRotatedAtNotNil applies the NotNil predicate on the "credential_rotated_at" field.
+func CredentialRotatedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldCredentialRotatedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldCreatedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
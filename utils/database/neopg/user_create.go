@@ -6,11 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -88,6 +88,104 @@ func (_c *UserCreate) SetNillableLastLoginAt(v *time.Time) *UserCreate {
 	return _c
 }
 
+// SetName sets the "name" field.
+func (_c *UserCreate) SetName(v string) *UserCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *UserCreate) SetNillableName(v *string) *UserCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *UserCreate) SetStatus(v user.Status) *UserCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatus(v *user.Status) *UserCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_c *UserCreate) SetStatusReason(v string) *UserCreate {
+	_c.mutation.SetStatusReason(v)
+	return _c
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusReason(v *string) *UserCreate {
+	if v != nil {
+		_c.SetStatusReason(*v)
+	}
+	return _c
+}
+
+// SetStatusChangedBy sets the "status_changed_by" field.
+func (_c *UserCreate) SetStatusChangedBy(v string) *UserCreate {
+	_c.mutation.SetStatusChangedBy(v)
+	return _c
+}
+
+// SetNillableStatusChangedBy sets the "status_changed_by" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusChangedBy(v *string) *UserCreate {
+	if v != nil {
+		_c.SetStatusChangedBy(*v)
+	}
+	return _c
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_c *UserCreate) SetStatusChangedAt(v time.Time) *UserCreate {
+	_c.mutation.SetStatusChangedAt(v)
+	return _c
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusChangedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetStatusChangedAt(*v)
+	}
+	return _c
+}
+
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (_c *UserCreate) SetCredentialRotatedAt(v time.Time) *UserCreate {
+	_c.mutation.SetCredentialRotatedAt(v)
+	return _c
+}
+
+// SetNillableCredentialRotatedAt sets the "credential_rotated_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableCredentialRotatedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetCredentialRotatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableCreatedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -131,6 +229,26 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultLastLoginLocation
 		_c.mutation.SetLastLoginLocation(v)
 	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := user.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := user.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.StatusReason(); !ok {
+		v := user.DefaultStatusReason
+		_c.mutation.SetStatusReason(v)
+	}
+	if _, ok := _c.mutation.StatusChangedBy(); !ok {
+		v := user.DefaultStatusChangedBy
+		_c.mutation.SetStatusChangedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := user.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -156,6 +274,29 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "last_login_location", err: fmt.Errorf(`neopg: validator failed for field "User.last_login_location": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`neopg: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`neopg: missing required field "User.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`neopg: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`neopg: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StatusChangedBy(); ok {
+		if err := user.StatusChangedByValidator(v); err != nil {
+			return &ValidationError{Name: "status_changed_by", err: fmt.Errorf(`neopg: validator failed for field "User.status_changed_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -206,6 +347,34 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = &value
 	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+		_node.StatusReason = value
+	}
+	if value, ok := _c.mutation.StatusChangedBy(); ok {
+		_spec.SetField(user.FieldStatusChangedBy, field.TypeString, value)
+		_node.StatusChangedBy = value
+	}
+	if value, ok := _c.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+		_node.StatusChangedAt = &value
+	}
+	if value, ok := _c.mutation.CredentialRotatedAt(); ok {
+		_spec.SetField(user.FieldCredentialRotatedAt, field.TypeTime, value)
+		_node.CredentialRotatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
 	return _node, _spec
 }
 
@@ -6,13 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
-	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/neopg/user"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -150,6 +150,140 @@ func (_u *UserUpdate) ClearLastLoginAt() *UserUpdate {
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *UserUpdate) SetName(v string) *UserUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableName(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *UserUpdate) ClearName() *UserUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdate) SetStatus(v user.Status) *UserUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatus(v *user.Status) *UserUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *UserUpdate) SetStatusReason(v string) *UserUpdate {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusReason(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (_u *UserUpdate) ClearStatusReason() *UserUpdate {
+	_u.mutation.ClearStatusReason()
+	return _u
+}
+
+// SetStatusChangedBy sets the "status_changed_by" field.
+func (_u *UserUpdate) SetStatusChangedBy(v string) *UserUpdate {
+	_u.mutation.SetStatusChangedBy(v)
+	return _u
+}
+
+// SetNillableStatusChangedBy sets the "status_changed_by" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusChangedBy(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetStatusChangedBy(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedBy clears the value of the "status_changed_by" field.
+func (_u *UserUpdate) ClearStatusChangedBy() *UserUpdate {
+	_u.mutation.ClearStatusChangedBy()
+	return _u
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_u *UserUpdate) SetStatusChangedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetStatusChangedAt(v)
+	return _u
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusChangedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetStatusChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (_u *UserUpdate) ClearStatusChangedAt() *UserUpdate {
+	_u.mutation.ClearStatusChangedAt()
+	return _u
+}
+
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (_u *UserUpdate) SetCredentialRotatedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetCredentialRotatedAt(v)
+	return _u
+}
+
+// SetNillableCredentialRotatedAt sets the "credential_rotated_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableCredentialRotatedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetCredentialRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (_u *UserUpdate) ClearCredentialRotatedAt() *UserUpdate {
+	_u.mutation.ClearCredentialRotatedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserUpdate) SetCreatedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableCreatedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *UserUpdate) ClearCreatedAt() *UserUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -199,6 +333,26 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "last_login_location", err: fmt.Errorf(`neopg: validator failed for field "User.last_login_location": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`neopg: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`neopg: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`neopg: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusChangedBy(); ok {
+		if err := user.StatusChangedByValidator(v); err != nil {
+			return &ValidationError{Name: "status_changed_by", err: fmt.Errorf(`neopg: validator failed for field "User.status_changed_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -250,6 +404,45 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastLoginAtCleared() {
 		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(user.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if _u.mutation.StatusReasonCleared() {
+		_spec.ClearField(user.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusChangedBy(); ok {
+		_spec.SetField(user.FieldStatusChangedBy, field.TypeString, value)
+	}
+	if _u.mutation.StatusChangedByCleared() {
+		_spec.ClearField(user.FieldStatusChangedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CredentialRotatedAt(); ok {
+		_spec.SetField(user.FieldCredentialRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CredentialRotatedAtCleared() {
+		_spec.ClearField(user.FieldCredentialRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(user.FieldCreatedAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -392,6 +585,140 @@ func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
 	return _u
 }
 
+// SetName sets the "name" field.
+func (_u *UserUpdateOne) SetName(v string) *UserUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *UserUpdateOne) ClearName() *UserUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdateOne) SetStatus(v user.Status) *UserUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatus(v *user.Status) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *UserUpdateOne) SetStatusReason(v string) *UserUpdateOne {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusReason(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (_u *UserUpdateOne) ClearStatusReason() *UserUpdateOne {
+	_u.mutation.ClearStatusReason()
+	return _u
+}
+
+// SetStatusChangedBy sets the "status_changed_by" field.
+func (_u *UserUpdateOne) SetStatusChangedBy(v string) *UserUpdateOne {
+	_u.mutation.SetStatusChangedBy(v)
+	return _u
+}
+
+// SetNillableStatusChangedBy sets the "status_changed_by" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusChangedBy(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusChangedBy(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedBy clears the value of the "status_changed_by" field.
+func (_u *UserUpdateOne) ClearStatusChangedBy() *UserUpdateOne {
+	_u.mutation.ClearStatusChangedBy()
+	return _u
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_u *UserUpdateOne) SetStatusChangedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetStatusChangedAt(v)
+	return _u
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusChangedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (_u *UserUpdateOne) ClearStatusChangedAt() *UserUpdateOne {
+	_u.mutation.ClearStatusChangedAt()
+	return _u
+}
+
+// SetCredentialRotatedAt sets the "credential_rotated_at" field.
+func (_u *UserUpdateOne) SetCredentialRotatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetCredentialRotatedAt(v)
+	return _u
+}
+
+// SetNillableCredentialRotatedAt sets the "credential_rotated_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableCredentialRotatedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetCredentialRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCredentialRotatedAt clears the value of the "credential_rotated_at" field.
+func (_u *UserUpdateOne) ClearCredentialRotatedAt() *UserUpdateOne {
+	_u.mutation.ClearCredentialRotatedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableCreatedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *UserUpdateOne) ClearCreatedAt() *UserUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -454,6 +781,26 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "last_login_location", err: fmt.Errorf(`neopg: validator failed for field "User.last_login_location": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`neopg: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`neopg: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`neopg: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusChangedBy(); ok {
+		if err := user.StatusChangedByValidator(v); err != nil {
+			return &ValidationError{Name: "status_changed_by", err: fmt.Errorf(`neopg: validator failed for field "User.status_changed_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -522,6 +869,45 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.LastLoginAtCleared() {
 		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(user.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if _u.mutation.StatusReasonCleared() {
+		_spec.ClearField(user.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusChangedBy(); ok {
+		_spec.SetField(user.FieldStatusChangedBy, field.TypeString, value)
+	}
+	if _u.mutation.StatusChangedByCleared() {
+		_spec.ClearField(user.FieldStatusChangedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CredentialRotatedAt(); ok {
+		_spec.SetField(user.FieldCredentialRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CredentialRotatedAtCleared() {
+		_spec.ClearField(user.FieldCredentialRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(user.FieldCreatedAt, field.TypeTime)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
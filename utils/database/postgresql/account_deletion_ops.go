@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const accountDeletionErrorMaxLen = 500
+
+func truncateAccountDeletionError(message string) string {
+	if len(message) <= accountDeletionErrorMaxLen {
+		return message
+	}
+	return message[:accountDeletionErrorMaxLen]
+}
+
+// CreateAccountDeletion schedules the purge of userID for scheduledFor.
+func (c *Client) CreateAccountDeletion(ctx context.Context, id, userID, locale string, maxAttempts int, scheduledFor, now time.Time) (*AccountDeletion, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	scheduledFor = scheduledFor.UTC()
+	return c.AccountDeletion.Create().
+		SetID(id).
+		SetUserID(userID).
+		SetLocale(locale).
+		SetScheduledFor(scheduledFor).
+		SetMaxAttempts(maxAttempts).
+		SetNextAttemptAt(scheduledFor).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+// GetLatestAccountDeletion returns the user's most recent deletion request.
+func (c *Client) GetLatestAccountDeletion(ctx context.Context, userID string) (*AccountDeletion, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.AccountDeletion.Query().
+		Where(accountdeletion.UserIDEQ(userID)).
+		Order(accountdeletion.ByCreatedAt(sql.OrderDesc()), accountdeletion.ByID(sql.OrderDesc())).
+		First(ctx)
+}
+
+func (c *Client) HasPendingAccountDeletion(ctx context.Context, userID string) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	return c.AccountDeletion.Query().
+		Where(accountdeletion.UserIDEQ(userID), accountdeletion.StatusEQ(accountdeletion.StatusPending)).
+		Exist(ctx)
+}
+
+// CancelAccountDeletion cancels the user's pending request while its grace
+// period is still running. It reports false when there is nothing left to
+// cancel, including when the purge may already have started.
+func (c *Client) CancelAccountDeletion(ctx context.Context, userID string, now time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	affected, err := c.AccountDeletion.Update().
+		Where(
+			accountdeletion.UserIDEQ(userID),
+			accountdeletion.StatusEQ(accountdeletion.StatusPending),
+			accountdeletion.ScheduledForGT(now),
+		).
+		SetStatus(accountdeletion.StatusCancelled).
+		SetCancelledAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (c *Client) ListDueAccountDeletions(ctx context.Context, now time.Time, limit int) ([]*AccountDeletion, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.AccountDeletion.Query().
+		Where(
+			accountdeletion.StatusEQ(accountdeletion.StatusPending),
+			accountdeletion.ScheduledForLTE(now),
+			accountdeletion.NextAttemptAtLTE(now),
+		).
+		Order(accountdeletion.ByNextAttemptAt(sql.OrderAsc()), accountdeletion.ByCreatedAt(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// ClaimAccountDeletion takes ownership of a due request the same way
+// ClaimDataExport does. Requests still inside their grace period are never
+// claimed, so a cancellation cannot race with the purge.
+func (c *Client) ClaimAccountDeletion(ctx context.Context, deletion *AccountDeletion, now, leaseUntil time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.AccountDeletion.Update().
+		Where(
+			accountdeletion.IDEQ(deletion.ID),
+			accountdeletion.StatusEQ(accountdeletion.StatusPending),
+			accountdeletion.AttemptsEQ(deletion.Attempts),
+			accountdeletion.ScheduledForLTE(now),
+			accountdeletion.NextAttemptAtLTE(now),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (c *Client) CompleteAccountDeletion(ctx context.Context, id string, receipt map[string]any, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	return c.AccountDeletion.UpdateOneID(id).
+		Where(accountdeletion.StatusEQ(accountdeletion.StatusPending)).
+		SetStatus(accountdeletion.StatusCompleted).
+		SetReceipt(receipt).
+		SetCompletedAt(now).
+		ClearLastError().
+		SetUpdatedAt(now).
+		Exec(ctx)
+}
+
+func (c *Client) RetryAccountDeletion(ctx context.Context, id, message string, nextAttemptAt time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	return c.AccountDeletion.UpdateOneID(id).
+		Where(accountdeletion.StatusEQ(accountdeletion.StatusPending)).
+		SetNextAttemptAt(nextAttemptAt.UTC()).
+		SetLastError(truncateAccountDeletionError(message)).
+		Exec(ctx)
+}
+
+func (c *Client) FailAccountDeletion(ctx context.Context, id, message string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	return c.AccountDeletion.UpdateOneID(id).
+		Where(accountdeletion.StatusEQ(accountdeletion.StatusPending)).
+		SetStatus(accountdeletion.StatusFailed).
+		SetLastError(truncateAccountDeletionError(message)).
+		SetUpdatedAt(now.UTC()).
+		Exec(ctx)
+}
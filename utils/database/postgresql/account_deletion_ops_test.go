@@ -0,0 +1,77 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestAccountDeletionLifecycleOps(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:account-deletion-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	scheduledFor := now.Add(24 * time.Hour)
+	if _, err := client.CreateAccountDeletion(ctx, "d1", "u1", "en", 2, scheduledFor, now); err != nil {
+		t.Fatalf("CreateAccountDeletion returned error: %v", err)
+	}
+	if pending, err := client.HasPendingAccountDeletion(ctx, "u1"); err != nil || !pending {
+		t.Fatalf("HasPendingAccountDeletion = %v, %v; want true", pending, err)
+	}
+	if due, _ := client.ListDueAccountDeletions(ctx, now, 10); len(due) != 0 {
+		t.Fatalf("ListDueAccountDeletions within the grace period = %d, want 0", len(due))
+	}
+	if cancelled, err := client.CancelAccountDeletion(ctx, "u1", now); err != nil || !cancelled {
+		t.Fatalf("CancelAccountDeletion = %v, %v; want true", cancelled, err)
+	}
+	if pending, _ := client.HasPendingAccountDeletion(ctx, "u1"); pending {
+		t.Fatalf("HasPendingAccountDeletion after cancel = true, want false")
+	}
+
+	if _, err := client.CreateAccountDeletion(ctx, "d2", "u1", "en", 2, scheduledFor, now.Add(time.Second)); err != nil {
+		t.Fatalf("CreateAccountDeletion returned error: %v", err)
+	}
+	later := scheduledFor.Add(time.Minute)
+	// The grace period is over, so the request can no longer be cancelled.
+	if cancelled, err := client.CancelAccountDeletion(ctx, "u1", later); err != nil || cancelled {
+		t.Fatalf("CancelAccountDeletion after the grace period = %v, %v; want false", cancelled, err)
+	}
+	due, err := client.ListDueAccountDeletions(ctx, later, 10)
+	if err != nil || len(due) != 1 || due[0].ID != "d2" {
+		t.Fatalf("ListDueAccountDeletions = %v, %v; want d2", due, err)
+	}
+	claimed, err := client.ClaimAccountDeletion(ctx, due[0], later, later.Add(time.Minute))
+	if err != nil || !claimed {
+		t.Fatalf("ClaimAccountDeletion = %v, %v; want true", claimed, err)
+	}
+	if claimed, err := client.ClaimAccountDeletion(ctx, due[0], later, later.Add(time.Minute)); err != nil || claimed {
+		t.Fatalf("second ClaimAccountDeletion = %v, %v; want false", claimed, err)
+	}
+	if err := client.RetryAccountDeletion(ctx, "d2", "kratos unavailable", later); err != nil {
+		t.Fatalf("RetryAccountDeletion returned error: %v", err)
+	}
+	if err := client.CompleteAccountDeletion(ctx, "d2", map[string]any{"receiptId": "d2"}, later); err != nil {
+		t.Fatalf("CompleteAccountDeletion returned error: %v", err)
+	}
+
+	row, err := client.GetLatestAccountDeletion(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetLatestAccountDeletion returned error: %v", err)
+	}
+	if row.ID != "d2" || row.Status != accountdeletion.StatusCompleted || row.Receipt["receiptId"] != "d2" || row.CompletedAt == nil {
+		t.Fatalf("latest deletion = %+v, want completed d2", row)
+	}
+	if due, _ := client.ListDueAccountDeletions(ctx, later.Add(time.Hour), 10); len(due) != 0 {
+		t.Fatalf("ListDueAccountDeletions after completion = %d, want 0", len(due))
+	}
+}
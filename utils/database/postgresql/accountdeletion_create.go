@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
+)
+
+// AccountDeletionCreate is the builder for creating a AccountDeletion entity.
+type AccountDeletionCreate struct {
+	config
+	mutation *AccountDeletionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AccountDeletionCreate) SetUserID(v string) *AccountDeletionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AccountDeletionCreate) SetStatus(v accountdeletion.Status) *AccountDeletionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableStatus(v *accountdeletion.Status) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *AccountDeletionCreate) SetLocale(v string) *AccountDeletionCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableLocale(v *string) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_c *AccountDeletionCreate) SetScheduledFor(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetScheduledFor(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *AccountDeletionCreate) SetAttempts(v int) *AccountDeletionCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableAttempts(v *int) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *AccountDeletionCreate) SetMaxAttempts(v int) *AccountDeletionCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *AccountDeletionCreate) SetNextAttemptAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *AccountDeletionCreate) SetLastError(v string) *AccountDeletionCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableLastError(v *string) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetReceipt sets the "receipt" field.
+func (_c *AccountDeletionCreate) SetReceipt(v map[string]interface{}) *AccountDeletionCreate {
+	_c.mutation.SetReceipt(v)
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *AccountDeletionCreate) SetCancelledAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableCancelledAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *AccountDeletionCreate) SetCompletedAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableCompletedAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountDeletionCreate) SetCreatedAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableCreatedAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AccountDeletionCreate) SetUpdatedAt(v time.Time) *AccountDeletionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AccountDeletionCreate) SetNillableUpdatedAt(v *time.Time) *AccountDeletionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountDeletionCreate) SetID(v string) *AccountDeletionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (_c *AccountDeletionCreate) Mutation() *AccountDeletionMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountDeletion in the database.
+func (_c *AccountDeletionCreate) Save(ctx context.Context) (*AccountDeletion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountDeletionCreate) SaveX(ctx context.Context) *AccountDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountDeletionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountDeletionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountDeletionCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := accountdeletion.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		v := accountdeletion.DefaultLocale
+		_c.mutation.SetLocale(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := accountdeletion.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountdeletion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := accountdeletion.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountDeletionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`postgresql: missing required field "AccountDeletion.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := accountdeletion.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`postgresql: missing required field "AccountDeletion.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := accountdeletion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`postgresql: missing required field "AccountDeletion.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := accountdeletion.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScheduledFor(); !ok {
+		return &ValidationError{Name: "scheduled_for", err: errors.New(`postgresql: missing required field "AccountDeletion.scheduled_for"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`postgresql: missing required field "AccountDeletion.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := accountdeletion.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`postgresql: missing required field "AccountDeletion.max_attempts"`)}
+	}
+	if v, ok := _c.mutation.MaxAttempts(); ok {
+		if err := accountdeletion.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`postgresql: missing required field "AccountDeletion.next_attempt_at"`)}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := accountdeletion.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "AccountDeletion.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "AccountDeletion.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := accountdeletion.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AccountDeletionCreate) sqlSave(ctx context.Context) (*AccountDeletion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AccountDeletion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountDeletionCreate) createSpec() (*AccountDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountDeletion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountdeletion.Table, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(accountdeletion.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(accountdeletion.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(accountdeletion.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.ScheduledFor(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(accountdeletion.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(accountdeletion.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(accountdeletion.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(accountdeletion.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.Receipt(); ok {
+		_spec.SetField(accountdeletion.FieldReceipt, field.TypeJSON, value)
+		_node.Receipt = value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(accountdeletion.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountdeletion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AccountDeletionCreateBulk is the builder for creating many AccountDeletion entities in bulk.
+type AccountDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*AccountDeletionCreate
+}
+
+// Save creates the AccountDeletion entities in the database.
+func (_c *AccountDeletionCreateBulk) Save(ctx context.Context) ([]*AccountDeletion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountDeletion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountDeletionCreateBulk) SaveX(ctx context.Context) []*AccountDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
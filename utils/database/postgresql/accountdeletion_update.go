@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// AccountDeletionUpdate is the builder for updating AccountDeletion entities.
+type AccountDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountDeletionMutation
+}
+
+// Where appends a list predicates to the AccountDeletionUpdate builder.
+func (_u *AccountDeletionUpdate) Where(ps ...predicate.AccountDeletion) *AccountDeletionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AccountDeletionUpdate) SetStatus(v accountdeletion.Status) *AccountDeletionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableStatus(v *accountdeletion.Status) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *AccountDeletionUpdate) SetLocale(v string) *AccountDeletionUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableLocale(v *string) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *AccountDeletionUpdate) SetScheduledFor(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableScheduledFor(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *AccountDeletionUpdate) SetAttempts(v int) *AccountDeletionUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableAttempts(v *int) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *AccountDeletionUpdate) AddAttempts(v int) *AccountDeletionUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *AccountDeletionUpdate) SetMaxAttempts(v int) *AccountDeletionUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableMaxAttempts(v *int) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *AccountDeletionUpdate) AddMaxAttempts(v int) *AccountDeletionUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *AccountDeletionUpdate) SetNextAttemptAt(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableNextAttemptAt(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *AccountDeletionUpdate) SetLastError(v string) *AccountDeletionUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableLastError(v *string) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *AccountDeletionUpdate) ClearLastError() *AccountDeletionUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetReceipt sets the "receipt" field.
+func (_u *AccountDeletionUpdate) SetReceipt(v map[string]interface{}) *AccountDeletionUpdate {
+	_u.mutation.SetReceipt(v)
+	return _u
+}
+
+// ClearReceipt clears the value of the "receipt" field.
+func (_u *AccountDeletionUpdate) ClearReceipt() *AccountDeletionUpdate {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *AccountDeletionUpdate) SetCancelledAt(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableCancelledAt(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *AccountDeletionUpdate) ClearCancelledAt() *AccountDeletionUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *AccountDeletionUpdate) SetCompletedAt(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdate) SetNillableCompletedAt(v *time.Time) *AccountDeletionUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *AccountDeletionUpdate) ClearCompletedAt() *AccountDeletionUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountDeletionUpdate) SetUpdatedAt(v time.Time) *AccountDeletionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (_u *AccountDeletionUpdate) Mutation() *AccountDeletionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountDeletionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountDeletionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountDeletionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := accountdeletion.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountDeletionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := accountdeletion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := accountdeletion.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := accountdeletion.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := accountdeletion.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := accountdeletion.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccountDeletionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountdeletion.Table, accountdeletion.Columns, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(accountdeletion.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(accountdeletion.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(accountdeletion.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(accountdeletion.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(accountdeletion.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(accountdeletion.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(accountdeletion.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(accountdeletion.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(accountdeletion.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Receipt(); ok {
+		_spec.SetField(accountdeletion.FieldReceipt, field.TypeJSON, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		_spec.ClearField(accountdeletion.FieldReceipt, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(accountdeletion.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountdeletion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountDeletionUpdateOne is the builder for updating a single AccountDeletion entity.
+type AccountDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountDeletionMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *AccountDeletionUpdateOne) SetStatus(v accountdeletion.Status) *AccountDeletionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableStatus(v *accountdeletion.Status) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *AccountDeletionUpdateOne) SetLocale(v string) *AccountDeletionUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableLocale(v *string) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *AccountDeletionUpdateOne) SetScheduledFor(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableScheduledFor(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *AccountDeletionUpdateOne) SetAttempts(v int) *AccountDeletionUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableAttempts(v *int) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *AccountDeletionUpdateOne) AddAttempts(v int) *AccountDeletionUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *AccountDeletionUpdateOne) SetMaxAttempts(v int) *AccountDeletionUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableMaxAttempts(v *int) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *AccountDeletionUpdateOne) AddMaxAttempts(v int) *AccountDeletionUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *AccountDeletionUpdateOne) SetNextAttemptAt(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableNextAttemptAt(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *AccountDeletionUpdateOne) SetLastError(v string) *AccountDeletionUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableLastError(v *string) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *AccountDeletionUpdateOne) ClearLastError() *AccountDeletionUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetReceipt sets the "receipt" field.
+func (_u *AccountDeletionUpdateOne) SetReceipt(v map[string]interface{}) *AccountDeletionUpdateOne {
+	_u.mutation.SetReceipt(v)
+	return _u
+}
+
+// ClearReceipt clears the value of the "receipt" field.
+func (_u *AccountDeletionUpdateOne) ClearReceipt() *AccountDeletionUpdateOne {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *AccountDeletionUpdateOne) SetCancelledAt(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableCancelledAt(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *AccountDeletionUpdateOne) ClearCancelledAt() *AccountDeletionUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *AccountDeletionUpdateOne) SetCompletedAt(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *AccountDeletionUpdateOne) SetNillableCompletedAt(v *time.Time) *AccountDeletionUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *AccountDeletionUpdateOne) ClearCompletedAt() *AccountDeletionUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountDeletionUpdateOne) SetUpdatedAt(v time.Time) *AccountDeletionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (_u *AccountDeletionUpdateOne) Mutation() *AccountDeletionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccountDeletionUpdate builder.
+func (_u *AccountDeletionUpdateOne) Where(ps ...predicate.AccountDeletion) *AccountDeletionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountDeletionUpdateOne) Select(field string, fields ...string) *AccountDeletionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountDeletion entity.
+func (_u *AccountDeletionUpdateOne) Save(ctx context.Context) (*AccountDeletion, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountDeletionUpdateOne) SaveX(ctx context.Context) *AccountDeletion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountDeletionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := accountdeletion.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountDeletionUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := accountdeletion.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := accountdeletion.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := accountdeletion.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := accountdeletion.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := accountdeletion.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "AccountDeletion.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccountDeletionUpdateOne) sqlSave(ctx context.Context) (_node *AccountDeletion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountdeletion.Table, accountdeletion.Columns, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "AccountDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountdeletion.FieldID)
+		for _, f := range fields {
+			if !accountdeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != accountdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(accountdeletion.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(accountdeletion.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(accountdeletion.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(accountdeletion.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(accountdeletion.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(accountdeletion.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(accountdeletion.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(accountdeletion.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(accountdeletion.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Receipt(); ok {
+		_spec.SetField(accountdeletion.FieldReceipt, field.TypeJSON, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		_spec.ClearField(accountdeletion.FieldReceipt, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(accountdeletion.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(accountdeletion.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountdeletion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AccountDeletion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -15,12 +15,18 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/authorizesocialplatforminfo"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/friendlink"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountdatagrant"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/group"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/grouplist"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/iosscriptcode"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
@@ -29,9 +35,15 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/sponsor"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/userrecoverycode"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/usertotp"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webauthncredential"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhooksubscription"
 )
@@ -41,8 +53,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
 	// AuthorizeSocialPlatformInfo is the client for interacting with the AuthorizeSocialPlatformInfo builders.
 	AuthorizeSocialPlatformInfo *AuthorizeSocialPlatformInfoClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// DataProviderOutbox is the client for interacting with the DataProviderOutbox builders.
+	DataProviderOutbox *DataProviderOutboxClient
+	// EmailMessage is the client for interacting with the EmailMessage builders.
+	EmailMessage *EmailMessageClient
+	// EmailSuppression is the client for interacting with the EmailSuppression builders.
+	EmailSuppression *EmailSuppressionClient
 	// FriendLink is the client for interacting with the FriendLink builders.
 	FriendLink *FriendLinkClient
 	// GameAccountBinding is the client for interacting with the GameAccountBinding builders.
@@ -55,6 +77,8 @@ type Client struct {
 	GroupList *GroupListClient
 	// IOSScriptCode is the client for interacting with the IOSScriptCode builders.
 	IOSScriptCode *IOSScriptCodeClient
+	// InheritJob is the client for interacting with the InheritJob builders.
+	InheritJob *InheritJobClient
 	// OAuth2ClientWebhookEndpoint is the client for interacting with the OAuth2ClientWebhookEndpoint builders.
 	OAuth2ClientWebhookEndpoint *OAuth2ClientWebhookEndpointClient
 	// RiskEvent is the client for interacting with the RiskEvent builders.
@@ -69,12 +93,24 @@ type Client struct {
 	SystemLog *SystemLogClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
+	// TicketAttachment is the client for interacting with the TicketAttachment builders.
+	TicketAttachment *TicketAttachmentClient
 	// TicketMessage is the client for interacting with the TicketMessage builders.
 	TicketMessage *TicketMessageClient
 	// UploadLog is the client for interacting with the UploadLog builders.
 	UploadLog *UploadLogClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserRecoveryCode is the client for interacting with the UserRecoveryCode builders.
+	UserRecoveryCode *UserRecoveryCodeClient
+	// UserTOTP is the client for interacting with the UserTOTP builders.
+	UserTOTP *UserTOTPClient
+	// WebAuthnCredential is the client for interacting with the WebAuthnCredential builders.
+	WebAuthnCredential *WebAuthnCredentialClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookDeliveryAttempt is the client for interacting with the WebhookDeliveryAttempt builders.
+	WebhookDeliveryAttempt *WebhookDeliveryAttemptClient
 	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
 	WebhookEndpoint *WebhookEndpointClient
 	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
@@ -90,13 +126,19 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccountDeletion = NewAccountDeletionClient(c.config)
 	c.AuthorizeSocialPlatformInfo = NewAuthorizeSocialPlatformInfoClient(c.config)
+	c.DataExport = NewDataExportClient(c.config)
+	c.DataProviderOutbox = NewDataProviderOutboxClient(c.config)
+	c.EmailMessage = NewEmailMessageClient(c.config)
+	c.EmailSuppression = NewEmailSuppressionClient(c.config)
 	c.FriendLink = NewFriendLinkClient(c.config)
 	c.GameAccountBinding = NewGameAccountBindingClient(c.config)
 	c.GameAccountDataGrant = NewGameAccountDataGrantClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.GroupList = NewGroupListClient(c.config)
 	c.IOSScriptCode = NewIOSScriptCodeClient(c.config)
+	c.InheritJob = NewInheritJobClient(c.config)
 	c.OAuth2ClientWebhookEndpoint = NewOAuth2ClientWebhookEndpointClient(c.config)
 	c.RiskEvent = NewRiskEventClient(c.config)
 	c.RiskRule = NewRiskRuleClient(c.config)
@@ -104,9 +146,15 @@ func (c *Client) init() {
 	c.Sponsor = NewSponsorClient(c.config)
 	c.SystemLog = NewSystemLogClient(c.config)
 	c.Ticket = NewTicketClient(c.config)
+	c.TicketAttachment = NewTicketAttachmentClient(c.config)
 	c.TicketMessage = NewTicketMessageClient(c.config)
 	c.UploadLog = NewUploadLogClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserRecoveryCode = NewUserRecoveryCodeClient(c.config)
+	c.UserTOTP = NewUserTOTPClient(c.config)
+	c.WebAuthnCredential = NewWebAuthnCredentialClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookDeliveryAttempt = NewWebhookDeliveryAttemptClient(c.config)
 	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
 	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
 }
@@ -201,13 +249,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                         ctx,
 		config:                      cfg,
+		AccountDeletion:             NewAccountDeletionClient(cfg),
 		AuthorizeSocialPlatformInfo: NewAuthorizeSocialPlatformInfoClient(cfg),
+		DataExport:                  NewDataExportClient(cfg),
+		DataProviderOutbox:          NewDataProviderOutboxClient(cfg),
+		EmailMessage:                NewEmailMessageClient(cfg),
+		EmailSuppression:            NewEmailSuppressionClient(cfg),
 		FriendLink:                  NewFriendLinkClient(cfg),
 		GameAccountBinding:          NewGameAccountBindingClient(cfg),
 		GameAccountDataGrant:        NewGameAccountDataGrantClient(cfg),
 		Group:                       NewGroupClient(cfg),
 		GroupList:                   NewGroupListClient(cfg),
 		IOSScriptCode:               NewIOSScriptCodeClient(cfg),
+		InheritJob:                  NewInheritJobClient(cfg),
 		OAuth2ClientWebhookEndpoint: NewOAuth2ClientWebhookEndpointClient(cfg),
 		RiskEvent:                   NewRiskEventClient(cfg),
 		RiskRule:                    NewRiskRuleClient(cfg),
@@ -215,9 +269,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Sponsor:                     NewSponsorClient(cfg),
 		SystemLog:                   NewSystemLogClient(cfg),
 		Ticket:                      NewTicketClient(cfg),
+		TicketAttachment:            NewTicketAttachmentClient(cfg),
 		TicketMessage:               NewTicketMessageClient(cfg),
 		UploadLog:                   NewUploadLogClient(cfg),
 		User:                        NewUserClient(cfg),
+		UserRecoveryCode:            NewUserRecoveryCodeClient(cfg),
+		UserTOTP:                    NewUserTOTPClient(cfg),
+		WebAuthnCredential:          NewWebAuthnCredentialClient(cfg),
+		WebhookDelivery:             NewWebhookDeliveryClient(cfg),
+		WebhookDeliveryAttempt:      NewWebhookDeliveryAttemptClient(cfg),
 		WebhookEndpoint:             NewWebhookEndpointClient(cfg),
 		WebhookSubscription:         NewWebhookSubscriptionClient(cfg),
 	}, nil
@@ -239,13 +299,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                         ctx,
 		config:                      cfg,
+		AccountDeletion:             NewAccountDeletionClient(cfg),
 		AuthorizeSocialPlatformInfo: NewAuthorizeSocialPlatformInfoClient(cfg),
+		DataExport:                  NewDataExportClient(cfg),
+		DataProviderOutbox:          NewDataProviderOutboxClient(cfg),
+		EmailMessage:                NewEmailMessageClient(cfg),
+		EmailSuppression:            NewEmailSuppressionClient(cfg),
 		FriendLink:                  NewFriendLinkClient(cfg),
 		GameAccountBinding:          NewGameAccountBindingClient(cfg),
 		GameAccountDataGrant:        NewGameAccountDataGrantClient(cfg),
 		Group:                       NewGroupClient(cfg),
 		GroupList:                   NewGroupListClient(cfg),
 		IOSScriptCode:               NewIOSScriptCodeClient(cfg),
+		InheritJob:                  NewInheritJobClient(cfg),
 		OAuth2ClientWebhookEndpoint: NewOAuth2ClientWebhookEndpointClient(cfg),
 		RiskEvent:                   NewRiskEventClient(cfg),
 		RiskRule:                    NewRiskRuleClient(cfg),
@@ -253,9 +319,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Sponsor:                     NewSponsorClient(cfg),
 		SystemLog:                   NewSystemLogClient(cfg),
 		Ticket:                      NewTicketClient(cfg),
+		TicketAttachment:            NewTicketAttachmentClient(cfg),
 		TicketMessage:               NewTicketMessageClient(cfg),
 		UploadLog:                   NewUploadLogClient(cfg),
 		User:                        NewUserClient(cfg),
+		UserRecoveryCode:            NewUserRecoveryCodeClient(cfg),
+		UserTOTP:                    NewUserTOTPClient(cfg),
+		WebAuthnCredential:          NewWebAuthnCredentialClient(cfg),
+		WebhookDelivery:             NewWebhookDeliveryClient(cfg),
+		WebhookDeliveryAttempt:      NewWebhookDeliveryAttemptClient(cfg),
 		WebhookEndpoint:             NewWebhookEndpointClient(cfg),
 		WebhookSubscription:         NewWebhookSubscriptionClient(cfg),
 	}, nil
@@ -264,7 +336,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuthorizeSocialPlatformInfo.
+//		AccountDeletion.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -287,10 +359,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuthorizeSocialPlatformInfo, c.FriendLink, c.GameAccountBinding,
-		c.GameAccountDataGrant, c.Group, c.GroupList, c.IOSScriptCode,
-		c.OAuth2ClientWebhookEndpoint, c.RiskEvent, c.RiskRule, c.SocialPlatformInfo,
-		c.Sponsor, c.SystemLog, c.Ticket, c.TicketMessage, c.UploadLog, c.User,
+		c.AccountDeletion, c.AuthorizeSocialPlatformInfo, c.DataExport,
+		c.DataProviderOutbox, c.EmailMessage, c.EmailSuppression, c.FriendLink,
+		c.GameAccountBinding, c.GameAccountDataGrant, c.Group, c.GroupList,
+		c.IOSScriptCode, c.InheritJob, c.OAuth2ClientWebhookEndpoint, c.RiskEvent,
+		c.RiskRule, c.SocialPlatformInfo, c.Sponsor, c.SystemLog, c.Ticket,
+		c.TicketAttachment, c.TicketMessage, c.UploadLog, c.User, c.UserRecoveryCode,
+		c.UserTOTP, c.WebAuthnCredential, c.WebhookDelivery, c.WebhookDeliveryAttempt,
 		c.WebhookEndpoint, c.WebhookSubscription,
 	} {
 		n.Use(hooks...)
@@ -301,10 +376,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuthorizeSocialPlatformInfo, c.FriendLink, c.GameAccountBinding,
-		c.GameAccountDataGrant, c.Group, c.GroupList, c.IOSScriptCode,
-		c.OAuth2ClientWebhookEndpoint, c.RiskEvent, c.RiskRule, c.SocialPlatformInfo,
-		c.Sponsor, c.SystemLog, c.Ticket, c.TicketMessage, c.UploadLog, c.User,
+		c.AccountDeletion, c.AuthorizeSocialPlatformInfo, c.DataExport,
+		c.DataProviderOutbox, c.EmailMessage, c.EmailSuppression, c.FriendLink,
+		c.GameAccountBinding, c.GameAccountDataGrant, c.Group, c.GroupList,
+		c.IOSScriptCode, c.InheritJob, c.OAuth2ClientWebhookEndpoint, c.RiskEvent,
+		c.RiskRule, c.SocialPlatformInfo, c.Sponsor, c.SystemLog, c.Ticket,
+		c.TicketAttachment, c.TicketMessage, c.UploadLog, c.User, c.UserRecoveryCode,
+		c.UserTOTP, c.WebAuthnCredential, c.WebhookDelivery, c.WebhookDeliveryAttempt,
 		c.WebhookEndpoint, c.WebhookSubscription,
 	} {
 		n.Intercept(interceptors...)
@@ -314,8 +392,18 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccountDeletionMutation:
+		return c.AccountDeletion.mutate(ctx, m)
 	case *AuthorizeSocialPlatformInfoMutation:
 		return c.AuthorizeSocialPlatformInfo.mutate(ctx, m)
+	case *DataExportMutation:
+		return c.DataExport.mutate(ctx, m)
+	case *DataProviderOutboxMutation:
+		return c.DataProviderOutbox.mutate(ctx, m)
+	case *EmailMessageMutation:
+		return c.EmailMessage.mutate(ctx, m)
+	case *EmailSuppressionMutation:
+		return c.EmailSuppression.mutate(ctx, m)
 	case *FriendLinkMutation:
 		return c.FriendLink.mutate(ctx, m)
 	case *GameAccountBindingMutation:
@@ -328,6 +416,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.GroupList.mutate(ctx, m)
 	case *IOSScriptCodeMutation:
 		return c.IOSScriptCode.mutate(ctx, m)
+	case *InheritJobMutation:
+		return c.InheritJob.mutate(ctx, m)
 	case *OAuth2ClientWebhookEndpointMutation:
 		return c.OAuth2ClientWebhookEndpoint.mutate(ctx, m)
 	case *RiskEventMutation:
@@ -342,12 +432,24 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SystemLog.mutate(ctx, m)
 	case *TicketMutation:
 		return c.Ticket.mutate(ctx, m)
+	case *TicketAttachmentMutation:
+		return c.TicketAttachment.mutate(ctx, m)
 	case *TicketMessageMutation:
 		return c.TicketMessage.mutate(ctx, m)
 	case *UploadLogMutation:
 		return c.UploadLog.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserRecoveryCodeMutation:
+		return c.UserRecoveryCode.mutate(ctx, m)
+	case *UserTOTPMutation:
+		return c.UserTOTP.mutate(ctx, m)
+	case *WebAuthnCredentialMutation:
+		return c.WebAuthnCredential.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookDeliveryAttemptMutation:
+		return c.WebhookDeliveryAttempt.mutate(ctx, m)
 	case *WebhookEndpointMutation:
 		return c.WebhookEndpoint.mutate(ctx, m)
 	case *WebhookSubscriptionMutation:
@@ -357,6 +459,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AccountDeletionClient is a client for the AccountDeletion schema.
+type AccountDeletionClient struct {
+	config
+}
+
+// NewAccountDeletionClient returns a client for the AccountDeletion from the given config.
+func NewAccountDeletionClient(c config) *AccountDeletionClient {
+	return &AccountDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountdeletion.Hooks(f(g(h())))`.
+func (c *AccountDeletionClient) Use(hooks ...Hook) {
+	c.hooks.AccountDeletion = append(c.hooks.AccountDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountdeletion.Intercept(f(g(h())))`.
+func (c *AccountDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountDeletion = append(c.inters.AccountDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a AccountDeletion entity.
+func (c *AccountDeletionClient) Create() *AccountDeletionCreate {
+	mutation := newAccountDeletionMutation(c.config, OpCreate)
+	return &AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountDeletion entities.
+func (c *AccountDeletionClient) CreateBulk(builders ...*AccountDeletionCreate) *AccountDeletionCreateBulk {
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountDeletionClient) MapCreateBulk(slice any, setFunc func(*AccountDeletionCreate, int)) *AccountDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountDeletionCreateBulk{err: fmt.Errorf("calling to AccountDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountDeletion.
+func (c *AccountDeletionClient) Update() *AccountDeletionUpdate {
+	mutation := newAccountDeletionMutation(c.config, OpUpdate)
+	return &AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountDeletionClient) UpdateOne(_m *AccountDeletion) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletion(_m))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountDeletionClient) UpdateOneID(id string) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletionID(id))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountDeletion.
+func (c *AccountDeletionClient) Delete() *AccountDeletionDelete {
+	mutation := newAccountDeletionMutation(c.config, OpDelete)
+	return &AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountDeletionClient) DeleteOne(_m *AccountDeletion) *AccountDeletionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountDeletionClient) DeleteOneID(id string) *AccountDeletionDeleteOne {
+	builder := c.Delete().Where(accountdeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountDeletion.
+func (c *AccountDeletionClient) Query() *AccountDeletionQuery {
+	return &AccountDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountDeletion entity by its id.
+func (c *AccountDeletionClient) Get(ctx context.Context, id string) (*AccountDeletion, error) {
+	return c.Query().Where(accountdeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountDeletionClient) GetX(ctx context.Context, id string) *AccountDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccountDeletionClient) Hooks() []Hook {
+	return c.hooks.AccountDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountDeletionClient) Interceptors() []Interceptor {
+	return c.inters.AccountDeletion
+}
+
+func (c *AccountDeletionClient) mutate(ctx context.Context, m *AccountDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown AccountDeletion mutation op: %q", m.Op())
+	}
+}
+
 // AuthorizeSocialPlatformInfoClient is a client for the AuthorizeSocialPlatformInfo schema.
 type AuthorizeSocialPlatformInfoClient struct {
 	config
@@ -506,107 +741,107 @@ func (c *AuthorizeSocialPlatformInfoClient) mutate(ctx context.Context, m *Autho
 	}
 }
 
-// FriendLinkClient is a client for the FriendLink schema.
-type FriendLinkClient struct {
+// DataExportClient is a client for the DataExport schema.
+type DataExportClient struct {
 	config
 }
 
-// NewFriendLinkClient returns a client for the FriendLink from the given config.
-func NewFriendLinkClient(c config) *FriendLinkClient {
-	return &FriendLinkClient{config: c}
+// NewDataExportClient returns a client for the DataExport from the given config.
+func NewDataExportClient(c config) *DataExportClient {
+	return &DataExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friendlink.Hooks(f(g(h())))`.
-func (c *FriendLinkClient) Use(hooks ...Hook) {
-	c.hooks.FriendLink = append(c.hooks.FriendLink, hooks...)
+// A call to `Use(f, g, h)` equals to `dataexport.Hooks(f(g(h())))`.
+func (c *DataExportClient) Use(hooks ...Hook) {
+	c.hooks.DataExport = append(c.hooks.DataExport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friendlink.Intercept(f(g(h())))`.
-func (c *FriendLinkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FriendLink = append(c.inters.FriendLink, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dataexport.Intercept(f(g(h())))`.
+func (c *DataExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataExport = append(c.inters.DataExport, interceptors...)
 }
 
-// Create returns a builder for creating a FriendLink entity.
-func (c *FriendLinkClient) Create() *FriendLinkCreate {
-	mutation := newFriendLinkMutation(c.config, OpCreate)
-	return &FriendLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DataExport entity.
+func (c *DataExportClient) Create() *DataExportCreate {
+	mutation := newDataExportMutation(c.config, OpCreate)
+	return &DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FriendLink entities.
-func (c *FriendLinkClient) CreateBulk(builders ...*FriendLinkCreate) *FriendLinkCreateBulk {
-	return &FriendLinkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DataExport entities.
+func (c *DataExportClient) CreateBulk(builders ...*DataExportCreate) *DataExportCreateBulk {
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendLinkClient) MapCreateBulk(slice any, setFunc func(*FriendLinkCreate, int)) *FriendLinkCreateBulk {
+func (c *DataExportClient) MapCreateBulk(slice any, setFunc func(*DataExportCreate, int)) *DataExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendLinkCreateBulk{err: fmt.Errorf("calling to FriendLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DataExportCreateBulk{err: fmt.Errorf("calling to DataExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendLinkCreate, rv.Len())
+	builders := make([]*DataExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendLinkCreateBulk{config: c.config, builders: builders}
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FriendLink.
-func (c *FriendLinkClient) Update() *FriendLinkUpdate {
-	mutation := newFriendLinkMutation(c.config, OpUpdate)
-	return &FriendLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DataExport.
+func (c *DataExportClient) Update() *DataExportUpdate {
+	mutation := newDataExportMutation(c.config, OpUpdate)
+	return &DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendLinkClient) UpdateOne(_m *FriendLink) *FriendLinkUpdateOne {
-	mutation := newFriendLinkMutation(c.config, OpUpdateOne, withFriendLink(_m))
-	return &FriendLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOne(_m *DataExport) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExport(_m))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendLinkClient) UpdateOneID(id int) *FriendLinkUpdateOne {
-	mutation := newFriendLinkMutation(c.config, OpUpdateOne, withFriendLinkID(id))
-	return &FriendLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOneID(id string) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExportID(id))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FriendLink.
-func (c *FriendLinkClient) Delete() *FriendLinkDelete {
-	mutation := newFriendLinkMutation(c.config, OpDelete)
-	return &FriendLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DataExport.
+func (c *DataExportClient) Delete() *DataExportDelete {
+	mutation := newDataExportMutation(c.config, OpDelete)
+	return &DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendLinkClient) DeleteOne(_m *FriendLink) *FriendLinkDeleteOne {
+func (c *DataExportClient) DeleteOne(_m *DataExport) *DataExportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendLinkClient) DeleteOneID(id int) *FriendLinkDeleteOne {
-	builder := c.Delete().Where(friendlink.ID(id))
+func (c *DataExportClient) DeleteOneID(id string) *DataExportDeleteOne {
+	builder := c.Delete().Where(dataexport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendLinkDeleteOne{builder}
+	return &DataExportDeleteOne{builder}
 }
 
-// Query returns a query builder for FriendLink.
-func (c *FriendLinkClient) Query() *FriendLinkQuery {
-	return &FriendLinkQuery{
+// Query returns a query builder for DataExport.
+func (c *DataExportClient) Query() *DataExportQuery {
+	return &DataExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriendLink},
+		ctx:    &QueryContext{Type: TypeDataExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FriendLink entity by its id.
-func (c *FriendLinkClient) Get(ctx context.Context, id int) (*FriendLink, error) {
-	return c.Query().Where(friendlink.ID(id)).Only(ctx)
+// Get returns a DataExport entity by its id.
+func (c *DataExportClient) Get(ctx context.Context, id string) (*DataExport, error) {
+	return c.Query().Where(dataexport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendLinkClient) GetX(ctx context.Context, id int) *FriendLink {
+func (c *DataExportClient) GetX(ctx context.Context, id string) *DataExport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -615,131 +850,131 @@ func (c *FriendLinkClient) GetX(ctx context.Context, id int) *FriendLink {
 }
 
 // Hooks returns the client hooks.
-func (c *FriendLinkClient) Hooks() []Hook {
-	return c.hooks.FriendLink
+func (c *DataExportClient) Hooks() []Hook {
+	return c.hooks.DataExport
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendLinkClient) Interceptors() []Interceptor {
-	return c.inters.FriendLink
+func (c *DataExportClient) Interceptors() []Interceptor {
+	return c.inters.DataExport
 }
 
-func (c *FriendLinkClient) mutate(ctx context.Context, m *FriendLinkMutation) (Value, error) {
+func (c *DataExportClient) mutate(ctx context.Context, m *DataExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown FriendLink mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown DataExport mutation op: %q", m.Op())
 	}
 }
 
-// GameAccountBindingClient is a client for the GameAccountBinding schema.
-type GameAccountBindingClient struct {
+// DataProviderOutboxClient is a client for the DataProviderOutbox schema.
+type DataProviderOutboxClient struct {
 	config
 }
 
-// NewGameAccountBindingClient returns a client for the GameAccountBinding from the given config.
-func NewGameAccountBindingClient(c config) *GameAccountBindingClient {
-	return &GameAccountBindingClient{config: c}
+// NewDataProviderOutboxClient returns a client for the DataProviderOutbox from the given config.
+func NewDataProviderOutboxClient(c config) *DataProviderOutboxClient {
+	return &DataProviderOutboxClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `gameaccountbinding.Hooks(f(g(h())))`.
-func (c *GameAccountBindingClient) Use(hooks ...Hook) {
-	c.hooks.GameAccountBinding = append(c.hooks.GameAccountBinding, hooks...)
+// A call to `Use(f, g, h)` equals to `dataprovideroutbox.Hooks(f(g(h())))`.
+func (c *DataProviderOutboxClient) Use(hooks ...Hook) {
+	c.hooks.DataProviderOutbox = append(c.hooks.DataProviderOutbox, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `gameaccountbinding.Intercept(f(g(h())))`.
-func (c *GameAccountBindingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GameAccountBinding = append(c.inters.GameAccountBinding, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dataprovideroutbox.Intercept(f(g(h())))`.
+func (c *DataProviderOutboxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataProviderOutbox = append(c.inters.DataProviderOutbox, interceptors...)
 }
 
-// Create returns a builder for creating a GameAccountBinding entity.
-func (c *GameAccountBindingClient) Create() *GameAccountBindingCreate {
-	mutation := newGameAccountBindingMutation(c.config, OpCreate)
-	return &GameAccountBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DataProviderOutbox entity.
+func (c *DataProviderOutboxClient) Create() *DataProviderOutboxCreate {
+	mutation := newDataProviderOutboxMutation(c.config, OpCreate)
+	return &DataProviderOutboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GameAccountBinding entities.
-func (c *GameAccountBindingClient) CreateBulk(builders ...*GameAccountBindingCreate) *GameAccountBindingCreateBulk {
-	return &GameAccountBindingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DataProviderOutbox entities.
+func (c *DataProviderOutboxClient) CreateBulk(builders ...*DataProviderOutboxCreate) *DataProviderOutboxCreateBulk {
+	return &DataProviderOutboxCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GameAccountBindingClient) MapCreateBulk(slice any, setFunc func(*GameAccountBindingCreate, int)) *GameAccountBindingCreateBulk {
+func (c *DataProviderOutboxClient) MapCreateBulk(slice any, setFunc func(*DataProviderOutboxCreate, int)) *DataProviderOutboxCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GameAccountBindingCreateBulk{err: fmt.Errorf("calling to GameAccountBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DataProviderOutboxCreateBulk{err: fmt.Errorf("calling to DataProviderOutboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GameAccountBindingCreate, rv.Len())
+	builders := make([]*DataProviderOutboxCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GameAccountBindingCreateBulk{config: c.config, builders: builders}
+	return &DataProviderOutboxCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GameAccountBinding.
-func (c *GameAccountBindingClient) Update() *GameAccountBindingUpdate {
-	mutation := newGameAccountBindingMutation(c.config, OpUpdate)
-	return &GameAccountBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DataProviderOutbox.
+func (c *DataProviderOutboxClient) Update() *DataProviderOutboxUpdate {
+	mutation := newDataProviderOutboxMutation(c.config, OpUpdate)
+	return &DataProviderOutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GameAccountBindingClient) UpdateOne(_m *GameAccountBinding) *GameAccountBindingUpdateOne {
-	mutation := newGameAccountBindingMutation(c.config, OpUpdateOne, withGameAccountBinding(_m))
-	return &GameAccountBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataProviderOutboxClient) UpdateOne(_m *DataProviderOutbox) *DataProviderOutboxUpdateOne {
+	mutation := newDataProviderOutboxMutation(c.config, OpUpdateOne, withDataProviderOutbox(_m))
+	return &DataProviderOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GameAccountBindingClient) UpdateOneID(id int) *GameAccountBindingUpdateOne {
-	mutation := newGameAccountBindingMutation(c.config, OpUpdateOne, withGameAccountBindingID(id))
-	return &GameAccountBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataProviderOutboxClient) UpdateOneID(id int) *DataProviderOutboxUpdateOne {
+	mutation := newDataProviderOutboxMutation(c.config, OpUpdateOne, withDataProviderOutboxID(id))
+	return &DataProviderOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GameAccountBinding.
-func (c *GameAccountBindingClient) Delete() *GameAccountBindingDelete {
-	mutation := newGameAccountBindingMutation(c.config, OpDelete)
-	return &GameAccountBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DataProviderOutbox.
+func (c *DataProviderOutboxClient) Delete() *DataProviderOutboxDelete {
+	mutation := newDataProviderOutboxMutation(c.config, OpDelete)
+	return &DataProviderOutboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GameAccountBindingClient) DeleteOne(_m *GameAccountBinding) *GameAccountBindingDeleteOne {
+func (c *DataProviderOutboxClient) DeleteOne(_m *DataProviderOutbox) *DataProviderOutboxDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GameAccountBindingClient) DeleteOneID(id int) *GameAccountBindingDeleteOne {
-	builder := c.Delete().Where(gameaccountbinding.ID(id))
+func (c *DataProviderOutboxClient) DeleteOneID(id int) *DataProviderOutboxDeleteOne {
+	builder := c.Delete().Where(dataprovideroutbox.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GameAccountBindingDeleteOne{builder}
+	return &DataProviderOutboxDeleteOne{builder}
 }
 
-// Query returns a query builder for GameAccountBinding.
-func (c *GameAccountBindingClient) Query() *GameAccountBindingQuery {
-	return &GameAccountBindingQuery{
+// Query returns a query builder for DataProviderOutbox.
+func (c *DataProviderOutboxClient) Query() *DataProviderOutboxQuery {
+	return &DataProviderOutboxQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGameAccountBinding},
+		ctx:    &QueryContext{Type: TypeDataProviderOutbox},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GameAccountBinding entity by its id.
-func (c *GameAccountBindingClient) Get(ctx context.Context, id int) (*GameAccountBinding, error) {
-	return c.Query().Where(gameaccountbinding.ID(id)).Only(ctx)
+// Get returns a DataProviderOutbox entity by its id.
+func (c *DataProviderOutboxClient) Get(ctx context.Context, id int) (*DataProviderOutbox, error) {
+	return c.Query().Where(dataprovideroutbox.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GameAccountBindingClient) GetX(ctx context.Context, id int) *GameAccountBinding {
+func (c *DataProviderOutboxClient) GetX(ctx context.Context, id int) *DataProviderOutbox {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -747,148 +982,132 @@ func (c *GameAccountBindingClient) GetX(ctx context.Context, id int) *GameAccoun
 	return obj
 }
 
-// QueryUser queries the user edge of a GameAccountBinding.
-func (c *GameAccountBindingClient) QueryUser(_m *GameAccountBinding) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(gameaccountbinding.Table, gameaccountbinding.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, gameaccountbinding.UserTable, gameaccountbinding.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *GameAccountBindingClient) Hooks() []Hook {
-	return c.hooks.GameAccountBinding
+func (c *DataProviderOutboxClient) Hooks() []Hook {
+	return c.hooks.DataProviderOutbox
 }
 
 // Interceptors returns the client interceptors.
-func (c *GameAccountBindingClient) Interceptors() []Interceptor {
-	return c.inters.GameAccountBinding
+func (c *DataProviderOutboxClient) Interceptors() []Interceptor {
+	return c.inters.DataProviderOutbox
 }
 
-func (c *GameAccountBindingClient) mutate(ctx context.Context, m *GameAccountBindingMutation) (Value, error) {
+func (c *DataProviderOutboxClient) mutate(ctx context.Context, m *DataProviderOutboxMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GameAccountBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataProviderOutboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GameAccountBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataProviderOutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GameAccountBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataProviderOutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GameAccountBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DataProviderOutboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown GameAccountBinding mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown DataProviderOutbox mutation op: %q", m.Op())
 	}
 }
 
-// GameAccountDataGrantClient is a client for the GameAccountDataGrant schema.
-type GameAccountDataGrantClient struct {
+// EmailMessageClient is a client for the EmailMessage schema.
+type EmailMessageClient struct {
 	config
 }
 
-// NewGameAccountDataGrantClient returns a client for the GameAccountDataGrant from the given config.
-func NewGameAccountDataGrantClient(c config) *GameAccountDataGrantClient {
-	return &GameAccountDataGrantClient{config: c}
+// NewEmailMessageClient returns a client for the EmailMessage from the given config.
+func NewEmailMessageClient(c config) *EmailMessageClient {
+	return &EmailMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `gameaccountdatagrant.Hooks(f(g(h())))`.
-func (c *GameAccountDataGrantClient) Use(hooks ...Hook) {
-	c.hooks.GameAccountDataGrant = append(c.hooks.GameAccountDataGrant, hooks...)
+// A call to `Use(f, g, h)` equals to `emailmessage.Hooks(f(g(h())))`.
+func (c *EmailMessageClient) Use(hooks ...Hook) {
+	c.hooks.EmailMessage = append(c.hooks.EmailMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `gameaccountdatagrant.Intercept(f(g(h())))`.
-func (c *GameAccountDataGrantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GameAccountDataGrant = append(c.inters.GameAccountDataGrant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailmessage.Intercept(f(g(h())))`.
+func (c *EmailMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailMessage = append(c.inters.EmailMessage, interceptors...)
 }
 
-// Create returns a builder for creating a GameAccountDataGrant entity.
-func (c *GameAccountDataGrantClient) Create() *GameAccountDataGrantCreate {
-	mutation := newGameAccountDataGrantMutation(c.config, OpCreate)
-	return &GameAccountDataGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailMessage entity.
+func (c *EmailMessageClient) Create() *EmailMessageCreate {
+	mutation := newEmailMessageMutation(c.config, OpCreate)
+	return &EmailMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GameAccountDataGrant entities.
-func (c *GameAccountDataGrantClient) CreateBulk(builders ...*GameAccountDataGrantCreate) *GameAccountDataGrantCreateBulk {
-	return &GameAccountDataGrantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailMessage entities.
+func (c *EmailMessageClient) CreateBulk(builders ...*EmailMessageCreate) *EmailMessageCreateBulk {
+	return &EmailMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GameAccountDataGrantClient) MapCreateBulk(slice any, setFunc func(*GameAccountDataGrantCreate, int)) *GameAccountDataGrantCreateBulk {
+func (c *EmailMessageClient) MapCreateBulk(slice any, setFunc func(*EmailMessageCreate, int)) *EmailMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GameAccountDataGrantCreateBulk{err: fmt.Errorf("calling to GameAccountDataGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailMessageCreateBulk{err: fmt.Errorf("calling to EmailMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GameAccountDataGrantCreate, rv.Len())
+	builders := make([]*EmailMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GameAccountDataGrantCreateBulk{config: c.config, builders: builders}
+	return &EmailMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GameAccountDataGrant.
-func (c *GameAccountDataGrantClient) Update() *GameAccountDataGrantUpdate {
-	mutation := newGameAccountDataGrantMutation(c.config, OpUpdate)
-	return &GameAccountDataGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailMessage.
+func (c *EmailMessageClient) Update() *EmailMessageUpdate {
+	mutation := newEmailMessageMutation(c.config, OpUpdate)
+	return &EmailMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GameAccountDataGrantClient) UpdateOne(_m *GameAccountDataGrant) *GameAccountDataGrantUpdateOne {
-	mutation := newGameAccountDataGrantMutation(c.config, OpUpdateOne, withGameAccountDataGrant(_m))
-	return &GameAccountDataGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailMessageClient) UpdateOne(_m *EmailMessage) *EmailMessageUpdateOne {
+	mutation := newEmailMessageMutation(c.config, OpUpdateOne, withEmailMessage(_m))
+	return &EmailMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GameAccountDataGrantClient) UpdateOneID(id int) *GameAccountDataGrantUpdateOne {
-	mutation := newGameAccountDataGrantMutation(c.config, OpUpdateOne, withGameAccountDataGrantID(id))
-	return &GameAccountDataGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailMessageClient) UpdateOneID(id string) *EmailMessageUpdateOne {
+	mutation := newEmailMessageMutation(c.config, OpUpdateOne, withEmailMessageID(id))
+	return &EmailMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GameAccountDataGrant.
-func (c *GameAccountDataGrantClient) Delete() *GameAccountDataGrantDelete {
-	mutation := newGameAccountDataGrantMutation(c.config, OpDelete)
-	return &GameAccountDataGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailMessage.
+func (c *EmailMessageClient) Delete() *EmailMessageDelete {
+	mutation := newEmailMessageMutation(c.config, OpDelete)
+	return &EmailMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GameAccountDataGrantClient) DeleteOne(_m *GameAccountDataGrant) *GameAccountDataGrantDeleteOne {
+func (c *EmailMessageClient) DeleteOne(_m *EmailMessage) *EmailMessageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GameAccountDataGrantClient) DeleteOneID(id int) *GameAccountDataGrantDeleteOne {
-	builder := c.Delete().Where(gameaccountdatagrant.ID(id))
+func (c *EmailMessageClient) DeleteOneID(id string) *EmailMessageDeleteOne {
+	builder := c.Delete().Where(emailmessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GameAccountDataGrantDeleteOne{builder}
+	return &EmailMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for GameAccountDataGrant.
-func (c *GameAccountDataGrantClient) Query() *GameAccountDataGrantQuery {
-	return &GameAccountDataGrantQuery{
+// Query returns a query builder for EmailMessage.
+func (c *EmailMessageClient) Query() *EmailMessageQuery {
+	return &EmailMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGameAccountDataGrant},
+		ctx:    &QueryContext{Type: TypeEmailMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GameAccountDataGrant entity by its id.
-func (c *GameAccountDataGrantClient) Get(ctx context.Context, id int) (*GameAccountDataGrant, error) {
-	return c.Query().Where(gameaccountdatagrant.ID(id)).Only(ctx)
+// Get returns a EmailMessage entity by its id.
+func (c *EmailMessageClient) Get(ctx context.Context, id string) (*EmailMessage, error) {
+	return c.Query().Where(emailmessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GameAccountDataGrantClient) GetX(ctx context.Context, id int) *GameAccountDataGrant {
+func (c *EmailMessageClient) GetX(ctx context.Context, id string) *EmailMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -896,164 +1115,132 @@ func (c *GameAccountDataGrantClient) GetX(ctx context.Context, id int) *GameAcco
 	return obj
 }
 
-// QueryOwner queries the owner edge of a GameAccountDataGrant.
-func (c *GameAccountDataGrantClient) QueryOwner(_m *GameAccountDataGrant) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, gameaccountdatagrant.OwnerTable, gameaccountdatagrant.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryGrantee queries the grantee edge of a GameAccountDataGrant.
-func (c *GameAccountDataGrantClient) QueryGrantee(_m *GameAccountDataGrant) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, gameaccountdatagrant.GranteeTable, gameaccountdatagrant.GranteeColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *GameAccountDataGrantClient) Hooks() []Hook {
-	return c.hooks.GameAccountDataGrant
+func (c *EmailMessageClient) Hooks() []Hook {
+	return c.hooks.EmailMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *GameAccountDataGrantClient) Interceptors() []Interceptor {
-	return c.inters.GameAccountDataGrant
+func (c *EmailMessageClient) Interceptors() []Interceptor {
+	return c.inters.EmailMessage
 }
 
-func (c *GameAccountDataGrantClient) mutate(ctx context.Context, m *GameAccountDataGrantMutation) (Value, error) {
+func (c *EmailMessageClient) mutate(ctx context.Context, m *EmailMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GameAccountDataGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GameAccountDataGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GameAccountDataGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GameAccountDataGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown GameAccountDataGrant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown EmailMessage mutation op: %q", m.Op())
 	}
 }
 
-// GroupClient is a client for the Group schema.
-type GroupClient struct {
+// EmailSuppressionClient is a client for the EmailSuppression schema.
+type EmailSuppressionClient struct {
 	config
 }
 
-// NewGroupClient returns a client for the Group from the given config.
-func NewGroupClient(c config) *GroupClient {
-	return &GroupClient{config: c}
+// NewEmailSuppressionClient returns a client for the EmailSuppression from the given config.
+func NewEmailSuppressionClient(c config) *EmailSuppressionClient {
+	return &EmailSuppressionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
-func (c *GroupClient) Use(hooks ...Hook) {
-	c.hooks.Group = append(c.hooks.Group, hooks...)
+// A call to `Use(f, g, h)` equals to `emailsuppression.Hooks(f(g(h())))`.
+func (c *EmailSuppressionClient) Use(hooks ...Hook) {
+	c.hooks.EmailSuppression = append(c.hooks.EmailSuppression, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
-func (c *GroupClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Group = append(c.inters.Group, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailsuppression.Intercept(f(g(h())))`.
+func (c *EmailSuppressionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSuppression = append(c.inters.EmailSuppression, interceptors...)
 }
 
-// Create returns a builder for creating a Group entity.
-func (c *GroupClient) Create() *GroupCreate {
-	mutation := newGroupMutation(c.config, OpCreate)
-	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailSuppression entity.
+func (c *EmailSuppressionClient) Create() *EmailSuppressionCreate {
+	mutation := newEmailSuppressionMutation(c.config, OpCreate)
+	return &EmailSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Group entities.
-func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
-	return &GroupCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailSuppression entities.
+func (c *EmailSuppressionClient) CreateBulk(builders ...*EmailSuppressionCreate) *EmailSuppressionCreateBulk {
+	return &EmailSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+func (c *EmailSuppressionClient) MapCreateBulk(slice any, setFunc func(*EmailSuppressionCreate, int)) *EmailSuppressionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailSuppressionCreateBulk{err: fmt.Errorf("calling to EmailSuppressionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupCreate, rv.Len())
+	builders := make([]*EmailSuppressionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupCreateBulk{config: c.config, builders: builders}
+	return &EmailSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Group.
-func (c *GroupClient) Update() *GroupUpdate {
-	mutation := newGroupMutation(c.config, OpUpdate)
-	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailSuppression.
+func (c *EmailSuppressionClient) Update() *EmailSuppressionUpdate {
+	mutation := newEmailSuppressionMutation(c.config, OpUpdate)
+	return &EmailSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupClient) UpdateOne(_m *Group) *GroupUpdateOne {
-	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(_m))
-	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSuppressionClient) UpdateOne(_m *EmailSuppression) *EmailSuppressionUpdateOne {
+	mutation := newEmailSuppressionMutation(c.config, OpUpdateOne, withEmailSuppression(_m))
+	return &EmailSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
-	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
-	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSuppressionClient) UpdateOneID(id int) *EmailSuppressionUpdateOne {
+	mutation := newEmailSuppressionMutation(c.config, OpUpdateOne, withEmailSuppressionID(id))
+	return &EmailSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Group.
-func (c *GroupClient) Delete() *GroupDelete {
-	mutation := newGroupMutation(c.config, OpDelete)
-	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailSuppression.
+func (c *EmailSuppressionClient) Delete() *EmailSuppressionDelete {
+	mutation := newEmailSuppressionMutation(c.config, OpDelete)
+	return &EmailSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupClient) DeleteOne(_m *Group) *GroupDeleteOne {
+func (c *EmailSuppressionClient) DeleteOne(_m *EmailSuppression) *EmailSuppressionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
-	builder := c.Delete().Where(group.ID(id))
+func (c *EmailSuppressionClient) DeleteOneID(id int) *EmailSuppressionDeleteOne {
+	builder := c.Delete().Where(emailsuppression.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupDeleteOne{builder}
+	return &EmailSuppressionDeleteOne{builder}
 }
 
-// Query returns a query builder for Group.
-func (c *GroupClient) Query() *GroupQuery {
-	return &GroupQuery{
+// Query returns a query builder for EmailSuppression.
+func (c *EmailSuppressionClient) Query() *EmailSuppressionQuery {
+	return &EmailSuppressionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroup},
+		ctx:    &QueryContext{Type: TypeEmailSuppression},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Group entity by its id.
-func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
-	return c.Query().Where(group.ID(id)).Only(ctx)
+// Get returns a EmailSuppression entity by its id.
+func (c *EmailSuppressionClient) Get(ctx context.Context, id int) (*EmailSuppression, error) {
+	return c.Query().Where(emailsuppression.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
+func (c *EmailSuppressionClient) GetX(ctx context.Context, id int) *EmailSuppression {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1061,148 +1248,132 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 	return obj
 }
 
-// QueryGroupList queries the group_list edge of a Group.
-func (c *GroupClient) QueryGroupList(_m *Group) *GroupListQuery {
-	query := (&GroupListClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(group.Table, group.FieldID, id),
-			sqlgraph.To(grouplist.Table, grouplist.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, group.GroupListTable, group.GroupListColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+func (c *EmailSuppressionClient) Hooks() []Hook {
+	return c.hooks.EmailSuppression
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupClient) Interceptors() []Interceptor {
-	return c.inters.Group
+func (c *EmailSuppressionClient) Interceptors() []Interceptor {
+	return c.inters.EmailSuppression
 }
 
-func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+func (c *EmailSuppressionClient) mutate(ctx context.Context, m *EmailSuppressionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown Group mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown EmailSuppression mutation op: %q", m.Op())
 	}
 }
 
-// GroupListClient is a client for the GroupList schema.
-type GroupListClient struct {
+// FriendLinkClient is a client for the FriendLink schema.
+type FriendLinkClient struct {
 	config
 }
 
-// NewGroupListClient returns a client for the GroupList from the given config.
-func NewGroupListClient(c config) *GroupListClient {
-	return &GroupListClient{config: c}
+// NewFriendLinkClient returns a client for the FriendLink from the given config.
+func NewFriendLinkClient(c config) *FriendLinkClient {
+	return &FriendLinkClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `grouplist.Hooks(f(g(h())))`.
-func (c *GroupListClient) Use(hooks ...Hook) {
-	c.hooks.GroupList = append(c.hooks.GroupList, hooks...)
+// A call to `Use(f, g, h)` equals to `friendlink.Hooks(f(g(h())))`.
+func (c *FriendLinkClient) Use(hooks ...Hook) {
+	c.hooks.FriendLink = append(c.hooks.FriendLink, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `grouplist.Intercept(f(g(h())))`.
-func (c *GroupListClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GroupList = append(c.inters.GroupList, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `friendlink.Intercept(f(g(h())))`.
+func (c *FriendLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendLink = append(c.inters.FriendLink, interceptors...)
 }
 
-// Create returns a builder for creating a GroupList entity.
-func (c *GroupListClient) Create() *GroupListCreate {
-	mutation := newGroupListMutation(c.config, OpCreate)
-	return &GroupListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FriendLink entity.
+func (c *FriendLinkClient) Create() *FriendLinkCreate {
+	mutation := newFriendLinkMutation(c.config, OpCreate)
+	return &FriendLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GroupList entities.
-func (c *GroupListClient) CreateBulk(builders ...*GroupListCreate) *GroupListCreateBulk {
-	return &GroupListCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FriendLink entities.
+func (c *FriendLinkClient) CreateBulk(builders ...*FriendLinkCreate) *FriendLinkCreateBulk {
+	return &FriendLinkCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupListClient) MapCreateBulk(slice any, setFunc func(*GroupListCreate, int)) *GroupListCreateBulk {
+func (c *FriendLinkClient) MapCreateBulk(slice any, setFunc func(*FriendLinkCreate, int)) *FriendLinkCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupListCreateBulk{err: fmt.Errorf("calling to GroupListClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FriendLinkCreateBulk{err: fmt.Errorf("calling to FriendLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupListCreate, rv.Len())
+	builders := make([]*FriendLinkCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupListCreateBulk{config: c.config, builders: builders}
+	return &FriendLinkCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GroupList.
-func (c *GroupListClient) Update() *GroupListUpdate {
-	mutation := newGroupListMutation(c.config, OpUpdate)
-	return &GroupListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FriendLink.
+func (c *FriendLinkClient) Update() *FriendLinkUpdate {
+	mutation := newFriendLinkMutation(c.config, OpUpdate)
+	return &FriendLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupListClient) UpdateOne(_m *GroupList) *GroupListUpdateOne {
-	mutation := newGroupListMutation(c.config, OpUpdateOne, withGroupList(_m))
-	return &GroupListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FriendLinkClient) UpdateOne(_m *FriendLink) *FriendLinkUpdateOne {
+	mutation := newFriendLinkMutation(c.config, OpUpdateOne, withFriendLink(_m))
+	return &FriendLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupListClient) UpdateOneID(id int) *GroupListUpdateOne {
-	mutation := newGroupListMutation(c.config, OpUpdateOne, withGroupListID(id))
-	return &GroupListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FriendLinkClient) UpdateOneID(id int) *FriendLinkUpdateOne {
+	mutation := newFriendLinkMutation(c.config, OpUpdateOne, withFriendLinkID(id))
+	return &FriendLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GroupList.
-func (c *GroupListClient) Delete() *GroupListDelete {
-	mutation := newGroupListMutation(c.config, OpDelete)
-	return &GroupListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FriendLink.
+func (c *FriendLinkClient) Delete() *FriendLinkDelete {
+	mutation := newFriendLinkMutation(c.config, OpDelete)
+	return &FriendLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupListClient) DeleteOne(_m *GroupList) *GroupListDeleteOne {
+func (c *FriendLinkClient) DeleteOne(_m *FriendLink) *FriendLinkDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupListClient) DeleteOneID(id int) *GroupListDeleteOne {
-	builder := c.Delete().Where(grouplist.ID(id))
+func (c *FriendLinkClient) DeleteOneID(id int) *FriendLinkDeleteOne {
+	builder := c.Delete().Where(friendlink.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupListDeleteOne{builder}
+	return &FriendLinkDeleteOne{builder}
 }
 
-// Query returns a query builder for GroupList.
-func (c *GroupListClient) Query() *GroupListQuery {
-	return &GroupListQuery{
+// Query returns a query builder for FriendLink.
+func (c *FriendLinkClient) Query() *FriendLinkQuery {
+	return &FriendLinkQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroupList},
+		ctx:    &QueryContext{Type: TypeFriendLink},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GroupList entity by its id.
-func (c *GroupListClient) Get(ctx context.Context, id int) (*GroupList, error) {
-	return c.Query().Where(grouplist.ID(id)).Only(ctx)
+// Get returns a FriendLink entity by its id.
+func (c *FriendLinkClient) Get(ctx context.Context, id int) (*FriendLink, error) {
+	return c.Query().Where(friendlink.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupListClient) GetX(ctx context.Context, id int) *GroupList {
+func (c *FriendLinkClient) GetX(ctx context.Context, id int) *FriendLink {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1210,148 +1381,132 @@ func (c *GroupListClient) GetX(ctx context.Context, id int) *GroupList {
 	return obj
 }
 
-// QueryGroup queries the group edge of a GroupList.
-func (c *GroupListClient) QueryGroup(_m *GroupList) *GroupQuery {
-	query := (&GroupClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(grouplist.Table, grouplist.FieldID, id),
-			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, grouplist.GroupTable, grouplist.GroupColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *GroupListClient) Hooks() []Hook {
-	return c.hooks.GroupList
+func (c *FriendLinkClient) Hooks() []Hook {
+	return c.hooks.FriendLink
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupListClient) Interceptors() []Interceptor {
-	return c.inters.GroupList
+func (c *FriendLinkClient) Interceptors() []Interceptor {
+	return c.inters.FriendLink
 }
 
-func (c *GroupListClient) mutate(ctx context.Context, m *GroupListMutation) (Value, error) {
+func (c *FriendLinkClient) mutate(ctx context.Context, m *FriendLinkMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupListCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FriendLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupListUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FriendLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FriendLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupListDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FriendLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown GroupList mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown FriendLink mutation op: %q", m.Op())
 	}
 }
 
-// IOSScriptCodeClient is a client for the IOSScriptCode schema.
-type IOSScriptCodeClient struct {
+// GameAccountBindingClient is a client for the GameAccountBinding schema.
+type GameAccountBindingClient struct {
 	config
 }
 
-// NewIOSScriptCodeClient returns a client for the IOSScriptCode from the given config.
-func NewIOSScriptCodeClient(c config) *IOSScriptCodeClient {
-	return &IOSScriptCodeClient{config: c}
-}
-
+// NewGameAccountBindingClient returns a client for the GameAccountBinding from the given config.
+func NewGameAccountBindingClient(c config) *GameAccountBindingClient {
+	return &GameAccountBindingClient{config: c}
+}
+
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `iosscriptcode.Hooks(f(g(h())))`.
-func (c *IOSScriptCodeClient) Use(hooks ...Hook) {
-	c.hooks.IOSScriptCode = append(c.hooks.IOSScriptCode, hooks...)
+// A call to `Use(f, g, h)` equals to `gameaccountbinding.Hooks(f(g(h())))`.
+func (c *GameAccountBindingClient) Use(hooks ...Hook) {
+	c.hooks.GameAccountBinding = append(c.hooks.GameAccountBinding, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `iosscriptcode.Intercept(f(g(h())))`.
-func (c *IOSScriptCodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IOSScriptCode = append(c.inters.IOSScriptCode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `gameaccountbinding.Intercept(f(g(h())))`.
+func (c *GameAccountBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GameAccountBinding = append(c.inters.GameAccountBinding, interceptors...)
 }
 
-// Create returns a builder for creating a IOSScriptCode entity.
-func (c *IOSScriptCodeClient) Create() *IOSScriptCodeCreate {
-	mutation := newIOSScriptCodeMutation(c.config, OpCreate)
-	return &IOSScriptCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GameAccountBinding entity.
+func (c *GameAccountBindingClient) Create() *GameAccountBindingCreate {
+	mutation := newGameAccountBindingMutation(c.config, OpCreate)
+	return &GameAccountBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IOSScriptCode entities.
-func (c *IOSScriptCodeClient) CreateBulk(builders ...*IOSScriptCodeCreate) *IOSScriptCodeCreateBulk {
-	return &IOSScriptCodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GameAccountBinding entities.
+func (c *GameAccountBindingClient) CreateBulk(builders ...*GameAccountBindingCreate) *GameAccountBindingCreateBulk {
+	return &GameAccountBindingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IOSScriptCodeClient) MapCreateBulk(slice any, setFunc func(*IOSScriptCodeCreate, int)) *IOSScriptCodeCreateBulk {
+func (c *GameAccountBindingClient) MapCreateBulk(slice any, setFunc func(*GameAccountBindingCreate, int)) *GameAccountBindingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IOSScriptCodeCreateBulk{err: fmt.Errorf("calling to IOSScriptCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GameAccountBindingCreateBulk{err: fmt.Errorf("calling to GameAccountBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IOSScriptCodeCreate, rv.Len())
+	builders := make([]*GameAccountBindingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IOSScriptCodeCreateBulk{config: c.config, builders: builders}
+	return &GameAccountBindingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IOSScriptCode.
-func (c *IOSScriptCodeClient) Update() *IOSScriptCodeUpdate {
-	mutation := newIOSScriptCodeMutation(c.config, OpUpdate)
-	return &IOSScriptCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GameAccountBinding.
+func (c *GameAccountBindingClient) Update() *GameAccountBindingUpdate {
+	mutation := newGameAccountBindingMutation(c.config, OpUpdate)
+	return &GameAccountBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IOSScriptCodeClient) UpdateOne(_m *IOSScriptCode) *IOSScriptCodeUpdateOne {
-	mutation := newIOSScriptCodeMutation(c.config, OpUpdateOne, withIOSScriptCode(_m))
-	return &IOSScriptCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GameAccountBindingClient) UpdateOne(_m *GameAccountBinding) *GameAccountBindingUpdateOne {
+	mutation := newGameAccountBindingMutation(c.config, OpUpdateOne, withGameAccountBinding(_m))
+	return &GameAccountBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IOSScriptCodeClient) UpdateOneID(id int) *IOSScriptCodeUpdateOne {
-	mutation := newIOSScriptCodeMutation(c.config, OpUpdateOne, withIOSScriptCodeID(id))
-	return &IOSScriptCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GameAccountBindingClient) UpdateOneID(id int) *GameAccountBindingUpdateOne {
+	mutation := newGameAccountBindingMutation(c.config, OpUpdateOne, withGameAccountBindingID(id))
+	return &GameAccountBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IOSScriptCode.
-func (c *IOSScriptCodeClient) Delete() *IOSScriptCodeDelete {
-	mutation := newIOSScriptCodeMutation(c.config, OpDelete)
-	return &IOSScriptCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GameAccountBinding.
+func (c *GameAccountBindingClient) Delete() *GameAccountBindingDelete {
+	mutation := newGameAccountBindingMutation(c.config, OpDelete)
+	return &GameAccountBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IOSScriptCodeClient) DeleteOne(_m *IOSScriptCode) *IOSScriptCodeDeleteOne {
+func (c *GameAccountBindingClient) DeleteOne(_m *GameAccountBinding) *GameAccountBindingDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IOSScriptCodeClient) DeleteOneID(id int) *IOSScriptCodeDeleteOne {
-	builder := c.Delete().Where(iosscriptcode.ID(id))
+func (c *GameAccountBindingClient) DeleteOneID(id int) *GameAccountBindingDeleteOne {
+	builder := c.Delete().Where(gameaccountbinding.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IOSScriptCodeDeleteOne{builder}
+	return &GameAccountBindingDeleteOne{builder}
 }
 
-// Query returns a query builder for IOSScriptCode.
-func (c *IOSScriptCodeClient) Query() *IOSScriptCodeQuery {
-	return &IOSScriptCodeQuery{
+// Query returns a query builder for GameAccountBinding.
+func (c *GameAccountBindingClient) Query() *GameAccountBindingQuery {
+	return &GameAccountBindingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIOSScriptCode},
+		ctx:    &QueryContext{Type: TypeGameAccountBinding},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IOSScriptCode entity by its id.
-func (c *IOSScriptCodeClient) Get(ctx context.Context, id int) (*IOSScriptCode, error) {
-	return c.Query().Where(iosscriptcode.ID(id)).Only(ctx)
+// Get returns a GameAccountBinding entity by its id.
+func (c *GameAccountBindingClient) Get(ctx context.Context, id int) (*GameAccountBinding, error) {
+	return c.Query().Where(gameaccountbinding.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IOSScriptCodeClient) GetX(ctx context.Context, id int) *IOSScriptCode {
+func (c *GameAccountBindingClient) GetX(ctx context.Context, id int) *GameAccountBinding {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1359,15 +1514,15 @@ func (c *IOSScriptCodeClient) GetX(ctx context.Context, id int) *IOSScriptCode {
 	return obj
 }
 
-// QueryUser queries the user edge of a IOSScriptCode.
-func (c *IOSScriptCodeClient) QueryUser(_m *IOSScriptCode) *UserQuery {
+// QueryUser queries the user edge of a GameAccountBinding.
+func (c *GameAccountBindingClient) QueryUser(_m *GameAccountBinding) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(iosscriptcode.Table, iosscriptcode.FieldID, id),
+			sqlgraph.From(gameaccountbinding.Table, gameaccountbinding.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, iosscriptcode.UserTable, iosscriptcode.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, gameaccountbinding.UserTable, gameaccountbinding.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1376,131 +1531,131 @@ func (c *IOSScriptCodeClient) QueryUser(_m *IOSScriptCode) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *IOSScriptCodeClient) Hooks() []Hook {
-	return c.hooks.IOSScriptCode
+func (c *GameAccountBindingClient) Hooks() []Hook {
+	return c.hooks.GameAccountBinding
 }
 
 // Interceptors returns the client interceptors.
-func (c *IOSScriptCodeClient) Interceptors() []Interceptor {
-	return c.inters.IOSScriptCode
+func (c *GameAccountBindingClient) Interceptors() []Interceptor {
+	return c.inters.GameAccountBinding
 }
 
-func (c *IOSScriptCodeClient) mutate(ctx context.Context, m *IOSScriptCodeMutation) (Value, error) {
+func (c *GameAccountBindingClient) mutate(ctx context.Context, m *GameAccountBindingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IOSScriptCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GameAccountBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IOSScriptCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GameAccountBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IOSScriptCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GameAccountBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IOSScriptCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GameAccountBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown IOSScriptCode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown GameAccountBinding mutation op: %q", m.Op())
 	}
 }
 
-// OAuth2ClientWebhookEndpointClient is a client for the OAuth2ClientWebhookEndpoint schema.
-type OAuth2ClientWebhookEndpointClient struct {
+// GameAccountDataGrantClient is a client for the GameAccountDataGrant schema.
+type GameAccountDataGrantClient struct {
 	config
 }
 
-// NewOAuth2ClientWebhookEndpointClient returns a client for the OAuth2ClientWebhookEndpoint from the given config.
-func NewOAuth2ClientWebhookEndpointClient(c config) *OAuth2ClientWebhookEndpointClient {
-	return &OAuth2ClientWebhookEndpointClient{config: c}
+// NewGameAccountDataGrantClient returns a client for the GameAccountDataGrant from the given config.
+func NewGameAccountDataGrantClient(c config) *GameAccountDataGrantClient {
+	return &GameAccountDataGrantClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oauth2clientwebhookendpoint.Hooks(f(g(h())))`.
-func (c *OAuth2ClientWebhookEndpointClient) Use(hooks ...Hook) {
-	c.hooks.OAuth2ClientWebhookEndpoint = append(c.hooks.OAuth2ClientWebhookEndpoint, hooks...)
+// A call to `Use(f, g, h)` equals to `gameaccountdatagrant.Hooks(f(g(h())))`.
+func (c *GameAccountDataGrantClient) Use(hooks ...Hook) {
+	c.hooks.GameAccountDataGrant = append(c.hooks.GameAccountDataGrant, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oauth2clientwebhookendpoint.Intercept(f(g(h())))`.
-func (c *OAuth2ClientWebhookEndpointClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OAuth2ClientWebhookEndpoint = append(c.inters.OAuth2ClientWebhookEndpoint, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `gameaccountdatagrant.Intercept(f(g(h())))`.
+func (c *GameAccountDataGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GameAccountDataGrant = append(c.inters.GameAccountDataGrant, interceptors...)
 }
 
-// Create returns a builder for creating a OAuth2ClientWebhookEndpoint entity.
-func (c *OAuth2ClientWebhookEndpointClient) Create() *OAuth2ClientWebhookEndpointCreate {
-	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpCreate)
-	return &OAuth2ClientWebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GameAccountDataGrant entity.
+func (c *GameAccountDataGrantClient) Create() *GameAccountDataGrantCreate {
+	mutation := newGameAccountDataGrantMutation(c.config, OpCreate)
+	return &GameAccountDataGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OAuth2ClientWebhookEndpoint entities.
-func (c *OAuth2ClientWebhookEndpointClient) CreateBulk(builders ...*OAuth2ClientWebhookEndpointCreate) *OAuth2ClientWebhookEndpointCreateBulk {
-	return &OAuth2ClientWebhookEndpointCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GameAccountDataGrant entities.
+func (c *GameAccountDataGrantClient) CreateBulk(builders ...*GameAccountDataGrantCreate) *GameAccountDataGrantCreateBulk {
+	return &GameAccountDataGrantCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OAuth2ClientWebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*OAuth2ClientWebhookEndpointCreate, int)) *OAuth2ClientWebhookEndpointCreateBulk {
+func (c *GameAccountDataGrantClient) MapCreateBulk(slice any, setFunc func(*GameAccountDataGrantCreate, int)) *GameAccountDataGrantCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OAuth2ClientWebhookEndpointCreateBulk{err: fmt.Errorf("calling to OAuth2ClientWebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GameAccountDataGrantCreateBulk{err: fmt.Errorf("calling to GameAccountDataGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OAuth2ClientWebhookEndpointCreate, rv.Len())
+	builders := make([]*GameAccountDataGrantCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OAuth2ClientWebhookEndpointCreateBulk{config: c.config, builders: builders}
+	return &GameAccountDataGrantCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OAuth2ClientWebhookEndpoint.
-func (c *OAuth2ClientWebhookEndpointClient) Update() *OAuth2ClientWebhookEndpointUpdate {
-	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpUpdate)
-	return &OAuth2ClientWebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GameAccountDataGrant.
+func (c *GameAccountDataGrantClient) Update() *GameAccountDataGrantUpdate {
+	mutation := newGameAccountDataGrantMutation(c.config, OpUpdate)
+	return &GameAccountDataGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OAuth2ClientWebhookEndpointClient) UpdateOne(_m *OAuth2ClientWebhookEndpoint) *OAuth2ClientWebhookEndpointUpdateOne {
-	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpUpdateOne, withOAuth2ClientWebhookEndpoint(_m))
-	return &OAuth2ClientWebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GameAccountDataGrantClient) UpdateOne(_m *GameAccountDataGrant) *GameAccountDataGrantUpdateOne {
+	mutation := newGameAccountDataGrantMutation(c.config, OpUpdateOne, withGameAccountDataGrant(_m))
+	return &GameAccountDataGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OAuth2ClientWebhookEndpointClient) UpdateOneID(id string) *OAuth2ClientWebhookEndpointUpdateOne {
-	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpUpdateOne, withOAuth2ClientWebhookEndpointID(id))
-	return &OAuth2ClientWebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GameAccountDataGrantClient) UpdateOneID(id int) *GameAccountDataGrantUpdateOne {
+	mutation := newGameAccountDataGrantMutation(c.config, OpUpdateOne, withGameAccountDataGrantID(id))
+	return &GameAccountDataGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OAuth2ClientWebhookEndpoint.
-func (c *OAuth2ClientWebhookEndpointClient) Delete() *OAuth2ClientWebhookEndpointDelete {
-	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpDelete)
-	return &OAuth2ClientWebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GameAccountDataGrant.
+func (c *GameAccountDataGrantClient) Delete() *GameAccountDataGrantDelete {
+	mutation := newGameAccountDataGrantMutation(c.config, OpDelete)
+	return &GameAccountDataGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OAuth2ClientWebhookEndpointClient) DeleteOne(_m *OAuth2ClientWebhookEndpoint) *OAuth2ClientWebhookEndpointDeleteOne {
+func (c *GameAccountDataGrantClient) DeleteOne(_m *GameAccountDataGrant) *GameAccountDataGrantDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OAuth2ClientWebhookEndpointClient) DeleteOneID(id string) *OAuth2ClientWebhookEndpointDeleteOne {
-	builder := c.Delete().Where(oauth2clientwebhookendpoint.ID(id))
+func (c *GameAccountDataGrantClient) DeleteOneID(id int) *GameAccountDataGrantDeleteOne {
+	builder := c.Delete().Where(gameaccountdatagrant.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OAuth2ClientWebhookEndpointDeleteOne{builder}
+	return &GameAccountDataGrantDeleteOne{builder}
 }
 
-// Query returns a query builder for OAuth2ClientWebhookEndpoint.
-func (c *OAuth2ClientWebhookEndpointClient) Query() *OAuth2ClientWebhookEndpointQuery {
-	return &OAuth2ClientWebhookEndpointQuery{
+// Query returns a query builder for GameAccountDataGrant.
+func (c *GameAccountDataGrantClient) Query() *GameAccountDataGrantQuery {
+	return &GameAccountDataGrantQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOAuth2ClientWebhookEndpoint},
+		ctx:    &QueryContext{Type: TypeGameAccountDataGrant},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OAuth2ClientWebhookEndpoint entity by its id.
-func (c *OAuth2ClientWebhookEndpointClient) Get(ctx context.Context, id string) (*OAuth2ClientWebhookEndpoint, error) {
-	return c.Query().Where(oauth2clientwebhookendpoint.ID(id)).Only(ctx)
+// Get returns a GameAccountDataGrant entity by its id.
+func (c *GameAccountDataGrantClient) Get(ctx context.Context, id int) (*GameAccountDataGrant, error) {
+	return c.Query().Where(gameaccountdatagrant.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OAuth2ClientWebhookEndpointClient) GetX(ctx context.Context, id string) *OAuth2ClientWebhookEndpoint {
+func (c *GameAccountDataGrantClient) GetX(ctx context.Context, id int) *GameAccountDataGrant {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1508,132 +1663,164 @@ func (c *OAuth2ClientWebhookEndpointClient) GetX(ctx context.Context, id string)
 	return obj
 }
 
+// QueryOwner queries the owner edge of a GameAccountDataGrant.
+func (c *GameAccountDataGrantClient) QueryOwner(_m *GameAccountDataGrant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gameaccountdatagrant.OwnerTable, gameaccountdatagrant.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGrantee queries the grantee edge of a GameAccountDataGrant.
+func (c *GameAccountDataGrantClient) QueryGrantee(_m *GameAccountDataGrant) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gameaccountdatagrant.GranteeTable, gameaccountdatagrant.GranteeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *OAuth2ClientWebhookEndpointClient) Hooks() []Hook {
-	return c.hooks.OAuth2ClientWebhookEndpoint
+func (c *GameAccountDataGrantClient) Hooks() []Hook {
+	return c.hooks.GameAccountDataGrant
 }
 
 // Interceptors returns the client interceptors.
-func (c *OAuth2ClientWebhookEndpointClient) Interceptors() []Interceptor {
-	return c.inters.OAuth2ClientWebhookEndpoint
+func (c *GameAccountDataGrantClient) Interceptors() []Interceptor {
+	return c.inters.GameAccountDataGrant
 }
 
-func (c *OAuth2ClientWebhookEndpointClient) mutate(ctx context.Context, m *OAuth2ClientWebhookEndpointMutation) (Value, error) {
+func (c *GameAccountDataGrantClient) mutate(ctx context.Context, m *GameAccountDataGrantMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OAuth2ClientWebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GameAccountDataGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OAuth2ClientWebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GameAccountDataGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OAuth2ClientWebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GameAccountDataGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OAuth2ClientWebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GameAccountDataGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown OAuth2ClientWebhookEndpoint mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown GameAccountDataGrant mutation op: %q", m.Op())
 	}
 }
 
-// RiskEventClient is a client for the RiskEvent schema.
-type RiskEventClient struct {
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
 	config
 }
 
-// NewRiskEventClient returns a client for the RiskEvent from the given config.
-func NewRiskEventClient(c config) *RiskEventClient {
-	return &RiskEventClient{config: c}
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `riskevent.Hooks(f(g(h())))`.
-func (c *RiskEventClient) Use(hooks ...Hook) {
-	c.hooks.RiskEvent = append(c.hooks.RiskEvent, hooks...)
+// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
+func (c *GroupClient) Use(hooks ...Hook) {
+	c.hooks.Group = append(c.hooks.Group, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `riskevent.Intercept(f(g(h())))`.
-func (c *RiskEventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RiskEvent = append(c.inters.RiskEvent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
 }
 
-// Create returns a builder for creating a RiskEvent entity.
-func (c *RiskEventClient) Create() *RiskEventCreate {
-	mutation := newRiskEventMutation(c.config, OpCreate)
-	return &RiskEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RiskEvent entities.
-func (c *RiskEventClient) CreateBulk(builders ...*RiskEventCreate) *RiskEventCreateBulk {
-	return &RiskEventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RiskEventClient) MapCreateBulk(slice any, setFunc func(*RiskEventCreate, int)) *RiskEventCreateBulk {
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RiskEventCreateBulk{err: fmt.Errorf("calling to RiskEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RiskEventCreate, rv.Len())
+	builders := make([]*GroupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RiskEventCreateBulk{config: c.config, builders: builders}
+	return &GroupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RiskEvent.
-func (c *RiskEventClient) Update() *RiskEventUpdate {
-	mutation := newRiskEventMutation(c.config, OpUpdate)
-	return &RiskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RiskEventClient) UpdateOne(_m *RiskEvent) *RiskEventUpdateOne {
-	mutation := newRiskEventMutation(c.config, OpUpdateOne, withRiskEvent(_m))
-	return &RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupClient) UpdateOne(_m *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(_m))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RiskEventClient) UpdateOneID(id int) *RiskEventUpdateOne {
-	mutation := newRiskEventMutation(c.config, OpUpdateOne, withRiskEventID(id))
-	return &RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RiskEvent.
-func (c *RiskEventClient) Delete() *RiskEventDelete {
-	mutation := newRiskEventMutation(c.config, OpDelete)
-	return &RiskEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RiskEventClient) DeleteOne(_m *RiskEvent) *RiskEventDeleteOne {
+func (c *GroupClient) DeleteOne(_m *Group) *GroupDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RiskEventClient) DeleteOneID(id int) *RiskEventDeleteOne {
-	builder := c.Delete().Where(riskevent.ID(id))
+func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RiskEventDeleteOne{builder}
+	return &GroupDeleteOne{builder}
 }
 
-// Query returns a query builder for RiskEvent.
-func (c *RiskEventClient) Query() *RiskEventQuery {
-	return &RiskEventQuery{
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRiskEvent},
+		ctx:    &QueryContext{Type: TypeGroup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RiskEvent entity by its id.
-func (c *RiskEventClient) Get(ctx context.Context, id int) (*RiskEvent, error) {
-	return c.Query().Where(riskevent.ID(id)).Only(ctx)
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RiskEventClient) GetX(ctx context.Context, id int) *RiskEvent {
+func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1641,132 +1828,148 @@ func (c *RiskEventClient) GetX(ctx context.Context, id int) *RiskEvent {
 	return obj
 }
 
+// QueryGroupList queries the group_list edge of a Group.
+func (c *GroupClient) QueryGroupList(_m *Group) *GroupListQuery {
+	query := (&GroupListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(grouplist.Table, grouplist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.GroupListTable, group.GroupListColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *RiskEventClient) Hooks() []Hook {
-	return c.hooks.RiskEvent
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
 }
 
 // Interceptors returns the client interceptors.
-func (c *RiskEventClient) Interceptors() []Interceptor {
-	return c.inters.RiskEvent
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
 }
 
-func (c *RiskEventClient) mutate(ctx context.Context, m *RiskEventMutation) (Value, error) {
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RiskEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RiskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RiskEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown RiskEvent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown Group mutation op: %q", m.Op())
 	}
 }
 
-// RiskRuleClient is a client for the RiskRule schema.
-type RiskRuleClient struct {
+// GroupListClient is a client for the GroupList schema.
+type GroupListClient struct {
 	config
 }
 
-// NewRiskRuleClient returns a client for the RiskRule from the given config.
-func NewRiskRuleClient(c config) *RiskRuleClient {
-	return &RiskRuleClient{config: c}
+// NewGroupListClient returns a client for the GroupList from the given config.
+func NewGroupListClient(c config) *GroupListClient {
+	return &GroupListClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `riskrule.Hooks(f(g(h())))`.
-func (c *RiskRuleClient) Use(hooks ...Hook) {
-	c.hooks.RiskRule = append(c.hooks.RiskRule, hooks...)
+// A call to `Use(f, g, h)` equals to `grouplist.Hooks(f(g(h())))`.
+func (c *GroupListClient) Use(hooks ...Hook) {
+	c.hooks.GroupList = append(c.hooks.GroupList, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `riskrule.Intercept(f(g(h())))`.
-func (c *RiskRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RiskRule = append(c.inters.RiskRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `grouplist.Intercept(f(g(h())))`.
+func (c *GroupListClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupList = append(c.inters.GroupList, interceptors...)
 }
 
-// Create returns a builder for creating a RiskRule entity.
-func (c *RiskRuleClient) Create() *RiskRuleCreate {
-	mutation := newRiskRuleMutation(c.config, OpCreate)
-	return &RiskRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GroupList entity.
+func (c *GroupListClient) Create() *GroupListCreate {
+	mutation := newGroupListMutation(c.config, OpCreate)
+	return &GroupListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RiskRule entities.
-func (c *RiskRuleClient) CreateBulk(builders ...*RiskRuleCreate) *RiskRuleCreateBulk {
-	return &RiskRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GroupList entities.
+func (c *GroupListClient) CreateBulk(builders ...*GroupListCreate) *GroupListCreateBulk {
+	return &GroupListCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RiskRuleClient) MapCreateBulk(slice any, setFunc func(*RiskRuleCreate, int)) *RiskRuleCreateBulk {
+func (c *GroupListClient) MapCreateBulk(slice any, setFunc func(*GroupListCreate, int)) *GroupListCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RiskRuleCreateBulk{err: fmt.Errorf("calling to RiskRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GroupListCreateBulk{err: fmt.Errorf("calling to GroupListClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RiskRuleCreate, rv.Len())
+	builders := make([]*GroupListCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RiskRuleCreateBulk{config: c.config, builders: builders}
+	return &GroupListCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RiskRule.
-func (c *RiskRuleClient) Update() *RiskRuleUpdate {
-	mutation := newRiskRuleMutation(c.config, OpUpdate)
-	return &RiskRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GroupList.
+func (c *GroupListClient) Update() *GroupListUpdate {
+	mutation := newGroupListMutation(c.config, OpUpdate)
+	return &GroupListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RiskRuleClient) UpdateOne(_m *RiskRule) *RiskRuleUpdateOne {
-	mutation := newRiskRuleMutation(c.config, OpUpdateOne, withRiskRule(_m))
-	return &RiskRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupListClient) UpdateOne(_m *GroupList) *GroupListUpdateOne {
+	mutation := newGroupListMutation(c.config, OpUpdateOne, withGroupList(_m))
+	return &GroupListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RiskRuleClient) UpdateOneID(id int) *RiskRuleUpdateOne {
-	mutation := newRiskRuleMutation(c.config, OpUpdateOne, withRiskRuleID(id))
-	return &RiskRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GroupListClient) UpdateOneID(id int) *GroupListUpdateOne {
+	mutation := newGroupListMutation(c.config, OpUpdateOne, withGroupListID(id))
+	return &GroupListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RiskRule.
-func (c *RiskRuleClient) Delete() *RiskRuleDelete {
-	mutation := newRiskRuleMutation(c.config, OpDelete)
-	return &RiskRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GroupList.
+func (c *GroupListClient) Delete() *GroupListDelete {
+	mutation := newGroupListMutation(c.config, OpDelete)
+	return &GroupListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RiskRuleClient) DeleteOne(_m *RiskRule) *RiskRuleDeleteOne {
+func (c *GroupListClient) DeleteOne(_m *GroupList) *GroupListDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RiskRuleClient) DeleteOneID(id int) *RiskRuleDeleteOne {
-	builder := c.Delete().Where(riskrule.ID(id))
+func (c *GroupListClient) DeleteOneID(id int) *GroupListDeleteOne {
+	builder := c.Delete().Where(grouplist.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RiskRuleDeleteOne{builder}
+	return &GroupListDeleteOne{builder}
 }
 
-// Query returns a query builder for RiskRule.
-func (c *RiskRuleClient) Query() *RiskRuleQuery {
-	return &RiskRuleQuery{
+// Query returns a query builder for GroupList.
+func (c *GroupListClient) Query() *GroupListQuery {
+	return &GroupListQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRiskRule},
+		ctx:    &QueryContext{Type: TypeGroupList},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RiskRule entity by its id.
-func (c *RiskRuleClient) Get(ctx context.Context, id int) (*RiskRule, error) {
-	return c.Query().Where(riskrule.ID(id)).Only(ctx)
+// Get returns a GroupList entity by its id.
+func (c *GroupListClient) Get(ctx context.Context, id int) (*GroupList, error) {
+	return c.Query().Where(grouplist.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RiskRuleClient) GetX(ctx context.Context, id int) *RiskRule {
+func (c *GroupListClient) GetX(ctx context.Context, id int) *GroupList {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1774,132 +1977,148 @@ func (c *RiskRuleClient) GetX(ctx context.Context, id int) *RiskRule {
 	return obj
 }
 
-// Hooks returns the client hooks.
-func (c *RiskRuleClient) Hooks() []Hook {
-	return c.hooks.RiskRule
+// QueryGroup queries the group edge of a GroupList.
+func (c *GroupListClient) QueryGroup(_m *GroupList) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouplist.Table, grouplist.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, grouplist.GroupTable, grouplist.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupListClient) Hooks() []Hook {
+	return c.hooks.GroupList
 }
 
 // Interceptors returns the client interceptors.
-func (c *RiskRuleClient) Interceptors() []Interceptor {
-	return c.inters.RiskRule
+func (c *GroupListClient) Interceptors() []Interceptor {
+	return c.inters.GroupList
 }
 
-func (c *RiskRuleClient) mutate(ctx context.Context, m *RiskRuleMutation) (Value, error) {
+func (c *GroupListClient) mutate(ctx context.Context, m *GroupListMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RiskRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupListCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RiskRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupListUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RiskRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RiskRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupListDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown RiskRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown GroupList mutation op: %q", m.Op())
 	}
 }
 
-// SocialPlatformInfoClient is a client for the SocialPlatformInfo schema.
-type SocialPlatformInfoClient struct {
+// IOSScriptCodeClient is a client for the IOSScriptCode schema.
+type IOSScriptCodeClient struct {
 	config
 }
 
-// NewSocialPlatformInfoClient returns a client for the SocialPlatformInfo from the given config.
-func NewSocialPlatformInfoClient(c config) *SocialPlatformInfoClient {
-	return &SocialPlatformInfoClient{config: c}
+// NewIOSScriptCodeClient returns a client for the IOSScriptCode from the given config.
+func NewIOSScriptCodeClient(c config) *IOSScriptCodeClient {
+	return &IOSScriptCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `socialplatforminfo.Hooks(f(g(h())))`.
-func (c *SocialPlatformInfoClient) Use(hooks ...Hook) {
-	c.hooks.SocialPlatformInfo = append(c.hooks.SocialPlatformInfo, hooks...)
+// A call to `Use(f, g, h)` equals to `iosscriptcode.Hooks(f(g(h())))`.
+func (c *IOSScriptCodeClient) Use(hooks ...Hook) {
+	c.hooks.IOSScriptCode = append(c.hooks.IOSScriptCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `socialplatforminfo.Intercept(f(g(h())))`.
-func (c *SocialPlatformInfoClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SocialPlatformInfo = append(c.inters.SocialPlatformInfo, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `iosscriptcode.Intercept(f(g(h())))`.
+func (c *IOSScriptCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IOSScriptCode = append(c.inters.IOSScriptCode, interceptors...)
 }
 
-// Create returns a builder for creating a SocialPlatformInfo entity.
-func (c *SocialPlatformInfoClient) Create() *SocialPlatformInfoCreate {
-	mutation := newSocialPlatformInfoMutation(c.config, OpCreate)
-	return &SocialPlatformInfoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IOSScriptCode entity.
+func (c *IOSScriptCodeClient) Create() *IOSScriptCodeCreate {
+	mutation := newIOSScriptCodeMutation(c.config, OpCreate)
+	return &IOSScriptCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SocialPlatformInfo entities.
-func (c *SocialPlatformInfoClient) CreateBulk(builders ...*SocialPlatformInfoCreate) *SocialPlatformInfoCreateBulk {
-	return &SocialPlatformInfoCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IOSScriptCode entities.
+func (c *IOSScriptCodeClient) CreateBulk(builders ...*IOSScriptCodeCreate) *IOSScriptCodeCreateBulk {
+	return &IOSScriptCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SocialPlatformInfoClient) MapCreateBulk(slice any, setFunc func(*SocialPlatformInfoCreate, int)) *SocialPlatformInfoCreateBulk {
+func (c *IOSScriptCodeClient) MapCreateBulk(slice any, setFunc func(*IOSScriptCodeCreate, int)) *IOSScriptCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SocialPlatformInfoCreateBulk{err: fmt.Errorf("calling to SocialPlatformInfoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IOSScriptCodeCreateBulk{err: fmt.Errorf("calling to IOSScriptCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SocialPlatformInfoCreate, rv.Len())
+	builders := make([]*IOSScriptCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SocialPlatformInfoCreateBulk{config: c.config, builders: builders}
+	return &IOSScriptCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SocialPlatformInfo.
-func (c *SocialPlatformInfoClient) Update() *SocialPlatformInfoUpdate {
-	mutation := newSocialPlatformInfoMutation(c.config, OpUpdate)
-	return &SocialPlatformInfoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IOSScriptCode.
+func (c *IOSScriptCodeClient) Update() *IOSScriptCodeUpdate {
+	mutation := newIOSScriptCodeMutation(c.config, OpUpdate)
+	return &IOSScriptCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SocialPlatformInfoClient) UpdateOne(_m *SocialPlatformInfo) *SocialPlatformInfoUpdateOne {
-	mutation := newSocialPlatformInfoMutation(c.config, OpUpdateOne, withSocialPlatformInfo(_m))
-	return &SocialPlatformInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IOSScriptCodeClient) UpdateOne(_m *IOSScriptCode) *IOSScriptCodeUpdateOne {
+	mutation := newIOSScriptCodeMutation(c.config, OpUpdateOne, withIOSScriptCode(_m))
+	return &IOSScriptCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SocialPlatformInfoClient) UpdateOneID(id int) *SocialPlatformInfoUpdateOne {
-	mutation := newSocialPlatformInfoMutation(c.config, OpUpdateOne, withSocialPlatformInfoID(id))
-	return &SocialPlatformInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IOSScriptCodeClient) UpdateOneID(id int) *IOSScriptCodeUpdateOne {
+	mutation := newIOSScriptCodeMutation(c.config, OpUpdateOne, withIOSScriptCodeID(id))
+	return &IOSScriptCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SocialPlatformInfo.
-func (c *SocialPlatformInfoClient) Delete() *SocialPlatformInfoDelete {
-	mutation := newSocialPlatformInfoMutation(c.config, OpDelete)
-	return &SocialPlatformInfoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IOSScriptCode.
+func (c *IOSScriptCodeClient) Delete() *IOSScriptCodeDelete {
+	mutation := newIOSScriptCodeMutation(c.config, OpDelete)
+	return &IOSScriptCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SocialPlatformInfoClient) DeleteOne(_m *SocialPlatformInfo) *SocialPlatformInfoDeleteOne {
+func (c *IOSScriptCodeClient) DeleteOne(_m *IOSScriptCode) *IOSScriptCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SocialPlatformInfoClient) DeleteOneID(id int) *SocialPlatformInfoDeleteOne {
-	builder := c.Delete().Where(socialplatforminfo.ID(id))
+func (c *IOSScriptCodeClient) DeleteOneID(id int) *IOSScriptCodeDeleteOne {
+	builder := c.Delete().Where(iosscriptcode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SocialPlatformInfoDeleteOne{builder}
+	return &IOSScriptCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for SocialPlatformInfo.
-func (c *SocialPlatformInfoClient) Query() *SocialPlatformInfoQuery {
-	return &SocialPlatformInfoQuery{
+// Query returns a query builder for IOSScriptCode.
+func (c *IOSScriptCodeClient) Query() *IOSScriptCodeQuery {
+	return &IOSScriptCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSocialPlatformInfo},
+		ctx:    &QueryContext{Type: TypeIOSScriptCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SocialPlatformInfo entity by its id.
-func (c *SocialPlatformInfoClient) Get(ctx context.Context, id int) (*SocialPlatformInfo, error) {
-	return c.Query().Where(socialplatforminfo.ID(id)).Only(ctx)
+// Get returns a IOSScriptCode entity by its id.
+func (c *IOSScriptCodeClient) Get(ctx context.Context, id int) (*IOSScriptCode, error) {
+	return c.Query().Where(iosscriptcode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SocialPlatformInfoClient) GetX(ctx context.Context, id int) *SocialPlatformInfo {
+func (c *IOSScriptCodeClient) GetX(ctx context.Context, id int) *IOSScriptCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1907,15 +2126,15 @@ func (c *SocialPlatformInfoClient) GetX(ctx context.Context, id int) *SocialPlat
 	return obj
 }
 
-// QueryUser queries the user edge of a SocialPlatformInfo.
-func (c *SocialPlatformInfoClient) QueryUser(_m *SocialPlatformInfo) *UserQuery {
+// QueryUser queries the user edge of a IOSScriptCode.
+func (c *IOSScriptCodeClient) QueryUser(_m *IOSScriptCode) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(socialplatforminfo.Table, socialplatforminfo.FieldID, id),
+			sqlgraph.From(iosscriptcode.Table, iosscriptcode.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, socialplatforminfo.UserTable, socialplatforminfo.UserColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, iosscriptcode.UserTable, iosscriptcode.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1924,264 +2143,1935 @@ func (c *SocialPlatformInfoClient) QueryUser(_m *SocialPlatformInfo) *UserQuery
 }
 
 // Hooks returns the client hooks.
-func (c *SocialPlatformInfoClient) Hooks() []Hook {
-	return c.hooks.SocialPlatformInfo
+func (c *IOSScriptCodeClient) Hooks() []Hook {
+	return c.hooks.IOSScriptCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *SocialPlatformInfoClient) Interceptors() []Interceptor {
-	return c.inters.SocialPlatformInfo
+func (c *IOSScriptCodeClient) Interceptors() []Interceptor {
+	return c.inters.IOSScriptCode
 }
 
-func (c *SocialPlatformInfoClient) mutate(ctx context.Context, m *SocialPlatformInfoMutation) (Value, error) {
+func (c *IOSScriptCodeClient) mutate(ctx context.Context, m *IOSScriptCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SocialPlatformInfoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IOSScriptCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SocialPlatformInfoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IOSScriptCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SocialPlatformInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IOSScriptCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SocialPlatformInfoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IOSScriptCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown SocialPlatformInfo mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown IOSScriptCode mutation op: %q", m.Op())
 	}
 }
 
-// SponsorClient is a client for the Sponsor schema.
-type SponsorClient struct {
+// InheritJobClient is a client for the InheritJob schema.
+type InheritJobClient struct {
 	config
 }
 
-// NewSponsorClient returns a client for the Sponsor from the given config.
-func NewSponsorClient(c config) *SponsorClient {
-	return &SponsorClient{config: c}
+// NewInheritJobClient returns a client for the InheritJob from the given config.
+func NewInheritJobClient(c config) *InheritJobClient {
+	return &InheritJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `sponsor.Hooks(f(g(h())))`.
-func (c *SponsorClient) Use(hooks ...Hook) {
-	c.hooks.Sponsor = append(c.hooks.Sponsor, hooks...)
+// A call to `Use(f, g, h)` equals to `inheritjob.Hooks(f(g(h())))`.
+func (c *InheritJobClient) Use(hooks ...Hook) {
+	c.hooks.InheritJob = append(c.hooks.InheritJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `sponsor.Intercept(f(g(h())))`.
-func (c *SponsorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Sponsor = append(c.inters.Sponsor, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `inheritjob.Intercept(f(g(h())))`.
+func (c *InheritJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InheritJob = append(c.inters.InheritJob, interceptors...)
 }
 
-// Create returns a builder for creating a Sponsor entity.
-func (c *SponsorClient) Create() *SponsorCreate {
-	mutation := newSponsorMutation(c.config, OpCreate)
-	return &SponsorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InheritJob entity.
+func (c *InheritJobClient) Create() *InheritJobCreate {
+	mutation := newInheritJobMutation(c.config, OpCreate)
+	return &InheritJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Sponsor entities.
-func (c *SponsorClient) CreateBulk(builders ...*SponsorCreate) *SponsorCreateBulk {
-	return &SponsorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InheritJob entities.
+func (c *InheritJobClient) CreateBulk(builders ...*InheritJobCreate) *InheritJobCreateBulk {
+	return &InheritJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SponsorClient) MapCreateBulk(slice any, setFunc func(*SponsorCreate, int)) *SponsorCreateBulk {
+func (c *InheritJobClient) MapCreateBulk(slice any, setFunc func(*InheritJobCreate, int)) *InheritJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SponsorCreateBulk{err: fmt.Errorf("calling to SponsorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InheritJobCreateBulk{err: fmt.Errorf("calling to InheritJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SponsorCreate, rv.Len())
+	builders := make([]*InheritJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SponsorCreateBulk{config: c.config, builders: builders}
+	return &InheritJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Sponsor.
-func (c *SponsorClient) Update() *SponsorUpdate {
-	mutation := newSponsorMutation(c.config, OpUpdate)
-	return &SponsorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InheritJob.
+func (c *InheritJobClient) Update() *InheritJobUpdate {
+	mutation := newInheritJobMutation(c.config, OpUpdate)
+	return &InheritJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SponsorClient) UpdateOne(_m *Sponsor) *SponsorUpdateOne {
-	mutation := newSponsorMutation(c.config, OpUpdateOne, withSponsor(_m))
-	return &SponsorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InheritJobClient) UpdateOne(_m *InheritJob) *InheritJobUpdateOne {
+	mutation := newInheritJobMutation(c.config, OpUpdateOne, withInheritJob(_m))
+	return &InheritJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SponsorClient) UpdateOneID(id string) *SponsorUpdateOne {
-	mutation := newSponsorMutation(c.config, OpUpdateOne, withSponsorID(id))
-	return &SponsorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InheritJobClient) UpdateOneID(id string) *InheritJobUpdateOne {
+	mutation := newInheritJobMutation(c.config, OpUpdateOne, withInheritJobID(id))
+	return &InheritJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Sponsor.
-func (c *SponsorClient) Delete() *SponsorDelete {
-	mutation := newSponsorMutation(c.config, OpDelete)
-	return &SponsorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InheritJob.
+func (c *InheritJobClient) Delete() *InheritJobDelete {
+	mutation := newInheritJobMutation(c.config, OpDelete)
+	return &InheritJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SponsorClient) DeleteOne(_m *Sponsor) *SponsorDeleteOne {
+func (c *InheritJobClient) DeleteOne(_m *InheritJob) *InheritJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SponsorClient) DeleteOneID(id string) *SponsorDeleteOne {
-	builder := c.Delete().Where(sponsor.ID(id))
+func (c *InheritJobClient) DeleteOneID(id string) *InheritJobDeleteOne {
+	builder := c.Delete().Where(inheritjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SponsorDeleteOne{builder}
+	return &InheritJobDeleteOne{builder}
+}
+
+// Query returns a query builder for InheritJob.
+func (c *InheritJobClient) Query() *InheritJobQuery {
+	return &InheritJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInheritJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InheritJob entity by its id.
+func (c *InheritJobClient) Get(ctx context.Context, id string) (*InheritJob, error) {
+	return c.Query().Where(inheritjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InheritJobClient) GetX(ctx context.Context, id string) *InheritJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InheritJobClient) Hooks() []Hook {
+	return c.hooks.InheritJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *InheritJobClient) Interceptors() []Interceptor {
+	return c.inters.InheritJob
+}
+
+func (c *InheritJobClient) mutate(ctx context.Context, m *InheritJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InheritJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InheritJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InheritJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InheritJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown InheritJob mutation op: %q", m.Op())
+	}
+}
+
+// OAuth2ClientWebhookEndpointClient is a client for the OAuth2ClientWebhookEndpoint schema.
+type OAuth2ClientWebhookEndpointClient struct {
+	config
+}
+
+// NewOAuth2ClientWebhookEndpointClient returns a client for the OAuth2ClientWebhookEndpoint from the given config.
+func NewOAuth2ClientWebhookEndpointClient(c config) *OAuth2ClientWebhookEndpointClient {
+	return &OAuth2ClientWebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oauth2clientwebhookendpoint.Hooks(f(g(h())))`.
+func (c *OAuth2ClientWebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.OAuth2ClientWebhookEndpoint = append(c.hooks.OAuth2ClientWebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oauth2clientwebhookendpoint.Intercept(f(g(h())))`.
+func (c *OAuth2ClientWebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuth2ClientWebhookEndpoint = append(c.inters.OAuth2ClientWebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a OAuth2ClientWebhookEndpoint entity.
+func (c *OAuth2ClientWebhookEndpointClient) Create() *OAuth2ClientWebhookEndpointCreate {
+	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpCreate)
+	return &OAuth2ClientWebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OAuth2ClientWebhookEndpoint entities.
+func (c *OAuth2ClientWebhookEndpointClient) CreateBulk(builders ...*OAuth2ClientWebhookEndpointCreate) *OAuth2ClientWebhookEndpointCreateBulk {
+	return &OAuth2ClientWebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OAuth2ClientWebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*OAuth2ClientWebhookEndpointCreate, int)) *OAuth2ClientWebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OAuth2ClientWebhookEndpointCreateBulk{err: fmt.Errorf("calling to OAuth2ClientWebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OAuth2ClientWebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OAuth2ClientWebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OAuth2ClientWebhookEndpoint.
+func (c *OAuth2ClientWebhookEndpointClient) Update() *OAuth2ClientWebhookEndpointUpdate {
+	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpUpdate)
+	return &OAuth2ClientWebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OAuth2ClientWebhookEndpointClient) UpdateOne(_m *OAuth2ClientWebhookEndpoint) *OAuth2ClientWebhookEndpointUpdateOne {
+	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpUpdateOne, withOAuth2ClientWebhookEndpoint(_m))
+	return &OAuth2ClientWebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OAuth2ClientWebhookEndpointClient) UpdateOneID(id string) *OAuth2ClientWebhookEndpointUpdateOne {
+	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpUpdateOne, withOAuth2ClientWebhookEndpointID(id))
+	return &OAuth2ClientWebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OAuth2ClientWebhookEndpoint.
+func (c *OAuth2ClientWebhookEndpointClient) Delete() *OAuth2ClientWebhookEndpointDelete {
+	mutation := newOAuth2ClientWebhookEndpointMutation(c.config, OpDelete)
+	return &OAuth2ClientWebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OAuth2ClientWebhookEndpointClient) DeleteOne(_m *OAuth2ClientWebhookEndpoint) *OAuth2ClientWebhookEndpointDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OAuth2ClientWebhookEndpointClient) DeleteOneID(id string) *OAuth2ClientWebhookEndpointDeleteOne {
+	builder := c.Delete().Where(oauth2clientwebhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OAuth2ClientWebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for OAuth2ClientWebhookEndpoint.
+func (c *OAuth2ClientWebhookEndpointClient) Query() *OAuth2ClientWebhookEndpointQuery {
+	return &OAuth2ClientWebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOAuth2ClientWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OAuth2ClientWebhookEndpoint entity by its id.
+func (c *OAuth2ClientWebhookEndpointClient) Get(ctx context.Context, id string) (*OAuth2ClientWebhookEndpoint, error) {
+	return c.Query().Where(oauth2clientwebhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OAuth2ClientWebhookEndpointClient) GetX(ctx context.Context, id string) *OAuth2ClientWebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OAuth2ClientWebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.OAuth2ClientWebhookEndpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *OAuth2ClientWebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.OAuth2ClientWebhookEndpoint
+}
+
+func (c *OAuth2ClientWebhookEndpointClient) mutate(ctx context.Context, m *OAuth2ClientWebhookEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OAuth2ClientWebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OAuth2ClientWebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OAuth2ClientWebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OAuth2ClientWebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown OAuth2ClientWebhookEndpoint mutation op: %q", m.Op())
+	}
+}
+
+// RiskEventClient is a client for the RiskEvent schema.
+type RiskEventClient struct {
+	config
+}
+
+// NewRiskEventClient returns a client for the RiskEvent from the given config.
+func NewRiskEventClient(c config) *RiskEventClient {
+	return &RiskEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `riskevent.Hooks(f(g(h())))`.
+func (c *RiskEventClient) Use(hooks ...Hook) {
+	c.hooks.RiskEvent = append(c.hooks.RiskEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `riskevent.Intercept(f(g(h())))`.
+func (c *RiskEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RiskEvent = append(c.inters.RiskEvent, interceptors...)
+}
+
+// Create returns a builder for creating a RiskEvent entity.
+func (c *RiskEventClient) Create() *RiskEventCreate {
+	mutation := newRiskEventMutation(c.config, OpCreate)
+	return &RiskEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RiskEvent entities.
+func (c *RiskEventClient) CreateBulk(builders ...*RiskEventCreate) *RiskEventCreateBulk {
+	return &RiskEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RiskEventClient) MapCreateBulk(slice any, setFunc func(*RiskEventCreate, int)) *RiskEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RiskEventCreateBulk{err: fmt.Errorf("calling to RiskEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RiskEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RiskEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RiskEvent.
+func (c *RiskEventClient) Update() *RiskEventUpdate {
+	mutation := newRiskEventMutation(c.config, OpUpdate)
+	return &RiskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RiskEventClient) UpdateOne(_m *RiskEvent) *RiskEventUpdateOne {
+	mutation := newRiskEventMutation(c.config, OpUpdateOne, withRiskEvent(_m))
+	return &RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RiskEventClient) UpdateOneID(id int) *RiskEventUpdateOne {
+	mutation := newRiskEventMutation(c.config, OpUpdateOne, withRiskEventID(id))
+	return &RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RiskEvent.
+func (c *RiskEventClient) Delete() *RiskEventDelete {
+	mutation := newRiskEventMutation(c.config, OpDelete)
+	return &RiskEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RiskEventClient) DeleteOne(_m *RiskEvent) *RiskEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RiskEventClient) DeleteOneID(id int) *RiskEventDeleteOne {
+	builder := c.Delete().Where(riskevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RiskEventDeleteOne{builder}
+}
+
+// Query returns a query builder for RiskEvent.
+func (c *RiskEventClient) Query() *RiskEventQuery {
+	return &RiskEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRiskEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RiskEvent entity by its id.
+func (c *RiskEventClient) Get(ctx context.Context, id int) (*RiskEvent, error) {
+	return c.Query().Where(riskevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RiskEventClient) GetX(ctx context.Context, id int) *RiskEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RiskEventClient) Hooks() []Hook {
+	return c.hooks.RiskEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *RiskEventClient) Interceptors() []Interceptor {
+	return c.inters.RiskEvent
+}
+
+func (c *RiskEventClient) mutate(ctx context.Context, m *RiskEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RiskEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RiskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RiskEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown RiskEvent mutation op: %q", m.Op())
+	}
+}
+
+// RiskRuleClient is a client for the RiskRule schema.
+type RiskRuleClient struct {
+	config
+}
+
+// NewRiskRuleClient returns a client for the RiskRule from the given config.
+func NewRiskRuleClient(c config) *RiskRuleClient {
+	return &RiskRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `riskrule.Hooks(f(g(h())))`.
+func (c *RiskRuleClient) Use(hooks ...Hook) {
+	c.hooks.RiskRule = append(c.hooks.RiskRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `riskrule.Intercept(f(g(h())))`.
+func (c *RiskRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RiskRule = append(c.inters.RiskRule, interceptors...)
+}
+
+// Create returns a builder for creating a RiskRule entity.
+func (c *RiskRuleClient) Create() *RiskRuleCreate {
+	mutation := newRiskRuleMutation(c.config, OpCreate)
+	return &RiskRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RiskRule entities.
+func (c *RiskRuleClient) CreateBulk(builders ...*RiskRuleCreate) *RiskRuleCreateBulk {
+	return &RiskRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RiskRuleClient) MapCreateBulk(slice any, setFunc func(*RiskRuleCreate, int)) *RiskRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RiskRuleCreateBulk{err: fmt.Errorf("calling to RiskRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RiskRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RiskRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RiskRule.
+func (c *RiskRuleClient) Update() *RiskRuleUpdate {
+	mutation := newRiskRuleMutation(c.config, OpUpdate)
+	return &RiskRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RiskRuleClient) UpdateOne(_m *RiskRule) *RiskRuleUpdateOne {
+	mutation := newRiskRuleMutation(c.config, OpUpdateOne, withRiskRule(_m))
+	return &RiskRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RiskRuleClient) UpdateOneID(id int) *RiskRuleUpdateOne {
+	mutation := newRiskRuleMutation(c.config, OpUpdateOne, withRiskRuleID(id))
+	return &RiskRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RiskRule.
+func (c *RiskRuleClient) Delete() *RiskRuleDelete {
+	mutation := newRiskRuleMutation(c.config, OpDelete)
+	return &RiskRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RiskRuleClient) DeleteOne(_m *RiskRule) *RiskRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RiskRuleClient) DeleteOneID(id int) *RiskRuleDeleteOne {
+	builder := c.Delete().Where(riskrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RiskRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RiskRule.
+func (c *RiskRuleClient) Query() *RiskRuleQuery {
+	return &RiskRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRiskRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RiskRule entity by its id.
+func (c *RiskRuleClient) Get(ctx context.Context, id int) (*RiskRule, error) {
+	return c.Query().Where(riskrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RiskRuleClient) GetX(ctx context.Context, id int) *RiskRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RiskRuleClient) Hooks() []Hook {
+	return c.hooks.RiskRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *RiskRuleClient) Interceptors() []Interceptor {
+	return c.inters.RiskRule
+}
+
+func (c *RiskRuleClient) mutate(ctx context.Context, m *RiskRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RiskRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RiskRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RiskRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RiskRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown RiskRule mutation op: %q", m.Op())
+	}
+}
+
+// SocialPlatformInfoClient is a client for the SocialPlatformInfo schema.
+type SocialPlatformInfoClient struct {
+	config
+}
+
+// NewSocialPlatformInfoClient returns a client for the SocialPlatformInfo from the given config.
+func NewSocialPlatformInfoClient(c config) *SocialPlatformInfoClient {
+	return &SocialPlatformInfoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `socialplatforminfo.Hooks(f(g(h())))`.
+func (c *SocialPlatformInfoClient) Use(hooks ...Hook) {
+	c.hooks.SocialPlatformInfo = append(c.hooks.SocialPlatformInfo, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `socialplatforminfo.Intercept(f(g(h())))`.
+func (c *SocialPlatformInfoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SocialPlatformInfo = append(c.inters.SocialPlatformInfo, interceptors...)
+}
+
+// Create returns a builder for creating a SocialPlatformInfo entity.
+func (c *SocialPlatformInfoClient) Create() *SocialPlatformInfoCreate {
+	mutation := newSocialPlatformInfoMutation(c.config, OpCreate)
+	return &SocialPlatformInfoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SocialPlatformInfo entities.
+func (c *SocialPlatformInfoClient) CreateBulk(builders ...*SocialPlatformInfoCreate) *SocialPlatformInfoCreateBulk {
+	return &SocialPlatformInfoCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SocialPlatformInfoClient) MapCreateBulk(slice any, setFunc func(*SocialPlatformInfoCreate, int)) *SocialPlatformInfoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SocialPlatformInfoCreateBulk{err: fmt.Errorf("calling to SocialPlatformInfoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SocialPlatformInfoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SocialPlatformInfoCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SocialPlatformInfo.
+func (c *SocialPlatformInfoClient) Update() *SocialPlatformInfoUpdate {
+	mutation := newSocialPlatformInfoMutation(c.config, OpUpdate)
+	return &SocialPlatformInfoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SocialPlatformInfoClient) UpdateOne(_m *SocialPlatformInfo) *SocialPlatformInfoUpdateOne {
+	mutation := newSocialPlatformInfoMutation(c.config, OpUpdateOne, withSocialPlatformInfo(_m))
+	return &SocialPlatformInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SocialPlatformInfoClient) UpdateOneID(id int) *SocialPlatformInfoUpdateOne {
+	mutation := newSocialPlatformInfoMutation(c.config, OpUpdateOne, withSocialPlatformInfoID(id))
+	return &SocialPlatformInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SocialPlatformInfo.
+func (c *SocialPlatformInfoClient) Delete() *SocialPlatformInfoDelete {
+	mutation := newSocialPlatformInfoMutation(c.config, OpDelete)
+	return &SocialPlatformInfoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SocialPlatformInfoClient) DeleteOne(_m *SocialPlatformInfo) *SocialPlatformInfoDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SocialPlatformInfoClient) DeleteOneID(id int) *SocialPlatformInfoDeleteOne {
+	builder := c.Delete().Where(socialplatforminfo.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SocialPlatformInfoDeleteOne{builder}
+}
+
+// Query returns a query builder for SocialPlatformInfo.
+func (c *SocialPlatformInfoClient) Query() *SocialPlatformInfoQuery {
+	return &SocialPlatformInfoQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSocialPlatformInfo},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SocialPlatformInfo entity by its id.
+func (c *SocialPlatformInfoClient) Get(ctx context.Context, id int) (*SocialPlatformInfo, error) {
+	return c.Query().Where(socialplatforminfo.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SocialPlatformInfoClient) GetX(ctx context.Context, id int) *SocialPlatformInfo {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a SocialPlatformInfo.
+func (c *SocialPlatformInfoClient) QueryUser(_m *SocialPlatformInfo) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(socialplatforminfo.Table, socialplatforminfo.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, socialplatforminfo.UserTable, socialplatforminfo.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SocialPlatformInfoClient) Hooks() []Hook {
+	return c.hooks.SocialPlatformInfo
+}
+
+// Interceptors returns the client interceptors.
+func (c *SocialPlatformInfoClient) Interceptors() []Interceptor {
+	return c.inters.SocialPlatformInfo
+}
+
+func (c *SocialPlatformInfoClient) mutate(ctx context.Context, m *SocialPlatformInfoMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SocialPlatformInfoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SocialPlatformInfoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SocialPlatformInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SocialPlatformInfoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown SocialPlatformInfo mutation op: %q", m.Op())
+	}
+}
+
+// SponsorClient is a client for the Sponsor schema.
+type SponsorClient struct {
+	config
+}
+
+// NewSponsorClient returns a client for the Sponsor from the given config.
+func NewSponsorClient(c config) *SponsorClient {
+	return &SponsorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sponsor.Hooks(f(g(h())))`.
+func (c *SponsorClient) Use(hooks ...Hook) {
+	c.hooks.Sponsor = append(c.hooks.Sponsor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sponsor.Intercept(f(g(h())))`.
+func (c *SponsorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sponsor = append(c.inters.Sponsor, interceptors...)
+}
+
+// Create returns a builder for creating a Sponsor entity.
+func (c *SponsorClient) Create() *SponsorCreate {
+	mutation := newSponsorMutation(c.config, OpCreate)
+	return &SponsorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sponsor entities.
+func (c *SponsorClient) CreateBulk(builders ...*SponsorCreate) *SponsorCreateBulk {
+	return &SponsorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SponsorClient) MapCreateBulk(slice any, setFunc func(*SponsorCreate, int)) *SponsorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SponsorCreateBulk{err: fmt.Errorf("calling to SponsorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SponsorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SponsorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sponsor.
+func (c *SponsorClient) Update() *SponsorUpdate {
+	mutation := newSponsorMutation(c.config, OpUpdate)
+	return &SponsorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SponsorClient) UpdateOne(_m *Sponsor) *SponsorUpdateOne {
+	mutation := newSponsorMutation(c.config, OpUpdateOne, withSponsor(_m))
+	return &SponsorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SponsorClient) UpdateOneID(id string) *SponsorUpdateOne {
+	mutation := newSponsorMutation(c.config, OpUpdateOne, withSponsorID(id))
+	return &SponsorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sponsor.
+func (c *SponsorClient) Delete() *SponsorDelete {
+	mutation := newSponsorMutation(c.config, OpDelete)
+	return &SponsorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SponsorClient) DeleteOne(_m *Sponsor) *SponsorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SponsorClient) DeleteOneID(id string) *SponsorDeleteOne {
+	builder := c.Delete().Where(sponsor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SponsorDeleteOne{builder}
+}
+
+// Query returns a query builder for Sponsor.
+func (c *SponsorClient) Query() *SponsorQuery {
+	return &SponsorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSponsor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sponsor entity by its id.
+func (c *SponsorClient) Get(ctx context.Context, id string) (*Sponsor, error) {
+	return c.Query().Where(sponsor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SponsorClient) GetX(ctx context.Context, id string) *Sponsor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SponsorClient) Hooks() []Hook {
+	return c.hooks.Sponsor
+}
+
+// Interceptors returns the client interceptors.
+func (c *SponsorClient) Interceptors() []Interceptor {
+	return c.inters.Sponsor
+}
+
+func (c *SponsorClient) mutate(ctx context.Context, m *SponsorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SponsorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SponsorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SponsorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SponsorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown Sponsor mutation op: %q", m.Op())
+	}
+}
+
+// SystemLogClient is a client for the SystemLog schema.
+type SystemLogClient struct {
+	config
+}
+
+// NewSystemLogClient returns a client for the SystemLog from the given config.
+func NewSystemLogClient(c config) *SystemLogClient {
+	return &SystemLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `systemlog.Hooks(f(g(h())))`.
+func (c *SystemLogClient) Use(hooks ...Hook) {
+	c.hooks.SystemLog = append(c.hooks.SystemLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `systemlog.Intercept(f(g(h())))`.
+func (c *SystemLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SystemLog = append(c.inters.SystemLog, interceptors...)
+}
+
+// Create returns a builder for creating a SystemLog entity.
+func (c *SystemLogClient) Create() *SystemLogCreate {
+	mutation := newSystemLogMutation(c.config, OpCreate)
+	return &SystemLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SystemLog entities.
+func (c *SystemLogClient) CreateBulk(builders ...*SystemLogCreate) *SystemLogCreateBulk {
+	return &SystemLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SystemLogClient) MapCreateBulk(slice any, setFunc func(*SystemLogCreate, int)) *SystemLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SystemLogCreateBulk{err: fmt.Errorf("calling to SystemLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SystemLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SystemLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SystemLog.
+func (c *SystemLogClient) Update() *SystemLogUpdate {
+	mutation := newSystemLogMutation(c.config, OpUpdate)
+	return &SystemLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SystemLogClient) UpdateOne(_m *SystemLog) *SystemLogUpdateOne {
+	mutation := newSystemLogMutation(c.config, OpUpdateOne, withSystemLog(_m))
+	return &SystemLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SystemLogClient) UpdateOneID(id int) *SystemLogUpdateOne {
+	mutation := newSystemLogMutation(c.config, OpUpdateOne, withSystemLogID(id))
+	return &SystemLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SystemLog.
+func (c *SystemLogClient) Delete() *SystemLogDelete {
+	mutation := newSystemLogMutation(c.config, OpDelete)
+	return &SystemLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SystemLogClient) DeleteOne(_m *SystemLog) *SystemLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SystemLogClient) DeleteOneID(id int) *SystemLogDeleteOne {
+	builder := c.Delete().Where(systemlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SystemLogDeleteOne{builder}
+}
+
+// Query returns a query builder for SystemLog.
+func (c *SystemLogClient) Query() *SystemLogQuery {
+	return &SystemLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSystemLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SystemLog entity by its id.
+func (c *SystemLogClient) Get(ctx context.Context, id int) (*SystemLog, error) {
+	return c.Query().Where(systemlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SystemLogClient) GetX(ctx context.Context, id int) *SystemLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SystemLogClient) Hooks() []Hook {
+	return c.hooks.SystemLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *SystemLogClient) Interceptors() []Interceptor {
+	return c.inters.SystemLog
+}
+
+func (c *SystemLogClient) mutate(ctx context.Context, m *SystemLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SystemLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SystemLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SystemLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SystemLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown SystemLog mutation op: %q", m.Op())
+	}
+}
+
+// TicketClient is a client for the Ticket schema.
+type TicketClient struct {
+	config
+}
+
+// NewTicketClient returns a client for the Ticket from the given config.
+func NewTicketClient(c config) *TicketClient {
+	return &TicketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ticket.Hooks(f(g(h())))`.
+func (c *TicketClient) Use(hooks ...Hook) {
+	c.hooks.Ticket = append(c.hooks.Ticket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ticket.Intercept(f(g(h())))`.
+func (c *TicketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ticket = append(c.inters.Ticket, interceptors...)
+}
+
+// Create returns a builder for creating a Ticket entity.
+func (c *TicketClient) Create() *TicketCreate {
+	mutation := newTicketMutation(c.config, OpCreate)
+	return &TicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ticket entities.
+func (c *TicketClient) CreateBulk(builders ...*TicketCreate) *TicketCreateBulk {
+	return &TicketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TicketClient) MapCreateBulk(slice any, setFunc func(*TicketCreate, int)) *TicketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TicketCreateBulk{err: fmt.Errorf("calling to TicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TicketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TicketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ticket.
+func (c *TicketClient) Update() *TicketUpdate {
+	mutation := newTicketMutation(c.config, OpUpdate)
+	return &TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TicketClient) UpdateOne(_m *Ticket) *TicketUpdateOne {
+	mutation := newTicketMutation(c.config, OpUpdateOne, withTicket(_m))
+	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TicketClient) UpdateOneID(id int) *TicketUpdateOne {
+	mutation := newTicketMutation(c.config, OpUpdateOne, withTicketID(id))
+	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ticket.
+func (c *TicketClient) Delete() *TicketDelete {
+	mutation := newTicketMutation(c.config, OpDelete)
+	return &TicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TicketClient) DeleteOne(_m *Ticket) *TicketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TicketClient) DeleteOneID(id int) *TicketDeleteOne {
+	builder := c.Delete().Where(ticket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TicketDeleteOne{builder}
+}
+
+// Query returns a query builder for Ticket.
+func (c *TicketClient) Query() *TicketQuery {
+	return &TicketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTicket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ticket entity by its id.
+func (c *TicketClient) Get(ctx context.Context, id int) (*Ticket, error) {
+	return c.Query().Where(ticket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TicketClient) GetX(ctx context.Context, id int) *Ticket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessages queries the messages edge of a Ticket.
+func (c *TicketClient) QueryMessages(_m *Ticket) *TicketMessageQuery {
+	query := (&TicketMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, id),
+			sqlgraph.To(ticketmessage.Table, ticketmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.MessagesTable, ticket.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Ticket.
+func (c *TicketClient) QueryAttachments(_m *Ticket) *TicketAttachmentQuery {
+	query := (&TicketAttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, id),
+			sqlgraph.To(ticketattachment.Table, ticketattachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.AttachmentsTable, ticket.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TicketClient) Hooks() []Hook {
+	return c.hooks.Ticket
+}
+
+// Interceptors returns the client interceptors.
+func (c *TicketClient) Interceptors() []Interceptor {
+	return c.inters.Ticket
+}
+
+func (c *TicketClient) mutate(ctx context.Context, m *TicketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown Ticket mutation op: %q", m.Op())
+	}
+}
+
+// TicketAttachmentClient is a client for the TicketAttachment schema.
+type TicketAttachmentClient struct {
+	config
+}
+
+// NewTicketAttachmentClient returns a client for the TicketAttachment from the given config.
+func NewTicketAttachmentClient(c config) *TicketAttachmentClient {
+	return &TicketAttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ticketattachment.Hooks(f(g(h())))`.
+func (c *TicketAttachmentClient) Use(hooks ...Hook) {
+	c.hooks.TicketAttachment = append(c.hooks.TicketAttachment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ticketattachment.Intercept(f(g(h())))`.
+func (c *TicketAttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TicketAttachment = append(c.inters.TicketAttachment, interceptors...)
+}
+
+// Create returns a builder for creating a TicketAttachment entity.
+func (c *TicketAttachmentClient) Create() *TicketAttachmentCreate {
+	mutation := newTicketAttachmentMutation(c.config, OpCreate)
+	return &TicketAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TicketAttachment entities.
+func (c *TicketAttachmentClient) CreateBulk(builders ...*TicketAttachmentCreate) *TicketAttachmentCreateBulk {
+	return &TicketAttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TicketAttachmentClient) MapCreateBulk(slice any, setFunc func(*TicketAttachmentCreate, int)) *TicketAttachmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TicketAttachmentCreateBulk{err: fmt.Errorf("calling to TicketAttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TicketAttachmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TicketAttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TicketAttachment.
+func (c *TicketAttachmentClient) Update() *TicketAttachmentUpdate {
+	mutation := newTicketAttachmentMutation(c.config, OpUpdate)
+	return &TicketAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TicketAttachmentClient) UpdateOne(_m *TicketAttachment) *TicketAttachmentUpdateOne {
+	mutation := newTicketAttachmentMutation(c.config, OpUpdateOne, withTicketAttachment(_m))
+	return &TicketAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TicketAttachmentClient) UpdateOneID(id string) *TicketAttachmentUpdateOne {
+	mutation := newTicketAttachmentMutation(c.config, OpUpdateOne, withTicketAttachmentID(id))
+	return &TicketAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TicketAttachment.
+func (c *TicketAttachmentClient) Delete() *TicketAttachmentDelete {
+	mutation := newTicketAttachmentMutation(c.config, OpDelete)
+	return &TicketAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TicketAttachmentClient) DeleteOne(_m *TicketAttachment) *TicketAttachmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TicketAttachmentClient) DeleteOneID(id string) *TicketAttachmentDeleteOne {
+	builder := c.Delete().Where(ticketattachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TicketAttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for TicketAttachment.
+func (c *TicketAttachmentClient) Query() *TicketAttachmentQuery {
+	return &TicketAttachmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTicketAttachment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TicketAttachment entity by its id.
+func (c *TicketAttachmentClient) Get(ctx context.Context, id string) (*TicketAttachment, error) {
+	return c.Query().Where(ticketattachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TicketAttachmentClient) GetX(ctx context.Context, id string) *TicketAttachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTicket queries the ticket edge of a TicketAttachment.
+func (c *TicketAttachmentClient) QueryTicket(_m *TicketAttachment) *TicketQuery {
+	query := (&TicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticketattachment.Table, ticketattachment.FieldID, id),
+			sqlgraph.To(ticket.Table, ticket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ticketattachment.TicketTable, ticketattachment.TicketColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a TicketAttachment.
+func (c *TicketAttachmentClient) QueryMessage(_m *TicketAttachment) *TicketMessageQuery {
+	query := (&TicketMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticketattachment.Table, ticketattachment.FieldID, id),
+			sqlgraph.To(ticketmessage.Table, ticketmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ticketattachment.MessageTable, ticketattachment.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TicketAttachmentClient) Hooks() []Hook {
+	return c.hooks.TicketAttachment
+}
+
+// Interceptors returns the client interceptors.
+func (c *TicketAttachmentClient) Interceptors() []Interceptor {
+	return c.inters.TicketAttachment
+}
+
+func (c *TicketAttachmentClient) mutate(ctx context.Context, m *TicketAttachmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TicketAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TicketAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TicketAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TicketAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown TicketAttachment mutation op: %q", m.Op())
+	}
+}
+
+// TicketMessageClient is a client for the TicketMessage schema.
+type TicketMessageClient struct {
+	config
+}
+
+// NewTicketMessageClient returns a client for the TicketMessage from the given config.
+func NewTicketMessageClient(c config) *TicketMessageClient {
+	return &TicketMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ticketmessage.Hooks(f(g(h())))`.
+func (c *TicketMessageClient) Use(hooks ...Hook) {
+	c.hooks.TicketMessage = append(c.hooks.TicketMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ticketmessage.Intercept(f(g(h())))`.
+func (c *TicketMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TicketMessage = append(c.inters.TicketMessage, interceptors...)
+}
+
+// Create returns a builder for creating a TicketMessage entity.
+func (c *TicketMessageClient) Create() *TicketMessageCreate {
+	mutation := newTicketMessageMutation(c.config, OpCreate)
+	return &TicketMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TicketMessage entities.
+func (c *TicketMessageClient) CreateBulk(builders ...*TicketMessageCreate) *TicketMessageCreateBulk {
+	return &TicketMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TicketMessageClient) MapCreateBulk(slice any, setFunc func(*TicketMessageCreate, int)) *TicketMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TicketMessageCreateBulk{err: fmt.Errorf("calling to TicketMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TicketMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TicketMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TicketMessage.
+func (c *TicketMessageClient) Update() *TicketMessageUpdate {
+	mutation := newTicketMessageMutation(c.config, OpUpdate)
+	return &TicketMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TicketMessageClient) UpdateOne(_m *TicketMessage) *TicketMessageUpdateOne {
+	mutation := newTicketMessageMutation(c.config, OpUpdateOne, withTicketMessage(_m))
+	return &TicketMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TicketMessageClient) UpdateOneID(id int) *TicketMessageUpdateOne {
+	mutation := newTicketMessageMutation(c.config, OpUpdateOne, withTicketMessageID(id))
+	return &TicketMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TicketMessage.
+func (c *TicketMessageClient) Delete() *TicketMessageDelete {
+	mutation := newTicketMessageMutation(c.config, OpDelete)
+	return &TicketMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TicketMessageClient) DeleteOne(_m *TicketMessage) *TicketMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TicketMessageClient) DeleteOneID(id int) *TicketMessageDeleteOne {
+	builder := c.Delete().Where(ticketmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TicketMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for TicketMessage.
+func (c *TicketMessageClient) Query() *TicketMessageQuery {
+	return &TicketMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTicketMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TicketMessage entity by its id.
+func (c *TicketMessageClient) Get(ctx context.Context, id int) (*TicketMessage, error) {
+	return c.Query().Where(ticketmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TicketMessageClient) GetX(ctx context.Context, id int) *TicketMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTicket queries the ticket edge of a TicketMessage.
+func (c *TicketMessageClient) QueryTicket(_m *TicketMessage) *TicketQuery {
+	query := (&TicketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticketmessage.Table, ticketmessage.FieldID, id),
+			sqlgraph.To(ticket.Table, ticket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ticketmessage.TicketTable, ticketmessage.TicketColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a TicketMessage.
+func (c *TicketMessageClient) QueryAttachments(_m *TicketMessage) *TicketAttachmentQuery {
+	query := (&TicketAttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticketmessage.Table, ticketmessage.FieldID, id),
+			sqlgraph.To(ticketattachment.Table, ticketattachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticketmessage.AttachmentsTable, ticketmessage.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TicketMessageClient) Hooks() []Hook {
+	return c.hooks.TicketMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *TicketMessageClient) Interceptors() []Interceptor {
+	return c.inters.TicketMessage
+}
+
+func (c *TicketMessageClient) mutate(ctx context.Context, m *TicketMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TicketMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TicketMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TicketMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TicketMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown TicketMessage mutation op: %q", m.Op())
+	}
+}
+
+// UploadLogClient is a client for the UploadLog schema.
+type UploadLogClient struct {
+	config
+}
+
+// NewUploadLogClient returns a client for the UploadLog from the given config.
+func NewUploadLogClient(c config) *UploadLogClient {
+	return &UploadLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `uploadlog.Hooks(f(g(h())))`.
+func (c *UploadLogClient) Use(hooks ...Hook) {
+	c.hooks.UploadLog = append(c.hooks.UploadLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `uploadlog.Intercept(f(g(h())))`.
+func (c *UploadLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UploadLog = append(c.inters.UploadLog, interceptors...)
+}
+
+// Create returns a builder for creating a UploadLog entity.
+func (c *UploadLogClient) Create() *UploadLogCreate {
+	mutation := newUploadLogMutation(c.config, OpCreate)
+	return &UploadLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UploadLog entities.
+func (c *UploadLogClient) CreateBulk(builders ...*UploadLogCreate) *UploadLogCreateBulk {
+	return &UploadLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UploadLogClient) MapCreateBulk(slice any, setFunc func(*UploadLogCreate, int)) *UploadLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UploadLogCreateBulk{err: fmt.Errorf("calling to UploadLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UploadLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UploadLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UploadLog.
+func (c *UploadLogClient) Update() *UploadLogUpdate {
+	mutation := newUploadLogMutation(c.config, OpUpdate)
+	return &UploadLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UploadLogClient) UpdateOne(_m *UploadLog) *UploadLogUpdateOne {
+	mutation := newUploadLogMutation(c.config, OpUpdateOne, withUploadLog(_m))
+	return &UploadLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UploadLogClient) UpdateOneID(id int) *UploadLogUpdateOne {
+	mutation := newUploadLogMutation(c.config, OpUpdateOne, withUploadLogID(id))
+	return &UploadLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UploadLog.
+func (c *UploadLogClient) Delete() *UploadLogDelete {
+	mutation := newUploadLogMutation(c.config, OpDelete)
+	return &UploadLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UploadLogClient) DeleteOne(_m *UploadLog) *UploadLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UploadLogClient) DeleteOneID(id int) *UploadLogDeleteOne {
+	builder := c.Delete().Where(uploadlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UploadLogDeleteOne{builder}
+}
+
+// Query returns a query builder for UploadLog.
+func (c *UploadLogClient) Query() *UploadLogQuery {
+	return &UploadLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUploadLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UploadLog entity by its id.
+func (c *UploadLogClient) Get(ctx context.Context, id int) (*UploadLog, error) {
+	return c.Query().Where(uploadlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UploadLogClient) GetX(ctx context.Context, id int) *UploadLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UploadLogClient) Hooks() []Hook {
+	return c.hooks.UploadLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *UploadLogClient) Interceptors() []Interceptor {
+	return c.inters.UploadLog
+}
+
+func (c *UploadLogClient) mutate(ctx context.Context, m *UploadLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UploadLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UploadLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UploadLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UploadLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("postgresql: unknown UploadLog mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id string) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySocialPlatformInfo queries the social_platform_info edge of a User.
+func (c *UserClient) QuerySocialPlatformInfo(_m *User) *SocialPlatformInfoQuery {
+	query := (&SocialPlatformInfoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(socialplatforminfo.Table, socialplatforminfo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.SocialPlatformInfoTable, user.SocialPlatformInfoColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthorizedSocialPlatforms queries the authorized_social_platforms edge of a User.
+func (c *UserClient) QueryAuthorizedSocialPlatforms(_m *User) *AuthorizeSocialPlatformInfoQuery {
+	query := (&AuthorizeSocialPlatformInfoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(authorizesocialplatforminfo.Table, authorizesocialplatforminfo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthorizedSocialPlatformsTable, user.AuthorizedSocialPlatformsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGameAccountBindings queries the game_account_bindings edge of a User.
+func (c *UserClient) QueryGameAccountBindings(_m *User) *GameAccountBindingQuery {
+	query := (&GameAccountBindingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(gameaccountbinding.Table, gameaccountbinding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GameAccountBindingsTable, user.GameAccountBindingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Query returns a query builder for Sponsor.
-func (c *SponsorClient) Query() *SponsorQuery {
-	return &SponsorQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeSponsor},
-		inters: c.Interceptors(),
+// QueryGameAccountDataGrantsOwned queries the game_account_data_grants_owned edge of a User.
+func (c *UserClient) QueryGameAccountDataGrantsOwned(_m *User) *GameAccountDataGrantQuery {
+	query := (&GameAccountDataGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GameAccountDataGrantsOwnedTable, user.GameAccountDataGrantsOwnedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// Get returns a Sponsor entity by its id.
-func (c *SponsorClient) Get(ctx context.Context, id string) (*Sponsor, error) {
-	return c.Query().Where(sponsor.ID(id)).Only(ctx)
+// QueryGameAccountDataGrantsReceived queries the game_account_data_grants_received edge of a User.
+func (c *UserClient) QueryGameAccountDataGrantsReceived(_m *User) *GameAccountDataGrantQuery {
+	query := (&GameAccountDataGrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GameAccountDataGrantsReceivedTable, user.GameAccountDataGrantsReceivedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *SponsorClient) GetX(ctx context.Context, id string) *Sponsor {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// QueryIosScriptCode queries the ios_script_code edge of a User.
+func (c *UserClient) QueryIosScriptCode(_m *User) *IOSScriptCodeQuery {
+	query := (&IOSScriptCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(iosscriptcode.Table, iosscriptcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.IosScriptCodeTable, user.IosScriptCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SponsorClient) Hooks() []Hook {
-	return c.hooks.Sponsor
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
 }
 
 // Interceptors returns the client interceptors.
-func (c *SponsorClient) Interceptors() []Interceptor {
-	return c.inters.Sponsor
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
 }
 
-func (c *SponsorClient) mutate(ctx context.Context, m *SponsorMutation) (Value, error) {
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SponsorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SponsorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SponsorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SponsorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown Sponsor mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown User mutation op: %q", m.Op())
 	}
 }
 
-// SystemLogClient is a client for the SystemLog schema.
-type SystemLogClient struct {
+// UserRecoveryCodeClient is a client for the UserRecoveryCode schema.
+type UserRecoveryCodeClient struct {
 	config
 }
 
-// NewSystemLogClient returns a client for the SystemLog from the given config.
-func NewSystemLogClient(c config) *SystemLogClient {
-	return &SystemLogClient{config: c}
+// NewUserRecoveryCodeClient returns a client for the UserRecoveryCode from the given config.
+func NewUserRecoveryCodeClient(c config) *UserRecoveryCodeClient {
+	return &UserRecoveryCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `systemlog.Hooks(f(g(h())))`.
-func (c *SystemLogClient) Use(hooks ...Hook) {
-	c.hooks.SystemLog = append(c.hooks.SystemLog, hooks...)
+// A call to `Use(f, g, h)` equals to `userrecoverycode.Hooks(f(g(h())))`.
+func (c *UserRecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.UserRecoveryCode = append(c.hooks.UserRecoveryCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `systemlog.Intercept(f(g(h())))`.
-func (c *SystemLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SystemLog = append(c.inters.SystemLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `userrecoverycode.Intercept(f(g(h())))`.
+func (c *UserRecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserRecoveryCode = append(c.inters.UserRecoveryCode, interceptors...)
 }
 
-// Create returns a builder for creating a SystemLog entity.
-func (c *SystemLogClient) Create() *SystemLogCreate {
-	mutation := newSystemLogMutation(c.config, OpCreate)
-	return &SystemLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserRecoveryCode entity.
+func (c *UserRecoveryCodeClient) Create() *UserRecoveryCodeCreate {
+	mutation := newUserRecoveryCodeMutation(c.config, OpCreate)
+	return &UserRecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SystemLog entities.
-func (c *SystemLogClient) CreateBulk(builders ...*SystemLogCreate) *SystemLogCreateBulk {
-	return &SystemLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserRecoveryCode entities.
+func (c *UserRecoveryCodeClient) CreateBulk(builders ...*UserRecoveryCodeCreate) *UserRecoveryCodeCreateBulk {
+	return &UserRecoveryCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SystemLogClient) MapCreateBulk(slice any, setFunc func(*SystemLogCreate, int)) *SystemLogCreateBulk {
+func (c *UserRecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*UserRecoveryCodeCreate, int)) *UserRecoveryCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SystemLogCreateBulk{err: fmt.Errorf("calling to SystemLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserRecoveryCodeCreateBulk{err: fmt.Errorf("calling to UserRecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SystemLogCreate, rv.Len())
+	builders := make([]*UserRecoveryCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SystemLogCreateBulk{config: c.config, builders: builders}
+	return &UserRecoveryCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SystemLog.
-func (c *SystemLogClient) Update() *SystemLogUpdate {
-	mutation := newSystemLogMutation(c.config, OpUpdate)
-	return &SystemLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserRecoveryCode.
+func (c *UserRecoveryCodeClient) Update() *UserRecoveryCodeUpdate {
+	mutation := newUserRecoveryCodeMutation(c.config, OpUpdate)
+	return &UserRecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SystemLogClient) UpdateOne(_m *SystemLog) *SystemLogUpdateOne {
-	mutation := newSystemLogMutation(c.config, OpUpdateOne, withSystemLog(_m))
-	return &SystemLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserRecoveryCodeClient) UpdateOne(_m *UserRecoveryCode) *UserRecoveryCodeUpdateOne {
+	mutation := newUserRecoveryCodeMutation(c.config, OpUpdateOne, withUserRecoveryCode(_m))
+	return &UserRecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SystemLogClient) UpdateOneID(id int) *SystemLogUpdateOne {
-	mutation := newSystemLogMutation(c.config, OpUpdateOne, withSystemLogID(id))
-	return &SystemLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserRecoveryCodeClient) UpdateOneID(id int) *UserRecoveryCodeUpdateOne {
+	mutation := newUserRecoveryCodeMutation(c.config, OpUpdateOne, withUserRecoveryCodeID(id))
+	return &UserRecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SystemLog.
-func (c *SystemLogClient) Delete() *SystemLogDelete {
-	mutation := newSystemLogMutation(c.config, OpDelete)
-	return &SystemLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserRecoveryCode.
+func (c *UserRecoveryCodeClient) Delete() *UserRecoveryCodeDelete {
+	mutation := newUserRecoveryCodeMutation(c.config, OpDelete)
+	return &UserRecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SystemLogClient) DeleteOne(_m *SystemLog) *SystemLogDeleteOne {
+func (c *UserRecoveryCodeClient) DeleteOne(_m *UserRecoveryCode) *UserRecoveryCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SystemLogClient) DeleteOneID(id int) *SystemLogDeleteOne {
-	builder := c.Delete().Where(systemlog.ID(id))
+func (c *UserRecoveryCodeClient) DeleteOneID(id int) *UserRecoveryCodeDeleteOne {
+	builder := c.Delete().Where(userrecoverycode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SystemLogDeleteOne{builder}
+	return &UserRecoveryCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for SystemLog.
-func (c *SystemLogClient) Query() *SystemLogQuery {
-	return &SystemLogQuery{
+// Query returns a query builder for UserRecoveryCode.
+func (c *UserRecoveryCodeClient) Query() *UserRecoveryCodeQuery {
+	return &UserRecoveryCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSystemLog},
+		ctx:    &QueryContext{Type: TypeUserRecoveryCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SystemLog entity by its id.
-func (c *SystemLogClient) Get(ctx context.Context, id int) (*SystemLog, error) {
-	return c.Query().Where(systemlog.ID(id)).Only(ctx)
+// Get returns a UserRecoveryCode entity by its id.
+func (c *UserRecoveryCodeClient) Get(ctx context.Context, id int) (*UserRecoveryCode, error) {
+	return c.Query().Where(userrecoverycode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SystemLogClient) GetX(ctx context.Context, id int) *SystemLog {
+func (c *UserRecoveryCodeClient) GetX(ctx context.Context, id int) *UserRecoveryCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2190,131 +4080,131 @@ func (c *SystemLogClient) GetX(ctx context.Context, id int) *SystemLog {
 }
 
 // Hooks returns the client hooks.
-func (c *SystemLogClient) Hooks() []Hook {
-	return c.hooks.SystemLog
+func (c *UserRecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.UserRecoveryCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *SystemLogClient) Interceptors() []Interceptor {
-	return c.inters.SystemLog
+func (c *UserRecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.UserRecoveryCode
 }
 
-func (c *SystemLogClient) mutate(ctx context.Context, m *SystemLogMutation) (Value, error) {
+func (c *UserRecoveryCodeClient) mutate(ctx context.Context, m *UserRecoveryCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SystemLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserRecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SystemLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserRecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SystemLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserRecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SystemLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserRecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown SystemLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown UserRecoveryCode mutation op: %q", m.Op())
 	}
 }
 
-// TicketClient is a client for the Ticket schema.
-type TicketClient struct {
+// UserTOTPClient is a client for the UserTOTP schema.
+type UserTOTPClient struct {
 	config
 }
 
-// NewTicketClient returns a client for the Ticket from the given config.
-func NewTicketClient(c config) *TicketClient {
-	return &TicketClient{config: c}
+// NewUserTOTPClient returns a client for the UserTOTP from the given config.
+func NewUserTOTPClient(c config) *UserTOTPClient {
+	return &UserTOTPClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ticket.Hooks(f(g(h())))`.
-func (c *TicketClient) Use(hooks ...Hook) {
-	c.hooks.Ticket = append(c.hooks.Ticket, hooks...)
+// A call to `Use(f, g, h)` equals to `usertotp.Hooks(f(g(h())))`.
+func (c *UserTOTPClient) Use(hooks ...Hook) {
+	c.hooks.UserTOTP = append(c.hooks.UserTOTP, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ticket.Intercept(f(g(h())))`.
-func (c *TicketClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Ticket = append(c.inters.Ticket, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usertotp.Intercept(f(g(h())))`.
+func (c *UserTOTPClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserTOTP = append(c.inters.UserTOTP, interceptors...)
 }
 
-// Create returns a builder for creating a Ticket entity.
-func (c *TicketClient) Create() *TicketCreate {
-	mutation := newTicketMutation(c.config, OpCreate)
-	return &TicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserTOTP entity.
+func (c *UserTOTPClient) Create() *UserTOTPCreate {
+	mutation := newUserTOTPMutation(c.config, OpCreate)
+	return &UserTOTPCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Ticket entities.
-func (c *TicketClient) CreateBulk(builders ...*TicketCreate) *TicketCreateBulk {
-	return &TicketCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserTOTP entities.
+func (c *UserTOTPClient) CreateBulk(builders ...*UserTOTPCreate) *UserTOTPCreateBulk {
+	return &UserTOTPCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TicketClient) MapCreateBulk(slice any, setFunc func(*TicketCreate, int)) *TicketCreateBulk {
+func (c *UserTOTPClient) MapCreateBulk(slice any, setFunc func(*UserTOTPCreate, int)) *UserTOTPCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TicketCreateBulk{err: fmt.Errorf("calling to TicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserTOTPCreateBulk{err: fmt.Errorf("calling to UserTOTPClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TicketCreate, rv.Len())
+	builders := make([]*UserTOTPCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TicketCreateBulk{config: c.config, builders: builders}
+	return &UserTOTPCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Ticket.
-func (c *TicketClient) Update() *TicketUpdate {
-	mutation := newTicketMutation(c.config, OpUpdate)
-	return &TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserTOTP.
+func (c *UserTOTPClient) Update() *UserTOTPUpdate {
+	mutation := newUserTOTPMutation(c.config, OpUpdate)
+	return &UserTOTPUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TicketClient) UpdateOne(_m *Ticket) *TicketUpdateOne {
-	mutation := newTicketMutation(c.config, OpUpdateOne, withTicket(_m))
-	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserTOTPClient) UpdateOne(_m *UserTOTP) *UserTOTPUpdateOne {
+	mutation := newUserTOTPMutation(c.config, OpUpdateOne, withUserTOTP(_m))
+	return &UserTOTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TicketClient) UpdateOneID(id int) *TicketUpdateOne {
-	mutation := newTicketMutation(c.config, OpUpdateOne, withTicketID(id))
-	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserTOTPClient) UpdateOneID(id int) *UserTOTPUpdateOne {
+	mutation := newUserTOTPMutation(c.config, OpUpdateOne, withUserTOTPID(id))
+	return &UserTOTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Ticket.
-func (c *TicketClient) Delete() *TicketDelete {
-	mutation := newTicketMutation(c.config, OpDelete)
-	return &TicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserTOTP.
+func (c *UserTOTPClient) Delete() *UserTOTPDelete {
+	mutation := newUserTOTPMutation(c.config, OpDelete)
+	return &UserTOTPDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TicketClient) DeleteOne(_m *Ticket) *TicketDeleteOne {
+func (c *UserTOTPClient) DeleteOne(_m *UserTOTP) *UserTOTPDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TicketClient) DeleteOneID(id int) *TicketDeleteOne {
-	builder := c.Delete().Where(ticket.ID(id))
+func (c *UserTOTPClient) DeleteOneID(id int) *UserTOTPDeleteOne {
+	builder := c.Delete().Where(usertotp.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TicketDeleteOne{builder}
+	return &UserTOTPDeleteOne{builder}
 }
 
-// Query returns a query builder for Ticket.
-func (c *TicketClient) Query() *TicketQuery {
-	return &TicketQuery{
+// Query returns a query builder for UserTOTP.
+func (c *UserTOTPClient) Query() *UserTOTPQuery {
+	return &UserTOTPQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTicket},
+		ctx:    &QueryContext{Type: TypeUserTOTP},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Ticket entity by its id.
-func (c *TicketClient) Get(ctx context.Context, id int) (*Ticket, error) {
-	return c.Query().Where(ticket.ID(id)).Only(ctx)
+// Get returns a UserTOTP entity by its id.
+func (c *UserTOTPClient) Get(ctx context.Context, id int) (*UserTOTP, error) {
+	return c.Query().Where(usertotp.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TicketClient) GetX(ctx context.Context, id int) *Ticket {
+func (c *UserTOTPClient) GetX(ctx context.Context, id int) *UserTOTP {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2322,148 +4212,132 @@ func (c *TicketClient) GetX(ctx context.Context, id int) *Ticket {
 	return obj
 }
 
-// QueryMessages queries the messages edge of a Ticket.
-func (c *TicketClient) QueryMessages(_m *Ticket) *TicketMessageQuery {
-	query := (&TicketMessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(ticket.Table, ticket.FieldID, id),
-			sqlgraph.To(ticketmessage.Table, ticketmessage.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ticket.MessagesTable, ticket.MessagesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TicketClient) Hooks() []Hook {
-	return c.hooks.Ticket
+func (c *UserTOTPClient) Hooks() []Hook {
+	return c.hooks.UserTOTP
 }
 
 // Interceptors returns the client interceptors.
-func (c *TicketClient) Interceptors() []Interceptor {
-	return c.inters.Ticket
+func (c *UserTOTPClient) Interceptors() []Interceptor {
+	return c.inters.UserTOTP
 }
 
-func (c *TicketClient) mutate(ctx context.Context, m *TicketMutation) (Value, error) {
+func (c *UserTOTPClient) mutate(ctx context.Context, m *UserTOTPMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserTOTPCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserTOTPUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserTOTPUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserTOTPDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown Ticket mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown UserTOTP mutation op: %q", m.Op())
 	}
 }
 
-// TicketMessageClient is a client for the TicketMessage schema.
-type TicketMessageClient struct {
+// WebAuthnCredentialClient is a client for the WebAuthnCredential schema.
+type WebAuthnCredentialClient struct {
 	config
 }
 
-// NewTicketMessageClient returns a client for the TicketMessage from the given config.
-func NewTicketMessageClient(c config) *TicketMessageClient {
-	return &TicketMessageClient{config: c}
+// NewWebAuthnCredentialClient returns a client for the WebAuthnCredential from the given config.
+func NewWebAuthnCredentialClient(c config) *WebAuthnCredentialClient {
+	return &WebAuthnCredentialClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ticketmessage.Hooks(f(g(h())))`.
-func (c *TicketMessageClient) Use(hooks ...Hook) {
-	c.hooks.TicketMessage = append(c.hooks.TicketMessage, hooks...)
+// A call to `Use(f, g, h)` equals to `webauthncredential.Hooks(f(g(h())))`.
+func (c *WebAuthnCredentialClient) Use(hooks ...Hook) {
+	c.hooks.WebAuthnCredential = append(c.hooks.WebAuthnCredential, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ticketmessage.Intercept(f(g(h())))`.
-func (c *TicketMessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TicketMessage = append(c.inters.TicketMessage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webauthncredential.Intercept(f(g(h())))`.
+func (c *WebAuthnCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebAuthnCredential = append(c.inters.WebAuthnCredential, interceptors...)
 }
 
-// Create returns a builder for creating a TicketMessage entity.
-func (c *TicketMessageClient) Create() *TicketMessageCreate {
-	mutation := newTicketMessageMutation(c.config, OpCreate)
-	return &TicketMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebAuthnCredential entity.
+func (c *WebAuthnCredentialClient) Create() *WebAuthnCredentialCreate {
+	mutation := newWebAuthnCredentialMutation(c.config, OpCreate)
+	return &WebAuthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TicketMessage entities.
-func (c *TicketMessageClient) CreateBulk(builders ...*TicketMessageCreate) *TicketMessageCreateBulk {
-	return &TicketMessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebAuthnCredential entities.
+func (c *WebAuthnCredentialClient) CreateBulk(builders ...*WebAuthnCredentialCreate) *WebAuthnCredentialCreateBulk {
+	return &WebAuthnCredentialCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TicketMessageClient) MapCreateBulk(slice any, setFunc func(*TicketMessageCreate, int)) *TicketMessageCreateBulk {
+func (c *WebAuthnCredentialClient) MapCreateBulk(slice any, setFunc func(*WebAuthnCredentialCreate, int)) *WebAuthnCredentialCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TicketMessageCreateBulk{err: fmt.Errorf("calling to TicketMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebAuthnCredentialCreateBulk{err: fmt.Errorf("calling to WebAuthnCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TicketMessageCreate, rv.Len())
+	builders := make([]*WebAuthnCredentialCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TicketMessageCreateBulk{config: c.config, builders: builders}
+	return &WebAuthnCredentialCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TicketMessage.
-func (c *TicketMessageClient) Update() *TicketMessageUpdate {
-	mutation := newTicketMessageMutation(c.config, OpUpdate)
-	return &TicketMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Update() *WebAuthnCredentialUpdate {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdate)
+	return &WebAuthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TicketMessageClient) UpdateOne(_m *TicketMessage) *TicketMessageUpdateOne {
-	mutation := newTicketMessageMutation(c.config, OpUpdateOne, withTicketMessage(_m))
-	return &TicketMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebAuthnCredentialClient) UpdateOne(_m *WebAuthnCredential) *WebAuthnCredentialUpdateOne {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdateOne, withWebAuthnCredential(_m))
+	return &WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
-
-// UpdateOneID returns an update builder for the given id.
-func (c *TicketMessageClient) UpdateOneID(id int) *TicketMessageUpdateOne {
-	mutation := newTicketMessageMutation(c.config, OpUpdateOne, withTicketMessageID(id))
-	return &TicketMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebAuthnCredentialClient) UpdateOneID(id string) *WebAuthnCredentialUpdateOne {
+	mutation := newWebAuthnCredentialMutation(c.config, OpUpdateOne, withWebAuthnCredentialID(id))
+	return &WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TicketMessage.
-func (c *TicketMessageClient) Delete() *TicketMessageDelete {
-	mutation := newTicketMessageMutation(c.config, OpDelete)
-	return &TicketMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Delete() *WebAuthnCredentialDelete {
+	mutation := newWebAuthnCredentialMutation(c.config, OpDelete)
+	return &WebAuthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TicketMessageClient) DeleteOne(_m *TicketMessage) *TicketMessageDeleteOne {
+func (c *WebAuthnCredentialClient) DeleteOne(_m *WebAuthnCredential) *WebAuthnCredentialDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TicketMessageClient) DeleteOneID(id int) *TicketMessageDeleteOne {
-	builder := c.Delete().Where(ticketmessage.ID(id))
+func (c *WebAuthnCredentialClient) DeleteOneID(id string) *WebAuthnCredentialDeleteOne {
+	builder := c.Delete().Where(webauthncredential.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TicketMessageDeleteOne{builder}
+	return &WebAuthnCredentialDeleteOne{builder}
 }
 
-// Query returns a query builder for TicketMessage.
-func (c *TicketMessageClient) Query() *TicketMessageQuery {
-	return &TicketMessageQuery{
+// Query returns a query builder for WebAuthnCredential.
+func (c *WebAuthnCredentialClient) Query() *WebAuthnCredentialQuery {
+	return &WebAuthnCredentialQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTicketMessage},
+		ctx:    &QueryContext{Type: TypeWebAuthnCredential},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TicketMessage entity by its id.
-func (c *TicketMessageClient) Get(ctx context.Context, id int) (*TicketMessage, error) {
-	return c.Query().Where(ticketmessage.ID(id)).Only(ctx)
+// Get returns a WebAuthnCredential entity by its id.
+func (c *WebAuthnCredentialClient) Get(ctx context.Context, id string) (*WebAuthnCredential, error) {
+	return c.Query().Where(webauthncredential.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TicketMessageClient) GetX(ctx context.Context, id int) *TicketMessage {
+func (c *WebAuthnCredentialClient) GetX(ctx context.Context, id string) *WebAuthnCredential {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2471,148 +4345,132 @@ func (c *TicketMessageClient) GetX(ctx context.Context, id int) *TicketMessage {
 	return obj
 }
 
-// QueryTicket queries the ticket edge of a TicketMessage.
-func (c *TicketMessageClient) QueryTicket(_m *TicketMessage) *TicketQuery {
-	query := (&TicketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(ticketmessage.Table, ticketmessage.FieldID, id),
-			sqlgraph.To(ticket.Table, ticket.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ticketmessage.TicketTable, ticketmessage.TicketColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TicketMessageClient) Hooks() []Hook {
-	return c.hooks.TicketMessage
+func (c *WebAuthnCredentialClient) Hooks() []Hook {
+	return c.hooks.WebAuthnCredential
 }
 
 // Interceptors returns the client interceptors.
-func (c *TicketMessageClient) Interceptors() []Interceptor {
-	return c.inters.TicketMessage
+func (c *WebAuthnCredentialClient) Interceptors() []Interceptor {
+	return c.inters.WebAuthnCredential
 }
 
-func (c *TicketMessageClient) mutate(ctx context.Context, m *TicketMessageMutation) (Value, error) {
+func (c *WebAuthnCredentialClient) mutate(ctx context.Context, m *WebAuthnCredentialMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TicketMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebAuthnCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TicketMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebAuthnCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TicketMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebAuthnCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TicketMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebAuthnCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown TicketMessage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown WebAuthnCredential mutation op: %q", m.Op())
 	}
 }
 
-// UploadLogClient is a client for the UploadLog schema.
-type UploadLogClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewUploadLogClient returns a client for the UploadLog from the given config.
-func NewUploadLogClient(c config) *UploadLogClient {
-	return &UploadLogClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `uploadlog.Hooks(f(g(h())))`.
-func (c *UploadLogClient) Use(hooks ...Hook) {
-	c.hooks.UploadLog = append(c.hooks.UploadLog, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `uploadlog.Intercept(f(g(h())))`.
-func (c *UploadLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UploadLog = append(c.inters.UploadLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a UploadLog entity.
-func (c *UploadLogClient) Create() *UploadLogCreate {
-	mutation := newUploadLogMutation(c.config, OpCreate)
-	return &UploadLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UploadLog entities.
-func (c *UploadLogClient) CreateBulk(builders ...*UploadLogCreate) *UploadLogCreateBulk {
-	return &UploadLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UploadLogClient) MapCreateBulk(slice any, setFunc func(*UploadLogCreate, int)) *UploadLogCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UploadLogCreateBulk{err: fmt.Errorf("calling to UploadLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UploadLogCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UploadLogCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UploadLog.
-func (c *UploadLogClient) Update() *UploadLogUpdate {
-	mutation := newUploadLogMutation(c.config, OpUpdate)
-	return &UploadLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UploadLogClient) UpdateOne(_m *UploadLog) *UploadLogUpdateOne {
-	mutation := newUploadLogMutation(c.config, OpUpdateOne, withUploadLog(_m))
-	return &UploadLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UploadLogClient) UpdateOneID(id int) *UploadLogUpdateOne {
-	mutation := newUploadLogMutation(c.config, OpUpdateOne, withUploadLogID(id))
-	return &UploadLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UploadLog.
-func (c *UploadLogClient) Delete() *UploadLogDelete {
-	mutation := newUploadLogMutation(c.config, OpDelete)
-	return &UploadLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UploadLogClient) DeleteOne(_m *UploadLog) *UploadLogDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UploadLogClient) DeleteOneID(id int) *UploadLogDeleteOne {
-	builder := c.Delete().Where(uploadlog.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UploadLogDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for UploadLog.
-func (c *UploadLogClient) Query() *UploadLogQuery {
-	return &UploadLogQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUploadLog},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UploadLog entity by its id.
-func (c *UploadLogClient) Get(ctx context.Context, id int) (*UploadLog, error) {
-	return c.Query().Where(uploadlog.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UploadLogClient) GetX(ctx context.Context, id int) *UploadLog {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2621,131 +4479,131 @@ func (c *UploadLogClient) GetX(ctx context.Context, id int) *UploadLog {
 }
 
 // Hooks returns the client hooks.
-func (c *UploadLogClient) Hooks() []Hook {
-	return c.hooks.UploadLog
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *UploadLogClient) Interceptors() []Interceptor {
-	return c.inters.UploadLog
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *UploadLogClient) mutate(ctx context.Context, m *UploadLogMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UploadLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UploadLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UploadLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UploadLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown UploadLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// WebhookDeliveryAttemptClient is a client for the WebhookDeliveryAttempt schema.
+type WebhookDeliveryAttemptClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewWebhookDeliveryAttemptClient returns a client for the WebhookDeliveryAttempt from the given config.
+func NewWebhookDeliveryAttemptClient(c config) *WebhookDeliveryAttemptClient {
+	return &WebhookDeliveryAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdeliveryattempt.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryAttemptClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDeliveryAttempt = append(c.hooks.WebhookDeliveryAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdeliveryattempt.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDeliveryAttempt = append(c.inters.WebhookDeliveryAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDeliveryAttempt entity.
+func (c *WebhookDeliveryAttemptClient) Create() *WebhookDeliveryAttemptCreate {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpCreate)
+	return &WebhookDeliveryAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDeliveryAttempt entities.
+func (c *WebhookDeliveryAttemptClient) CreateBulk(builders ...*WebhookDeliveryAttemptCreate) *WebhookDeliveryAttemptCreateBulk {
+	return &WebhookDeliveryAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *WebhookDeliveryAttemptClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryAttemptCreate, int)) *WebhookDeliveryAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryAttemptCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*WebhookDeliveryAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDeliveryAttempt.
+func (c *WebhookDeliveryAttemptClient) Update() *WebhookDeliveryAttemptUpdate {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpUpdate)
+	return &WebhookDeliveryAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryAttemptClient) UpdateOne(_m *WebhookDeliveryAttempt) *WebhookDeliveryAttemptUpdateOne {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpUpdateOne, withWebhookDeliveryAttempt(_m))
+	return &WebhookDeliveryAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryAttemptClient) UpdateOneID(id int) *WebhookDeliveryAttemptUpdateOne {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpUpdateOne, withWebhookDeliveryAttemptID(id))
+	return &WebhookDeliveryAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDeliveryAttempt.
+func (c *WebhookDeliveryAttemptClient) Delete() *WebhookDeliveryAttemptDelete {
+	mutation := newWebhookDeliveryAttemptMutation(c.config, OpDelete)
+	return &WebhookDeliveryAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *WebhookDeliveryAttemptClient) DeleteOne(_m *WebhookDeliveryAttempt) *WebhookDeliveryAttemptDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id string) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *WebhookDeliveryAttemptClient) DeleteOneID(id int) *WebhookDeliveryAttemptDeleteOne {
+	builder := c.Delete().Where(webhookdeliveryattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &WebhookDeliveryAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for WebhookDeliveryAttempt.
+func (c *WebhookDeliveryAttemptClient) Query() *WebhookDeliveryAttemptQuery {
+	return &WebhookDeliveryAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeWebhookDeliveryAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id string) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a WebhookDeliveryAttempt entity by its id.
+func (c *WebhookDeliveryAttemptClient) Get(ctx context.Context, id int) (*WebhookDeliveryAttempt, error) {
+	return c.Query().Where(webhookdeliveryattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id string) *User {
+func (c *WebhookDeliveryAttemptClient) GetX(ctx context.Context, id int) *WebhookDeliveryAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2753,124 +4611,28 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	return obj
 }
 
-// QuerySocialPlatformInfo queries the social_platform_info edge of a User.
-func (c *UserClient) QuerySocialPlatformInfo(_m *User) *SocialPlatformInfoQuery {
-	query := (&SocialPlatformInfoClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(socialplatforminfo.Table, socialplatforminfo.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.SocialPlatformInfoTable, user.SocialPlatformInfoColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAuthorizedSocialPlatforms queries the authorized_social_platforms edge of a User.
-func (c *UserClient) QueryAuthorizedSocialPlatforms(_m *User) *AuthorizeSocialPlatformInfoQuery {
-	query := (&AuthorizeSocialPlatformInfoClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(authorizesocialplatforminfo.Table, authorizesocialplatforminfo.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthorizedSocialPlatformsTable, user.AuthorizedSocialPlatformsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryGameAccountBindings queries the game_account_bindings edge of a User.
-func (c *UserClient) QueryGameAccountBindings(_m *User) *GameAccountBindingQuery {
-	query := (&GameAccountBindingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(gameaccountbinding.Table, gameaccountbinding.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.GameAccountBindingsTable, user.GameAccountBindingsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryGameAccountDataGrantsOwned queries the game_account_data_grants_owned edge of a User.
-func (c *UserClient) QueryGameAccountDataGrantsOwned(_m *User) *GameAccountDataGrantQuery {
-	query := (&GameAccountDataGrantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.GameAccountDataGrantsOwnedTable, user.GameAccountDataGrantsOwnedColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryGameAccountDataGrantsReceived queries the game_account_data_grants_received edge of a User.
-func (c *UserClient) QueryGameAccountDataGrantsReceived(_m *User) *GameAccountDataGrantQuery {
-	query := (&GameAccountDataGrantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(gameaccountdatagrant.Table, gameaccountdatagrant.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.GameAccountDataGrantsReceivedTable, user.GameAccountDataGrantsReceivedColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryIosScriptCode queries the ios_script_code edge of a User.
-func (c *UserClient) QueryIosScriptCode(_m *User) *IOSScriptCodeQuery {
-	query := (&IOSScriptCodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(iosscriptcode.Table, iosscriptcode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.IosScriptCodeTable, user.IosScriptCodeColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *WebhookDeliveryAttemptClient) Hooks() []Hook {
+	return c.hooks.WebhookDeliveryAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *WebhookDeliveryAttemptClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDeliveryAttempt
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *WebhookDeliveryAttemptClient) mutate(ctx context.Context, m *WebhookDeliveryAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("postgresql: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("postgresql: unknown WebhookDeliveryAttempt mutation op: %q", m.Op())
 	}
 }
 
@@ -3175,17 +4937,21 @@ func (c *WebhookSubscriptionClient) mutate(ctx context.Context, m *WebhookSubscr
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuthorizeSocialPlatformInfo, FriendLink, GameAccountBinding,
-		GameAccountDataGrant, Group, GroupList, IOSScriptCode,
+		AccountDeletion, AuthorizeSocialPlatformInfo, DataExport, DataProviderOutbox,
+		EmailMessage, EmailSuppression, FriendLink, GameAccountBinding,
+		GameAccountDataGrant, Group, GroupList, IOSScriptCode, InheritJob,
 		OAuth2ClientWebhookEndpoint, RiskEvent, RiskRule, SocialPlatformInfo, Sponsor,
-		SystemLog, Ticket, TicketMessage, UploadLog, User, WebhookEndpoint,
-		WebhookSubscription []ent.Hook
+		SystemLog, Ticket, TicketAttachment, TicketMessage, UploadLog, User,
+		UserRecoveryCode, UserTOTP, WebAuthnCredential, WebhookDelivery,
+		WebhookDeliveryAttempt, WebhookEndpoint, WebhookSubscription []ent.Hook
 	}
 	inters struct {
-		AuthorizeSocialPlatformInfo, FriendLink, GameAccountBinding,
-		GameAccountDataGrant, Group, GroupList, IOSScriptCode,
+		AccountDeletion, AuthorizeSocialPlatformInfo, DataExport, DataProviderOutbox,
+		EmailMessage, EmailSuppression, FriendLink, GameAccountBinding,
+		GameAccountDataGrant, Group, GroupList, IOSScriptCode, InheritJob,
 		OAuth2ClientWebhookEndpoint, RiskEvent, RiskRule, SocialPlatformInfo, Sponsor,
-		SystemLog, Ticket, TicketMessage, UploadLog, User, WebhookEndpoint,
-		WebhookSubscription []ent.Interceptor
+		SystemLog, Ticket, TicketAttachment, TicketMessage, UploadLog, User,
+		UserRecoveryCode, UserTOTP, WebAuthnCredential, WebhookDelivery,
+		WebhookDeliveryAttempt, WebhookEndpoint, WebhookSubscription []ent.Interceptor
 	}
 )
@@ -0,0 +1,201 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const dataExportErrorMaxLen = 500
+
+// DataExportArchive describes a built archive handed to CompleteDataExport.
+type DataExportArchive struct {
+	StorageKey        string
+	SizeBytes         int64
+	SHA256            string
+	DownloadTokenHash string
+	ExpiresAt         time.Time
+}
+
+func truncateDataExportError(message string) string {
+	if len(message) <= dataExportErrorMaxLen {
+		return message
+	}
+	return message[:dataExportErrorMaxLen]
+}
+
+func (c *Client) CreateDataExport(ctx context.Context, id, userID, locale string, maxAttempts int, now time.Time) (*DataExport, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	return c.DataExport.Create().
+		SetID(id).
+		SetUserID(userID).
+		SetLocale(locale).
+		SetMaxAttempts(maxAttempts).
+		SetNextAttemptAt(now).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+// ListUserDataExports returns the user's exports, newest first.
+func (c *Client) ListUserDataExports(ctx context.Context, userID string, limit int) ([]*DataExport, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.Query().
+		Where(dataexport.UserIDEQ(userID)).
+		Order(dataexport.ByCreatedAt(sql.OrderDesc()), dataexport.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+}
+
+func (c *Client) GetUserDataExport(ctx context.Context, userID, id string) (*DataExport, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.Query().
+		Where(dataexport.IDEQ(id), dataexport.UserIDEQ(userID)).
+		Only(ctx)
+}
+
+func (c *Client) GetDataExportByTokenHash(ctx context.Context, tokenHash string) (*DataExport, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.Query().
+		Where(dataexport.DownloadTokenHashEQ(tokenHash)).
+		Only(ctx)
+}
+
+func (c *Client) ListDueDataExports(ctx context.Context, now time.Time, limit int) ([]*DataExport, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.Query().
+		Where(
+			dataexport.StatusEQ(dataexport.StatusQueued),
+			dataexport.NextAttemptAtLTE(now),
+		).
+		Order(dataexport.ByNextAttemptAt(sql.OrderAsc()), dataexport.ByCreatedAt(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// ClaimDataExport takes ownership of a due export the same way
+// ClaimEmailMessage does: the compare on attempts makes the claim safe
+// across instances, and the export becomes due again once leaseUntil passes
+// if the worker dies while building it.
+func (c *Client) ClaimDataExport(ctx context.Context, export *DataExport, now, leaseUntil time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.DataExport.Update().
+		Where(
+			dataexport.IDEQ(export.ID),
+			dataexport.StatusEQ(dataexport.StatusQueued),
+			dataexport.AttemptsEQ(export.Attempts),
+			dataexport.NextAttemptAtLTE(now),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (c *Client) CompleteDataExport(ctx context.Context, id string, archive DataExportArchive, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	return c.DataExport.UpdateOneID(id).
+		Where(dataexport.StatusEQ(dataexport.StatusQueued)).
+		SetStatus(dataexport.StatusReady).
+		SetStorageKey(archive.StorageKey).
+		SetSizeBytes(archive.SizeBytes).
+		SetSha256(archive.SHA256).
+		SetDownloadTokenHash(archive.DownloadTokenHash).
+		SetReadyAt(now).
+		SetExpiresAt(archive.ExpiresAt.UTC()).
+		ClearLastError().
+		SetUpdatedAt(now).
+		Exec(ctx)
+}
+
+func (c *Client) RetryDataExport(ctx context.Context, id, message string, nextAttemptAt time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.UpdateOneID(id).
+		Where(dataexport.StatusEQ(dataexport.StatusQueued)).
+		SetNextAttemptAt(nextAttemptAt.UTC()).
+		SetLastError(truncateDataExportError(message)).
+		Exec(ctx)
+}
+
+func (c *Client) FailDataExport(ctx context.Context, id, message string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.UpdateOneID(id).
+		Where(dataexport.StatusEQ(dataexport.StatusQueued)).
+		SetStatus(dataexport.StatusFailed).
+		SetLastError(truncateDataExportError(message)).
+		SetUpdatedAt(now.UTC()).
+		Exec(ctx)
+}
+
+func (c *Client) RecordDataExportDownload(ctx context.Context, id string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.UpdateOneID(id).
+		AddDownloadCount(1).
+		SetLastDownloadedAt(now.UTC()).
+		Exec(ctx)
+}
+
+// ListExpiredDataExports returns ready exports whose archive should be
+// removed.
+func (c *Client) ListExpiredDataExports(ctx context.Context, now time.Time, limit int) ([]*DataExport, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataExport.Query().
+		Where(
+			dataexport.StatusEQ(dataexport.StatusReady),
+			dataexport.ExpiresAtLTE(now),
+		).
+		Order(dataexport.ByExpiresAt(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// ExpireDataExport marks a ready export as expired and forgets its archive
+// and download token. It reports false when another instance got there
+// first.
+func (c *Client) ExpireDataExport(ctx context.Context, id string, now time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.DataExport.Update().
+		Where(dataexport.IDEQ(id), dataexport.StatusEQ(dataexport.StatusReady)).
+		SetStatus(dataexport.StatusExpired).
+		ClearStorageKey().
+		ClearDownloadTokenHash().
+		SetUpdatedAt(now.UTC()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
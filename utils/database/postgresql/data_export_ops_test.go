@@ -0,0 +1,93 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDataExportLifecycleOps(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:data-export-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if _, err := client.User.Create().SetID("u1").SetName("u1").SetEmail("u1@example.com").Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	if _, err := client.CreateDataExport(ctx, "e1", "u1", "en", 3, now); err != nil {
+		t.Fatalf("CreateDataExport returned error: %v", err)
+	}
+
+	due, err := client.ListDueDataExports(ctx, now, 10)
+	if err != nil || len(due) != 1 {
+		t.Fatalf("ListDueDataExports = %d, %v; want 1 export", len(due), err)
+	}
+	claimed, err := client.ClaimDataExport(ctx, due[0], now, now.Add(time.Minute))
+	if err != nil || !claimed {
+		t.Fatalf("ClaimDataExport = %v, %v; want true", claimed, err)
+	}
+	// A second claim with the stale row loses the race.
+	if claimed, err := client.ClaimDataExport(ctx, due[0], now, now.Add(time.Minute)); err != nil || claimed {
+		t.Fatalf("second ClaimDataExport = %v, %v; want false", claimed, err)
+	}
+	if due, _ := client.ListDueDataExports(ctx, now, 10); len(due) != 0 {
+		t.Fatalf("ListDueDataExports during the lease = %d, want 0", len(due))
+	}
+
+	expiresAt := now.Add(time.Hour)
+	if err := client.CompleteDataExport(ctx, "e1", dbManager.DataExportArchive{
+		StorageKey:        "data-exports/u1/e1.zip",
+		SizeBytes:         42,
+		SHA256:            "abc",
+		DownloadTokenHash: "token-hash",
+		ExpiresAt:         expiresAt,
+	}, now); err != nil {
+		t.Fatalf("CompleteDataExport returned error: %v", err)
+	}
+	row, err := client.GetDataExportByTokenHash(ctx, "token-hash")
+	if err != nil {
+		t.Fatalf("GetDataExportByTokenHash returned error: %v", err)
+	}
+	if row.Status != dataexport.StatusReady || row.ReadyAt == nil || row.SizeBytes == nil || *row.SizeBytes != 42 {
+		t.Fatalf("completed export = %+v", row)
+	}
+	if err := client.RecordDataExportDownload(ctx, "e1", now); err != nil {
+		t.Fatalf("RecordDataExportDownload returned error: %v", err)
+	}
+
+	if expired, _ := client.ListExpiredDataExports(ctx, now, 10); len(expired) != 0 {
+		t.Fatalf("ListExpiredDataExports before expiry = %d, want 0", len(expired))
+	}
+	later := expiresAt.Add(time.Second)
+	expired, err := client.ListExpiredDataExports(ctx, later, 10)
+	if err != nil || len(expired) != 1 {
+		t.Fatalf("ListExpiredDataExports = %d, %v; want 1 export", len(expired), err)
+	}
+	if ok, err := client.ExpireDataExport(ctx, "e1", later); err != nil || !ok {
+		t.Fatalf("ExpireDataExport = %v, %v; want true", ok, err)
+	}
+	if ok, err := client.ExpireDataExport(ctx, "e1", later); err != nil || ok {
+		t.Fatalf("second ExpireDataExport = %v, %v; want false", ok, err)
+	}
+	row, err = client.GetUserDataExport(ctx, "u1", "e1")
+	if err != nil {
+		t.Fatalf("GetUserDataExport returned error: %v", err)
+	}
+	if row.Status != dataexport.StatusExpired || row.StorageKey != nil || row.DownloadTokenHash != nil || row.DownloadCount != 1 {
+		t.Fatalf("expired export = %+v", row)
+	}
+	if _, err := client.GetDataExportByTokenHash(ctx, "token-hash"); !dbManager.IsNotFound(err) {
+		t.Fatalf("GetDataExportByTokenHash after expiry error = %v, want not found", err)
+	}
+}
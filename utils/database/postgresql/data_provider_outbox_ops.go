@@ -0,0 +1,408 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const dataProviderOutboxErrorMaxLen = 500
+
+// dataProviderOutboxMetadataFields are all columns but the payload, which
+// is only read once an entry has been claimed.
+var dataProviderOutboxMetadataFields = []string{
+	dataprovideroutbox.FieldProvider,
+	dataprovideroutbox.FieldServer,
+	dataprovideroutbox.FieldGameUserID,
+	dataprovideroutbox.FieldDataType,
+	dataprovideroutbox.FieldGeneration,
+	dataprovideroutbox.FieldSnapshotAt,
+	dataprovideroutbox.FieldStatus,
+	dataprovideroutbox.FieldAttempts,
+	dataprovideroutbox.FieldMaxAttempts,
+	dataprovideroutbox.FieldNextAttemptAt,
+	dataprovideroutbox.FieldLastStatusCode,
+	dataprovideroutbox.FieldLastError,
+	dataprovideroutbox.FieldDeliveredAt,
+	dataprovideroutbox.FieldCreatedAt,
+	dataprovideroutbox.FieldUpdatedAt,
+}
+
+type DataProviderOutboxRecord struct {
+	Provider    string
+	Server      string
+	GameUserID  string
+	DataType    string
+	Payload     []byte
+	MaxAttempts int
+}
+
+// DataProviderOutboxFilter narrows outbox listings and resyncs. Empty fields
+// match everything.
+type DataProviderOutboxFilter struct {
+	Provider   string
+	Server     string
+	GameUserID string
+	DataType   string
+	Statuses   []dataprovideroutbox.Status
+}
+
+func (f DataProviderOutboxFilter) predicates() []predicate.DataProviderOutbox {
+	preds := make([]predicate.DataProviderOutbox, 0, 5)
+	if provider := strings.TrimSpace(f.Provider); provider != "" {
+		preds = append(preds, dataprovideroutbox.ProviderEQ(provider))
+	}
+	if server := strings.TrimSpace(f.Server); server != "" {
+		preds = append(preds, dataprovideroutbox.ServerEQ(server))
+	}
+	if gameUserID := strings.TrimSpace(f.GameUserID); gameUserID != "" {
+		preds = append(preds, dataprovideroutbox.GameUserIDEQ(gameUserID))
+	}
+	if dataType := strings.TrimSpace(f.DataType); dataType != "" {
+		preds = append(preds, dataprovideroutbox.DataTypeEQ(dataType))
+	}
+	if len(f.Statuses) > 0 {
+		preds = append(preds, dataprovideroutbox.StatusIn(f.Statuses...))
+	}
+	return preds
+}
+
+// DataProviderOutboxSummary describes the backlog of one provider.
+type DataProviderOutboxSummary struct {
+	Provider        string     `json:"provider"`
+	Pending         int        `json:"pending"`
+	Due             int        `json:"due"`
+	Retrying        int        `json:"retrying"`
+	Dead            int        `json:"dead"`
+	OldestPendingAt *time.Time `json:"oldestPendingAt,omitempty"`
+	LastDeliveredAt *time.Time `json:"lastDeliveredAt,omitempty"`
+	NextAttemptAt   *time.Time `json:"nextAttemptAt,omitempty"`
+	LagSeconds      int64      `json:"lagSeconds"`
+}
+
+func truncateDataProviderOutboxError(message string) string {
+	message = strings.TrimSpace(message)
+	if len(message) <= dataProviderOutboxErrorMaxLen {
+		return message
+	}
+	return message[:dataProviderOutboxErrorMaxLen]
+}
+
+// EnqueueDataProviderSnapshots stores the latest snapshot for each record.
+// An existing entry for the same provider, server, game account and data
+// type gets the new payload, a bumped generation and a fresh attempt budget.
+func (c *Client) EnqueueDataProviderSnapshots(ctx context.Context, records []DataProviderOutboxRecord, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	for _, record := range records {
+		err := c.enqueueDataProviderSnapshot(ctx, record, now)
+		if IsConstraintError(err) {
+			// A concurrent upload created the entry first; update it instead.
+			err = c.enqueueDataProviderSnapshot(ctx, record, now)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) enqueueDataProviderSnapshot(ctx context.Context, record DataProviderOutboxRecord, now time.Time) error {
+	affected, err := c.DataProviderOutbox.Update().
+		Where(
+			dataprovideroutbox.ProviderEQ(record.Provider),
+			dataprovideroutbox.ServerEQ(record.Server),
+			dataprovideroutbox.GameUserIDEQ(record.GameUserID),
+			dataprovideroutbox.DataTypeEQ(record.DataType),
+		).
+		SetPayload(record.Payload).
+		AddGeneration(1).
+		SetSnapshotAt(now).
+		SetStatus(dataprovideroutbox.StatusPending).
+		SetAttempts(0).
+		SetMaxAttempts(record.MaxAttempts).
+		SetNextAttemptAt(now).
+		ClearDeliveredAt().
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil || affected > 0 {
+		return err
+	}
+	return c.DataProviderOutbox.Create().
+		SetProvider(record.Provider).
+		SetServer(record.Server).
+		SetGameUserID(record.GameUserID).
+		SetDataType(record.DataType).
+		SetPayload(record.Payload).
+		SetSnapshotAt(now).
+		SetMaxAttempts(record.MaxAttempts).
+		SetNextAttemptAt(now).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Exec(ctx)
+}
+
+// ListDueDataProviderOutbox returns due entries without their payloads,
+// leaving out the providers in exclude.
+func (c *Client) ListDueDataProviderOutbox(ctx context.Context, now time.Time, exclude []string, limit int) ([]*DataProviderOutbox, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	preds := []predicate.DataProviderOutbox{
+		dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusPending),
+		dataprovideroutbox.NextAttemptAtLTE(now),
+	}
+	if len(exclude) > 0 {
+		preds = append(preds, dataprovideroutbox.ProviderNotIn(exclude...))
+	}
+	return c.DataProviderOutbox.Query().
+		Where(preds...).
+		Order(dataprovideroutbox.ByNextAttemptAt(sql.OrderAsc()), dataprovideroutbox.ByID(sql.OrderAsc())).
+		Limit(limit).
+		Select(dataProviderOutboxMetadataFields...).
+		All(ctx)
+}
+
+// ClaimDataProviderOutbox takes ownership of a due entry by bumping its
+// attempt counter and pushing next_attempt_at out to leaseUntil, and returns
+// the payload of the claimed generation. The compare on generation and
+// attempts makes the claim safe across instances; if the worker dies
+// mid-delivery the entry becomes due again once the lease expires.
+func (c *Client) ClaimDataProviderOutbox(ctx context.Context, entry *DataProviderOutbox, now, leaseUntil time.Time) ([]byte, bool, error) {
+	if c == nil {
+		return nil, false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.DataProviderOutbox.Update().
+		Where(
+			dataprovideroutbox.IDEQ(entry.ID),
+			dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusPending),
+			dataprovideroutbox.GenerationEQ(entry.Generation),
+			dataprovideroutbox.AttemptsEQ(entry.Attempts),
+			dataprovideroutbox.NextAttemptAtLTE(now),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil || affected != 1 {
+		return nil, false, err
+	}
+	row, err := c.DataProviderOutbox.Query().
+		Where(dataprovideroutbox.IDEQ(entry.ID), dataprovideroutbox.GenerationEQ(entry.Generation)).
+		Select(dataprovideroutbox.FieldPayload).
+		Only(ctx)
+	if IsNotFound(err) {
+		// Replaced by a newer snapshot between the claim and the read.
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return row.Payload, true, nil
+}
+
+// FinishDataProviderOutbox records the final state of a claimed generation.
+// An entry that has meanwhile received a newer snapshot is left pending.
+func (c *Client) FinishDataProviderOutbox(ctx context.Context, id, generation int, status dataprovideroutbox.Status, statusCode *int, message string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	update := c.DataProviderOutbox.Update().
+		Where(
+			dataprovideroutbox.IDEQ(id),
+			dataprovideroutbox.GenerationEQ(generation),
+			dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusPending),
+		).
+		SetStatus(status).
+		SetUpdatedAt(now)
+	if statusCode != nil {
+		update.SetLastStatusCode(*statusCode)
+	} else {
+		update.ClearLastStatusCode()
+	}
+	if message != "" {
+		update.SetLastError(truncateDataProviderOutboxError(message))
+	} else {
+		update.ClearLastError()
+	}
+	if status == dataprovideroutbox.StatusSucceeded {
+		update.SetDeliveredAt(now)
+	}
+	_, err := update.Save(ctx)
+	return err
+}
+
+// RetryDataProviderOutbox records a failed attempt of a claimed generation
+// and schedules the next one.
+func (c *Client) RetryDataProviderOutbox(ctx context.Context, id, generation int, statusCode *int, message string, nextAttemptAt time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	update := c.DataProviderOutbox.Update().
+		Where(
+			dataprovideroutbox.IDEQ(id),
+			dataprovideroutbox.GenerationEQ(generation),
+			dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusPending),
+		).
+		SetNextAttemptAt(nextAttemptAt).
+		SetLastError(truncateDataProviderOutboxError(message))
+	if statusCode != nil {
+		update.SetLastStatusCode(*statusCode)
+	} else {
+		update.ClearLastStatusCode()
+	}
+	_, err := update.Save(ctx)
+	return err
+}
+
+// DeferDataProviderOutbox holds back the other pending entries of a provider
+// that just failed, so an unavailable partner does not burn through the
+// attempt budget of its whole backlog.
+func (c *Client) DeferDataProviderOutbox(ctx context.Context, provider string, until time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataProviderOutbox.Update().
+		Where(
+			dataprovideroutbox.ProviderEQ(provider),
+			dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusPending),
+			dataprovideroutbox.NextAttemptAtLT(until),
+		).
+		SetNextAttemptAt(until).
+		Save(ctx)
+}
+
+// ResyncDataProviderOutbox re-queues the stored snapshots that match filter
+// for immediate delivery with a fresh attempt budget. The generation is
+// bumped so the outcome of a delivery already in flight is discarded.
+func (c *Client) ResyncDataProviderOutbox(ctx context.Context, filter DataProviderOutboxFilter, maxAttempts int, now time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataProviderOutbox.Update().
+		Where(filter.predicates()...).
+		AddGeneration(1).
+		SetStatus(dataprovideroutbox.StatusPending).
+		SetAttempts(0).
+		SetMaxAttempts(maxAttempts).
+		SetNextAttemptAt(now).
+		ClearDeliveredAt().
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+func (c *Client) ListDataProviderOutbox(ctx context.Context, filter DataProviderOutboxFilter, offset, limit int) ([]*DataProviderOutbox, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("postgresql client is nil")
+	}
+	preds := filter.predicates()
+	total, err := c.DataProviderOutbox.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := c.DataProviderOutbox.Query().
+		Where(preds...).
+		Order(dataprovideroutbox.BySnapshotAt(sql.OrderDesc()), dataprovideroutbox.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		Select(dataProviderOutboxMetadataFields...).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+// SummarizeDataProviderOutbox reports the backlog of each provider. Lag is
+// the age of the oldest snapshot still waiting to be delivered.
+func (c *Client) SummarizeDataProviderOutbox(ctx context.Context, providers []string, now time.Time) ([]DataProviderOutboxSummary, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	summaries := make([]DataProviderOutboxSummary, 0, len(providers))
+	for _, provider := range providers {
+		summary := DataProviderOutboxSummary{Provider: provider}
+		pending := c.DataProviderOutbox.Query().Where(
+			dataprovideroutbox.ProviderEQ(provider),
+			dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusPending),
+		)
+		var err error
+		if summary.Pending, err = pending.Clone().Count(ctx); err != nil {
+			return nil, err
+		}
+		if summary.Due, err = pending.Clone().Where(dataprovideroutbox.NextAttemptAtLTE(now)).Count(ctx); err != nil {
+			return nil, err
+		}
+		if summary.Retrying, err = pending.Clone().Where(dataprovideroutbox.AttemptsGT(0)).Count(ctx); err != nil {
+			return nil, err
+		}
+		if summary.Dead, err = c.DataProviderOutbox.Query().Where(
+			dataprovideroutbox.ProviderEQ(provider),
+			dataprovideroutbox.StatusEQ(dataprovideroutbox.StatusDead),
+		).Count(ctx); err != nil {
+			return nil, err
+		}
+		if summary.Pending > 0 {
+			oldest, err := pending.Clone().
+				Order(dataprovideroutbox.BySnapshotAt(sql.OrderAsc())).
+				Select(dataprovideroutbox.FieldSnapshotAt).
+				First(ctx)
+			if err != nil {
+				return nil, err
+			}
+			oldestAt := oldest.SnapshotAt.UTC()
+			summary.OldestPendingAt = &oldestAt
+			summary.LagSeconds = int64(now.Sub(oldestAt).Seconds())
+			next, err := pending.Clone().
+				Order(dataprovideroutbox.ByNextAttemptAt(sql.OrderAsc())).
+				Select(dataprovideroutbox.FieldNextAttemptAt).
+				First(ctx)
+			if err != nil {
+				return nil, err
+			}
+			nextAt := next.NextAttemptAt.UTC()
+			summary.NextAttemptAt = &nextAt
+		}
+		delivered, err := c.DataProviderOutbox.Query().
+			Where(
+				dataprovideroutbox.ProviderEQ(provider),
+				dataprovideroutbox.DeliveredAtNotNil(),
+			).
+			Order(dataprovideroutbox.ByDeliveredAt(sql.OrderDesc())).
+			Select(dataprovideroutbox.FieldDeliveredAt).
+			First(ctx)
+		if err != nil && !IsNotFound(err) {
+			return nil, err
+		}
+		if delivered != nil && delivered.DeliveredAt != nil {
+			deliveredAt := delivered.DeliveredAt.UTC()
+			summary.LastDeliveredAt = &deliveredAt
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// PurgeFinishedDataProviderOutbox deletes succeeded, skipped and dead
+// entries that have not changed since before.
+func (c *Client) PurgeFinishedDataProviderOutbox(ctx context.Context, before time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.DataProviderOutbox.Delete().
+		Where(
+			dataprovideroutbox.StatusIn(
+				dataprovideroutbox.StatusSucceeded,
+				dataprovideroutbox.StatusSkipped,
+				dataprovideroutbox.StatusDead,
+			),
+			dataprovideroutbox.UpdatedAtLT(before),
+		).
+		Exec(ctx)
+}
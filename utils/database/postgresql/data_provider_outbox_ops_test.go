@@ -0,0 +1,130 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDataProviderOutboxLatestSnapshotWins(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:data-provider-outbox-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	record := dbManager.DataProviderOutboxRecord{Provider: "partner", Server: "jp", GameUserID: "123", DataType: "suite", Payload: []byte("v1"), MaxAttempts: 3}
+	other := dbManager.DataProviderOutboxRecord{Provider: "other", Server: "jp", GameUserID: "123", DataType: "suite", Payload: []byte("v1"), MaxAttempts: 3}
+	if err := client.EnqueueDataProviderSnapshots(ctx, []dbManager.DataProviderOutboxRecord{record, other}, now); err != nil {
+		t.Fatalf("EnqueueDataProviderSnapshots returned error: %v", err)
+	}
+
+	due, err := client.ListDueDataProviderOutbox(ctx, now, []string{"other"}, 10)
+	if err != nil {
+		t.Fatalf("ListDueDataProviderOutbox returned error: %v", err)
+	}
+	if len(due) != 1 || due[0].Provider != "partner" || due[0].Payload != nil {
+		t.Fatalf("due entries = %+v, want only partner without payload", due)
+	}
+
+	lease := now.Add(time.Minute)
+	payload, claimed, err := client.ClaimDataProviderOutbox(ctx, due[0], now, lease)
+	if err != nil || !claimed || string(payload) != "v1" {
+		t.Fatalf("ClaimDataProviderOutbox = %q, %v, %v; want v1, true, nil", payload, claimed, err)
+	}
+	if _, claimed, err = client.ClaimDataProviderOutbox(ctx, due[0], now, lease); err != nil || claimed {
+		t.Fatalf("second ClaimDataProviderOutbox = %v, %v; want false, nil", claimed, err)
+	}
+
+	// A newer upload arrives while v1 is in flight.
+	later := now.Add(10 * time.Second)
+	record.Payload = []byte("v2")
+	if err := client.EnqueueDataProviderSnapshots(ctx, []dbManager.DataProviderOutboxRecord{record}, later); err != nil {
+		t.Fatalf("second EnqueueDataProviderSnapshots returned error: %v", err)
+	}
+	statusCode := 200
+	if err := client.FinishDataProviderOutbox(ctx, due[0].ID, due[0].Generation, dataprovideroutbox.StatusSucceeded, &statusCode, "", later); err != nil {
+		t.Fatalf("FinishDataProviderOutbox returned error: %v", err)
+	}
+	row, err := client.DataProviderOutbox.Get(ctx, due[0].ID)
+	if err != nil {
+		t.Fatalf("get outbox entry returned error: %v", err)
+	}
+	if row.Status != dataprovideroutbox.StatusPending || row.Generation != 2 || string(row.Payload) != "v2" || row.Attempts != 0 {
+		t.Fatalf("entry after stale finish = %+v, want pending generation 2 with v2", row)
+	}
+
+	due, err = client.ListDueDataProviderOutbox(ctx, later, nil, 10)
+	if err != nil {
+		t.Fatalf("ListDueDataProviderOutbox returned error: %v", err)
+	}
+	var partner *dbManager.DataProviderOutbox
+	for _, entry := range due {
+		if entry.Provider == "partner" {
+			partner = entry
+		}
+	}
+	if partner == nil {
+		t.Fatalf("partner entry is not due after a newer snapshot")
+	}
+	if payload, claimed, err = client.ClaimDataProviderOutbox(ctx, partner, later, later.Add(time.Minute)); err != nil || !claimed || string(payload) != "v2" {
+		t.Fatalf("ClaimDataProviderOutbox = %q, %v, %v; want v2, true, nil", payload, claimed, err)
+	}
+	retryAt := later.Add(time.Minute)
+	unavailable := 503
+	if err := client.RetryDataProviderOutbox(ctx, partner.ID, partner.Generation, &unavailable, "503 Service Unavailable", retryAt); err != nil {
+		t.Fatalf("RetryDataProviderOutbox returned error: %v", err)
+	}
+	deferred, err := client.DeferDataProviderOutbox(ctx, "partner", retryAt)
+	if err != nil || deferred != 0 {
+		t.Fatalf("DeferDataProviderOutbox = %d, %v; want 0, nil", deferred, err)
+	}
+
+	summaries, err := client.SummarizeDataProviderOutbox(ctx, []string{"partner", "other", "idle"}, later)
+	if err != nil {
+		t.Fatalf("SummarizeDataProviderOutbox returned error: %v", err)
+	}
+	if summaries[0].Pending != 1 || summaries[0].Due != 0 || summaries[0].Retrying != 1 || summaries[0].LagSeconds != 0 {
+		t.Fatalf("partner summary = %+v", summaries[0])
+	}
+	if summaries[1].Pending != 1 || summaries[1].Due != 1 || summaries[1].LagSeconds != 10 {
+		t.Fatalf("other summary = %+v", summaries[1])
+	}
+	if summaries[2].Pending != 0 || summaries[2].OldestPendingAt != nil {
+		t.Fatalf("idle summary = %+v", summaries[2])
+	}
+
+	requeued, err := client.ResyncDataProviderOutbox(ctx, dbManager.DataProviderOutboxFilter{Server: "jp", GameUserID: "123", Provider: "partner"}, 5, later)
+	if err != nil || requeued != 1 {
+		t.Fatalf("ResyncDataProviderOutbox = %d, %v; want 1, nil", requeued, err)
+	}
+	row, err = client.DataProviderOutbox.Get(ctx, partner.ID)
+	if err != nil {
+		t.Fatalf("get outbox entry returned error: %v", err)
+	}
+	if row.Generation != 3 || row.Attempts != 0 || row.MaxAttempts != 5 || !row.NextAttemptAt.Equal(later) {
+		t.Fatalf("entry after resync = %+v", row)
+	}
+
+	rows, total, err := client.ListDataProviderOutbox(ctx, dbManager.DataProviderOutboxFilter{Statuses: []dataprovideroutbox.Status{dataprovideroutbox.StatusPending}}, 0, 1)
+	if err != nil || total != 2 || len(rows) != 1 {
+		t.Fatalf("ListDataProviderOutbox = %d rows, total %d, %v; want 1 row, total 2", len(rows), total, err)
+	}
+
+	if err := client.FinishDataProviderOutbox(ctx, row.ID, row.Generation, dataprovideroutbox.StatusDead, nil, "gone", later); err != nil {
+		t.Fatalf("FinishDataProviderOutbox returned error: %v", err)
+	}
+	purged, err := client.PurgeFinishedDataProviderOutbox(ctx, later.Add(time.Second))
+	if err != nil || purged != 1 {
+		t.Fatalf("PurgeFinishedDataProviderOutbox = %d, %v; want 1, nil", purged, err)
+	}
+}
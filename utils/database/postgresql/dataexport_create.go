@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DataExportCreate) SetUserID(v string) *DataExportCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DataExportCreate) SetStatus(v dataexport.Status) *DataExportCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStatus(v *dataexport.Status) *DataExportCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *DataExportCreate) SetLocale(v string) *DataExportCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableLocale(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *DataExportCreate) SetAttempts(v int) *DataExportCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableAttempts(v *int) *DataExportCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *DataExportCreate) SetMaxAttempts(v int) *DataExportCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *DataExportCreate) SetNextAttemptAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableNextAttemptAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *DataExportCreate) SetStorageKey(v string) *DataExportCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStorageKey(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetStorageKey(*v)
+	}
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *DataExportCreate) SetSizeBytes(v int64) *DataExportCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableSizeBytes(v *int64) *DataExportCreate {
+	if v != nil {
+		_c.SetSizeBytes(*v)
+	}
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *DataExportCreate) SetSha256(v string) *DataExportCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableSha256(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetSha256(*v)
+	}
+	return _c
+}
+
+// SetDownloadTokenHash sets the "download_token_hash" field.
+func (_c *DataExportCreate) SetDownloadTokenHash(v string) *DataExportCreate {
+	_c.mutation.SetDownloadTokenHash(v)
+	return _c
+}
+
+// SetNillableDownloadTokenHash sets the "download_token_hash" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableDownloadTokenHash(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetDownloadTokenHash(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *DataExportCreate) SetDownloadCount(v int) *DataExportCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableDownloadCount(v *int) *DataExportCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *DataExportCreate) SetLastError(v string) *DataExportCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableLastError(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (_c *DataExportCreate) SetReadyAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetReadyAt(v)
+	return _c
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableReadyAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetReadyAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *DataExportCreate) SetExpiresAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableExpiresAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_c *DataExportCreate) SetLastDownloadedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetLastDownloadedAt(v)
+	return _c
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableLastDownloadedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetLastDownloadedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DataExportCreate) SetCreatedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableCreatedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DataExportCreate) SetUpdatedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableUpdatedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DataExportCreate) SetID(v string) *DataExportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_c *DataExportCreate) Mutation() *DataExportMutation {
+	return _c.mutation
+}
+
+// Save creates the DataExport in the database.
+func (_c *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DataExportCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := dataexport.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		v := dataexport.DefaultLocale
+		_c.mutation.SetLocale(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := dataexport.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := dataexport.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := dataexport.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := dataexport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := dataexport.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DataExportCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`postgresql: missing required field "DataExport.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := dataexport.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`postgresql: missing required field "DataExport.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`postgresql: missing required field "DataExport.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := dataexport.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`postgresql: missing required field "DataExport.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := dataexport.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`postgresql: missing required field "DataExport.max_attempts"`)}
+	}
+	if v, ok := _c.mutation.MaxAttempts(); ok {
+		if err := dataexport.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`postgresql: missing required field "DataExport.next_attempt_at"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := dataexport.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Sha256(); ok {
+		if err := dataexport.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DownloadTokenHash(); ok {
+		if err := dataexport.DownloadTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "download_token_hash", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.download_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`postgresql: missing required field "DataExport.download_count"`)}
+	}
+	if v, ok := _c.mutation.DownloadCount(); ok {
+		if err := dataexport.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.download_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := dataexport.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "DataExport.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "DataExport.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := dataexport.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DataExport.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dataexport.Table, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(dataexport.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(dataexport.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(dataexport.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(dataexport.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(dataexport.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = &value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = &value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(dataexport.FieldSha256, field.TypeString, value)
+		_node.Sha256 = &value
+	}
+	if value, ok := _c.mutation.DownloadTokenHash(); ok {
+		_spec.SetField(dataexport.FieldDownloadTokenHash, field.TypeString, value)
+		_node.DownloadTokenHash = &value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(dataexport.FieldDownloadCount, field.TypeInt, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(dataexport.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.ReadyAt(); ok {
+		_spec.SetField(dataexport.FieldReadyAt, field.TypeTime, value)
+		_node.ReadyAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(dataexport.FieldLastDownloadedAt, field.TypeTime, value)
+		_node.LastDownloadedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dataexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataexport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	err      error
+	builders []*DataExportCreate
+}
+
+// Save creates the DataExport entities in the database.
+func (_c *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DataExport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1018 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// DataExportUpdate is the builder for updating DataExport entities.
+type DataExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (_u *DataExportUpdate) Where(ps ...predicate.DataExport) *DataExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DataExportUpdate) SetStatus(v dataexport.Status) *DataExportUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableStatus(v *dataexport.Status) *DataExportUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *DataExportUpdate) SetLocale(v string) *DataExportUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableLocale(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DataExportUpdate) SetAttempts(v int) *DataExportUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableAttempts(v *int) *DataExportUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DataExportUpdate) AddAttempts(v int) *DataExportUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *DataExportUpdate) SetMaxAttempts(v int) *DataExportUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableMaxAttempts(v *int) *DataExportUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *DataExportUpdate) AddMaxAttempts(v int) *DataExportUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *DataExportUpdate) SetNextAttemptAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableNextAttemptAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *DataExportUpdate) SetStorageKey(v string) *DataExportUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableStorageKey(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *DataExportUpdate) ClearStorageKey() *DataExportUpdate {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *DataExportUpdate) SetSizeBytes(v int64) *DataExportUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableSizeBytes(v *int64) *DataExportUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *DataExportUpdate) AddSizeBytes(v int64) *DataExportUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (_u *DataExportUpdate) ClearSizeBytes() *DataExportUpdate {
+	_u.mutation.ClearSizeBytes()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *DataExportUpdate) SetSha256(v string) *DataExportUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableSha256(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *DataExportUpdate) ClearSha256() *DataExportUpdate {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetDownloadTokenHash sets the "download_token_hash" field.
+func (_u *DataExportUpdate) SetDownloadTokenHash(v string) *DataExportUpdate {
+	_u.mutation.SetDownloadTokenHash(v)
+	return _u
+}
+
+// SetNillableDownloadTokenHash sets the "download_token_hash" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableDownloadTokenHash(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetDownloadTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearDownloadTokenHash clears the value of the "download_token_hash" field.
+func (_u *DataExportUpdate) ClearDownloadTokenHash() *DataExportUpdate {
+	_u.mutation.ClearDownloadTokenHash()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *DataExportUpdate) SetDownloadCount(v int) *DataExportUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableDownloadCount(v *int) *DataExportUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *DataExportUpdate) AddDownloadCount(v int) *DataExportUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DataExportUpdate) SetLastError(v string) *DataExportUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableLastError(v *string) *DataExportUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DataExportUpdate) ClearLastError() *DataExportUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (_u *DataExportUpdate) SetReadyAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetReadyAt(v)
+	return _u
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableReadyAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetReadyAt(*v)
+	}
+	return _u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (_u *DataExportUpdate) ClearReadyAt() *DataExportUpdate {
+	_u.mutation.ClearReadyAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *DataExportUpdate) SetExpiresAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableExpiresAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *DataExportUpdate) ClearExpiresAt() *DataExportUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_u *DataExportUpdate) SetLastDownloadedAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetLastDownloadedAt(v)
+	return _u
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_u *DataExportUpdate) SetNillableLastDownloadedAt(v *time.Time) *DataExportUpdate {
+	if v != nil {
+		_u.SetLastDownloadedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (_u *DataExportUpdate) ClearLastDownloadedAt() *DataExportUpdate {
+	_u.mutation.ClearLastDownloadedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DataExportUpdate) SetUpdatedAt(v time.Time) *DataExportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_u *DataExportUpdate) Mutation() *DataExportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DataExportUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DataExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DataExportUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := dataexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataExportUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := dataexport.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := dataexport.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := dataexport.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := dataexport.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := dataexport.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadTokenHash(); ok {
+		if err := dataexport.DownloadTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "download_token_hash", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.download_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadCount(); ok {
+		if err := dataexport.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.download_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := dataexport.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DataExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(dataexport.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(dataexport.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(dataexport.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(dataexport.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(dataexport.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(dataexport.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(dataexport.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.SizeBytesCleared() {
+		_spec.ClearField(dataexport.FieldSizeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(dataexport.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(dataexport.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadTokenHash(); ok {
+		_spec.SetField(dataexport.FieldDownloadTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.DownloadTokenHashCleared() {
+		_spec.ClearField(dataexport.FieldDownloadTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(dataexport.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(dataexport.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(dataexport.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(dataexport.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadyAt(); ok {
+		_spec.SetField(dataexport.FieldReadyAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadyAtCleared() {
+		_spec.ClearField(dataexport.FieldReadyAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(dataexport.FieldLastDownloadedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadedAtCleared() {
+		_spec.ClearField(dataexport.FieldLastDownloadedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DataExportUpdateOne is the builder for updating a single DataExport entity.
+type DataExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataExportMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *DataExportUpdateOne) SetStatus(v dataexport.Status) *DataExportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableStatus(v *dataexport.Status) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *DataExportUpdateOne) SetLocale(v string) *DataExportUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableLocale(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DataExportUpdateOne) SetAttempts(v int) *DataExportUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableAttempts(v *int) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DataExportUpdateOne) AddAttempts(v int) *DataExportUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *DataExportUpdateOne) SetMaxAttempts(v int) *DataExportUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableMaxAttempts(v *int) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *DataExportUpdateOne) AddMaxAttempts(v int) *DataExportUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *DataExportUpdateOne) SetNextAttemptAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableNextAttemptAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *DataExportUpdateOne) SetStorageKey(v string) *DataExportUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableStorageKey(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (_u *DataExportUpdateOne) ClearStorageKey() *DataExportUpdateOne {
+	_u.mutation.ClearStorageKey()
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *DataExportUpdateOne) SetSizeBytes(v int64) *DataExportUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableSizeBytes(v *int64) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *DataExportUpdateOne) AddSizeBytes(v int64) *DataExportUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (_u *DataExportUpdateOne) ClearSizeBytes() *DataExportUpdateOne {
+	_u.mutation.ClearSizeBytes()
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *DataExportUpdateOne) SetSha256(v string) *DataExportUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableSha256(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// ClearSha256 clears the value of the "sha256" field.
+func (_u *DataExportUpdateOne) ClearSha256() *DataExportUpdateOne {
+	_u.mutation.ClearSha256()
+	return _u
+}
+
+// SetDownloadTokenHash sets the "download_token_hash" field.
+func (_u *DataExportUpdateOne) SetDownloadTokenHash(v string) *DataExportUpdateOne {
+	_u.mutation.SetDownloadTokenHash(v)
+	return _u
+}
+
+// SetNillableDownloadTokenHash sets the "download_token_hash" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableDownloadTokenHash(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetDownloadTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearDownloadTokenHash clears the value of the "download_token_hash" field.
+func (_u *DataExportUpdateOne) ClearDownloadTokenHash() *DataExportUpdateOne {
+	_u.mutation.ClearDownloadTokenHash()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *DataExportUpdateOne) SetDownloadCount(v int) *DataExportUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableDownloadCount(v *int) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *DataExportUpdateOne) AddDownloadCount(v int) *DataExportUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DataExportUpdateOne) SetLastError(v string) *DataExportUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableLastError(v *string) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DataExportUpdateOne) ClearLastError() *DataExportUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (_u *DataExportUpdateOne) SetReadyAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetReadyAt(v)
+	return _u
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableReadyAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetReadyAt(*v)
+	}
+	return _u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (_u *DataExportUpdateOne) ClearReadyAt() *DataExportUpdateOne {
+	_u.mutation.ClearReadyAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *DataExportUpdateOne) SetExpiresAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableExpiresAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *DataExportUpdateOne) ClearExpiresAt() *DataExportUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_u *DataExportUpdateOne) SetLastDownloadedAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetLastDownloadedAt(v)
+	return _u
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_u *DataExportUpdateOne) SetNillableLastDownloadedAt(v *time.Time) *DataExportUpdateOne {
+	if v != nil {
+		_u.SetLastDownloadedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (_u *DataExportUpdateOne) ClearLastDownloadedAt() *DataExportUpdateOne {
+	_u.mutation.ClearLastDownloadedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DataExportUpdateOne) SetUpdatedAt(v time.Time) *DataExportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_u *DataExportUpdateOne) Mutation() *DataExportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DataExportUpdate builder.
+func (_u *DataExportUpdateOne) Where(ps ...predicate.DataExport) *DataExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DataExportUpdateOne) Select(field string, fields ...string) *DataExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DataExport entity.
+func (_u *DataExportUpdateOne) Save(ctx context.Context) (*DataExport, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataExportUpdateOne) SaveX(ctx context.Context) *DataExport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DataExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DataExportUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := dataexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataExportUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := dataexport.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := dataexport.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := dataexport.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := dataexport.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := dataexport.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadTokenHash(); ok {
+		if err := dataexport.DownloadTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "download_token_hash", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.download_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadCount(); ok {
+		if err := dataexport.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.download_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := dataexport.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "DataExport.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DataExportUpdateOne) sqlSave(ctx context.Context) (_node *DataExport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataexport.Table, dataexport.Columns, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "DataExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataexport.FieldID)
+		for _, f := range fields {
+			if !dataexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != dataexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(dataexport.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(dataexport.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(dataexport.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(dataexport.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(dataexport.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(dataexport.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+	}
+	if _u.mutation.StorageKeyCleared() {
+		_spec.ClearField(dataexport.FieldStorageKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(dataexport.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.SizeBytesCleared() {
+		_spec.ClearField(dataexport.FieldSizeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(dataexport.FieldSha256, field.TypeString, value)
+	}
+	if _u.mutation.Sha256Cleared() {
+		_spec.ClearField(dataexport.FieldSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadTokenHash(); ok {
+		_spec.SetField(dataexport.FieldDownloadTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.DownloadTokenHashCleared() {
+		_spec.ClearField(dataexport.FieldDownloadTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(dataexport.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(dataexport.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(dataexport.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(dataexport.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadyAt(); ok {
+		_spec.SetField(dataexport.FieldReadyAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadyAtCleared() {
+		_spec.ClearField(dataexport.FieldReadyAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(dataexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(dataexport.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(dataexport.FieldLastDownloadedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadedAtCleared() {
+		_spec.ClearField(dataexport.FieldLastDownloadedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DataExport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
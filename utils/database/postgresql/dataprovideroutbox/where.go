@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dataprovideroutbox
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldProvider, v))
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldServer, v))
+}
+
+// GameUserID applies equality check predicate on the "game_user_id" field. It's identical to GameUserIDEQ.
+func GameUserID(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldGameUserID, v))
+}
+
+// DataType applies equality check predicate on the "data_type" field. It's identical to DataTypeEQ.
+func DataType(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldDataType, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldPayload, v))
+}
+
+// Generation applies equality check predicate on the "generation" field. It's identical to GenerationEQ.
+func Generation(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldGeneration, v))
+}
+
+// SnapshotAt applies equality check predicate on the "snapshot_at" field. It's identical to SnapshotAtEQ.
+func SnapshotAt(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldSnapshotAt, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastStatusCode applies equality check predicate on the "last_status_code" field. It's identical to LastStatusCodeEQ.
+func LastStatusCode(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldLastStatusCode, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldServer, v))
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldServer, v))
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldServer, vs...))
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldServer, vs...))
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldServer, v))
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldServer, v))
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldServer, v))
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldServer, v))
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContains(FieldServer, v))
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasPrefix(FieldServer, v))
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasSuffix(FieldServer, v))
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEqualFold(FieldServer, v))
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContainsFold(FieldServer, v))
+}
+
+// GameUserIDEQ applies the EQ predicate on the "game_user_id" field.
+func GameUserIDEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldGameUserID, v))
+}
+
+// GameUserIDNEQ applies the NEQ predicate on the "game_user_id" field.
+func GameUserIDNEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldGameUserID, v))
+}
+
+// GameUserIDIn applies the In predicate on the "game_user_id" field.
+func GameUserIDIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldGameUserID, vs...))
+}
+
+// GameUserIDNotIn applies the NotIn predicate on the "game_user_id" field.
+func GameUserIDNotIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldGameUserID, vs...))
+}
+
+// GameUserIDGT applies the GT predicate on the "game_user_id" field.
+func GameUserIDGT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldGameUserID, v))
+}
+
+// GameUserIDGTE applies the GTE predicate on the "game_user_id" field.
+func GameUserIDGTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldGameUserID, v))
+}
+
+// GameUserIDLT applies the LT predicate on the "game_user_id" field.
+func GameUserIDLT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldGameUserID, v))
+}
+
+// GameUserIDLTE applies the LTE predicate on the "game_user_id" field.
+func GameUserIDLTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldGameUserID, v))
+}
+
+// GameUserIDContains applies the Contains predicate on the "game_user_id" field.
+func GameUserIDContains(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContains(FieldGameUserID, v))
+}
+
+// GameUserIDHasPrefix applies the HasPrefix predicate on the "game_user_id" field.
+func GameUserIDHasPrefix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasPrefix(FieldGameUserID, v))
+}
+
+// GameUserIDHasSuffix applies the HasSuffix predicate on the "game_user_id" field.
+func GameUserIDHasSuffix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasSuffix(FieldGameUserID, v))
+}
+
+// GameUserIDEqualFold applies the EqualFold predicate on the "game_user_id" field.
+func GameUserIDEqualFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEqualFold(FieldGameUserID, v))
+}
+
+// GameUserIDContainsFold applies the ContainsFold predicate on the "game_user_id" field.
+func GameUserIDContainsFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContainsFold(FieldGameUserID, v))
+}
+
+// DataTypeEQ applies the EQ predicate on the "data_type" field.
+func DataTypeEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldDataType, v))
+}
+
+// DataTypeNEQ applies the NEQ predicate on the "data_type" field.
+func DataTypeNEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldDataType, v))
+}
+
+// DataTypeIn applies the In predicate on the "data_type" field.
+func DataTypeIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldDataType, vs...))
+}
+
+// DataTypeNotIn applies the NotIn predicate on the "data_type" field.
+func DataTypeNotIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldDataType, vs...))
+}
+
+// DataTypeGT applies the GT predicate on the "data_type" field.
+func DataTypeGT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldDataType, v))
+}
+
+// DataTypeGTE applies the GTE predicate on the "data_type" field.
+func DataTypeGTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldDataType, v))
+}
+
+// DataTypeLT applies the LT predicate on the "data_type" field.
+func DataTypeLT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldDataType, v))
+}
+
+// DataTypeLTE applies the LTE predicate on the "data_type" field.
+func DataTypeLTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldDataType, v))
+}
+
+// DataTypeContains applies the Contains predicate on the "data_type" field.
+func DataTypeContains(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContains(FieldDataType, v))
+}
+
+// DataTypeHasPrefix applies the HasPrefix predicate on the "data_type" field.
+func DataTypeHasPrefix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasPrefix(FieldDataType, v))
+}
+
+// DataTypeHasSuffix applies the HasSuffix predicate on the "data_type" field.
+func DataTypeHasSuffix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasSuffix(FieldDataType, v))
+}
+
+// DataTypeEqualFold applies the EqualFold predicate on the "data_type" field.
+func DataTypeEqualFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEqualFold(FieldDataType, v))
+}
+
+// DataTypeContainsFold applies the ContainsFold predicate on the "data_type" field.
+func DataTypeContainsFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContainsFold(FieldDataType, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...[]byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...[]byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v []byte) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldPayload, v))
+}
+
+// GenerationEQ applies the EQ predicate on the "generation" field.
+func GenerationEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldGeneration, v))
+}
+
+// GenerationNEQ applies the NEQ predicate on the "generation" field.
+func GenerationNEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldGeneration, v))
+}
+
+// GenerationIn applies the In predicate on the "generation" field.
+func GenerationIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldGeneration, vs...))
+}
+
+// GenerationNotIn applies the NotIn predicate on the "generation" field.
+func GenerationNotIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldGeneration, vs...))
+}
+
+// GenerationGT applies the GT predicate on the "generation" field.
+func GenerationGT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldGeneration, v))
+}
+
+// GenerationGTE applies the GTE predicate on the "generation" field.
+func GenerationGTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldGeneration, v))
+}
+
+// GenerationLT applies the LT predicate on the "generation" field.
+func GenerationLT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldGeneration, v))
+}
+
+// GenerationLTE applies the LTE predicate on the "generation" field.
+func GenerationLTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldGeneration, v))
+}
+
+// SnapshotAtEQ applies the EQ predicate on the "snapshot_at" field.
+func SnapshotAtEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldSnapshotAt, v))
+}
+
+// SnapshotAtNEQ applies the NEQ predicate on the "snapshot_at" field.
+func SnapshotAtNEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldSnapshotAt, v))
+}
+
+// SnapshotAtIn applies the In predicate on the "snapshot_at" field.
+func SnapshotAtIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldSnapshotAt, vs...))
+}
+
+// SnapshotAtNotIn applies the NotIn predicate on the "snapshot_at" field.
+func SnapshotAtNotIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldSnapshotAt, vs...))
+}
+
+// SnapshotAtGT applies the GT predicate on the "snapshot_at" field.
+func SnapshotAtGT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldSnapshotAt, v))
+}
+
+// SnapshotAtGTE applies the GTE predicate on the "snapshot_at" field.
+func SnapshotAtGTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldSnapshotAt, v))
+}
+
+// SnapshotAtLT applies the LT predicate on the "snapshot_at" field.
+func SnapshotAtLT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldSnapshotAt, v))
+}
+
+// SnapshotAtLTE applies the LTE predicate on the "snapshot_at" field.
+func SnapshotAtLTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldSnapshotAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldAttempts, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// LastStatusCodeEQ applies the EQ predicate on the "last_status_code" field.
+func LastStatusCodeEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeNEQ applies the NEQ predicate on the "last_status_code" field.
+func LastStatusCodeNEQ(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeIn applies the In predicate on the "last_status_code" field.
+func LastStatusCodeIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldLastStatusCode, vs...))
+}
+
+// LastStatusCodeNotIn applies the NotIn predicate on the "last_status_code" field.
+func LastStatusCodeNotIn(vs ...int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldLastStatusCode, vs...))
+}
+
+// LastStatusCodeGT applies the GT predicate on the "last_status_code" field.
+func LastStatusCodeGT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeGTE applies the GTE predicate on the "last_status_code" field.
+func LastStatusCodeGTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeLT applies the LT predicate on the "last_status_code" field.
+func LastStatusCodeLT(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeLTE applies the LTE predicate on the "last_status_code" field.
+func LastStatusCodeLTE(v int) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeIsNil applies the IsNil predicate on the "last_status_code" field.
+func LastStatusCodeIsNil() predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIsNull(FieldLastStatusCode))
+}
+
+// LastStatusCodeNotNil applies the NotNil predicate on the "last_status_code" field.
+func LastStatusCodeNotNil() predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotNull(FieldLastStatusCode))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DataProviderOutbox) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DataProviderOutbox) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DataProviderOutbox) predicate.DataProviderOutbox {
+	return predicate.DataProviderOutbox(sql.NotPredicates(p))
+}
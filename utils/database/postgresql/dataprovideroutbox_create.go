@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+)
+
+// DataProviderOutboxCreate is the builder for creating a DataProviderOutbox entity.
+type DataProviderOutboxCreate struct {
+	config
+	mutation *DataProviderOutboxMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *DataProviderOutboxCreate) SetProvider(v string) *DataProviderOutboxCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetServer sets the "server" field.
+func (_c *DataProviderOutboxCreate) SetServer(v string) *DataProviderOutboxCreate {
+	_c.mutation.SetServer(v)
+	return _c
+}
+
+// SetGameUserID sets the "game_user_id" field.
+func (_c *DataProviderOutboxCreate) SetGameUserID(v string) *DataProviderOutboxCreate {
+	_c.mutation.SetGameUserID(v)
+	return _c
+}
+
+// SetDataType sets the "data_type" field.
+func (_c *DataProviderOutboxCreate) SetDataType(v string) *DataProviderOutboxCreate {
+	_c.mutation.SetDataType(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *DataProviderOutboxCreate) SetPayload(v []byte) *DataProviderOutboxCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetGeneration sets the "generation" field.
+func (_c *DataProviderOutboxCreate) SetGeneration(v int) *DataProviderOutboxCreate {
+	_c.mutation.SetGeneration(v)
+	return _c
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableGeneration(v *int) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetGeneration(*v)
+	}
+	return _c
+}
+
+// SetSnapshotAt sets the "snapshot_at" field.
+func (_c *DataProviderOutboxCreate) SetSnapshotAt(v time.Time) *DataProviderOutboxCreate {
+	_c.mutation.SetSnapshotAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DataProviderOutboxCreate) SetStatus(v dataprovideroutbox.Status) *DataProviderOutboxCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableStatus(v *dataprovideroutbox.Status) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *DataProviderOutboxCreate) SetAttempts(v int) *DataProviderOutboxCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableAttempts(v *int) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *DataProviderOutboxCreate) SetMaxAttempts(v int) *DataProviderOutboxCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *DataProviderOutboxCreate) SetNextAttemptAt(v time.Time) *DataProviderOutboxCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableNextAttemptAt(v *time.Time) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (_c *DataProviderOutboxCreate) SetLastStatusCode(v int) *DataProviderOutboxCreate {
+	_c.mutation.SetLastStatusCode(v)
+	return _c
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableLastStatusCode(v *int) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetLastStatusCode(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *DataProviderOutboxCreate) SetLastError(v string) *DataProviderOutboxCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableLastError(v *string) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *DataProviderOutboxCreate) SetDeliveredAt(v time.Time) *DataProviderOutboxCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableDeliveredAt(v *time.Time) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DataProviderOutboxCreate) SetCreatedAt(v time.Time) *DataProviderOutboxCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableCreatedAt(v *time.Time) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DataProviderOutboxCreate) SetUpdatedAt(v time.Time) *DataProviderOutboxCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DataProviderOutboxCreate) SetNillableUpdatedAt(v *time.Time) *DataProviderOutboxCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DataProviderOutboxMutation object of the builder.
+func (_c *DataProviderOutboxCreate) Mutation() *DataProviderOutboxMutation {
+	return _c.mutation
+}
+
+// Save creates the DataProviderOutbox in the database.
+func (_c *DataProviderOutboxCreate) Save(ctx context.Context) (*DataProviderOutbox, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DataProviderOutboxCreate) SaveX(ctx context.Context) *DataProviderOutbox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataProviderOutboxCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataProviderOutboxCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DataProviderOutboxCreate) defaults() {
+	if _, ok := _c.mutation.Generation(); !ok {
+		v := dataprovideroutbox.DefaultGeneration
+		_c.mutation.SetGeneration(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := dataprovideroutbox.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := dataprovideroutbox.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := dataprovideroutbox.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := dataprovideroutbox.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := dataprovideroutbox.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DataProviderOutboxCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`postgresql: missing required field "DataProviderOutbox.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := dataprovideroutbox.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`postgresql: missing required field "DataProviderOutbox.server"`)}
+	}
+	if v, ok := _c.mutation.Server(); ok {
+		if err := dataprovideroutbox.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.server": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GameUserID(); !ok {
+		return &ValidationError{Name: "game_user_id", err: errors.New(`postgresql: missing required field "DataProviderOutbox.game_user_id"`)}
+	}
+	if v, ok := _c.mutation.GameUserID(); ok {
+		if err := dataprovideroutbox.GameUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_user_id", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.game_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DataType(); !ok {
+		return &ValidationError{Name: "data_type", err: errors.New(`postgresql: missing required field "DataProviderOutbox.data_type"`)}
+	}
+	if v, ok := _c.mutation.DataType(); ok {
+		if err := dataprovideroutbox.DataTypeValidator(v); err != nil {
+			return &ValidationError{Name: "data_type", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.data_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`postgresql: missing required field "DataProviderOutbox.payload"`)}
+	}
+	if _, ok := _c.mutation.Generation(); !ok {
+		return &ValidationError{Name: "generation", err: errors.New(`postgresql: missing required field "DataProviderOutbox.generation"`)}
+	}
+	if v, ok := _c.mutation.Generation(); ok {
+		if err := dataprovideroutbox.GenerationValidator(v); err != nil {
+			return &ValidationError{Name: "generation", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.generation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SnapshotAt(); !ok {
+		return &ValidationError{Name: "snapshot_at", err: errors.New(`postgresql: missing required field "DataProviderOutbox.snapshot_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`postgresql: missing required field "DataProviderOutbox.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := dataprovideroutbox.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`postgresql: missing required field "DataProviderOutbox.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := dataprovideroutbox.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`postgresql: missing required field "DataProviderOutbox.max_attempts"`)}
+	}
+	if v, ok := _c.mutation.MaxAttempts(); ok {
+		if err := dataprovideroutbox.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`postgresql: missing required field "DataProviderOutbox.next_attempt_at"`)}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := dataprovideroutbox.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "DataProviderOutbox.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "DataProviderOutbox.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DataProviderOutboxCreate) sqlSave(ctx context.Context) (*DataProviderOutbox, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DataProviderOutboxCreate) createSpec() (*DataProviderOutbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataProviderOutbox{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dataprovideroutbox.Table, sqlgraph.NewFieldSpec(dataprovideroutbox.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(dataprovideroutbox.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Server(); ok {
+		_spec.SetField(dataprovideroutbox.FieldServer, field.TypeString, value)
+		_node.Server = value
+	}
+	if value, ok := _c.mutation.GameUserID(); ok {
+		_spec.SetField(dataprovideroutbox.FieldGameUserID, field.TypeString, value)
+		_node.GameUserID = value
+	}
+	if value, ok := _c.mutation.DataType(); ok {
+		_spec.SetField(dataprovideroutbox.FieldDataType, field.TypeString, value)
+		_node.DataType = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(dataprovideroutbox.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Generation(); ok {
+		_spec.SetField(dataprovideroutbox.FieldGeneration, field.TypeInt, value)
+		_node.Generation = value
+	}
+	if value, ok := _c.mutation.SnapshotAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldSnapshotAt, field.TypeTime, value)
+		_node.SnapshotAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(dataprovideroutbox.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(dataprovideroutbox.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(dataprovideroutbox.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LastStatusCode(); ok {
+		_spec.SetField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt, value)
+		_node.LastStatusCode = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(dataprovideroutbox.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DataProviderOutboxCreateBulk is the builder for creating many DataProviderOutbox entities in bulk.
+type DataProviderOutboxCreateBulk struct {
+	config
+	err      error
+	builders []*DataProviderOutboxCreate
+}
+
+// Save creates the DataProviderOutbox entities in the database.
+func (_c *DataProviderOutboxCreateBulk) Save(ctx context.Context) ([]*DataProviderOutbox, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DataProviderOutbox, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataProviderOutboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DataProviderOutboxCreateBulk) SaveX(ctx context.Context) []*DataProviderOutbox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataProviderOutboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataProviderOutboxCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,736 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// DataProviderOutboxUpdate is the builder for updating DataProviderOutbox entities.
+type DataProviderOutboxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataProviderOutboxMutation
+}
+
+// Where appends a list predicates to the DataProviderOutboxUpdate builder.
+func (_u *DataProviderOutboxUpdate) Where(ps ...predicate.DataProviderOutbox) *DataProviderOutboxUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *DataProviderOutboxUpdate) SetPayload(v []byte) *DataProviderOutboxUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *DataProviderOutboxUpdate) SetGeneration(v int) *DataProviderOutboxUpdate {
+	_u.mutation.ResetGeneration()
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableGeneration(v *int) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// AddGeneration adds value to the "generation" field.
+func (_u *DataProviderOutboxUpdate) AddGeneration(v int) *DataProviderOutboxUpdate {
+	_u.mutation.AddGeneration(v)
+	return _u
+}
+
+// SetSnapshotAt sets the "snapshot_at" field.
+func (_u *DataProviderOutboxUpdate) SetSnapshotAt(v time.Time) *DataProviderOutboxUpdate {
+	_u.mutation.SetSnapshotAt(v)
+	return _u
+}
+
+// SetNillableSnapshotAt sets the "snapshot_at" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableSnapshotAt(v *time.Time) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetSnapshotAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DataProviderOutboxUpdate) SetStatus(v dataprovideroutbox.Status) *DataProviderOutboxUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableStatus(v *dataprovideroutbox.Status) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DataProviderOutboxUpdate) SetAttempts(v int) *DataProviderOutboxUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableAttempts(v *int) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DataProviderOutboxUpdate) AddAttempts(v int) *DataProviderOutboxUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *DataProviderOutboxUpdate) SetMaxAttempts(v int) *DataProviderOutboxUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableMaxAttempts(v *int) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *DataProviderOutboxUpdate) AddMaxAttempts(v int) *DataProviderOutboxUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *DataProviderOutboxUpdate) SetNextAttemptAt(v time.Time) *DataProviderOutboxUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableNextAttemptAt(v *time.Time) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (_u *DataProviderOutboxUpdate) SetLastStatusCode(v int) *DataProviderOutboxUpdate {
+	_u.mutation.ResetLastStatusCode()
+	_u.mutation.SetLastStatusCode(v)
+	return _u
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableLastStatusCode(v *int) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetLastStatusCode(*v)
+	}
+	return _u
+}
+
+// AddLastStatusCode adds value to the "last_status_code" field.
+func (_u *DataProviderOutboxUpdate) AddLastStatusCode(v int) *DataProviderOutboxUpdate {
+	_u.mutation.AddLastStatusCode(v)
+	return _u
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (_u *DataProviderOutboxUpdate) ClearLastStatusCode() *DataProviderOutboxUpdate {
+	_u.mutation.ClearLastStatusCode()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DataProviderOutboxUpdate) SetLastError(v string) *DataProviderOutboxUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableLastError(v *string) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DataProviderOutboxUpdate) ClearLastError() *DataProviderOutboxUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *DataProviderOutboxUpdate) SetDeliveredAt(v time.Time) *DataProviderOutboxUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdate) SetNillableDeliveredAt(v *time.Time) *DataProviderOutboxUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *DataProviderOutboxUpdate) ClearDeliveredAt() *DataProviderOutboxUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DataProviderOutboxUpdate) SetUpdatedAt(v time.Time) *DataProviderOutboxUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DataProviderOutboxMutation object of the builder.
+func (_u *DataProviderOutboxUpdate) Mutation() *DataProviderOutboxMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DataProviderOutboxUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataProviderOutboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DataProviderOutboxUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataProviderOutboxUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DataProviderOutboxUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := dataprovideroutbox.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataProviderOutboxUpdate) check() error {
+	if v, ok := _u.mutation.Generation(); ok {
+		if err := dataprovideroutbox.GenerationValidator(v); err != nil {
+			return &ValidationError{Name: "generation", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.generation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := dataprovideroutbox.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := dataprovideroutbox.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := dataprovideroutbox.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := dataprovideroutbox.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DataProviderOutboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataprovideroutbox.Table, dataprovideroutbox.Columns, sqlgraph.NewFieldSpec(dataprovideroutbox.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(dataprovideroutbox.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(dataprovideroutbox.FieldGeneration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeneration(); ok {
+		_spec.AddField(dataprovideroutbox.FieldGeneration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SnapshotAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldSnapshotAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(dataprovideroutbox.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(dataprovideroutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(dataprovideroutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(dataprovideroutbox.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(dataprovideroutbox.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastStatusCode(); ok {
+		_spec.SetField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.LastStatusCodeCleared() {
+		_spec.ClearField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(dataprovideroutbox.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(dataprovideroutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(dataprovideroutbox.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataprovideroutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DataProviderOutboxUpdateOne is the builder for updating a single DataProviderOutbox entity.
+type DataProviderOutboxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataProviderOutboxMutation
+}
+
+// SetPayload sets the "payload" field.
+func (_u *DataProviderOutboxUpdateOne) SetPayload(v []byte) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *DataProviderOutboxUpdateOne) SetGeneration(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.ResetGeneration()
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableGeneration(v *int) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// AddGeneration adds value to the "generation" field.
+func (_u *DataProviderOutboxUpdateOne) AddGeneration(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.AddGeneration(v)
+	return _u
+}
+
+// SetSnapshotAt sets the "snapshot_at" field.
+func (_u *DataProviderOutboxUpdateOne) SetSnapshotAt(v time.Time) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetSnapshotAt(v)
+	return _u
+}
+
+// SetNillableSnapshotAt sets the "snapshot_at" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableSnapshotAt(v *time.Time) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetSnapshotAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DataProviderOutboxUpdateOne) SetStatus(v dataprovideroutbox.Status) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableStatus(v *dataprovideroutbox.Status) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DataProviderOutboxUpdateOne) SetAttempts(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableAttempts(v *int) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DataProviderOutboxUpdateOne) AddAttempts(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *DataProviderOutboxUpdateOne) SetMaxAttempts(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableMaxAttempts(v *int) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *DataProviderOutboxUpdateOne) AddMaxAttempts(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *DataProviderOutboxUpdateOne) SetNextAttemptAt(v time.Time) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableNextAttemptAt(v *time.Time) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (_u *DataProviderOutboxUpdateOne) SetLastStatusCode(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.ResetLastStatusCode()
+	_u.mutation.SetLastStatusCode(v)
+	return _u
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableLastStatusCode(v *int) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetLastStatusCode(*v)
+	}
+	return _u
+}
+
+// AddLastStatusCode adds value to the "last_status_code" field.
+func (_u *DataProviderOutboxUpdateOne) AddLastStatusCode(v int) *DataProviderOutboxUpdateOne {
+	_u.mutation.AddLastStatusCode(v)
+	return _u
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (_u *DataProviderOutboxUpdateOne) ClearLastStatusCode() *DataProviderOutboxUpdateOne {
+	_u.mutation.ClearLastStatusCode()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *DataProviderOutboxUpdateOne) SetLastError(v string) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableLastError(v *string) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *DataProviderOutboxUpdateOne) ClearLastError() *DataProviderOutboxUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *DataProviderOutboxUpdateOne) SetDeliveredAt(v time.Time) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *DataProviderOutboxUpdateOne) SetNillableDeliveredAt(v *time.Time) *DataProviderOutboxUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *DataProviderOutboxUpdateOne) ClearDeliveredAt() *DataProviderOutboxUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DataProviderOutboxUpdateOne) SetUpdatedAt(v time.Time) *DataProviderOutboxUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DataProviderOutboxMutation object of the builder.
+func (_u *DataProviderOutboxUpdateOne) Mutation() *DataProviderOutboxMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DataProviderOutboxUpdate builder.
+func (_u *DataProviderOutboxUpdateOne) Where(ps ...predicate.DataProviderOutbox) *DataProviderOutboxUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DataProviderOutboxUpdateOne) Select(field string, fields ...string) *DataProviderOutboxUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DataProviderOutbox entity.
+func (_u *DataProviderOutboxUpdateOne) Save(ctx context.Context) (*DataProviderOutbox, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DataProviderOutboxUpdateOne) SaveX(ctx context.Context) *DataProviderOutbox {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DataProviderOutboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DataProviderOutboxUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DataProviderOutboxUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := dataprovideroutbox.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DataProviderOutboxUpdateOne) check() error {
+	if v, ok := _u.mutation.Generation(); ok {
+		if err := dataprovideroutbox.GenerationValidator(v); err != nil {
+			return &ValidationError{Name: "generation", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.generation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := dataprovideroutbox.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := dataprovideroutbox.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := dataprovideroutbox.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := dataprovideroutbox.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "DataProviderOutbox.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DataProviderOutboxUpdateOne) sqlSave(ctx context.Context) (_node *DataProviderOutbox, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dataprovideroutbox.Table, dataprovideroutbox.Columns, sqlgraph.NewFieldSpec(dataprovideroutbox.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "DataProviderOutbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataprovideroutbox.FieldID)
+		for _, f := range fields {
+			if !dataprovideroutbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != dataprovideroutbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(dataprovideroutbox.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(dataprovideroutbox.FieldGeneration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeneration(); ok {
+		_spec.AddField(dataprovideroutbox.FieldGeneration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SnapshotAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldSnapshotAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(dataprovideroutbox.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(dataprovideroutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(dataprovideroutbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(dataprovideroutbox.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(dataprovideroutbox.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastStatusCode(); ok {
+		_spec.SetField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.LastStatusCodeCleared() {
+		_spec.ClearField(dataprovideroutbox.FieldLastStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(dataprovideroutbox.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(dataprovideroutbox.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(dataprovideroutbox.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataprovideroutbox.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DataProviderOutbox{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataprovideroutbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,499 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const emailOutboxErrorMaxLen = 500
+
+// emailMessageMetadataFields are all columns but the body, which may hold
+// verification codes and is only read by the sender.
+var emailMessageMetadataFields = []string{
+	emailmessage.FieldRecipient,
+	emailmessage.FieldSubject,
+	emailmessage.FieldDisplayName,
+	emailmessage.FieldTemplate,
+	emailmessage.FieldLocale,
+	emailmessage.FieldStatus,
+	emailmessage.FieldAttempts,
+	emailmessage.FieldMaxAttempts,
+	emailmessage.FieldNextAttemptAt,
+	emailmessage.FieldExpiresAt,
+	emailmessage.FieldRelay,
+	emailmessage.FieldLastError,
+	emailmessage.FieldSentAt,
+	emailmessage.FieldCreatedAt,
+	emailmessage.FieldUpdatedAt,
+}
+
+type EmailMessageRecord struct {
+	ID          string
+	Recipient   string
+	Subject     string
+	Body        string
+	DisplayName string
+	Template    string
+	Locale      string
+	MaxAttempts int
+	ExpiresAt   *time.Time
+	// Suppressed records the message as suppressed instead of queueing it,
+	// so the admin view still shows that it was requested.
+	Suppressed bool
+}
+
+// EmailMessageFilter narrows outbox listings. Empty fields match everything.
+type EmailMessageFilter struct {
+	Recipient string
+	Template  string
+	Statuses  []emailmessage.Status
+}
+
+func (f EmailMessageFilter) predicates() []predicate.EmailMessage {
+	preds := make([]predicate.EmailMessage, 0, 3)
+	if recipient := strings.TrimSpace(f.Recipient); recipient != "" {
+		preds = append(preds, emailmessage.RecipientEQ(strings.ToLower(recipient)))
+	}
+	if template := strings.TrimSpace(f.Template); template != "" {
+		preds = append(preds, emailmessage.TemplateEQ(template))
+	}
+	if len(f.Statuses) > 0 {
+		preds = append(preds, emailmessage.StatusIn(f.Statuses...))
+	}
+	return preds
+}
+
+// EmailOutboxSummary counts messages per status. Lag is the age of the
+// oldest message still waiting to be sent.
+type EmailOutboxSummary struct {
+	Queued         int        `json:"queued"`
+	Due            int        `json:"due"`
+	Retrying       int        `json:"retrying"`
+	Sent           int        `json:"sent"`
+	Failed         int        `json:"failed"`
+	Bounced        int        `json:"bounced"`
+	Suppressed     int        `json:"suppressed"`
+	Expired        int        `json:"expired"`
+	OldestQueuedAt *time.Time `json:"oldestQueuedAt,omitempty"`
+	LastSentAt     *time.Time `json:"lastSentAt,omitempty"`
+	LagSeconds     int64      `json:"lagSeconds"`
+}
+
+func truncateEmailOutboxError(message string) string {
+	message = strings.TrimSpace(message)
+	if len(message) <= emailOutboxErrorMaxLen {
+		return message
+	}
+	return message[:emailOutboxErrorMaxLen]
+}
+
+func (c *Client) EnqueueEmailMessages(ctx context.Context, records []EmailMessageRecord, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	builders := make([]*EmailMessageCreate, 0, len(records))
+	for _, record := range records {
+		builder := c.EmailMessage.Create().
+			SetID(record.ID).
+			SetRecipient(record.Recipient).
+			SetSubject(record.Subject).
+			SetBody(record.Body).
+			SetDisplayName(record.DisplayName).
+			SetTemplate(record.Template).
+			SetLocale(record.Locale).
+			SetMaxAttempts(record.MaxAttempts).
+			SetNextAttemptAt(now).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
+		if record.ExpiresAt != nil {
+			builder.SetExpiresAt(record.ExpiresAt.UTC())
+		}
+		if record.Suppressed {
+			builder.SetStatus(emailmessage.StatusSuppressed)
+		}
+		builders = append(builders, builder)
+	}
+	return c.EmailMessage.CreateBulk(builders...).Exec(ctx)
+}
+
+func (c *Client) ListDueEmailMessages(ctx context.Context, now time.Time, limit int) ([]*EmailMessage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.EmailMessage.Query().
+		Where(
+			emailmessage.StatusEQ(emailmessage.StatusQueued),
+			emailmessage.NextAttemptAtLTE(now),
+		).
+		Order(emailmessage.ByNextAttemptAt(sql.OrderAsc()), emailmessage.ByCreatedAt(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// ClaimEmailMessage takes ownership of a due message by bumping its attempt
+// counter and pushing next_attempt_at out to leaseUntil. The compare on
+// attempts makes the claim safe across instances; if the worker dies while
+// sending, the message becomes due again once the lease expires.
+func (c *Client) ClaimEmailMessage(ctx context.Context, message *EmailMessage, now, leaseUntil time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.EmailMessage.Update().
+		Where(
+			emailmessage.IDEQ(message.ID),
+			emailmessage.StatusEQ(emailmessage.StatusQueued),
+			emailmessage.AttemptsEQ(message.Attempts),
+			emailmessage.NextAttemptAtLTE(now),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// FinishEmailMessage records the final state of a claimed message. relay is
+// the relay that accepted a sent message.
+func (c *Client) FinishEmailMessage(ctx context.Context, id string, status emailmessage.Status, relay, message string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	update := c.EmailMessage.Update().
+		Where(
+			emailmessage.IDEQ(id),
+			emailmessage.StatusEQ(emailmessage.StatusQueued),
+		).
+		SetStatus(status).
+		SetUpdatedAt(now)
+	if relay != "" {
+		update.SetRelay(relay)
+	}
+	if message != "" {
+		update.SetLastError(truncateEmailOutboxError(message))
+	} else {
+		update.ClearLastError()
+	}
+	if status == emailmessage.StatusSent {
+		update.SetSentAt(now)
+	}
+	_, err := update.Save(ctx)
+	return err
+}
+
+// RetryEmailMessage records a failed attempt of a claimed message and
+// schedules the next one.
+func (c *Client) RetryEmailMessage(ctx context.Context, id, message string, nextAttemptAt time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	_, err := c.EmailMessage.Update().
+		Where(
+			emailmessage.IDEQ(id),
+			emailmessage.StatusEQ(emailmessage.StatusQueued),
+		).
+		SetNextAttemptAt(nextAttemptAt).
+		SetLastError(truncateEmailOutboxError(message)).
+		Save(ctx)
+	return err
+}
+
+// RequeueEmailMessage puts a failed or bounced message back in the queue
+// with a fresh attempt budget. It reports false when the message does not
+// exist or is in another state.
+func (c *Client) RequeueEmailMessage(ctx context.Context, id string, maxAttempts int, now time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.EmailMessage.Update().
+		Where(
+			emailmessage.IDEQ(id),
+			emailmessage.StatusIn(emailmessage.StatusFailed, emailmessage.StatusBounced),
+		).
+		SetStatus(emailmessage.StatusQueued).
+		SetAttempts(0).
+		SetMaxAttempts(maxAttempts).
+		SetNextAttemptAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (c *Client) GetEmailMessageMetadata(ctx context.Context, id string) (*EmailMessage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.EmailMessage.Query().
+		Where(emailmessage.IDEQ(id)).
+		Select(emailMessageMetadataFields...).
+		Only(ctx)
+}
+
+// ListEmailMessages returns matching messages, newest first, without their
+// bodies.
+func (c *Client) ListEmailMessages(ctx context.Context, filter EmailMessageFilter, offset, limit int) ([]*EmailMessage, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("postgresql client is nil")
+	}
+	preds := filter.predicates()
+	total, err := c.EmailMessage.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := c.EmailMessage.Query().
+		Where(preds...).
+		Order(emailmessage.ByCreatedAt(sql.OrderDesc()), emailmessage.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		Select(emailMessageMetadataFields...).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+func (c *Client) SummarizeEmailMessages(ctx context.Context, now time.Time) (*EmailOutboxSummary, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	var counts []struct {
+		Status emailmessage.Status `json:"status"`
+		Count  int                 `json:"count"`
+	}
+	if err := c.EmailMessage.Query().
+		GroupBy(emailmessage.FieldStatus).
+		Aggregate(Count()).
+		Scan(ctx, &counts); err != nil {
+		return nil, err
+	}
+	summary := &EmailOutboxSummary{}
+	for _, row := range counts {
+		switch row.Status {
+		case emailmessage.StatusQueued:
+			summary.Queued = row.Count
+		case emailmessage.StatusSent:
+			summary.Sent = row.Count
+		case emailmessage.StatusFailed:
+			summary.Failed = row.Count
+		case emailmessage.StatusBounced:
+			summary.Bounced = row.Count
+		case emailmessage.StatusSuppressed:
+			summary.Suppressed = row.Count
+		case emailmessage.StatusExpired:
+			summary.Expired = row.Count
+		}
+	}
+	queued := c.EmailMessage.Query().Where(emailmessage.StatusEQ(emailmessage.StatusQueued))
+	var err error
+	if summary.Due, err = queued.Clone().Where(emailmessage.NextAttemptAtLTE(now)).Count(ctx); err != nil {
+		return nil, err
+	}
+	if summary.Retrying, err = queued.Clone().Where(emailmessage.AttemptsGT(0)).Count(ctx); err != nil {
+		return nil, err
+	}
+	if summary.Queued > 0 {
+		oldest, err := queued.Clone().
+			Order(emailmessage.ByCreatedAt(sql.OrderAsc())).
+			Select(emailmessage.FieldCreatedAt).
+			First(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oldestAt := oldest.CreatedAt.UTC()
+		summary.OldestQueuedAt = &oldestAt
+		summary.LagSeconds = int64(now.Sub(oldestAt).Seconds())
+	}
+	sent, err := c.EmailMessage.Query().
+		Where(emailmessage.SentAtNotNil()).
+		Order(emailmessage.BySentAt(sql.OrderDesc())).
+		Select(emailmessage.FieldSentAt).
+		First(ctx)
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+	if sent != nil && sent.SentAt != nil {
+		sentAt := sent.SentAt.UTC()
+		summary.LastSentAt = &sentAt
+	}
+	return summary, nil
+}
+
+// PurgeFinishedEmailMessages deletes messages that are no longer queued and
+// have not changed since before.
+func (c *Client) PurgeFinishedEmailMessages(ctx context.Context, before time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.EmailMessage.Delete().
+		Where(
+			emailmessage.StatusNEQ(emailmessage.StatusQueued),
+			emailmessage.UpdatedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+// SuppressedEmailAddresses reports which of emails are on the suppression
+// list. Addresses are compared in lower case.
+func (c *Client) SuppressedEmailAddresses(ctx context.Context, emails []string) (map[string]bool, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	suppressed := make(map[string]bool)
+	if len(emails) == 0 {
+		return suppressed, nil
+	}
+	normalized := make([]string, 0, len(emails))
+	for _, email := range emails {
+		normalized = append(normalized, strings.ToLower(strings.TrimSpace(email)))
+	}
+	rows, err := c.EmailSuppression.Query().
+		Where(emailsuppression.EmailIn(normalized...)).
+		Select(emailsuppression.FieldEmail).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		suppressed[row.Email] = true
+	}
+	return suppressed, nil
+}
+
+// RecordEmailBounce adds a hard bounce suppression for email, or bumps the
+// bounce count of an existing entry without changing its reason.
+func (c *Client) RecordEmailBounce(ctx context.Context, email, detail string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	err := c.recordEmailBounce(ctx, email, detail, now)
+	if IsConstraintError(err) {
+		// A concurrent bounce created the entry first; update it instead.
+		err = c.recordEmailBounce(ctx, email, detail, now)
+	}
+	return err
+}
+
+func (c *Client) recordEmailBounce(ctx context.Context, email, detail string, now time.Time) error {
+	detail = truncateEmailOutboxError(detail)
+	affected, err := c.EmailSuppression.Update().
+		Where(emailsuppression.EmailEQ(email)).
+		AddBounceCount(1).
+		SetDetail(detail).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil || affected > 0 {
+		return err
+	}
+	return c.EmailSuppression.Create().
+		SetEmail(email).
+		SetReason(emailsuppression.ReasonHardBounce).
+		SetDetail(detail).
+		SetBounceCount(1).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Exec(ctx)
+}
+
+// AddEmailSuppression suppresses email for reason, replacing the reason and
+// detail of an existing entry.
+func (c *Client) AddEmailSuppression(ctx context.Context, email string, reason emailsuppression.Reason, detail, createdBy string, now time.Time) (*EmailSuppression, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	row, err := c.addEmailSuppression(ctx, email, reason, detail, createdBy, now)
+	if IsConstraintError(err) {
+		row, err = c.addEmailSuppression(ctx, email, reason, detail, createdBy, now)
+	}
+	return row, err
+}
+
+func (c *Client) addEmailSuppression(ctx context.Context, email string, reason emailsuppression.Reason, detail, createdBy string, now time.Time) (*EmailSuppression, error) {
+	existing, err := c.EmailSuppression.Query().Where(emailsuppression.EmailEQ(email)).Only(ctx)
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+	if existing != nil {
+		update := existing.Update().SetReason(reason).SetUpdatedAt(now)
+		if detail = strings.TrimSpace(detail); detail != "" {
+			update.SetDetail(truncateEmailOutboxError(detail))
+		} else {
+			update.ClearDetail()
+		}
+		if createdBy != "" {
+			update.SetCreatedBy(createdBy)
+		}
+		return update.Save(ctx)
+	}
+	create := c.EmailSuppression.Create().
+		SetEmail(email).
+		SetReason(reason).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	if detail = strings.TrimSpace(detail); detail != "" {
+		create.SetDetail(truncateEmailOutboxError(detail))
+	}
+	if createdBy != "" {
+		create.SetCreatedBy(createdBy)
+	}
+	return create.Save(ctx)
+}
+
+func (c *Client) DeleteEmailSuppression(ctx context.Context, email string) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	deleted, err := c.EmailSuppression.Delete().
+		Where(emailsuppression.EmailEQ(strings.ToLower(strings.TrimSpace(email)))).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// ListEmailSuppressions returns suppressions whose address contains query,
+// most recently changed first.
+func (c *Client) ListEmailSuppressions(ctx context.Context, query string, reason emailsuppression.Reason, offset, limit int) ([]*EmailSuppression, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("postgresql client is nil")
+	}
+	preds := make([]predicate.EmailSuppression, 0, 2)
+	if query = strings.ToLower(strings.TrimSpace(query)); query != "" {
+		preds = append(preds, emailsuppression.EmailContains(query))
+	}
+	if reason != "" {
+		preds = append(preds, emailsuppression.ReasonEQ(reason))
+	}
+	total, err := c.EmailSuppression.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := c.EmailSuppression.Query().
+		Where(preds...).
+		Order(emailsuppression.ByUpdatedAt(sql.OrderDesc()), emailsuppression.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
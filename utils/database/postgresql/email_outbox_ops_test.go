@@ -0,0 +1,123 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEmailOutboxLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:email-outbox-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := client.EnqueueEmailMessages(ctx, []dbManager.EmailMessageRecord{
+		{ID: "m1", Recipient: "a@example.com", Subject: "s", Body: "b", Template: "verification_code", Locale: "en", MaxAttempts: 3},
+		{ID: "m2", Recipient: "b@example.com", Subject: "s", Body: "b", MaxAttempts: 3},
+		{ID: "m3", Recipient: "c@example.com", Subject: "s", Body: "b", MaxAttempts: 3, Suppressed: true},
+	}, now); err != nil {
+		t.Fatalf("EnqueueEmailMessages returned error: %v", err)
+	}
+
+	due, err := client.ListDueEmailMessages(ctx, now, 10)
+	if err != nil || len(due) != 2 {
+		t.Fatalf("ListDueEmailMessages = %d, %v; want 2 messages", len(due), err)
+	}
+	claimed, err := client.ClaimEmailMessage(ctx, due[0], now, now.Add(time.Minute))
+	if err != nil || !claimed {
+		t.Fatalf("ClaimEmailMessage = %v, %v; want true", claimed, err)
+	}
+	if claimed, err := client.ClaimEmailMessage(ctx, due[0], now, now.Add(time.Minute)); err != nil || claimed {
+		t.Fatalf("second ClaimEmailMessage = %v, %v; want false", claimed, err)
+	}
+	if err := client.FinishEmailMessage(ctx, "m1", emailmessage.StatusSent, "primary", "", now); err != nil {
+		t.Fatalf("FinishEmailMessage returned error: %v", err)
+	}
+	if err := client.FinishEmailMessage(ctx, "m2", emailmessage.StatusFailed, "", "421 busy", now); err != nil {
+		t.Fatalf("FinishEmailMessage returned error: %v", err)
+	}
+
+	summary, err := client.SummarizeEmailMessages(ctx, now)
+	if err != nil {
+		t.Fatalf("SummarizeEmailMessages returned error: %v", err)
+	}
+	if summary.Queued != 0 || summary.Sent != 1 || summary.Failed != 1 || summary.Suppressed != 1 || summary.LastSentAt == nil {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	rows, total, err := client.ListEmailMessages(ctx, dbManager.EmailMessageFilter{Template: "verification_code"}, 0, 10)
+	if err != nil || total != 1 || len(rows) != 1 || rows[0].ID != "m1" || rows[0].Body != "" {
+		t.Fatalf("ListEmailMessages = %+v, %d, %v; want m1 without its body", rows, total, err)
+	}
+
+	if requeued, err := client.RequeueEmailMessage(ctx, "m1", 5, now); err != nil || requeued {
+		t.Fatalf("RequeueEmailMessage of a sent message = %v, %v; want false", requeued, err)
+	}
+	if requeued, err := client.RequeueEmailMessage(ctx, "m2", 5, now); err != nil || !requeued {
+		t.Fatalf("RequeueEmailMessage = %v, %v; want true", requeued, err)
+	}
+	metadata, err := client.GetEmailMessageMetadata(ctx, "m2")
+	if err != nil || metadata.Status != emailmessage.StatusQueued || metadata.Attempts != 0 || metadata.MaxAttempts != 5 {
+		t.Fatalf("requeued message = %+v, %v", metadata, err)
+	}
+
+	deleted, err := client.PurgeFinishedEmailMessages(ctx, now.Add(time.Hour))
+	if err != nil || deleted != 2 {
+		t.Fatalf("PurgeFinishedEmailMessages = %d, %v; want 2", deleted, err)
+	}
+	if remaining, _ := client.EmailMessage.Query().Count(ctx); remaining != 1 {
+		t.Fatalf("remaining messages = %d, want the queued one", remaining)
+	}
+}
+
+func TestEmailSuppressions(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:email-suppression-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for range 2 {
+		if err := client.RecordEmailBounce(ctx, "Bounce@Example.com", "550 user unknown", now); err != nil {
+			t.Fatalf("RecordEmailBounce returned error: %v", err)
+		}
+	}
+	if _, err := client.AddEmailSuppression(ctx, "manual@example.com", emailsuppression.ReasonManual, "", "admin-1", now); err != nil {
+		t.Fatalf("AddEmailSuppression returned error: %v", err)
+	}
+
+	suppressed, err := client.SuppressedEmailAddresses(ctx, []string{"bounce@example.com", "other@example.com"})
+	if err != nil || !suppressed["bounce@example.com"] || suppressed["other@example.com"] {
+		t.Fatalf("SuppressedEmailAddresses = %v, %v", suppressed, err)
+	}
+
+	rows, total, err := client.ListEmailSuppressions(ctx, "", emailsuppression.ReasonHardBounce, 0, 10)
+	if err != nil || total != 1 || rows[0].BounceCount != 2 {
+		t.Fatalf("ListEmailSuppressions = %+v, %d, %v; want one address bounced twice", rows, total, err)
+	}
+	if _, total, err := client.ListEmailSuppressions(ctx, "manual", "", 0, 10); err != nil || total != 1 {
+		t.Fatalf("ListEmailSuppressions by query = %d, %v; want 1", total, err)
+	}
+
+	if removed, err := client.DeleteEmailSuppression(ctx, "bounce@example.com"); err != nil || !removed {
+		t.Fatalf("DeleteEmailSuppression = %v, %v; want true", removed, err)
+	}
+	if removed, err := client.DeleteEmailSuppression(ctx, "bounce@example.com"); err != nil || removed {
+		t.Fatalf("second DeleteEmailSuppression = %v, %v; want false", removed, err)
+	}
+}
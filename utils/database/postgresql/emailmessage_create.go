@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+)
+
+// EmailMessageCreate is the builder for creating a EmailMessage entity.
+type EmailMessageCreate struct {
+	config
+	mutation *EmailMessageMutation
+	hooks    []Hook
+}
+
+// SetRecipient sets the "recipient" field.
+func (_c *EmailMessageCreate) SetRecipient(v string) *EmailMessageCreate {
+	_c.mutation.SetRecipient(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *EmailMessageCreate) SetSubject(v string) *EmailMessageCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *EmailMessageCreate) SetBody(v string) *EmailMessageCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *EmailMessageCreate) SetDisplayName(v string) *EmailMessageCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableDisplayName(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *EmailMessageCreate) SetTemplate(v string) *EmailMessageCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableTemplate(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetTemplate(*v)
+	}
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *EmailMessageCreate) SetLocale(v string) *EmailMessageCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableLocale(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetLocale(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmailMessageCreate) SetStatus(v emailmessage.Status) *EmailMessageCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableStatus(v *emailmessage.Status) *EmailMessageCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *EmailMessageCreate) SetAttempts(v int) *EmailMessageCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableAttempts(v *int) *EmailMessageCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *EmailMessageCreate) SetMaxAttempts(v int) *EmailMessageCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *EmailMessageCreate) SetNextAttemptAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableNextAttemptAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EmailMessageCreate) SetExpiresAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableExpiresAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRelay sets the "relay" field.
+func (_c *EmailMessageCreate) SetRelay(v string) *EmailMessageCreate {
+	_c.mutation.SetRelay(v)
+	return _c
+}
+
+// SetNillableRelay sets the "relay" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableRelay(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetRelay(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *EmailMessageCreate) SetLastError(v string) *EmailMessageCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableLastError(v *string) *EmailMessageCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *EmailMessageCreate) SetSentAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableSentAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailMessageCreate) SetCreatedAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableCreatedAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmailMessageCreate) SetUpdatedAt(v time.Time) *EmailMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmailMessageCreate) SetNillableUpdatedAt(v *time.Time) *EmailMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmailMessageCreate) SetID(v string) *EmailMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EmailMessageMutation object of the builder.
+func (_c *EmailMessageCreate) Mutation() *EmailMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailMessage in the database.
+func (_c *EmailMessageCreate) Save(ctx context.Context) (*EmailMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailMessageCreate) SaveX(ctx context.Context) *EmailMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailMessageCreate) defaults() {
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		v := emailmessage.DefaultDisplayName
+		_c.mutation.SetDisplayName(v)
+	}
+	if _, ok := _c.mutation.Template(); !ok {
+		v := emailmessage.DefaultTemplate
+		_c.mutation.SetTemplate(v)
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		v := emailmessage.DefaultLocale
+		_c.mutation.SetLocale(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := emailmessage.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := emailmessage.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := emailmessage.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emailmessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailMessageCreate) check() error {
+	if _, ok := _c.mutation.Recipient(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`postgresql: missing required field "EmailMessage.recipient"`)}
+	}
+	if v, ok := _c.mutation.Recipient(); ok {
+		if err := emailmessage.RecipientValidator(v); err != nil {
+			return &ValidationError{Name: "recipient", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.recipient": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`postgresql: missing required field "EmailMessage.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := emailmessage.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`postgresql: missing required field "EmailMessage.body"`)}
+	}
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`postgresql: missing required field "EmailMessage.display_name"`)}
+	}
+	if _, ok := _c.mutation.Template(); !ok {
+		return &ValidationError{Name: "template", err: errors.New(`postgresql: missing required field "EmailMessage.template"`)}
+	}
+	if v, ok := _c.mutation.Template(); ok {
+		if err := emailmessage.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.template": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`postgresql: missing required field "EmailMessage.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := emailmessage.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`postgresql: missing required field "EmailMessage.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := emailmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`postgresql: missing required field "EmailMessage.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := emailmessage.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`postgresql: missing required field "EmailMessage.max_attempts"`)}
+	}
+	if v, ok := _c.mutation.MaxAttempts(); ok {
+		if err := emailmessage.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`postgresql: missing required field "EmailMessage.next_attempt_at"`)}
+	}
+	if v, ok := _c.mutation.Relay(); ok {
+		if err := emailmessage.RelayValidator(v); err != nil {
+			return &ValidationError{Name: "relay", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.relay": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := emailmessage.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "EmailMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "EmailMessage.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := emailmessage.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EmailMessageCreate) sqlSave(ctx context.Context) (*EmailMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EmailMessage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailMessageCreate) createSpec() (*EmailMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailmessage.Table, sqlgraph.NewFieldSpec(emailmessage.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Recipient(); ok {
+		_spec.SetField(emailmessage.FieldRecipient, field.TypeString, value)
+		_node.Recipient = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(emailmessage.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(emailmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(emailmessage.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(emailmessage.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(emailmessage.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(emailmessage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(emailmessage.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(emailmessage.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailmessage.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailmessage.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Relay(); ok {
+		_spec.SetField(emailmessage.FieldRelay, field.TypeString, value)
+		_node.Relay = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(emailmessage.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(emailmessage.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EmailMessageCreateBulk is the builder for creating many EmailMessage entities in bulk.
+type EmailMessageCreateBulk struct {
+	config
+	err      error
+	builders []*EmailMessageCreate
+}
+
+// Save creates the EmailMessage entities in the database.
+func (_c *EmailMessageCreateBulk) Save(ctx context.Context) ([]*EmailMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailMessageCreateBulk) SaveX(ctx context.Context) []*EmailMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// EmailMessageUpdate is the builder for updating EmailMessage entities.
+type EmailMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailMessageMutation
+}
+
+// Where appends a list predicates to the EmailMessageUpdate builder.
+func (_u *EmailMessageUpdate) Where(ps ...predicate.EmailMessage) *EmailMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmailMessageUpdate) SetStatus(v emailmessage.Status) *EmailMessageUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableStatus(v *emailmessage.Status) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailMessageUpdate) SetAttempts(v int) *EmailMessageUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableAttempts(v *int) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailMessageUpdate) AddAttempts(v int) *EmailMessageUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *EmailMessageUpdate) SetMaxAttempts(v int) *EmailMessageUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableMaxAttempts(v *int) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *EmailMessageUpdate) AddMaxAttempts(v int) *EmailMessageUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EmailMessageUpdate) SetNextAttemptAt(v time.Time) *EmailMessageUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableNextAttemptAt(v *time.Time) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailMessageUpdate) SetExpiresAt(v time.Time) *EmailMessageUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableExpiresAt(v *time.Time) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *EmailMessageUpdate) ClearExpiresAt() *EmailMessageUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRelay sets the "relay" field.
+func (_u *EmailMessageUpdate) SetRelay(v string) *EmailMessageUpdate {
+	_u.mutation.SetRelay(v)
+	return _u
+}
+
+// SetNillableRelay sets the "relay" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableRelay(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetRelay(*v)
+	}
+	return _u
+}
+
+// ClearRelay clears the value of the "relay" field.
+func (_u *EmailMessageUpdate) ClearRelay() *EmailMessageUpdate {
+	_u.mutation.ClearRelay()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmailMessageUpdate) SetLastError(v string) *EmailMessageUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableLastError(v *string) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmailMessageUpdate) ClearLastError() *EmailMessageUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *EmailMessageUpdate) SetSentAt(v time.Time) *EmailMessageUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *EmailMessageUpdate) SetNillableSentAt(v *time.Time) *EmailMessageUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *EmailMessageUpdate) ClearSentAt() *EmailMessageUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailMessageUpdate) SetUpdatedAt(v time.Time) *EmailMessageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailMessageMutation object of the builder.
+func (_u *EmailMessageUpdate) Mutation() *EmailMessageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailMessageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailMessageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailMessageUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emailmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := emailmessage.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := emailmessage.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Relay(); ok {
+		if err := emailmessage.RelayValidator(v); err != nil {
+			return &ValidationError{Name: "relay", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.relay": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := emailmessage.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailmessage.Table, emailmessage.Columns, sqlgraph.NewFieldSpec(emailmessage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emailmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(emailmessage.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(emailmessage.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailmessage.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailmessage.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(emailmessage.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Relay(); ok {
+		_spec.SetField(emailmessage.FieldRelay, field.TypeString, value)
+	}
+	if _u.mutation.RelayCleared() {
+		_spec.ClearField(emailmessage.FieldRelay, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(emailmessage.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(emailmessage.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(emailmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(emailmessage.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailMessageUpdateOne is the builder for updating a single EmailMessage entity.
+type EmailMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailMessageMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmailMessageUpdateOne) SetStatus(v emailmessage.Status) *EmailMessageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableStatus(v *emailmessage.Status) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *EmailMessageUpdateOne) SetAttempts(v int) *EmailMessageUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableAttempts(v *int) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *EmailMessageUpdateOne) AddAttempts(v int) *EmailMessageUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *EmailMessageUpdateOne) SetMaxAttempts(v int) *EmailMessageUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableMaxAttempts(v *int) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *EmailMessageUpdateOne) AddMaxAttempts(v int) *EmailMessageUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *EmailMessageUpdateOne) SetNextAttemptAt(v time.Time) *EmailMessageUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableNextAttemptAt(v *time.Time) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *EmailMessageUpdateOne) SetExpiresAt(v time.Time) *EmailMessageUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableExpiresAt(v *time.Time) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *EmailMessageUpdateOne) ClearExpiresAt() *EmailMessageUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRelay sets the "relay" field.
+func (_u *EmailMessageUpdateOne) SetRelay(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetRelay(v)
+	return _u
+}
+
+// SetNillableRelay sets the "relay" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableRelay(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetRelay(*v)
+	}
+	return _u
+}
+
+// ClearRelay clears the value of the "relay" field.
+func (_u *EmailMessageUpdateOne) ClearRelay() *EmailMessageUpdateOne {
+	_u.mutation.ClearRelay()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *EmailMessageUpdateOne) SetLastError(v string) *EmailMessageUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableLastError(v *string) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *EmailMessageUpdateOne) ClearLastError() *EmailMessageUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *EmailMessageUpdateOne) SetSentAt(v time.Time) *EmailMessageUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *EmailMessageUpdateOne) SetNillableSentAt(v *time.Time) *EmailMessageUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *EmailMessageUpdateOne) ClearSentAt() *EmailMessageUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailMessageUpdateOne) SetUpdatedAt(v time.Time) *EmailMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailMessageMutation object of the builder.
+func (_u *EmailMessageUpdateOne) Mutation() *EmailMessageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmailMessageUpdate builder.
+func (_u *EmailMessageUpdateOne) Where(ps ...predicate.EmailMessage) *EmailMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailMessageUpdateOne) Select(field string, fields ...string) *EmailMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailMessage entity.
+func (_u *EmailMessageUpdateOne) Save(ctx context.Context) (*EmailMessage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailMessageUpdateOne) SaveX(ctx context.Context) *EmailMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailMessageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := emailmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := emailmessage.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := emailmessage.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Relay(); ok {
+		if err := emailmessage.RelayValidator(v); err != nil {
+			return &ValidationError{Name: "relay", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.relay": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := emailmessage.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`postgresql: validator failed for field "EmailMessage.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailMessageUpdateOne) sqlSave(ctx context.Context) (_node *EmailMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailmessage.Table, emailmessage.Columns, sqlgraph.NewFieldSpec(emailmessage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "EmailMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailmessage.FieldID)
+		for _, f := range fields {
+			if !emailmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != emailmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(emailmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(emailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(emailmessage.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(emailmessage.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailmessage.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailmessage.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(emailmessage.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Relay(); ok {
+		_spec.SetField(emailmessage.FieldRelay, field.TypeString, value)
+	}
+	if _u.mutation.RelayCleared() {
+		_spec.ClearField(emailmessage.FieldRelay, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(emailmessage.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(emailmessage.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(emailmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(emailmessage.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmailMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
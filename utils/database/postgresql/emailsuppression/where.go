@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsuppression
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldID, id))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldEmail, v))
+}
+
+// Detail applies equality check predicate on the "detail" field. It's identical to DetailEQ.
+func Detail(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldDetail, v))
+}
+
+// BounceCount applies equality check predicate on the "bounce_count" field. It's identical to BounceCountEQ.
+func BounceCount(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldBounceCount, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// DetailEQ applies the EQ predicate on the "detail" field.
+func DetailEQ(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldDetail, v))
+}
+
+// DetailNEQ applies the NEQ predicate on the "detail" field.
+func DetailNEQ(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldDetail, v))
+}
+
+// DetailIn applies the In predicate on the "detail" field.
+func DetailIn(vs ...string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldDetail, vs...))
+}
+
+// DetailNotIn applies the NotIn predicate on the "detail" field.
+func DetailNotIn(vs ...string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldDetail, vs...))
+}
+
+// DetailGT applies the GT predicate on the "detail" field.
+func DetailGT(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldDetail, v))
+}
+
+// DetailGTE applies the GTE predicate on the "detail" field.
+func DetailGTE(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldDetail, v))
+}
+
+// DetailLT applies the LT predicate on the "detail" field.
+func DetailLT(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldDetail, v))
+}
+
+// DetailLTE applies the LTE predicate on the "detail" field.
+func DetailLTE(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldDetail, v))
+}
+
+// DetailContains applies the Contains predicate on the "detail" field.
+func DetailContains(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldContains(FieldDetail, v))
+}
+
+// DetailHasPrefix applies the HasPrefix predicate on the "detail" field.
+func DetailHasPrefix(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldHasPrefix(FieldDetail, v))
+}
+
+// DetailHasSuffix applies the HasSuffix predicate on the "detail" field.
+func DetailHasSuffix(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldHasSuffix(FieldDetail, v))
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIsNull(FieldDetail))
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotNull(FieldDetail))
+}
+
+// DetailEqualFold applies the EqualFold predicate on the "detail" field.
+func DetailEqualFold(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEqualFold(FieldDetail, v))
+}
+
+// DetailContainsFold applies the ContainsFold predicate on the "detail" field.
+func DetailContainsFold(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldContainsFold(FieldDetail, v))
+}
+
+// BounceCountEQ applies the EQ predicate on the "bounce_count" field.
+func BounceCountEQ(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldBounceCount, v))
+}
+
+// BounceCountNEQ applies the NEQ predicate on the "bounce_count" field.
+func BounceCountNEQ(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldBounceCount, v))
+}
+
+// BounceCountIn applies the In predicate on the "bounce_count" field.
+func BounceCountIn(vs ...int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldBounceCount, vs...))
+}
+
+// BounceCountNotIn applies the NotIn predicate on the "bounce_count" field.
+func BounceCountNotIn(vs ...int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldBounceCount, vs...))
+}
+
+// BounceCountGT applies the GT predicate on the "bounce_count" field.
+func BounceCountGT(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldBounceCount, v))
+}
+
+// BounceCountGTE applies the GTE predicate on the "bounce_count" field.
+func BounceCountGTE(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldBounceCount, v))
+}
+
+// BounceCountLT applies the LT predicate on the "bounce_count" field.
+func BounceCountLT(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldBounceCount, v))
+}
+
+// BounceCountLTE applies the LTE predicate on the "bounce_count" field.
+func BounceCountLTE(v int) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldBounceCount, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailSuppression) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailSuppression) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailSuppression) predicate.EmailSuppression {
+	return predicate.EmailSuppression(sql.NotPredicates(p))
+}
@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
+)
+
+// EmailSuppressionCreate is the builder for creating a EmailSuppression entity.
+type EmailSuppressionCreate struct {
+	config
+	mutation *EmailSuppressionMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (_c *EmailSuppressionCreate) SetEmail(v string) *EmailSuppressionCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *EmailSuppressionCreate) SetReason(v emailsuppression.Reason) *EmailSuppressionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetDetail sets the "detail" field.
+func (_c *EmailSuppressionCreate) SetDetail(v string) *EmailSuppressionCreate {
+	_c.mutation.SetDetail(v)
+	return _c
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_c *EmailSuppressionCreate) SetNillableDetail(v *string) *EmailSuppressionCreate {
+	if v != nil {
+		_c.SetDetail(*v)
+	}
+	return _c
+}
+
+// SetBounceCount sets the "bounce_count" field.
+func (_c *EmailSuppressionCreate) SetBounceCount(v int) *EmailSuppressionCreate {
+	_c.mutation.SetBounceCount(v)
+	return _c
+}
+
+// SetNillableBounceCount sets the "bounce_count" field if the given value is not nil.
+func (_c *EmailSuppressionCreate) SetNillableBounceCount(v *int) *EmailSuppressionCreate {
+	if v != nil {
+		_c.SetBounceCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *EmailSuppressionCreate) SetCreatedBy(v string) *EmailSuppressionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *EmailSuppressionCreate) SetNillableCreatedBy(v *string) *EmailSuppressionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmailSuppressionCreate) SetCreatedAt(v time.Time) *EmailSuppressionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmailSuppressionCreate) SetNillableCreatedAt(v *time.Time) *EmailSuppressionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmailSuppressionCreate) SetUpdatedAt(v time.Time) *EmailSuppressionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmailSuppressionCreate) SetNillableUpdatedAt(v *time.Time) *EmailSuppressionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EmailSuppressionMutation object of the builder.
+func (_c *EmailSuppressionCreate) Mutation() *EmailSuppressionMutation {
+	return _c.mutation
+}
+
+// Save creates the EmailSuppression in the database.
+func (_c *EmailSuppressionCreate) Save(ctx context.Context) (*EmailSuppression, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmailSuppressionCreate) SaveX(ctx context.Context) *EmailSuppression {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailSuppressionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailSuppressionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmailSuppressionCreate) defaults() {
+	if _, ok := _c.mutation.BounceCount(); !ok {
+		v := emailsuppression.DefaultBounceCount
+		_c.mutation.SetBounceCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := emailsuppression.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := emailsuppression.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmailSuppressionCreate) check() error {
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`postgresql: missing required field "EmailSuppression.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := emailsuppression.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`postgresql: missing required field "EmailSuppression.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := emailsuppression.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Detail(); ok {
+		if err := emailsuppression.DetailValidator(v); err != nil {
+			return &ValidationError{Name: "detail", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.detail": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BounceCount(); !ok {
+		return &ValidationError{Name: "bounce_count", err: errors.New(`postgresql: missing required field "EmailSuppression.bounce_count"`)}
+	}
+	if v, ok := _c.mutation.BounceCount(); ok {
+		if err := emailsuppression.BounceCountValidator(v); err != nil {
+			return &ValidationError{Name: "bounce_count", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.bounce_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "EmailSuppression.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "EmailSuppression.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EmailSuppressionCreate) sqlSave(ctx context.Context) (*EmailSuppression, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmailSuppressionCreate) createSpec() (*EmailSuppression, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailSuppression{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(emailsuppression.Table, sqlgraph.NewFieldSpec(emailsuppression.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(emailsuppression.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(emailsuppression.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Detail(); ok {
+		_spec.SetField(emailsuppression.FieldDetail, field.TypeString, value)
+		_node.Detail = &value
+	}
+	if value, ok := _c.mutation.BounceCount(); ok {
+		_spec.SetField(emailsuppression.FieldBounceCount, field.TypeInt, value)
+		_node.BounceCount = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(emailsuppression.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(emailsuppression.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsuppression.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EmailSuppressionCreateBulk is the builder for creating many EmailSuppression entities in bulk.
+type EmailSuppressionCreateBulk struct {
+	config
+	err      error
+	builders []*EmailSuppressionCreate
+}
+
+// Save creates the EmailSuppression entities in the database.
+func (_c *EmailSuppressionCreateBulk) Save(ctx context.Context) ([]*EmailSuppression, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmailSuppression, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailSuppressionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmailSuppressionCreateBulk) SaveX(ctx context.Context) []*EmailSuppression {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmailSuppressionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmailSuppressionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// EmailSuppressionUpdate is the builder for updating EmailSuppression entities.
+type EmailSuppressionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailSuppressionMutation
+}
+
+// Where appends a list predicates to the EmailSuppressionUpdate builder.
+func (_u *EmailSuppressionUpdate) Where(ps ...predicate.EmailSuppression) *EmailSuppressionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *EmailSuppressionUpdate) SetEmail(v string) *EmailSuppressionUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *EmailSuppressionUpdate) SetNillableEmail(v *string) *EmailSuppressionUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EmailSuppressionUpdate) SetReason(v emailsuppression.Reason) *EmailSuppressionUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EmailSuppressionUpdate) SetNillableReason(v *emailsuppression.Reason) *EmailSuppressionUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *EmailSuppressionUpdate) SetDetail(v string) *EmailSuppressionUpdate {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *EmailSuppressionUpdate) SetNillableDetail(v *string) *EmailSuppressionUpdate {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *EmailSuppressionUpdate) ClearDetail() *EmailSuppressionUpdate {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetBounceCount sets the "bounce_count" field.
+func (_u *EmailSuppressionUpdate) SetBounceCount(v int) *EmailSuppressionUpdate {
+	_u.mutation.ResetBounceCount()
+	_u.mutation.SetBounceCount(v)
+	return _u
+}
+
+// SetNillableBounceCount sets the "bounce_count" field if the given value is not nil.
+func (_u *EmailSuppressionUpdate) SetNillableBounceCount(v *int) *EmailSuppressionUpdate {
+	if v != nil {
+		_u.SetBounceCount(*v)
+	}
+	return _u
+}
+
+// AddBounceCount adds value to the "bounce_count" field.
+func (_u *EmailSuppressionUpdate) AddBounceCount(v int) *EmailSuppressionUpdate {
+	_u.mutation.AddBounceCount(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EmailSuppressionUpdate) SetCreatedBy(v string) *EmailSuppressionUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EmailSuppressionUpdate) SetNillableCreatedBy(v *string) *EmailSuppressionUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *EmailSuppressionUpdate) ClearCreatedBy() *EmailSuppressionUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailSuppressionUpdate) SetUpdatedAt(v time.Time) *EmailSuppressionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailSuppressionMutation object of the builder.
+func (_u *EmailSuppressionUpdate) Mutation() *EmailSuppressionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmailSuppressionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailSuppressionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmailSuppressionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailSuppressionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailSuppressionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailsuppression.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailSuppressionUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := emailsuppression.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := emailsuppression.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Detail(); ok {
+		if err := emailsuppression.DetailValidator(v); err != nil {
+			return &ValidationError{Name: "detail", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.detail": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BounceCount(); ok {
+		if err := emailsuppression.BounceCountValidator(v); err != nil {
+			return &ValidationError{Name: "bounce_count", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.bounce_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailSuppressionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsuppression.Table, emailsuppression.Columns, sqlgraph.NewFieldSpec(emailsuppression.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(emailsuppression.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(emailsuppression.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(emailsuppression.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(emailsuppression.FieldDetail, field.TypeString)
+	}
+	if value, ok := _u.mutation.BounceCount(); ok {
+		_spec.SetField(emailsuppression.FieldBounceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBounceCount(); ok {
+		_spec.AddField(emailsuppression.FieldBounceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(emailsuppression.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(emailsuppression.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsuppression.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmailSuppressionUpdateOne is the builder for updating a single EmailSuppression entity.
+type EmailSuppressionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailSuppressionMutation
+}
+
+// SetEmail sets the "email" field.
+func (_u *EmailSuppressionUpdateOne) SetEmail(v string) *EmailSuppressionUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *EmailSuppressionUpdateOne) SetNillableEmail(v *string) *EmailSuppressionUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *EmailSuppressionUpdateOne) SetReason(v emailsuppression.Reason) *EmailSuppressionUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *EmailSuppressionUpdateOne) SetNillableReason(v *emailsuppression.Reason) *EmailSuppressionUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *EmailSuppressionUpdateOne) SetDetail(v string) *EmailSuppressionUpdateOne {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *EmailSuppressionUpdateOne) SetNillableDetail(v *string) *EmailSuppressionUpdateOne {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *EmailSuppressionUpdateOne) ClearDetail() *EmailSuppressionUpdateOne {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// SetBounceCount sets the "bounce_count" field.
+func (_u *EmailSuppressionUpdateOne) SetBounceCount(v int) *EmailSuppressionUpdateOne {
+	_u.mutation.ResetBounceCount()
+	_u.mutation.SetBounceCount(v)
+	return _u
+}
+
+// SetNillableBounceCount sets the "bounce_count" field if the given value is not nil.
+func (_u *EmailSuppressionUpdateOne) SetNillableBounceCount(v *int) *EmailSuppressionUpdateOne {
+	if v != nil {
+		_u.SetBounceCount(*v)
+	}
+	return _u
+}
+
+// AddBounceCount adds value to the "bounce_count" field.
+func (_u *EmailSuppressionUpdateOne) AddBounceCount(v int) *EmailSuppressionUpdateOne {
+	_u.mutation.AddBounceCount(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EmailSuppressionUpdateOne) SetCreatedBy(v string) *EmailSuppressionUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EmailSuppressionUpdateOne) SetNillableCreatedBy(v *string) *EmailSuppressionUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *EmailSuppressionUpdateOne) ClearCreatedBy() *EmailSuppressionUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmailSuppressionUpdateOne) SetUpdatedAt(v time.Time) *EmailSuppressionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EmailSuppressionMutation object of the builder.
+func (_u *EmailSuppressionUpdateOne) Mutation() *EmailSuppressionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmailSuppressionUpdate builder.
+func (_u *EmailSuppressionUpdateOne) Where(ps ...predicate.EmailSuppression) *EmailSuppressionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmailSuppressionUpdateOne) Select(field string, fields ...string) *EmailSuppressionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmailSuppression entity.
+func (_u *EmailSuppressionUpdateOne) Save(ctx context.Context) (*EmailSuppression, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmailSuppressionUpdateOne) SaveX(ctx context.Context) *EmailSuppression {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmailSuppressionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmailSuppressionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmailSuppressionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := emailsuppression.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmailSuppressionUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := emailsuppression.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := emailsuppression.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Detail(); ok {
+		if err := emailsuppression.DetailValidator(v); err != nil {
+			return &ValidationError{Name: "detail", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.detail": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BounceCount(); ok {
+		if err := emailsuppression.BounceCountValidator(v); err != nil {
+			return &ValidationError{Name: "bounce_count", err: fmt.Errorf(`postgresql: validator failed for field "EmailSuppression.bounce_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EmailSuppressionUpdateOne) sqlSave(ctx context.Context) (_node *EmailSuppression, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsuppression.Table, emailsuppression.Columns, sqlgraph.NewFieldSpec(emailsuppression.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "EmailSuppression.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailsuppression.FieldID)
+		for _, f := range fields {
+			if !emailsuppression.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != emailsuppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(emailsuppression.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(emailsuppression.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(emailsuppression.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(emailsuppression.FieldDetail, field.TypeString)
+	}
+	if value, ok := _u.mutation.BounceCount(); ok {
+		_spec.SetField(emailsuppression.FieldBounceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBounceCount(); ok {
+		_spec.AddField(emailsuppression.FieldBounceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(emailsuppression.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(emailsuppression.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsuppression.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmailSuppression{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/authorizesocialplatforminfo"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/friendlink"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountdatagrant"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/group"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/grouplist"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/iosscriptcode"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
@@ -26,9 +32,15 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/sponsor"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/userrecoverycode"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/usertotp"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webauthncredential"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhooksubscription"
 )
@@ -91,13 +103,19 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			accountdeletion.Table:             accountdeletion.ValidColumn,
 			authorizesocialplatforminfo.Table: authorizesocialplatforminfo.ValidColumn,
+			dataexport.Table:                  dataexport.ValidColumn,
+			dataprovideroutbox.Table:          dataprovideroutbox.ValidColumn,
+			emailmessage.Table:                emailmessage.ValidColumn,
+			emailsuppression.Table:            emailsuppression.ValidColumn,
 			friendlink.Table:                  friendlink.ValidColumn,
 			gameaccountbinding.Table:          gameaccountbinding.ValidColumn,
 			gameaccountdatagrant.Table:        gameaccountdatagrant.ValidColumn,
 			group.Table:                       group.ValidColumn,
 			grouplist.Table:                   grouplist.ValidColumn,
 			iosscriptcode.Table:               iosscriptcode.ValidColumn,
+			inheritjob.Table:                  inheritjob.ValidColumn,
 			oauth2clientwebhookendpoint.Table: oauth2clientwebhookendpoint.ValidColumn,
 			riskevent.Table:                   riskevent.ValidColumn,
 			riskrule.Table:                    riskrule.ValidColumn,
@@ -105,9 +123,15 @@ func checkColumn(t, c string) error {
 			sponsor.Table:                     sponsor.ValidColumn,
 			systemlog.Table:                   systemlog.ValidColumn,
 			ticket.Table:                      ticket.ValidColumn,
+			ticketattachment.Table:            ticketattachment.ValidColumn,
 			ticketmessage.Table:               ticketmessage.ValidColumn,
 			uploadlog.Table:                   uploadlog.ValidColumn,
 			user.Table:                        user.ValidColumn,
+			userrecoverycode.Table:            userrecoverycode.ValidColumn,
+			usertotp.Table:                    usertotp.ValidColumn,
+			webauthncredential.Table:          webauthncredential.ValidColumn,
+			webhookdelivery.Table:             webhookdelivery.ValidColumn,
+			webhookdeliveryattempt.Table:      webhookdeliveryattempt.ValidColumn,
 			webhookendpoint.Table:             webhookendpoint.ValidColumn,
 			webhooksubscription.Table:         webhooksubscription.ValidColumn,
 		})
@@ -9,6 +9,18 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 )
 
+// The AccountDeletionFunc type is an adapter to allow the use of ordinary
+// function as AccountDeletion mutator.
+type AccountDeletionFunc func(context.Context, *postgresql.AccountDeletionMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountDeletionFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.AccountDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.AccountDeletionMutation", m)
+}
+
 // The AuthorizeSocialPlatformInfoFunc type is an adapter to allow the use of ordinary
 // function as AuthorizeSocialPlatformInfo mutator.
 type AuthorizeSocialPlatformInfoFunc func(context.Context, *postgresql.AuthorizeSocialPlatformInfoMutation) (postgresql.Value, error)
@@ -21,6 +33,54 @@ func (f AuthorizeSocialPlatformInfoFunc) Mutate(ctx context.Context, m postgresq
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.AuthorizeSocialPlatformInfoMutation", m)
 }
 
+// The DataExportFunc type is an adapter to allow the use of ordinary
+// function as DataExport mutator.
+type DataExportFunc func(context.Context, *postgresql.DataExportMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataExportFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.DataExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.DataExportMutation", m)
+}
+
+// The DataProviderOutboxFunc type is an adapter to allow the use of ordinary
+// function as DataProviderOutbox mutator.
+type DataProviderOutboxFunc func(context.Context, *postgresql.DataProviderOutboxMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataProviderOutboxFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.DataProviderOutboxMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.DataProviderOutboxMutation", m)
+}
+
+// The EmailMessageFunc type is an adapter to allow the use of ordinary
+// function as EmailMessage mutator.
+type EmailMessageFunc func(context.Context, *postgresql.EmailMessageMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailMessageFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.EmailMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.EmailMessageMutation", m)
+}
+
+// The EmailSuppressionFunc type is an adapter to allow the use of ordinary
+// function as EmailSuppression mutator.
+type EmailSuppressionFunc func(context.Context, *postgresql.EmailSuppressionMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailSuppressionFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.EmailSuppressionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.EmailSuppressionMutation", m)
+}
+
 // The FriendLinkFunc type is an adapter to allow the use of ordinary
 // function as FriendLink mutator.
 type FriendLinkFunc func(context.Context, *postgresql.FriendLinkMutation) (postgresql.Value, error)
@@ -93,6 +153,18 @@ func (f IOSScriptCodeFunc) Mutate(ctx context.Context, m postgresql.Mutation) (p
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.IOSScriptCodeMutation", m)
 }
 
+// The InheritJobFunc type is an adapter to allow the use of ordinary
+// function as InheritJob mutator.
+type InheritJobFunc func(context.Context, *postgresql.InheritJobMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InheritJobFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.InheritJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.InheritJobMutation", m)
+}
+
 // The OAuth2ClientWebhookEndpointFunc type is an adapter to allow the use of ordinary
 // function as OAuth2ClientWebhookEndpoint mutator.
 type OAuth2ClientWebhookEndpointFunc func(context.Context, *postgresql.OAuth2ClientWebhookEndpointMutation) (postgresql.Value, error)
@@ -177,6 +249,18 @@ func (f TicketFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgres
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.TicketMutation", m)
 }
 
+// The TicketAttachmentFunc type is an adapter to allow the use of ordinary
+// function as TicketAttachment mutator.
+type TicketAttachmentFunc func(context.Context, *postgresql.TicketAttachmentMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TicketAttachmentFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.TicketAttachmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.TicketAttachmentMutation", m)
+}
+
 // The TicketMessageFunc type is an adapter to allow the use of ordinary
 // function as TicketMessage mutator.
 type TicketMessageFunc func(context.Context, *postgresql.TicketMessageMutation) (postgresql.Value, error)
@@ -213,6 +297,66 @@ func (f UserFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.UserMutation", m)
 }
 
+// The UserRecoveryCodeFunc type is an adapter to allow the use of ordinary
+// function as UserRecoveryCode mutator.
+type UserRecoveryCodeFunc func(context.Context, *postgresql.UserRecoveryCodeMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserRecoveryCodeFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.UserRecoveryCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.UserRecoveryCodeMutation", m)
+}
+
+// The UserTOTPFunc type is an adapter to allow the use of ordinary
+// function as UserTOTP mutator.
+type UserTOTPFunc func(context.Context, *postgresql.UserTOTPMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserTOTPFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.UserTOTPMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.UserTOTPMutation", m)
+}
+
+// The WebAuthnCredentialFunc type is an adapter to allow the use of ordinary
+// function as WebAuthnCredential mutator.
+type WebAuthnCredentialFunc func(context.Context, *postgresql.WebAuthnCredentialMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebAuthnCredentialFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.WebAuthnCredentialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.WebAuthnCredentialMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *postgresql.WebhookDeliveryMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.WebhookDeliveryMutation", m)
+}
+
+// The WebhookDeliveryAttemptFunc type is an adapter to allow the use of ordinary
+// function as WebhookDeliveryAttempt mutator.
+type WebhookDeliveryAttemptFunc func(context.Context, *postgresql.WebhookDeliveryAttemptMutation) (postgresql.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryAttemptFunc) Mutate(ctx context.Context, m postgresql.Mutation) (postgresql.Value, error) {
+	if mv, ok := m.(*postgresql.WebhookDeliveryAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *postgresql.WebhookDeliveryAttemptMutation", m)
+}
+
 // The WebhookEndpointFunc type is an adapter to allow the use of ordinary
 // function as WebhookEndpoint mutator.
 type WebhookEndpointFunc func(context.Context, *postgresql.WebhookEndpointMutation) (postgresql.Value, error)
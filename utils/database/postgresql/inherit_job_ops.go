@@ -0,0 +1,157 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
+)
+
+const maxInheritJobTextLength = 500
+
+// truncateInheritJobText keeps value within the column limit, which ent
+// checks in bytes, without splitting a multi-byte character.
+func truncateInheritJobText(value string) string {
+	if len(value) <= maxInheritJobTextLength {
+		return value
+	}
+	cut := maxInheritJobTextLength
+	for cut > 0 && !utf8.RuneStart(value[cut]) {
+		cut--
+	}
+	return value[:cut]
+}
+
+func (c *Client) CreateInheritJob(ctx context.Context, id, server, uploadType string, now time.Time) (*InheritJob, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	return c.InheritJob.Create().
+		SetID(id).
+		SetServer(server).
+		SetUploadType(uploadType).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+// StartInheritJob moves a queued job to running. It reports false when the
+// job is no longer queued, for example because a sweep already failed it.
+func (c *Client) StartInheritJob(ctx context.Context, id string, now time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	affected, err := c.InheritJob.Update().
+		Where(inheritjob.IDEQ(id), inheritjob.StatusEQ(inheritjob.StatusQueued)).
+		SetStatus(inheritjob.StatusRunning).
+		SetStep(inheritjob.StepInit).
+		SetStartedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// UpdateInheritJobProgress records the step a running job has entered. A
+// zero gameUserID leaves the stored value untouched.
+func (c *Client) UpdateInheritJobProgress(ctx context.Context, id string, step inheritjob.Step, gameUserID int64, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	update := c.InheritJob.Update().
+		Where(inheritjob.IDEQ(id), inheritjob.StatusEQ(inheritjob.StatusRunning)).
+		SetStep(step).
+		SetUpdatedAt(now.UTC())
+	if gameUserID > 0 {
+		update.SetGameUserID(gameUserID)
+	}
+	return update.Exec(ctx)
+}
+
+func (c *Client) CompleteInheritJob(ctx context.Context, id string, gameUserID int64, message string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	update := c.InheritJob.Update().
+		Where(inheritjob.IDEQ(id), inheritjob.StatusEQ(inheritjob.StatusRunning)).
+		SetStatus(inheritjob.StatusSucceeded).
+		SetMessage(truncateInheritJobText(message)).
+		SetFinishedAt(now).
+		SetUpdatedAt(now)
+	if gameUserID > 0 {
+		update.SetGameUserID(gameUserID)
+	}
+	return update.Exec(ctx)
+}
+
+// FailInheritJob finishes a queued or running job with an error. The step is
+// kept so callers can see where the job stopped.
+func (c *Client) FailInheritJob(ctx context.Context, id string, gameUserID int64, errorCode int, message string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	update := c.InheritJob.Update().
+		Where(inheritjob.IDEQ(id), inheritjob.StatusIn(inheritjob.StatusQueued, inheritjob.StatusRunning)).
+		SetStatus(inheritjob.StatusFailed).
+		SetError(truncateInheritJobText(message)).
+		SetFinishedAt(now).
+		SetUpdatedAt(now)
+	if errorCode > 0 {
+		update.SetErrorCode(errorCode)
+	}
+	if gameUserID > 0 {
+		update.SetGameUserID(gameUserID)
+	}
+	return update.Exec(ctx)
+}
+
+// TouchInheritJobs refreshes updated_at on unfinished jobs this process still
+// owns, so FailStaleInheritJobs on any instance leaves them alone.
+func (c *Client) TouchInheritJobs(ctx context.Context, ids []string, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return c.InheritJob.Update().
+		Where(inheritjob.IDIn(ids...), inheritjob.StatusIn(inheritjob.StatusQueued, inheritjob.StatusRunning)).
+		SetUpdatedAt(now.UTC()).
+		Exec(ctx)
+}
+
+// FailStaleInheritJobs fails unfinished jobs that nobody has touched since
+// staleBefore; their process has exited and the credentials are gone.
+func (c *Client) FailStaleInheritJobs(ctx context.Context, staleBefore time.Time, message string, now time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	return c.InheritJob.Update().
+		Where(
+			inheritjob.StatusIn(inheritjob.StatusQueued, inheritjob.StatusRunning),
+			inheritjob.UpdatedAtLT(staleBefore.UTC()),
+		).
+		SetStatus(inheritjob.StatusFailed).
+		SetError(truncateInheritJobText(message)).
+		SetFinishedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+func (c *Client) PurgeFinishedInheritJobs(ctx context.Context, before time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.InheritJob.Delete().
+		Where(inheritjob.FinishedAtNotNil(), inheritjob.FinishedAtLT(before.UTC())).
+		Exec(ctx)
+}
@@ -0,0 +1,130 @@
+package postgresql_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestInheritJobLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:inherit-job-lifecycle-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	if _, err := client.CreateInheritJob(ctx, "job-1", "jp", "suite", now); err != nil {
+		t.Fatalf("CreateInheritJob returned error: %v", err)
+	}
+
+	// Progress is ignored until the job is running.
+	if err := client.UpdateInheritJobProgress(ctx, "job-1", inheritjob.StepSuite, 42, now); err != nil {
+		t.Fatalf("UpdateInheritJobProgress returned error: %v", err)
+	}
+	job, err := client.InheritJob.Get(ctx, "job-1")
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	if job.Status != inheritjob.StatusQueued || job.Step != nil || job.GameUserID != nil {
+		t.Fatalf("queued job = %+v", job)
+	}
+
+	started, err := client.StartInheritJob(ctx, "job-1", now.Add(time.Second))
+	if err != nil || !started {
+		t.Fatalf("StartInheritJob = %v, %v; want true, nil", started, err)
+	}
+	if started, err := client.StartInheritJob(ctx, "job-1", now.Add(time.Second)); err != nil || started {
+		t.Fatalf("second StartInheritJob = %v, %v; want false, nil", started, err)
+	}
+	if err := client.UpdateInheritJobProgress(ctx, "job-1", inheritjob.StepHomeRefresh, 42, now.Add(2*time.Second)); err != nil {
+		t.Fatalf("UpdateInheritJobProgress returned error: %v", err)
+	}
+	if err := client.CompleteInheritJob(ctx, "job-1", 0, strings.Repeat("é", 400), now.Add(3*time.Second)); err != nil {
+		t.Fatalf("CompleteInheritJob returned error: %v", err)
+	}
+	job, err = client.InheritJob.Get(ctx, "job-1")
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	if job.Status != inheritjob.StatusSucceeded || job.Step == nil || *job.Step != inheritjob.StepHomeRefresh {
+		t.Fatalf("completed job status %s step %v", job.Status, job.Step)
+	}
+	if job.GameUserID == nil || *job.GameUserID != 42 || job.FinishedAt == nil || job.StartedAt == nil {
+		t.Fatalf("completed job = %+v", job)
+	}
+	if job.Message == nil || len(*job.Message) != 500 {
+		t.Fatalf("message was not truncated to 500 bytes: %v", job.Message)
+	}
+
+	// A finished job cannot be failed afterwards.
+	if err := client.FailInheritJob(ctx, "job-1", 0, 500, "late failure", now.Add(4*time.Second)); err != nil {
+		t.Fatalf("FailInheritJob returned error: %v", err)
+	}
+	job, err = client.InheritJob.Get(ctx, "job-1")
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	if job.Status != inheritjob.StatusSucceeded || job.Error != nil {
+		t.Fatalf("finished job changed: status %s error %v", job.Status, job.Error)
+	}
+}
+
+func TestFailStaleInheritJobsAndPurge(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:inherit-job-stale-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+	for _, id := range []string{"alive", "stale-queued", "stale-running"} {
+		if _, err := client.CreateInheritJob(ctx, id, "en", "mysekai", now); err != nil {
+			t.Fatalf("CreateInheritJob(%s) returned error: %v", id, err)
+		}
+	}
+	if _, err := client.StartInheritJob(ctx, "stale-running", now); err != nil {
+		t.Fatalf("StartInheritJob returned error: %v", err)
+	}
+
+	later := now.Add(20 * time.Minute)
+	if err := client.TouchInheritJobs(ctx, []string{"alive"}, later); err != nil {
+		t.Fatalf("TouchInheritJobs returned error: %v", err)
+	}
+	failed, err := client.FailStaleInheritJobs(ctx, later.Add(-15*time.Minute), "interrupted", later)
+	if err != nil || failed != 2 {
+		t.Fatalf("FailStaleInheritJobs = %d, %v; want 2, nil", failed, err)
+	}
+	alive, err := client.InheritJob.Get(ctx, "alive")
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	if alive.Status != inheritjob.StatusQueued {
+		t.Fatalf("touched job status = %s, want queued", alive.Status)
+	}
+	stale, err := client.InheritJob.Get(ctx, "stale-running")
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	if stale.Status != inheritjob.StatusFailed || stale.Error == nil || *stale.Error != "interrupted" {
+		t.Fatalf("stale job = %+v", stale)
+	}
+
+	purged, err := client.PurgeFinishedInheritJobs(ctx, later.Add(time.Second))
+	if err != nil || purged != 2 {
+		t.Fatalf("PurgeFinishedInheritJobs = %d, %v; want 2, nil", purged, err)
+	}
+	if count, err := client.InheritJob.Query().Count(ctx); err != nil || count != 1 {
+		t.Fatalf("remaining jobs = %d, %v; want 1", count, err)
+	}
+}
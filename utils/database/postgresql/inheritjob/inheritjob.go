@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inheritjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the inheritjob type in the database.
+	Label = "inherit_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldServer holds the string denoting the server field in the database.
+	FieldServer = "server"
+	// FieldUploadType holds the string denoting the upload_type field in the database.
+	FieldUploadType = "upload_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStep holds the string denoting the step field in the database.
+	FieldStep = "step"
+	// FieldGameUserID holds the string denoting the game_user_id field in the database.
+	FieldGameUserID = "game_user_id"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldErrorCode holds the string denoting the error_code field in the database.
+	FieldErrorCode = "error_code"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the inheritjob in the database.
+	Table = "inherit_jobs"
+)
+
+// Columns holds all SQL columns for inheritjob fields.
+var Columns = []string{
+	FieldID,
+	FieldServer,
+	FieldUploadType,
+	FieldStatus,
+	FieldStep,
+	FieldGameUserID,
+	FieldMessage,
+	FieldError,
+	FieldErrorCode,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	ServerValidator func(string) error
+	// UploadTypeValidator is a validator for the "upload_type" field. It is called by the builders before save.
+	UploadTypeValidator func(string) error
+	// MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	MessageValidator func(string) error
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusQueued is the default value of the Status enum.
+const DefaultStatus = StatusQueued
+
+// Status values.
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusQueued, StatusRunning, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("inheritjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// Step defines the type for the "step" enum field.
+type Step string
+
+// Step values.
+const (
+	StepInit        Step = "init"
+	StepSuite       Step = "suite"
+	StepHomeRefresh Step = "home_refresh"
+	StepMysekai     Step = "mysekai"
+	StepPersist     Step = "persist"
+)
+
+func (s Step) String() string {
+	return string(s)
+}
+
+// StepValidator is a validator for the "step" field enum values. It is called by the builders before save.
+func StepValidator(s Step) error {
+	switch s {
+	case StepInit, StepSuite, StepHomeRefresh, StepMysekai, StepPersist:
+		return nil
+	default:
+		return fmt.Errorf("inheritjob: invalid enum value for step field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the InheritJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByServer orders the results by the server field.
+func ByServer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServer, opts...).ToFunc()
+}
+
+// ByUploadType orders the results by the upload_type field.
+func ByUploadType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStep orders the results by the step field.
+func ByStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStep, opts...).ToFunc()
+}
+
+// ByGameUserID orders the results by the game_user_id field.
+func ByGameUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGameUserID, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByErrorCode orders the results by the error_code field.
+func ByErrorCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorCode, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,740 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inheritjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContainsFold(FieldID, id))
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldServer, v))
+}
+
+// UploadType applies equality check predicate on the "upload_type" field. It's identical to UploadTypeEQ.
+func UploadType(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldUploadType, v))
+}
+
+// GameUserID applies equality check predicate on the "game_user_id" field. It's identical to GameUserIDEQ.
+func GameUserID(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldGameUserID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldMessage, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorCode applies equality check predicate on the "error_code" field. It's identical to ErrorCodeEQ.
+func ErrorCode(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldErrorCode, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldServer, v))
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldServer, v))
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldServer, vs...))
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldServer, vs...))
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldServer, v))
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldServer, v))
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldServer, v))
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldServer, v))
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContains(FieldServer, v))
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasPrefix(FieldServer, v))
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasSuffix(FieldServer, v))
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEqualFold(FieldServer, v))
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContainsFold(FieldServer, v))
+}
+
+// UploadTypeEQ applies the EQ predicate on the "upload_type" field.
+func UploadTypeEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldUploadType, v))
+}
+
+// UploadTypeNEQ applies the NEQ predicate on the "upload_type" field.
+func UploadTypeNEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldUploadType, v))
+}
+
+// UploadTypeIn applies the In predicate on the "upload_type" field.
+func UploadTypeIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldUploadType, vs...))
+}
+
+// UploadTypeNotIn applies the NotIn predicate on the "upload_type" field.
+func UploadTypeNotIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldUploadType, vs...))
+}
+
+// UploadTypeGT applies the GT predicate on the "upload_type" field.
+func UploadTypeGT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldUploadType, v))
+}
+
+// UploadTypeGTE applies the GTE predicate on the "upload_type" field.
+func UploadTypeGTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldUploadType, v))
+}
+
+// UploadTypeLT applies the LT predicate on the "upload_type" field.
+func UploadTypeLT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldUploadType, v))
+}
+
+// UploadTypeLTE applies the LTE predicate on the "upload_type" field.
+func UploadTypeLTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldUploadType, v))
+}
+
+// UploadTypeContains applies the Contains predicate on the "upload_type" field.
+func UploadTypeContains(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContains(FieldUploadType, v))
+}
+
+// UploadTypeHasPrefix applies the HasPrefix predicate on the "upload_type" field.
+func UploadTypeHasPrefix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasPrefix(FieldUploadType, v))
+}
+
+// UploadTypeHasSuffix applies the HasSuffix predicate on the "upload_type" field.
+func UploadTypeHasSuffix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasSuffix(FieldUploadType, v))
+}
+
+// UploadTypeEqualFold applies the EqualFold predicate on the "upload_type" field.
+func UploadTypeEqualFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEqualFold(FieldUploadType, v))
+}
+
+// UploadTypeContainsFold applies the ContainsFold predicate on the "upload_type" field.
+func UploadTypeContainsFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContainsFold(FieldUploadType, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v Step) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldStep, v))
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v Step) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldStep, v))
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...Step) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldStep, vs...))
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...Step) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldStep, vs...))
+}
+
+// StepIsNil applies the IsNil predicate on the "step" field.
+func StepIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldStep))
+}
+
+// StepNotNil applies the NotNil predicate on the "step" field.
+func StepNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldStep))
+}
+
+// GameUserIDEQ applies the EQ predicate on the "game_user_id" field.
+func GameUserIDEQ(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldGameUserID, v))
+}
+
+// GameUserIDNEQ applies the NEQ predicate on the "game_user_id" field.
+func GameUserIDNEQ(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldGameUserID, v))
+}
+
+// GameUserIDIn applies the In predicate on the "game_user_id" field.
+func GameUserIDIn(vs ...int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldGameUserID, vs...))
+}
+
+// GameUserIDNotIn applies the NotIn predicate on the "game_user_id" field.
+func GameUserIDNotIn(vs ...int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldGameUserID, vs...))
+}
+
+// GameUserIDGT applies the GT predicate on the "game_user_id" field.
+func GameUserIDGT(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldGameUserID, v))
+}
+
+// GameUserIDGTE applies the GTE predicate on the "game_user_id" field.
+func GameUserIDGTE(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldGameUserID, v))
+}
+
+// GameUserIDLT applies the LT predicate on the "game_user_id" field.
+func GameUserIDLT(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldGameUserID, v))
+}
+
+// GameUserIDLTE applies the LTE predicate on the "game_user_id" field.
+func GameUserIDLTE(v int64) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldGameUserID, v))
+}
+
+// GameUserIDIsNil applies the IsNil predicate on the "game_user_id" field.
+func GameUserIDIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldGameUserID))
+}
+
+// GameUserIDNotNil applies the NotNil predicate on the "game_user_id" field.
+func GameUserIDNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldGameUserID))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// ErrorCodeEQ applies the EQ predicate on the "error_code" field.
+func ErrorCodeEQ(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldErrorCode, v))
+}
+
+// ErrorCodeNEQ applies the NEQ predicate on the "error_code" field.
+func ErrorCodeNEQ(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldErrorCode, v))
+}
+
+// ErrorCodeIn applies the In predicate on the "error_code" field.
+func ErrorCodeIn(vs ...int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldErrorCode, vs...))
+}
+
+// ErrorCodeNotIn applies the NotIn predicate on the "error_code" field.
+func ErrorCodeNotIn(vs ...int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldErrorCode, vs...))
+}
+
+// ErrorCodeGT applies the GT predicate on the "error_code" field.
+func ErrorCodeGT(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldErrorCode, v))
+}
+
+// ErrorCodeGTE applies the GTE predicate on the "error_code" field.
+func ErrorCodeGTE(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldErrorCode, v))
+}
+
+// ErrorCodeLT applies the LT predicate on the "error_code" field.
+func ErrorCodeLT(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldErrorCode, v))
+}
+
+// ErrorCodeLTE applies the LTE predicate on the "error_code" field.
+func ErrorCodeLTE(v int) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldErrorCode, v))
+}
+
+// ErrorCodeIsNil applies the IsNil predicate on the "error_code" field.
+func ErrorCodeIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldErrorCode))
+}
+
+// ErrorCodeNotNil applies the NotNil predicate on the "error_code" field.
+func ErrorCodeNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldErrorCode))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InheritJob {
+	return predicate.InheritJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InheritJob) predicate.InheritJob {
+	return predicate.InheritJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InheritJob) predicate.InheritJob {
+	return predicate.InheritJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InheritJob) predicate.InheritJob {
+	return predicate.InheritJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
+)
+
+// InheritJobCreate is the builder for creating a InheritJob entity.
+type InheritJobCreate struct {
+	config
+	mutation *InheritJobMutation
+	hooks    []Hook
+}
+
+// SetServer sets the "server" field.
+func (_c *InheritJobCreate) SetServer(v string) *InheritJobCreate {
+	_c.mutation.SetServer(v)
+	return _c
+}
+
+// SetUploadType sets the "upload_type" field.
+func (_c *InheritJobCreate) SetUploadType(v string) *InheritJobCreate {
+	_c.mutation.SetUploadType(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *InheritJobCreate) SetStatus(v inheritjob.Status) *InheritJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableStatus(v *inheritjob.Status) *InheritJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStep sets the "step" field.
+func (_c *InheritJobCreate) SetStep(v inheritjob.Step) *InheritJobCreate {
+	_c.mutation.SetStep(v)
+	return _c
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableStep(v *inheritjob.Step) *InheritJobCreate {
+	if v != nil {
+		_c.SetStep(*v)
+	}
+	return _c
+}
+
+// SetGameUserID sets the "game_user_id" field.
+func (_c *InheritJobCreate) SetGameUserID(v int64) *InheritJobCreate {
+	_c.mutation.SetGameUserID(v)
+	return _c
+}
+
+// SetNillableGameUserID sets the "game_user_id" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableGameUserID(v *int64) *InheritJobCreate {
+	if v != nil {
+		_c.SetGameUserID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *InheritJobCreate) SetMessage(v string) *InheritJobCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableMessage(v *string) *InheritJobCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *InheritJobCreate) SetError(v string) *InheritJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableError(v *string) *InheritJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetErrorCode sets the "error_code" field.
+func (_c *InheritJobCreate) SetErrorCode(v int) *InheritJobCreate {
+	_c.mutation.SetErrorCode(v)
+	return _c
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableErrorCode(v *int) *InheritJobCreate {
+	if v != nil {
+		_c.SetErrorCode(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *InheritJobCreate) SetStartedAt(v time.Time) *InheritJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableStartedAt(v *time.Time) *InheritJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *InheritJobCreate) SetFinishedAt(v time.Time) *InheritJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableFinishedAt(v *time.Time) *InheritJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InheritJobCreate) SetCreatedAt(v time.Time) *InheritJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableCreatedAt(v *time.Time) *InheritJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InheritJobCreate) SetUpdatedAt(v time.Time) *InheritJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InheritJobCreate) SetNillableUpdatedAt(v *time.Time) *InheritJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InheritJobCreate) SetID(v string) *InheritJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InheritJobMutation object of the builder.
+func (_c *InheritJobCreate) Mutation() *InheritJobMutation {
+	return _c.mutation
+}
+
+// Save creates the InheritJob in the database.
+func (_c *InheritJobCreate) Save(ctx context.Context) (*InheritJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InheritJobCreate) SaveX(ctx context.Context) *InheritJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InheritJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InheritJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InheritJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := inheritjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := inheritjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := inheritjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InheritJobCreate) check() error {
+	if _, ok := _c.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`postgresql: missing required field "InheritJob.server"`)}
+	}
+	if v, ok := _c.mutation.Server(); ok {
+		if err := inheritjob.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.server": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UploadType(); !ok {
+		return &ValidationError{Name: "upload_type", err: errors.New(`postgresql: missing required field "InheritJob.upload_type"`)}
+	}
+	if v, ok := _c.mutation.UploadType(); ok {
+		if err := inheritjob.UploadTypeValidator(v); err != nil {
+			return &ValidationError{Name: "upload_type", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.upload_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`postgresql: missing required field "InheritJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := inheritjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Step(); ok {
+		if err := inheritjob.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.step": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := inheritjob.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.message": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := inheritjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "InheritJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "InheritJob.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := inheritjob.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InheritJobCreate) sqlSave(ctx context.Context) (*InheritJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected InheritJob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InheritJobCreate) createSpec() (*InheritJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InheritJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inheritjob.Table, sqlgraph.NewFieldSpec(inheritjob.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Server(); ok {
+		_spec.SetField(inheritjob.FieldServer, field.TypeString, value)
+		_node.Server = value
+	}
+	if value, ok := _c.mutation.UploadType(); ok {
+		_spec.SetField(inheritjob.FieldUploadType, field.TypeString, value)
+		_node.UploadType = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(inheritjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Step(); ok {
+		_spec.SetField(inheritjob.FieldStep, field.TypeEnum, value)
+		_node.Step = &value
+	}
+	if value, ok := _c.mutation.GameUserID(); ok {
+		_spec.SetField(inheritjob.FieldGameUserID, field.TypeInt64, value)
+		_node.GameUserID = &value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(inheritjob.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(inheritjob.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.ErrorCode(); ok {
+		_spec.SetField(inheritjob.FieldErrorCode, field.TypeInt, value)
+		_node.ErrorCode = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(inheritjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(inheritjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(inheritjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(inheritjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// InheritJobCreateBulk is the builder for creating many InheritJob entities in bulk.
+type InheritJobCreateBulk struct {
+	config
+	err      error
+	builders []*InheritJobCreate
+}
+
+// Save creates the InheritJob entities in the database.
+func (_c *InheritJobCreateBulk) Save(ctx context.Context) ([]*InheritJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InheritJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InheritJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InheritJobCreateBulk) SaveX(ctx context.Context) []*InheritJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InheritJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InheritJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
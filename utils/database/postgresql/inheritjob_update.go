@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// InheritJobUpdate is the builder for updating InheritJob entities.
+type InheritJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InheritJobMutation
+}
+
+// Where appends a list predicates to the InheritJobUpdate builder.
+func (_u *InheritJobUpdate) Where(ps ...predicate.InheritJob) *InheritJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InheritJobUpdate) SetStatus(v inheritjob.Status) *InheritJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableStatus(v *inheritjob.Status) *InheritJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *InheritJobUpdate) SetStep(v inheritjob.Step) *InheritJobUpdate {
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableStep(v *inheritjob.Step) *InheritJobUpdate {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// ClearStep clears the value of the "step" field.
+func (_u *InheritJobUpdate) ClearStep() *InheritJobUpdate {
+	_u.mutation.ClearStep()
+	return _u
+}
+
+// SetGameUserID sets the "game_user_id" field.
+func (_u *InheritJobUpdate) SetGameUserID(v int64) *InheritJobUpdate {
+	_u.mutation.ResetGameUserID()
+	_u.mutation.SetGameUserID(v)
+	return _u
+}
+
+// SetNillableGameUserID sets the "game_user_id" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableGameUserID(v *int64) *InheritJobUpdate {
+	if v != nil {
+		_u.SetGameUserID(*v)
+	}
+	return _u
+}
+
+// AddGameUserID adds value to the "game_user_id" field.
+func (_u *InheritJobUpdate) AddGameUserID(v int64) *InheritJobUpdate {
+	_u.mutation.AddGameUserID(v)
+	return _u
+}
+
+// ClearGameUserID clears the value of the "game_user_id" field.
+func (_u *InheritJobUpdate) ClearGameUserID() *InheritJobUpdate {
+	_u.mutation.ClearGameUserID()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *InheritJobUpdate) SetMessage(v string) *InheritJobUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableMessage(v *string) *InheritJobUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *InheritJobUpdate) ClearMessage() *InheritJobUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *InheritJobUpdate) SetError(v string) *InheritJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableError(v *string) *InheritJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *InheritJobUpdate) ClearError() *InheritJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetErrorCode sets the "error_code" field.
+func (_u *InheritJobUpdate) SetErrorCode(v int) *InheritJobUpdate {
+	_u.mutation.ResetErrorCode()
+	_u.mutation.SetErrorCode(v)
+	return _u
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableErrorCode(v *int) *InheritJobUpdate {
+	if v != nil {
+		_u.SetErrorCode(*v)
+	}
+	return _u
+}
+
+// AddErrorCode adds value to the "error_code" field.
+func (_u *InheritJobUpdate) AddErrorCode(v int) *InheritJobUpdate {
+	_u.mutation.AddErrorCode(v)
+	return _u
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (_u *InheritJobUpdate) ClearErrorCode() *InheritJobUpdate {
+	_u.mutation.ClearErrorCode()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *InheritJobUpdate) SetStartedAt(v time.Time) *InheritJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableStartedAt(v *time.Time) *InheritJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *InheritJobUpdate) ClearStartedAt() *InheritJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *InheritJobUpdate) SetFinishedAt(v time.Time) *InheritJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *InheritJobUpdate) SetNillableFinishedAt(v *time.Time) *InheritJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *InheritJobUpdate) ClearFinishedAt() *InheritJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InheritJobUpdate) SetUpdatedAt(v time.Time) *InheritJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the InheritJobMutation object of the builder.
+func (_u *InheritJobUpdate) Mutation() *InheritJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InheritJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InheritJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InheritJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InheritJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InheritJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := inheritjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InheritJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := inheritjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Step(); ok {
+		if err := inheritjob.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.step": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := inheritjob.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := inheritjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InheritJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inheritjob.Table, inheritjob.Columns, sqlgraph.NewFieldSpec(inheritjob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(inheritjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(inheritjob.FieldStep, field.TypeEnum, value)
+	}
+	if _u.mutation.StepCleared() {
+		_spec.ClearField(inheritjob.FieldStep, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.GameUserID(); ok {
+		_spec.SetField(inheritjob.FieldGameUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGameUserID(); ok {
+		_spec.AddField(inheritjob.FieldGameUserID, field.TypeInt64, value)
+	}
+	if _u.mutation.GameUserIDCleared() {
+		_spec.ClearField(inheritjob.FieldGameUserID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(inheritjob.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(inheritjob.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(inheritjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(inheritjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorCode(); ok {
+		_spec.SetField(inheritjob.FieldErrorCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedErrorCode(); ok {
+		_spec.AddField(inheritjob.FieldErrorCode, field.TypeInt, value)
+	}
+	if _u.mutation.ErrorCodeCleared() {
+		_spec.ClearField(inheritjob.FieldErrorCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(inheritjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(inheritjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(inheritjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(inheritjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(inheritjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inheritjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InheritJobUpdateOne is the builder for updating a single InheritJob entity.
+type InheritJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InheritJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *InheritJobUpdateOne) SetStatus(v inheritjob.Status) *InheritJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableStatus(v *inheritjob.Status) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *InheritJobUpdateOne) SetStep(v inheritjob.Step) *InheritJobUpdateOne {
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableStep(v *inheritjob.Step) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// ClearStep clears the value of the "step" field.
+func (_u *InheritJobUpdateOne) ClearStep() *InheritJobUpdateOne {
+	_u.mutation.ClearStep()
+	return _u
+}
+
+// SetGameUserID sets the "game_user_id" field.
+func (_u *InheritJobUpdateOne) SetGameUserID(v int64) *InheritJobUpdateOne {
+	_u.mutation.ResetGameUserID()
+	_u.mutation.SetGameUserID(v)
+	return _u
+}
+
+// SetNillableGameUserID sets the "game_user_id" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableGameUserID(v *int64) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetGameUserID(*v)
+	}
+	return _u
+}
+
+// AddGameUserID adds value to the "game_user_id" field.
+func (_u *InheritJobUpdateOne) AddGameUserID(v int64) *InheritJobUpdateOne {
+	_u.mutation.AddGameUserID(v)
+	return _u
+}
+
+// ClearGameUserID clears the value of the "game_user_id" field.
+func (_u *InheritJobUpdateOne) ClearGameUserID() *InheritJobUpdateOne {
+	_u.mutation.ClearGameUserID()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *InheritJobUpdateOne) SetMessage(v string) *InheritJobUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableMessage(v *string) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *InheritJobUpdateOne) ClearMessage() *InheritJobUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *InheritJobUpdateOne) SetError(v string) *InheritJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableError(v *string) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *InheritJobUpdateOne) ClearError() *InheritJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetErrorCode sets the "error_code" field.
+func (_u *InheritJobUpdateOne) SetErrorCode(v int) *InheritJobUpdateOne {
+	_u.mutation.ResetErrorCode()
+	_u.mutation.SetErrorCode(v)
+	return _u
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableErrorCode(v *int) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetErrorCode(*v)
+	}
+	return _u
+}
+
+// AddErrorCode adds value to the "error_code" field.
+func (_u *InheritJobUpdateOne) AddErrorCode(v int) *InheritJobUpdateOne {
+	_u.mutation.AddErrorCode(v)
+	return _u
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (_u *InheritJobUpdateOne) ClearErrorCode() *InheritJobUpdateOne {
+	_u.mutation.ClearErrorCode()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *InheritJobUpdateOne) SetStartedAt(v time.Time) *InheritJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableStartedAt(v *time.Time) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *InheritJobUpdateOne) ClearStartedAt() *InheritJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *InheritJobUpdateOne) SetFinishedAt(v time.Time) *InheritJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *InheritJobUpdateOne) SetNillableFinishedAt(v *time.Time) *InheritJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *InheritJobUpdateOne) ClearFinishedAt() *InheritJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InheritJobUpdateOne) SetUpdatedAt(v time.Time) *InheritJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the InheritJobMutation object of the builder.
+func (_u *InheritJobUpdateOne) Mutation() *InheritJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InheritJobUpdate builder.
+func (_u *InheritJobUpdateOne) Where(ps ...predicate.InheritJob) *InheritJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InheritJobUpdateOne) Select(field string, fields ...string) *InheritJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InheritJob entity.
+func (_u *InheritJobUpdateOne) Save(ctx context.Context) (*InheritJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InheritJobUpdateOne) SaveX(ctx context.Context) *InheritJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InheritJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InheritJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InheritJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := inheritjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InheritJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := inheritjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Step(); ok {
+		if err := inheritjob.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.step": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := inheritjob.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := inheritjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`postgresql: validator failed for field "InheritJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InheritJobUpdateOne) sqlSave(ctx context.Context) (_node *InheritJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inheritjob.Table, inheritjob.Columns, sqlgraph.NewFieldSpec(inheritjob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "InheritJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inheritjob.FieldID)
+		for _, f := range fields {
+			if !inheritjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != inheritjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(inheritjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(inheritjob.FieldStep, field.TypeEnum, value)
+	}
+	if _u.mutation.StepCleared() {
+		_spec.ClearField(inheritjob.FieldStep, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.GameUserID(); ok {
+		_spec.SetField(inheritjob.FieldGameUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGameUserID(); ok {
+		_spec.AddField(inheritjob.FieldGameUserID, field.TypeInt64, value)
+	}
+	if _u.mutation.GameUserIDCleared() {
+		_spec.ClearField(inheritjob.FieldGameUserID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(inheritjob.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(inheritjob.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(inheritjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(inheritjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorCode(); ok {
+		_spec.SetField(inheritjob.FieldErrorCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedErrorCode(); ok {
+		_spec.AddField(inheritjob.FieldErrorCode, field.TypeInt, value)
+	}
+	if _u.mutation.ErrorCodeCleared() {
+		_spec.ClearField(inheritjob.FieldErrorCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(inheritjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(inheritjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(inheritjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(inheritjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(inheritjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &InheritJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inheritjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
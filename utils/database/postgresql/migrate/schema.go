@@ -9,6 +9,41 @@ import (
 )
 
 var (
+	// AccountDeletionsColumns holds the columns for the "account_deletions" table.
+	AccountDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "cancelled", "completed", "failed"}, Default: "pending"},
+		{Name: "locale", Type: field.TypeString, Size: 16, Default: ""},
+		{Name: "scheduled_for", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "receipt", Type: field.TypeJSON, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AccountDeletionsTable holds the schema information for the "account_deletions" table.
+	AccountDeletionsTable = &schema.Table{
+		Name:       "account_deletions",
+		Columns:    AccountDeletionsColumns,
+		PrimaryKey: []*schema.Column{AccountDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountdeletion_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionsColumns[1], AccountDeletionsColumns[2]},
+			},
+			{
+				Name:    "accountdeletion_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionsColumns[2], AccountDeletionsColumns[7]},
+			},
+		},
+	}
 	// AuthorizeSocialPlatformInfosColumns holds the columns for the "authorize_social_platform_infos" table.
 	AuthorizeSocialPlatformInfosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -40,6 +75,158 @@ var (
 			},
 		},
 	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "ready", "failed", "expired"}, Default: "queued"},
+		{Name: "locale", Type: field.TypeString, Size: 16, Default: ""},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "size_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "sha256", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "download_token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+		{Name: "download_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "ready_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_downloaded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataexport_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[1], DataExportsColumns[16]},
+			},
+			{
+				Name:    "dataexport_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[2], DataExportsColumns[6]},
+			},
+			{
+				Name:    "dataexport_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[2], DataExportsColumns[14]},
+			},
+		},
+	}
+	// DataProviderOutboxColumns holds the columns for the "data_provider_outbox" table.
+	DataProviderOutboxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 32},
+		{Name: "server", Type: field.TypeString, Size: 8},
+		{Name: "game_user_id", Type: field.TypeString, Size: 30},
+		{Name: "data_type", Type: field.TypeString, Size: 32},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "generation", Type: field.TypeInt, Default: 1},
+		{Name: "snapshot_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "skipped", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DataProviderOutboxTable holds the schema information for the "data_provider_outbox" table.
+	DataProviderOutboxTable = &schema.Table{
+		Name:       "data_provider_outbox",
+		Columns:    DataProviderOutboxColumns,
+		PrimaryKey: []*schema.Column{DataProviderOutboxColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataprovideroutbox_provider_server_game_user_id_data_type",
+				Unique:  true,
+				Columns: []*schema.Column{DataProviderOutboxColumns[1], DataProviderOutboxColumns[2], DataProviderOutboxColumns[3], DataProviderOutboxColumns[4]},
+			},
+			{
+				Name:    "dataprovideroutbox_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataProviderOutboxColumns[8], DataProviderOutboxColumns[11]},
+			},
+			{
+				Name:    "dataprovideroutbox_provider_status_snapshot_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataProviderOutboxColumns[1], DataProviderOutboxColumns[8], DataProviderOutboxColumns[7]},
+			},
+			{
+				Name:    "dataprovideroutbox_server_game_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{DataProviderOutboxColumns[2], DataProviderOutboxColumns[3]},
+			},
+		},
+	}
+	// EmailMessagesColumns holds the columns for the "email_messages" table.
+	EmailMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "recipient", Type: field.TypeString, Size: 320},
+		{Name: "subject", Type: field.TypeString, Size: 998},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "display_name", Type: field.TypeString, Default: ""},
+		{Name: "template", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "locale", Type: field.TypeString, Size: 16, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "sent", "failed", "bounced", "suppressed", "expired"}, Default: "queued"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "relay", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmailMessagesTable holds the schema information for the "email_messages" table.
+	EmailMessagesTable = &schema.Table{
+		Name:       "email_messages",
+		Columns:    EmailMessagesColumns,
+		PrimaryKey: []*schema.Column{EmailMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailmessage_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailMessagesColumns[7], EmailMessagesColumns[10]},
+			},
+			{
+				Name:    "emailmessage_recipient_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailMessagesColumns[1], EmailMessagesColumns[15]},
+			},
+			{
+				Name:    "emailmessage_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EmailMessagesColumns[15]},
+			},
+		},
+	}
+	// EmailSuppressionsColumns holds the columns for the "email_suppressions" table.
+	EmailSuppressionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Unique: true, Size: 320},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"hard_bounce", "complaint", "manual"}},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "bounce_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmailSuppressionsTable holds the schema information for the "email_suppressions" table.
+	EmailSuppressionsTable = &schema.Table{
+		Name:       "email_suppressions",
+		Columns:    EmailSuppressionsColumns,
+		PrimaryKey: []*schema.Column{EmailSuppressionsColumns[0]},
+	}
 	// FriendLinksColumns holds the columns for the "friend_links" table.
 	FriendLinksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -203,13 +390,54 @@ var (
 			},
 		},
 	}
+	// InheritJobsColumns holds the columns for the "inherit_jobs" table.
+	InheritJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "server", Type: field.TypeString, Size: 8},
+		{Name: "upload_type", Type: field.TypeString, Size: 32},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "running", "succeeded", "failed"}, Default: "queued"},
+		{Name: "step", Type: field.TypeEnum, Nullable: true, Enums: []string{"init", "suite", "home_refresh", "mysekai", "persist"}},
+		{Name: "game_user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "error_code", Type: field.TypeInt, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InheritJobsTable holds the schema information for the "inherit_jobs" table.
+	InheritJobsTable = &schema.Table{
+		Name:       "inherit_jobs",
+		Columns:    InheritJobsColumns,
+		PrimaryKey: []*schema.Column{InheritJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inheritjob_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{InheritJobsColumns[3], InheritJobsColumns[12]},
+			},
+			{
+				Name:    "inheritjob_finished_at",
+				Unique:  false,
+				Columns: []*schema.Column{InheritJobsColumns[10]},
+			},
+		},
+	}
 	// Oauth2ClientWebhookEndpointsColumns holds the columns for the "oauth2_client_webhook_endpoints" table.
 	Oauth2ClientWebhookEndpointsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "client_id", Type: field.TypeString},
 		{Name: "callback_url", Type: field.TypeString},
 		{Name: "bearer", Type: field.TypeString, Nullable: true},
+		{Name: "signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "owner_email", Type: field.TypeString, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "failing_since", Type: field.TypeTime, Nullable: true},
+		{Name: "auto_disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -452,6 +680,10 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "first_response_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "first_response_breached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_breached_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 	}
 	// TicketsTable holds the schema information for the "tickets" table.
@@ -475,6 +707,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TicketsColumns[6], TicketsColumns[8]},
 			},
+			{
+				Name:    "ticket_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{TicketsColumns[6], TicketsColumns[9]},
+			},
 			{
 				Name:    "ticket_assignee_admin_id_created_at",
 				Unique:  false,
@@ -482,6 +719,54 @@ var (
 			},
 		},
 	}
+	// TicketAttachmentsColumns holds the columns for the "ticket_attachments" table.
+	TicketAttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "uploader_user_id", Type: field.TypeString, Size: 64},
+		{Name: "file_name", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString, Size: 128},
+		{Name: "size_bytes", Type: field.TypeInt64},
+		{Name: "sha256", Type: field.TypeString, Size: 64},
+		{Name: "storage_key", Type: field.TypeString, Size: 512},
+		{Name: "thumbnail_key", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeInt},
+		{Name: "message_id", Type: field.TypeInt},
+	}
+	// TicketAttachmentsTable holds the schema information for the "ticket_attachments" table.
+	TicketAttachmentsTable = &schema.Table{
+		Name:       "ticket_attachments",
+		Columns:    TicketAttachmentsColumns,
+		PrimaryKey: []*schema.Column{TicketAttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ticket_attachments_tickets_attachments",
+				Columns:    []*schema.Column{TicketAttachmentsColumns[11]},
+				RefColumns: []*schema.Column{TicketsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ticket_attachments_ticket_messages_attachments",
+				Columns:    []*schema.Column{TicketAttachmentsColumns[12]},
+				RefColumns: []*schema.Column{TicketMessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ticketattachment_ticket_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TicketAttachmentsColumns[11], TicketAttachmentsColumns[10]},
+			},
+			{
+				Name:    "ticketattachment_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{TicketAttachmentsColumns[12]},
+			},
+		},
+	}
 	// TicketMessagesColumns holds the columns for the "ticket_messages" table.
 	TicketMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -569,6 +854,8 @@ var (
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin", "super_admin"}, Default: "user"},
 		{Name: "banned", Type: field.TypeBool, Default: false},
 		{Name: "ban_reason", Type: field.TypeString, Nullable: true},
+		{Name: "ban_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "upload_blocked_until", Type: field.TypeTime, Nullable: true},
 		{Name: "kratos_identity_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 	}
@@ -581,13 +868,170 @@ var (
 			{
 				Name:    "user_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[10]},
+				Columns: []*schema.Column{UsersColumns[12]},
 			},
 			{
 				Name:    "user_role_banned",
 				Unique:  false,
 				Columns: []*schema.Column{UsersColumns[6], UsersColumns[7]},
 			},
+			{
+				Name:    "user_banned_ban_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[7], UsersColumns[9]},
+			},
+		},
+	}
+	// UserRecoveryCodesColumns holds the columns for the "user_recovery_codes" table.
+	UserRecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString, Size: 64},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UserRecoveryCodesTable holds the schema information for the "user_recovery_codes" table.
+	UserRecoveryCodesTable = &schema.Table{
+		Name:       "user_recovery_codes",
+		Columns:    UserRecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{UserRecoveryCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userrecoverycode_user_id_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{UserRecoveryCodesColumns[1], UserRecoveryCodesColumns[2]},
+			},
+		},
+	}
+	// UserTotpFactorsColumns holds the columns for the "user_totp_factors" table.
+	UserTotpFactorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UserTotpFactorsTable holds the schema information for the "user_totp_factors" table.
+	UserTotpFactorsTable = &schema.Table{
+		Name:       "user_totp_factors",
+		Columns:    UserTotpFactorsColumns,
+		PrimaryKey: []*schema.Column{UserTotpFactorsColumns[0]},
+	}
+	// WebauthnCredentialsColumns holds the columns for the "webauthn_credentials" table.
+	WebauthnCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 1400},
+		{Name: "user_id", Type: field.TypeString, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "credential", Type: field.TypeBytes},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebauthnCredentialsTable holds the schema information for the "webauthn_credentials" table.
+	WebauthnCredentialsTable = &schema.Table{
+		Name:       "webauthn_credentials",
+		Columns:    WebauthnCredentialsColumns,
+		PrimaryKey: []*schema.Column{WebauthnCredentialsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webauthncredential_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebauthnCredentialsColumns[1]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "endpoint_type", Type: field.TypeEnum, Enums: []string{"webhook", "oauth2_client"}},
+		{Name: "endpoint_id", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "callback_url", Type: field.TypeString},
+		{Name: "game_user_id", Type: field.TypeString, Size: 30},
+		{Name: "server", Type: field.TypeString, Size: 8},
+		{Name: "data_type", Type: field.TypeString, Size: 32},
+		{Name: "event_id", Type: field.TypeString, Default: ""},
+		{Name: "payload", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10], WebhookDeliveriesColumns[13]},
+			},
+			{
+				Name:    "webhookdelivery_endpoint_type_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[2], WebhookDeliveriesColumns[17]},
+			},
+			{
+				Name:    "webhookdelivery_client_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[17]},
+			},
+			{
+				Name:    "webhookdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[17]},
+			},
+		},
+	}
+	// WebhookDeliveryAttemptsColumns holds the columns for the "webhook_delivery_attempts" table.
+	WebhookDeliveryAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "endpoint_type", Type: field.TypeEnum, Enums: []string{"webhook", "oauth2_client"}},
+		{Name: "endpoint_id", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "delivery_id", Type: field.TypeString, Default: ""},
+		{Name: "event_id", Type: field.TypeString, Default: ""},
+		{Name: "attempt", Type: field.TypeInt, Default: 0},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveryAttemptsTable holds the schema information for the "webhook_delivery_attempts" table.
+	WebhookDeliveryAttemptsTable = &schema.Table{
+		Name:       "webhook_delivery_attempts",
+		Columns:    WebhookDeliveryAttemptsColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveryAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdeliveryattempt_endpoint_type_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryAttemptsColumns[1], WebhookDeliveryAttemptsColumns[2], WebhookDeliveryAttemptsColumns[11]},
+			},
+			{
+				Name:    "webhookdeliveryattempt_client_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryAttemptsColumns[3], WebhookDeliveryAttemptsColumns[11]},
+			},
+			{
+				Name:    "webhookdeliveryattempt_delivery_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryAttemptsColumns[4]},
+			},
+			{
+				Name:    "webhookdeliveryattempt_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryAttemptsColumns[11]},
+			},
 		},
 	}
 	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
@@ -596,7 +1040,14 @@ var (
 		{Name: "credential", Type: field.TypeString},
 		{Name: "callback_url", Type: field.TypeString},
 		{Name: "bearer", Type: field.TypeString, Nullable: true},
+		{Name: "signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "owner_email", Type: field.TypeString, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "failing_since", Type: field.TypeTime, Nullable: true},
+		{Name: "auto_disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
@@ -654,13 +1105,19 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountDeletionsTable,
 		AuthorizeSocialPlatformInfosTable,
+		DataExportsTable,
+		DataProviderOutboxTable,
+		EmailMessagesTable,
+		EmailSuppressionsTable,
 		FriendLinksTable,
 		GameAccountBindingsTable,
 		GameAccountDataGrantsTable,
 		GroupsTable,
 		GroupListsTable,
 		IosScriptCodesTable,
+		InheritJobsTable,
 		Oauth2ClientWebhookEndpointsTable,
 		RiskEventsTable,
 		RiskRulesTable,
@@ -668,16 +1125,37 @@ var (
 		SponsorsTable,
 		SystemLogsTable,
 		TicketsTable,
+		TicketAttachmentsTable,
 		TicketMessagesTable,
 		UploadLogsTable,
 		UsersTable,
+		UserRecoveryCodesTable,
+		UserTotpFactorsTable,
+		WebauthnCredentialsTable,
+		WebhookDeliveriesTable,
+		WebhookDeliveryAttemptsTable,
 		WebhookEndpointsTable,
 		WebhookSubscriptionsTable,
 	}
 )
 
 func init() {
+	AccountDeletionsTable.Annotation = &entsql.Annotation{
+		Table: "account_deletions",
+	}
 	AuthorizeSocialPlatformInfosTable.ForeignKeys[0].RefTable = UsersTable
+	DataExportsTable.Annotation = &entsql.Annotation{
+		Table: "data_exports",
+	}
+	DataProviderOutboxTable.Annotation = &entsql.Annotation{
+		Table: "data_provider_outbox",
+	}
+	EmailMessagesTable.Annotation = &entsql.Annotation{
+		Table: "email_messages",
+	}
+	EmailSuppressionsTable.Annotation = &entsql.Annotation{
+		Table: "email_suppressions",
+	}
 	GameAccountBindingsTable.ForeignKeys[0].RefTable = UsersTable
 	GameAccountBindingsTable.Annotation = &entsql.Annotation{
 		Table: "game_account_bindings",
@@ -689,6 +1167,9 @@ func init() {
 	}
 	GroupListsTable.ForeignKeys[0].RefTable = GroupsTable
 	IosScriptCodesTable.ForeignKeys[0].RefTable = UsersTable
+	InheritJobsTable.Annotation = &entsql.Annotation{
+		Table: "inherit_jobs",
+	}
 	Oauth2ClientWebhookEndpointsTable.Annotation = &entsql.Annotation{
 		Table: "oauth2_client_webhook_endpoints",
 	}
@@ -708,10 +1189,30 @@ func init() {
 	TicketsTable.Annotation = &entsql.Annotation{
 		Table: "tickets",
 	}
+	TicketAttachmentsTable.ForeignKeys[0].RefTable = TicketsTable
+	TicketAttachmentsTable.ForeignKeys[1].RefTable = TicketMessagesTable
+	TicketAttachmentsTable.Annotation = &entsql.Annotation{
+		Table: "ticket_attachments",
+	}
 	TicketMessagesTable.ForeignKeys[0].RefTable = TicketsTable
 	TicketMessagesTable.Annotation = &entsql.Annotation{
 		Table: "ticket_messages",
 	}
+	UserRecoveryCodesTable.Annotation = &entsql.Annotation{
+		Table: "user_recovery_codes",
+	}
+	UserTotpFactorsTable.Annotation = &entsql.Annotation{
+		Table: "user_totp_factors",
+	}
+	WebauthnCredentialsTable.Annotation = &entsql.Annotation{
+		Table: "webauthn_credentials",
+	}
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "webhook_deliveries",
+	}
+	WebhookDeliveryAttemptsTable.Annotation = &entsql.Annotation{
+		Table: "webhook_delivery_attempts",
+	}
 	WebhookEndpointsTable.Annotation = &entsql.Annotation{
 		Table: "webhook_endpoints",
 	}
@@ -18,9 +18,11 @@ type OAuth2WebhookOwner struct {
 }
 
 type OAuth2ClientWebhookCallback struct {
-	ClientID    string
-	CallbackURL string
-	Bearer      string
+	EndpointID     string
+	ClientID       string
+	CallbackURL    string
+	Bearer         string
+	SigningSecrets WebhookSigningSecrets
 }
 
 func (c *Client) GetOAuth2WebhookOwnerForGameAccount(ctx context.Context, userID int64, server string) (*OAuth2WebhookOwner, error) {
@@ -96,9 +98,11 @@ func (c *Client) GetOAuth2ClientWebhookCallbacks(ctx context.Context, clientIDs
 			bearer = strings.TrimSpace(*row.Bearer)
 		}
 		callbacks = append(callbacks, OAuth2ClientWebhookCallback{
-			ClientID:    strings.TrimSpace(row.ClientID),
-			CallbackURL: callbackURL,
-			Bearer:      bearer,
+			EndpointID:     row.ID,
+			ClientID:       strings.TrimSpace(row.ClientID),
+			CallbackURL:    callbackURL,
+			Bearer:         bearer,
+			SigningSecrets: row.SigningSecrets(),
 		})
 	}
 	return callbacks, nil
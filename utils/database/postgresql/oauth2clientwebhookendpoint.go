@@ -23,8 +23,22 @@ type OAuth2ClientWebhookEndpoint struct {
 	CallbackURL string `json:"callback_url,omitempty"`
 	// Bearer holds the value of the "bearer" field.
 	Bearer *string `json:"bearer,omitempty"`
+	// SigningSecret holds the value of the "signing_secret" field.
+	SigningSecret *string `json:"-"`
+	// PreviousSigningSecret holds the value of the "previous_signing_secret" field.
+	PreviousSigningSecret *string `json:"-"`
+	// PreviousSigningSecretExpiresAt holds the value of the "previous_signing_secret_expires_at" field.
+	PreviousSigningSecretExpiresAt *time.Time `json:"previous_signing_secret_expires_at,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
+	// OwnerEmail holds the value of the "owner_email" field.
+	OwnerEmail *string `json:"owner_email,omitempty"`
+	// ConsecutiveFailures holds the value of the "consecutive_failures" field.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// FailingSince holds the value of the "failing_since" field.
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+	// AutoDisabledAt holds the value of the "auto_disabled_at" field.
+	AutoDisabledAt *time.Time `json:"auto_disabled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -39,9 +53,11 @@ func (*OAuth2ClientWebhookEndpoint) scanValues(columns []string) ([]any, error)
 		switch columns[i] {
 		case oauth2clientwebhookendpoint.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case oauth2clientwebhookendpoint.FieldID, oauth2clientwebhookendpoint.FieldClientID, oauth2clientwebhookendpoint.FieldCallbackURL, oauth2clientwebhookendpoint.FieldBearer:
+		case oauth2clientwebhookendpoint.FieldConsecutiveFailures:
+			values[i] = new(sql.NullInt64)
+		case oauth2clientwebhookendpoint.FieldID, oauth2clientwebhookendpoint.FieldClientID, oauth2clientwebhookendpoint.FieldCallbackURL, oauth2clientwebhookendpoint.FieldBearer, oauth2clientwebhookendpoint.FieldSigningSecret, oauth2clientwebhookendpoint.FieldPreviousSigningSecret, oauth2clientwebhookendpoint.FieldOwnerEmail:
 			values[i] = new(sql.NullString)
-		case oauth2clientwebhookendpoint.FieldCreatedAt, oauth2clientwebhookendpoint.FieldUpdatedAt:
+		case oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt, oauth2clientwebhookendpoint.FieldFailingSince, oauth2clientwebhookendpoint.FieldAutoDisabledAt, oauth2clientwebhookendpoint.FieldCreatedAt, oauth2clientwebhookendpoint.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -83,12 +99,60 @@ func (_m *OAuth2ClientWebhookEndpoint) assignValues(columns []string, values []a
 				_m.Bearer = new(string)
 				*_m.Bearer = value.String
 			}
+		case oauth2clientwebhookendpoint.FieldSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_secret", values[i])
+			} else if value.Valid {
+				_m.SigningSecret = new(string)
+				*_m.SigningSecret = value.String
+			}
+		case oauth2clientwebhookendpoint.FieldPreviousSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_signing_secret", values[i])
+			} else if value.Valid {
+				_m.PreviousSigningSecret = new(string)
+				*_m.PreviousSigningSecret = value.String
+			}
+		case oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_signing_secret_expires_at", values[i])
+			} else if value.Valid {
+				_m.PreviousSigningSecretExpiresAt = new(time.Time)
+				*_m.PreviousSigningSecretExpiresAt = value.Time
+			}
 		case oauth2clientwebhookendpoint.FieldEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enabled", values[i])
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case oauth2clientwebhookendpoint.FieldOwnerEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_email", values[i])
+			} else if value.Valid {
+				_m.OwnerEmail = new(string)
+				*_m.OwnerEmail = value.String
+			}
+		case oauth2clientwebhookendpoint.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case oauth2clientwebhookendpoint.FieldFailingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failing_since", values[i])
+			} else if value.Valid {
+				_m.FailingSince = new(time.Time)
+				*_m.FailingSince = value.Time
+			}
+		case oauth2clientwebhookendpoint.FieldAutoDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_disabled_at", values[i])
+			} else if value.Valid {
+				_m.AutoDisabledAt = new(time.Time)
+				*_m.AutoDisabledAt = value.Time
+			}
 		case oauth2clientwebhookendpoint.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -148,9 +212,36 @@ func (_m *OAuth2ClientWebhookEndpoint) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("signing_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_signing_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.PreviousSigningSecretExpiresAt; v != nil {
+		builder.WriteString("previous_signing_secret_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteString(", ")
+	if v := _m.OwnerEmail; v != nil {
+		builder.WriteString("owner_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.FailingSince; v != nil {
+		builder.WriteString("failing_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AutoDisabledAt; v != nil {
+		builder.WriteString("auto_disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
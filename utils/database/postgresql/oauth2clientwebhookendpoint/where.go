@@ -79,11 +79,46 @@ func Bearer(v string) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldBearer, v))
 }
 
+// SigningSecret applies equality check predicate on the "signing_secret" field. It's identical to SigningSecretEQ.
+func SigningSecret(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldSigningSecret, v))
+}
+
+// PreviousSigningSecret applies equality check predicate on the "previous_signing_secret" field. It's identical to PreviousSigningSecretEQ.
+func PreviousSigningSecret(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretExpiresAt applies equality check predicate on the "previous_signing_secret_expires_at" field. It's identical to PreviousSigningSecretExpiresAtEQ.
+func PreviousSigningSecretExpiresAt(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldPreviousSigningSecretExpiresAt, v))
+}
+
 // Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
 func Enabled(v bool) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldEnabled, v))
 }
 
+// OwnerEmail applies equality check predicate on the "owner_email" field. It's identical to OwnerEmailEQ.
+func OwnerEmail(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldOwnerEmail, v))
+}
+
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// FailingSince applies equality check predicate on the "failing_since" field. It's identical to FailingSinceEQ.
+func FailingSince(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldFailingSince, v))
+}
+
+// AutoDisabledAt applies equality check predicate on the "auto_disabled_at" field. It's identical to AutoDisabledAtEQ.
+func AutoDisabledAt(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldAutoDisabledAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldCreatedAt, v))
@@ -299,6 +334,206 @@ func BearerContainsFold(v string) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContainsFold(FieldBearer, v))
 }
 
+// SigningSecretEQ applies the EQ predicate on the "signing_secret" field.
+func SigningSecretEQ(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldSigningSecret, v))
+}
+
+// SigningSecretNEQ applies the NEQ predicate on the "signing_secret" field.
+func SigningSecretNEQ(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldSigningSecret, v))
+}
+
+// SigningSecretIn applies the In predicate on the "signing_secret" field.
+func SigningSecretIn(vs ...string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldSigningSecret, vs...))
+}
+
+// SigningSecretNotIn applies the NotIn predicate on the "signing_secret" field.
+func SigningSecretNotIn(vs ...string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldSigningSecret, vs...))
+}
+
+// SigningSecretGT applies the GT predicate on the "signing_secret" field.
+func SigningSecretGT(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldSigningSecret, v))
+}
+
+// SigningSecretGTE applies the GTE predicate on the "signing_secret" field.
+func SigningSecretGTE(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldSigningSecret, v))
+}
+
+// SigningSecretLT applies the LT predicate on the "signing_secret" field.
+func SigningSecretLT(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldSigningSecret, v))
+}
+
+// SigningSecretLTE applies the LTE predicate on the "signing_secret" field.
+func SigningSecretLTE(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldSigningSecret, v))
+}
+
+// SigningSecretContains applies the Contains predicate on the "signing_secret" field.
+func SigningSecretContains(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContains(FieldSigningSecret, v))
+}
+
+// SigningSecretHasPrefix applies the HasPrefix predicate on the "signing_secret" field.
+func SigningSecretHasPrefix(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldHasPrefix(FieldSigningSecret, v))
+}
+
+// SigningSecretHasSuffix applies the HasSuffix predicate on the "signing_secret" field.
+func SigningSecretHasSuffix(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldHasSuffix(FieldSigningSecret, v))
+}
+
+// SigningSecretIsNil applies the IsNil predicate on the "signing_secret" field.
+func SigningSecretIsNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIsNull(FieldSigningSecret))
+}
+
+// SigningSecretNotNil applies the NotNil predicate on the "signing_secret" field.
+func SigningSecretNotNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotNull(FieldSigningSecret))
+}
+
+// SigningSecretEqualFold applies the EqualFold predicate on the "signing_secret" field.
+func SigningSecretEqualFold(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEqualFold(FieldSigningSecret, v))
+}
+
+// SigningSecretContainsFold applies the ContainsFold predicate on the "signing_secret" field.
+func SigningSecretContainsFold(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContainsFold(FieldSigningSecret, v))
+}
+
+// PreviousSigningSecretEQ applies the EQ predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretEQ(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretNEQ applies the NEQ predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretNEQ(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretIn applies the In predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretIn(vs ...string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldPreviousSigningSecret, vs...))
+}
+
+// PreviousSigningSecretNotIn applies the NotIn predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretNotIn(vs ...string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldPreviousSigningSecret, vs...))
+}
+
+// PreviousSigningSecretGT applies the GT predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretGT(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretGTE applies the GTE predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretGTE(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretLT applies the LT predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretLT(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretLTE applies the LTE predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretLTE(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretContains applies the Contains predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretContains(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContains(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretHasPrefix applies the HasPrefix predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretHasPrefix(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldHasPrefix(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretHasSuffix applies the HasSuffix predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretHasSuffix(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldHasSuffix(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretIsNil applies the IsNil predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretIsNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIsNull(FieldPreviousSigningSecret))
+}
+
+// PreviousSigningSecretNotNil applies the NotNil predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretNotNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotNull(FieldPreviousSigningSecret))
+}
+
+// PreviousSigningSecretEqualFold applies the EqualFold predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretEqualFold(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEqualFold(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretContainsFold applies the ContainsFold predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretContainsFold(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContainsFold(FieldPreviousSigningSecret, v))
+}
+
+// PreviousSigningSecretExpiresAtEQ applies the EQ predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldPreviousSigningSecretExpiresAt, v))
+}
+
+// PreviousSigningSecretExpiresAtNEQ applies the NEQ predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtNEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldPreviousSigningSecretExpiresAt, v))
+}
+
+// PreviousSigningSecretExpiresAtIn applies the In predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtIn(vs ...time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldPreviousSigningSecretExpiresAt, vs...))
+}
+
+// PreviousSigningSecretExpiresAtNotIn applies the NotIn predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtNotIn(vs ...time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldPreviousSigningSecretExpiresAt, vs...))
+}
+
+// PreviousSigningSecretExpiresAtGT applies the GT predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtGT(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldPreviousSigningSecretExpiresAt, v))
+}
+
+// PreviousSigningSecretExpiresAtGTE applies the GTE predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtGTE(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldPreviousSigningSecretExpiresAt, v))
+}
+
+// PreviousSigningSecretExpiresAtLT applies the LT predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtLT(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldPreviousSigningSecretExpiresAt, v))
+}
+
+// PreviousSigningSecretExpiresAtLTE applies the LTE predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtLTE(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldPreviousSigningSecretExpiresAt, v))
+}
+
+// PreviousSigningSecretExpiresAtIsNil applies the IsNil predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtIsNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIsNull(FieldPreviousSigningSecretExpiresAt))
+}
+
+// PreviousSigningSecretExpiresAtNotNil applies the NotNil predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtNotNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotNull(FieldPreviousSigningSecretExpiresAt))
+}
+
 // EnabledEQ applies the EQ predicate on the "enabled" field.
 func EnabledEQ(v bool) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldEnabled, v))
@@ -309,6 +544,221 @@ func EnabledNEQ(v bool) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// OwnerEmailEQ applies the EQ predicate on the "owner_email" field.
+func OwnerEmailEQ(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldOwnerEmail, v))
+}
+
+// OwnerEmailNEQ applies the NEQ predicate on the "owner_email" field.
+func OwnerEmailNEQ(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldOwnerEmail, v))
+}
+
+// OwnerEmailIn applies the In predicate on the "owner_email" field.
+func OwnerEmailIn(vs ...string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldOwnerEmail, vs...))
+}
+
+// OwnerEmailNotIn applies the NotIn predicate on the "owner_email" field.
+func OwnerEmailNotIn(vs ...string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldOwnerEmail, vs...))
+}
+
+// OwnerEmailGT applies the GT predicate on the "owner_email" field.
+func OwnerEmailGT(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldOwnerEmail, v))
+}
+
+// OwnerEmailGTE applies the GTE predicate on the "owner_email" field.
+func OwnerEmailGTE(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldOwnerEmail, v))
+}
+
+// OwnerEmailLT applies the LT predicate on the "owner_email" field.
+func OwnerEmailLT(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldOwnerEmail, v))
+}
+
+// OwnerEmailLTE applies the LTE predicate on the "owner_email" field.
+func OwnerEmailLTE(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldOwnerEmail, v))
+}
+
+// OwnerEmailContains applies the Contains predicate on the "owner_email" field.
+func OwnerEmailContains(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContains(FieldOwnerEmail, v))
+}
+
+// OwnerEmailHasPrefix applies the HasPrefix predicate on the "owner_email" field.
+func OwnerEmailHasPrefix(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldHasPrefix(FieldOwnerEmail, v))
+}
+
+// OwnerEmailHasSuffix applies the HasSuffix predicate on the "owner_email" field.
+func OwnerEmailHasSuffix(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldHasSuffix(FieldOwnerEmail, v))
+}
+
+// OwnerEmailIsNil applies the IsNil predicate on the "owner_email" field.
+func OwnerEmailIsNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIsNull(FieldOwnerEmail))
+}
+
+// OwnerEmailNotNil applies the NotNil predicate on the "owner_email" field.
+func OwnerEmailNotNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotNull(FieldOwnerEmail))
+}
+
+// OwnerEmailEqualFold applies the EqualFold predicate on the "owner_email" field.
+func OwnerEmailEqualFold(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEqualFold(FieldOwnerEmail, v))
+}
+
+// OwnerEmailContainsFold applies the ContainsFold predicate on the "owner_email" field.
+func OwnerEmailContainsFold(v string) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldContainsFold(FieldOwnerEmail, v))
+}
+
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// FailingSinceEQ applies the EQ predicate on the "failing_since" field.
+func FailingSinceEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldFailingSince, v))
+}
+
+// FailingSinceNEQ applies the NEQ predicate on the "failing_since" field.
+func FailingSinceNEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldFailingSince, v))
+}
+
+// FailingSinceIn applies the In predicate on the "failing_since" field.
+func FailingSinceIn(vs ...time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldFailingSince, vs...))
+}
+
+// FailingSinceNotIn applies the NotIn predicate on the "failing_since" field.
+func FailingSinceNotIn(vs ...time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldFailingSince, vs...))
+}
+
+// FailingSinceGT applies the GT predicate on the "failing_since" field.
+func FailingSinceGT(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldFailingSince, v))
+}
+
+// FailingSinceGTE applies the GTE predicate on the "failing_since" field.
+func FailingSinceGTE(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldFailingSince, v))
+}
+
+// FailingSinceLT applies the LT predicate on the "failing_since" field.
+func FailingSinceLT(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldFailingSince, v))
+}
+
+// FailingSinceLTE applies the LTE predicate on the "failing_since" field.
+func FailingSinceLTE(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldFailingSince, v))
+}
+
+// FailingSinceIsNil applies the IsNil predicate on the "failing_since" field.
+func FailingSinceIsNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIsNull(FieldFailingSince))
+}
+
+// FailingSinceNotNil applies the NotNil predicate on the "failing_since" field.
+func FailingSinceNotNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotNull(FieldFailingSince))
+}
+
+// AutoDisabledAtEQ applies the EQ predicate on the "auto_disabled_at" field.
+func AutoDisabledAtEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldAutoDisabledAt, v))
+}
+
+// AutoDisabledAtNEQ applies the NEQ predicate on the "auto_disabled_at" field.
+func AutoDisabledAtNEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNEQ(FieldAutoDisabledAt, v))
+}
+
+// AutoDisabledAtIn applies the In predicate on the "auto_disabled_at" field.
+func AutoDisabledAtIn(vs ...time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIn(FieldAutoDisabledAt, vs...))
+}
+
+// AutoDisabledAtNotIn applies the NotIn predicate on the "auto_disabled_at" field.
+func AutoDisabledAtNotIn(vs ...time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotIn(FieldAutoDisabledAt, vs...))
+}
+
+// AutoDisabledAtGT applies the GT predicate on the "auto_disabled_at" field.
+func AutoDisabledAtGT(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGT(FieldAutoDisabledAt, v))
+}
+
+// AutoDisabledAtGTE applies the GTE predicate on the "auto_disabled_at" field.
+func AutoDisabledAtGTE(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldGTE(FieldAutoDisabledAt, v))
+}
+
+// AutoDisabledAtLT applies the LT predicate on the "auto_disabled_at" field.
+func AutoDisabledAtLT(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLT(FieldAutoDisabledAt, v))
+}
+
+// AutoDisabledAtLTE applies the LTE predicate on the "auto_disabled_at" field.
+func AutoDisabledAtLTE(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldLTE(FieldAutoDisabledAt, v))
+}
+
+// AutoDisabledAtIsNil applies the IsNil predicate on the "auto_disabled_at" field.
+func AutoDisabledAtIsNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldIsNull(FieldAutoDisabledAt))
+}
+
+// AutoDisabledAtNotNil applies the NotNil predicate on the "auto_disabled_at" field.
+func AutoDisabledAtNotNil() predicate.OAuth2ClientWebhookEndpoint {
+	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldNotNull(FieldAutoDisabledAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OAuth2ClientWebhookEndpoint {
 	return predicate.OAuth2ClientWebhookEndpoint(sql.FieldEQ(FieldCreatedAt, v))
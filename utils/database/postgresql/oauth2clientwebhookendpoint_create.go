@@ -46,6 +46,48 @@ func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableBearer(v *string) *OAuth
 	return _c
 }
 
+// SetSigningSecret sets the "signing_secret" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetSigningSecret(v string) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetSigningSecret(v)
+	return _c
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableSigningSecret(v *string) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetSigningSecret(*v)
+	}
+	return _c
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetPreviousSigningSecret(v string) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetPreviousSigningSecret(v)
+	return _c
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillablePreviousSigningSecret(v *string) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetPreviousSigningSecret(*v)
+	}
+	return _c
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetPreviousSigningSecretExpiresAt(v time.Time) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetPreviousSigningSecretExpiresAt(v)
+	return _c
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillablePreviousSigningSecretExpiresAt(v *time.Time) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetPreviousSigningSecretExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetEnabled sets the "enabled" field.
 func (_c *OAuth2ClientWebhookEndpointCreate) SetEnabled(v bool) *OAuth2ClientWebhookEndpointCreate {
 	_c.mutation.SetEnabled(v)
@@ -60,6 +102,62 @@ func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableEnabled(v *bool) *OAuth2
 	return _c
 }
 
+// SetOwnerEmail sets the "owner_email" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetOwnerEmail(v string) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetOwnerEmail(v)
+	return _c
+}
+
+// SetNillableOwnerEmail sets the "owner_email" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableOwnerEmail(v *string) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetOwnerEmail(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetConsecutiveFailures(v int) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableConsecutiveFailures(v *int) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetFailingSince(v time.Time) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetFailingSince(v)
+	return _c
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableFailingSince(v *time.Time) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetFailingSince(*v)
+	}
+	return _c
+}
+
+// SetAutoDisabledAt sets the "auto_disabled_at" field.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetAutoDisabledAt(v time.Time) *OAuth2ClientWebhookEndpointCreate {
+	_c.mutation.SetAutoDisabledAt(v)
+	return _c
+}
+
+// SetNillableAutoDisabledAt sets the "auto_disabled_at" field if the given value is not nil.
+func (_c *OAuth2ClientWebhookEndpointCreate) SetNillableAutoDisabledAt(v *time.Time) *OAuth2ClientWebhookEndpointCreate {
+	if v != nil {
+		_c.SetAutoDisabledAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *OAuth2ClientWebhookEndpointCreate) SetCreatedAt(v time.Time) *OAuth2ClientWebhookEndpointCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -133,6 +231,10 @@ func (_c *OAuth2ClientWebhookEndpointCreate) defaults() {
 		v := oauth2clientwebhookendpoint.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := oauth2clientwebhookendpoint.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := oauth2clientwebhookendpoint.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -164,6 +266,14 @@ func (_c *OAuth2ClientWebhookEndpointCreate) check() error {
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`postgresql: missing required field "OAuth2ClientWebhookEndpoint.enabled"`)}
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`postgresql: missing required field "OAuth2ClientWebhookEndpoint.consecutive_failures"`)}
+	}
+	if v, ok := _c.mutation.ConsecutiveFailures(); ok {
+		if err := oauth2clientwebhookendpoint.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`postgresql: validator failed for field "OAuth2ClientWebhookEndpoint.consecutive_failures": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "OAuth2ClientWebhookEndpoint.created_at"`)}
 	}
@@ -222,10 +332,38 @@ func (_c *OAuth2ClientWebhookEndpointCreate) createSpec() (*OAuth2ClientWebhookE
 		_spec.SetField(oauth2clientwebhookendpoint.FieldBearer, field.TypeString, value)
 		_node.Bearer = &value
 	}
+	if value, ok := _c.mutation.SigningSecret(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldSigningSecret, field.TypeString, value)
+		_node.SigningSecret = &value
+	}
+	if value, ok := _c.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+		_node.PreviousSigningSecret = &value
+	}
+	if value, ok := _c.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+		_node.PreviousSigningSecretExpiresAt = &value
+	}
 	if value, ok := _c.mutation.Enabled(); ok {
 		_spec.SetField(oauth2clientwebhookendpoint.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.OwnerEmail(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldOwnerEmail, field.TypeString, value)
+		_node.OwnerEmail = &value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.FailingSince(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldFailingSince, field.TypeTime, value)
+		_node.FailingSince = &value
+	}
+	if value, ok := _c.mutation.AutoDisabledAt(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldAutoDisabledAt, field.TypeTime, value)
+		_node.AutoDisabledAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(oauth2clientwebhookendpoint.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -76,6 +76,66 @@ func (_u *OAuth2ClientWebhookEndpointUpdate) ClearBearer() *OAuth2ClientWebhookE
 	return _u
 }
 
+// SetSigningSecret sets the "signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetSigningSecret(v string) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.SetSigningSecret(v)
+	return _u
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillableSigningSecret(v *string) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) ClearSigningSecret() *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ClearSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetPreviousSigningSecret(v string) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.SetPreviousSigningSecret(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillablePreviousSigningSecret(v *string) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetPreviousSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) ClearPreviousSigningSecret() *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ClearPreviousSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetPreviousSigningSecretExpiresAt(v time.Time) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.SetPreviousSigningSecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillablePreviousSigningSecretExpiresAt(v *time.Time) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetPreviousSigningSecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) ClearPreviousSigningSecretExpiresAt() *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ClearPreviousSigningSecretExpiresAt()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *OAuth2ClientWebhookEndpointUpdate) SetEnabled(v bool) *OAuth2ClientWebhookEndpointUpdate {
 	_u.mutation.SetEnabled(v)
@@ -90,6 +150,87 @@ func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillableEnabled(v *bool) *OAuth2
 	return _u
 }
 
+// SetOwnerEmail sets the "owner_email" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetOwnerEmail(v string) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.SetOwnerEmail(v)
+	return _u
+}
+
+// SetNillableOwnerEmail sets the "owner_email" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillableOwnerEmail(v *string) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetOwnerEmail(*v)
+	}
+	return _u
+}
+
+// ClearOwnerEmail clears the value of the "owner_email" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) ClearOwnerEmail() *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ClearOwnerEmail()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetConsecutiveFailures(v int) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillableConsecutiveFailures(v *int) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) AddConsecutiveFailures(v int) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetFailingSince(v time.Time) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.SetFailingSince(v)
+	return _u
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillableFailingSince(v *time.Time) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetFailingSince(*v)
+	}
+	return _u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) ClearFailingSince() *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ClearFailingSince()
+	return _u
+}
+
+// SetAutoDisabledAt sets the "auto_disabled_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetAutoDisabledAt(v time.Time) *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.SetAutoDisabledAt(v)
+	return _u
+}
+
+// SetNillableAutoDisabledAt sets the "auto_disabled_at" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdate) SetNillableAutoDisabledAt(v *time.Time) *OAuth2ClientWebhookEndpointUpdate {
+	if v != nil {
+		_u.SetAutoDisabledAt(*v)
+	}
+	return _u
+}
+
+// ClearAutoDisabledAt clears the value of the "auto_disabled_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdate) ClearAutoDisabledAt() *OAuth2ClientWebhookEndpointUpdate {
+	_u.mutation.ClearAutoDisabledAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *OAuth2ClientWebhookEndpointUpdate) SetCreatedAt(v time.Time) *OAuth2ClientWebhookEndpointUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -163,6 +304,11 @@ func (_u *OAuth2ClientWebhookEndpointUpdate) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`postgresql: validator failed for field "OAuth2ClientWebhookEndpoint.callback_url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := oauth2clientwebhookendpoint.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`postgresql: validator failed for field "OAuth2ClientWebhookEndpoint.consecutive_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -190,9 +336,51 @@ func (_u *OAuth2ClientWebhookEndpointUpdate) sqlSave(ctx context.Context) (_node
 	if _u.mutation.BearerCleared() {
 		_spec.ClearField(oauth2clientwebhookendpoint.FieldBearer, field.TypeString)
 	}
+	if value, ok := _u.mutation.SigningSecret(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.SigningSecretCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.PreviousSigningSecretCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldPreviousSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(oauth2clientwebhookendpoint.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.OwnerEmail(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldOwnerEmail, field.TypeString, value)
+	}
+	if _u.mutation.OwnerEmailCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldOwnerEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(oauth2clientwebhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailingSince(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldFailingSince, field.TypeTime, value)
+	}
+	if _u.mutation.FailingSinceCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoDisabledAt(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldAutoDisabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.AutoDisabledAtCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldAutoDisabledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(oauth2clientwebhookendpoint.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -267,6 +455,66 @@ func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearBearer() *OAuth2ClientWebho
 	return _u
 }
 
+// SetSigningSecret sets the "signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetSigningSecret(v string) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.SetSigningSecret(v)
+	return _u
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillableSigningSecret(v *string) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearSigningSecret() *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ClearSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetPreviousSigningSecret(v string) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.SetPreviousSigningSecret(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillablePreviousSigningSecret(v *string) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetPreviousSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearPreviousSigningSecret() *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ClearPreviousSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetPreviousSigningSecretExpiresAt(v time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.SetPreviousSigningSecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillablePreviousSigningSecretExpiresAt(v *time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetPreviousSigningSecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearPreviousSigningSecretExpiresAt() *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ClearPreviousSigningSecretExpiresAt()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetEnabled(v bool) *OAuth2ClientWebhookEndpointUpdateOne {
 	_u.mutation.SetEnabled(v)
@@ -281,6 +529,87 @@ func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillableEnabled(v *bool) *OAu
 	return _u
 }
 
+// SetOwnerEmail sets the "owner_email" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetOwnerEmail(v string) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.SetOwnerEmail(v)
+	return _u
+}
+
+// SetNillableOwnerEmail sets the "owner_email" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillableOwnerEmail(v *string) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetOwnerEmail(*v)
+	}
+	return _u
+}
+
+// ClearOwnerEmail clears the value of the "owner_email" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearOwnerEmail() *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ClearOwnerEmail()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetConsecutiveFailures(v int) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillableConsecutiveFailures(v *int) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) AddConsecutiveFailures(v int) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetFailingSince(v time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.SetFailingSince(v)
+	return _u
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillableFailingSince(v *time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetFailingSince(*v)
+	}
+	return _u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearFailingSince() *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ClearFailingSince()
+	return _u
+}
+
+// SetAutoDisabledAt sets the "auto_disabled_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetAutoDisabledAt(v time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.SetAutoDisabledAt(v)
+	return _u
+}
+
+// SetNillableAutoDisabledAt sets the "auto_disabled_at" field if the given value is not nil.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetNillableAutoDisabledAt(v *time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetAutoDisabledAt(*v)
+	}
+	return _u
+}
+
+// ClearAutoDisabledAt clears the value of the "auto_disabled_at" field.
+func (_u *OAuth2ClientWebhookEndpointUpdateOne) ClearAutoDisabledAt() *OAuth2ClientWebhookEndpointUpdateOne {
+	_u.mutation.ClearAutoDisabledAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *OAuth2ClientWebhookEndpointUpdateOne) SetCreatedAt(v time.Time) *OAuth2ClientWebhookEndpointUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -367,6 +696,11 @@ func (_u *OAuth2ClientWebhookEndpointUpdateOne) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`postgresql: validator failed for field "OAuth2ClientWebhookEndpoint.callback_url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := oauth2clientwebhookendpoint.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`postgresql: validator failed for field "OAuth2ClientWebhookEndpoint.consecutive_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -411,9 +745,51 @@ func (_u *OAuth2ClientWebhookEndpointUpdateOne) sqlSave(ctx context.Context) (_n
 	if _u.mutation.BearerCleared() {
 		_spec.ClearField(oauth2clientwebhookendpoint.FieldBearer, field.TypeString)
 	}
+	if value, ok := _u.mutation.SigningSecret(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.SigningSecretCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.PreviousSigningSecretCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldPreviousSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(oauth2clientwebhookendpoint.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.OwnerEmail(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldOwnerEmail, field.TypeString, value)
+	}
+	if _u.mutation.OwnerEmailCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldOwnerEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(oauth2clientwebhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailingSince(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldFailingSince, field.TypeTime, value)
+	}
+	if _u.mutation.FailingSinceCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoDisabledAt(); ok {
+		_spec.SetField(oauth2clientwebhookendpoint.FieldAutoDisabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.AutoDisabledAtCleared() {
+		_spec.ClearField(oauth2clientwebhookendpoint.FieldAutoDisabledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(oauth2clientwebhookendpoint.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -6,9 +6,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccountDeletion is the predicate function for accountdeletion builders.
+type AccountDeletion func(*sql.Selector)
+
 // AuthorizeSocialPlatformInfo is the predicate function for authorizesocialplatforminfo builders.
 type AuthorizeSocialPlatformInfo func(*sql.Selector)
 
+// DataExport is the predicate function for dataexport builders.
+type DataExport func(*sql.Selector)
+
+// DataProviderOutbox is the predicate function for dataprovideroutbox builders.
+type DataProviderOutbox func(*sql.Selector)
+
+// EmailMessage is the predicate function for emailmessage builders.
+type EmailMessage func(*sql.Selector)
+
+// EmailSuppression is the predicate function for emailsuppression builders.
+type EmailSuppression func(*sql.Selector)
+
 // FriendLink is the predicate function for friendlink builders.
 type FriendLink func(*sql.Selector)
 
@@ -27,6 +42,9 @@ type GroupList func(*sql.Selector)
 // IOSScriptCode is the predicate function for iosscriptcode builders.
 type IOSScriptCode func(*sql.Selector)
 
+// InheritJob is the predicate function for inheritjob builders.
+type InheritJob func(*sql.Selector)
+
 // OAuth2ClientWebhookEndpoint is the predicate function for oauth2clientwebhookendpoint builders.
 type OAuth2ClientWebhookEndpoint func(*sql.Selector)
 
@@ -48,6 +66,9 @@ type SystemLog func(*sql.Selector)
 // Ticket is the predicate function for ticket builders.
 type Ticket func(*sql.Selector)
 
+// TicketAttachment is the predicate function for ticketattachment builders.
+type TicketAttachment func(*sql.Selector)
+
 // TicketMessage is the predicate function for ticketmessage builders.
 type TicketMessage func(*sql.Selector)
 
@@ -57,6 +78,21 @@ type UploadLog func(*sql.Selector)
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserRecoveryCode is the predicate function for userrecoverycode builders.
+type UserRecoveryCode func(*sql.Selector)
+
+// UserTOTP is the predicate function for usertotp builders.
+type UserTOTP func(*sql.Selector)
+
+// WebAuthnCredential is the predicate function for webauthncredential builders.
+type WebAuthnCredential func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookDeliveryAttempt is the predicate function for webhookdeliveryattempt builders.
+type WebhookDeliveryAttempt func(*sql.Selector)
+
 // WebhookEndpoint is the predicate function for webhookendpoint builders.
 type WebhookEndpoint func(*sql.Selector)
 
@@ -0,0 +1,86 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+)
+
+// TemporarilyBanUser bans a regular, currently unbanned user until expiresAt.
+// It reports false when nothing changed, so an existing ban is never shortened
+// and staff accounts are never touched.
+func (c *Client) TemporarilyBanUser(ctx context.Context, userID, reason string, expiresAt time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.User.Update().
+		Where(user.IDEQ(userID), user.RoleEQ(user.RoleUser), user.BannedEQ(false)).
+		SetBanned(true).
+		SetBanReason(reason).
+		SetBanExpiresAt(expiresAt.UTC()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// LiftExpiredTemporaryBans unbans users whose temporary ban has run out.
+// Permanent bans have no expiry and are left alone.
+func (c *Client) LiftExpiredTemporaryBans(ctx context.Context, now time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.User.Update().
+		Where(user.BannedEQ(true), user.BanExpiresAtNotNil(), user.BanExpiresAtLTE(now.UTC())).
+		SetBanned(false).
+		ClearBanReason().
+		ClearBanExpiresAt().
+		Save(ctx)
+}
+
+// BlockUserUploads blocks uploads by or for a regular user until the given
+// time. An existing block that ends later is kept.
+func (c *Client) BlockUserUploads(ctx context.Context, userID string, until time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	until = until.UTC()
+	affected, err := c.User.Update().
+		Where(
+			user.IDEQ(userID),
+			user.RoleEQ(user.RoleUser),
+			user.Or(user.UploadBlockedUntilIsNil(), user.UploadBlockedUntilLT(until)),
+		).
+		SetUploadBlockedUntil(until).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// IsUploadBlocked reports whether the uploading user or the owner of the
+// target game account currently has uploads blocked.
+func (c *Client) IsUploadBlocked(ctx context.Context, server, gameUserID, userID string, now time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	subjects := []predicate.User{
+		user.HasGameAccountBindingsWith(
+			gameaccountbinding.ServerEQ(server),
+			gameaccountbinding.GameUserIDEQ(gameUserID),
+		),
+	}
+	if userID = strings.TrimSpace(userID); userID != "" {
+		subjects = append(subjects, user.IDEQ(userID))
+	}
+	return c.User.Query().
+		Where(user.UploadBlockedUntilGT(now.UTC()), user.Or(subjects...)).
+		Exist(ctx)
+}
@@ -0,0 +1,127 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestTemporaryBanLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:risk-action-temp-ban-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	createGrantTestUser(t, client, "regular", false)
+	createGrantTestUser(t, client, "permanent", true)
+	if _, err := client.User.Create().SetID("staff").SetName("staff").SetEmail("staff@example.com").SetRole(user.RoleAdmin).Save(ctx); err != nil {
+		t.Fatalf("create staff user: %v", err)
+	}
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		id   string
+		want bool
+	}{
+		{id: "regular", want: true},
+		{id: "regular", want: false},
+		{id: "permanent", want: false},
+		{id: "staff", want: false},
+	} {
+		banned, err := client.TemporarilyBanUser(ctx, tc.id, "rule", now.Add(time.Hour))
+		if err != nil {
+			t.Fatalf("TemporarilyBanUser(%s) returned error: %v", tc.id, err)
+		}
+		if banned != tc.want {
+			t.Fatalf("TemporarilyBanUser(%s) = %v, want %v", tc.id, banned, tc.want)
+		}
+	}
+
+	lifted, err := client.LiftExpiredTemporaryBans(ctx, now.Add(30*time.Minute))
+	if err != nil || lifted != 0 {
+		t.Fatalf("LiftExpiredTemporaryBans before expiry = %d, %v", lifted, err)
+	}
+	lifted, err = client.LiftExpiredTemporaryBans(ctx, now.Add(time.Hour))
+	if err != nil || lifted != 1 {
+		t.Fatalf("LiftExpiredTemporaryBans at expiry = %d, %v", lifted, err)
+	}
+	regular, err := client.User.Get(ctx, "regular")
+	if err != nil {
+		t.Fatalf("get regular user: %v", err)
+	}
+	if regular.Banned || regular.BanReason != nil || regular.BanExpiresAt != nil {
+		t.Fatalf("regular user after lift = banned %v, reason %v, expires %v", regular.Banned, regular.BanReason, regular.BanExpiresAt)
+	}
+	permanent, err := client.User.Get(ctx, "permanent")
+	if err != nil {
+		t.Fatalf("get permanent user: %v", err)
+	}
+	if !permanent.Banned {
+		t.Fatalf("permanent ban was lifted")
+	}
+}
+
+func TestUploadBlockMatchesUploaderAndBindingOwner(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:risk-action-upload-block-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	createGrantTestUser(t, client, "owner", false)
+	createGrantTestUser(t, client, "other", false)
+	if err := client.GameAccountBinding.Create().
+		SetServer("jp").
+		SetGameUserID("1001").
+		SetVerified(true).
+		SetUserID("owner").
+		Exec(ctx); err != nil {
+		t.Fatalf("create binding: %v", err)
+	}
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	blocked, err := client.IsUploadBlocked(ctx, "jp", "1001", "", now)
+	if err != nil || blocked {
+		t.Fatalf("IsUploadBlocked before block = %v, %v", blocked, err)
+	}
+
+	applied, err := client.BlockUserUploads(ctx, "owner", now.Add(2*time.Hour))
+	if err != nil || !applied {
+		t.Fatalf("BlockUserUploads = %v, %v", applied, err)
+	}
+	applied, err = client.BlockUserUploads(ctx, "owner", now.Add(time.Hour))
+	if err != nil || applied {
+		t.Fatalf("BlockUserUploads with shorter block = %v, %v, want unchanged", applied, err)
+	}
+
+	for _, tc := range []struct {
+		name       string
+		gameUserID string
+		userID     string
+		at         time.Time
+		want       bool
+	}{
+		{name: "owner account anonymous upload", gameUserID: "1001", at: now, want: true},
+		{name: "owner uploading elsewhere", gameUserID: "2002", userID: "owner", at: now, want: true},
+		{name: "other user unbound account", gameUserID: "2002", userID: "other", at: now, want: false},
+		{name: "after expiry", gameUserID: "1001", at: now.Add(2 * time.Hour), want: false},
+	} {
+		blocked, err := client.IsUploadBlocked(ctx, "jp", tc.gameUserID, tc.userID, tc.at)
+		if err != nil {
+			t.Fatalf("%s: IsUploadBlocked returned error: %v", tc.name, err)
+		}
+		if blocked != tc.want {
+			t.Fatalf("%s: IsUploadBlocked = %v, want %v", tc.name, blocked, tc.want)
+		}
+	}
+}
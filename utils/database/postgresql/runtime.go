@@ -6,12 +6,18 @@ import (
 	"time"
 
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/toolbox/schema"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/accountdeletion"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/authorizesocialplatforminfo"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataexport"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailmessage"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/emailsuppression"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/friendlink"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountdatagrant"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/group"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/grouplist"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/inheritjob"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/iosscriptcode"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/riskevent"
@@ -20,9 +26,15 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/sponsor"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/systemlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/uploadlog"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/userrecoverycode"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/usertotp"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webauthncredential"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhooksubscription"
 )
@@ -31,12 +43,324 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accountdeletionFields := schema.AccountDeletion{}.Fields()
+	_ = accountdeletionFields
+	// accountdeletionDescUserID is the schema descriptor for user_id field.
+	accountdeletionDescUserID := accountdeletionFields[1].Descriptor()
+	// accountdeletion.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	accountdeletion.UserIDValidator = func() func(string) error {
+		validators := accountdeletionDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountdeletionDescLocale is the schema descriptor for locale field.
+	accountdeletionDescLocale := accountdeletionFields[3].Descriptor()
+	// accountdeletion.DefaultLocale holds the default value on creation for the locale field.
+	accountdeletion.DefaultLocale = accountdeletionDescLocale.Default.(string)
+	// accountdeletion.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	accountdeletion.LocaleValidator = accountdeletionDescLocale.Validators[0].(func(string) error)
+	// accountdeletionDescAttempts is the schema descriptor for attempts field.
+	accountdeletionDescAttempts := accountdeletionFields[5].Descriptor()
+	// accountdeletion.DefaultAttempts holds the default value on creation for the attempts field.
+	accountdeletion.DefaultAttempts = accountdeletionDescAttempts.Default.(int)
+	// accountdeletion.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	accountdeletion.AttemptsValidator = accountdeletionDescAttempts.Validators[0].(func(int) error)
+	// accountdeletionDescMaxAttempts is the schema descriptor for max_attempts field.
+	accountdeletionDescMaxAttempts := accountdeletionFields[6].Descriptor()
+	// accountdeletion.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	accountdeletion.MaxAttemptsValidator = accountdeletionDescMaxAttempts.Validators[0].(func(int) error)
+	// accountdeletionDescLastError is the schema descriptor for last_error field.
+	accountdeletionDescLastError := accountdeletionFields[8].Descriptor()
+	// accountdeletion.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	accountdeletion.LastErrorValidator = accountdeletionDescLastError.Validators[0].(func(string) error)
+	// accountdeletionDescCreatedAt is the schema descriptor for created_at field.
+	accountdeletionDescCreatedAt := accountdeletionFields[12].Descriptor()
+	// accountdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountdeletion.DefaultCreatedAt = accountdeletionDescCreatedAt.Default.(func() time.Time)
+	// accountdeletionDescUpdatedAt is the schema descriptor for updated_at field.
+	accountdeletionDescUpdatedAt := accountdeletionFields[13].Descriptor()
+	// accountdeletion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accountdeletion.DefaultUpdatedAt = accountdeletionDescUpdatedAt.Default.(func() time.Time)
+	// accountdeletion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accountdeletion.UpdateDefaultUpdatedAt = accountdeletionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accountdeletionDescID is the schema descriptor for id field.
+	accountdeletionDescID := accountdeletionFields[0].Descriptor()
+	// accountdeletion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	accountdeletion.IDValidator = accountdeletionDescID.Validators[0].(func(string) error)
 	authorizesocialplatforminfoFields := schema.AuthorizeSocialPlatformInfo{}.Fields()
 	_ = authorizesocialplatforminfoFields
 	// authorizesocialplatforminfoDescAllowFastVerification is the schema descriptor for allow_fast_verification field.
 	authorizesocialplatforminfoDescAllowFastVerification := authorizesocialplatforminfoFields[5].Descriptor()
 	// authorizesocialplatforminfo.DefaultAllowFastVerification holds the default value on creation for the allow_fast_verification field.
 	authorizesocialplatforminfo.DefaultAllowFastVerification = authorizesocialplatforminfoDescAllowFastVerification.Default.(bool)
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescUserID is the schema descriptor for user_id field.
+	dataexportDescUserID := dataexportFields[1].Descriptor()
+	// dataexport.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	dataexport.UserIDValidator = func() func(string) error {
+		validators := dataexportDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// dataexportDescLocale is the schema descriptor for locale field.
+	dataexportDescLocale := dataexportFields[3].Descriptor()
+	// dataexport.DefaultLocale holds the default value on creation for the locale field.
+	dataexport.DefaultLocale = dataexportDescLocale.Default.(string)
+	// dataexport.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	dataexport.LocaleValidator = dataexportDescLocale.Validators[0].(func(string) error)
+	// dataexportDescAttempts is the schema descriptor for attempts field.
+	dataexportDescAttempts := dataexportFields[4].Descriptor()
+	// dataexport.DefaultAttempts holds the default value on creation for the attempts field.
+	dataexport.DefaultAttempts = dataexportDescAttempts.Default.(int)
+	// dataexport.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	dataexport.AttemptsValidator = dataexportDescAttempts.Validators[0].(func(int) error)
+	// dataexportDescMaxAttempts is the schema descriptor for max_attempts field.
+	dataexportDescMaxAttempts := dataexportFields[5].Descriptor()
+	// dataexport.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	dataexport.MaxAttemptsValidator = dataexportDescMaxAttempts.Validators[0].(func(int) error)
+	// dataexportDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	dataexportDescNextAttemptAt := dataexportFields[6].Descriptor()
+	// dataexport.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	dataexport.DefaultNextAttemptAt = dataexportDescNextAttemptAt.Default.(func() time.Time)
+	// dataexportDescStorageKey is the schema descriptor for storage_key field.
+	dataexportDescStorageKey := dataexportFields[7].Descriptor()
+	// dataexport.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	dataexport.StorageKeyValidator = dataexportDescStorageKey.Validators[0].(func(string) error)
+	// dataexportDescSha256 is the schema descriptor for sha256 field.
+	dataexportDescSha256 := dataexportFields[9].Descriptor()
+	// dataexport.Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	dataexport.Sha256Validator = dataexportDescSha256.Validators[0].(func(string) error)
+	// dataexportDescDownloadTokenHash is the schema descriptor for download_token_hash field.
+	dataexportDescDownloadTokenHash := dataexportFields[10].Descriptor()
+	// dataexport.DownloadTokenHashValidator is a validator for the "download_token_hash" field. It is called by the builders before save.
+	dataexport.DownloadTokenHashValidator = dataexportDescDownloadTokenHash.Validators[0].(func(string) error)
+	// dataexportDescDownloadCount is the schema descriptor for download_count field.
+	dataexportDescDownloadCount := dataexportFields[11].Descriptor()
+	// dataexport.DefaultDownloadCount holds the default value on creation for the download_count field.
+	dataexport.DefaultDownloadCount = dataexportDescDownloadCount.Default.(int)
+	// dataexport.DownloadCountValidator is a validator for the "download_count" field. It is called by the builders before save.
+	dataexport.DownloadCountValidator = dataexportDescDownloadCount.Validators[0].(func(int) error)
+	// dataexportDescLastError is the schema descriptor for last_error field.
+	dataexportDescLastError := dataexportFields[12].Descriptor()
+	// dataexport.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	dataexport.LastErrorValidator = dataexportDescLastError.Validators[0].(func(string) error)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[16].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	// dataexportDescUpdatedAt is the schema descriptor for updated_at field.
+	dataexportDescUpdatedAt := dataexportFields[17].Descriptor()
+	// dataexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dataexport.DefaultUpdatedAt = dataexportDescUpdatedAt.Default.(func() time.Time)
+	// dataexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dataexport.UpdateDefaultUpdatedAt = dataexportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dataexportDescID is the schema descriptor for id field.
+	dataexportDescID := dataexportFields[0].Descriptor()
+	// dataexport.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	dataexport.IDValidator = dataexportDescID.Validators[0].(func(string) error)
+	dataprovideroutboxFields := schema.DataProviderOutbox{}.Fields()
+	_ = dataprovideroutboxFields
+	// dataprovideroutboxDescProvider is the schema descriptor for provider field.
+	dataprovideroutboxDescProvider := dataprovideroutboxFields[0].Descriptor()
+	// dataprovideroutbox.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	dataprovideroutbox.ProviderValidator = func() func(string) error {
+		validators := dataprovideroutboxDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// dataprovideroutboxDescServer is the schema descriptor for server field.
+	dataprovideroutboxDescServer := dataprovideroutboxFields[1].Descriptor()
+	// dataprovideroutbox.ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	dataprovideroutbox.ServerValidator = dataprovideroutboxDescServer.Validators[0].(func(string) error)
+	// dataprovideroutboxDescGameUserID is the schema descriptor for game_user_id field.
+	dataprovideroutboxDescGameUserID := dataprovideroutboxFields[2].Descriptor()
+	// dataprovideroutbox.GameUserIDValidator is a validator for the "game_user_id" field. It is called by the builders before save.
+	dataprovideroutbox.GameUserIDValidator = dataprovideroutboxDescGameUserID.Validators[0].(func(string) error)
+	// dataprovideroutboxDescDataType is the schema descriptor for data_type field.
+	dataprovideroutboxDescDataType := dataprovideroutboxFields[3].Descriptor()
+	// dataprovideroutbox.DataTypeValidator is a validator for the "data_type" field. It is called by the builders before save.
+	dataprovideroutbox.DataTypeValidator = dataprovideroutboxDescDataType.Validators[0].(func(string) error)
+	// dataprovideroutboxDescGeneration is the schema descriptor for generation field.
+	dataprovideroutboxDescGeneration := dataprovideroutboxFields[5].Descriptor()
+	// dataprovideroutbox.DefaultGeneration holds the default value on creation for the generation field.
+	dataprovideroutbox.DefaultGeneration = dataprovideroutboxDescGeneration.Default.(int)
+	// dataprovideroutbox.GenerationValidator is a validator for the "generation" field. It is called by the builders before save.
+	dataprovideroutbox.GenerationValidator = dataprovideroutboxDescGeneration.Validators[0].(func(int) error)
+	// dataprovideroutboxDescAttempts is the schema descriptor for attempts field.
+	dataprovideroutboxDescAttempts := dataprovideroutboxFields[8].Descriptor()
+	// dataprovideroutbox.DefaultAttempts holds the default value on creation for the attempts field.
+	dataprovideroutbox.DefaultAttempts = dataprovideroutboxDescAttempts.Default.(int)
+	// dataprovideroutbox.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	dataprovideroutbox.AttemptsValidator = dataprovideroutboxDescAttempts.Validators[0].(func(int) error)
+	// dataprovideroutboxDescMaxAttempts is the schema descriptor for max_attempts field.
+	dataprovideroutboxDescMaxAttempts := dataprovideroutboxFields[9].Descriptor()
+	// dataprovideroutbox.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	dataprovideroutbox.MaxAttemptsValidator = dataprovideroutboxDescMaxAttempts.Validators[0].(func(int) error)
+	// dataprovideroutboxDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	dataprovideroutboxDescNextAttemptAt := dataprovideroutboxFields[10].Descriptor()
+	// dataprovideroutbox.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	dataprovideroutbox.DefaultNextAttemptAt = dataprovideroutboxDescNextAttemptAt.Default.(func() time.Time)
+	// dataprovideroutboxDescLastError is the schema descriptor for last_error field.
+	dataprovideroutboxDescLastError := dataprovideroutboxFields[12].Descriptor()
+	// dataprovideroutbox.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	dataprovideroutbox.LastErrorValidator = dataprovideroutboxDescLastError.Validators[0].(func(string) error)
+	// dataprovideroutboxDescCreatedAt is the schema descriptor for created_at field.
+	dataprovideroutboxDescCreatedAt := dataprovideroutboxFields[14].Descriptor()
+	// dataprovideroutbox.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataprovideroutbox.DefaultCreatedAt = dataprovideroutboxDescCreatedAt.Default.(func() time.Time)
+	// dataprovideroutboxDescUpdatedAt is the schema descriptor for updated_at field.
+	dataprovideroutboxDescUpdatedAt := dataprovideroutboxFields[15].Descriptor()
+	// dataprovideroutbox.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dataprovideroutbox.DefaultUpdatedAt = dataprovideroutboxDescUpdatedAt.Default.(func() time.Time)
+	// dataprovideroutbox.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dataprovideroutbox.UpdateDefaultUpdatedAt = dataprovideroutboxDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailmessageFields := schema.EmailMessage{}.Fields()
+	_ = emailmessageFields
+	// emailmessageDescRecipient is the schema descriptor for recipient field.
+	emailmessageDescRecipient := emailmessageFields[1].Descriptor()
+	// emailmessage.RecipientValidator is a validator for the "recipient" field. It is called by the builders before save.
+	emailmessage.RecipientValidator = func() func(string) error {
+		validators := emailmessageDescRecipient.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(recipient string) error {
+			for _, fn := range fns {
+				if err := fn(recipient); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emailmessageDescSubject is the schema descriptor for subject field.
+	emailmessageDescSubject := emailmessageFields[2].Descriptor()
+	// emailmessage.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	emailmessage.SubjectValidator = emailmessageDescSubject.Validators[0].(func(string) error)
+	// emailmessageDescDisplayName is the schema descriptor for display_name field.
+	emailmessageDescDisplayName := emailmessageFields[4].Descriptor()
+	// emailmessage.DefaultDisplayName holds the default value on creation for the display_name field.
+	emailmessage.DefaultDisplayName = emailmessageDescDisplayName.Default.(string)
+	// emailmessageDescTemplate is the schema descriptor for template field.
+	emailmessageDescTemplate := emailmessageFields[5].Descriptor()
+	// emailmessage.DefaultTemplate holds the default value on creation for the template field.
+	emailmessage.DefaultTemplate = emailmessageDescTemplate.Default.(string)
+	// emailmessage.TemplateValidator is a validator for the "template" field. It is called by the builders before save.
+	emailmessage.TemplateValidator = emailmessageDescTemplate.Validators[0].(func(string) error)
+	// emailmessageDescLocale is the schema descriptor for locale field.
+	emailmessageDescLocale := emailmessageFields[6].Descriptor()
+	// emailmessage.DefaultLocale holds the default value on creation for the locale field.
+	emailmessage.DefaultLocale = emailmessageDescLocale.Default.(string)
+	// emailmessage.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	emailmessage.LocaleValidator = emailmessageDescLocale.Validators[0].(func(string) error)
+	// emailmessageDescAttempts is the schema descriptor for attempts field.
+	emailmessageDescAttempts := emailmessageFields[8].Descriptor()
+	// emailmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	emailmessage.DefaultAttempts = emailmessageDescAttempts.Default.(int)
+	// emailmessage.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	emailmessage.AttemptsValidator = emailmessageDescAttempts.Validators[0].(func(int) error)
+	// emailmessageDescMaxAttempts is the schema descriptor for max_attempts field.
+	emailmessageDescMaxAttempts := emailmessageFields[9].Descriptor()
+	// emailmessage.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	emailmessage.MaxAttemptsValidator = emailmessageDescMaxAttempts.Validators[0].(func(int) error)
+	// emailmessageDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	emailmessageDescNextAttemptAt := emailmessageFields[10].Descriptor()
+	// emailmessage.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	emailmessage.DefaultNextAttemptAt = emailmessageDescNextAttemptAt.Default.(func() time.Time)
+	// emailmessageDescRelay is the schema descriptor for relay field.
+	emailmessageDescRelay := emailmessageFields[12].Descriptor()
+	// emailmessage.RelayValidator is a validator for the "relay" field. It is called by the builders before save.
+	emailmessage.RelayValidator = emailmessageDescRelay.Validators[0].(func(string) error)
+	// emailmessageDescLastError is the schema descriptor for last_error field.
+	emailmessageDescLastError := emailmessageFields[13].Descriptor()
+	// emailmessage.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	emailmessage.LastErrorValidator = emailmessageDescLastError.Validators[0].(func(string) error)
+	// emailmessageDescCreatedAt is the schema descriptor for created_at field.
+	emailmessageDescCreatedAt := emailmessageFields[15].Descriptor()
+	// emailmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailmessage.DefaultCreatedAt = emailmessageDescCreatedAt.Default.(func() time.Time)
+	// emailmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	emailmessageDescUpdatedAt := emailmessageFields[16].Descriptor()
+	// emailmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailmessage.DefaultUpdatedAt = emailmessageDescUpdatedAt.Default.(func() time.Time)
+	// emailmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailmessage.UpdateDefaultUpdatedAt = emailmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// emailmessageDescID is the schema descriptor for id field.
+	emailmessageDescID := emailmessageFields[0].Descriptor()
+	// emailmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	emailmessage.IDValidator = emailmessageDescID.Validators[0].(func(string) error)
+	emailsuppressionFields := schema.EmailSuppression{}.Fields()
+	_ = emailsuppressionFields
+	// emailsuppressionDescEmail is the schema descriptor for email field.
+	emailsuppressionDescEmail := emailsuppressionFields[0].Descriptor()
+	// emailsuppression.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailsuppression.EmailValidator = func() func(string) error {
+		validators := emailsuppressionDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// emailsuppressionDescDetail is the schema descriptor for detail field.
+	emailsuppressionDescDetail := emailsuppressionFields[2].Descriptor()
+	// emailsuppression.DetailValidator is a validator for the "detail" field. It is called by the builders before save.
+	emailsuppression.DetailValidator = emailsuppressionDescDetail.Validators[0].(func(string) error)
+	// emailsuppressionDescBounceCount is the schema descriptor for bounce_count field.
+	emailsuppressionDescBounceCount := emailsuppressionFields[3].Descriptor()
+	// emailsuppression.DefaultBounceCount holds the default value on creation for the bounce_count field.
+	emailsuppression.DefaultBounceCount = emailsuppressionDescBounceCount.Default.(int)
+	// emailsuppression.BounceCountValidator is a validator for the "bounce_count" field. It is called by the builders before save.
+	emailsuppression.BounceCountValidator = emailsuppressionDescBounceCount.Validators[0].(func(int) error)
+	// emailsuppressionDescCreatedAt is the schema descriptor for created_at field.
+	emailsuppressionDescCreatedAt := emailsuppressionFields[5].Descriptor()
+	// emailsuppression.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailsuppression.DefaultCreatedAt = emailsuppressionDescCreatedAt.Default.(func() time.Time)
+	// emailsuppressionDescUpdatedAt is the schema descriptor for updated_at field.
+	emailsuppressionDescUpdatedAt := emailsuppressionFields[6].Descriptor()
+	// emailsuppression.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailsuppression.DefaultUpdatedAt = emailsuppressionDescUpdatedAt.Default.(func() time.Time)
+	// emailsuppression.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailsuppression.UpdateDefaultUpdatedAt = emailsuppressionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	friendlinkFields := schema.FriendLink{}.Fields()
 	_ = friendlinkFields
 	// friendlinkDescName is the schema descriptor for name field.
@@ -139,6 +463,38 @@ func init() {
 	iosscriptcodeDescUploadCode := iosscriptcodeFields[1].Descriptor()
 	// iosscriptcode.UploadCodeValidator is a validator for the "upload_code" field. It is called by the builders before save.
 	iosscriptcode.UploadCodeValidator = iosscriptcodeDescUploadCode.Validators[0].(func(string) error)
+	inheritjobFields := schema.InheritJob{}.Fields()
+	_ = inheritjobFields
+	// inheritjobDescServer is the schema descriptor for server field.
+	inheritjobDescServer := inheritjobFields[1].Descriptor()
+	// inheritjob.ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	inheritjob.ServerValidator = inheritjobDescServer.Validators[0].(func(string) error)
+	// inheritjobDescUploadType is the schema descriptor for upload_type field.
+	inheritjobDescUploadType := inheritjobFields[2].Descriptor()
+	// inheritjob.UploadTypeValidator is a validator for the "upload_type" field. It is called by the builders before save.
+	inheritjob.UploadTypeValidator = inheritjobDescUploadType.Validators[0].(func(string) error)
+	// inheritjobDescMessage is the schema descriptor for message field.
+	inheritjobDescMessage := inheritjobFields[6].Descriptor()
+	// inheritjob.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	inheritjob.MessageValidator = inheritjobDescMessage.Validators[0].(func(string) error)
+	// inheritjobDescError is the schema descriptor for error field.
+	inheritjobDescError := inheritjobFields[7].Descriptor()
+	// inheritjob.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	inheritjob.ErrorValidator = inheritjobDescError.Validators[0].(func(string) error)
+	// inheritjobDescCreatedAt is the schema descriptor for created_at field.
+	inheritjobDescCreatedAt := inheritjobFields[11].Descriptor()
+	// inheritjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inheritjob.DefaultCreatedAt = inheritjobDescCreatedAt.Default.(func() time.Time)
+	// inheritjobDescUpdatedAt is the schema descriptor for updated_at field.
+	inheritjobDescUpdatedAt := inheritjobFields[12].Descriptor()
+	// inheritjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	inheritjob.DefaultUpdatedAt = inheritjobDescUpdatedAt.Default.(func() time.Time)
+	// inheritjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	inheritjob.UpdateDefaultUpdatedAt = inheritjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// inheritjobDescID is the schema descriptor for id field.
+	inheritjobDescID := inheritjobFields[0].Descriptor()
+	// inheritjob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	inheritjob.IDValidator = inheritjobDescID.Validators[0].(func(string) error)
 	oauth2clientwebhookendpointFields := schema.OAuth2ClientWebhookEndpoint{}.Fields()
 	_ = oauth2clientwebhookendpointFields
 	// oauth2clientwebhookendpointDescClientID is the schema descriptor for client_id field.
@@ -150,15 +506,21 @@ func init() {
 	// oauth2clientwebhookendpoint.CallbackURLValidator is a validator for the "callback_url" field. It is called by the builders before save.
 	oauth2clientwebhookendpoint.CallbackURLValidator = oauth2clientwebhookendpointDescCallbackURL.Validators[0].(func(string) error)
 	// oauth2clientwebhookendpointDescEnabled is the schema descriptor for enabled field.
-	oauth2clientwebhookendpointDescEnabled := oauth2clientwebhookendpointFields[4].Descriptor()
+	oauth2clientwebhookendpointDescEnabled := oauth2clientwebhookendpointFields[7].Descriptor()
 	// oauth2clientwebhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
 	oauth2clientwebhookendpoint.DefaultEnabled = oauth2clientwebhookendpointDescEnabled.Default.(bool)
+	// oauth2clientwebhookendpointDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	oauth2clientwebhookendpointDescConsecutiveFailures := oauth2clientwebhookendpointFields[9].Descriptor()
+	// oauth2clientwebhookendpoint.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	oauth2clientwebhookendpoint.DefaultConsecutiveFailures = oauth2clientwebhookendpointDescConsecutiveFailures.Default.(int)
+	// oauth2clientwebhookendpoint.ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	oauth2clientwebhookendpoint.ConsecutiveFailuresValidator = oauth2clientwebhookendpointDescConsecutiveFailures.Validators[0].(func(int) error)
 	// oauth2clientwebhookendpointDescCreatedAt is the schema descriptor for created_at field.
-	oauth2clientwebhookendpointDescCreatedAt := oauth2clientwebhookendpointFields[5].Descriptor()
+	oauth2clientwebhookendpointDescCreatedAt := oauth2clientwebhookendpointFields[12].Descriptor()
 	// oauth2clientwebhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
 	oauth2clientwebhookendpoint.DefaultCreatedAt = oauth2clientwebhookendpointDescCreatedAt.Default.(func() time.Time)
 	// oauth2clientwebhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
-	oauth2clientwebhookendpointDescUpdatedAt := oauth2clientwebhookendpointFields[6].Descriptor()
+	oauth2clientwebhookendpointDescUpdatedAt := oauth2clientwebhookendpointFields[13].Descriptor()
 	// oauth2clientwebhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	oauth2clientwebhookendpoint.DefaultUpdatedAt = oauth2clientwebhookendpointDescUpdatedAt.Default.(func() time.Time)
 	// oauth2clientwebhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -403,6 +765,44 @@ func init() {
 	ticket.DefaultUpdatedAt = ticketDescUpdatedAt.Default.(func() time.Time)
 	// ticket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	ticket.UpdateDefaultUpdatedAt = ticketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ticketattachmentFields := schema.TicketAttachment{}.Fields()
+	_ = ticketattachmentFields
+	// ticketattachmentDescUploaderUserID is the schema descriptor for uploader_user_id field.
+	ticketattachmentDescUploaderUserID := ticketattachmentFields[3].Descriptor()
+	// ticketattachment.UploaderUserIDValidator is a validator for the "uploader_user_id" field. It is called by the builders before save.
+	ticketattachment.UploaderUserIDValidator = ticketattachmentDescUploaderUserID.Validators[0].(func(string) error)
+	// ticketattachmentDescFileName is the schema descriptor for file_name field.
+	ticketattachmentDescFileName := ticketattachmentFields[4].Descriptor()
+	// ticketattachment.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	ticketattachment.FileNameValidator = ticketattachmentDescFileName.Validators[0].(func(string) error)
+	// ticketattachmentDescContentType is the schema descriptor for content_type field.
+	ticketattachmentDescContentType := ticketattachmentFields[5].Descriptor()
+	// ticketattachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ticketattachment.ContentTypeValidator = ticketattachmentDescContentType.Validators[0].(func(string) error)
+	// ticketattachmentDescSizeBytes is the schema descriptor for size_bytes field.
+	ticketattachmentDescSizeBytes := ticketattachmentFields[6].Descriptor()
+	// ticketattachment.SizeBytesValidator is a validator for the "size_bytes" field. It is called by the builders before save.
+	ticketattachment.SizeBytesValidator = ticketattachmentDescSizeBytes.Validators[0].(func(int64) error)
+	// ticketattachmentDescSha256 is the schema descriptor for sha256 field.
+	ticketattachmentDescSha256 := ticketattachmentFields[7].Descriptor()
+	// ticketattachment.Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	ticketattachment.Sha256Validator = ticketattachmentDescSha256.Validators[0].(func(string) error)
+	// ticketattachmentDescStorageKey is the schema descriptor for storage_key field.
+	ticketattachmentDescStorageKey := ticketattachmentFields[8].Descriptor()
+	// ticketattachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	ticketattachment.StorageKeyValidator = ticketattachmentDescStorageKey.Validators[0].(func(string) error)
+	// ticketattachmentDescThumbnailKey is the schema descriptor for thumbnail_key field.
+	ticketattachmentDescThumbnailKey := ticketattachmentFields[9].Descriptor()
+	// ticketattachment.ThumbnailKeyValidator is a validator for the "thumbnail_key" field. It is called by the builders before save.
+	ticketattachment.ThumbnailKeyValidator = ticketattachmentDescThumbnailKey.Validators[0].(func(string) error)
+	// ticketattachmentDescCreatedAt is the schema descriptor for created_at field.
+	ticketattachmentDescCreatedAt := ticketattachmentFields[12].Descriptor()
+	// ticketattachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ticketattachment.DefaultCreatedAt = ticketattachmentDescCreatedAt.Default.(func() time.Time)
+	// ticketattachmentDescID is the schema descriptor for id field.
+	ticketattachmentDescID := ticketattachmentFields[0].Descriptor()
+	// ticketattachment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ticketattachment.IDValidator = ticketattachmentDescID.Validators[0].(func(string) error)
 	ticketmessageFields := schema.TicketMessage{}.Fields()
 	_ = ticketmessageFields
 	// ticketmessageDescSenderUserID is the schema descriptor for sender_user_id field.
@@ -457,6 +857,230 @@ func init() {
 	userDescID := userFields[1].Descriptor()
 	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	user.IDValidator = userDescID.Validators[0].(func(string) error)
+	userrecoverycodeFields := schema.UserRecoveryCode{}.Fields()
+	_ = userrecoverycodeFields
+	// userrecoverycodeDescUserID is the schema descriptor for user_id field.
+	userrecoverycodeDescUserID := userrecoverycodeFields[0].Descriptor()
+	// userrecoverycode.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userrecoverycode.UserIDValidator = func() func(string) error {
+		validators := userrecoverycodeDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userrecoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	userrecoverycodeDescCodeHash := userrecoverycodeFields[1].Descriptor()
+	// userrecoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	userrecoverycode.CodeHashValidator = func() func(string) error {
+		validators := userrecoverycodeDescCodeHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_hash string) error {
+			for _, fn := range fns {
+				if err := fn(code_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userrecoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	userrecoverycodeDescCreatedAt := userrecoverycodeFields[3].Descriptor()
+	// userrecoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userrecoverycode.DefaultCreatedAt = userrecoverycodeDescCreatedAt.Default.(func() time.Time)
+	usertotpFields := schema.UserTOTP{}.Fields()
+	_ = usertotpFields
+	// usertotpDescUserID is the schema descriptor for user_id field.
+	usertotpDescUserID := usertotpFields[0].Descriptor()
+	// usertotp.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	usertotp.UserIDValidator = func() func(string) error {
+		validators := usertotpDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usertotpDescSecret is the schema descriptor for secret field.
+	usertotpDescSecret := usertotpFields[1].Descriptor()
+	// usertotp.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	usertotp.SecretValidator = usertotpDescSecret.Validators[0].(func(string) error)
+	// usertotpDescLastUsedStep is the schema descriptor for last_used_step field.
+	usertotpDescLastUsedStep := usertotpFields[3].Descriptor()
+	// usertotp.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	usertotp.DefaultLastUsedStep = usertotpDescLastUsedStep.Default.(int64)
+	// usertotpDescCreatedAt is the schema descriptor for created_at field.
+	usertotpDescCreatedAt := usertotpFields[4].Descriptor()
+	// usertotp.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertotp.DefaultCreatedAt = usertotpDescCreatedAt.Default.(func() time.Time)
+	// usertotpDescUpdatedAt is the schema descriptor for updated_at field.
+	usertotpDescUpdatedAt := usertotpFields[5].Descriptor()
+	// usertotp.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usertotp.DefaultUpdatedAt = usertotpDescUpdatedAt.Default.(func() time.Time)
+	// usertotp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usertotp.UpdateDefaultUpdatedAt = usertotpDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webauthncredentialFields := schema.WebAuthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescUserID is the schema descriptor for user_id field.
+	webauthncredentialDescUserID := webauthncredentialFields[1].Descriptor()
+	// webauthncredential.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	webauthncredential.UserIDValidator = func() func(string) error {
+		validators := webauthncredentialDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[2].Descriptor()
+	// webauthncredential.DefaultName holds the default value on creation for the name field.
+	webauthncredential.DefaultName = webauthncredentialDescName.Default.(string)
+	// webauthncredential.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webauthncredential.NameValidator = webauthncredentialDescName.Validators[0].(func(string) error)
+	// webauthncredentialDescCredential is the schema descriptor for credential field.
+	webauthncredentialDescCredential := webauthncredentialFields[3].Descriptor()
+	// webauthncredential.CredentialValidator is a validator for the "credential" field. It is called by the builders before save.
+	webauthncredential.CredentialValidator = webauthncredentialDescCredential.Validators[0].(func([]byte) error)
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialFields[5].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	// webauthncredentialDescID is the schema descriptor for id field.
+	webauthncredentialDescID := webauthncredentialFields[0].Descriptor()
+	// webauthncredential.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webauthncredential.IDValidator = func() func(string) error {
+		validators := webauthncredentialDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEndpointID is the schema descriptor for endpoint_id field.
+	webhookdeliveryDescEndpointID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EndpointIDValidator is a validator for the "endpoint_id" field. It is called by the builders before save.
+	webhookdelivery.EndpointIDValidator = webhookdeliveryDescEndpointID.Validators[0].(func(string) error)
+	// webhookdeliveryDescCallbackURL is the schema descriptor for callback_url field.
+	webhookdeliveryDescCallbackURL := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.CallbackURLValidator is a validator for the "callback_url" field. It is called by the builders before save.
+	webhookdelivery.CallbackURLValidator = webhookdeliveryDescCallbackURL.Validators[0].(func(string) error)
+	// webhookdeliveryDescGameUserID is the schema descriptor for game_user_id field.
+	webhookdeliveryDescGameUserID := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.GameUserIDValidator is a validator for the "game_user_id" field. It is called by the builders before save.
+	webhookdelivery.GameUserIDValidator = webhookdeliveryDescGameUserID.Validators[0].(func(string) error)
+	// webhookdeliveryDescServer is the schema descriptor for server field.
+	webhookdeliveryDescServer := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	webhookdelivery.ServerValidator = webhookdeliveryDescServer.Validators[0].(func(string) error)
+	// webhookdeliveryDescDataType is the schema descriptor for data_type field.
+	webhookdeliveryDescDataType := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DataTypeValidator is a validator for the "data_type" field. It is called by the builders before save.
+	webhookdelivery.DataTypeValidator = webhookdeliveryDescDataType.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultEventID holds the default value on creation for the event_id field.
+	webhookdelivery.DefaultEventID = webhookdeliveryDescEventID.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescMaxAttempts is the schema descriptor for max_attempts field.
+	webhookdeliveryDescMaxAttempts := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	webhookdelivery.MaxAttemptsValidator = webhookdeliveryDescMaxAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[13].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[15].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[17].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[18].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = webhookdeliveryDescID.Validators[0].(func(string) error)
+	webhookdeliveryattemptFields := schema.WebhookDeliveryAttempt{}.Fields()
+	_ = webhookdeliveryattemptFields
+	// webhookdeliveryattemptDescEndpointID is the schema descriptor for endpoint_id field.
+	webhookdeliveryattemptDescEndpointID := webhookdeliveryattemptFields[1].Descriptor()
+	// webhookdeliveryattempt.EndpointIDValidator is a validator for the "endpoint_id" field. It is called by the builders before save.
+	webhookdeliveryattempt.EndpointIDValidator = webhookdeliveryattemptDescEndpointID.Validators[0].(func(string) error)
+	// webhookdeliveryattemptDescDeliveryID is the schema descriptor for delivery_id field.
+	webhookdeliveryattemptDescDeliveryID := webhookdeliveryattemptFields[3].Descriptor()
+	// webhookdeliveryattempt.DefaultDeliveryID holds the default value on creation for the delivery_id field.
+	webhookdeliveryattempt.DefaultDeliveryID = webhookdeliveryattemptDescDeliveryID.Default.(string)
+	// webhookdeliveryattemptDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryattemptDescEventID := webhookdeliveryattemptFields[4].Descriptor()
+	// webhookdeliveryattempt.DefaultEventID holds the default value on creation for the event_id field.
+	webhookdeliveryattempt.DefaultEventID = webhookdeliveryattemptDescEventID.Default.(string)
+	// webhookdeliveryattemptDescAttempt is the schema descriptor for attempt field.
+	webhookdeliveryattemptDescAttempt := webhookdeliveryattemptFields[5].Descriptor()
+	// webhookdeliveryattempt.DefaultAttempt holds the default value on creation for the attempt field.
+	webhookdeliveryattempt.DefaultAttempt = webhookdeliveryattemptDescAttempt.Default.(int)
+	// webhookdeliveryattempt.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	webhookdeliveryattempt.AttemptValidator = webhookdeliveryattemptDescAttempt.Validators[0].(func(int) error)
+	// webhookdeliveryattemptDescLatencyMs is the schema descriptor for latency_ms field.
+	webhookdeliveryattemptDescLatencyMs := webhookdeliveryattemptFields[8].Descriptor()
+	// webhookdeliveryattempt.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	webhookdeliveryattempt.DefaultLatencyMs = webhookdeliveryattemptDescLatencyMs.Default.(int64)
+	// webhookdeliveryattempt.LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	webhookdeliveryattempt.LatencyMsValidator = webhookdeliveryattemptDescLatencyMs.Validators[0].(func(int64) error)
+	// webhookdeliveryattemptDescError is the schema descriptor for error field.
+	webhookdeliveryattemptDescError := webhookdeliveryattemptFields[9].Descriptor()
+	// webhookdeliveryattempt.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	webhookdeliveryattempt.ErrorValidator = webhookdeliveryattemptDescError.Validators[0].(func(string) error)
+	// webhookdeliveryattemptDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryattemptDescCreatedAt := webhookdeliveryattemptFields[10].Descriptor()
+	// webhookdeliveryattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdeliveryattempt.DefaultCreatedAt = webhookdeliveryattemptDescCreatedAt.Default.(func() time.Time)
 	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
 	_ = webhookendpointFields
 	// webhookendpointDescCredential is the schema descriptor for credential field.
@@ -468,11 +1092,17 @@ func init() {
 	// webhookendpoint.CallbackURLValidator is a validator for the "callback_url" field. It is called by the builders before save.
 	webhookendpoint.CallbackURLValidator = webhookendpointDescCallbackURL.Validators[0].(func(string) error)
 	// webhookendpointDescEnabled is the schema descriptor for enabled field.
-	webhookendpointDescEnabled := webhookendpointFields[4].Descriptor()
+	webhookendpointDescEnabled := webhookendpointFields[7].Descriptor()
 	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
 	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	webhookendpointDescConsecutiveFailures := webhookendpointFields[9].Descriptor()
+	// webhookendpoint.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	webhookendpoint.DefaultConsecutiveFailures = webhookendpointDescConsecutiveFailures.Default.(int)
+	// webhookendpoint.ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	webhookendpoint.ConsecutiveFailuresValidator = webhookendpointDescConsecutiveFailures.Validators[0].(func(int) error)
 	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
-	webhookendpointDescCreatedAt := webhookendpointFields[5].Descriptor()
+	webhookendpointDescCreatedAt := webhookendpointFields[12].Descriptor()
 	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
 	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
 	// webhookendpointDescID is the schema descriptor for id field.
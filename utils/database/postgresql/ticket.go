@@ -38,6 +38,14 @@ type Ticket struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// ClosedAt holds the value of the "closed_at" field.
 	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// FirstResponseAt holds the value of the "first_response_at" field.
+	FirstResponseAt *time.Time `json:"first_response_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// FirstResponseBreachedAt holds the value of the "first_response_breached_at" field.
+	FirstResponseBreachedAt *time.Time `json:"first_response_breached_at,omitempty"`
+	// ResolutionBreachedAt holds the value of the "resolution_breached_at" field.
+	ResolutionBreachedAt *time.Time `json:"resolution_breached_at,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -50,9 +58,11 @@ type Ticket struct {
 type TicketEdges struct {
 	// Messages holds the value of the messages edge.
 	Messages []*TicketMessage `json:"messages,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*TicketAttachment `json:"attachments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -64,6 +74,15 @@ func (e TicketEdges) MessagesOrErr() ([]*TicketMessage, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketEdges) AttachmentsOrErr() ([]*TicketAttachment, error) {
+	if e.loadedTypes[1] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Ticket) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -75,7 +94,7 @@ func (*Ticket) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case ticket.FieldTicketID, ticket.FieldCreatorUserID, ticket.FieldSubject, ticket.FieldCategory, ticket.FieldPriority, ticket.FieldStatus, ticket.FieldAssigneeAdminID:
 			values[i] = new(sql.NullString)
-		case ticket.FieldCreatedAt, ticket.FieldUpdatedAt, ticket.FieldClosedAt:
+		case ticket.FieldCreatedAt, ticket.FieldUpdatedAt, ticket.FieldClosedAt, ticket.FieldFirstResponseAt, ticket.FieldResolvedAt, ticket.FieldFirstResponseBreachedAt, ticket.FieldResolutionBreachedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -161,6 +180,34 @@ func (_m *Ticket) assignValues(columns []string, values []any) error {
 				_m.ClosedAt = new(time.Time)
 				*_m.ClosedAt = value.Time
 			}
+		case ticket.FieldFirstResponseAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_response_at", values[i])
+			} else if value.Valid {
+				_m.FirstResponseAt = new(time.Time)
+				*_m.FirstResponseAt = value.Time
+			}
+		case ticket.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case ticket.FieldFirstResponseBreachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_response_breached_at", values[i])
+			} else if value.Valid {
+				_m.FirstResponseBreachedAt = new(time.Time)
+				*_m.FirstResponseBreachedAt = value.Time
+			}
+		case ticket.FieldResolutionBreachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_breached_at", values[i])
+			} else if value.Valid {
+				_m.ResolutionBreachedAt = new(time.Time)
+				*_m.ResolutionBreachedAt = value.Time
+			}
 		case ticket.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -187,6 +234,11 @@ func (_m *Ticket) QueryMessages() *TicketMessageQuery {
 	return NewTicketClient(_m.config).QueryMessages(_m)
 }
 
+// QueryAttachments queries the "attachments" edge of the Ticket entity.
+func (_m *Ticket) QueryAttachments() *TicketAttachmentQuery {
+	return NewTicketClient(_m.config).QueryAttachments(_m)
+}
+
 // Update returns a builder for updating this Ticket.
 // Note that you need to call Ticket.Unwrap() before calling this method if this Ticket
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -246,6 +298,26 @@ func (_m *Ticket) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.FirstResponseAt; v != nil {
+		builder.WriteString("first_response_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FirstResponseBreachedAt; v != nil {
+		builder.WriteString("first_response_breached_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolutionBreachedAt; v != nil {
+		builder.WriteString("resolution_breached_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteByte(')')
@@ -95,6 +95,26 @@ func ClosedAt(v time.Time) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldClosedAt, v))
 }
 
+// FirstResponseAt applies equality check predicate on the "first_response_at" field. It's identical to FirstResponseAtEQ.
+func FirstResponseAt(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldFirstResponseAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// FirstResponseBreachedAt applies equality check predicate on the "first_response_breached_at" field. It's identical to FirstResponseBreachedAtEQ.
+func FirstResponseBreachedAt(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldFirstResponseBreachedAt, v))
+}
+
+// ResolutionBreachedAt applies equality check predicate on the "resolution_breached_at" field. It's identical to ResolutionBreachedAtEQ.
+func ResolutionBreachedAt(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldResolutionBreachedAt, v))
+}
+
 // TicketIDEQ applies the EQ predicate on the "ticket_id" field.
 func TicketIDEQ(v string) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldTicketID, v))
@@ -610,6 +630,206 @@ func ClosedAtNotNil() predicate.Ticket {
 	return predicate.Ticket(sql.FieldNotNull(FieldClosedAt))
 }
 
+// FirstResponseAtEQ applies the EQ predicate on the "first_response_at" field.
+func FirstResponseAtEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldFirstResponseAt, v))
+}
+
+// FirstResponseAtNEQ applies the NEQ predicate on the "first_response_at" field.
+func FirstResponseAtNEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldFirstResponseAt, v))
+}
+
+// FirstResponseAtIn applies the In predicate on the "first_response_at" field.
+func FirstResponseAtIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldIn(FieldFirstResponseAt, vs...))
+}
+
+// FirstResponseAtNotIn applies the NotIn predicate on the "first_response_at" field.
+func FirstResponseAtNotIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotIn(FieldFirstResponseAt, vs...))
+}
+
+// FirstResponseAtGT applies the GT predicate on the "first_response_at" field.
+func FirstResponseAtGT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGT(FieldFirstResponseAt, v))
+}
+
+// FirstResponseAtGTE applies the GTE predicate on the "first_response_at" field.
+func FirstResponseAtGTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGTE(FieldFirstResponseAt, v))
+}
+
+// FirstResponseAtLT applies the LT predicate on the "first_response_at" field.
+func FirstResponseAtLT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLT(FieldFirstResponseAt, v))
+}
+
+// FirstResponseAtLTE applies the LTE predicate on the "first_response_at" field.
+func FirstResponseAtLTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLTE(FieldFirstResponseAt, v))
+}
+
+// FirstResponseAtIsNil applies the IsNil predicate on the "first_response_at" field.
+func FirstResponseAtIsNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldIsNull(FieldFirstResponseAt))
+}
+
+// FirstResponseAtNotNil applies the NotNil predicate on the "first_response_at" field.
+func FirstResponseAtNotNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotNull(FieldFirstResponseAt))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// FirstResponseBreachedAtEQ applies the EQ predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldFirstResponseBreachedAt, v))
+}
+
+// FirstResponseBreachedAtNEQ applies the NEQ predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtNEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldFirstResponseBreachedAt, v))
+}
+
+// FirstResponseBreachedAtIn applies the In predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldIn(FieldFirstResponseBreachedAt, vs...))
+}
+
+// FirstResponseBreachedAtNotIn applies the NotIn predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtNotIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotIn(FieldFirstResponseBreachedAt, vs...))
+}
+
+// FirstResponseBreachedAtGT applies the GT predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtGT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGT(FieldFirstResponseBreachedAt, v))
+}
+
+// FirstResponseBreachedAtGTE applies the GTE predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtGTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGTE(FieldFirstResponseBreachedAt, v))
+}
+
+// FirstResponseBreachedAtLT applies the LT predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtLT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLT(FieldFirstResponseBreachedAt, v))
+}
+
+// FirstResponseBreachedAtLTE applies the LTE predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtLTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLTE(FieldFirstResponseBreachedAt, v))
+}
+
+// FirstResponseBreachedAtIsNil applies the IsNil predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtIsNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldIsNull(FieldFirstResponseBreachedAt))
+}
+
+// FirstResponseBreachedAtNotNil applies the NotNil predicate on the "first_response_breached_at" field.
+func FirstResponseBreachedAtNotNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotNull(FieldFirstResponseBreachedAt))
+}
+
+// ResolutionBreachedAtEQ applies the EQ predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldResolutionBreachedAt, v))
+}
+
+// ResolutionBreachedAtNEQ applies the NEQ predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtNEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldResolutionBreachedAt, v))
+}
+
+// ResolutionBreachedAtIn applies the In predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldIn(FieldResolutionBreachedAt, vs...))
+}
+
+// ResolutionBreachedAtNotIn applies the NotIn predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtNotIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotIn(FieldResolutionBreachedAt, vs...))
+}
+
+// ResolutionBreachedAtGT applies the GT predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtGT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGT(FieldResolutionBreachedAt, v))
+}
+
+// ResolutionBreachedAtGTE applies the GTE predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtGTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGTE(FieldResolutionBreachedAt, v))
+}
+
+// ResolutionBreachedAtLT applies the LT predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtLT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLT(FieldResolutionBreachedAt, v))
+}
+
+// ResolutionBreachedAtLTE applies the LTE predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtLTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLTE(FieldResolutionBreachedAt, v))
+}
+
+// ResolutionBreachedAtIsNil applies the IsNil predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtIsNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldIsNull(FieldResolutionBreachedAt))
+}
+
+// ResolutionBreachedAtNotNil applies the NotNil predicate on the "resolution_breached_at" field.
+func ResolutionBreachedAtNotNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotNull(FieldResolutionBreachedAt))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.Ticket {
 	return predicate.Ticket(sql.FieldIsNull(FieldMetadata))
@@ -643,6 +863,29 @@ func HasMessagesWith(preds ...predicate.TicketMessage) predicate.Ticket {
 	})
 }
 
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.TicketAttachment) predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Ticket) predicate.Ticket {
 	return predicate.Ticket(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 )
 
@@ -137,6 +138,62 @@ func (_c *TicketCreate) SetNillableClosedAt(v *time.Time) *TicketCreate {
 	return _c
 }
 
+// SetFirstResponseAt sets the "first_response_at" field.
+func (_c *TicketCreate) SetFirstResponseAt(v time.Time) *TicketCreate {
+	_c.mutation.SetFirstResponseAt(v)
+	return _c
+}
+
+// SetNillableFirstResponseAt sets the "first_response_at" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableFirstResponseAt(v *time.Time) *TicketCreate {
+	if v != nil {
+		_c.SetFirstResponseAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *TicketCreate) SetResolvedAt(v time.Time) *TicketCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableResolvedAt(v *time.Time) *TicketCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetFirstResponseBreachedAt sets the "first_response_breached_at" field.
+func (_c *TicketCreate) SetFirstResponseBreachedAt(v time.Time) *TicketCreate {
+	_c.mutation.SetFirstResponseBreachedAt(v)
+	return _c
+}
+
+// SetNillableFirstResponseBreachedAt sets the "first_response_breached_at" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableFirstResponseBreachedAt(v *time.Time) *TicketCreate {
+	if v != nil {
+		_c.SetFirstResponseBreachedAt(*v)
+	}
+	return _c
+}
+
+// SetResolutionBreachedAt sets the "resolution_breached_at" field.
+func (_c *TicketCreate) SetResolutionBreachedAt(v time.Time) *TicketCreate {
+	_c.mutation.SetResolutionBreachedAt(v)
+	return _c
+}
+
+// SetNillableResolutionBreachedAt sets the "resolution_breached_at" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableResolutionBreachedAt(v *time.Time) *TicketCreate {
+	if v != nil {
+		_c.SetResolutionBreachedAt(*v)
+	}
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *TicketCreate) SetMetadata(v map[string]interface{}) *TicketCreate {
 	_c.mutation.SetMetadata(v)
@@ -158,6 +215,21 @@ func (_c *TicketCreate) AddMessages(v ...*TicketMessage) *TicketCreate {
 	return _c.AddMessageIDs(ids...)
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the TicketAttachment entity by IDs.
+func (_c *TicketCreate) AddAttachmentIDs(ids ...string) *TicketCreate {
+	_c.mutation.AddAttachmentIDs(ids...)
+	return _c
+}
+
+// AddAttachments adds the "attachments" edges to the TicketAttachment entity.
+func (_c *TicketCreate) AddAttachments(v ...*TicketAttachment) *TicketCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TicketMutation object of the builder.
 func (_c *TicketCreate) Mutation() *TicketMutation {
 	return _c.mutation
@@ -335,6 +407,22 @@ func (_c *TicketCreate) createSpec() (*Ticket, *sqlgraph.CreateSpec) {
 		_spec.SetField(ticket.FieldClosedAt, field.TypeTime, value)
 		_node.ClosedAt = &value
 	}
+	if value, ok := _c.mutation.FirstResponseAt(); ok {
+		_spec.SetField(ticket.FieldFirstResponseAt, field.TypeTime, value)
+		_node.FirstResponseAt = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(ticket.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.FirstResponseBreachedAt(); ok {
+		_spec.SetField(ticket.FieldFirstResponseBreachedAt, field.TypeTime, value)
+		_node.FirstResponseBreachedAt = &value
+	}
+	if value, ok := _c.mutation.ResolutionBreachedAt(); ok {
+		_spec.SetField(ticket.FieldResolutionBreachedAt, field.TypeTime, value)
+		_node.ResolutionBreachedAt = &value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(ticket.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -355,6 +443,22 @@ func (_c *TicketCreate) createSpec() (*Ticket, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
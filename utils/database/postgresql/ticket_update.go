@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 )
 
@@ -179,6 +180,86 @@ func (_u *TicketUpdate) ClearClosedAt() *TicketUpdate {
 	return _u
 }
 
+// SetFirstResponseAt sets the "first_response_at" field.
+func (_u *TicketUpdate) SetFirstResponseAt(v time.Time) *TicketUpdate {
+	_u.mutation.SetFirstResponseAt(v)
+	return _u
+}
+
+// SetNillableFirstResponseAt sets the "first_response_at" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableFirstResponseAt(v *time.Time) *TicketUpdate {
+	if v != nil {
+		_u.SetFirstResponseAt(*v)
+	}
+	return _u
+}
+
+// ClearFirstResponseAt clears the value of the "first_response_at" field.
+func (_u *TicketUpdate) ClearFirstResponseAt() *TicketUpdate {
+	_u.mutation.ClearFirstResponseAt()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *TicketUpdate) SetResolvedAt(v time.Time) *TicketUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableResolvedAt(v *time.Time) *TicketUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *TicketUpdate) ClearResolvedAt() *TicketUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetFirstResponseBreachedAt sets the "first_response_breached_at" field.
+func (_u *TicketUpdate) SetFirstResponseBreachedAt(v time.Time) *TicketUpdate {
+	_u.mutation.SetFirstResponseBreachedAt(v)
+	return _u
+}
+
+// SetNillableFirstResponseBreachedAt sets the "first_response_breached_at" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableFirstResponseBreachedAt(v *time.Time) *TicketUpdate {
+	if v != nil {
+		_u.SetFirstResponseBreachedAt(*v)
+	}
+	return _u
+}
+
+// ClearFirstResponseBreachedAt clears the value of the "first_response_breached_at" field.
+func (_u *TicketUpdate) ClearFirstResponseBreachedAt() *TicketUpdate {
+	_u.mutation.ClearFirstResponseBreachedAt()
+	return _u
+}
+
+// SetResolutionBreachedAt sets the "resolution_breached_at" field.
+func (_u *TicketUpdate) SetResolutionBreachedAt(v time.Time) *TicketUpdate {
+	_u.mutation.SetResolutionBreachedAt(v)
+	return _u
+}
+
+// SetNillableResolutionBreachedAt sets the "resolution_breached_at" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableResolutionBreachedAt(v *time.Time) *TicketUpdate {
+	if v != nil {
+		_u.SetResolutionBreachedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolutionBreachedAt clears the value of the "resolution_breached_at" field.
+func (_u *TicketUpdate) ClearResolutionBreachedAt() *TicketUpdate {
+	_u.mutation.ClearResolutionBreachedAt()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *TicketUpdate) SetMetadata(v map[string]interface{}) *TicketUpdate {
 	_u.mutation.SetMetadata(v)
@@ -206,6 +287,21 @@ func (_u *TicketUpdate) AddMessages(v ...*TicketMessage) *TicketUpdate {
 	return _u.AddMessageIDs(ids...)
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the TicketAttachment entity by IDs.
+func (_u *TicketUpdate) AddAttachmentIDs(ids ...string) *TicketUpdate {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the TicketAttachment entity.
+func (_u *TicketUpdate) AddAttachments(v ...*TicketAttachment) *TicketUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TicketMutation object of the builder.
 func (_u *TicketUpdate) Mutation() *TicketMutation {
 	return _u.mutation
@@ -232,6 +328,27 @@ func (_u *TicketUpdate) RemoveMessages(v ...*TicketMessage) *TicketUpdate {
 	return _u.RemoveMessageIDs(ids...)
 }
 
+// ClearAttachments clears all "attachments" edges to the TicketAttachment entity.
+func (_u *TicketUpdate) ClearAttachments() *TicketUpdate {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to TicketAttachment entities by IDs.
+func (_u *TicketUpdate) RemoveAttachmentIDs(ids ...string) *TicketUpdate {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to TicketAttachment entities.
+func (_u *TicketUpdate) RemoveAttachments(v ...*TicketAttachment) *TicketUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TicketUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -359,6 +476,30 @@ func (_u *TicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ClosedAtCleared() {
 		_spec.ClearField(ticket.FieldClosedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.FirstResponseAt(); ok {
+		_spec.SetField(ticket.FieldFirstResponseAt, field.TypeTime, value)
+	}
+	if _u.mutation.FirstResponseAtCleared() {
+		_spec.ClearField(ticket.FieldFirstResponseAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(ticket.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(ticket.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FirstResponseBreachedAt(); ok {
+		_spec.SetField(ticket.FieldFirstResponseBreachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FirstResponseBreachedAtCleared() {
+		_spec.ClearField(ticket.FieldFirstResponseBreachedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolutionBreachedAt(); ok {
+		_spec.SetField(ticket.FieldResolutionBreachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolutionBreachedAtCleared() {
+		_spec.ClearField(ticket.FieldResolutionBreachedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(ticket.FieldMetadata, field.TypeJSON, value)
 	}
@@ -410,6 +551,51 @@ func (_u *TicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{ticket.Label}
@@ -580,6 +766,86 @@ func (_u *TicketUpdateOne) ClearClosedAt() *TicketUpdateOne {
 	return _u
 }
 
+// SetFirstResponseAt sets the "first_response_at" field.
+func (_u *TicketUpdateOne) SetFirstResponseAt(v time.Time) *TicketUpdateOne {
+	_u.mutation.SetFirstResponseAt(v)
+	return _u
+}
+
+// SetNillableFirstResponseAt sets the "first_response_at" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableFirstResponseAt(v *time.Time) *TicketUpdateOne {
+	if v != nil {
+		_u.SetFirstResponseAt(*v)
+	}
+	return _u
+}
+
+// ClearFirstResponseAt clears the value of the "first_response_at" field.
+func (_u *TicketUpdateOne) ClearFirstResponseAt() *TicketUpdateOne {
+	_u.mutation.ClearFirstResponseAt()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *TicketUpdateOne) SetResolvedAt(v time.Time) *TicketUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableResolvedAt(v *time.Time) *TicketUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *TicketUpdateOne) ClearResolvedAt() *TicketUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetFirstResponseBreachedAt sets the "first_response_breached_at" field.
+func (_u *TicketUpdateOne) SetFirstResponseBreachedAt(v time.Time) *TicketUpdateOne {
+	_u.mutation.SetFirstResponseBreachedAt(v)
+	return _u
+}
+
+// SetNillableFirstResponseBreachedAt sets the "first_response_breached_at" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableFirstResponseBreachedAt(v *time.Time) *TicketUpdateOne {
+	if v != nil {
+		_u.SetFirstResponseBreachedAt(*v)
+	}
+	return _u
+}
+
+// ClearFirstResponseBreachedAt clears the value of the "first_response_breached_at" field.
+func (_u *TicketUpdateOne) ClearFirstResponseBreachedAt() *TicketUpdateOne {
+	_u.mutation.ClearFirstResponseBreachedAt()
+	return _u
+}
+
+// SetResolutionBreachedAt sets the "resolution_breached_at" field.
+func (_u *TicketUpdateOne) SetResolutionBreachedAt(v time.Time) *TicketUpdateOne {
+	_u.mutation.SetResolutionBreachedAt(v)
+	return _u
+}
+
+// SetNillableResolutionBreachedAt sets the "resolution_breached_at" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableResolutionBreachedAt(v *time.Time) *TicketUpdateOne {
+	if v != nil {
+		_u.SetResolutionBreachedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolutionBreachedAt clears the value of the "resolution_breached_at" field.
+func (_u *TicketUpdateOne) ClearResolutionBreachedAt() *TicketUpdateOne {
+	_u.mutation.ClearResolutionBreachedAt()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *TicketUpdateOne) SetMetadata(v map[string]interface{}) *TicketUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -607,6 +873,21 @@ func (_u *TicketUpdateOne) AddMessages(v ...*TicketMessage) *TicketUpdateOne {
 	return _u.AddMessageIDs(ids...)
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the TicketAttachment entity by IDs.
+func (_u *TicketUpdateOne) AddAttachmentIDs(ids ...string) *TicketUpdateOne {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the TicketAttachment entity.
+func (_u *TicketUpdateOne) AddAttachments(v ...*TicketAttachment) *TicketUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TicketMutation object of the builder.
 func (_u *TicketUpdateOne) Mutation() *TicketMutation {
 	return _u.mutation
@@ -633,6 +914,27 @@ func (_u *TicketUpdateOne) RemoveMessages(v ...*TicketMessage) *TicketUpdateOne
 	return _u.RemoveMessageIDs(ids...)
 }
 
+// ClearAttachments clears all "attachments" edges to the TicketAttachment entity.
+func (_u *TicketUpdateOne) ClearAttachments() *TicketUpdateOne {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to TicketAttachment entities by IDs.
+func (_u *TicketUpdateOne) RemoveAttachmentIDs(ids ...string) *TicketUpdateOne {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to TicketAttachment entities.
+func (_u *TicketUpdateOne) RemoveAttachments(v ...*TicketAttachment) *TicketUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
 // Where appends a list predicates to the TicketUpdate builder.
 func (_u *TicketUpdateOne) Where(ps ...predicate.Ticket) *TicketUpdateOne {
 	_u.mutation.Where(ps...)
@@ -790,6 +1092,30 @@ func (_u *TicketUpdateOne) sqlSave(ctx context.Context) (_node *Ticket, err erro
 	if _u.mutation.ClosedAtCleared() {
 		_spec.ClearField(ticket.FieldClosedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.FirstResponseAt(); ok {
+		_spec.SetField(ticket.FieldFirstResponseAt, field.TypeTime, value)
+	}
+	if _u.mutation.FirstResponseAtCleared() {
+		_spec.ClearField(ticket.FieldFirstResponseAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(ticket.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(ticket.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FirstResponseBreachedAt(); ok {
+		_spec.SetField(ticket.FieldFirstResponseBreachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FirstResponseBreachedAtCleared() {
+		_spec.ClearField(ticket.FieldFirstResponseBreachedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolutionBreachedAt(); ok {
+		_spec.SetField(ticket.FieldResolutionBreachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolutionBreachedAtCleared() {
+		_spec.ClearField(ticket.FieldResolutionBreachedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(ticket.FieldMetadata, field.TypeJSON, value)
 	}
@@ -841,6 +1167,51 @@ func (_u *TicketUpdateOne) sqlSave(ctx context.Context) (_node *Ticket, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.AttachmentsTable,
+			Columns: []string{ticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Ticket{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
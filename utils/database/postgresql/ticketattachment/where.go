@@ -0,0 +1,807 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketattachment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldID, id))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldTicketID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldMessageID, v))
+}
+
+// UploaderUserID applies equality check predicate on the "uploader_user_id" field. It's identical to UploaderUserIDEQ.
+func UploaderUserID(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldUploaderUserID, v))
+}
+
+// FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldFileName, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldContentType, v))
+}
+
+// SizeBytes applies equality check predicate on the "size_bytes" field. It's identical to SizeBytesEQ.
+func SizeBytes(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldSha256, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// ThumbnailKey applies equality check predicate on the "thumbnail_key" field. It's identical to ThumbnailKeyEQ.
+func ThumbnailKey(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldThumbnailKey, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldHeight, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// UploaderUserIDEQ applies the EQ predicate on the "uploader_user_id" field.
+func UploaderUserIDEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDNEQ applies the NEQ predicate on the "uploader_user_id" field.
+func UploaderUserIDNEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDIn applies the In predicate on the "uploader_user_id" field.
+func UploaderUserIDIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldUploaderUserID, vs...))
+}
+
+// UploaderUserIDNotIn applies the NotIn predicate on the "uploader_user_id" field.
+func UploaderUserIDNotIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldUploaderUserID, vs...))
+}
+
+// UploaderUserIDGT applies the GT predicate on the "uploader_user_id" field.
+func UploaderUserIDGT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDGTE applies the GTE predicate on the "uploader_user_id" field.
+func UploaderUserIDGTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDLT applies the LT predicate on the "uploader_user_id" field.
+func UploaderUserIDLT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDLTE applies the LTE predicate on the "uploader_user_id" field.
+func UploaderUserIDLTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDContains applies the Contains predicate on the "uploader_user_id" field.
+func UploaderUserIDContains(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContains(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDHasPrefix applies the HasPrefix predicate on the "uploader_user_id" field.
+func UploaderUserIDHasPrefix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasPrefix(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDHasSuffix applies the HasSuffix predicate on the "uploader_user_id" field.
+func UploaderUserIDHasSuffix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasSuffix(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDEqualFold applies the EqualFold predicate on the "uploader_user_id" field.
+func UploaderUserIDEqualFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldUploaderUserID, v))
+}
+
+// UploaderUserIDContainsFold applies the ContainsFold predicate on the "uploader_user_id" field.
+func UploaderUserIDContainsFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldUploaderUserID, v))
+}
+
+// FileNameEQ applies the EQ predicate on the "file_name" field.
+func FileNameEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileNameNEQ applies the NEQ predicate on the "file_name" field.
+func FileNameNEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldFileName, v))
+}
+
+// FileNameIn applies the In predicate on the "file_name" field.
+func FileNameIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldFileName, vs...))
+}
+
+// FileNameNotIn applies the NotIn predicate on the "file_name" field.
+func FileNameNotIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldFileName, vs...))
+}
+
+// FileNameGT applies the GT predicate on the "file_name" field.
+func FileNameGT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldFileName, v))
+}
+
+// FileNameGTE applies the GTE predicate on the "file_name" field.
+func FileNameGTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldFileName, v))
+}
+
+// FileNameLT applies the LT predicate on the "file_name" field.
+func FileNameLT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldFileName, v))
+}
+
+// FileNameLTE applies the LTE predicate on the "file_name" field.
+func FileNameLTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldFileName, v))
+}
+
+// FileNameContains applies the Contains predicate on the "file_name" field.
+func FileNameContains(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContains(FieldFileName, v))
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "file_name" field.
+func FileNameHasPrefix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasPrefix(FieldFileName, v))
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "file_name" field.
+func FileNameHasSuffix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasSuffix(FieldFileName, v))
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "file_name" field.
+func FileNameEqualFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldFileName, v))
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "file_name" field.
+func FileNameContainsFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldFileName, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeBytesEQ applies the EQ predicate on the "size_bytes" field.
+func SizeBytesEQ(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesNEQ applies the NEQ predicate on the "size_bytes" field.
+func SizeBytesNEQ(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesIn applies the In predicate on the "size_bytes" field.
+func SizeBytesIn(vs ...int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesNotIn applies the NotIn predicate on the "size_bytes" field.
+func SizeBytesNotIn(vs ...int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesGT applies the GT predicate on the "size_bytes" field.
+func SizeBytesGT(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldSizeBytes, v))
+}
+
+// SizeBytesGTE applies the GTE predicate on the "size_bytes" field.
+func SizeBytesGTE(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldSizeBytes, v))
+}
+
+// SizeBytesLT applies the LT predicate on the "size_bytes" field.
+func SizeBytesLT(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldSizeBytes, v))
+}
+
+// SizeBytesLTE applies the LTE predicate on the "size_bytes" field.
+func SizeBytesLTE(v int64) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldSizeBytes, v))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// ThumbnailKeyEQ applies the EQ predicate on the "thumbnail_key" field.
+func ThumbnailKeyEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyNEQ applies the NEQ predicate on the "thumbnail_key" field.
+func ThumbnailKeyNEQ(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyIn applies the In predicate on the "thumbnail_key" field.
+func ThumbnailKeyIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldThumbnailKey, vs...))
+}
+
+// ThumbnailKeyNotIn applies the NotIn predicate on the "thumbnail_key" field.
+func ThumbnailKeyNotIn(vs ...string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldThumbnailKey, vs...))
+}
+
+// ThumbnailKeyGT applies the GT predicate on the "thumbnail_key" field.
+func ThumbnailKeyGT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyGTE applies the GTE predicate on the "thumbnail_key" field.
+func ThumbnailKeyGTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyLT applies the LT predicate on the "thumbnail_key" field.
+func ThumbnailKeyLT(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyLTE applies the LTE predicate on the "thumbnail_key" field.
+func ThumbnailKeyLTE(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyContains applies the Contains predicate on the "thumbnail_key" field.
+func ThumbnailKeyContains(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContains(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyHasPrefix applies the HasPrefix predicate on the "thumbnail_key" field.
+func ThumbnailKeyHasPrefix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasPrefix(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyHasSuffix applies the HasSuffix predicate on the "thumbnail_key" field.
+func ThumbnailKeyHasSuffix(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldHasSuffix(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyIsNil applies the IsNil predicate on the "thumbnail_key" field.
+func ThumbnailKeyIsNil() predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIsNull(FieldThumbnailKey))
+}
+
+// ThumbnailKeyNotNil applies the NotNil predicate on the "thumbnail_key" field.
+func ThumbnailKeyNotNil() predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotNull(FieldThumbnailKey))
+}
+
+// ThumbnailKeyEqualFold applies the EqualFold predicate on the "thumbnail_key" field.
+func ThumbnailKeyEqualFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEqualFold(FieldThumbnailKey, v))
+}
+
+// ThumbnailKeyContainsFold applies the ContainsFold predicate on the "thumbnail_key" field.
+func ThumbnailKeyContainsFold(v string) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldContainsFold(FieldThumbnailKey, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotNull(FieldHeight))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTicket applies the HasEdge predicate on the "ticket" edge.
+func HasTicket() predicate.TicketAttachment {
+	return predicate.TicketAttachment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTicketWith applies the HasEdge predicate on the "ticket" edge with a given conditions (other predicates).
+func HasTicketWith(preds ...predicate.Ticket) predicate.TicketAttachment {
+	return predicate.TicketAttachment(func(s *sql.Selector) {
+		step := newTicketStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.TicketAttachment {
+	return predicate.TicketAttachment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.TicketMessage) predicate.TicketAttachment {
+	return predicate.TicketAttachment(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TicketAttachment) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TicketAttachment) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TicketAttachment) predicate.TicketAttachment {
+	return predicate.TicketAttachment(sql.NotPredicates(p))
+}
@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
+)
+
+// TicketAttachmentCreate is the builder for creating a TicketAttachment entity.
+type TicketAttachmentCreate struct {
+	config
+	mutation *TicketAttachmentMutation
+	hooks    []Hook
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *TicketAttachmentCreate) SetTicketID(v int) *TicketAttachmentCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *TicketAttachmentCreate) SetMessageID(v int) *TicketAttachmentCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetUploaderUserID sets the "uploader_user_id" field.
+func (_c *TicketAttachmentCreate) SetUploaderUserID(v string) *TicketAttachmentCreate {
+	_c.mutation.SetUploaderUserID(v)
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *TicketAttachmentCreate) SetFileName(v string) *TicketAttachmentCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *TicketAttachmentCreate) SetContentType(v string) *TicketAttachmentCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *TicketAttachmentCreate) SetSizeBytes(v int64) *TicketAttachmentCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *TicketAttachmentCreate) SetSha256(v string) *TicketAttachmentCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *TicketAttachmentCreate) SetStorageKey(v string) *TicketAttachmentCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (_c *TicketAttachmentCreate) SetThumbnailKey(v string) *TicketAttachmentCreate {
+	_c.mutation.SetThumbnailKey(v)
+	return _c
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (_c *TicketAttachmentCreate) SetNillableThumbnailKey(v *string) *TicketAttachmentCreate {
+	if v != nil {
+		_c.SetThumbnailKey(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *TicketAttachmentCreate) SetWidth(v int) *TicketAttachmentCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *TicketAttachmentCreate) SetNillableWidth(v *int) *TicketAttachmentCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *TicketAttachmentCreate) SetHeight(v int) *TicketAttachmentCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *TicketAttachmentCreate) SetNillableHeight(v *int) *TicketAttachmentCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TicketAttachmentCreate) SetCreatedAt(v time.Time) *TicketAttachmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TicketAttachmentCreate) SetNillableCreatedAt(v *time.Time) *TicketAttachmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TicketAttachmentCreate) SetID(v string) *TicketAttachmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_c *TicketAttachmentCreate) SetTicket(v *Ticket) *TicketAttachmentCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// SetMessage sets the "message" edge to the TicketMessage entity.
+func (_c *TicketAttachmentCreate) SetMessage(v *TicketMessage) *TicketAttachmentCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// Mutation returns the TicketAttachmentMutation object of the builder.
+func (_c *TicketAttachmentCreate) Mutation() *TicketAttachmentMutation {
+	return _c.mutation
+}
+
+// Save creates the TicketAttachment in the database.
+func (_c *TicketAttachmentCreate) Save(ctx context.Context) (*TicketAttachment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TicketAttachmentCreate) SaveX(ctx context.Context) *TicketAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketAttachmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketAttachmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TicketAttachmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ticketattachment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TicketAttachmentCreate) check() error {
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`postgresql: missing required field "TicketAttachment.ticket_id"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`postgresql: missing required field "TicketAttachment.message_id"`)}
+	}
+	if _, ok := _c.mutation.UploaderUserID(); !ok {
+		return &ValidationError{Name: "uploader_user_id", err: errors.New(`postgresql: missing required field "TicketAttachment.uploader_user_id"`)}
+	}
+	if v, ok := _c.mutation.UploaderUserID(); ok {
+		if err := ticketattachment.UploaderUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "uploader_user_id", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.uploader_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`postgresql: missing required field "TicketAttachment.file_name"`)}
+	}
+	if v, ok := _c.mutation.FileName(); ok {
+		if err := ticketattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.file_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`postgresql: missing required field "TicketAttachment.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := ticketattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SizeBytes(); !ok {
+		return &ValidationError{Name: "size_bytes", err: errors.New(`postgresql: missing required field "TicketAttachment.size_bytes"`)}
+	}
+	if v, ok := _c.mutation.SizeBytes(); ok {
+		if err := ticketattachment.SizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.size_bytes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`postgresql: missing required field "TicketAttachment.sha256"`)}
+	}
+	if v, ok := _c.mutation.Sha256(); ok {
+		if err := ticketattachment.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.sha256": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`postgresql: missing required field "TicketAttachment.storage_key"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := ticketattachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ThumbnailKey(); ok {
+		if err := ticketattachment.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.thumbnail_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "TicketAttachment.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := ticketattachment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TicketIDs()) == 0 {
+		return &ValidationError{Name: "ticket", err: errors.New(`postgresql: missing required edge "TicketAttachment.ticket"`)}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`postgresql: missing required edge "TicketAttachment.message"`)}
+	}
+	return nil
+}
+
+func (_c *TicketAttachmentCreate) sqlSave(ctx context.Context) (*TicketAttachment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TicketAttachment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TicketAttachmentCreate) createSpec() (*TicketAttachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TicketAttachment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ticketattachment.Table, sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UploaderUserID(); ok {
+		_spec.SetField(ticketattachment.FieldUploaderUserID, field.TypeString, value)
+		_node.UploaderUserID = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(ticketattachment.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(ticketattachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(ticketattachment.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(ticketattachment.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(ticketattachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.ThumbnailKey(); ok {
+		_spec.SetField(ticketattachment.FieldThumbnailKey, field.TypeString, value)
+		_node.ThumbnailKey = &value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(ticketattachment.FieldWidth, field.TypeInt, value)
+		_node.Width = &value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(ticketattachment.FieldHeight, field.TypeInt, value)
+		_node.Height = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketattachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketattachment.TicketTable,
+			Columns: []string{ticketattachment.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TicketID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketattachment.MessageTable,
+			Columns: []string{ticketattachment.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketmessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TicketAttachmentCreateBulk is the builder for creating many TicketAttachment entities in bulk.
+type TicketAttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*TicketAttachmentCreate
+}
+
+// Save creates the TicketAttachment entities in the database.
+func (_c *TicketAttachmentCreateBulk) Save(ctx context.Context) ([]*TicketAttachment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TicketAttachment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TicketAttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TicketAttachmentCreateBulk) SaveX(ctx context.Context) []*TicketAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketAttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketAttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
+)
+
+// TicketAttachmentUpdate is the builder for updating TicketAttachment entities.
+type TicketAttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TicketAttachmentMutation
+}
+
+// Where appends a list predicates to the TicketAttachmentUpdate builder.
+func (_u *TicketAttachmentUpdate) Where(ps ...predicate.TicketAttachment) *TicketAttachmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *TicketAttachmentUpdate) SetFileName(v string) *TicketAttachmentUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableFileName(v *string) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *TicketAttachmentUpdate) SetContentType(v string) *TicketAttachmentUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableContentType(v *string) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *TicketAttachmentUpdate) SetSizeBytes(v int64) *TicketAttachmentUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableSizeBytes(v *int64) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *TicketAttachmentUpdate) AddSizeBytes(v int64) *TicketAttachmentUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *TicketAttachmentUpdate) SetSha256(v string) *TicketAttachmentUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableSha256(v *string) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (_u *TicketAttachmentUpdate) SetThumbnailKey(v string) *TicketAttachmentUpdate {
+	_u.mutation.SetThumbnailKey(v)
+	return _u
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableThumbnailKey(v *string) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetThumbnailKey(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (_u *TicketAttachmentUpdate) ClearThumbnailKey() *TicketAttachmentUpdate {
+	_u.mutation.ClearThumbnailKey()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *TicketAttachmentUpdate) SetWidth(v int) *TicketAttachmentUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableWidth(v *int) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *TicketAttachmentUpdate) AddWidth(v int) *TicketAttachmentUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *TicketAttachmentUpdate) ClearWidth() *TicketAttachmentUpdate {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *TicketAttachmentUpdate) SetHeight(v int) *TicketAttachmentUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *TicketAttachmentUpdate) SetNillableHeight(v *int) *TicketAttachmentUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *TicketAttachmentUpdate) AddHeight(v int) *TicketAttachmentUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *TicketAttachmentUpdate) ClearHeight() *TicketAttachmentUpdate {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// Mutation returns the TicketAttachmentMutation object of the builder.
+func (_u *TicketAttachmentUpdate) Mutation() *TicketAttachmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TicketAttachmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketAttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TicketAttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketAttachmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketAttachmentUpdate) check() error {
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := ticketattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := ticketattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeBytes(); ok {
+		if err := ticketattachment.SizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.size_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := ticketattachment.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThumbnailKey(); ok {
+		if err := ticketattachment.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.thumbnail_key": %w`, err)}
+		}
+	}
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`postgresql: clearing a required unique edge "TicketAttachment.ticket"`)
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`postgresql: clearing a required unique edge "TicketAttachment.message"`)
+	}
+	return nil
+}
+
+func (_u *TicketAttachmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketattachment.Table, ticketattachment.Columns, sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(ticketattachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(ticketattachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(ticketattachment.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(ticketattachment.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(ticketattachment.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailKey(); ok {
+		_spec.SetField(ticketattachment.FieldThumbnailKey, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailKeyCleared() {
+		_spec.ClearField(ticketattachment.FieldThumbnailKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(ticketattachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(ticketattachment.FieldWidth, field.TypeInt, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(ticketattachment.FieldWidth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(ticketattachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(ticketattachment.FieldHeight, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(ticketattachment.FieldHeight, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TicketAttachmentUpdateOne is the builder for updating a single TicketAttachment entity.
+type TicketAttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TicketAttachmentMutation
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *TicketAttachmentUpdateOne) SetFileName(v string) *TicketAttachmentUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableFileName(v *string) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *TicketAttachmentUpdateOne) SetContentType(v string) *TicketAttachmentUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableContentType(v *string) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *TicketAttachmentUpdateOne) SetSizeBytes(v int64) *TicketAttachmentUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableSizeBytes(v *int64) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *TicketAttachmentUpdateOne) AddSizeBytes(v int64) *TicketAttachmentUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *TicketAttachmentUpdateOne) SetSha256(v string) *TicketAttachmentUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableSha256(v *string) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (_u *TicketAttachmentUpdateOne) SetThumbnailKey(v string) *TicketAttachmentUpdateOne {
+	_u.mutation.SetThumbnailKey(v)
+	return _u
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableThumbnailKey(v *string) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetThumbnailKey(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (_u *TicketAttachmentUpdateOne) ClearThumbnailKey() *TicketAttachmentUpdateOne {
+	_u.mutation.ClearThumbnailKey()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *TicketAttachmentUpdateOne) SetWidth(v int) *TicketAttachmentUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableWidth(v *int) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *TicketAttachmentUpdateOne) AddWidth(v int) *TicketAttachmentUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *TicketAttachmentUpdateOne) ClearWidth() *TicketAttachmentUpdateOne {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *TicketAttachmentUpdateOne) SetHeight(v int) *TicketAttachmentUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *TicketAttachmentUpdateOne) SetNillableHeight(v *int) *TicketAttachmentUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *TicketAttachmentUpdateOne) AddHeight(v int) *TicketAttachmentUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *TicketAttachmentUpdateOne) ClearHeight() *TicketAttachmentUpdateOne {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// Mutation returns the TicketAttachmentMutation object of the builder.
+func (_u *TicketAttachmentUpdateOne) Mutation() *TicketAttachmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TicketAttachmentUpdate builder.
+func (_u *TicketAttachmentUpdateOne) Where(ps ...predicate.TicketAttachment) *TicketAttachmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TicketAttachmentUpdateOne) Select(field string, fields ...string) *TicketAttachmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TicketAttachment entity.
+func (_u *TicketAttachmentUpdateOne) Save(ctx context.Context) (*TicketAttachment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketAttachmentUpdateOne) SaveX(ctx context.Context) *TicketAttachment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TicketAttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketAttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketAttachmentUpdateOne) check() error {
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := ticketattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := ticketattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeBytes(); ok {
+		if err := ticketattachment.SizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.size_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := ticketattachment.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThumbnailKey(); ok {
+		if err := ticketattachment.ThumbnailKeyValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_key", err: fmt.Errorf(`postgresql: validator failed for field "TicketAttachment.thumbnail_key": %w`, err)}
+		}
+	}
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`postgresql: clearing a required unique edge "TicketAttachment.ticket"`)
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`postgresql: clearing a required unique edge "TicketAttachment.message"`)
+	}
+	return nil
+}
+
+func (_u *TicketAttachmentUpdateOne) sqlSave(ctx context.Context) (_node *TicketAttachment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketattachment.Table, ticketattachment.Columns, sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "TicketAttachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ticketattachment.FieldID)
+		for _, f := range fields {
+			if !ticketattachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != ticketattachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(ticketattachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(ticketattachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(ticketattachment.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(ticketattachment.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(ticketattachment.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailKey(); ok {
+		_spec.SetField(ticketattachment.FieldThumbnailKey, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailKeyCleared() {
+		_spec.ClearField(ticketattachment.FieldThumbnailKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(ticketattachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(ticketattachment.FieldWidth, field.TypeInt, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(ticketattachment.FieldWidth, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(ticketattachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(ticketattachment.FieldHeight, field.TypeInt, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(ticketattachment.FieldHeight, field.TypeInt)
+	}
+	_node = &TicketAttachment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
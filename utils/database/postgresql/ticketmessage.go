@@ -40,9 +40,11 @@ type TicketMessage struct {
 type TicketMessageEdges struct {
 	// Ticket holds the value of the ticket edge.
 	Ticket *Ticket `json:"ticket,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*TicketAttachment `json:"attachments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TicketOrErr returns the Ticket value or an error if the edge
@@ -56,6 +58,15 @@ func (e TicketMessageEdges) TicketOrErr() (*Ticket, error) {
 	return nil, &NotLoadedError{edge: "ticket"}
 }
 
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketMessageEdges) AttachmentsOrErr() ([]*TicketAttachment, error) {
+	if e.loadedTypes[1] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TicketMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -145,6 +156,11 @@ func (_m *TicketMessage) QueryTicket() *TicketQuery {
 	return NewTicketMessageClient(_m.config).QueryTicket(_m)
 }
 
+// QueryAttachments queries the "attachments" edge of the TicketMessage entity.
+func (_m *TicketMessage) QueryAttachments() *TicketAttachmentQuery {
+	return NewTicketMessageClient(_m.config).QueryAttachments(_m)
+}
+
 // Update returns a builder for updating this TicketMessage.
 // Note that you need to call TicketMessage.Unwrap() before calling this method if this TicketMessage
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 )
 
@@ -94,6 +95,21 @@ func (_c *TicketMessageCreate) SetTicket(v *Ticket) *TicketMessageCreate {
 	return _c.SetTicketID(v.ID)
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the TicketAttachment entity by IDs.
+func (_c *TicketMessageCreate) AddAttachmentIDs(ids ...string) *TicketMessageCreate {
+	_c.mutation.AddAttachmentIDs(ids...)
+	return _c
+}
+
+// AddAttachments adds the "attachments" edges to the TicketAttachment entity.
+func (_c *TicketMessageCreate) AddAttachments(v ...*TicketAttachment) *TicketMessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TicketMessageMutation object of the builder.
 func (_c *TicketMessageCreate) Mutation() *TicketMessageMutation {
 	return _c.mutation
@@ -241,6 +257,22 @@ func (_c *TicketMessageCreate) createSpec() (*TicketMessage, *sqlgraph.CreateSpe
 		_node.TicketID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
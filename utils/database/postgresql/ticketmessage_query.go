@@ -4,6 +4,7 @@ package postgresql
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -13,17 +14,19 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 )
 
 // TicketMessageQuery is the builder for querying TicketMessage entities.
 type TicketMessageQuery struct {
 	config
-	ctx        *QueryContext
-	order      []ticketmessage.OrderOption
-	inters     []Interceptor
-	predicates []predicate.TicketMessage
-	withTicket *TicketQuery
+	ctx             *QueryContext
+	order           []ticketmessage.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TicketMessage
+	withTicket      *TicketQuery
+	withAttachments *TicketAttachmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +85,28 @@ func (_q *TicketMessageQuery) QueryTicket() *TicketQuery {
 	return query
 }
 
+// QueryAttachments chains the current query on the "attachments" edge.
+func (_q *TicketMessageQuery) QueryAttachments() *TicketAttachmentQuery {
+	query := (&TicketAttachmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticketmessage.Table, ticketmessage.FieldID, selector),
+			sqlgraph.To(ticketattachment.Table, ticketattachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticketmessage.AttachmentsTable, ticketmessage.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TicketMessage entity from the query.
 // Returns a *NotFoundError when no TicketMessage was found.
 func (_q *TicketMessageQuery) First(ctx context.Context) (*TicketMessage, error) {
@@ -269,12 +294,13 @@ func (_q *TicketMessageQuery) Clone() *TicketMessageQuery {
 		return nil
 	}
 	return &TicketMessageQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]ticketmessage.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.TicketMessage{}, _q.predicates...),
-		withTicket: _q.withTicket.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]ticketmessage.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.TicketMessage{}, _q.predicates...),
+		withTicket:      _q.withTicket.Clone(),
+		withAttachments: _q.withAttachments.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -292,6 +318,17 @@ func (_q *TicketMessageQuery) WithTicket(opts ...func(*TicketQuery)) *TicketMess
 	return _q
 }
 
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TicketMessageQuery) WithAttachments(opts ...func(*TicketAttachmentQuery)) *TicketMessageQuery {
+	query := (&TicketAttachmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttachments = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +407,9 @@ func (_q *TicketMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*TicketMessage{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withTicket != nil,
+			_q.withAttachments != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -398,6 +436,13 @@ func (_q *TicketMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withAttachments; query != nil {
+		if err := _q.loadAttachments(ctx, query, nodes,
+			func(n *TicketMessage) { n.Edges.Attachments = []*TicketAttachment{} },
+			func(n *TicketMessage, e *TicketAttachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,6 +475,36 @@ func (_q *TicketMessageQuery) loadTicket(ctx context.Context, query *TicketQuery
 	}
 	return nil
 }
+func (_q *TicketMessageQuery) loadAttachments(ctx context.Context, query *TicketAttachmentQuery, nodes []*TicketMessage, init func(*TicketMessage), assign func(*TicketMessage, *TicketAttachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*TicketMessage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ticketattachment.FieldMessageID)
+	}
+	query.Where(predicate.TicketAttachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ticketmessage.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TicketMessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticket"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketattachment"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/ticketmessage"
 )
 
@@ -124,6 +125,21 @@ func (_u *TicketMessageUpdate) SetTicket(v *Ticket) *TicketMessageUpdate {
 	return _u.SetTicketID(v.ID)
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the TicketAttachment entity by IDs.
+func (_u *TicketMessageUpdate) AddAttachmentIDs(ids ...string) *TicketMessageUpdate {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the TicketAttachment entity.
+func (_u *TicketMessageUpdate) AddAttachments(v ...*TicketAttachment) *TicketMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TicketMessageMutation object of the builder.
 func (_u *TicketMessageUpdate) Mutation() *TicketMessageMutation {
 	return _u.mutation
@@ -135,6 +151,27 @@ func (_u *TicketMessageUpdate) ClearTicket() *TicketMessageUpdate {
 	return _u
 }
 
+// ClearAttachments clears all "attachments" edges to the TicketAttachment entity.
+func (_u *TicketMessageUpdate) ClearAttachments() *TicketMessageUpdate {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to TicketAttachment entities by IDs.
+func (_u *TicketMessageUpdate) RemoveAttachmentIDs(ids ...string) *TicketMessageUpdate {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to TicketAttachment entities.
+func (_u *TicketMessageUpdate) RemoveAttachments(v ...*TicketAttachment) *TicketMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TicketMessageUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -244,6 +281,51 @@ func (_u *TicketMessageUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{ticketmessage.Label}
@@ -359,6 +441,21 @@ func (_u *TicketMessageUpdateOne) SetTicket(v *Ticket) *TicketMessageUpdateOne {
 	return _u.SetTicketID(v.ID)
 }
 
+// AddAttachmentIDs adds the "attachments" edge to the TicketAttachment entity by IDs.
+func (_u *TicketMessageUpdateOne) AddAttachmentIDs(ids ...string) *TicketMessageUpdateOne {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the TicketAttachment entity.
+func (_u *TicketMessageUpdateOne) AddAttachments(v ...*TicketAttachment) *TicketMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TicketMessageMutation object of the builder.
 func (_u *TicketMessageUpdateOne) Mutation() *TicketMessageMutation {
 	return _u.mutation
@@ -370,6 +467,27 @@ func (_u *TicketMessageUpdateOne) ClearTicket() *TicketMessageUpdateOne {
 	return _u
 }
 
+// ClearAttachments clears all "attachments" edges to the TicketAttachment entity.
+func (_u *TicketMessageUpdateOne) ClearAttachments() *TicketMessageUpdateOne {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to TicketAttachment entities by IDs.
+func (_u *TicketMessageUpdateOne) RemoveAttachmentIDs(ids ...string) *TicketMessageUpdateOne {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to TicketAttachment entities.
+func (_u *TicketMessageUpdateOne) RemoveAttachments(v ...*TicketAttachment) *TicketMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
 // Where appends a list predicates to the TicketMessageUpdate builder.
 func (_u *TicketMessageUpdateOne) Where(ps ...predicate.TicketMessage) *TicketMessageUpdateOne {
 	_u.mutation.Where(ps...)
@@ -509,6 +627,51 @@ func (_u *TicketMessageUpdateOne) sqlSave(ctx context.Context) (_node *TicketMes
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketmessage.AttachmentsTable,
+			Columns: []string{ticketmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketattachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TicketMessage{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
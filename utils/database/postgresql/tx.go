@@ -12,8 +12,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
 	// AuthorizeSocialPlatformInfo is the client for interacting with the AuthorizeSocialPlatformInfo builders.
 	AuthorizeSocialPlatformInfo *AuthorizeSocialPlatformInfoClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// DataProviderOutbox is the client for interacting with the DataProviderOutbox builders.
+	DataProviderOutbox *DataProviderOutboxClient
+	// EmailMessage is the client for interacting with the EmailMessage builders.
+	EmailMessage *EmailMessageClient
+	// EmailSuppression is the client for interacting with the EmailSuppression builders.
+	EmailSuppression *EmailSuppressionClient
 	// FriendLink is the client for interacting with the FriendLink builders.
 	FriendLink *FriendLinkClient
 	// GameAccountBinding is the client for interacting with the GameAccountBinding builders.
@@ -26,6 +36,8 @@ type Tx struct {
 	GroupList *GroupListClient
 	// IOSScriptCode is the client for interacting with the IOSScriptCode builders.
 	IOSScriptCode *IOSScriptCodeClient
+	// InheritJob is the client for interacting with the InheritJob builders.
+	InheritJob *InheritJobClient
 	// OAuth2ClientWebhookEndpoint is the client for interacting with the OAuth2ClientWebhookEndpoint builders.
 	OAuth2ClientWebhookEndpoint *OAuth2ClientWebhookEndpointClient
 	// RiskEvent is the client for interacting with the RiskEvent builders.
@@ -40,12 +52,24 @@ type Tx struct {
 	SystemLog *SystemLogClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
+	// TicketAttachment is the client for interacting with the TicketAttachment builders.
+	TicketAttachment *TicketAttachmentClient
 	// TicketMessage is the client for interacting with the TicketMessage builders.
 	TicketMessage *TicketMessageClient
 	// UploadLog is the client for interacting with the UploadLog builders.
 	UploadLog *UploadLogClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserRecoveryCode is the client for interacting with the UserRecoveryCode builders.
+	UserRecoveryCode *UserRecoveryCodeClient
+	// UserTOTP is the client for interacting with the UserTOTP builders.
+	UserTOTP *UserTOTPClient
+	// WebAuthnCredential is the client for interacting with the WebAuthnCredential builders.
+	WebAuthnCredential *WebAuthnCredentialClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookDeliveryAttempt is the client for interacting with the WebhookDeliveryAttempt builders.
+	WebhookDeliveryAttempt *WebhookDeliveryAttemptClient
 	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
 	WebhookEndpoint *WebhookEndpointClient
 	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
@@ -181,13 +205,19 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccountDeletion = NewAccountDeletionClient(tx.config)
 	tx.AuthorizeSocialPlatformInfo = NewAuthorizeSocialPlatformInfoClient(tx.config)
+	tx.DataExport = NewDataExportClient(tx.config)
+	tx.DataProviderOutbox = NewDataProviderOutboxClient(tx.config)
+	tx.EmailMessage = NewEmailMessageClient(tx.config)
+	tx.EmailSuppression = NewEmailSuppressionClient(tx.config)
 	tx.FriendLink = NewFriendLinkClient(tx.config)
 	tx.GameAccountBinding = NewGameAccountBindingClient(tx.config)
 	tx.GameAccountDataGrant = NewGameAccountDataGrantClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.GroupList = NewGroupListClient(tx.config)
 	tx.IOSScriptCode = NewIOSScriptCodeClient(tx.config)
+	tx.InheritJob = NewInheritJobClient(tx.config)
 	tx.OAuth2ClientWebhookEndpoint = NewOAuth2ClientWebhookEndpointClient(tx.config)
 	tx.RiskEvent = NewRiskEventClient(tx.config)
 	tx.RiskRule = NewRiskRuleClient(tx.config)
@@ -195,9 +225,15 @@ func (tx *Tx) init() {
 	tx.Sponsor = NewSponsorClient(tx.config)
 	tx.SystemLog = NewSystemLogClient(tx.config)
 	tx.Ticket = NewTicketClient(tx.config)
+	tx.TicketAttachment = NewTicketAttachmentClient(tx.config)
 	tx.TicketMessage = NewTicketMessageClient(tx.config)
 	tx.UploadLog = NewUploadLogClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserRecoveryCode = NewUserRecoveryCodeClient(tx.config)
+	tx.UserTOTP = NewUserTOTPClient(tx.config)
+	tx.WebAuthnCredential = NewWebAuthnCredentialClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookDeliveryAttempt = NewWebhookDeliveryAttemptClient(tx.config)
 	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
 	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
@@ -209,7 +245,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuthorizeSocialPlatformInfo.QueryXXX(), the query will be executed
+// applies a query, for example: AccountDeletion.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -35,6 +35,10 @@ type User struct {
 	Banned bool `json:"banned,omitempty"`
 	// BanReason holds the value of the "ban_reason" field.
 	BanReason *string `json:"ban_reason,omitempty"`
+	// BanExpiresAt holds the value of the "ban_expires_at" field.
+	BanExpiresAt *time.Time `json:"ban_expires_at,omitempty"`
+	// UploadBlockedUntil holds the value of the "upload_blocked_until" field.
+	UploadBlockedUntil *time.Time `json:"upload_blocked_until,omitempty"`
 	// KratosIdentityID holds the value of the "kratos_identity_id" field.
 	KratosIdentityID *string `json:"kratos_identity_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -131,7 +135,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldName, user.FieldEmail, user.FieldAvatarPath, user.FieldRole, user.FieldBanReason, user.FieldKratosIdentityID:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldBanExpiresAt, user.FieldUploadBlockedUntil, user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -204,6 +208,20 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.BanReason = new(string)
 				*_m.BanReason = value.String
 			}
+		case user.FieldBanExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ban_expires_at", values[i])
+			} else if value.Valid {
+				_m.BanExpiresAt = new(time.Time)
+				*_m.BanExpiresAt = value.Time
+			}
+		case user.FieldUploadBlockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_blocked_until", values[i])
+			} else if value.Valid {
+				_m.UploadBlockedUntil = new(time.Time)
+				*_m.UploadBlockedUntil = value.Time
+			}
 		case user.FieldKratosIdentityID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field kratos_identity_id", values[i])
@@ -312,6 +330,16 @@ func (_m *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.BanExpiresAt; v != nil {
+		builder.WriteString("ban_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UploadBlockedUntil; v != nil {
+		builder.WriteString("upload_blocked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.KratosIdentityID; v != nil {
 		builder.WriteString("kratos_identity_id=")
 		builder.WriteString(*v)
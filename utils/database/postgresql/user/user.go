@@ -30,6 +30,10 @@ const (
 	FieldBanned = "banned"
 	// FieldBanReason holds the string denoting the ban_reason field in the database.
 	FieldBanReason = "ban_reason"
+	// FieldBanExpiresAt holds the string denoting the ban_expires_at field in the database.
+	FieldBanExpiresAt = "ban_expires_at"
+	// FieldUploadBlockedUntil holds the string denoting the upload_blocked_until field in the database.
+	FieldUploadBlockedUntil = "upload_blocked_until"
 	// FieldKratosIdentityID holds the string denoting the kratos_identity_id field in the database.
 	FieldKratosIdentityID = "kratos_identity_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -103,6 +107,8 @@ var Columns = []string{
 	FieldRole,
 	FieldBanned,
 	FieldBanReason,
+	FieldBanExpiresAt,
+	FieldUploadBlockedUntil,
 	FieldKratosIdentityID,
 	FieldCreatedAt,
 }
@@ -203,6 +209,16 @@ func ByBanReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBanReason, opts...).ToFunc()
 }
 
+// ByBanExpiresAt orders the results by the ban_expires_at field.
+func ByBanExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBanExpiresAt, opts...).ToFunc()
+}
+
+// ByUploadBlockedUntil orders the results by the upload_blocked_until field.
+func ByUploadBlockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadBlockedUntil, opts...).ToFunc()
+}
+
 // ByKratosIdentityID orders the results by the kratos_identity_id field.
 func ByKratosIdentityID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKratosIdentityID, opts...).ToFunc()
@@ -100,6 +100,16 @@ func BanReason(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBanReason, v))
 }
 
+// BanExpiresAt applies equality check predicate on the "ban_expires_at" field. It's identical to BanExpiresAtEQ.
+func BanExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBanExpiresAt, v))
+}
+
+// UploadBlockedUntil applies equality check predicate on the "upload_blocked_until" field. It's identical to UploadBlockedUntilEQ.
+func UploadBlockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUploadBlockedUntil, v))
+}
+
 // KratosIdentityID applies equality check predicate on the "kratos_identity_id" field. It's identical to KratosIdentityIDEQ.
 func KratosIdentityID(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldKratosIdentityID, v))
@@ -440,6 +450,106 @@ func BanReasonContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldBanReason, v))
 }
 
+// BanExpiresAtEQ applies the EQ predicate on the "ban_expires_at" field.
+func BanExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtNEQ applies the NEQ predicate on the "ban_expires_at" field.
+func BanExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtIn applies the In predicate on the "ban_expires_at" field.
+func BanExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBanExpiresAt, vs...))
+}
+
+// BanExpiresAtNotIn applies the NotIn predicate on the "ban_expires_at" field.
+func BanExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBanExpiresAt, vs...))
+}
+
+// BanExpiresAtGT applies the GT predicate on the "ban_expires_at" field.
+func BanExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtGTE applies the GTE predicate on the "ban_expires_at" field.
+func BanExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtLT applies the LT predicate on the "ban_expires_at" field.
+func BanExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtLTE applies the LTE predicate on the "ban_expires_at" field.
+func BanExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtIsNil applies the IsNil predicate on the "ban_expires_at" field.
+func BanExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBanExpiresAt))
+}
+
+// BanExpiresAtNotNil applies the NotNil predicate on the "ban_expires_at" field.
+func BanExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBanExpiresAt))
+}
+
+// UploadBlockedUntilEQ applies the EQ predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUploadBlockedUntil, v))
+}
+
+// UploadBlockedUntilNEQ applies the NEQ predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUploadBlockedUntil, v))
+}
+
+// UploadBlockedUntilIn applies the In predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUploadBlockedUntil, vs...))
+}
+
+// UploadBlockedUntilNotIn applies the NotIn predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUploadBlockedUntil, vs...))
+}
+
+// UploadBlockedUntilGT applies the GT predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUploadBlockedUntil, v))
+}
+
+// UploadBlockedUntilGTE applies the GTE predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUploadBlockedUntil, v))
+}
+
+// UploadBlockedUntilLT applies the LT predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUploadBlockedUntil, v))
+}
+
+// UploadBlockedUntilLTE applies the LTE predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUploadBlockedUntil, v))
+}
+
+// UploadBlockedUntilIsNil applies the IsNil predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUploadBlockedUntil))
+}
+
+// UploadBlockedUntilNotNil applies the NotNil predicate on the "upload_blocked_until" field.
+func UploadBlockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUploadBlockedUntil))
+}
+
 // KratosIdentityIDEQ applies the EQ predicate on the "kratos_identity_id" field.
 func KratosIdentityIDEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldKratosIdentityID, v))
@@ -121,6 +121,34 @@ func (_c *UserCreate) SetNillableBanReason(v *string) *UserCreate {
 	return _c
 }
 
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (_c *UserCreate) SetBanExpiresAt(v time.Time) *UserCreate {
+	_c.mutation.SetBanExpiresAt(v)
+	return _c
+}
+
+// SetNillableBanExpiresAt sets the "ban_expires_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableBanExpiresAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetBanExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetUploadBlockedUntil sets the "upload_blocked_until" field.
+func (_c *UserCreate) SetUploadBlockedUntil(v time.Time) *UserCreate {
+	_c.mutation.SetUploadBlockedUntil(v)
+	return _c
+}
+
+// SetNillableUploadBlockedUntil sets the "upload_blocked_until" field if the given value is not nil.
+func (_c *UserCreate) SetNillableUploadBlockedUntil(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetUploadBlockedUntil(*v)
+	}
+	return _c
+}
+
 // SetKratosIdentityID sets the "kratos_identity_id" field.
 func (_c *UserCreate) SetKratosIdentityID(v string) *UserCreate {
 	_c.mutation.SetKratosIdentityID(v)
@@ -403,6 +431,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldBanReason, field.TypeString, value)
 		_node.BanReason = &value
 	}
+	if value, ok := _c.mutation.BanExpiresAt(); ok {
+		_spec.SetField(user.FieldBanExpiresAt, field.TypeTime, value)
+		_node.BanExpiresAt = &value
+	}
+	if value, ok := _c.mutation.UploadBlockedUntil(); ok {
+		_spec.SetField(user.FieldUploadBlockedUntil, field.TypeTime, value)
+		_node.UploadBlockedUntil = &value
+	}
 	if value, ok := _c.mutation.KratosIdentityID(); ok {
 		_spec.SetField(user.FieldKratosIdentityID, field.TypeString, value)
 		_node.KratosIdentityID = &value
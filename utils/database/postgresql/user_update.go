@@ -157,6 +157,46 @@ func (_u *UserUpdate) ClearBanReason() *UserUpdate {
 	return _u
 }
 
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (_u *UserUpdate) SetBanExpiresAt(v time.Time) *UserUpdate {
+	_u.mutation.SetBanExpiresAt(v)
+	return _u
+}
+
+// SetNillableBanExpiresAt sets the "ban_expires_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBanExpiresAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetBanExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearBanExpiresAt clears the value of the "ban_expires_at" field.
+func (_u *UserUpdate) ClearBanExpiresAt() *UserUpdate {
+	_u.mutation.ClearBanExpiresAt()
+	return _u
+}
+
+// SetUploadBlockedUntil sets the "upload_blocked_until" field.
+func (_u *UserUpdate) SetUploadBlockedUntil(v time.Time) *UserUpdate {
+	_u.mutation.SetUploadBlockedUntil(v)
+	return _u
+}
+
+// SetNillableUploadBlockedUntil sets the "upload_blocked_until" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableUploadBlockedUntil(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetUploadBlockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearUploadBlockedUntil clears the value of the "upload_blocked_until" field.
+func (_u *UserUpdate) ClearUploadBlockedUntil() *UserUpdate {
+	_u.mutation.ClearUploadBlockedUntil()
+	return _u
+}
+
 // SetKratosIdentityID sets the "kratos_identity_id" field.
 func (_u *UserUpdate) SetKratosIdentityID(v string) *UserUpdate {
 	_u.mutation.SetKratosIdentityID(v)
@@ -475,6 +515,18 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.BanReasonCleared() {
 		_spec.ClearField(user.FieldBanReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.BanExpiresAt(); ok {
+		_spec.SetField(user.FieldBanExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.BanExpiresAtCleared() {
+		_spec.ClearField(user.FieldBanExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UploadBlockedUntil(); ok {
+		_spec.SetField(user.FieldUploadBlockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.UploadBlockedUntilCleared() {
+		_spec.ClearField(user.FieldUploadBlockedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.KratosIdentityID(); ok {
 		_spec.SetField(user.FieldKratosIdentityID, field.TypeString, value)
 	}
@@ -869,6 +921,46 @@ func (_u *UserUpdateOne) ClearBanReason() *UserUpdateOne {
 	return _u
 }
 
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (_u *UserUpdateOne) SetBanExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetBanExpiresAt(v)
+	return _u
+}
+
+// SetNillableBanExpiresAt sets the "ban_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBanExpiresAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetBanExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearBanExpiresAt clears the value of the "ban_expires_at" field.
+func (_u *UserUpdateOne) ClearBanExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearBanExpiresAt()
+	return _u
+}
+
+// SetUploadBlockedUntil sets the "upload_blocked_until" field.
+func (_u *UserUpdateOne) SetUploadBlockedUntil(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUploadBlockedUntil(v)
+	return _u
+}
+
+// SetNillableUploadBlockedUntil sets the "upload_blocked_until" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUploadBlockedUntil(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetUploadBlockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearUploadBlockedUntil clears the value of the "upload_blocked_until" field.
+func (_u *UserUpdateOne) ClearUploadBlockedUntil() *UserUpdateOne {
+	_u.mutation.ClearUploadBlockedUntil()
+	return _u
+}
+
 // SetKratosIdentityID sets the "kratos_identity_id" field.
 func (_u *UserUpdateOne) SetKratosIdentityID(v string) *UserUpdateOne {
 	_u.mutation.SetKratosIdentityID(v)
@@ -1217,6 +1309,18 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.BanReasonCleared() {
 		_spec.ClearField(user.FieldBanReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.BanExpiresAt(); ok {
+		_spec.SetField(user.FieldBanExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.BanExpiresAtCleared() {
+		_spec.ClearField(user.FieldBanExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UploadBlockedUntil(); ok {
+		_spec.SetField(user.FieldUploadBlockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.UploadBlockedUntilCleared() {
+		_spec.ClearField(user.FieldUploadBlockedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.KratosIdentityID(); ok {
 		_spec.SetField(user.FieldKratosIdentityID, field.TypeString, value)
 	}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/usertotp"
+)
+
+// UserTOTPCreate is the builder for creating a UserTOTP entity.
+type UserTOTPCreate struct {
+	config
+	mutation *UserTOTPMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserTOTPCreate) SetUserID(v string) *UserTOTPCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *UserTOTPCreate) SetSecret(v string) *UserTOTPCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_c *UserTOTPCreate) SetConfirmedAt(v time.Time) *UserTOTPCreate {
+	_c.mutation.SetConfirmedAt(v)
+	return _c
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_c *UserTOTPCreate) SetNillableConfirmedAt(v *time.Time) *UserTOTPCreate {
+	if v != nil {
+		_c.SetConfirmedAt(*v)
+	}
+	return _c
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_c *UserTOTPCreate) SetLastUsedStep(v int64) *UserTOTPCreate {
+	_c.mutation.SetLastUsedStep(v)
+	return _c
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_c *UserTOTPCreate) SetNillableLastUsedStep(v *int64) *UserTOTPCreate {
+	if v != nil {
+		_c.SetLastUsedStep(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserTOTPCreate) SetCreatedAt(v time.Time) *UserTOTPCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserTOTPCreate) SetNillableCreatedAt(v *time.Time) *UserTOTPCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserTOTPCreate) SetUpdatedAt(v time.Time) *UserTOTPCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserTOTPCreate) SetNillableUpdatedAt(v *time.Time) *UserTOTPCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UserTOTPMutation object of the builder.
+func (_c *UserTOTPCreate) Mutation() *UserTOTPMutation {
+	return _c.mutation
+}
+
+// Save creates the UserTOTP in the database.
+func (_c *UserTOTPCreate) Save(ctx context.Context) (*UserTOTP, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserTOTPCreate) SaveX(ctx context.Context) *UserTOTP {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserTOTPCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserTOTPCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserTOTPCreate) defaults() {
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		v := usertotp.DefaultLastUsedStep
+		_c.mutation.SetLastUsedStep(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := usertotp.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := usertotp.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserTOTPCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`postgresql: missing required field "UserTOTP.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := usertotp.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`postgresql: validator failed for field "UserTOTP.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`postgresql: missing required field "UserTOTP.secret"`)}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := usertotp.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`postgresql: validator failed for field "UserTOTP.secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`postgresql: missing required field "UserTOTP.last_used_step"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "UserTOTP.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`postgresql: missing required field "UserTOTP.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *UserTOTPCreate) sqlSave(ctx context.Context) (*UserTOTP, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserTOTPCreate) createSpec() (*UserTOTP, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserTOTP{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usertotp.Table, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(usertotp.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.ConfirmedAt(); ok {
+		_spec.SetField(usertotp.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = &value
+	}
+	if value, ok := _c.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usertotp.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserTOTPCreateBulk is the builder for creating many UserTOTP entities in bulk.
+type UserTOTPCreateBulk struct {
+	config
+	err      error
+	builders []*UserTOTPCreate
+}
+
+// Save creates the UserTOTP entities in the database.
+func (_c *UserTOTPCreateBulk) Save(ctx context.Context) ([]*UserTOTP, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserTOTP, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserTOTPMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserTOTPCreateBulk) SaveX(ctx context.Context) []*UserTOTP {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserTOTPCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserTOTPCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
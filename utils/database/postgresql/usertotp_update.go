@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/usertotp"
+)
+
+// UserTOTPUpdate is the builder for updating UserTOTP entities.
+type UserTOTPUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserTOTPMutation
+}
+
+// Where appends a list predicates to the UserTOTPUpdate builder.
+func (_u *UserTOTPUpdate) Where(ps ...predicate.UserTOTP) *UserTOTPUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *UserTOTPUpdate) SetSecret(v string) *UserTOTPUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *UserTOTPUpdate) SetNillableSecret(v *string) *UserTOTPUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_u *UserTOTPUpdate) SetConfirmedAt(v time.Time) *UserTOTPUpdate {
+	_u.mutation.SetConfirmedAt(v)
+	return _u
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_u *UserTOTPUpdate) SetNillableConfirmedAt(v *time.Time) *UserTOTPUpdate {
+	if v != nil {
+		_u.SetConfirmedAt(*v)
+	}
+	return _u
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (_u *UserTOTPUpdate) ClearConfirmedAt() *UserTOTPUpdate {
+	_u.mutation.ClearConfirmedAt()
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *UserTOTPUpdate) SetLastUsedStep(v int64) *UserTOTPUpdate {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *UserTOTPUpdate) SetNillableLastUsedStep(v *int64) *UserTOTPUpdate {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *UserTOTPUpdate) AddLastUsedStep(v int64) *UserTOTPUpdate {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserTOTPUpdate) SetCreatedAt(v time.Time) *UserTOTPUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserTOTPUpdate) SetNillableCreatedAt(v *time.Time) *UserTOTPUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserTOTPUpdate) SetUpdatedAt(v time.Time) *UserTOTPUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UserTOTPMutation object of the builder.
+func (_u *UserTOTPUpdate) Mutation() *UserTOTPMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserTOTPUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserTOTPUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserTOTPUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserTOTPUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserTOTPUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usertotp.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserTOTPUpdate) check() error {
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := usertotp.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`postgresql: validator failed for field "UserTOTP.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserTOTPUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertotp.Table, usertotp.Columns, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConfirmedAt(); ok {
+		_spec.SetField(usertotp.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(usertotp.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(usertotp.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertotp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserTOTPUpdateOne is the builder for updating a single UserTOTP entity.
+type UserTOTPUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserTOTPMutation
+}
+
+// SetSecret sets the "secret" field.
+func (_u *UserTOTPUpdateOne) SetSecret(v string) *UserTOTPUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *UserTOTPUpdateOne) SetNillableSecret(v *string) *UserTOTPUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_u *UserTOTPUpdateOne) SetConfirmedAt(v time.Time) *UserTOTPUpdateOne {
+	_u.mutation.SetConfirmedAt(v)
+	return _u
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_u *UserTOTPUpdateOne) SetNillableConfirmedAt(v *time.Time) *UserTOTPUpdateOne {
+	if v != nil {
+		_u.SetConfirmedAt(*v)
+	}
+	return _u
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (_u *UserTOTPUpdateOne) ClearConfirmedAt() *UserTOTPUpdateOne {
+	_u.mutation.ClearConfirmedAt()
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *UserTOTPUpdateOne) SetLastUsedStep(v int64) *UserTOTPUpdateOne {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *UserTOTPUpdateOne) SetNillableLastUsedStep(v *int64) *UserTOTPUpdateOne {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *UserTOTPUpdateOne) AddLastUsedStep(v int64) *UserTOTPUpdateOne {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserTOTPUpdateOne) SetCreatedAt(v time.Time) *UserTOTPUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserTOTPUpdateOne) SetNillableCreatedAt(v *time.Time) *UserTOTPUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserTOTPUpdateOne) SetUpdatedAt(v time.Time) *UserTOTPUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the UserTOTPMutation object of the builder.
+func (_u *UserTOTPUpdateOne) Mutation() *UserTOTPMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserTOTPUpdate builder.
+func (_u *UserTOTPUpdateOne) Where(ps ...predicate.UserTOTP) *UserTOTPUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserTOTPUpdateOne) Select(field string, fields ...string) *UserTOTPUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserTOTP entity.
+func (_u *UserTOTPUpdateOne) Save(ctx context.Context) (*UserTOTP, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserTOTPUpdateOne) SaveX(ctx context.Context) *UserTOTP {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserTOTPUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserTOTPUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserTOTPUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := usertotp.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserTOTPUpdateOne) check() error {
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := usertotp.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`postgresql: validator failed for field "UserTOTP.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserTOTPUpdateOne) sqlSave(ctx context.Context) (_node *UserTOTP, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertotp.Table, usertotp.Columns, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "UserTOTP.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertotp.FieldID)
+		for _, f := range fields {
+			if !usertotp.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != usertotp.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConfirmedAt(); ok {
+		_spec.SetField(usertotp.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(usertotp.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(usertotp.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &UserTOTP{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertotp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,261 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+const webhookDeliveryErrorMaxLen = 500
+
+type WebhookDeliveryRecord struct {
+	ID           string
+	EndpointType webhookdelivery.EndpointType
+	EndpointID   string
+	ClientID     string
+	CallbackURL  string
+	GameUserID   string
+	Server       string
+	DataType     string
+	EventID      string
+	Payload      []byte
+	MaxAttempts  int
+}
+
+// WebhookDeliveryFilter narrows delivery listings and purges. Empty fields
+// match everything.
+type WebhookDeliveryFilter struct {
+	EndpointType webhookdelivery.EndpointType
+	EndpointID   string
+	ClientID     string
+	Statuses     []webhookdelivery.Status
+	Before       *time.Time
+}
+
+func (f WebhookDeliveryFilter) predicates() []predicate.WebhookDelivery {
+	preds := make([]predicate.WebhookDelivery, 0, 5)
+	if f.EndpointType != "" {
+		preds = append(preds, webhookdelivery.EndpointTypeEQ(f.EndpointType))
+	}
+	if endpointID := strings.TrimSpace(f.EndpointID); endpointID != "" {
+		preds = append(preds, webhookdelivery.EndpointIDEQ(endpointID))
+	}
+	if clientID := strings.TrimSpace(f.ClientID); clientID != "" {
+		preds = append(preds, webhookdelivery.ClientIDEQ(clientID))
+	}
+	if len(f.Statuses) > 0 {
+		preds = append(preds, webhookdelivery.StatusIn(f.Statuses...))
+	}
+	if f.Before != nil {
+		preds = append(preds, webhookdelivery.CreatedAtLT(f.Before.UTC()))
+	}
+	return preds
+}
+
+func truncateWebhookDeliveryError(message string) string {
+	message = strings.TrimSpace(message)
+	if len(message) <= webhookDeliveryErrorMaxLen {
+		return message
+	}
+	return message[:webhookDeliveryErrorMaxLen]
+}
+
+func (c *Client) EnqueueWebhookDeliveries(ctx context.Context, records []WebhookDeliveryRecord, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	builders := make([]*WebhookDeliveryCreate, 0, len(records))
+	for _, record := range records {
+		builder := c.WebhookDelivery.Create().
+			SetID(record.ID).
+			SetEndpointType(record.EndpointType).
+			SetEndpointID(record.EndpointID).
+			SetCallbackURL(record.CallbackURL).
+			SetGameUserID(record.GameUserID).
+			SetServer(record.Server).
+			SetDataType(record.DataType).
+			SetEventID(record.EventID).
+			SetMaxAttempts(record.MaxAttempts).
+			SetNextAttemptAt(now).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
+		if clientID := strings.TrimSpace(record.ClientID); clientID != "" {
+			builder.SetClientID(clientID)
+		}
+		if len(record.Payload) > 0 {
+			builder.SetPayload(string(record.Payload))
+		}
+		builders = append(builders, builder)
+	}
+	return c.WebhookDelivery.CreateBulk(builders...).Exec(ctx)
+}
+
+func (c *Client) ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	return c.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(webhookdelivery.ByNextAttemptAt(sql.OrderAsc()), webhookdelivery.ByID(sql.OrderAsc())).
+		Limit(limit).
+		All(ctx)
+}
+
+// ClaimWebhookDelivery takes ownership of a due delivery by bumping its attempt
+// counter and pushing next_attempt_at out to leaseUntil. The compare on the
+// attempt count makes the claim safe across instances; if the worker dies
+// mid-delivery the row becomes due again once the lease expires.
+func (c *Client) ClaimWebhookDelivery(ctx context.Context, delivery *WebhookDelivery, now, leaseUntil time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.IDEQ(delivery.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.AttemptsEQ(delivery.Attempts),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (c *Client) CompleteWebhookDelivery(ctx context.Context, id string, statusCode int, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	_, err := c.WebhookDelivery.Update().
+		Where(webhookdelivery.IDEQ(id), webhookdelivery.StatusEQ(webhookdelivery.StatusPending)).
+		SetStatus(webhookdelivery.StatusSucceeded).
+		SetLastStatusCode(statusCode).
+		ClearLastError().
+		SetDeliveredAt(now).
+		Save(ctx)
+	return err
+}
+
+// FailWebhookDelivery records a failed attempt. A nil nextAttemptAt moves the
+// delivery to the dead-letter state.
+func (c *Client) FailWebhookDelivery(ctx context.Context, id string, statusCode *int, message string, nextAttemptAt *time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	update := c.WebhookDelivery.Update().
+		Where(webhookdelivery.IDEQ(id), webhookdelivery.StatusEQ(webhookdelivery.StatusPending)).
+		SetLastError(truncateWebhookDeliveryError(message))
+	if statusCode != nil {
+		update.SetLastStatusCode(*statusCode)
+	} else {
+		update.ClearLastStatusCode()
+	}
+	if nextAttemptAt == nil {
+		update.SetStatus(webhookdelivery.StatusDead)
+	} else {
+		update.SetNextAttemptAt(*nextAttemptAt)
+	}
+	_, err := update.Save(ctx)
+	return err
+}
+
+// DeferWebhookEndpointDeliveries holds back other pending deliveries for an
+// endpoint that just failed, so a struggling subscriber is not hit by a burst
+// of queued events before its backoff elapses.
+func (c *Client) DeferWebhookEndpointDeliveries(ctx context.Context, endpointType webhookdelivery.EndpointType, endpointID string, until time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.EndpointTypeEQ(endpointType),
+			webhookdelivery.EndpointIDEQ(endpointID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLT(until),
+		).
+		SetNextAttemptAt(until).
+		Save(ctx)
+}
+
+// ErrWebhookDeliveryNotReplayable is returned when replaying a delivery that
+// is not dead-lettered.
+var ErrWebhookDeliveryNotReplayable = errors.New("only dead webhook deliveries can be replayed")
+
+// ReplayWebhookDelivery re-queues a dead delivery for immediate dispatch with
+// a fresh attempt budget. Pending deliveries are refused: one may be claimed
+// by a worker right now, and re-arming it would send it a second time.
+func (c *Client) ReplayWebhookDelivery(ctx context.Context, id string, now time.Time) (*WebhookDelivery, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	affected, err := c.WebhookDelivery.Update().
+		Where(webhookdelivery.IDEQ(id), webhookdelivery.StatusEQ(webhookdelivery.StatusDead)).
+		SetStatus(webhookdelivery.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(now).
+		SetUpdatedAt(now).
+		ClearDeliveredAt().
+		ClearLastError().
+		ClearLastStatusCode().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := c.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, ErrWebhookDeliveryNotReplayable
+	}
+	return row, nil
+}
+
+func (c *Client) ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter, offset, limit int) ([]*WebhookDelivery, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("postgresql client is nil")
+	}
+	preds := filter.predicates()
+	total, err := c.WebhookDelivery.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := c.WebhookDelivery.Query().
+		Where(preds...).
+		Order(webhookdelivery.ByCreatedAt(sql.OrderDesc()), webhookdelivery.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+// PurgeWebhookDeliveries deletes matching deliveries. Without an explicit
+// status filter only finished (succeeded or dead) deliveries are removed.
+func (c *Client) PurgeWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []webhookdelivery.Status{webhookdelivery.StatusSucceeded, webhookdelivery.StatusDead}
+	}
+	return c.WebhookDelivery.Delete().Where(filter.predicates()...).Exec(ctx)
+}
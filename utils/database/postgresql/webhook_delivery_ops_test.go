@@ -0,0 +1,119 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestWebhookDeliveryLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:webhook-delivery-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := []dbManager.WebhookDeliveryRecord{
+		{ID: "d1", EndpointType: webhookdelivery.EndpointTypeWebhook, EndpointID: "1", CallbackURL: "https://example.com/a", GameUserID: "123", Server: "jp", DataType: "suite", MaxAttempts: 2},
+		{ID: "d2", EndpointType: webhookdelivery.EndpointTypeWebhook, EndpointID: "1", CallbackURL: "https://example.com/a", GameUserID: "456", Server: "jp", DataType: "suite", MaxAttempts: 2},
+		{ID: "d3", EndpointType: webhookdelivery.EndpointTypeOauth2Client, EndpointID: "w1", ClientID: "client-a", CallbackURL: "https://example.com/b", GameUserID: "123", Server: "jp", DataType: "mysekai", MaxAttempts: 2},
+	}
+	if err := client.EnqueueWebhookDeliveries(ctx, records, now); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+
+	due, err := client.ListDueWebhookDeliveries(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("ListDueWebhookDeliveries returned error: %v", err)
+	}
+	if len(due) != 3 {
+		t.Fatalf("due deliveries = %d, want 3", len(due))
+	}
+
+	lease := now.Add(time.Minute)
+	claimed, err := client.ClaimWebhookDelivery(ctx, due[0], now, lease)
+	if err != nil || !claimed {
+		t.Fatalf("ClaimWebhookDelivery = %v, %v; want true, nil", claimed, err)
+	}
+	claimed, err = client.ClaimWebhookDelivery(ctx, due[0], now, lease)
+	if err != nil || claimed {
+		t.Fatalf("second ClaimWebhookDelivery = %v, %v; want false, nil", claimed, err)
+	}
+
+	retryAt := now.Add(30 * time.Second)
+	statusCode := 503
+	if err := client.FailWebhookDelivery(ctx, "d1", &statusCode, "unexpected status code 503", &retryAt); err != nil {
+		t.Fatalf("FailWebhookDelivery returned error: %v", err)
+	}
+	deferred, err := client.DeferWebhookEndpointDeliveries(ctx, webhookdelivery.EndpointTypeWebhook, "1", retryAt)
+	if err != nil {
+		t.Fatalf("DeferWebhookEndpointDeliveries returned error: %v", err)
+	}
+	if deferred != 1 {
+		t.Fatalf("deferred = %d, want 1 (only d2)", deferred)
+	}
+	due, err = client.ListDueWebhookDeliveries(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("ListDueWebhookDeliveries returned error: %v", err)
+	}
+	if len(due) != 1 || due[0].ID != "d3" {
+		t.Fatalf("due after defer = %v, want only d3", due)
+	}
+
+	d1, err := client.WebhookDelivery.Get(ctx, "d1")
+	if err != nil {
+		t.Fatalf("get d1: %v", err)
+	}
+	if d1.Attempts != 1 || d1.Status != webhookdelivery.StatusPending || d1.LastStatusCode == nil || *d1.LastStatusCode != 503 {
+		t.Fatalf("d1 after failure = %+v", d1)
+	}
+	if err := client.FailWebhookDelivery(ctx, "d1", nil, "connection refused", nil); err != nil {
+		t.Fatalf("FailWebhookDelivery dead returned error: %v", err)
+	}
+	if err := client.CompleteWebhookDelivery(ctx, "d3", 204, now); err != nil {
+		t.Fatalf("CompleteWebhookDelivery returned error: %v", err)
+	}
+
+	dead, total, err := client.ListWebhookDeliveries(ctx, dbManager.WebhookDeliveryFilter{Statuses: []webhookdelivery.Status{webhookdelivery.StatusDead}}, 0, 10)
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveries returned error: %v", err)
+	}
+	if total != 1 || len(dead) != 1 || dead[0].ID != "d1" || dead[0].LastStatusCode != nil {
+		t.Fatalf("dead deliveries = %v (total %d), want d1 without status code", dead, total)
+	}
+
+	replayed, err := client.ReplayWebhookDelivery(ctx, "d1", now)
+	if err != nil {
+		t.Fatalf("ReplayWebhookDelivery returned error: %v", err)
+	}
+	if replayed.Status != webhookdelivery.StatusPending || replayed.Attempts != 0 || !replayed.NextAttemptAt.Equal(now) {
+		t.Fatalf("replayed delivery = %+v", replayed)
+	}
+	if _, err := client.ReplayWebhookDelivery(ctx, "missing", now); !dbManager.IsNotFound(err) {
+		t.Fatalf("ReplayWebhookDelivery missing error = %v, want not found", err)
+	}
+
+	purged, err := client.PurgeWebhookDeliveries(ctx, dbManager.WebhookDeliveryFilter{ClientID: "client-a"})
+	if err != nil {
+		t.Fatalf("PurgeWebhookDeliveries returned error: %v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("purged = %d, want 1", purged)
+	}
+	_, total, err = client.ListWebhookDeliveries(ctx, dbManager.WebhookDeliveryFilter{}, 0, 10)
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveries returned error: %v", err)
+	}
+	if total != 2 {
+		t.Fatalf("remaining deliveries = %d, want 2", total)
+	}
+}
@@ -0,0 +1,382 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookendpoint"
+
+	sql "entgo.io/ent/dialect/sql"
+)
+
+type WebhookDeliveryAttemptRecord struct {
+	EndpointType webhookdeliveryattempt.EndpointType
+	EndpointID   string
+	ClientID     string
+	DeliveryID   string
+	EventID      string
+	Attempt      int
+	Success      bool
+	StatusCode   *int
+	Latency      time.Duration
+	Error        string
+}
+
+func (c *Client) RecordWebhookDeliveryAttempt(ctx context.Context, record WebhookDeliveryAttemptRecord, now time.Time) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	builder := c.WebhookDeliveryAttempt.Create().
+		SetEndpointType(record.EndpointType).
+		SetEndpointID(record.EndpointID).
+		SetDeliveryID(record.DeliveryID).
+		SetEventID(record.EventID).
+		SetAttempt(record.Attempt).
+		SetSuccess(record.Success).
+		SetNillableStatusCode(record.StatusCode).
+		SetLatencyMs(record.Latency.Milliseconds()).
+		SetCreatedAt(now)
+	if clientID := strings.TrimSpace(record.ClientID); clientID != "" {
+		builder.SetClientID(clientID)
+	}
+	if message := truncateWebhookDeliveryError(record.Error); message != "" {
+		builder.SetError(message)
+	}
+	return builder.Exec(ctx)
+}
+
+// WebhookDeliveryAttemptFilter narrows attempt listings. Empty fields match
+// everything.
+type WebhookDeliveryAttemptFilter struct {
+	EndpointType webhookdeliveryattempt.EndpointType
+	EndpointID   string
+	ClientID     string
+	DeliveryID   string
+	EventID      string
+	Success      *bool
+	From         *time.Time
+	To           *time.Time
+}
+
+func (f WebhookDeliveryAttemptFilter) predicates() []predicate.WebhookDeliveryAttempt {
+	preds := make([]predicate.WebhookDeliveryAttempt, 0, 8)
+	if f.EndpointType != "" {
+		preds = append(preds, webhookdeliveryattempt.EndpointTypeEQ(f.EndpointType))
+	}
+	if endpointID := strings.TrimSpace(f.EndpointID); endpointID != "" {
+		preds = append(preds, webhookdeliveryattempt.EndpointIDEQ(endpointID))
+	}
+	if clientID := strings.TrimSpace(f.ClientID); clientID != "" {
+		preds = append(preds, webhookdeliveryattempt.ClientIDEQ(clientID))
+	}
+	if deliveryID := strings.TrimSpace(f.DeliveryID); deliveryID != "" {
+		preds = append(preds, webhookdeliveryattempt.DeliveryIDEQ(deliveryID))
+	}
+	if eventID := strings.TrimSpace(f.EventID); eventID != "" {
+		preds = append(preds, webhookdeliveryattempt.EventIDEQ(eventID))
+	}
+	if f.Success != nil {
+		preds = append(preds, webhookdeliveryattempt.SuccessEQ(*f.Success))
+	}
+	if f.From != nil {
+		preds = append(preds, webhookdeliveryattempt.CreatedAtGTE(f.From.UTC()))
+	}
+	if f.To != nil {
+		preds = append(preds, webhookdeliveryattempt.CreatedAtLTE(f.To.UTC()))
+	}
+	return preds
+}
+
+func (c *Client) ListWebhookDeliveryAttempts(ctx context.Context, filter WebhookDeliveryAttemptFilter, offset, limit int) ([]*WebhookDeliveryAttempt, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("postgresql client is nil")
+	}
+	preds := filter.predicates()
+	total, err := c.WebhookDeliveryAttempt.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := c.WebhookDeliveryAttempt.Query().
+		Where(preds...).
+		Order(webhookdeliveryattempt.ByCreatedAt(sql.OrderDesc()), webhookdeliveryattempt.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+func (c *Client) PurgeWebhookDeliveryAttempts(ctx context.Context, before time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("postgresql client is nil")
+	}
+	return c.WebhookDeliveryAttempt.Delete().
+		Where(webhookdeliveryattempt.CreatedAtLT(before.UTC())).
+		Exec(ctx)
+}
+
+// WebhookEndpointHealthStats summarizes the attempts made to one endpoint
+// within a time window.
+type WebhookEndpointHealthStats struct {
+	TotalAttempts      int
+	SuccessfulAttempts int
+	FailedAttempts     int
+	SuccessRate        *float64
+	P95LatencyMs       *int64
+	LastSuccessAt      *time.Time
+	RecentFailures     []*WebhookDeliveryAttempt
+}
+
+// GetWebhookEndpointHealthStats returns per-endpoint statistics for attempts
+// made since the given time, keyed by endpoint id. Endpoints without attempts
+// get an empty entry.
+func (c *Client) GetWebhookEndpointHealthStats(
+	ctx context.Context,
+	endpointType webhookdeliveryattempt.EndpointType,
+	endpointIDs []string,
+	since time.Time,
+	recentFailures int,
+) (map[string]*WebhookEndpointHealthStats, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	stats := make(map[string]*WebhookEndpointHealthStats, len(endpointIDs))
+	for _, endpointID := range endpointIDs {
+		stats[endpointID] = &WebhookEndpointHealthStats{RecentFailures: []*WebhookDeliveryAttempt{}}
+	}
+	if len(endpointIDs) == 0 {
+		return stats, nil
+	}
+
+	rows, err := c.WebhookDeliveryAttempt.Query().
+		Where(
+			webhookdeliveryattempt.EndpointTypeEQ(endpointType),
+			webhookdeliveryattempt.EndpointIDIn(endpointIDs...),
+			webhookdeliveryattempt.CreatedAtGTE(since.UTC()),
+		).
+		Select(
+			webhookdeliveryattempt.FieldEndpointID,
+			webhookdeliveryattempt.FieldSuccess,
+			webhookdeliveryattempt.FieldLatencyMs,
+			webhookdeliveryattempt.FieldCreatedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latencies := make(map[string][]int64, len(endpointIDs))
+	for _, row := range rows {
+		entry, ok := stats[row.EndpointID]
+		if !ok {
+			continue
+		}
+		entry.TotalAttempts++
+		latencies[row.EndpointID] = append(latencies[row.EndpointID], row.LatencyMs)
+		if row.Success {
+			entry.SuccessfulAttempts++
+			if entry.LastSuccessAt == nil || row.CreatedAt.After(*entry.LastSuccessAt) {
+				createdAt := row.CreatedAt.UTC()
+				entry.LastSuccessAt = &createdAt
+			}
+		} else {
+			entry.FailedAttempts++
+		}
+	}
+	for endpointID, entry := range stats {
+		if entry.TotalAttempts == 0 {
+			continue
+		}
+		rate := float64(entry.SuccessfulAttempts) / float64(entry.TotalAttempts)
+		entry.SuccessRate = &rate
+		p95 := percentileNearestRank(latencies[endpointID], 0.95)
+		entry.P95LatencyMs = &p95
+
+		if entry.FailedAttempts == 0 || recentFailures <= 0 {
+			continue
+		}
+		failures, err := c.WebhookDeliveryAttempt.Query().
+			Where(
+				webhookdeliveryattempt.EndpointTypeEQ(endpointType),
+				webhookdeliveryattempt.EndpointIDEQ(endpointID),
+				webhookdeliveryattempt.SuccessEQ(false),
+				webhookdeliveryattempt.CreatedAtGTE(since.UTC()),
+			).
+			Order(webhookdeliveryattempt.ByCreatedAt(sql.OrderDesc()), webhookdeliveryattempt.ByID(sql.OrderDesc())).
+			Limit(recentFailures).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entry.RecentFailures = failures
+	}
+	return stats, nil
+}
+
+func percentileNearestRank(values []int64, percentile float64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(percentile*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// WebhookAutoDisablePolicy decides when an endpoint that keeps failing is
+// switched off. Both conditions must hold; a zero FailureThreshold disables
+// the policy.
+type WebhookAutoDisablePolicy struct {
+	FailureThreshold int
+	MinFailureWindow time.Duration
+}
+
+func (p WebhookAutoDisablePolicy) shouldDisable(consecutiveFailures int, failingSince *time.Time, now time.Time) bool {
+	if p.FailureThreshold <= 0 || consecutiveFailures < p.FailureThreshold {
+		return false
+	}
+	if p.MinFailureWindow <= 0 {
+		return true
+	}
+	return failingSince != nil && now.Sub(*failingSince) >= p.MinFailureWindow
+}
+
+// WebhookEndpointFailureResult reports the endpoint state after a failed
+// attempt. AutoDisabled is true only for the call that switched the endpoint
+// off, so the owner is notified once.
+type WebhookEndpointFailureResult struct {
+	ConsecutiveFailures int
+	FailingSince        *time.Time
+	AutoDisabled        bool
+	OwnerEmail          string
+	CallbackURL         string
+	ClientID            string
+}
+
+func (c *Client) MarkWebhookEndpointAttemptSucceeded(ctx context.Context, endpointType webhookdeliveryattempt.EndpointType, endpointID string) error {
+	if c == nil {
+		return fmt.Errorf("postgresql client is nil")
+	}
+	switch endpointType {
+	case webhookdeliveryattempt.EndpointTypeWebhook:
+		return c.WebhookEndpoint.Update().
+			Where(webhookendpoint.IDEQ(endpointID), webhookendpoint.ConsecutiveFailuresGT(0)).
+			SetConsecutiveFailures(0).
+			ClearFailingSince().
+			Exec(ctx)
+	case webhookdeliveryattempt.EndpointTypeOauth2Client:
+		return c.OAuth2ClientWebhookEndpoint.Update().
+			Where(oauth2clientwebhookendpoint.IDEQ(endpointID), oauth2clientwebhookendpoint.ConsecutiveFailuresGT(0)).
+			SetConsecutiveFailures(0).
+			ClearFailingSince().
+			Exec(ctx)
+	default:
+		return fmt.Errorf("unknown webhook endpoint type %q", endpointType)
+	}
+}
+
+func (c *Client) MarkWebhookEndpointAttemptFailed(
+	ctx context.Context,
+	endpointType webhookdeliveryattempt.EndpointType,
+	endpointID string,
+	now time.Time,
+	policy WebhookAutoDisablePolicy,
+) (*WebhookEndpointFailureResult, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	now = now.UTC()
+	switch endpointType {
+	case webhookdeliveryattempt.EndpointTypeWebhook:
+		if err := c.WebhookEndpoint.Update().
+			Where(webhookendpoint.IDEQ(endpointID)).
+			AddConsecutiveFailures(1).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err := c.WebhookEndpoint.Update().
+			Where(webhookendpoint.IDEQ(endpointID), webhookendpoint.FailingSinceIsNil()).
+			SetFailingSince(now).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		endpoint, err := c.WebhookEndpoint.Get(ctx, endpointID)
+		if err != nil {
+			return nil, err
+		}
+		result := &WebhookEndpointFailureResult{
+			ConsecutiveFailures: endpoint.ConsecutiveFailures,
+			FailingSince:        endpoint.FailingSince,
+			CallbackURL:         endpoint.CallbackURL,
+		}
+		if endpoint.OwnerEmail != nil {
+			result.OwnerEmail = *endpoint.OwnerEmail
+		}
+		if endpoint.Enabled && policy.shouldDisable(endpoint.ConsecutiveFailures, endpoint.FailingSince, now) {
+			affected, err := c.WebhookEndpoint.Update().
+				Where(webhookendpoint.IDEQ(endpointID), webhookendpoint.EnabledEQ(true)).
+				SetEnabled(false).
+				SetAutoDisabledAt(now).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			result.AutoDisabled = affected == 1
+		}
+		return result, nil
+	case webhookdeliveryattempt.EndpointTypeOauth2Client:
+		if err := c.OAuth2ClientWebhookEndpoint.Update().
+			Where(oauth2clientwebhookendpoint.IDEQ(endpointID)).
+			AddConsecutiveFailures(1).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err := c.OAuth2ClientWebhookEndpoint.Update().
+			Where(oauth2clientwebhookendpoint.IDEQ(endpointID), oauth2clientwebhookendpoint.FailingSinceIsNil()).
+			SetFailingSince(now).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		endpoint, err := c.OAuth2ClientWebhookEndpoint.Get(ctx, endpointID)
+		if err != nil {
+			return nil, err
+		}
+		result := &WebhookEndpointFailureResult{
+			ConsecutiveFailures: endpoint.ConsecutiveFailures,
+			FailingSince:        endpoint.FailingSince,
+			CallbackURL:         endpoint.CallbackURL,
+			ClientID:            endpoint.ClientID,
+		}
+		if endpoint.OwnerEmail != nil {
+			result.OwnerEmail = *endpoint.OwnerEmail
+		}
+		if endpoint.Enabled && policy.shouldDisable(endpoint.ConsecutiveFailures, endpoint.FailingSince, now) {
+			affected, err := c.OAuth2ClientWebhookEndpoint.Update().
+				Where(oauth2clientwebhookendpoint.IDEQ(endpointID), oauth2clientwebhookendpoint.EnabledEQ(true)).
+				SetEnabled(false).
+				SetAutoDisabledAt(now).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			result.AutoDisabled = affected == 1
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unknown webhook endpoint type %q", endpointType)
+	}
+}
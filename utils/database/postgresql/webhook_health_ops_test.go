@@ -0,0 +1,158 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestWebhookDeliveryAttemptsListAndStats(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:webhook-health-attempts-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	statusOK := 200
+	statusUnavailable := 503
+	records := []dbManager.WebhookDeliveryAttemptRecord{
+		{EndpointType: webhookdeliveryattempt.EndpointTypeWebhook, EndpointID: "1", DeliveryID: "d1", EventID: "e1", Attempt: 1, Success: false, StatusCode: &statusUnavailable, Latency: 400 * time.Millisecond, Error: "unexpected status code 503"},
+		{EndpointType: webhookdeliveryattempt.EndpointTypeWebhook, EndpointID: "1", DeliveryID: "d1", EventID: "e1", Attempt: 2, Success: true, StatusCode: &statusOK, Latency: 100 * time.Millisecond},
+		{EndpointType: webhookdeliveryattempt.EndpointTypeWebhook, EndpointID: "1", DeliveryID: "d2", EventID: "e2", Attempt: 1, Success: true, StatusCode: &statusOK, Latency: 200 * time.Millisecond},
+		{EndpointType: webhookdeliveryattempt.EndpointTypeWebhook, EndpointID: "1", DeliveryID: "d3", EventID: "e3", Attempt: 1, Success: true, StatusCode: &statusOK, Latency: 300 * time.Millisecond},
+		{EndpointType: webhookdeliveryattempt.EndpointTypeOauth2Client, EndpointID: "w1", ClientID: "client-a", DeliveryID: "d4", Attempt: 1, Success: false, Latency: time.Second, Error: "dial tcp: connection refused"},
+	}
+	for i, record := range records {
+		if err := client.RecordWebhookDeliveryAttempt(ctx, record, now.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("RecordWebhookDeliveryAttempt(%d) returned error: %v", i, err)
+		}
+	}
+
+	rows, total, err := client.ListWebhookDeliveryAttempts(ctx, dbManager.WebhookDeliveryAttemptFilter{EndpointType: webhookdeliveryattempt.EndpointTypeWebhook, EndpointID: "1"}, 0, 2)
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveryAttempts returned error: %v", err)
+	}
+	if total != 4 || len(rows) != 2 || rows[0].DeliveryID != "d3" {
+		t.Fatalf("list = total %d, rows %d, first %+v", total, len(rows), rows[0])
+	}
+	failed := false
+	rows, total, err = client.ListWebhookDeliveryAttempts(ctx, dbManager.WebhookDeliveryAttemptFilter{Success: &failed}, 0, 10)
+	if err != nil || total != 2 || len(rows) != 2 {
+		t.Fatalf("failed attempts = %d rows, total %d, err %v", len(rows), total, err)
+	}
+	rows, total, err = client.ListWebhookDeliveryAttempts(ctx, dbManager.WebhookDeliveryAttemptFilter{ClientID: "client-a"}, 0, 10)
+	if err != nil || total != 1 || rows[0].ClientID == nil || *rows[0].ClientID != "client-a" {
+		t.Fatalf("client attempts = %+v, total %d, err %v", rows, total, err)
+	}
+
+	stats, err := client.GetWebhookEndpointHealthStats(ctx, webhookdeliveryattempt.EndpointTypeWebhook, []string{"1", "idle"}, now.Add(-time.Hour), 5)
+	if err != nil {
+		t.Fatalf("GetWebhookEndpointHealthStats returned error: %v", err)
+	}
+	entry := stats["1"]
+	if entry == nil || entry.TotalAttempts != 4 || entry.SuccessfulAttempts != 3 || entry.FailedAttempts != 1 {
+		t.Fatalf("stats = %+v", entry)
+	}
+	if entry.SuccessRate == nil || *entry.SuccessRate != 0.75 {
+		t.Fatalf("success rate = %v, want 0.75", entry.SuccessRate)
+	}
+	if entry.P95LatencyMs == nil || *entry.P95LatencyMs != 400 {
+		t.Fatalf("p95 = %v, want 400", entry.P95LatencyMs)
+	}
+	if entry.LastSuccessAt == nil || !entry.LastSuccessAt.Equal(now.Add(3*time.Minute)) {
+		t.Fatalf("last success = %v", entry.LastSuccessAt)
+	}
+	if len(entry.RecentFailures) != 1 || entry.RecentFailures[0].DeliveryID != "d1" {
+		t.Fatalf("recent failures = %+v", entry.RecentFailures)
+	}
+	if idle := stats["idle"]; idle == nil || idle.TotalAttempts != 0 || idle.SuccessRate != nil {
+		t.Fatalf("idle stats = %+v", idle)
+	}
+
+	purged, err := client.PurgeWebhookDeliveryAttempts(ctx, now.Add(2*time.Minute))
+	if err != nil || purged != 2 {
+		t.Fatalf("PurgeWebhookDeliveryAttempts = %d, %v; want 2, nil", purged, err)
+	}
+}
+
+func TestMarkWebhookEndpointAttemptFailedAutoDisablesOnce(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:webhook-health-auto-disable-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	if err := client.UpsertWebhookEndpoint(ctx, dbManager.WebhookEndpointRecord{
+		ID:          "1",
+		Credential:  "cred-a",
+		CallbackURL: "https://hooks.example.com/a",
+		Enabled:     true,
+	}); err != nil {
+		t.Fatalf("UpsertWebhookEndpoint returned error: %v", err)
+	}
+	if err := client.WebhookEndpoint.UpdateOneID("1").SetOwnerEmail("owner@example.com").Exec(ctx); err != nil {
+		t.Fatalf("set owner email: %v", err)
+	}
+
+	policy := dbManager.WebhookAutoDisablePolicy{FailureThreshold: 3, MinFailureWindow: time.Hour}
+	for i := 0; i < 3; i++ {
+		result, err := client.MarkWebhookEndpointAttemptFailed(ctx, webhookdeliveryattempt.EndpointTypeWebhook, "1", now.Add(time.Duration(i)*time.Minute), policy)
+		if err != nil {
+			t.Fatalf("MarkWebhookEndpointAttemptFailed(%d) returned error: %v", i, err)
+		}
+		if result.AutoDisabled {
+			t.Fatalf("endpoint disabled before the failure window elapsed: %+v", result)
+		}
+	}
+
+	if err := client.MarkWebhookEndpointAttemptSucceeded(ctx, webhookdeliveryattempt.EndpointTypeWebhook, "1"); err != nil {
+		t.Fatalf("MarkWebhookEndpointAttemptSucceeded returned error: %v", err)
+	}
+	endpoint, err := client.WebhookEndpoint.Get(ctx, "1")
+	if err != nil {
+		t.Fatalf("get endpoint: %v", err)
+	}
+	if endpoint.ConsecutiveFailures != 0 || endpoint.FailingSince != nil {
+		t.Fatalf("streak not reset: %d, %v", endpoint.ConsecutiveFailures, endpoint.FailingSince)
+	}
+
+	var disabledCount int
+	for i := 0; i < 5; i++ {
+		result, err := client.MarkWebhookEndpointAttemptFailed(ctx, webhookdeliveryattempt.EndpointTypeWebhook, "1", now.Add(time.Duration(i)*30*time.Minute), policy)
+		if err != nil {
+			t.Fatalf("MarkWebhookEndpointAttemptFailed returned error: %v", err)
+		}
+		if result.AutoDisabled {
+			disabledCount++
+			if result.OwnerEmail != "owner@example.com" || result.CallbackURL != "https://hooks.example.com/a" {
+				t.Fatalf("unexpected result: %+v", result)
+			}
+		}
+	}
+	if disabledCount != 1 {
+		t.Fatalf("auto-disabled %d times, want 1", disabledCount)
+	}
+	endpoint, err = client.WebhookEndpoint.Get(ctx, "1")
+	if err != nil {
+		t.Fatalf("get endpoint: %v", err)
+	}
+	if endpoint.Enabled || endpoint.AutoDisabledAt == nil {
+		t.Fatalf("endpoint not auto-disabled: enabled=%v autoDisabledAt=%v", endpoint.Enabled, endpoint.AutoDisabledAt)
+	}
+
+	if _, err := client.MarkWebhookEndpointAttemptFailed(ctx, webhookdeliveryattempt.EndpointTypeWebhook, "missing", now, policy); !dbManager.IsNotFound(err) {
+		t.Fatalf("missing endpoint error = %v, want not found", err)
+	}
+}
@@ -19,8 +19,10 @@ type WebhookEndpointRecord struct {
 }
 
 type WebhookCallback struct {
-	CallbackURL string
-	Bearer      string
+	EndpointID     string
+	CallbackURL    string
+	Bearer         string
+	SigningSecrets WebhookSigningSecrets
 }
 
 type WebhookSubscriber struct {
@@ -140,8 +142,10 @@ func (c *Client) GetWebhookPushAPI(ctx context.Context, userID int64, server, da
 			bearer = strings.TrimSpace(*subscription.Edges.Endpoint.Bearer)
 		}
 		callbacks = append(callbacks, WebhookCallback{
-			CallbackURL: callbackURL,
-			Bearer:      bearer,
+			EndpointID:     subscription.Edges.Endpoint.ID,
+			CallbackURL:    callbackURL,
+			Bearer:         bearer,
+			SigningSecrets: subscription.Edges.Endpoint.SigningSecrets(),
 		})
 	}
 	return callbacks, nil
@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/oauth2clientwebhookendpoint"
+)
+
+// WebhookSigningSecrets holds the HMAC secrets of a webhook endpoint. After a
+// rotation the previous secret keeps signing deliveries until
+// PreviousExpiresAt, so receivers can switch over without rejecting requests.
+type WebhookSigningSecrets struct {
+	Current           string
+	Previous          string
+	PreviousExpiresAt *time.Time
+}
+
+// Active returns the secrets deliveries should be signed with at now, current
+// secret first.
+func (s WebhookSigningSecrets) Active(now time.Time) []string {
+	secrets := make([]string, 0, 2)
+	if current := strings.TrimSpace(s.Current); current != "" {
+		secrets = append(secrets, current)
+	}
+	if previous := strings.TrimSpace(s.Previous); previous != "" && s.PreviousExpiresAt != nil && now.Before(*s.PreviousExpiresAt) {
+		secrets = append(secrets, previous)
+	}
+	return secrets
+}
+
+func newWebhookSigningSecrets(current, previous *string, previousExpiresAt *time.Time) WebhookSigningSecrets {
+	secrets := WebhookSigningSecrets{PreviousExpiresAt: previousExpiresAt}
+	if current != nil {
+		secrets.Current = *current
+	}
+	if previous != nil {
+		secrets.Previous = *previous
+	}
+	return secrets
+}
+
+func (e *WebhookEndpoint) SigningSecrets() WebhookSigningSecrets {
+	return newWebhookSigningSecrets(e.SigningSecret, e.PreviousSigningSecret, e.PreviousSigningSecretExpiresAt)
+}
+
+func (e *OAuth2ClientWebhookEndpoint) SigningSecrets() WebhookSigningSecrets {
+	return newWebhookSigningSecrets(e.SigningSecret, e.PreviousSigningSecret, e.PreviousSigningSecretExpiresAt)
+}
+
+// RotateWebhookEndpointSigningSecret installs secret as the endpoint's signing
+// secret. When graceUntil is set the old secret stays active until then;
+// otherwise it is dropped immediately.
+func (c *Client) RotateWebhookEndpointSigningSecret(ctx context.Context, id, secret string, graceUntil *time.Time) (*WebhookEndpoint, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	current, err := c.WebhookEndpoint.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	update := c.WebhookEndpoint.UpdateOneID(current.ID).SetSigningSecret(secret)
+	if graceUntil != nil && current.SigningSecret != nil && *current.SigningSecret != "" {
+		update.SetPreviousSigningSecret(*current.SigningSecret).SetPreviousSigningSecretExpiresAt(graceUntil.UTC())
+	} else {
+		update.ClearPreviousSigningSecret().ClearPreviousSigningSecretExpiresAt()
+	}
+	return update.Save(ctx)
+}
+
+// RotateOAuth2ClientWebhookSigningSecret is the OAuth2 client webhook
+// counterpart of RotateWebhookEndpointSigningSecret.
+func (c *Client) RotateOAuth2ClientWebhookSigningSecret(ctx context.Context, clientID, id, secret string, graceUntil *time.Time) (*OAuth2ClientWebhookEndpoint, error) {
+	if c == nil {
+		return nil, fmt.Errorf("postgresql client is nil")
+	}
+	current, err := c.OAuth2ClientWebhookEndpoint.Query().
+		Where(oauth2clientwebhookendpoint.IDEQ(id), oauth2clientwebhookendpoint.ClientIDEQ(clientID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	update := c.OAuth2ClientWebhookEndpoint.UpdateOneID(current.ID).SetSigningSecret(secret)
+	if graceUntil != nil && current.SigningSecret != nil && *current.SigningSecret != "" {
+		update.SetPreviousSigningSecret(*current.SigningSecret).SetPreviousSigningSecretExpiresAt(graceUntil.UTC())
+	} else {
+		update.ClearPreviousSigningSecret().ClearPreviousSigningSecretExpiresAt()
+	}
+	return update.Save(ctx)
+}
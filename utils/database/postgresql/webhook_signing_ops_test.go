@@ -0,0 +1,60 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRotateOAuth2ClientWebhookSigningSecret(t *testing.T) {
+	t.Parallel()
+
+	client := enttest.Open(t, "sqlite3", "file:webhook-signing-ops-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	if _, err := client.OAuth2ClientWebhookEndpoint.Create().
+		SetID("w1").
+		SetClientID("client-a").
+		SetCallbackURL("https://example.com/hook").
+		SetSigningSecret("whsec_a").
+		Save(ctx); err != nil {
+		t.Fatalf("create endpoint: %v", err)
+	}
+
+	if _, err := client.RotateOAuth2ClientWebhookSigningSecret(ctx, "client-b", "w1", "whsec_b", nil); !dbManager.IsNotFound(err) {
+		t.Fatalf("rotate with foreign client error = %v, want not found", err)
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	graceUntil := now.Add(time.Hour)
+	rotated, err := client.RotateOAuth2ClientWebhookSigningSecret(ctx, "client-a", "w1", "whsec_b", &graceUntil)
+	if err != nil {
+		t.Fatalf("rotate with grace: %v", err)
+	}
+	secrets := rotated.SigningSecrets()
+	if active := secrets.Active(now); len(active) != 2 || active[0] != "whsec_b" || active[1] != "whsec_a" {
+		t.Fatalf("active during grace = %v", active)
+	}
+	if active := secrets.Active(graceUntil); len(active) != 1 || active[0] != "whsec_b" {
+		t.Fatalf("active after grace = %v", active)
+	}
+
+	rotated, err = client.RotateOAuth2ClientWebhookSigningSecret(ctx, "client-a", "w1", "whsec_c", nil)
+	if err != nil {
+		t.Fatalf("rotate without grace: %v", err)
+	}
+	if rotated.PreviousSigningSecret != nil || rotated.PreviousSigningSecretExpiresAt != nil {
+		t.Fatalf("previous secret kept after immediate rotation: %+v", rotated.SigningSecrets())
+	}
+	if active := rotated.SigningSecrets().Active(now); len(active) != 1 || active[0] != "whsec_c" {
+		t.Fatalf("active after immediate rotation = %v", active)
+	}
+}
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookdeliveryattempt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the webhookdeliveryattempt type in the database.
+	Label = "webhook_delivery_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEndpointType holds the string denoting the endpoint_type field in the database.
+	FieldEndpointType = "endpoint_type"
+	// FieldEndpointID holds the string denoting the endpoint_id field in the database.
+	FieldEndpointID = "endpoint_id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldDeliveryID holds the string denoting the delivery_id field in the database.
+	FieldDeliveryID = "delivery_id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldAttempt holds the string denoting the attempt field in the database.
+	FieldAttempt = "attempt"
+	// FieldSuccess holds the string denoting the success field in the database.
+	FieldSuccess = "success"
+	// FieldStatusCode holds the string denoting the status_code field in the database.
+	FieldStatusCode = "status_code"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the webhookdeliveryattempt in the database.
+	Table = "webhook_delivery_attempts"
+)
+
+// Columns holds all SQL columns for webhookdeliveryattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldEndpointType,
+	FieldEndpointID,
+	FieldClientID,
+	FieldDeliveryID,
+	FieldEventID,
+	FieldAttempt,
+	FieldSuccess,
+	FieldStatusCode,
+	FieldLatencyMs,
+	FieldError,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EndpointIDValidator is a validator for the "endpoint_id" field. It is called by the builders before save.
+	EndpointIDValidator func(string) error
+	// DefaultDeliveryID holds the default value on creation for the "delivery_id" field.
+	DefaultDeliveryID string
+	// DefaultEventID holds the default value on creation for the "event_id" field.
+	DefaultEventID string
+	// DefaultAttempt holds the default value on creation for the "attempt" field.
+	DefaultAttempt int
+	// AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	AttemptValidator func(int) error
+	// DefaultLatencyMs holds the default value on creation for the "latency_ms" field.
+	DefaultLatencyMs int64
+	// LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	LatencyMsValidator func(int64) error
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// EndpointType defines the type for the "endpoint_type" enum field.
+type EndpointType string
+
+// EndpointType values.
+const (
+	EndpointTypeWebhook      EndpointType = "webhook"
+	EndpointTypeOauth2Client EndpointType = "oauth2_client"
+)
+
+func (et EndpointType) String() string {
+	return string(et)
+}
+
+// EndpointTypeValidator is a validator for the "endpoint_type" field enum values. It is called by the builders before save.
+func EndpointTypeValidator(et EndpointType) error {
+	switch et {
+	case EndpointTypeWebhook, EndpointTypeOauth2Client:
+		return nil
+	default:
+		return fmt.Errorf("webhookdeliveryattempt: invalid enum value for endpoint_type field: %q", et)
+	}
+}
+
+// OrderOption defines the ordering options for the WebhookDeliveryAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEndpointType orders the results by the endpoint_type field.
+func ByEndpointType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpointType, opts...).ToFunc()
+}
+
+// ByEndpointID orders the results by the endpoint_id field.
+func ByEndpointID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndpointID, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByDeliveryID orders the results by the delivery_id field.
+func ByDeliveryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByAttempt orders the results by the attempt field.
+func ByAttempt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempt, opts...).ToFunc()
+}
+
+// BySuccess orders the results by the success field.
+func BySuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccess, opts...).ToFunc()
+}
+
+// ByStatusCode orders the results by the status_code field.
+func ByStatusCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusCode, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
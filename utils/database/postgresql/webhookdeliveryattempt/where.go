@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookdeliveryattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// EndpointID applies equality check predicate on the "endpoint_id" field. It's identical to EndpointIDEQ.
+func EndpointID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEndpointID, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldClientID, v))
+}
+
+// DeliveryID applies equality check predicate on the "delivery_id" field. It's identical to DeliveryIDEQ.
+func DeliveryID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldDeliveryID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEventID, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldAttempt, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EndpointTypeEQ applies the EQ predicate on the "endpoint_type" field.
+func EndpointTypeEQ(v EndpointType) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEndpointType, v))
+}
+
+// EndpointTypeNEQ applies the NEQ predicate on the "endpoint_type" field.
+func EndpointTypeNEQ(v EndpointType) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldEndpointType, v))
+}
+
+// EndpointTypeIn applies the In predicate on the "endpoint_type" field.
+func EndpointTypeIn(vs ...EndpointType) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldEndpointType, vs...))
+}
+
+// EndpointTypeNotIn applies the NotIn predicate on the "endpoint_type" field.
+func EndpointTypeNotIn(vs ...EndpointType) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldEndpointType, vs...))
+}
+
+// EndpointIDEQ applies the EQ predicate on the "endpoint_id" field.
+func EndpointIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEndpointID, v))
+}
+
+// EndpointIDNEQ applies the NEQ predicate on the "endpoint_id" field.
+func EndpointIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldEndpointID, v))
+}
+
+// EndpointIDIn applies the In predicate on the "endpoint_id" field.
+func EndpointIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldEndpointID, vs...))
+}
+
+// EndpointIDNotIn applies the NotIn predicate on the "endpoint_id" field.
+func EndpointIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldEndpointID, vs...))
+}
+
+// EndpointIDGT applies the GT predicate on the "endpoint_id" field.
+func EndpointIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldEndpointID, v))
+}
+
+// EndpointIDGTE applies the GTE predicate on the "endpoint_id" field.
+func EndpointIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldEndpointID, v))
+}
+
+// EndpointIDLT applies the LT predicate on the "endpoint_id" field.
+func EndpointIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldEndpointID, v))
+}
+
+// EndpointIDLTE applies the LTE predicate on the "endpoint_id" field.
+func EndpointIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldEndpointID, v))
+}
+
+// EndpointIDContains applies the Contains predicate on the "endpoint_id" field.
+func EndpointIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldEndpointID, v))
+}
+
+// EndpointIDHasPrefix applies the HasPrefix predicate on the "endpoint_id" field.
+func EndpointIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldEndpointID, v))
+}
+
+// EndpointIDHasSuffix applies the HasSuffix predicate on the "endpoint_id" field.
+func EndpointIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldEndpointID, v))
+}
+
+// EndpointIDEqualFold applies the EqualFold predicate on the "endpoint_id" field.
+func EndpointIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldEndpointID, v))
+}
+
+// EndpointIDContainsFold applies the ContainsFold predicate on the "endpoint_id" field.
+func EndpointIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldEndpointID, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldClientID))
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldClientID))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// DeliveryIDEQ applies the EQ predicate on the "delivery_id" field.
+func DeliveryIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldDeliveryID, v))
+}
+
+// DeliveryIDNEQ applies the NEQ predicate on the "delivery_id" field.
+func DeliveryIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldDeliveryID, v))
+}
+
+// DeliveryIDIn applies the In predicate on the "delivery_id" field.
+func DeliveryIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldDeliveryID, vs...))
+}
+
+// DeliveryIDNotIn applies the NotIn predicate on the "delivery_id" field.
+func DeliveryIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldDeliveryID, vs...))
+}
+
+// DeliveryIDGT applies the GT predicate on the "delivery_id" field.
+func DeliveryIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldDeliveryID, v))
+}
+
+// DeliveryIDGTE applies the GTE predicate on the "delivery_id" field.
+func DeliveryIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldDeliveryID, v))
+}
+
+// DeliveryIDLT applies the LT predicate on the "delivery_id" field.
+func DeliveryIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldDeliveryID, v))
+}
+
+// DeliveryIDLTE applies the LTE predicate on the "delivery_id" field.
+func DeliveryIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldDeliveryID, v))
+}
+
+// DeliveryIDContains applies the Contains predicate on the "delivery_id" field.
+func DeliveryIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldDeliveryID, v))
+}
+
+// DeliveryIDHasPrefix applies the HasPrefix predicate on the "delivery_id" field.
+func DeliveryIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldDeliveryID, v))
+}
+
+// DeliveryIDHasSuffix applies the HasSuffix predicate on the "delivery_id" field.
+func DeliveryIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldDeliveryID, v))
+}
+
+// DeliveryIDEqualFold applies the EqualFold predicate on the "delivery_id" field.
+func DeliveryIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldDeliveryID, v))
+}
+
+// DeliveryIDContainsFold applies the ContainsFold predicate on the "delivery_id" field.
+func DeliveryIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldDeliveryID, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldAttempt, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldStatusCode))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int64) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebhookDeliveryAttempt) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebhookDeliveryAttempt) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebhookDeliveryAttempt) predicate.WebhookDeliveryAttempt {
+	return predicate.WebhookDeliveryAttempt(sql.NotPredicates(p))
+}
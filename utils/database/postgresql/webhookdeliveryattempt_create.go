@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttemptCreate is the builder for creating a WebhookDeliveryAttempt entity.
+type WebhookDeliveryAttemptCreate struct {
+	config
+	mutation *WebhookDeliveryAttemptMutation
+	hooks    []Hook
+}
+
+// SetEndpointType sets the "endpoint_type" field.
+func (_c *WebhookDeliveryAttemptCreate) SetEndpointType(v webhookdeliveryattempt.EndpointType) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetEndpointType(v)
+	return _c
+}
+
+// SetEndpointID sets the "endpoint_id" field.
+func (_c *WebhookDeliveryAttemptCreate) SetEndpointID(v string) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetEndpointID(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *WebhookDeliveryAttemptCreate) SetClientID(v string) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableClientID(v *string) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (_c *WebhookDeliveryAttemptCreate) SetDeliveryID(v string) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetDeliveryID(v)
+	return _c
+}
+
+// SetNillableDeliveryID sets the "delivery_id" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableDeliveryID(v *string) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetDeliveryID(*v)
+	}
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *WebhookDeliveryAttemptCreate) SetEventID(v string) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableEventID(v *string) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" field.
+func (_c *WebhookDeliveryAttemptCreate) SetAttempt(v int) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetAttempt(v)
+	return _c
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableAttempt(v *int) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetAttempt(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *WebhookDeliveryAttemptCreate) SetSuccess(v bool) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_c *WebhookDeliveryAttemptCreate) SetStatusCode(v int) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetStatusCode(v)
+	return _c
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableStatusCode(v *int) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetStatusCode(*v)
+	}
+	return _c
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_c *WebhookDeliveryAttemptCreate) SetLatencyMs(v int64) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetLatencyMs(v)
+	return _c
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableLatencyMs(v *int64) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetLatencyMs(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *WebhookDeliveryAttemptCreate) SetError(v string) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableError(v *string) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebhookDeliveryAttemptCreate) SetCreatedAt(v time.Time) *WebhookDeliveryAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebhookDeliveryAttemptCreate) SetNillableCreatedAt(v *time.Time) *WebhookDeliveryAttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WebhookDeliveryAttemptMutation object of the builder.
+func (_c *WebhookDeliveryAttemptCreate) Mutation() *WebhookDeliveryAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the WebhookDeliveryAttempt in the database.
+func (_c *WebhookDeliveryAttemptCreate) Save(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebhookDeliveryAttemptCreate) SaveX(ctx context.Context) *WebhookDeliveryAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookDeliveryAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookDeliveryAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebhookDeliveryAttemptCreate) defaults() {
+	if _, ok := _c.mutation.DeliveryID(); !ok {
+		v := webhookdeliveryattempt.DefaultDeliveryID
+		_c.mutation.SetDeliveryID(v)
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		v := webhookdeliveryattempt.DefaultEventID
+		_c.mutation.SetEventID(v)
+	}
+	if _, ok := _c.mutation.Attempt(); !ok {
+		v := webhookdeliveryattempt.DefaultAttempt
+		_c.mutation.SetAttempt(v)
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		v := webhookdeliveryattempt.DefaultLatencyMs
+		_c.mutation.SetLatencyMs(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webhookdeliveryattempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebhookDeliveryAttemptCreate) check() error {
+	if _, ok := _c.mutation.EndpointType(); !ok {
+		return &ValidationError{Name: "endpoint_type", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.endpoint_type"`)}
+	}
+	if v, ok := _c.mutation.EndpointType(); ok {
+		if err := webhookdeliveryattempt.EndpointTypeValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint_type", err: fmt.Errorf(`postgresql: validator failed for field "WebhookDeliveryAttempt.endpoint_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EndpointID(); !ok {
+		return &ValidationError{Name: "endpoint_id", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.endpoint_id"`)}
+	}
+	if v, ok := _c.mutation.EndpointID(); ok {
+		if err := webhookdeliveryattempt.EndpointIDValidator(v); err != nil {
+			return &ValidationError{Name: "endpoint_id", err: fmt.Errorf(`postgresql: validator failed for field "WebhookDeliveryAttempt.endpoint_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliveryID(); !ok {
+		return &ValidationError{Name: "delivery_id", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.delivery_id"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.event_id"`)}
+	}
+	if _, ok := _c.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.attempt"`)}
+	}
+	if v, ok := _c.mutation.Attempt(); ok {
+		if err := webhookdeliveryattempt.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`postgresql: validator failed for field "WebhookDeliveryAttempt.attempt": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.success"`)}
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.latency_ms"`)}
+	}
+	if v, ok := _c.mutation.LatencyMs(); ok {
+		if err := webhookdeliveryattempt.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`postgresql: validator failed for field "WebhookDeliveryAttempt.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := webhookdeliveryattempt.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`postgresql: validator failed for field "WebhookDeliveryAttempt.error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "WebhookDeliveryAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *WebhookDeliveryAttemptCreate) sqlSave(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebhookDeliveryAttemptCreate) createSpec() (*WebhookDeliveryAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDeliveryAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdeliveryattempt.Table, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EndpointType(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldEndpointType, field.TypeEnum, value)
+		_node.EndpointType = value
+	}
+	if value, ok := _c.mutation.EndpointID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldEndpointID, field.TypeString, value)
+		_node.EndpointID = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldClientID, field.TypeString, value)
+		_node.ClientID = &value
+	}
+	if value, ok := _c.mutation.DeliveryID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldDeliveryID, field.TypeString, value)
+		_node.DeliveryID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.Attempt(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = &value
+	}
+	if value, ok := _c.mutation.LatencyMs(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdeliveryattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryAttemptCreateBulk is the builder for creating many WebhookDeliveryAttempt entities in bulk.
+type WebhookDeliveryAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryAttemptCreate
+}
+
+// Save creates the WebhookDeliveryAttempt entities in the database.
+func (_c *WebhookDeliveryAttemptCreateBulk) Save(ctx context.Context) ([]*WebhookDeliveryAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebhookDeliveryAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebhookDeliveryAttemptCreateBulk) SaveX(ctx context.Context) []*WebhookDeliveryAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookDeliveryAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookDeliveryAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/predicate"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+)
+
+// WebhookDeliveryAttemptUpdate is the builder for updating WebhookDeliveryAttempt entities.
+type WebhookDeliveryAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryAttemptMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryAttemptUpdate builder.
+func (_u *WebhookDeliveryAttemptUpdate) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the WebhookDeliveryAttemptMutation object of the builder.
+func (_u *WebhookDeliveryAttemptUpdate) Mutation() *WebhookDeliveryAttemptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebhookDeliveryAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebhookDeliveryAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WebhookDeliveryAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdeliveryattempt.Table, webhookdeliveryattempt.Columns, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldClientID, field.TypeString)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldStatusCode, field.TypeInt)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldError, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeliveryattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebhookDeliveryAttemptUpdateOne is the builder for updating a single WebhookDeliveryAttempt entity.
+type WebhookDeliveryAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryAttemptMutation
+}
+
+// Mutation returns the WebhookDeliveryAttemptMutation object of the builder.
+func (_u *WebhookDeliveryAttemptUpdateOne) Mutation() *WebhookDeliveryAttemptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryAttemptUpdate builder.
+func (_u *WebhookDeliveryAttemptUpdateOne) Where(ps ...predicate.WebhookDeliveryAttempt) *WebhookDeliveryAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebhookDeliveryAttemptUpdateOne) Select(field string, fields ...string) *WebhookDeliveryAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebhookDeliveryAttempt entity.
+func (_u *WebhookDeliveryAttemptUpdateOne) Save(ctx context.Context) (*WebhookDeliveryAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryAttemptUpdateOne) SaveX(ctx context.Context) *WebhookDeliveryAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebhookDeliveryAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WebhookDeliveryAttemptUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDeliveryAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookdeliveryattempt.Table, webhookdeliveryattempt.Columns, sqlgraph.NewFieldSpec(webhookdeliveryattempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`postgresql: missing "WebhookDeliveryAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeliveryattempt.FieldID)
+		for _, f := range fields {
+			if !webhookdeliveryattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("postgresql: invalid field %q for query", f)}
+			}
+			if f != webhookdeliveryattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ClientIDCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldClientID, field.TypeString)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldStatusCode, field.TypeInt)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdeliveryattempt.FieldError, field.TypeString)
+	}
+	_node = &WebhookDeliveryAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeliveryattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -23,8 +23,22 @@ type WebhookEndpoint struct {
 	CallbackURL string `json:"callback_url,omitempty"`
 	// Bearer holds the value of the "bearer" field.
 	Bearer *string `json:"bearer,omitempty"`
+	// SigningSecret holds the value of the "signing_secret" field.
+	SigningSecret *string `json:"-"`
+	// PreviousSigningSecret holds the value of the "previous_signing_secret" field.
+	PreviousSigningSecret *string `json:"-"`
+	// PreviousSigningSecretExpiresAt holds the value of the "previous_signing_secret_expires_at" field.
+	PreviousSigningSecretExpiresAt *time.Time `json:"previous_signing_secret_expires_at,omitempty"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
+	// OwnerEmail holds the value of the "owner_email" field.
+	OwnerEmail *string `json:"owner_email,omitempty"`
+	// ConsecutiveFailures holds the value of the "consecutive_failures" field.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// FailingSince holds the value of the "failing_since" field.
+	FailingSince *time.Time `json:"failing_since,omitempty"`
+	// AutoDisabledAt holds the value of the "auto_disabled_at" field.
+	AutoDisabledAt *time.Time `json:"auto_disabled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -58,9 +72,11 @@ func (*WebhookEndpoint) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case webhookendpoint.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case webhookendpoint.FieldID, webhookendpoint.FieldCredential, webhookendpoint.FieldCallbackURL, webhookendpoint.FieldBearer:
+		case webhookendpoint.FieldConsecutiveFailures:
+			values[i] = new(sql.NullInt64)
+		case webhookendpoint.FieldID, webhookendpoint.FieldCredential, webhookendpoint.FieldCallbackURL, webhookendpoint.FieldBearer, webhookendpoint.FieldSigningSecret, webhookendpoint.FieldPreviousSigningSecret, webhookendpoint.FieldOwnerEmail:
 			values[i] = new(sql.NullString)
-		case webhookendpoint.FieldCreatedAt:
+		case webhookendpoint.FieldPreviousSigningSecretExpiresAt, webhookendpoint.FieldFailingSince, webhookendpoint.FieldAutoDisabledAt, webhookendpoint.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -102,12 +118,60 @@ func (_m *WebhookEndpoint) assignValues(columns []string, values []any) error {
 				_m.Bearer = new(string)
 				*_m.Bearer = value.String
 			}
+		case webhookendpoint.FieldSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_secret", values[i])
+			} else if value.Valid {
+				_m.SigningSecret = new(string)
+				*_m.SigningSecret = value.String
+			}
+		case webhookendpoint.FieldPreviousSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_signing_secret", values[i])
+			} else if value.Valid {
+				_m.PreviousSigningSecret = new(string)
+				*_m.PreviousSigningSecret = value.String
+			}
+		case webhookendpoint.FieldPreviousSigningSecretExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_signing_secret_expires_at", values[i])
+			} else if value.Valid {
+				_m.PreviousSigningSecretExpiresAt = new(time.Time)
+				*_m.PreviousSigningSecretExpiresAt = value.Time
+			}
 		case webhookendpoint.FieldEnabled:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enabled", values[i])
 			} else if value.Valid {
 				_m.Enabled = value.Bool
 			}
+		case webhookendpoint.FieldOwnerEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_email", values[i])
+			} else if value.Valid {
+				_m.OwnerEmail = new(string)
+				*_m.OwnerEmail = value.String
+			}
+		case webhookendpoint.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case webhookendpoint.FieldFailingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failing_since", values[i])
+			} else if value.Valid {
+				_m.FailingSince = new(time.Time)
+				*_m.FailingSince = value.Time
+			}
+		case webhookendpoint.FieldAutoDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_disabled_at", values[i])
+			} else if value.Valid {
+				_m.AutoDisabledAt = new(time.Time)
+				*_m.AutoDisabledAt = value.Time
+			}
 		case webhookendpoint.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -166,9 +230,36 @@ func (_m *WebhookEndpoint) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("signing_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_signing_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.PreviousSigningSecretExpiresAt; v != nil {
+		builder.WriteString("previous_signing_secret_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
 	builder.WriteString(", ")
+	if v := _m.OwnerEmail; v != nil {
+		builder.WriteString("owner_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.FailingSince; v != nil {
+		builder.WriteString("failing_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AutoDisabledAt; v != nil {
+		builder.WriteString("auto_disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
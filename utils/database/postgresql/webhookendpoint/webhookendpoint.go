@@ -20,8 +20,22 @@ const (
 	FieldCallbackURL = "callback_url"
 	// FieldBearer holds the string denoting the bearer field in the database.
 	FieldBearer = "bearer"
+	// FieldSigningSecret holds the string denoting the signing_secret field in the database.
+	FieldSigningSecret = "signing_secret"
+	// FieldPreviousSigningSecret holds the string denoting the previous_signing_secret field in the database.
+	FieldPreviousSigningSecret = "previous_signing_secret"
+	// FieldPreviousSigningSecretExpiresAt holds the string denoting the previous_signing_secret_expires_at field in the database.
+	FieldPreviousSigningSecretExpiresAt = "previous_signing_secret_expires_at"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldOwnerEmail holds the string denoting the owner_email field in the database.
+	FieldOwnerEmail = "owner_email"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldFailingSince holds the string denoting the failing_since field in the database.
+	FieldFailingSince = "failing_since"
+	// FieldAutoDisabledAt holds the string denoting the auto_disabled_at field in the database.
+	FieldAutoDisabledAt = "auto_disabled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
@@ -43,7 +57,14 @@ var Columns = []string{
 	FieldCredential,
 	FieldCallbackURL,
 	FieldBearer,
+	FieldSigningSecret,
+	FieldPreviousSigningSecret,
+	FieldPreviousSigningSecretExpiresAt,
 	FieldEnabled,
+	FieldOwnerEmail,
+	FieldConsecutiveFailures,
+	FieldFailingSince,
+	FieldAutoDisabledAt,
 	FieldCreatedAt,
 }
 
@@ -64,6 +85,10 @@ var (
 	CallbackURLValidator func(string) error
 	// DefaultEnabled holds the default value on creation for the "enabled" field.
 	DefaultEnabled bool
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	ConsecutiveFailuresValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -93,11 +118,46 @@ func ByBearer(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBearer, opts...).ToFunc()
 }
 
+// BySigningSecret orders the results by the signing_secret field.
+func BySigningSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningSecret, opts...).ToFunc()
+}
+
+// ByPreviousSigningSecret orders the results by the previous_signing_secret field.
+func ByPreviousSigningSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousSigningSecret, opts...).ToFunc()
+}
+
+// ByPreviousSigningSecretExpiresAt orders the results by the previous_signing_secret_expires_at field.
+func ByPreviousSigningSecretExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousSigningSecretExpiresAt, opts...).ToFunc()
+}
+
 // ByEnabled orders the results by the enabled field.
 func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
 
+// ByOwnerEmail orders the results by the owner_email field.
+func ByOwnerEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerEmail, opts...).ToFunc()
+}
+
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByFailingSince orders the results by the failing_since field.
+func ByFailingSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailingSince, opts...).ToFunc()
+}
+
+// ByAutoDisabledAt orders the results by the auto_disabled_at field.
+func ByAutoDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoDisabledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
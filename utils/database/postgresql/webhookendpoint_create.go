@@ -47,6 +47,48 @@ func (_c *WebhookEndpointCreate) SetNillableBearer(v *string) *WebhookEndpointCr
 	return _c
 }
 
+// SetSigningSecret sets the "signing_secret" field.
+func (_c *WebhookEndpointCreate) SetSigningSecret(v string) *WebhookEndpointCreate {
+	_c.mutation.SetSigningSecret(v)
+	return _c
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableSigningSecret(v *string) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetSigningSecret(*v)
+	}
+	return _c
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (_c *WebhookEndpointCreate) SetPreviousSigningSecret(v string) *WebhookEndpointCreate {
+	_c.mutation.SetPreviousSigningSecret(v)
+	return _c
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillablePreviousSigningSecret(v *string) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetPreviousSigningSecret(*v)
+	}
+	return _c
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (_c *WebhookEndpointCreate) SetPreviousSigningSecretExpiresAt(v time.Time) *WebhookEndpointCreate {
+	_c.mutation.SetPreviousSigningSecretExpiresAt(v)
+	return _c
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillablePreviousSigningSecretExpiresAt(v *time.Time) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetPreviousSigningSecretExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetEnabled sets the "enabled" field.
 func (_c *WebhookEndpointCreate) SetEnabled(v bool) *WebhookEndpointCreate {
 	_c.mutation.SetEnabled(v)
@@ -61,6 +103,62 @@ func (_c *WebhookEndpointCreate) SetNillableEnabled(v *bool) *WebhookEndpointCre
 	return _c
 }
 
+// SetOwnerEmail sets the "owner_email" field.
+func (_c *WebhookEndpointCreate) SetOwnerEmail(v string) *WebhookEndpointCreate {
+	_c.mutation.SetOwnerEmail(v)
+	return _c
+}
+
+// SetNillableOwnerEmail sets the "owner_email" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableOwnerEmail(v *string) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetOwnerEmail(*v)
+	}
+	return _c
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *WebhookEndpointCreate) SetConsecutiveFailures(v int) *WebhookEndpointCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableConsecutiveFailures(v *int) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (_c *WebhookEndpointCreate) SetFailingSince(v time.Time) *WebhookEndpointCreate {
+	_c.mutation.SetFailingSince(v)
+	return _c
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableFailingSince(v *time.Time) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetFailingSince(*v)
+	}
+	return _c
+}
+
+// SetAutoDisabledAt sets the "auto_disabled_at" field.
+func (_c *WebhookEndpointCreate) SetAutoDisabledAt(v time.Time) *WebhookEndpointCreate {
+	_c.mutation.SetAutoDisabledAt(v)
+	return _c
+}
+
+// SetNillableAutoDisabledAt sets the "auto_disabled_at" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableAutoDisabledAt(v *time.Time) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetAutoDisabledAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *WebhookEndpointCreate) SetCreatedAt(v time.Time) *WebhookEndpointCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -135,6 +233,10 @@ func (_c *WebhookEndpointCreate) defaults() {
 		v := webhookendpoint.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := webhookendpoint.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := webhookendpoint.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -162,6 +264,14 @@ func (_c *WebhookEndpointCreate) check() error {
 	if _, ok := _c.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`postgresql: missing required field "WebhookEndpoint.enabled"`)}
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`postgresql: missing required field "WebhookEndpoint.consecutive_failures"`)}
+	}
+	if v, ok := _c.mutation.ConsecutiveFailures(); ok {
+		if err := webhookendpoint.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`postgresql: validator failed for field "WebhookEndpoint.consecutive_failures": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`postgresql: missing required field "WebhookEndpoint.created_at"`)}
 	}
@@ -217,10 +327,38 @@ func (_c *WebhookEndpointCreate) createSpec() (*WebhookEndpoint, *sqlgraph.Creat
 		_spec.SetField(webhookendpoint.FieldBearer, field.TypeString, value)
 		_node.Bearer = &value
 	}
+	if value, ok := _c.mutation.SigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldSigningSecret, field.TypeString, value)
+		_node.SigningSecret = &value
+	}
+	if value, ok := _c.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+		_node.PreviousSigningSecret = &value
+	}
+	if value, ok := _c.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+		_node.PreviousSigningSecretExpiresAt = &value
+	}
 	if value, ok := _c.mutation.Enabled(); ok {
 		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := _c.mutation.OwnerEmail(); ok {
+		_spec.SetField(webhookendpoint.FieldOwnerEmail, field.TypeString, value)
+		_node.OwnerEmail = &value
+	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.FailingSince(); ok {
+		_spec.SetField(webhookendpoint.FieldFailingSince, field.TypeTime, value)
+		_node.FailingSince = &value
+	}
+	if value, ok := _c.mutation.AutoDisabledAt(); ok {
+		_spec.SetField(webhookendpoint.FieldAutoDisabledAt, field.TypeTime, value)
+		_node.AutoDisabledAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(webhookendpoint.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -77,6 +77,66 @@ func (_u *WebhookEndpointUpdate) ClearBearer() *WebhookEndpointUpdate {
 	return _u
 }
 
+// SetSigningSecret sets the "signing_secret" field.
+func (_u *WebhookEndpointUpdate) SetSigningSecret(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetSigningSecret(v)
+	return _u
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableSigningSecret(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (_u *WebhookEndpointUpdate) ClearSigningSecret() *WebhookEndpointUpdate {
+	_u.mutation.ClearSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (_u *WebhookEndpointUpdate) SetPreviousSigningSecret(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetPreviousSigningSecret(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillablePreviousSigningSecret(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetPreviousSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (_u *WebhookEndpointUpdate) ClearPreviousSigningSecret() *WebhookEndpointUpdate {
+	_u.mutation.ClearPreviousSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (_u *WebhookEndpointUpdate) SetPreviousSigningSecretExpiresAt(v time.Time) *WebhookEndpointUpdate {
+	_u.mutation.SetPreviousSigningSecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillablePreviousSigningSecretExpiresAt(v *time.Time) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetPreviousSigningSecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (_u *WebhookEndpointUpdate) ClearPreviousSigningSecretExpiresAt() *WebhookEndpointUpdate {
+	_u.mutation.ClearPreviousSigningSecretExpiresAt()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *WebhookEndpointUpdate) SetEnabled(v bool) *WebhookEndpointUpdate {
 	_u.mutation.SetEnabled(v)
@@ -91,6 +151,87 @@ func (_u *WebhookEndpointUpdate) SetNillableEnabled(v *bool) *WebhookEndpointUpd
 	return _u
 }
 
+// SetOwnerEmail sets the "owner_email" field.
+func (_u *WebhookEndpointUpdate) SetOwnerEmail(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetOwnerEmail(v)
+	return _u
+}
+
+// SetNillableOwnerEmail sets the "owner_email" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableOwnerEmail(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetOwnerEmail(*v)
+	}
+	return _u
+}
+
+// ClearOwnerEmail clears the value of the "owner_email" field.
+func (_u *WebhookEndpointUpdate) ClearOwnerEmail() *WebhookEndpointUpdate {
+	_u.mutation.ClearOwnerEmail()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *WebhookEndpointUpdate) SetConsecutiveFailures(v int) *WebhookEndpointUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableConsecutiveFailures(v *int) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *WebhookEndpointUpdate) AddConsecutiveFailures(v int) *WebhookEndpointUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (_u *WebhookEndpointUpdate) SetFailingSince(v time.Time) *WebhookEndpointUpdate {
+	_u.mutation.SetFailingSince(v)
+	return _u
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableFailingSince(v *time.Time) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetFailingSince(*v)
+	}
+	return _u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (_u *WebhookEndpointUpdate) ClearFailingSince() *WebhookEndpointUpdate {
+	_u.mutation.ClearFailingSince()
+	return _u
+}
+
+// SetAutoDisabledAt sets the "auto_disabled_at" field.
+func (_u *WebhookEndpointUpdate) SetAutoDisabledAt(v time.Time) *WebhookEndpointUpdate {
+	_u.mutation.SetAutoDisabledAt(v)
+	return _u
+}
+
+// SetNillableAutoDisabledAt sets the "auto_disabled_at" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableAutoDisabledAt(v *time.Time) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetAutoDisabledAt(*v)
+	}
+	return _u
+}
+
+// ClearAutoDisabledAt clears the value of the "auto_disabled_at" field.
+func (_u *WebhookEndpointUpdate) ClearAutoDisabledAt() *WebhookEndpointUpdate {
+	_u.mutation.ClearAutoDisabledAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *WebhookEndpointUpdate) SetCreatedAt(v time.Time) *WebhookEndpointUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -185,6 +326,11 @@ func (_u *WebhookEndpointUpdate) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`postgresql: validator failed for field "WebhookEndpoint.callback_url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := webhookendpoint.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`postgresql: validator failed for field "WebhookEndpoint.consecutive_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -212,9 +358,51 @@ func (_u *WebhookEndpointUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.BearerCleared() {
 		_spec.ClearField(webhookendpoint.FieldBearer, field.TypeString)
 	}
+	if value, ok := _u.mutation.SigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.SigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.PreviousSigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.OwnerEmail(); ok {
+		_spec.SetField(webhookendpoint.FieldOwnerEmail, field.TypeString, value)
+	}
+	if _u.mutation.OwnerEmailCleared() {
+		_spec.ClearField(webhookendpoint.FieldOwnerEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailingSince(); ok {
+		_spec.SetField(webhookendpoint.FieldFailingSince, field.TypeTime, value)
+	}
+	if _u.mutation.FailingSinceCleared() {
+		_spec.ClearField(webhookendpoint.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoDisabledAt(); ok {
+		_spec.SetField(webhookendpoint.FieldAutoDisabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.AutoDisabledAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldAutoDisabledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(webhookendpoint.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -331,6 +519,66 @@ func (_u *WebhookEndpointUpdateOne) ClearBearer() *WebhookEndpointUpdateOne {
 	return _u
 }
 
+// SetSigningSecret sets the "signing_secret" field.
+func (_u *WebhookEndpointUpdateOne) SetSigningSecret(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetSigningSecret(v)
+	return _u
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableSigningSecret(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (_u *WebhookEndpointUpdateOne) ClearSigningSecret() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (_u *WebhookEndpointUpdateOne) SetPreviousSigningSecret(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetPreviousSigningSecret(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillablePreviousSigningSecret(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetPreviousSigningSecret(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (_u *WebhookEndpointUpdateOne) ClearPreviousSigningSecret() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearPreviousSigningSecret()
+	return _u
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (_u *WebhookEndpointUpdateOne) SetPreviousSigningSecretExpiresAt(v time.Time) *WebhookEndpointUpdateOne {
+	_u.mutation.SetPreviousSigningSecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillablePreviousSigningSecretExpiresAt(v *time.Time) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetPreviousSigningSecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (_u *WebhookEndpointUpdateOne) ClearPreviousSigningSecretExpiresAt() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearPreviousSigningSecretExpiresAt()
+	return _u
+}
+
 // SetEnabled sets the "enabled" field.
 func (_u *WebhookEndpointUpdateOne) SetEnabled(v bool) *WebhookEndpointUpdateOne {
 	_u.mutation.SetEnabled(v)
@@ -345,6 +593,87 @@ func (_u *WebhookEndpointUpdateOne) SetNillableEnabled(v *bool) *WebhookEndpoint
 	return _u
 }
 
+// SetOwnerEmail sets the "owner_email" field.
+func (_u *WebhookEndpointUpdateOne) SetOwnerEmail(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetOwnerEmail(v)
+	return _u
+}
+
+// SetNillableOwnerEmail sets the "owner_email" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableOwnerEmail(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetOwnerEmail(*v)
+	}
+	return _u
+}
+
+// ClearOwnerEmail clears the value of the "owner_email" field.
+func (_u *WebhookEndpointUpdateOne) ClearOwnerEmail() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearOwnerEmail()
+	return _u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *WebhookEndpointUpdateOne) SetConsecutiveFailures(v int) *WebhookEndpointUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableConsecutiveFailures(v *int) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *WebhookEndpointUpdateOne) AddConsecutiveFailures(v int) *WebhookEndpointUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetFailingSince sets the "failing_since" field.
+func (_u *WebhookEndpointUpdateOne) SetFailingSince(v time.Time) *WebhookEndpointUpdateOne {
+	_u.mutation.SetFailingSince(v)
+	return _u
+}
+
+// SetNillableFailingSince sets the "failing_since" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableFailingSince(v *time.Time) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetFailingSince(*v)
+	}
+	return _u
+}
+
+// ClearFailingSince clears the value of the "failing_since" field.
+func (_u *WebhookEndpointUpdateOne) ClearFailingSince() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearFailingSince()
+	return _u
+}
+
+// SetAutoDisabledAt sets the "auto_disabled_at" field.
+func (_u *WebhookEndpointUpdateOne) SetAutoDisabledAt(v time.Time) *WebhookEndpointUpdateOne {
+	_u.mutation.SetAutoDisabledAt(v)
+	return _u
+}
+
+// SetNillableAutoDisabledAt sets the "auto_disabled_at" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableAutoDisabledAt(v *time.Time) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetAutoDisabledAt(*v)
+	}
+	return _u
+}
+
+// ClearAutoDisabledAt clears the value of the "auto_disabled_at" field.
+func (_u *WebhookEndpointUpdateOne) ClearAutoDisabledAt() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearAutoDisabledAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *WebhookEndpointUpdateOne) SetCreatedAt(v time.Time) *WebhookEndpointUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -452,6 +781,11 @@ func (_u *WebhookEndpointUpdateOne) check() error {
 			return &ValidationError{Name: "callback_url", err: fmt.Errorf(`postgresql: validator failed for field "WebhookEndpoint.callback_url": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := webhookendpoint.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`postgresql: validator failed for field "WebhookEndpoint.consecutive_failures": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -496,9 +830,51 @@ func (_u *WebhookEndpointUpdateOne) sqlSave(ctx context.Context) (_node *Webhook
 	if _u.mutation.BearerCleared() {
 		_spec.ClearField(webhookendpoint.FieldBearer, field.TypeString)
 	}
+	if value, ok := _u.mutation.SigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.SigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecret(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString, value)
+	}
+	if _u.mutation.PreviousSigningSecretCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.SetField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldPreviousSigningSecretExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Enabled(); ok {
 		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.OwnerEmail(); ok {
+		_spec.SetField(webhookendpoint.FieldOwnerEmail, field.TypeString, value)
+	}
+	if _u.mutation.OwnerEmailCleared() {
+		_spec.ClearField(webhookendpoint.FieldOwnerEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhookendpoint.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailingSince(); ok {
+		_spec.SetField(webhookendpoint.FieldFailingSince, field.TypeTime, value)
+	}
+	if _u.mutation.FailingSinceCleared() {
+		_spec.ClearField(webhookendpoint.FieldFailingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoDisabledAt(); ok {
+		_spec.SetField(webhookendpoint.FieldAutoDisabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.AutoDisabledAtCleared() {
+		_spec.ClearField(webhookendpoint.FieldAutoDisabledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(webhookendpoint.FieldCreatedAt, field.TypeTime, value)
 	}
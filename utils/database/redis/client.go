@@ -7,6 +7,7 @@ import (
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	"time"
 
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -29,6 +30,9 @@ func NewRedisClient(cfg config.RedisConfig) *HarukiRedisManager {
 		Password: cfg.Password,
 		DB:       0,
 	})
+	if err := redisotel.InstrumentTracing(client); err != nil {
+		harukiLogger.Warnf("Failed to instrument Redis tracing: %v", err)
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), redisPingTimeout)
 	defer cancel()
 	if err := client.Ping(ctx).Err(); err != nil {
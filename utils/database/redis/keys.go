@@ -43,9 +43,21 @@ const (
 	KeyModulePublicAPI = "public-api"
 	KeyActionCache     = "cache"
 
+	KeyModuleDataProvider = "data-provider"
+	KeyActionDelivery     = "delivery"
+
 	KeyModuleBot      = "bot"
 	KeyActionRegister = "register"
 
+	KeyModuleCoordination = "coordination"
+	KeyActionLock         = "lock"
+	KeyActionLeader       = "leader"
+
+	KeyModuleRiskEngine = "risk-engine"
+	KeyActionCounter    = "counter"
+	KeyActionWindow     = "window"
+	KeyActionCooldown   = "cooldown"
+
 	KeyModuleMysekaiBirthday = "mysekai-birthday"
 	KeyActionMonitor         = "monitor"
 	KeyActionSubscription    = "subscription"
@@ -208,6 +220,34 @@ func BuildRuntimeConfigKey() string {
 	return buildKey(KeyPrefixHaruki, KeyModuleConfig, KeyActionRuntime)
 }
 
+func BuildDataProviderDeliveryKey(provider string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleDataProvider, KeyActionDelivery, provider)
+}
+
+func BuildCoordinationLockKey(name string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleCoordination, KeyActionLock, name)
+}
+
+func BuildCoordinationLeaderKey(role string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleCoordination, KeyActionLeader, role)
+}
+
+func BuildCoordinationRateLimitKey(name string, windowUnix int64, bucket string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleCoordination, KeyModuleRateLimit, name, strconv.FormatInt(windowUnix, 10), bucket)
+}
+
+func BuildRiskEngineCounterKey(ruleKey, ruleType string, windowUnix int64, group string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleRiskEngine, KeyActionCounter, ruleKey, ruleType, strconv.FormatInt(windowUnix, 10), hashNormalizedIdentifier(group))
+}
+
+func BuildRiskEngineWindowKey(ruleKey, ruleType, group string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleRiskEngine, KeyActionWindow, ruleKey, ruleType, hashNormalizedIdentifier(group))
+}
+
+func BuildRiskEngineCooldownKey(ruleKey, ruleType, group string) string {
+	return buildKey(KeyPrefixHaruki, KeyModuleRiskEngine, KeyActionCooldown, ruleKey, ruleType, hashNormalizedIdentifier(group))
+}
+
 func BuildMysekaiBirthdayMonitorKey(server, gameUserID string) string {
 	return buildKey(KeyPrefixHaruki, KeyModuleMysekaiBirthday, KeyActionMonitor, strings.TrimSpace(server), strings.TrimSpace(gameUserID))
 }
@@ -138,6 +138,36 @@ func TestBuildKeys(t *testing.T) {
 			got:  BuildIOSUploadChunkClaimKey("toolbox-user|jp|123456|upload-id"),
 			want: "haruki:upload:ios:chunk-claim:toolbox-user|jp|123456|upload-id",
 		},
+		{
+			name: "coordination lock",
+			got:  BuildCoordinationLockKey("runtime-config"),
+			want: "haruki:coordination:lock:runtime-config",
+		},
+		{
+			name: "coordination leader",
+			got:  BuildCoordinationLeaderKey("afdian-sponsor-sync"),
+			want: "haruki:coordination:leader:afdian-sponsor-sync",
+		},
+		{
+			name: "coordination rate limit",
+			got:  BuildCoordinationRateLimitKey("example", 42, "127.0.0.1"),
+			want: "haruki:coordination:rate-limit:example:42:127.0.0.1",
+		},
+		{
+			name: "risk engine counter",
+			got:  BuildRiskEngineCounterKey("login-burst", "threshold", 42, "127.0.0.1"),
+			want: "haruki:risk-engine:counter:login-burst:threshold:42:" + hashNormalizedIdentifier("127.0.0.1"),
+		},
+		{
+			name: "risk engine window",
+			got:  BuildRiskEngineWindowKey("login-spray", "sliding_window", "127.0.0.1"),
+			want: "haruki:risk-engine:window:login-spray:sliding_window:" + hashNormalizedIdentifier("127.0.0.1"),
+		},
+		{
+			name: "risk engine cooldown",
+			got:  BuildRiskEngineCooldownKey("login-spray", "sliding_window", "127.0.0.1"),
+			want: "haruki:risk-engine:cooldown:login-spray:sliding_window:" + hashNormalizedIdentifier("127.0.0.1"),
+		},
 	}
 
 	for _, tc := range tests {
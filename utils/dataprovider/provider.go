@@ -0,0 +1,223 @@
+package dataprovider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/toolbox/schema"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+)
+
+const (
+	FormatRaw      = "raw"
+	FormatJSONZstd = "json-zstd"
+	FormatRestored = "restored"
+
+	AuthBearer       = "bearer"
+	AuthXCredentials = "x-credentials"
+
+	MaxProviders = 32
+)
+
+var (
+	namePattern       = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+	consentKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+)
+
+// Normalize trims and validates a provider definition, filling in the
+// defaults: bearer auth, raw format and the provider name as consent key.
+func Normalize(p harukiConfig.DataProviderConfig) (harukiConfig.DataProviderConfig, error) {
+	out := harukiConfig.DataProviderConfig{
+		Name:        strings.ToLower(strings.TrimSpace(p.Name)),
+		DisplayName: strings.TrimSpace(p.DisplayName),
+		Enabled:     p.Enabled,
+		Endpoint:    strings.TrimSpace(p.Endpoint),
+		Secret:      strings.TrimSpace(p.Secret),
+		Auth:        strings.ToLower(strings.TrimSpace(p.Auth)),
+		Format:      strings.ToLower(strings.TrimSpace(p.Format)),
+		CheckURL:    strings.TrimSpace(p.CheckURL),
+		Privacy: harukiConfig.DataProviderPrivacyConfig{
+			ConsentKey:       strings.TrimSpace(p.Privacy.ConsentKey),
+			RequirePublicAPI: p.Privacy.RequirePublicAPI,
+		},
+	}
+	if !namePattern.MatchString(out.Name) {
+		return out, fmt.Errorf("provider name %q must be lowercase letters, digits, '-' or '_'", p.Name)
+	}
+	if out.DisplayName == "" {
+		out.DisplayName = out.Name
+	}
+	if err := validateURL(out.Endpoint); err != nil {
+		return out, fmt.Errorf("provider %s endpoint: %w", out.Name, err)
+	}
+	if out.CheckURL != "" {
+		if err := validateURL(out.CheckURL); err != nil {
+			return out, fmt.Errorf("provider %s check_url: %w", out.Name, err)
+		}
+	}
+
+	switch out.Auth {
+	case "":
+		out.Auth = AuthBearer
+	case AuthBearer, AuthXCredentials:
+	default:
+		return out, fmt.Errorf("provider %s has unsupported auth %q", out.Name, p.Auth)
+	}
+	switch out.Format {
+	case "":
+		out.Format = FormatRaw
+	case FormatRaw, FormatJSONZstd, FormatRestored:
+	default:
+		return out, fmt.Errorf("provider %s has unsupported format %q", out.Name, p.Format)
+	}
+
+	for _, raw := range p.Servers {
+		server, err := utils.ParseSupportedDataUploadServer(strings.ToLower(strings.TrimSpace(raw)))
+		if err != nil {
+			return out, fmt.Errorf("provider %s has unsupported server %q", out.Name, raw)
+		}
+		if !containsString(out.Servers, string(server)) {
+			out.Servers = append(out.Servers, string(server))
+		}
+	}
+	for _, raw := range p.DataTypes {
+		dataType, err := utils.ParseUploadDataType(strings.ToLower(strings.TrimSpace(raw)))
+		if err != nil {
+			return out, fmt.Errorf("provider %s has unsupported data type %q", out.Name, raw)
+		}
+		if !containsString(out.DataTypes, string(dataType)) {
+			out.DataTypes = append(out.DataTypes, string(dataType))
+		}
+	}
+	if len(out.DataTypes) == 0 {
+		return out, fmt.Errorf("provider %s must enable at least one data type", out.Name)
+	}
+
+	if out.Privacy.ConsentKey == "" {
+		out.Privacy.ConsentKey = out.Name
+	}
+	if !consentKeyPattern.MatchString(out.Privacy.ConsentKey) {
+		return out, fmt.Errorf("provider %s has invalid consent key %q", out.Name, out.Privacy.ConsentKey)
+	}
+	return out, nil
+}
+
+// NormalizeList normalizes every provider and rejects duplicate names.
+func NormalizeList(providers []harukiConfig.DataProviderConfig) ([]harukiConfig.DataProviderConfig, error) {
+	if len(providers) > MaxProviders {
+		return nil, fmt.Errorf("at most %d data providers are allowed", MaxProviders)
+	}
+	out := make([]harukiConfig.DataProviderConfig, 0, len(providers))
+	seen := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		normalized, err := Normalize(provider)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[normalized.Name]; ok {
+			return nil, fmt.Errorf("data provider %s is defined more than once", normalized.Name)
+		}
+		seen[normalized.Name] = struct{}{}
+		out = append(out, normalized)
+	}
+	return out, nil
+}
+
+func validateURL(raw string) error {
+	if raw == "" {
+		return fmt.Errorf("is required")
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("must be an absolute http(s) URL")
+	}
+	return nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Accepts reports whether the provider is enabled for this server and data
+// type.
+func Accepts(p harukiConfig.DataProviderConfig, server utils.SupportedDataUploadServer, dataType utils.UploadDataType) bool {
+	if !p.Enabled || !containsString(p.DataTypes, string(dataType)) {
+		return false
+	}
+	return len(p.Servers) == 0 || containsString(p.Servers, string(server))
+}
+
+// Allowed reports whether the game account's privacy settings for dataType
+// allow sending it to the provider. The legacy allow* switches are checked
+// first, then the per-provider AllowProviders map. Data types without
+// privacy settings are never sent.
+func Allowed(p harukiConfig.DataProviderConfig, dataType utils.UploadDataType, suite *schema.SuiteDataPrivacySettings, mysekai *schema.MysekaiDataPrivacySettings) bool {
+	var flags map[string]bool
+	var extra map[string]bool
+	switch dataType {
+	case utils.UploadDataTypeSuite:
+		if suite == nil {
+			return false
+		}
+		flags = map[string]bool{
+			"allowPublicApi": suite.AllowPublicApi,
+			"allowSakura":    suite.AllowSakura,
+			"allow8823":      suite.Allow8823,
+			"allowResona":    suite.AllowResona,
+			"allowLuna":      suite.AllowLuna,
+		}
+		extra = suite.AllowProviders
+	case utils.UploadDataTypeMysekai, utils.UploadDataTypeMysekaiBirthdayParty:
+		if mysekai == nil {
+			return false
+		}
+		flags = map[string]bool{
+			"allowPublicApi":  mysekai.AllowPublicApi,
+			"allowFixtureApi": mysekai.AllowFixtureApi,
+			"allow8823":       mysekai.Allow8823,
+			"allowResona":     mysekai.AllowResona,
+			"allowLuna":       mysekai.AllowLuna,
+		}
+		extra = mysekai.AllowProviders
+	default:
+		return false
+	}
+
+	if p.Privacy.RequirePublicAPI && !flags["allowPublicApi"] {
+		return false
+	}
+	key := p.Privacy.ConsentKey
+	if key == "" {
+		key = p.Name
+	}
+	if allowed, ok := flags[key]; ok {
+		return allowed
+	}
+	return extra[key]
+}
+
+// Select returns the providers an upload should be sent to, in definition
+// order.
+func Select(
+	providers []harukiConfig.DataProviderConfig,
+	server utils.SupportedDataUploadServer,
+	dataType utils.UploadDataType,
+	suite *schema.SuiteDataPrivacySettings,
+	mysekai *schema.MysekaiDataPrivacySettings,
+) []harukiConfig.DataProviderConfig {
+	var selected []harukiConfig.DataProviderConfig
+	for _, provider := range providers {
+		if Accepts(provider, server, dataType) && Allowed(provider, dataType, suite, mysekai) {
+			selected = append(selected, provider)
+		}
+	}
+	return selected
+}
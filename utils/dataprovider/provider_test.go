@@ -0,0 +1,91 @@
+package dataprovider
+
+import (
+	"testing"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/toolbox/schema"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	got, err := Normalize(harukiConfig.DataProviderConfig{
+		Name:      " Partner ",
+		Endpoint:  "https://partner.example/upload/{server}",
+		Secret:    " secret ",
+		Servers:   []string{"JP", "jp", "en"},
+		DataTypes: []string{"suite", "suite"},
+	})
+	if err != nil {
+		t.Fatalf("Normalize returned error: %v", err)
+	}
+	if got.Name != "partner" || got.DisplayName != "partner" || got.Secret != "secret" {
+		t.Fatalf("unexpected identity fields: %+v", got)
+	}
+	if got.Auth != AuthBearer || got.Format != FormatRaw || got.Privacy.ConsentKey != "partner" {
+		t.Fatalf("defaults not applied: %+v", got)
+	}
+	if len(got.Servers) != 2 || len(got.DataTypes) != 1 {
+		t.Fatalf("servers/data types not deduplicated: %+v", got)
+	}
+
+	invalid := []harukiConfig.DataProviderConfig{
+		{Name: "bad name", Endpoint: "https://x", DataTypes: []string{"suite"}},
+		{Name: "p", Endpoint: "ftp://x", DataTypes: []string{"suite"}},
+		{Name: "p", Endpoint: "https://x", DataTypes: []string{"suite"}, CheckURL: "/relative"},
+		{Name: "p", Endpoint: "https://x", DataTypes: []string{"suite"}, Auth: "basic"},
+		{Name: "p", Endpoint: "https://x", DataTypes: []string{"suite"}, Format: "xml"},
+		{Name: "p", Endpoint: "https://x", DataTypes: []string{"suite"}, Servers: []string{"us"}},
+		{Name: "p", Endpoint: "https://x", DataTypes: []string{"music"}},
+		{Name: "p", Endpoint: "https://x"},
+	}
+	for _, provider := range invalid {
+		if _, err := Normalize(provider); err == nil {
+			t.Fatalf("Normalize(%+v) should fail", provider)
+		}
+	}
+}
+
+func TestNormalizeListRejectsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	providers := []harukiConfig.DataProviderConfig{
+		{Name: "a", Endpoint: "https://a", DataTypes: []string{"suite"}},
+		{Name: "A", Endpoint: "https://b", DataTypes: []string{"suite"}},
+	}
+	if _, err := NormalizeList(providers); err == nil {
+		t.Fatalf("duplicate provider names should be rejected")
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	t.Parallel()
+
+	legacy := harukiConfig.DataProviderConfig{Name: "sakura", Privacy: harukiConfig.DataProviderPrivacyConfig{ConsentKey: "allowSakura"}}
+	custom := harukiConfig.DataProviderConfig{Name: "partner"}
+	suite := &schema.SuiteDataPrivacySettings{AllowSakura: true, AllowProviders: map[string]bool{"partner": true}}
+
+	if !Allowed(legacy, utils.UploadDataTypeSuite, suite, nil) {
+		t.Fatalf("built-in consent key should be honoured")
+	}
+	if !Allowed(custom, utils.UploadDataTypeSuite, suite, nil) {
+		t.Fatalf("allowProviders consent should be honoured")
+	}
+	if Allowed(custom, utils.UploadDataTypeMysekai, suite, nil) {
+		t.Fatalf("mysekai without settings should not be allowed")
+	}
+	if Allowed(legacy, utils.UploadDataTypeSuite, &schema.SuiteDataPrivacySettings{AllowProviders: map[string]bool{"allowSakura": true}}, nil) {
+		t.Fatalf("allowProviders must not override a built-in consent key")
+	}
+
+	custom.Privacy.RequirePublicAPI = true
+	if Allowed(custom, utils.UploadDataTypeSuite, suite, nil) {
+		t.Fatalf("requirePublicApi should need allowPublicApi")
+	}
+	suite.AllowPublicApi = true
+	if !Allowed(custom, utils.UploadDataTypeSuite, suite, nil) {
+		t.Fatalf("provider should be allowed once allowPublicApi is on")
+	}
+}
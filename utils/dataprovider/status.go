@@ -0,0 +1,144 @@
+package dataprovider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+	OutcomeError   = "error"
+	OutcomeSkipped = "skipped"
+
+	maxStoredErrorLength = 512
+
+	fieldLastAttemptAt  = "last_attempt_at"
+	fieldLastSuccessAt  = "last_success_at"
+	fieldLastFailureAt  = "last_failure_at"
+	fieldLastStatusCode = "last_status_code"
+	fieldLastError      = "last_error"
+	fieldLastDataType   = "last_data_type"
+)
+
+// Delivery is one delivery attempt to a provider.
+type Delivery struct {
+	Provider   string
+	DataType   string
+	Outcome    string
+	StatusCode int
+	Error      string
+	At         time.Time
+}
+
+// DeliveryStatus aggregates the deliveries to one provider.
+type DeliveryStatus struct {
+	Provider       string     `json:"provider"`
+	Success        int64      `json:"success"`
+	Failure        int64      `json:"failure"`
+	Error          int64      `json:"error"`
+	Skipped        int64      `json:"skipped"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt,omitempty"`
+	LastSuccessAt  *time.Time `json:"lastSuccessAt,omitempty"`
+	LastFailureAt  *time.Time `json:"lastFailureAt,omitempty"`
+	LastStatusCode int        `json:"lastStatusCode,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	LastDataType   string     `json:"lastDataType,omitempty"`
+}
+
+// RecordDelivery folds a delivery into the provider's status hash.
+func RecordDelivery(ctx context.Context, client *redis.Client, d Delivery) error {
+	if client == nil || d.Provider == "" {
+		return nil
+	}
+	at := strconv.FormatInt(d.At.UTC().Unix(), 10)
+	key := harukiRedis.BuildDataProviderDeliveryKey(d.Provider)
+	fields := map[string]any{
+		fieldLastAttemptAt: at,
+		fieldLastDataType:  d.DataType,
+	}
+	switch d.Outcome {
+	case OutcomeSuccess:
+		fields[fieldLastSuccessAt] = at
+		fields[fieldLastStatusCode] = d.StatusCode
+	case OutcomeFailure, OutcomeError:
+		fields[fieldLastFailureAt] = at
+		fields[fieldLastStatusCode] = d.StatusCode
+		errText := d.Error
+		if len(errText) > maxStoredErrorLength {
+			errText = errText[:maxStoredErrorLength]
+		}
+		fields[fieldLastError] = errText
+	}
+
+	pipe := client.TxPipeline()
+	pipe.HIncrBy(ctx, key, d.Outcome, 1)
+	pipe.HSet(ctx, key, fields)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetDeliveryStatus returns the aggregated status of every named provider,
+// in the given order. Providers without deliveries get zero counters.
+func GetDeliveryStatus(ctx context.Context, client *redis.Client, providers []string) ([]DeliveryStatus, error) {
+	statuses := make([]DeliveryStatus, 0, len(providers))
+	if client == nil {
+		for _, provider := range providers {
+			statuses = append(statuses, DeliveryStatus{Provider: provider})
+		}
+		return statuses, nil
+	}
+
+	pipe := client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(providers))
+	for _, provider := range providers {
+		cmds = append(cmds, pipe.HGetAll(ctx, harukiRedis.BuildDataProviderDeliveryKey(provider)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	for i, provider := range providers {
+		values, err := cmds[i].Result()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		statuses = append(statuses, statusFromHash(provider, values))
+	}
+	return statuses, nil
+}
+
+func statusFromHash(provider string, values map[string]string) DeliveryStatus {
+	status := DeliveryStatus{
+		Provider:      provider,
+		Success:       parseInt64(values[OutcomeSuccess]),
+		Failure:       parseInt64(values[OutcomeFailure]),
+		Error:         parseInt64(values[OutcomeError]),
+		Skipped:       parseInt64(values[OutcomeSkipped]),
+		LastAttemptAt: parseUnix(values[fieldLastAttemptAt]),
+		LastSuccessAt: parseUnix(values[fieldLastSuccessAt]),
+		LastFailureAt: parseUnix(values[fieldLastFailureAt]),
+		LastError:     values[fieldLastError],
+		LastDataType:  values[fieldLastDataType],
+	}
+	status.LastStatusCode = int(parseInt64(values[fieldLastStatusCode]))
+	return status
+}
+
+func parseInt64(raw string) int64 {
+	value, _ := strconv.ParseInt(raw, 10, 64)
+	return value
+}
+
+func parseUnix(raw string) *time.Time {
+	value := parseInt64(raw)
+	if value <= 0 {
+		return nil
+	}
+	t := time.Unix(value, 0).UTC()
+	return &t
+}
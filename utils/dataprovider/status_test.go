@@ -0,0 +1,56 @@
+package dataprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+func TestDeliveryStatus(t *testing.T) {
+	srv, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("miniredis.Run() error: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	client := goredis.NewClient(&goredis.Options{Addr: srv.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	ctx := t.Context()
+	first := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+
+	deliveries := []Delivery{
+		{Provider: "partner", DataType: "suite", Outcome: OutcomeSuccess, StatusCode: 200, At: first},
+		{Provider: "partner", DataType: "mysekai", Outcome: OutcomeFailure, StatusCode: 502, Error: "502 Bad Gateway", At: second},
+		{Provider: "partner", DataType: "suite", Outcome: OutcomeSkipped, At: second},
+	}
+	for _, d := range deliveries {
+		if err := RecordDelivery(ctx, client, d); err != nil {
+			t.Fatalf("RecordDelivery returned error: %v", err)
+		}
+	}
+
+	statuses, err := GetDeliveryStatus(ctx, client, []string{"partner", "idle"})
+	if err != nil {
+		t.Fatalf("GetDeliveryStatus returned error: %v", err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("len(statuses) = %d, want 2", len(statuses))
+	}
+	partner := statuses[0]
+	if partner.Success != 1 || partner.Failure != 1 || partner.Skipped != 1 || partner.Error != 0 {
+		t.Fatalf("unexpected counters: %+v", partner)
+	}
+	if partner.LastSuccessAt == nil || !partner.LastSuccessAt.Equal(first) {
+		t.Fatalf("lastSuccessAt = %v, want %v", partner.LastSuccessAt, first)
+	}
+	if partner.LastFailureAt == nil || !partner.LastFailureAt.Equal(second) || partner.LastStatusCode != 502 || partner.LastError != "502 Bad Gateway" {
+		t.Fatalf("unexpected failure details: %+v", partner)
+	}
+	if idle := statuses[1]; idle.Provider != "idle" || idle.Success != 0 || idle.LastAttemptAt != nil {
+		t.Fatalf("provider without deliveries should be empty, got %+v", idle)
+	}
+}
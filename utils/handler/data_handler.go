@@ -6,6 +6,9 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	apiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiSekai "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekai"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (h *DataHandler) HandleAndUpdateData(
@@ -17,7 +20,7 @@ func (h *DataHandler) HandleAndUpdateData(
 	expectedUserID *int64,
 	settings apiHelper.HarukiToolboxGameAccountPrivacySettings,
 ) (*utils.HandleDataResult, error) {
-	unpackedMap, result, err := h.DecodeUploadData(raw, server)
+	unpackedMap, result, err := h.DecodeUploadData(ctx, raw, server)
 	if err != nil || result != nil {
 		return result, err
 	}
@@ -29,14 +32,25 @@ func (h *DataHandler) HandleAndUpdateData(
 	if err != nil {
 		return nil, err
 	}
-	if err := h.PersistUploadData(ctx, data, server, dataType, expectedUserID); err != nil {
+	changedKeys, err := h.PersistUploadData(ctx, data, server, dataType, expectedUserID)
+	if err != nil {
 		return nil, err
 	}
-	h.RunUploadFanout(raw, data, server, dataType, expectedUserID, settings, isPublicAPI)
+	h.RunUploadFanout(ctx, raw, data, changedKeys, server, dataType, expectedUserID, settings, isPublicAPI)
 	return &utils.HandleDataResult{UserID: expectedUserID}, nil
 }
 
-func (h *DataHandler) DecodeUploadData(raw []byte, server utils.SupportedDataUploadServer) (map[string]any, *utils.HandleDataResult, error) {
+func (h *DataHandler) DecodeUploadData(ctx context.Context, raw []byte, server utils.SupportedDataUploadServer) (map[string]any, *utils.HandleDataResult, error) {
+	_, span := harukiTracing.Start(ctx, "upload.decode",
+		attribute.String("upload.server", string(server)),
+		attribute.Int("upload.size_bytes", len(raw)),
+	)
+	unpackedMap, result, err := h.decodeUploadData(raw, server)
+	harukiTracing.End(span, err)
+	return unpackedMap, result, err
+}
+
+func (h *DataHandler) decodeUploadData(raw []byte, server utils.SupportedDataUploadServer) (map[string]any, *utils.HandleDataResult, error) {
 	unpacked, err := harukiSekai.Unpack(raw, server)
 	if err != nil {
 		h.Logger.Errorf("unpack failed: %v", err)
@@ -61,34 +75,55 @@ func (h *DataHandler) ExtractGameUserIDForExpected(data map[string]any, expected
 	return extractUserIDFromGameDataWithExpected(data, expectedUserID, h.Logger)
 }
 
-func (h *DataHandler) PersistUploadData(ctx context.Context, data map[string]any, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, expectedUserID *int64) error {
-	if _, err := h.DBManager.Mongo.UpdateData(ctx, string(server), *expectedUserID, data, dataType); err != nil {
+// PersistUploadData stores the upload and returns the top-level keys it
+// changed.
+func (h *DataHandler) PersistUploadData(ctx context.Context, data map[string]any, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, expectedUserID *int64) ([]string, error) {
+	ctx, span := harukiTracing.Start(ctx, "upload.persist",
+		attribute.String("upload.server", string(server)),
+		attribute.String("upload.data_type", string(dataType)),
+	)
+	changedKeys, err := h.DBManager.Mongo.UpdateData(ctx, string(server), *expectedUserID, data, dataType)
+	harukiTracing.End(span, err)
+	if err != nil {
 		h.Logger.Errorf("Failed to update mongo data: %v", err)
-		return err
+		return nil, err
 	}
-	return nil
+	return changedKeys, nil
 }
 
-func (h *DataHandler) RunUploadFanout(raw []byte, data map[string]any, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, expectedUserID *int64, settings apiHelper.HarukiToolboxGameAccountPrivacySettings, isPublicAPI bool) {
+// RunUploadFanout starts the post-upload goroutines. They keep the trace of
+// ctx but not its cancellation, since they outlive the upload request.
+func (h *DataHandler) RunUploadFanout(ctx context.Context, raw []byte, data map[string]any, changedKeys []string, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, expectedUserID *int64, settings apiHelper.HarukiToolboxGameAccountPrivacySettings, isPublicAPI bool) {
+	ctx, span := harukiTracing.Start(ctx, "upload.fanout",
+		attribute.String("upload.server", string(server)),
+		attribute.String("upload.data_type", string(dataType)),
+	)
+	defer span.End()
+	ctx = harukiTracing.Detach(ctx)
 	if dataType == utils.UploadDataTypeMysekaiBirthdayParty && expectedUserID != nil {
-		h.ProcessBirthdaySubscriptionAsync(*expectedUserID, server, data)
+		h.ProcessBirthdaySubscriptionAsync(ctx, *expectedUserID, server, data)
 	}
 	if dataType != utils.UploadDataTypeMysekaiBirthdayParty {
 		rawCopy := make([]byte, len(raw))
 		copy(rawCopy, raw)
-		go DataSyncer(*expectedUserID, server, dataType, rawCopy, settings)
+		go h.syncToDataProviders(ctx, *expectedUserID, server, dataType, rawCopy, settings)
 	} else {
 		packedBody, err := harukiSekai.Pack(data, server)
 		if err != nil {
 			h.Logger.Errorf("pack birthday party data failed: %v", err)
 		} else {
-			go DataSyncer(*expectedUserID, server, dataType, packedBody, settings)
+			go h.syncToDataProviders(ctx, *expectedUserID, server, dataType, packedBody, settings)
 		}
 	}
+	event, err := newWebhookEvent(*expectedUserID, server, dataType, h.UploadMethod, data, changedKeys)
+	if err != nil {
+		h.Logger.Errorf("Failed to build webhook event: %v", err)
+		return
+	}
 	if isPublicAPI {
-		go h.CallWebhookAsync(*expectedUserID, server, dataType)
+		go h.CallWebhookAsync(ctx, event)
 	}
-	go h.CallOAuth2WebhookAsync(*expectedUserID, server, dataType)
+	go h.CallOAuth2WebhookAsync(ctx, event)
 }
 
 func (h *DataHandler) checkForHTTPError(unpackedMap map[string]any) *utils.HandleDataResult {
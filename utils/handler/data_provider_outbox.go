@@ -0,0 +1,459 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	apiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/dataprovider"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	dataProviderOutboxPruneInterval = time.Hour
+	// dataProviderOutboxLease covers the check request and the upload, each
+	// bounded by the sync client timeout, with some slack.
+	dataProviderOutboxLease = 3 * dataSyncerTimeoutSeconds * time.Second
+)
+
+// errDataProviderOutboxPermanent marks entries that retrying cannot deliver,
+// such as a provider removed from the configuration.
+var errDataProviderOutboxPermanent = errors.New("permanent data provider delivery failure")
+
+// errDataProviderOutboxSkipped marks entries that must no longer be sent,
+// e.g. because the game account withdrew its consent.
+var errDataProviderOutboxSkipped = errors.New("data provider delivery skipped")
+
+type DataProviderOutboxOptions struct {
+	MaxAttempts         int
+	BaseBackoff         time.Duration
+	MaxBackoff          time.Duration
+	PollInterval        time.Duration
+	BatchSize           int
+	Concurrency         int
+	ProviderConcurrency int
+	Retention           time.Duration
+}
+
+func DefaultDataProviderOutboxOptions() DataProviderOutboxOptions {
+	return DataProviderOutboxOptions{
+		MaxAttempts:         10,
+		BaseBackoff:         30 * time.Second,
+		MaxBackoff:          time.Hour,
+		PollInterval:        5 * time.Second,
+		BatchSize:           100,
+		Concurrency:         16,
+		ProviderConcurrency: 4,
+		Retention:           7 * 24 * time.Hour,
+	}
+}
+
+var (
+	dataProviderOutboxOptionsMu sync.RWMutex
+	dataProviderOutboxOptions   = DefaultDataProviderOutboxOptions()
+	dataProviderOutboxWake      = make(chan struct{}, 1)
+	dataProviderOutboxNow       = time.Now
+)
+
+// ConfigureDataProviderOutbox sets the options used when snapshots are
+// enqueued or resynced; it is called once at startup.
+func ConfigureDataProviderOutbox(options DataProviderOutboxOptions) {
+	dataProviderOutboxOptionsMu.Lock()
+	defer dataProviderOutboxOptionsMu.Unlock()
+	dataProviderOutboxOptions = options
+}
+
+func currentDataProviderOutboxOptions() DataProviderOutboxOptions {
+	dataProviderOutboxOptionsMu.RLock()
+	defer dataProviderOutboxOptionsMu.RUnlock()
+	return dataProviderOutboxOptions
+}
+
+// NotifyDataProviderOutboxWorker wakes the outbox worker early, e.g. after
+// snapshots are enqueued or an admin forces a resync.
+func NotifyDataProviderOutboxWorker() {
+	select {
+	case dataProviderOutboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// ResyncDataProviderOutbox re-queues the stored snapshots matching filter
+// with a fresh attempt budget and wakes the worker.
+func ResyncDataProviderOutbox(ctx context.Context, db *dbManager.Client, filter dbManager.DataProviderOutboxFilter) (int, error) {
+	options := currentDataProviderOutboxOptions()
+	updated, err := db.ResyncDataProviderOutbox(ctx, filter, options.MaxAttempts, dataProviderOutboxNow().UTC())
+	if err != nil {
+		return 0, err
+	}
+	if updated > 0 {
+		NotifyDataProviderOutboxWorker()
+	}
+	return updated, nil
+}
+
+// syncToDataProviders stores the upload as the newest snapshot for every
+// provider the game account allows. If the outbox cannot be written the
+// upload is pushed directly, as before the outbox existed.
+func (h *DataHandler) syncToDataProviders(ctx context.Context, userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, rawData []byte, settings apiHelper.HarukiToolboxGameAccountPrivacySettings) {
+	targets := buildSyncTargets(h.DataProviders, server, dataType, settings)
+	if len(targets) == 0 {
+		return
+	}
+	if h.DBManager != nil && h.DBManager.DB != nil {
+		options := currentDataProviderOutboxOptions()
+		records := make([]dbManager.DataProviderOutboxRecord, 0, len(targets))
+		for _, t := range targets {
+			records = append(records, dbManager.DataProviderOutboxRecord{
+				Provider:    t.Name,
+				Server:      string(server),
+				GameUserID:  strconv.FormatInt(userID, 10),
+				DataType:    string(dataType),
+				Payload:     rawData,
+				MaxAttempts: options.MaxAttempts,
+			})
+		}
+		err := h.DBManager.DB.EnqueueDataProviderSnapshots(ctx, records, dataProviderOutboxNow().UTC())
+		if err == nil {
+			NotifyDataProviderOutboxWorker()
+			return
+		}
+		logger.WithContext(ctx).Errorf("Failed to enqueue data provider snapshots, syncing directly: %v", err)
+	}
+	h.DataSyncer(ctx, userID, server, dataType, rawData, settings)
+}
+
+type DataProviderOutboxWorker struct {
+	db          *dbManager.Client
+	providers   func() []harukiConfig.DataProviderConfig
+	statusStore *redis.Client
+	logger      *harukiLogger.Logger
+	options     DataProviderOutboxOptions
+
+	sem      chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	inFlight map[string]int
+}
+
+// NewDataProviderOutboxWorker builds a worker; providers is called on every
+// delivery so runtime changes to the provider list apply to queued entries.
+func NewDataProviderOutboxWorker(db *dbManager.Client, providers func() []harukiConfig.DataProviderConfig, statusStore *redis.Client, logger *harukiLogger.Logger, options DataProviderOutboxOptions) *DataProviderOutboxWorker {
+	if options.ProviderConcurrency <= 0 || options.ProviderConcurrency > options.Concurrency {
+		options.ProviderConcurrency = options.Concurrency
+	}
+	return &DataProviderOutboxWorker{
+		db:          db,
+		providers:   providers,
+		statusStore: statusStore,
+		logger:      logger,
+		options:     options,
+		sem:         make(chan struct{}, options.Concurrency),
+		inFlight:    make(map[string]int),
+	}
+}
+
+// Start runs the worker until ctx is cancelled and returns a function that
+// blocks until in-flight deliveries have finished. Deliveries run in a pool
+// of Concurrency slots with at most ProviderConcurrency per provider; the
+// loop keeps dispatching while they run and is woken when a slot frees up.
+func (w *DataProviderOutboxWorker) Start(ctx context.Context) func() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.options.PollInterval)
+		defer ticker.Stop()
+		var lastPrunedAt time.Time
+		for {
+			w.dispatch(ctx)
+			if now := dataProviderOutboxNow(); now.Sub(lastPrunedAt) >= dataProviderOutboxPruneInterval {
+				w.prune(ctx, now)
+				lastPrunedAt = now
+			}
+			select {
+			case <-ctx.Done():
+				w.logger.Infof("data provider outbox worker stopped")
+				return
+			case <-ticker.C:
+			case <-dataProviderOutboxWake:
+			}
+		}
+	}()
+	return w.wg.Wait
+}
+
+// RunOnce dispatches the due entries and waits for their deliveries, returning
+// how many were attempted.
+func (w *DataProviderOutboxWorker) RunOnce(ctx context.Context) int {
+	attempted := w.dispatch(ctx)
+	w.wg.Wait()
+	return attempted
+}
+
+func (w *DataProviderOutboxWorker) saturatedProviders() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var saturated []string
+	for provider, count := range w.inFlight {
+		if count >= w.options.ProviderConcurrency {
+			saturated = append(saturated, provider)
+		}
+	}
+	return saturated
+}
+
+// acquire reserves a pool slot for provider without blocking.
+func (w *DataProviderOutboxWorker) acquire(provider string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.inFlight[provider] >= w.options.ProviderConcurrency {
+		return false
+	}
+	select {
+	case w.sem <- struct{}{}:
+	default:
+		return false
+	}
+	w.inFlight[provider]++
+	return true
+}
+
+func (w *DataProviderOutboxWorker) release(provider string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	<-w.sem
+	if w.inFlight[provider]--; w.inFlight[provider] <= 0 {
+		delete(w.inFlight, provider)
+	}
+}
+
+// dispatch claims due entries until the pool is full or nothing is due.
+// Providers that are at their limit are left out of the listing so their
+// backlog does not hide the entries of other providers.
+func (w *DataProviderOutboxWorker) dispatch(ctx context.Context) int {
+	attempted := 0
+	for ctx.Err() == nil && len(w.sem) < cap(w.sem) {
+		now := dataProviderOutboxNow().UTC()
+		due, err := w.db.ListDueDataProviderOutbox(ctx, now, w.saturatedProviders(), w.options.BatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.logger.Errorf("Failed to list due data provider outbox entries: %v", err)
+			}
+			return attempted
+		}
+		claimed := 0
+		for _, entry := range due {
+			if !w.acquire(entry.Provider) {
+				continue
+			}
+			payload, ok, err := w.db.ClaimDataProviderOutbox(ctx, entry, now, now.Add(dataProviderOutboxLease))
+			if err != nil || !ok {
+				if err != nil {
+					w.logger.Errorf("Failed to claim data provider outbox entry %d: %v", entry.ID, err)
+				}
+				w.release(entry.Provider)
+				continue
+			}
+			entry.Attempts++
+			claimed++
+			w.wg.Add(1)
+			go func(e *dbManager.DataProviderOutbox) {
+				defer w.wg.Done()
+				defer NotifyDataProviderOutboxWorker()
+				defer w.release(e.Provider)
+				w.deliver(ctx, e, payload)
+			}(entry)
+		}
+		attempted += claimed
+		if claimed == 0 || len(due) < w.options.BatchSize {
+			return attempted
+		}
+	}
+	return attempted
+}
+
+// prune drops finished entries older than the configured retention.
+func (w *DataProviderOutboxWorker) prune(ctx context.Context, now time.Time) {
+	if w.options.Retention <= 0 {
+		return
+	}
+	deleted, err := w.db.PurgeFinishedDataProviderOutbox(ctx, now.UTC().Add(-w.options.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Warnf("Failed to prune data provider outbox: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		w.logger.Infof("Pruned %d finished data provider outbox entries", deleted)
+	}
+}
+
+func (w *DataProviderOutboxWorker) findProvider(name string) (harukiConfig.DataProviderConfig, bool) {
+	for _, provider := range w.providers() {
+		if provider.Name == name {
+			return provider, true
+		}
+	}
+	return harukiConfig.DataProviderConfig{}, false
+}
+
+func (w *DataProviderOutboxWorker) deliver(ctx context.Context, entry *dbManager.DataProviderOutbox, payload []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.logger.Errorf("data provider outbox delivery panicked: %v", r)
+		}
+	}()
+	server := utils.SupportedDataUploadServer(entry.Server)
+	dataType := utils.UploadDataType(entry.DataType)
+
+	provider, err := w.resolveProvider(ctx, entry)
+	if err != nil {
+		if errors.Is(err, errDataProviderOutboxSkipped) {
+			recordDataSync(ctx, w.statusStore, provider, dataType, harukiMetrics.SyncSkipped, 0, "")
+		}
+		w.recordFailure(ctx, entry, nil, err)
+		return
+	}
+	userID, err := strconv.ParseInt(entry.GameUserID, 10, 64)
+	if err != nil {
+		w.recordFailure(ctx, entry, nil, fmt.Errorf("%w: invalid game user id", errDataProviderOutboxPermanent))
+		return
+	}
+	data, encoding, err := prepareSyncPayload(provider, server, dataType, payload)
+	if err != nil {
+		w.recordFailure(ctx, entry, nil, fmt.Errorf("%w: %v", errDataProviderOutboxPermanent, err))
+		return
+	}
+
+	exists, err := checkUserExists(ctx, provider, userID, server, dataType)
+	if err != nil {
+		recordDataSync(ctx, w.statusStore, provider, dataType, harukiMetrics.SyncError, 0, err.Error())
+		w.recordFailure(ctx, entry, nil, fmt.Errorf("check user: %w", err))
+		return
+	}
+	if !exists {
+		recordDataSync(ctx, w.statusStore, provider, dataType, harukiMetrics.SyncSkipped, 0, "")
+		w.recordFailure(ctx, entry, nil, fmt.Errorf("%w: user not found at provider", errDataProviderOutboxSkipped))
+		return
+	}
+
+	headers := buildSyncHeaders(provider, userID, server, dataType)
+	statusCode, err := sendData(ctx, w.statusStore, provider, userID, server, dataType, data, encoding, headers)
+	var code *int
+	if statusCode != 0 {
+		code = &statusCode
+	}
+	if err != nil {
+		w.recordFailure(ctx, entry, code, err)
+		return
+	}
+	status := dataprovideroutbox.StatusSucceeded
+	if provider.Endpoint == "" {
+		status = dataprovideroutbox.StatusSkipped
+	}
+	if err := w.db.FinishDataProviderOutbox(ctx, entry.ID, entry.Generation, status, code, "", dataProviderOutboxNow().UTC()); err != nil {
+		w.logger.Errorf("Failed to mark data provider outbox entry %d as %s: %v", entry.ID, status, err)
+	}
+}
+
+// resolveProvider looks the provider up again and re-checks the game
+// account's consent, so configuration and privacy changes made while an entry
+// waited are honoured.
+func (w *DataProviderOutboxWorker) resolveProvider(ctx context.Context, entry *dbManager.DataProviderOutbox) (harukiConfig.DataProviderConfig, error) {
+	server := utils.SupportedDataUploadServer(entry.Server)
+	dataType := utils.UploadDataType(entry.DataType)
+	provider, ok := w.findProvider(entry.Provider)
+	if !ok {
+		return harukiConfig.DataProviderConfig{Name: entry.Provider}, fmt.Errorf("%w: provider is no longer configured", errDataProviderOutboxPermanent)
+	}
+	if !provider.Enabled {
+		return provider, fmt.Errorf("%w: provider is disabled", errDataProviderOutboxSkipped)
+	}
+	if !dataprovider.Accepts(provider, server, dataType) {
+		return provider, fmt.Errorf("%w: provider no longer accepts %s data from %s", errDataProviderOutboxSkipped, dataType, server)
+	}
+	binding, err := w.db.GameAccountBinding.Query().
+		Where(
+			gameaccountbinding.ServerEQ(entry.Server),
+			gameaccountbinding.GameUserIDEQ(entry.GameUserID),
+		).
+		Only(ctx)
+	if dbManager.IsNotFound(err) {
+		return provider, fmt.Errorf("%w: game account is no longer bound", errDataProviderOutboxSkipped)
+	}
+	if err != nil {
+		return provider, fmt.Errorf("query game account binding: %w", err)
+	}
+	if !dataprovider.Allowed(provider, dataType, binding.Suite, binding.Mysekai) {
+		return provider, fmt.Errorf("%w: game account no longer allows this provider", errDataProviderOutboxSkipped)
+	}
+	return provider, nil
+}
+
+// prepareSyncPayload converts a stored upload into the provider's format.
+func prepareSyncPayload(provider harukiConfig.DataProviderConfig, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, payload []byte) ([]byte, string, error) {
+	switch {
+	case provider.Format == dataprovider.FormatRaw:
+		return payload, utils.HarukiDataSyncerDataFormatRaw, nil
+	case provider.Format == dataprovider.FormatRestored && dataType == utils.UploadDataTypeSuite:
+		data, err := processDataWithRestore(payload, server)
+		return data, utils.HarukiDataSyncerDataFormatJsonZstd, err
+	default:
+		data, err := processDataOnce(payload, server)
+		return data, utils.HarukiDataSyncerDataFormatJsonZstd, err
+	}
+}
+
+func (w *DataProviderOutboxWorker) recordFailure(ctx context.Context, entry *dbManager.DataProviderOutbox, statusCode *int, cause error) {
+	now := dataProviderOutboxNow().UTC()
+	var final dataprovideroutbox.Status
+	switch {
+	case errors.Is(cause, errDataProviderOutboxSkipped):
+		final = dataprovideroutbox.StatusSkipped
+	case errors.Is(cause, errDataProviderOutboxPermanent), entry.Attempts >= entry.MaxAttempts:
+		final = dataprovideroutbox.StatusDead
+	}
+	if final != "" {
+		if err := w.db.FinishDataProviderOutbox(ctx, entry.ID, entry.Generation, final, statusCode, cause.Error(), now); err != nil {
+			w.logger.Errorf("Failed to mark data provider outbox entry %d as %s: %v", entry.ID, final, err)
+			return
+		}
+		if final == dataprovideroutbox.StatusDead {
+			w.logger.Warnf("Data provider outbox entry %d (%s) dead after %d attempt(s): %v", entry.ID, entry.Provider, entry.Attempts, cause)
+		}
+		return
+	}
+
+	next := now.Add(webhookDeliveryBackoff(entry.Attempts, w.options.BaseBackoff, w.options.MaxBackoff))
+	if err := w.db.RetryDataProviderOutbox(ctx, entry.ID, entry.Generation, statusCode, cause.Error(), next); err != nil {
+		w.logger.Errorf("Failed to record data provider outbox entry %d failure: %v", entry.ID, err)
+		return
+	}
+	w.logger.Warnf("Data provider outbox entry %d (%s) attempt %d failed, retrying at %s: %v", entry.ID, entry.Provider, entry.Attempts, next.Format(time.RFC3339), cause)
+	if !dataProviderUnavailable(statusCode) {
+		return
+	}
+	if _, err := w.db.DeferDataProviderOutbox(ctx, entry.Provider, next); err != nil {
+		w.logger.Warnf("Failed to defer pending outbox entries for provider %s: %v", entry.Provider, err)
+	}
+}
+
+// dataProviderUnavailable reports whether a failure points at the provider
+// rather than the entry: no response, throttling or a server error. Only
+// those hold back the rest of the provider's backlog.
+func dataProviderUnavailable(statusCode *int) bool {
+	return statusCode == nil || *statusCode == 429 || *statusCode >= 500
+}
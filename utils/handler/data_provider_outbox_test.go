@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"context"
+	"io"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/toolbox/schema"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	apiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/dataprovideroutbox"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/gameaccountbinding"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDataProviderOutboxWorkerRetriesAndDeliversLatestSnapshot(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:data-provider-outbox-worker-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	var mu sync.Mutex
+	status := stdhttp.StatusServiceUnavailable
+	var bodies []string
+	var lastAuth string
+	srv := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, r.URL.Path+" "+string(body))
+		lastAuth = r.Header.Get(headerAuthorization)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	originalNow := dataProviderOutboxNow
+	dataProviderOutboxNow = func() time.Time { return now }
+	defer func() {
+		dataProviderOutboxNow = originalNow
+	}()
+
+	ctx := context.Background()
+	if _, err := client.User.Create().SetID("u1").SetName("User One").SetEmail("u1@example.com").Save(ctx); err != nil {
+		t.Fatalf("create user returned error: %v", err)
+	}
+	suite := &schema.SuiteDataPrivacySettings{AllowProviders: map[string]bool{"partner": true}}
+	if _, err := client.GameAccountBinding.Create().
+		SetServer("jp").
+		SetGameUserID("123").
+		SetVerified(true).
+		SetUserID("u1").
+		SetSuite(suite).
+		Save(ctx); err != nil {
+		t.Fatalf("create binding returned error: %v", err)
+	}
+
+	providers := []harukiConfig.DataProviderConfig{{
+		Name:      "partner",
+		Enabled:   true,
+		Endpoint:  srv.URL + "/{server}/{user_id}",
+		Secret:    "secret",
+		Auth:      "bearer",
+		Format:    "raw",
+		DataTypes: []string{"suite"},
+		Privacy:   harukiConfig.DataProviderPrivacyConfig{ConsentKey: "partner"},
+	}}
+	h := &DataHandler{
+		DBManager:     &database.HarukiToolboxDBManager{DB: client},
+		DataProviders: providers,
+	}
+	settings := apiHelper.HarukiToolboxGameAccountPrivacySettings{Suite: suite}
+	h.syncToDataProviders(ctx, 123, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite, []byte("v1"), settings)
+
+	options := DefaultDataProviderOutboxOptions()
+	options.MaxAttempts = 2
+	options.BaseBackoff = time.Minute
+	var providersMu sync.Mutex
+	current := providers
+	worker := NewDataProviderOutboxWorker(client, func() []harukiConfig.DataProviderConfig {
+		providersMu.Lock()
+		defer providersMu.Unlock()
+		return current
+	}, nil, testLogger(), options)
+	ConfigureDataProviderOutbox(options)
+	defer ConfigureDataProviderOutbox(DefaultDataProviderOutboxOptions())
+
+	if attempted := worker.RunOnce(ctx); attempted != 1 {
+		t.Fatalf("first RunOnce attempted %d, want 1", attempted)
+	}
+	entry, err := client.DataProviderOutbox.Query().Only(ctx)
+	if err != nil {
+		t.Fatalf("query outbox entry returned error: %v", err)
+	}
+	if entry.Status != dataprovideroutbox.StatusPending || entry.Attempts != 1 || entry.LastStatusCode == nil || *entry.LastStatusCode != 503 {
+		t.Fatalf("entry after failure = %+v, want pending retry with status 503", entry)
+	}
+	if !entry.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("next attempt = %s, want %s", entry.NextAttemptAt, now.Add(time.Minute))
+	}
+	if attempted := worker.RunOnce(ctx); attempted != 0 {
+		t.Fatalf("RunOnce during backoff attempted %d, want 0", attempted)
+	}
+
+	// A newer upload replaces the failed snapshot and is due right away.
+	mu.Lock()
+	status = stdhttp.StatusOK
+	mu.Unlock()
+	now = now.Add(10 * time.Second)
+	h.syncToDataProviders(ctx, 123, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite, []byte("v2"), settings)
+	if attempted := worker.RunOnce(ctx); attempted != 1 {
+		t.Fatalf("RunOnce after new snapshot attempted %d, want 1", attempted)
+	}
+	entry, err = client.DataProviderOutbox.Get(ctx, entry.ID)
+	if err != nil {
+		t.Fatalf("get outbox entry returned error: %v", err)
+	}
+	if entry.Status != dataprovideroutbox.StatusSucceeded || entry.DeliveredAt == nil {
+		t.Fatalf("entry after delivery = %+v, want succeeded", entry)
+	}
+	mu.Lock()
+	if len(bodies) != 2 || bodies[1] != "/jp/123 v2" || lastAuth != "Bearer secret" {
+		t.Fatalf("requests = %v (auth %q), want the v2 snapshot delivered last", bodies, lastAuth)
+	}
+	mu.Unlock()
+
+	// Consent withdrawn after the upload: a resync must not send the data.
+	if _, err := client.GameAccountBinding.Update().
+		Where(gameaccountbinding.ServerEQ("jp"), gameaccountbinding.GameUserIDEQ("123")).
+		SetSuite(&schema.SuiteDataPrivacySettings{}).
+		Save(ctx); err != nil {
+		t.Fatalf("update binding returned error: %v", err)
+	}
+	if requeued, err := ResyncDataProviderOutbox(ctx, client, dbManager.DataProviderOutboxFilter{Server: "jp"}); err != nil || requeued != 1 {
+		t.Fatalf("ResyncDataProviderOutbox = %d, %v; want 1, nil", requeued, err)
+	}
+	if attempted := worker.RunOnce(ctx); attempted != 1 {
+		t.Fatalf("RunOnce after resync attempted %d, want 1", attempted)
+	}
+	entry, err = client.DataProviderOutbox.Get(ctx, entry.ID)
+	if err != nil {
+		t.Fatalf("get outbox entry returned error: %v", err)
+	}
+	if entry.Status != dataprovideroutbox.StatusSkipped || entry.MaxAttempts != 2 {
+		t.Fatalf("entry after withdrawn consent = %+v, want skipped", entry)
+	}
+
+	// A provider removed from the configuration dead-letters its backlog.
+	providersMu.Lock()
+	current = nil
+	providersMu.Unlock()
+	if _, err := ResyncDataProviderOutbox(ctx, client, dbManager.DataProviderOutboxFilter{Provider: "partner"}); err != nil {
+		t.Fatalf("ResyncDataProviderOutbox returned error: %v", err)
+	}
+	worker.RunOnce(ctx)
+	entry, err = client.DataProviderOutbox.Get(ctx, entry.ID)
+	if err != nil {
+		t.Fatalf("get outbox entry returned error: %v", err)
+	}
+	if entry.Status != dataprovideroutbox.StatusDead {
+		t.Fatalf("entry of removed provider = %+v, want dead", entry)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(bodies) != 2 {
+		t.Fatalf("requests = %v, want no delivery after consent was withdrawn", bodies)
+	}
+}
+
+func TestDataProviderOutboxWorkerProviderConcurrency(t *testing.T) {
+	worker := NewDataProviderOutboxWorker(nil, nil, nil, testLogger(), DataProviderOutboxOptions{Concurrency: 3, ProviderConcurrency: 2})
+	if !worker.acquire("a") || !worker.acquire("a") {
+		t.Fatalf("acquire within the provider limit failed")
+	}
+	if worker.acquire("a") {
+		t.Fatalf("acquire beyond the provider limit succeeded")
+	}
+	if saturated := worker.saturatedProviders(); len(saturated) != 1 || saturated[0] != "a" {
+		t.Fatalf("saturated providers = %v, want [a]", saturated)
+	}
+	if !worker.acquire("b") {
+		t.Fatalf("acquire for another provider failed")
+	}
+	if worker.acquire("c") {
+		t.Fatalf("acquire beyond the pool size succeeded")
+	}
+	worker.release("a")
+	if !worker.acquire("c") {
+		t.Fatalf("acquire after release failed")
+	}
+}
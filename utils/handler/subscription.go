@@ -5,15 +5,18 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiRedis "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/redis"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 	"strconv"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (h *DataHandler) ProcessBirthdaySubscriptionAsync(userID int64, server utils.SupportedDataUploadServer, data map[string]any) {
-	go h.processBirthdaySubscription(userID, server, data)
+func (h *DataHandler) ProcessBirthdaySubscriptionAsync(parent context.Context, userID int64, server utils.SupportedDataUploadServer, data map[string]any) {
+	go h.processBirthdaySubscription(harukiTracing.Detach(parent), userID, server, data)
 }
 
-func (h *DataHandler) processBirthdaySubscription(userID int64, server utils.SupportedDataUploadServer, data map[string]any) {
+func (h *DataHandler) processBirthdaySubscription(parent context.Context, userID int64, server utils.SupportedDataUploadServer, data map[string]any) {
 	cfg := config.Cfg.Subscription
 	redisManager := h.birthdayRedis()
 	if redisManager == nil {
@@ -24,8 +27,10 @@ func (h *DataHandler) processBirthdaySubscription(userID int64, server utils.Sup
 	if timeout <= 0 {
 		timeout = 5 * time.Second
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(parent, timeout)
 	defer cancel()
+	ctx, span := harukiTracing.Start(ctx, "subscription.birthday", attribute.String("upload.server", string(server)))
+	defer span.End()
 
 	monitor, found, err := GetBirthdayMonitorMirror(ctx, redisManager, string(server), strconv.FormatInt(userID, 10))
 	if err != nil {
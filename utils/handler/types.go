@@ -1,6 +1,8 @@
 package handler
 
 import (
+	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database"
 	harukiHttp "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/http"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
@@ -13,4 +15,6 @@ type DataHandler struct {
 	HttpClient     *harukiHttp.Client
 	Logger         *harukiLogger.Logger
 	WebhookEnabled bool
+	UploadMethod   utils.UploadMethod
+	DataProviders  []harukiConfig.DataProviderConfig
 }
@@ -2,14 +2,18 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	apiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
 	harukiAPIData "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api/data"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/dataprovider"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/sekai"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/streamjson"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 	harukiVersion "github.com/Team-Haruki/Haruki-Toolbox-Backend/version"
 	"sync"
 	"time"
@@ -17,6 +21,9 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/go-resty/resty/v2"
 	"github.com/klauspost/compress/zstd"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 var (
@@ -26,6 +33,7 @@ var (
 
 func init() {
 	httpClient = resty.New()
+	httpClient.SetTransport(harukiTracing.Transport(httpClient.GetClient().Transport))
 	httpClient.SetTimeout(dataSyncerTimeoutSeconds * time.Second)
 	httpClient.SetHeader("User-Agent", fmt.Sprintf(defaultUserAgentName, harukiVersion.Version))
 	httpClient.SetHeader("Accept", defaultAcceptOctetStream)
@@ -127,15 +135,47 @@ func processDataWithRestore(rawData []byte, server utils.SupportedDataUploadServ
 	return result, nil
 }
 
-func sendData(url string, userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, data []byte, encoding string, headers map[string]string) {
-	if url == "" {
-		logger.Warnf("Upload endpoint url is empty, skipped syncing data.")
+func recordDataSync(ctx context.Context, statusStore *redis.Client, t harukiConfig.DataProviderConfig, dataType utils.UploadDataType, outcome string, statusCode int, errText string) {
+	harukiMetrics.RecordDataSync(t.Name, string(dataType), outcome)
+	if statusStore == nil {
 		return
 	}
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), dataSyncStatusTimeout)
+	defer cancel()
+	err := dataprovider.RecordDelivery(storeCtx, statusStore, dataprovider.Delivery{
+		Provider:   t.Name,
+		DataType:   string(dataType),
+		Outcome:    outcome,
+		StatusCode: statusCode,
+		Error:      errText,
+		At:         time.Now(),
+	})
+	if err != nil {
+		logger.WithContext(ctx).Warnf("Failed to record delivery status of %s: %v", t.Name, err)
+	}
+}
 
-	url = replaceSyncURLPlaceholders(url, userID, server, dataType)
+// sendData posts data to the provider, records the outcome and returns the
+// response status code (0 when no response was received) and the error of a
+// failed delivery.
+func sendData(ctx context.Context, statusStore *redis.Client, t harukiConfig.DataProviderConfig, userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, data []byte, encoding string, headers map[string]string) (int, error) {
+	logger := logger.WithContext(ctx)
+	if t.Endpoint == "" {
+		logger.Warnf("Upload endpoint url is empty, skipped syncing data.")
+		recordDataSync(ctx, statusStore, t, dataType, harukiMetrics.SyncSkipped, 0, "")
+		return 0, nil
+	}
+
+	url := replaceSyncURLPlaceholders(t.Endpoint, userID, server, dataType)
+
+	ctx, span := harukiTracing.Start(ctx, "data_syncer.send",
+		attribute.String("sync.target", t.Name),
+		attribute.String("upload.data_type", string(dataType)),
+	)
+	defer span.End()
 
 	req := httpClient.R().
+		SetContext(ctx).
 		SetHeader(headerXUploadDataFormat, encoding).
 		SetBody(data)
 
@@ -145,54 +185,79 @@ func sendData(url string, userID int64, server utils.SupportedDataUploadServer,
 
 	resp, err := req.Post(url)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		logger.Warnf("Failed to sync data to %s: %v", url, err)
-		return
+		recordDataSync(ctx, statusStore, t, dataType, harukiMetrics.SyncError, 0, err.Error())
+		return 0, err
 	}
 	if !isHTTPSuccessStatus(resp.StatusCode()) {
 		logger.Warnf("Failed to sync data to %s: status code %v", url, resp.Status())
-	} else {
-		logger.Infof("Successfully sync data to %s", url)
+		recordDataSync(ctx, statusStore, t, dataType, harukiMetrics.SyncFailure, resp.StatusCode(), resp.Status())
+		return resp.StatusCode(), fmt.Errorf("unexpected status %s", resp.Status())
 	}
+	logger.Infof("Successfully sync data to %s", url)
+	recordDataSync(ctx, statusStore, t, dataType, harukiMetrics.SyncSuccess, resp.StatusCode(), "")
+	return resp.StatusCode(), nil
 }
 
-func checkUserExists(t syncTarget, userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType) bool {
-	if !t.checkEnabled || t.checkURL == "" {
-		return true
+// checkUserExists asks the provider's check URL whether it knows the user.
+// It returns false for a 404 and an error when the provider could not give
+// an answer.
+func checkUserExists(ctx context.Context, t harukiConfig.DataProviderConfig, userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType) (bool, error) {
+	logger := logger.WithContext(ctx)
+	if t.CheckURL == "" {
+		return true, nil
 	}
 
-	url := replaceSyncURLPlaceholders(t.checkURL, userID, server, dataType)
+	url := replaceSyncURLPlaceholders(t.CheckURL, userID, server, dataType)
 
-	req := httpClient.R().SetHeaders(buildCheckHeaders(t))
+	req := httpClient.R().SetContext(ctx).SetHeaders(buildCheckHeaders(t))
 
 	resp, err := req.Get(url)
 	if err != nil {
 		logger.Warnf("Check user failed for %s: %v", url, err)
-		return false
+		return false, err
 	}
 	if resp.StatusCode() == httpStatusOK {
-		return true
+		return true, nil
 	}
 	if resp.StatusCode() == httpStatusNotFound {
 		logger.Debugf("User %d not found at %s, skipping sync", userID, url)
-		return false
+		return false, nil
 	}
 	logger.Warnf("Unexpected check response from %s: %d", url, resp.StatusCode())
-	return false
+	return false, fmt.Errorf("unexpected check status %d", resp.StatusCode())
 }
 
-func DataSyncer(userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, rawData []byte, settings apiHelper.HarukiToolboxGameAccountPrivacySettings) {
+// DataSyncer pushes an upload directly to the data providers the game
+// account's privacy settings allow, without retries. It is the fallback for
+// when the outbox cannot be written. ctx only carries the trace of the upload
+// and must not be cancelled with it.
+func (h *DataHandler) DataSyncer(ctx context.Context, userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType, rawData []byte, settings apiHelper.HarukiToolboxGameAccountPrivacySettings) {
+	logger := logger.WithContext(ctx)
 	defer func() {
 		if r := recover(); r != nil {
 			logger.Errorf("DataSyncer panicked: %v", r)
 		}
 	}()
 
-	cfg := harukiConfig.Cfg.ThirdPartyDataProvider
-	targets := buildSyncTargets(cfg, dataType, settings)
+	targets := buildSyncTargets(h.DataProviders, server, dataType, settings)
 
 	if len(targets) == 0 {
 		return
 	}
+	var statusStore *redis.Client
+	if h.DBManager != nil && h.DBManager.Redis != nil {
+		statusStore = h.DBManager.Redis.Redis
+	}
+
+	ctx, span := harukiTracing.Start(ctx, "data_syncer",
+		attribute.String("upload.server", string(server)),
+		attribute.String("upload.data_type", string(dataType)),
+		attribute.Int("sync.targets", len(targets)),
+	)
+	defer span.End()
 
 	needsProcessed, needsRestored := computeProcessingNeeds(targets, dataType)
 
@@ -219,8 +284,9 @@ func DataSyncer(userID int64, server utils.SupportedDataUploadServer, dataType u
 	for _, t := range targets {
 		t := t
 
-		if !checkUserExists(t, userID, server, dataType) {
-			logger.Infof("Skipping sync to %s: user %d not found", t.url, userID)
+		if exists, _ := checkUserExists(ctx, t, userID, server, dataType); !exists {
+			logger.Infof("Skipping sync to %s: user %d not found", t.Endpoint, userID)
+			recordDataSync(ctx, statusStore, t, dataType, harukiMetrics.SyncSkipped, 0, "")
 			continue
 		}
 
@@ -235,7 +301,7 @@ func DataSyncer(userID int64, server utils.SupportedDataUploadServer, dataType u
 		)
 		headers := buildSyncHeaders(t, userID, server, dataType)
 
-		logger.Infof("Syncing %s data to %s...", dataType, t.url)
-		go sendData(t.url, userID, server, dataType, data, encoding, headers)
+		logger.Infof("Syncing %s data to %s...", dataType, t.Endpoint)
+		go sendData(ctx, statusStore, t, userID, server, dataType, data, encoding, headers)
 	}
 }
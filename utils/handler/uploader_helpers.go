@@ -5,8 +5,10 @@ import (
 	harukiConfig "github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	apiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/dataprovider"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -23,114 +25,29 @@ const (
 	httpStatusOK             = 200
 	httpStatusNotFound       = 404
 	dataSyncerTimeoutSeconds = 30
+	dataSyncStatusTimeout    = 2 * time.Second
 	defaultAcceptOctetStream = "application/octet-stream"
 	defaultUserAgentName     = "Haruki-Toolbox-Backend/%s"
 )
 
-type syncTarget struct {
-	url               string
-	secret            string
-	sendJSONZstandard bool
-	is8823            bool
-	checkEnabled      bool
-	checkURL          string
-	restoreSuite      bool
-}
-
 func buildSyncTargets(
-	cfg harukiConfig.ThirdPartyDataProviderConfig,
+	providers []harukiConfig.DataProviderConfig,
+	server utils.SupportedDataUploadServer,
 	dataType utils.UploadDataType,
 	settings apiHelper.HarukiToolboxGameAccountPrivacySettings,
-) []syncTarget {
-	var targets []syncTarget
-
-	if dataType == utils.UploadDataTypeSuite && settings.Suite != nil {
-		if settings.Suite.Allow8823 {
-			targets = append(targets, syncTarget{
-				url:               cfg.Endpoint8823,
-				secret:            cfg.Secret8823,
-				sendJSONZstandard: cfg.SendJSONZstandard8823,
-				is8823:            true,
-				checkEnabled:      cfg.CheckEnabled8823,
-				checkURL:          cfg.CheckURL8823,
-				restoreSuite:      cfg.RestoreSuite8823,
-			})
-		}
-		if settings.Suite.AllowSakura {
-			targets = append(targets, syncTarget{
-				url:               cfg.EndpointSakura,
-				secret:            cfg.SecretSakura,
-				sendJSONZstandard: cfg.SendJSONZstandardSakura,
-				checkEnabled:      cfg.CheckEnabledSakura,
-				checkURL:          cfg.CheckURLSakura,
-				restoreSuite:      cfg.RestoreSuiteSakura,
-			})
-		}
-		if settings.Suite.AllowResona {
-			targets = append(targets, syncTarget{
-				url:               cfg.EndpointResona,
-				secret:            cfg.SecretResona,
-				sendJSONZstandard: cfg.SendJSONZstandardResona,
-				checkEnabled:      cfg.CheckEnabledResona,
-				checkURL:          cfg.CheckURLResona,
-				restoreSuite:      cfg.RestoreSuiteResona,
-			})
-		}
-		if settings.Suite.AllowLuna {
-			targets = append(targets, syncTarget{
-				url:               cfg.EndpointLuna,
-				secret:            cfg.SecretLuna,
-				sendJSONZstandard: cfg.SendJSONZstandardLuna,
-				checkEnabled:      cfg.CheckEnabledLuna,
-				checkURL:          cfg.CheckURLLuna,
-				restoreSuite:      cfg.RestoreSuiteLuna,
-			})
-		}
-	}
-
-	if (dataType == utils.UploadDataTypeMysekai || dataType == utils.UploadDataTypeMysekaiBirthdayParty) && settings.Mysekai != nil {
-		if settings.Mysekai.Allow8823 {
-			targets = append(targets, syncTarget{
-				url:               cfg.Endpoint8823,
-				secret:            cfg.Secret8823,
-				sendJSONZstandard: cfg.SendJSONZstandard8823,
-				is8823:            true,
-				checkEnabled:      cfg.CheckEnabled8823,
-				checkURL:          cfg.CheckURL8823,
-			})
-		}
-		if settings.Mysekai.AllowResona {
-			targets = append(targets, syncTarget{
-				url:               cfg.EndpointResona,
-				secret:            cfg.SecretResona,
-				sendJSONZstandard: cfg.SendJSONZstandardResona,
-				checkEnabled:      cfg.CheckEnabledResona,
-				checkURL:          cfg.CheckURLResona,
-			})
-		}
-		if settings.Mysekai.AllowLuna {
-			targets = append(targets, syncTarget{
-				url:               cfg.EndpointLuna,
-				secret:            cfg.SecretLuna,
-				sendJSONZstandard: cfg.SendJSONZstandardLuna,
-				checkEnabled:      cfg.CheckEnabledLuna,
-				checkURL:          cfg.CheckURLLuna,
-			})
-		}
-	}
-
-	return targets
+) []harukiConfig.DataProviderConfig {
+	return dataprovider.Select(providers, server, dataType, settings.Suite, settings.Mysekai)
 }
 
-func computeProcessingNeeds(targets []syncTarget, dataType utils.UploadDataType) (bool, bool) {
+func computeProcessingNeeds(targets []harukiConfig.DataProviderConfig, dataType utils.UploadDataType) (bool, bool) {
 	needsProcessed := false
 	needsRestored := false
 
 	for _, target := range targets {
-		if !target.sendJSONZstandard {
+		if target.Format == dataprovider.FormatRaw {
 			continue
 		}
-		if target.restoreSuite && dataType == utils.UploadDataTypeSuite {
+		if target.Format == dataprovider.FormatRestored && dataType == utils.UploadDataTypeSuite {
 			needsRestored = true
 			continue
 		}
@@ -141,7 +58,7 @@ func computeProcessingNeeds(targets []syncTarget, dataType utils.UploadDataType)
 }
 
 func chooseSyncPayload(
-	target syncTarget,
+	target harukiConfig.DataProviderConfig,
 	dataType utils.UploadDataType,
 	rawData []byte,
 	processedData []byte,
@@ -149,42 +66,42 @@ func chooseSyncPayload(
 	needsProcessed bool,
 	needsRestored bool,
 ) ([]byte, string) {
-	if target.sendJSONZstandard && target.restoreSuite && dataType == utils.UploadDataTypeSuite && needsRestored {
+	if target.Format == dataprovider.FormatRestored && dataType == utils.UploadDataTypeSuite && needsRestored {
 		return restoredData, utils.HarukiDataSyncerDataFormatJsonZstd
 	}
-	if target.sendJSONZstandard && needsProcessed {
+	if target.Format != dataprovider.FormatRaw && needsProcessed {
 		return processedData, utils.HarukiDataSyncerDataFormatJsonZstd
 	}
 	return rawData, utils.HarukiDataSyncerDataFormatRaw
 }
 
 func buildSyncHeaders(
-	target syncTarget,
+	target harukiConfig.DataProviderConfig,
 	userID int64,
 	server utils.SupportedDataUploadServer,
 	dataType utils.UploadDataType,
 ) map[string]string {
-	if target.is8823 {
+	if target.Auth == dataprovider.AuthXCredentials {
 		return map[string]string{
-			headerXCredentials:  target.secret,
+			headerXCredentials:  target.Secret,
 			headerXServerRegion: string(server),
 			headerXUploadType:   string(dataType),
 			headerXUserID:       strconv.FormatInt(userID, 10),
 		}
 	}
 	return map[string]string{
-		headerAuthorization: fmt.Sprintf(authBearerFormat, target.secret),
+		headerAuthorization: fmt.Sprintf(authBearerFormat, target.Secret),
 	}
 }
 
-func buildCheckHeaders(target syncTarget) map[string]string {
-	if target.is8823 {
+func buildCheckHeaders(target harukiConfig.DataProviderConfig) map[string]string {
+	if target.Auth == dataprovider.AuthXCredentials {
 		return map[string]string{
-			headerXCredentials: target.secret,
+			headerXCredentials: target.Secret,
 		}
 	}
 	return map[string]string{
-		headerAuthorization: fmt.Sprintf(authBearerFormat, target.secret),
+		headerAuthorization: fmt.Sprintf(authBearerFormat, target.Secret),
 	}
 }
 
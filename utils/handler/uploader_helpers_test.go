@@ -5,6 +5,7 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/ent/toolbox/schema"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	apiHelper "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/api"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/dataprovider"
 	"testing"
 )
 
@@ -39,17 +40,17 @@ func TestBuildSyncTargetsSuite(t *testing.T) {
 		},
 	}
 
-	targets := buildSyncTargets(cfg, utils.UploadDataTypeSuite, settings)
+	targets := buildSyncTargets(cfg.ProviderDefinitions(), utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite, settings)
 	if len(targets) != 3 {
 		t.Fatalf("buildSyncTargets suite len = %d, want 3", len(targets))
 	}
-	if !targets[0].is8823 || !targets[0].restoreSuite {
+	if targets[0].Auth != dataprovider.AuthXCredentials || targets[0].Format != dataprovider.FormatRestored {
 		t.Fatalf("first target should be 8823 with restoreSuite enabled")
 	}
-	if targets[1].url != "https://sakura/upload" || !targets[1].restoreSuite {
+	if targets[1].Endpoint != "https://sakura/upload" || targets[1].Format != dataprovider.FormatRestored {
 		t.Fatalf("second target mismatch: %+v", targets[1])
 	}
-	if targets[2].url != "https://resona/upload" || targets[2].restoreSuite {
+	if targets[2].Endpoint != "https://resona/upload" || targets[2].Format != dataprovider.FormatRaw {
 		t.Fatalf("third target mismatch: %+v", targets[2])
 	}
 }
@@ -80,27 +81,60 @@ func TestBuildSyncTargetsMysekai(t *testing.T) {
 		},
 	}
 
-	targets := buildSyncTargets(cfg, utils.UploadDataTypeMysekai, settings)
+	targets := buildSyncTargets(cfg.ProviderDefinitions(), utils.SupportedDataUploadServerJP, utils.UploadDataTypeMysekai, settings)
 	if len(targets) != 2 {
 		t.Fatalf("buildSyncTargets mysekai len = %d, want 2", len(targets))
 	}
 	for _, target := range targets {
-		if target.restoreSuite {
-			t.Fatalf("mysekai targets should not enable restoreSuite: %+v", target)
-		}
-		if target.url == "https://sakura/upload" {
+		if target.Endpoint == "https://sakura/upload" {
 			t.Fatalf("mysekai should not include sakura target")
 		}
 	}
+	needsProcessed, needsRestored := computeProcessingNeeds(targets, utils.UploadDataTypeMysekai)
+	if !needsProcessed || needsRestored {
+		t.Fatalf("mysekai targets should not restore suite data: processed=%v restored=%v", needsProcessed, needsRestored)
+	}
+}
+
+func TestBuildSyncTargetsProviderList(t *testing.T) {
+	t.Parallel()
+
+	providers := []harukiConfig.DataProviderConfig{
+		{Name: "partner", Enabled: true, Endpoint: "https://partner/upload", DataTypes: []string{"suite"}, Servers: []string{"jp"}},
+		{Name: "public", Enabled: true, Endpoint: "https://public/upload", DataTypes: []string{"suite"}, Privacy: harukiConfig.DataProviderPrivacyConfig{ConsentKey: "public", RequirePublicAPI: true}},
+		{Name: "disabled", Endpoint: "https://disabled/upload", DataTypes: []string{"suite"}},
+	}
+	settings := apiHelper.HarukiToolboxGameAccountPrivacySettings{
+		Suite: &schema.SuiteDataPrivacySettings{
+			AllowProviders: map[string]bool{"partner": true, "public": true, "disabled": true},
+		},
+	}
+
+	targets := buildSyncTargets(providers, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite, settings)
+	if len(targets) != 1 || targets[0].Name != "partner" {
+		t.Fatalf("jp targets = %+v, want only partner", targets)
+	}
+	if targets := buildSyncTargets(providers, utils.SupportedDataUploadServerEN, utils.UploadDataTypeSuite, settings); len(targets) != 0 {
+		t.Fatalf("partner is limited to jp, got %+v", targets)
+	}
+
+	settings.Suite.AllowPublicApi = true
+	targets = buildSyncTargets(providers, utils.SupportedDataUploadServerEN, utils.UploadDataTypeSuite, settings)
+	if len(targets) != 1 || targets[0].Name != "public" {
+		t.Fatalf("en targets = %+v, want only public", targets)
+	}
+	if targets := buildSyncTargets(providers, utils.SupportedDataUploadServerJP, utils.UploadDataTypeMysekai, settings); len(targets) != 0 {
+		t.Fatalf("mysekai without privacy settings should not sync, got %+v", targets)
+	}
 }
 
 func TestComputeProcessingNeeds(t *testing.T) {
 	t.Parallel()
 
-	targets := []syncTarget{
-		{sendJSONZstandard: true, restoreSuite: true},
-		{sendJSONZstandard: true, restoreSuite: false},
-		{sendJSONZstandard: false},
+	targets := []harukiConfig.DataProviderConfig{
+		{Format: dataprovider.FormatRestored},
+		{Format: dataprovider.FormatJSONZstd},
+		{Format: dataprovider.FormatRaw},
 	}
 
 	needsProcessed, needsRestored := computeProcessingNeeds(targets, utils.UploadDataTypeSuite)
@@ -121,7 +155,7 @@ func TestChooseSyncPayload(t *testing.T) {
 	processed := []byte("processed")
 	restored := []byte("restored")
 
-	target := syncTarget{sendJSONZstandard: true, restoreSuite: true}
+	target := harukiConfig.DataProviderConfig{Format: dataprovider.FormatRestored}
 	gotData, gotFormat := chooseSyncPayload(
 		target,
 		utils.UploadDataTypeSuite,
@@ -135,7 +169,7 @@ func TestChooseSyncPayload(t *testing.T) {
 		t.Fatalf("suite restored payload mismatch: data=%q format=%q", string(gotData), gotFormat)
 	}
 
-	target = syncTarget{sendJSONZstandard: true}
+	target = harukiConfig.DataProviderConfig{Format: dataprovider.FormatJSONZstd}
 	gotData, gotFormat = chooseSyncPayload(
 		target,
 		utils.UploadDataTypeMysekai,
@@ -149,7 +183,7 @@ func TestChooseSyncPayload(t *testing.T) {
 		t.Fatalf("processed payload mismatch: data=%q format=%q", string(gotData), gotFormat)
 	}
 
-	target = syncTarget{}
+	target = harukiConfig.DataProviderConfig{Format: dataprovider.FormatRaw}
 	gotData, gotFormat = chooseSyncPayload(
 		target,
 		utils.UploadDataTypeMysekai,
@@ -167,7 +201,7 @@ func TestChooseSyncPayload(t *testing.T) {
 func TestBuildSyncHeaders(t *testing.T) {
 	t.Parallel()
 
-	target := syncTarget{is8823: true, secret: "sec"}
+	target := harukiConfig.DataProviderConfig{Auth: dataprovider.AuthXCredentials, Secret: "sec"}
 	headers := buildSyncHeaders(target, 123, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite)
 	if headers[headerXCredentials] != "sec" {
 		t.Fatalf("x-credentials mismatch")
@@ -182,7 +216,7 @@ func TestBuildSyncHeaders(t *testing.T) {
 		t.Fatalf("x-upload-type mismatch")
 	}
 
-	target = syncTarget{secret: "token"}
+	target = harukiConfig.DataProviderConfig{Auth: dataprovider.AuthBearer, Secret: "token"}
 	headers = buildSyncHeaders(target, 1, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite)
 	if headers[headerAuthorization] != "Bearer token" {
 		t.Fatalf("authorization mismatch: %q", headers[headerAuthorization])
@@ -1,23 +1,33 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	oauth2Module "github.com/Team-Haruki/Haruki-Toolbox-Backend/internal/modules/oauth2"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
 	harukiOAuth2 "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/oauth2"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 	harukiVersion "github.com/Team-Haruki/Haruki-Toolbox-Backend/version"
 	"io"
 	"net"
 	stdhttp "net/http"
 	urlpkg "net/url"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
-const webhookCallbackTimeout = 10 * time.Second
+const (
+	webhookCallbackTimeout      = 10 * time.Second
+	webhookAttemptRecordTimeout = 5 * time.Second
+)
 
 var webhookIPAddrLookup = net.DefaultResolver.LookupIPAddr
 
@@ -68,17 +78,21 @@ var webhookCallbackHTTPClient = &stdhttp.Client{
 	CheckRedirect: func(*stdhttp.Request, []*stdhttp.Request) error {
 		return stdhttp.ErrUseLastResponse
 	},
-	Transport: &stdhttp.Transport{
+	Transport: harukiTracing.Transport(&stdhttp.Transport{
 		Proxy:                 nil,
 		DialContext:           webhookSafeDialContext,
 		TLSHandshakeTimeout:   5 * time.Second,
 		ResponseHeaderTimeout: webhookCallbackTimeout,
 		MaxIdleConns:          16,
-	},
+	}),
 }
 
-func doWebhookCallback(ctx context.Context, url string, headers map[string]string) (int, error) {
-	req, err := stdhttp.NewRequestWithContext(ctx, stdhttp.MethodPost, url, nil)
+func doWebhookCallback(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	var reqBody io.Reader
+	if len(body) > 0 {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := stdhttp.NewRequestWithContext(ctx, stdhttp.MethodPost, url, reqBody)
 	if err != nil {
 		return 0, err
 	}
@@ -94,6 +108,23 @@ func doWebhookCallback(ctx context.Context, url string, headers map[string]strin
 	return resp.StatusCode, nil
 }
 
+// performWebhookCallback sends one callback and records the attempt against
+// its endpoint. Recording uses a detached context so an attempt that used up
+// the callback deadline is still logged.
+func performWebhookCallback(ctx context.Context, db *dbManager.Client, logger *harukiLogger.Logger, target webhookAttemptTarget, url string, headers map[string]string, body []byte) (int, error) {
+	startedAt := time.Now()
+	statusCode, err := doWebhookCallback(ctx, url, headers, body)
+	latency := time.Since(startedAt)
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), webhookAttemptRecordTimeout)
+	defer cancel()
+	recordWebhookAttempt(recordCtx, db, logger, target, statusCode, err, latency)
+	return statusCode, err
+}
+
+func webhookUserAgent() string {
+	return fmt.Sprintf("Haruki-Toolbox-Backend/%s", harukiVersion.Version)
+}
+
 func isHTTPSuccessStatus(statusCode int) bool {
 	return statusCode >= 200 && statusCode < 300
 }
@@ -148,21 +179,33 @@ func ValidateWebhookCallbackURL(rawURL string) (string, bool) {
 	return trimmedURL, true
 }
 
-func (h *DataHandler) CallbackWebhookAPI(ctx context.Context, url, bearer string) {
+// CallbackWebhookAPI performs a single unqueued callback. payload and secrets
+// may be empty, in which case the request carries no body or signature.
+func (h *DataHandler) CallbackWebhookAPI(ctx context.Context, url, bearer string, payload []byte, secrets []string) {
+	h.callbackWebhookDirect(ctx, webhookAttemptTarget{}, url, bearer, payload, secrets)
+}
+
+func (h *DataHandler) callbackWebhookDirect(ctx context.Context, target webhookAttemptTarget, url, bearer string, payload []byte, secrets []string) {
 	h.Logger.Infof("Calling back WebHook API: %s", url)
-	headers := map[string]string{
-		"User-Agent": fmt.Sprintf("Haruki-Toolbox-Backend/%s", harukiVersion.Version),
-	}
-	if bearer != "" {
-		headers["Authorization"] = "Bearer " + bearer
+	eventID := target.eventID
+	if eventID == "" && len(payload) > 0 {
+		var event WebhookEvent
+		if err := json.Unmarshal(payload, &event); err == nil {
+			eventID = event.ID
+		}
 	}
+	headers := buildWebhookRequestHeaders(bearer, "", eventID, 0, payload, secrets, webhookDeliveryNow())
 	if validatedURL, ok := ValidateWebhookCallbackURL(url); ok {
 		url = validatedURL
 	} else {
 		h.Logger.Warnf("Skipped webhook callback after URL validation failed: %s", url)
 		return
 	}
-	statusCode, err := doWebhookCallback(ctx, url, headers)
+	var db *dbManager.Client
+	if h.DBManager != nil {
+		db = h.DBManager.DB
+	}
+	statusCode, err := performWebhookCallback(ctx, db, h.Logger, target, url, headers, payload)
 	if err != nil {
 		h.Logger.Errorf("WebHook API call failed: %v", err)
 		return
@@ -246,20 +289,24 @@ func applyWebhookPlaceholders(rawURL string, userID int64, server utils.Supporte
 	return url
 }
 
-func (h *DataHandler) CallWebhook(
-	ctx context.Context,
-	userID int64,
-	server utils.SupportedDataUploadServer,
-	dataType utils.UploadDataType,
-) {
+func (h *DataHandler) CallWebhook(ctx context.Context, event WebhookEvent) {
 	if h == nil || !h.WebhookEnabled || h.DBManager == nil || h.DBManager.DB == nil {
 		return
 	}
+	userID, server, dataType, ok := webhookEventTarget(event)
+	if !ok {
+		return
+	}
 	callbacks, err := h.DBManager.DB.GetWebhookPushAPI(ctx, userID, string(server), string(dataType))
 	if err != nil || len(callbacks) == 0 {
 		return
 	}
-	var wg sync.WaitGroup
+	payload, err := json.Marshal(event)
+	if err != nil {
+		h.Logger.Errorf("Failed to encode webhook event %s: %v", event.ID, err)
+		return
+	}
+	dispatches := make([]webhookDispatch, 0, len(callbacks))
 	for _, cb := range callbacks {
 		url, bearer, ok := parseWebhookCallback(cb)
 		if !ok {
@@ -267,33 +314,34 @@ func (h *DataHandler) CallWebhook(
 			continue
 		}
 		url = applyWebhookPlaceholders(url, userID, server, dataType)
-		wg.Add(1)
-		go func(u, b string) {
-			defer wg.Done()
-			h.CallbackWebhookAPI(ctx, u, b)
-		}(url, bearer)
+		dispatch := newWebhookDispatch(webhookdelivery.EndpointTypeWebhook, cb.EndpointID, "", url, bearer, event, payload)
+		dispatch.secrets = cb.SigningSecrets.Active(webhookDeliveryNow())
+		dispatches = append(dispatches, dispatch)
 	}
-	wg.Wait()
+	h.enqueueWebhookDispatches(ctx, dispatches)
 }
 
-func (h *DataHandler) CallWebhookAsync(userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType) {
-	ctx, cancel := context.WithTimeout(context.Background(), webhookCallbackTimeout)
+// CallWebhookAsync runs CallWebhook under its own timeout. parent only
+// supplies the trace; it may already be cancelled.
+func (h *DataHandler) CallWebhookAsync(parent context.Context, event WebhookEvent) {
+	ctx, cancel := context.WithTimeout(harukiTracing.Detach(parent), webhookCallbackTimeout)
 	defer cancel()
-	h.CallWebhook(ctx, userID, server, dataType)
+	ctx, span := harukiTracing.Start(ctx, "webhook.enqueue", attribute.String("webhook.event_id", event.ID))
+	defer span.End()
+	h.CallWebhook(ctx, event)
 }
 
-func (h *DataHandler) CallOAuth2Webhook(
-	ctx context.Context,
-	userID int64,
-	server utils.SupportedDataUploadServer,
-	dataType utils.UploadDataType,
-) {
+func (h *DataHandler) CallOAuth2Webhook(ctx context.Context, event WebhookEvent) {
 	if h == nil || !h.WebhookEnabled || h.DBManager == nil || h.DBManager.DB == nil {
 		return
 	}
 	if !oauth2Module.HydraOAuthManagementEnabled() {
 		return
 	}
+	userID, server, dataType, ok := webhookEventTarget(event)
+	if !ok {
+		return
+	}
 
 	owner, err := h.DBManager.DB.GetOAuth2WebhookOwnerForGameAccount(ctx, userID, string(server))
 	if err != nil {
@@ -328,8 +376,13 @@ func (h *DataHandler) CallOAuth2Webhook(
 	if len(callbacks) == 0 {
 		return
 	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		h.Logger.Errorf("Failed to encode OAuth2 webhook event %s: %v", event.ID, err)
+		return
+	}
 
-	var wg sync.WaitGroup
+	dispatches := make([]webhookDispatch, 0, len(callbacks))
 	for _, cb := range callbacks {
 		url, bearer, ok := parseWebhookCallback(cb)
 		if !ok {
@@ -337,17 +390,25 @@ func (h *DataHandler) CallOAuth2Webhook(
 			continue
 		}
 		url = applyWebhookPlaceholders(url, userID, server, dataType)
-		wg.Add(1)
-		go func(u, b string) {
-			defer wg.Done()
-			h.CallbackWebhookAPI(ctx, u, b)
-		}(url, bearer)
+		dispatch := newWebhookDispatch(webhookdelivery.EndpointTypeOauth2Client, cb.EndpointID, cb.ClientID, url, bearer, event, payload)
+		dispatch.secrets = cb.SigningSecrets.Active(webhookDeliveryNow())
+		dispatches = append(dispatches, dispatch)
 	}
-	wg.Wait()
+	h.enqueueWebhookDispatches(ctx, dispatches)
 }
 
-func (h *DataHandler) CallOAuth2WebhookAsync(userID int64, server utils.SupportedDataUploadServer, dataType utils.UploadDataType) {
-	ctx, cancel := context.WithTimeout(context.Background(), webhookCallbackTimeout)
+func (h *DataHandler) CallOAuth2WebhookAsync(parent context.Context, event WebhookEvent) {
+	ctx, cancel := context.WithTimeout(harukiTracing.Detach(parent), webhookCallbackTimeout)
 	defer cancel()
-	h.CallOAuth2Webhook(ctx, userID, server, dataType)
+	ctx, span := harukiTracing.Start(ctx, "webhook.oauth2_enqueue", attribute.String("webhook.event_id", event.ID))
+	defer span.End()
+	h.CallOAuth2Webhook(ctx, event)
+}
+
+func webhookEventTarget(event WebhookEvent) (int64, utils.SupportedDataUploadServer, utils.UploadDataType, bool) {
+	userID, err := strconv.ParseInt(event.UserID, 10, 64)
+	if err != nil {
+		return 0, "", "", false
+	}
+	return userID, utils.SupportedDataUploadServer(event.Server), utils.UploadDataType(event.DataType), true
 }
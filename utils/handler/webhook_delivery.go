@@ -0,0 +1,413 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
+)
+
+const (
+	webhookDeliveryIDHeader      = "X-Haruki-Delivery-Id"
+	webhookDeliveryAttemptHeader = "X-Haruki-Delivery-Attempt"
+
+	webhookAttemptPruneInterval = time.Hour
+)
+
+// errWebhookDeliveryPermanent marks failures that retrying cannot fix, such as
+// a deleted endpoint; those deliveries are dead-lettered immediately.
+var errWebhookDeliveryPermanent = errors.New("permanent webhook delivery failure")
+
+type WebhookDeliveryOptions struct {
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+	Concurrency  int
+}
+
+func DefaultWebhookDeliveryOptions() WebhookDeliveryOptions {
+	return WebhookDeliveryOptions{
+		MaxAttempts:  8,
+		BaseBackoff:  30 * time.Second,
+		MaxBackoff:   time.Hour,
+		PollInterval: 5 * time.Second,
+		BatchSize:    50,
+		Concurrency:  8,
+	}
+}
+
+var (
+	webhookDeliveryOptionsMu sync.RWMutex
+	webhookDeliveryOptions   = DefaultWebhookDeliveryOptions()
+	webhookDeliveryWake      = make(chan struct{}, 1)
+	webhookDeliveryNow       = time.Now
+)
+
+// ConfigureWebhookDelivery sets the options used when new deliveries are
+// enqueued; it is called once at startup.
+func ConfigureWebhookDelivery(options WebhookDeliveryOptions) {
+	webhookDeliveryOptionsMu.Lock()
+	defer webhookDeliveryOptionsMu.Unlock()
+	webhookDeliveryOptions = options
+}
+
+func currentWebhookDeliveryOptions() WebhookDeliveryOptions {
+	webhookDeliveryOptionsMu.RLock()
+	defer webhookDeliveryOptionsMu.RUnlock()
+	return webhookDeliveryOptions
+}
+
+// NotifyWebhookDeliveryWorker wakes the delivery worker early, e.g. after new
+// deliveries are enqueued or an admin replays one.
+func NotifyWebhookDeliveryWorker() {
+	select {
+	case webhookDeliveryWake <- struct{}{}:
+	default:
+	}
+}
+
+func newWebhookDeliveryID() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// webhookDeliveryBackoff returns base * 2^(attempt-1), capped at max.
+func webhookDeliveryBackoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	backoff := base
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
+
+type webhookDispatch struct {
+	record  dbManager.WebhookDeliveryRecord
+	bearer  string
+	secrets []string
+}
+
+func newWebhookDispatch(
+	endpointType webhookdelivery.EndpointType,
+	endpointID string,
+	clientID string,
+	url string,
+	bearer string,
+	event WebhookEvent,
+	payload []byte,
+) webhookDispatch {
+	return webhookDispatch{
+		record: dbManager.WebhookDeliveryRecord{
+			EndpointType: endpointType,
+			EndpointID:   endpointID,
+			ClientID:     clientID,
+			CallbackURL:  url,
+			GameUserID:   event.UserID,
+			Server:       event.Server,
+			DataType:     event.DataType,
+			EventID:      event.ID,
+			Payload:      payload,
+		},
+		bearer: bearer,
+	}
+}
+
+// enqueueWebhookDispatches persists the deliveries for the worker. If the
+// queue cannot be written the callbacks are fired directly, as before the
+// queue existed, so an outage of the queue table does not drop events.
+func (h *DataHandler) enqueueWebhookDispatches(ctx context.Context, dispatches []webhookDispatch) {
+	if len(dispatches) == 0 {
+		return
+	}
+	options := currentWebhookDeliveryOptions()
+	records := make([]dbManager.WebhookDeliveryRecord, 0, len(dispatches))
+	for _, dispatch := range dispatches {
+		id, err := newWebhookDeliveryID()
+		if err != nil {
+			h.Logger.Errorf("Failed to generate webhook delivery id: %v", err)
+			records = nil
+			break
+		}
+		record := dispatch.record
+		record.ID = id
+		record.MaxAttempts = options.MaxAttempts
+		records = append(records, record)
+	}
+	if records != nil {
+		err := h.DBManager.DB.EnqueueWebhookDeliveries(ctx, records, webhookDeliveryNow().UTC())
+		if err == nil {
+			NotifyWebhookDeliveryWorker()
+			return
+		}
+		h.Logger.Errorf("Failed to enqueue webhook deliveries, calling back directly: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, dispatch := range dispatches {
+		wg.Add(1)
+		go func(d webhookDispatch) {
+			defer wg.Done()
+			target := webhookAttemptTarget{
+				endpointType: d.record.EndpointType,
+				endpointID:   d.record.EndpointID,
+				clientID:     d.record.ClientID,
+				eventID:      d.record.EventID,
+				attempt:      1,
+			}
+			h.callbackWebhookDirect(ctx, target, d.record.CallbackURL, d.bearer, d.record.Payload, d.secrets)
+		}(dispatch)
+	}
+	wg.Wait()
+}
+
+type WebhookDeliveryWorker struct {
+	db      *dbManager.Client
+	logger  *harukiLogger.Logger
+	options WebhookDeliveryOptions
+}
+
+func NewWebhookDeliveryWorker(db *dbManager.Client, logger *harukiLogger.Logger, options WebhookDeliveryOptions) *WebhookDeliveryWorker {
+	return &WebhookDeliveryWorker{db: db, logger: logger, options: options}
+}
+
+// Start runs the worker until ctx is cancelled and returns a function that
+// blocks until in-flight deliveries have finished.
+func (w *WebhookDeliveryWorker) Start(ctx context.Context) func() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(w.options.PollInterval)
+		defer ticker.Stop()
+		var lastPrunedAt time.Time
+		for {
+			w.RunOnce(ctx)
+			if now := webhookDeliveryNow(); now.Sub(lastPrunedAt) >= webhookAttemptPruneInterval {
+				w.pruneAttempts(ctx, now)
+				lastPrunedAt = now
+			}
+			select {
+			case <-ctx.Done():
+				w.logger.Infof("webhook delivery worker stopped")
+				return
+			case <-ticker.C:
+			case <-webhookDeliveryWake:
+			}
+		}
+	}()
+	return wg.Wait
+}
+
+// RunOnce claims and delivers one batch of due deliveries and returns how many
+// were attempted.
+func (w *WebhookDeliveryWorker) RunOnce(ctx context.Context) int {
+	now := webhookDeliveryNow().UTC()
+	due, err := w.db.ListDueWebhookDeliveries(ctx, now, w.options.BatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Errorf("Failed to list due webhook deliveries: %v", err)
+		}
+		return 0
+	}
+
+	sem := make(chan struct{}, w.options.Concurrency)
+	var wg sync.WaitGroup
+	attempted := 0
+	for _, delivery := range due {
+		// Take a worker slot before claiming so a claimed delivery never
+		// waits in line while its lease runs out.
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return attempted
+		}
+		// The lease must outlive a full callback so another instance does not
+		// pick the same delivery up while this one is still waiting on it.
+		claimedAt := webhookDeliveryNow().UTC()
+		claimed, err := w.db.ClaimWebhookDelivery(ctx, delivery, claimedAt, claimedAt.Add(2*webhookCallbackTimeout))
+		if err != nil || !claimed {
+			if err != nil {
+				w.logger.Errorf("Failed to claim webhook delivery %s: %v", delivery.ID, err)
+			}
+			<-sem
+			continue
+		}
+		delivery.Attempts++
+		attempted++
+		wg.Add(1)
+		go func(d *dbManager.WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			w.deliver(ctx, d)
+		}(delivery)
+	}
+	wg.Wait()
+	return attempted
+}
+
+// pruneAttempts drops attempt records older than the configured retention.
+func (w *WebhookDeliveryWorker) pruneAttempts(ctx context.Context, now time.Time) {
+	options, _, _ := currentWebhookHealthConfig()
+	if options.AttemptRetention <= 0 {
+		return
+	}
+	deleted, err := w.db.PurgeWebhookDeliveryAttempts(ctx, now.UTC().Add(-options.AttemptRetention))
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Warnf("Failed to prune webhook delivery attempts: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		w.logger.Infof("Pruned %d webhook delivery attempt record(s)", deleted)
+	}
+}
+
+func (w *WebhookDeliveryWorker) deliver(ctx context.Context, delivery *dbManager.WebhookDelivery) {
+	target, err := w.resolveTarget(ctx, delivery)
+	if err != nil {
+		w.recordFailure(ctx, delivery, nil, err)
+		return
+	}
+	if target.url != delivery.CallbackURL {
+		if err := w.db.WebhookDelivery.UpdateOneID(delivery.ID).SetCallbackURL(target.url).Exec(ctx); err != nil {
+			w.logger.Warnf("Failed to refresh webhook delivery %s callback url: %v", delivery.ID, err)
+		}
+	}
+
+	// The signature is computed per attempt so its timestamp is always fresh
+	// and a rotated secret takes effect on the next retry.
+	payload := []byte(delivery.Payload)
+	headers := buildWebhookRequestHeaders(target.bearer, delivery.ID, delivery.EventID, delivery.Attempts, payload, target.secrets, webhookDeliveryNow())
+	callbackCtx, cancel := context.WithTimeout(ctx, webhookCallbackTimeout)
+	defer cancel()
+	attemptTarget := webhookAttemptTarget{
+		endpointType: delivery.EndpointType,
+		endpointID:   delivery.EndpointID,
+		deliveryID:   delivery.ID,
+		eventID:      delivery.EventID,
+		attempt:      delivery.Attempts,
+	}
+	if delivery.ClientID != nil {
+		attemptTarget.clientID = *delivery.ClientID
+	}
+	statusCode, err := performWebhookCallback(callbackCtx, w.db, w.logger, attemptTarget, target.url, headers, payload)
+	if err != nil {
+		w.recordFailure(ctx, delivery, nil, err)
+		return
+	}
+	if !isHTTPSuccessStatus(statusCode) {
+		w.recordFailure(ctx, delivery, &statusCode, fmt.Errorf("unexpected status code %d", statusCode))
+		return
+	}
+	harukiMetrics.RecordWebhookDelivery(string(delivery.EndpointType), harukiMetrics.WebhookSuccess)
+	if err := w.db.CompleteWebhookDelivery(ctx, delivery.ID, statusCode, webhookDeliveryNow().UTC()); err != nil {
+		w.logger.Errorf("Failed to mark webhook delivery %s as succeeded: %v", delivery.ID, err)
+	}
+}
+
+type webhookDeliveryTarget struct {
+	url     string
+	bearer  string
+	secrets []string
+}
+
+// resolveTarget reloads the endpoint so deliveries honour callback URL, bearer
+// and signing secret changes made after they were enqueued.
+func (w *WebhookDeliveryWorker) resolveTarget(ctx context.Context, delivery *dbManager.WebhookDelivery) (webhookDeliveryTarget, error) {
+	var rawURL string
+	var bearer *string
+	var signingSecrets dbManager.WebhookSigningSecrets
+	switch delivery.EndpointType {
+	case webhookdelivery.EndpointTypeWebhook:
+		endpoint, err := w.db.WebhookEndpoint.Get(ctx, delivery.EndpointID)
+		if dbManager.IsNotFound(err) {
+			return webhookDeliveryTarget{}, fmt.Errorf("%w: webhook endpoint no longer exists", errWebhookDeliveryPermanent)
+		}
+		if err != nil {
+			return webhookDeliveryTarget{}, fmt.Errorf("query webhook endpoint: %w", err)
+		}
+		if !endpoint.Enabled {
+			return webhookDeliveryTarget{}, fmt.Errorf("%w: webhook endpoint is disabled", errWebhookDeliveryPermanent)
+		}
+		rawURL, bearer, signingSecrets = endpoint.CallbackURL, endpoint.Bearer, endpoint.SigningSecrets()
+	case webhookdelivery.EndpointTypeOauth2Client:
+		endpoint, err := w.db.OAuth2ClientWebhookEndpoint.Get(ctx, delivery.EndpointID)
+		if dbManager.IsNotFound(err) {
+			return webhookDeliveryTarget{}, fmt.Errorf("%w: oauth client webhook no longer exists", errWebhookDeliveryPermanent)
+		}
+		if err != nil {
+			return webhookDeliveryTarget{}, fmt.Errorf("query oauth client webhook: %w", err)
+		}
+		if !endpoint.Enabled {
+			return webhookDeliveryTarget{}, fmt.Errorf("%w: oauth client webhook is disabled", errWebhookDeliveryPermanent)
+		}
+		rawURL, bearer, signingSecrets = endpoint.CallbackURL, endpoint.Bearer, endpoint.SigningSecrets()
+	default:
+		return webhookDeliveryTarget{}, fmt.Errorf("%w: unknown endpoint type %q", errWebhookDeliveryPermanent, delivery.EndpointType)
+	}
+
+	validatedURL, ok := ValidateWebhookCallbackURL(rawURL)
+	if !ok {
+		return webhookDeliveryTarget{}, fmt.Errorf("%w: callback url failed validation", errWebhookDeliveryPermanent)
+	}
+	userID, err := strconv.ParseInt(delivery.GameUserID, 10, 64)
+	if err != nil {
+		return webhookDeliveryTarget{}, fmt.Errorf("%w: invalid game user id", errWebhookDeliveryPermanent)
+	}
+	url := applyWebhookPlaceholders(validatedURL, userID, utils.SupportedDataUploadServer(delivery.Server), utils.UploadDataType(delivery.DataType))
+	target := webhookDeliveryTarget{
+		url:     url,
+		secrets: signingSecrets.Active(webhookDeliveryNow()),
+	}
+	if bearer != nil {
+		target.bearer = *bearer
+	}
+	return target, nil
+}
+
+func (w *WebhookDeliveryWorker) recordFailure(ctx context.Context, delivery *dbManager.WebhookDelivery, statusCode *int, cause error) {
+	var nextAttemptAt *time.Time
+	if !errors.Is(cause, errWebhookDeliveryPermanent) && delivery.Attempts < delivery.MaxAttempts {
+		next := webhookDeliveryNow().UTC().Add(webhookDeliveryBackoff(delivery.Attempts, w.options.BaseBackoff, w.options.MaxBackoff))
+		nextAttemptAt = &next
+	}
+	if err := w.db.FailWebhookDelivery(ctx, delivery.ID, statusCode, cause.Error(), nextAttemptAt); err != nil {
+		w.logger.Errorf("Failed to record webhook delivery %s failure: %v", delivery.ID, err)
+		return
+	}
+	if nextAttemptAt == nil {
+		harukiMetrics.RecordWebhookDelivery(string(delivery.EndpointType), harukiMetrics.WebhookDeadLetter)
+		w.logger.Warnf("Webhook delivery %s dead-lettered after %d attempt(s): %v", delivery.ID, delivery.Attempts, cause)
+		return
+	}
+	harukiMetrics.RecordWebhookDelivery(string(delivery.EndpointType), harukiMetrics.WebhookRetry)
+	w.logger.Warnf("Webhook delivery %s attempt %d failed, retrying at %s: %v", delivery.ID, delivery.Attempts, nextAttemptAt.Format(time.RFC3339), cause)
+	if _, err := w.db.DeferWebhookEndpointDeliveries(ctx, delivery.EndpointType, delivery.EndpointID, *nextAttemptAt); err != nil {
+		w.logger.Warnf("Failed to defer pending deliveries for endpoint %s: %v", delivery.EndpointID, err)
+	}
+}
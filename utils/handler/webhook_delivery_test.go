@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"context"
+	"net"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestWebhookDeliveryBackoff(t *testing.T) {
+	t.Parallel()
+
+	base := 30 * time.Second
+	max := 10 * time.Minute
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: 30 * time.Second},
+		{attempt: 1, want: 30 * time.Second},
+		{attempt: 2, want: time.Minute},
+		{attempt: 5, want: 8 * time.Minute},
+		{attempt: 6, want: max},
+		{attempt: 60, want: max},
+	}
+	for _, tt := range tests {
+		if got := webhookDeliveryBackoff(tt.attempt, base, max); got != tt.want {
+			t.Fatalf("webhookDeliveryBackoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// useWebhookDeliveryTestServer routes webhook callbacks for a public-looking
+// host to srv, so the worker can be exercised without relaxing the SSRF guard.
+func useWebhookDeliveryTestServer(t *testing.T, srv *httptest.Server) {
+	t.Helper()
+	originalLookup := webhookIPAddrLookup
+	originalClient := webhookCallbackHTTPClient
+	webhookIPAddrLookup = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		return []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil
+	}
+	webhookCallbackHTTPClient = &stdhttp.Client{
+		Transport: &stdhttp.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, network, srv.Listener.Addr().String())
+			},
+		},
+	}
+	t.Cleanup(func() {
+		webhookIPAddrLookup = originalLookup
+		webhookCallbackHTTPClient = originalClient
+	})
+}
+
+func TestWebhookDeliveryWorkerRetriesDeadLettersAndReplays(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook-delivery-worker-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	var mu sync.Mutex
+	status := stdhttp.StatusServiceUnavailable
+	var lastRequest *stdhttp.Request
+	srv := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		lastRequest = r
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	useWebhookDeliveryTestServer(t, srv)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	originalNow := webhookDeliveryNow
+	webhookDeliveryNow = func() time.Time { return now }
+	defer func() {
+		webhookDeliveryNow = originalNow
+	}()
+
+	ctx := context.Background()
+	bearer := "token-a"
+	if err := client.UpsertWebhookEndpoint(ctx, dbManager.WebhookEndpointRecord{
+		ID:          "1",
+		Credential:  "cred-a",
+		CallbackURL: "http://hooks.example.com/{server}/{user_id}",
+		Bearer:      &bearer,
+		Enabled:     true,
+	}); err != nil {
+		t.Fatalf("UpsertWebhookEndpoint returned error: %v", err)
+	}
+	if err := client.EnqueueWebhookDeliveries(ctx, []dbManager.WebhookDeliveryRecord{{
+		ID:           "delivery-1",
+		EndpointType: webhookdelivery.EndpointTypeWebhook,
+		EndpointID:   "1",
+		CallbackURL:  "http://hooks.example.com/jp/123",
+		GameUserID:   "123",
+		Server:       "jp",
+		DataType:     "suite",
+		MaxAttempts:  2,
+	}}, now); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+
+	options := DefaultWebhookDeliveryOptions()
+	options.BaseBackoff = time.Minute
+	worker := NewWebhookDeliveryWorker(client, testLogger(), options)
+
+	if attempted := worker.RunOnce(ctx); attempted != 1 {
+		t.Fatalf("first RunOnce attempted %d, want 1", attempted)
+	}
+	row, err := client.WebhookDelivery.Get(ctx, "delivery-1")
+	if err != nil {
+		t.Fatalf("get delivery: %v", err)
+	}
+	if row.Status != webhookdelivery.StatusPending || row.Attempts != 1 || !row.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("delivery after first failure = %+v", row)
+	}
+	if attempted := worker.RunOnce(ctx); attempted != 0 {
+		t.Fatalf("RunOnce before backoff elapsed attempted %d, want 0", attempted)
+	}
+
+	now = now.Add(time.Minute)
+	worker.RunOnce(ctx)
+	row, err = client.WebhookDelivery.Get(ctx, "delivery-1")
+	if err != nil {
+		t.Fatalf("get delivery: %v", err)
+	}
+	if row.Status != webhookdelivery.StatusDead || row.Attempts != 2 {
+		t.Fatalf("delivery after max attempts = %+v, want dead", row)
+	}
+
+	mu.Lock()
+	status = stdhttp.StatusNoContent
+	mu.Unlock()
+	if _, err := client.ReplayWebhookDelivery(ctx, "delivery-1", now); err != nil {
+		t.Fatalf("ReplayWebhookDelivery returned error: %v", err)
+	}
+	worker.RunOnce(ctx)
+	row, err = client.WebhookDelivery.Get(ctx, "delivery-1")
+	if err != nil {
+		t.Fatalf("get delivery: %v", err)
+	}
+	if row.Status != webhookdelivery.StatusSucceeded || row.DeliveredAt == nil || row.LastStatusCode == nil || *row.LastStatusCode != stdhttp.StatusNoContent {
+		t.Fatalf("delivery after replay = %+v, want succeeded", row)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if lastRequest.URL.Path != "/jp/123" {
+		t.Fatalf("callback path = %q, want /jp/123", lastRequest.URL.Path)
+	}
+	if got := lastRequest.Header.Get("Authorization"); got != "Bearer token-a" {
+		t.Fatalf("Authorization = %q", got)
+	}
+	if got := lastRequest.Header.Get(webhookDeliveryIDHeader); got != "delivery-1" {
+		t.Fatalf("%s = %q", webhookDeliveryIDHeader, got)
+	}
+	if got := lastRequest.Header.Get(webhookDeliveryAttemptHeader); got != "1" {
+		t.Fatalf("%s = %q, want 1", webhookDeliveryAttemptHeader, got)
+	}
+}
+
+func TestWebhookDeliveryWorkerLeasesAtClaimTime(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook-delivery-lease-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var mu sync.Mutex
+	now := base
+	leases := make(map[string]time.Time)
+	originalNow := webhookDeliveryNow
+	webhookDeliveryNow = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	defer func() {
+		webhookDeliveryNow = originalNow
+	}()
+
+	srv := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		id := r.Header.Get(webhookDeliveryIDHeader)
+		if row, err := client.WebhookDelivery.Get(r.Context(), id); err == nil {
+			leases[id] = row.NextAttemptAt
+		}
+		// Every callback is slow, so the second delivery only starts once
+		// the first one's time has passed.
+		mu.Lock()
+		now = now.Add(30 * time.Second)
+		mu.Unlock()
+		w.WriteHeader(stdhttp.StatusNoContent)
+	}))
+	defer srv.Close()
+	useWebhookDeliveryTestServer(t, srv)
+
+	ctx := context.Background()
+	if err := client.UpsertWebhookEndpoint(ctx, dbManager.WebhookEndpointRecord{
+		ID:          "1",
+		Credential:  "cred-a",
+		CallbackURL: "http://hooks.example.com/{server}/{user_id}",
+		Enabled:     true,
+	}); err != nil {
+		t.Fatalf("UpsertWebhookEndpoint returned error: %v", err)
+	}
+	var records []dbManager.WebhookDeliveryRecord
+	for _, id := range []string{"delivery-a", "delivery-b"} {
+		records = append(records, dbManager.WebhookDeliveryRecord{
+			ID:           id,
+			EndpointType: webhookdelivery.EndpointTypeWebhook,
+			EndpointID:   "1",
+			CallbackURL:  "http://hooks.example.com/jp/123",
+			GameUserID:   "123",
+			Server:       "jp",
+			DataType:     "suite",
+			MaxAttempts:  3,
+		})
+	}
+	if err := client.EnqueueWebhookDeliveries(ctx, records, base); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+
+	options := DefaultWebhookDeliveryOptions()
+	options.Concurrency = 1
+	worker := NewWebhookDeliveryWorker(client, testLogger(), options)
+	if attempted := worker.RunOnce(ctx); attempted != 2 {
+		t.Fatalf("RunOnce attempted %d, want 2", attempted)
+	}
+	if want := base.Add(2 * webhookCallbackTimeout); !leases["delivery-a"].Equal(want) {
+		t.Fatalf("first lease = %s, want %s", leases["delivery-a"], want)
+	}
+	// The second delivery waited for the only slot; its lease must start
+	// when it was claimed, not when the batch was listed.
+	if want := base.Add(30*time.Second + 2*webhookCallbackTimeout); !leases["delivery-b"].Equal(want) {
+		t.Fatalf("second lease = %s, want %s", leases["delivery-b"], want)
+	}
+}
+
+func TestWebhookDeliveryWorkerDeadLettersMissingEndpoint(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook-delivery-missing-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	if err := client.EnqueueWebhookDeliveries(ctx, []dbManager.WebhookDeliveryRecord{{
+		ID:           "delivery-gone",
+		EndpointType: webhookdelivery.EndpointTypeOauth2Client,
+		EndpointID:   "missing",
+		ClientID:     "client-a",
+		CallbackURL:  "https://hooks.example.com/",
+		GameUserID:   "123",
+		Server:       "jp",
+		DataType:     "suite",
+		MaxAttempts:  5,
+	}}, time.Now().UTC()); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+
+	worker := NewWebhookDeliveryWorker(client, testLogger(), DefaultWebhookDeliveryOptions())
+	worker.RunOnce(ctx)
+
+	row, err := client.WebhookDelivery.Get(ctx, "delivery-gone")
+	if err != nil {
+		t.Fatalf("get delivery: %v", err)
+	}
+	if row.Status != webhookdelivery.StatusDead || row.Attempts != 1 {
+		t.Fatalf("delivery for missing endpoint = %+v, want dead after one attempt", row)
+	}
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+)
+
+const defaultWebhookHealthMailDisplayName = "Haruki Toolbox"
+
+type WebhookHealthOptions struct {
+	// AutoDisableFailureThreshold is the number of consecutive failed
+	// attempts after which an endpoint may be disabled; zero turns the
+	// policy off.
+	AutoDisableFailureThreshold int
+	// AutoDisableMinFailureWindow additionally requires the endpoint to have
+	// been failing for at least this long, so a short outage that exhausts
+	// the threshold with queued events does not switch it off.
+	AutoDisableMinFailureWindow time.Duration
+	// AttemptRetention is how long delivery attempt records are kept.
+	AttemptRetention time.Duration
+}
+
+func DefaultWebhookHealthOptions() WebhookHealthOptions {
+	return WebhookHealthOptions{
+		AutoDisableFailureThreshold: 20,
+		AutoDisableMinFailureWindow: 24 * time.Hour,
+		AttemptRetention:            30 * 24 * time.Hour,
+	}
+}
+
+// WebhookMailSender is satisfied by the SMTP client and the mail outbox.
+type WebhookMailSender interface {
+	SendTemplate(to []string, template, locale string, vars map[string]string, displayName string) error
+}
+
+var (
+	webhookHealthMu              sync.RWMutex
+	webhookHealthOptions         = DefaultWebhookHealthOptions()
+	webhookHealthMailer          WebhookMailSender
+	webhookHealthMailDisplayName string
+)
+
+// ConfigureWebhookHealth sets the auto-disable policy and the mailer used to
+// tell endpoint owners; it is called once at startup. A nil mailer disables
+// the notification mail.
+func ConfigureWebhookHealth(options WebhookHealthOptions, mailer WebhookMailSender, displayName string) {
+	webhookHealthMu.Lock()
+	defer webhookHealthMu.Unlock()
+	webhookHealthOptions = options
+	webhookHealthMailer = mailer
+	webhookHealthMailDisplayName = strings.TrimSpace(displayName)
+}
+
+func currentWebhookHealthConfig() (WebhookHealthOptions, WebhookMailSender, string) {
+	webhookHealthMu.RLock()
+	defer webhookHealthMu.RUnlock()
+	return webhookHealthOptions, webhookHealthMailer, webhookHealthMailDisplayName
+}
+
+// webhookAttemptTarget identifies what a callback attempt is recorded
+// against. Callbacks without an endpoint id are not recorded.
+type webhookAttemptTarget struct {
+	endpointType webhookdelivery.EndpointType
+	endpointID   string
+	clientID     string
+	deliveryID   string
+	eventID      string
+	attempt      int
+}
+
+// recordWebhookAttempt stores the attempt and updates the endpoint's failure
+// streak, disabling it and mailing its owner once the streak exceeds the
+// configured policy. Errors are logged; they never fail the delivery itself.
+func recordWebhookAttempt(ctx context.Context, db *dbManager.Client, logger *harukiLogger.Logger, target webhookAttemptTarget, statusCode int, callErr error, latency time.Duration) {
+	if db == nil || strings.TrimSpace(target.endpointID) == "" {
+		return
+	}
+	endpointType := webhookdeliveryattempt.EndpointType(target.endpointType)
+	success := callErr == nil && isHTTPSuccessStatus(statusCode)
+	record := dbManager.WebhookDeliveryAttemptRecord{
+		EndpointType: endpointType,
+		EndpointID:   target.endpointID,
+		ClientID:     target.clientID,
+		DeliveryID:   target.deliveryID,
+		EventID:      target.eventID,
+		Attempt:      target.attempt,
+		Success:      success,
+		Latency:      latency,
+	}
+	if statusCode > 0 {
+		record.StatusCode = &statusCode
+	}
+	switch {
+	case callErr != nil:
+		record.Error = callErr.Error()
+	case !success:
+		record.Error = "unexpected status code " + strconv.Itoa(statusCode)
+	}
+	now := webhookDeliveryNow().UTC()
+	if err := db.RecordWebhookDeliveryAttempt(ctx, record, now); err != nil {
+		logger.Warnf("Failed to record webhook attempt for endpoint %s: %v", target.endpointID, err)
+	}
+
+	if success {
+		if err := db.MarkWebhookEndpointAttemptSucceeded(ctx, endpointType, target.endpointID); err != nil && !dbManager.IsNotFound(err) {
+			logger.Warnf("Failed to reset webhook endpoint %s failure streak: %v", target.endpointID, err)
+		}
+		return
+	}
+
+	options, mailer, displayName := currentWebhookHealthConfig()
+	result, err := db.MarkWebhookEndpointAttemptFailed(ctx, endpointType, target.endpointID, now, dbManager.WebhookAutoDisablePolicy{
+		FailureThreshold: options.AutoDisableFailureThreshold,
+		MinFailureWindow: options.AutoDisableMinFailureWindow,
+	})
+	if err != nil {
+		if !dbManager.IsNotFound(err) {
+			logger.Warnf("Failed to update webhook endpoint %s failure streak: %v", target.endpointID, err)
+		}
+		return
+	}
+	if !result.AutoDisabled {
+		return
+	}
+	logger.Warnf("Webhook endpoint %s auto-disabled after %d consecutive failed attempts", target.endpointID, result.ConsecutiveFailures)
+	notifyWebhookEndpointAutoDisabled(logger, mailer, displayName, target, result, record.Error)
+}
+
+func notifyWebhookEndpointAutoDisabled(
+	logger *harukiLogger.Logger,
+	mailer WebhookMailSender,
+	displayName string,
+	target webhookAttemptTarget,
+	result *dbManager.WebhookEndpointFailureResult,
+	lastError string,
+) {
+	email := strings.TrimSpace(result.OwnerEmail)
+	if mailer == nil || email == "" || !strings.Contains(email, "@") {
+		return
+	}
+	if displayName == "" {
+		displayName = defaultWebhookHealthMailDisplayName
+	}
+	// Endpoint owners have no stored language, so the mail goes out in the
+	// outbox's default locale.
+	if err := mailer.SendTemplate([]string{email}, harukiSMTP.TemplateWebhookAutoDisabled, "", buildWebhookAutoDisabledMailVars(target, result, lastError), displayName); err != nil {
+		logger.Warnf("Failed to send webhook auto-disable mail for endpoint %s to %s: %v", target.endpointID, email, err)
+	}
+}
+
+func buildWebhookAutoDisabledMailVars(target webhookAttemptTarget, result *dbManager.WebhookEndpointFailureResult, lastError string) map[string]string {
+	failingSince := ""
+	if result.FailingSince != nil {
+		failingSince = result.FailingSince.UTC().Format(time.RFC3339)
+	}
+	vars := map[string]string{
+		"WEBHOOK_ID":    target.endpointID,
+		"CLIENT_ID":     result.ClientID,
+		"CALLBACK_URL":  result.CallbackURL,
+		"FAILURES":      strconv.Itoa(result.ConsecutiveFailures),
+		"FAILING_SINCE": failingSince,
+		"LAST_ERROR":    lastError,
+	}
+	for key, value := range vars {
+		if value = strings.TrimSpace(value); value == "" {
+			value = "-"
+		}
+		vars[key] = value
+	}
+	return vars
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdeliveryattempt"
+	harukiSMTP "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type recordingWebhookMailer struct {
+	mu    sync.Mutex
+	sent  int
+	to    []string
+	body  string
+	title string
+}
+
+func (m *recordingWebhookMailer) SendTemplate(to []string, template, locale string, vars map[string]string, displayName string) error {
+	rendered, err := harukiSMTP.Render(template, locale, vars)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent++
+	m.to = append([]string(nil), to...)
+	m.title = rendered.Subject
+	m.body = rendered.Body
+	return nil
+}
+
+func TestWebhookDeliveryWorkerAutoDisablesFailingEndpoint(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook-health-worker-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	srv := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		w.WriteHeader(stdhttp.StatusBadGateway)
+	}))
+	defer srv.Close()
+	useWebhookDeliveryTestServer(t, srv)
+
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	originalNow := webhookDeliveryNow
+	webhookDeliveryNow = func() time.Time { return now }
+	defer func() {
+		webhookDeliveryNow = originalNow
+	}()
+
+	mailer := &recordingWebhookMailer{}
+	originalOptions, originalMailer, originalDisplayName := currentWebhookHealthConfig()
+	ConfigureWebhookHealth(WebhookHealthOptions{
+		AutoDisableFailureThreshold: 2,
+		AutoDisableMinFailureWindow: time.Minute,
+		AttemptRetention:            time.Hour,
+	}, mailer, "Haruki Test")
+	defer ConfigureWebhookHealth(originalOptions, originalMailer, originalDisplayName)
+
+	ctx := context.Background()
+	if err := client.OAuth2ClientWebhookEndpoint.Create().
+		SetID("w1").
+		SetClientID("client-a").
+		SetCallbackURL("http://hooks.example.com/{server}/{user_id}").
+		SetOwnerEmail("owner@example.com").
+		Exec(ctx); err != nil {
+		t.Fatalf("create oauth client webhook: %v", err)
+	}
+	if err := client.EnqueueWebhookDeliveries(ctx, []dbManager.WebhookDeliveryRecord{{
+		ID:           "delivery-failing",
+		EndpointType: webhookdelivery.EndpointTypeOauth2Client,
+		EndpointID:   "w1",
+		ClientID:     "client-a",
+		CallbackURL:  "http://hooks.example.com/jp/123",
+		GameUserID:   "123",
+		Server:       "jp",
+		DataType:     "suite",
+		MaxAttempts:  5,
+	}}, now); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+
+	options := DefaultWebhookDeliveryOptions()
+	options.BaseBackoff = time.Minute
+	options.MaxBackoff = time.Minute
+	worker := NewWebhookDeliveryWorker(client, testLogger(), options)
+
+	// Two failures meet the threshold but the second lands exactly one
+	// minute after the first, so the window is also satisfied.
+	worker.RunOnce(ctx)
+	endpoint, err := client.OAuth2ClientWebhookEndpoint.Get(ctx, "w1")
+	if err != nil {
+		t.Fatalf("get endpoint: %v", err)
+	}
+	if !endpoint.Enabled || endpoint.ConsecutiveFailures != 1 || endpoint.FailingSince == nil {
+		t.Fatalf("endpoint after first failure = enabled %v, failures %d, failingSince %v", endpoint.Enabled, endpoint.ConsecutiveFailures, endpoint.FailingSince)
+	}
+
+	now = now.Add(time.Minute)
+	worker.RunOnce(ctx)
+	endpoint, err = client.OAuth2ClientWebhookEndpoint.Get(ctx, "w1")
+	if err != nil {
+		t.Fatalf("get endpoint: %v", err)
+	}
+	if endpoint.Enabled || endpoint.AutoDisabledAt == nil || !endpoint.AutoDisabledAt.Equal(now) {
+		t.Fatalf("endpoint after second failure = enabled %v, autoDisabledAt %v", endpoint.Enabled, endpoint.AutoDisabledAt)
+	}
+
+	attempts, total, err := client.ListWebhookDeliveryAttempts(ctx, dbManager.WebhookDeliveryAttemptFilter{
+		EndpointType: webhookdeliveryattempt.EndpointTypeOauth2Client,
+		DeliveryID:   "delivery-failing",
+	}, 0, 10)
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveryAttempts returned error: %v", err)
+	}
+	if total != 2 || attempts[0].Attempt != 2 || attempts[0].Success || attempts[0].StatusCode == nil || *attempts[0].StatusCode != stdhttp.StatusBadGateway {
+		t.Fatalf("attempts = %d, latest %+v", total, attempts[0])
+	}
+	if attempts[0].ClientID == nil || *attempts[0].ClientID != "client-a" {
+		t.Fatalf("attempt client id = %v", attempts[0].ClientID)
+	}
+
+	mailer.mu.Lock()
+	defer mailer.mu.Unlock()
+	if mailer.sent != 1 || len(mailer.to) != 1 || mailer.to[0] != "owner@example.com" {
+		t.Fatalf("mailer sent %d to %v, want one mail to owner", mailer.sent, mailer.to)
+	}
+	if !strings.Contains(mailer.title, "w1") || !strings.Contains(mailer.body, "client-a") || !strings.Contains(mailer.body, "502") || strings.Contains(mailer.body, "{{") {
+		t.Fatalf("unexpected mail: %q / %q", mailer.title, mailer.body)
+	}
+}
+
+func TestRecordWebhookAttemptSkipsUntrackedCallbacks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook-health-untracked-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx := context.Background()
+	recordWebhookAttempt(ctx, client, testLogger(), webhookAttemptTarget{endpointType: webhookdelivery.EndpointTypeWebhook}, stdhttp.StatusOK, nil, time.Millisecond)
+	recordWebhookAttempt(ctx, nil, testLogger(), webhookAttemptTarget{endpointType: webhookdelivery.EndpointTypeWebhook, endpointID: "1"}, stdhttp.StatusOK, nil, time.Millisecond)
+
+	count, err := client.WebhookDeliveryAttempt.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("count attempts: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("attempts recorded = %d, want 0", count)
+	}
+}
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+)
+
+const (
+	WebhookSignatureHeader = "X-Haruki-Signature"
+	WebhookEventIDHeader   = "X-Haruki-Event-Id"
+
+	WebhookEventTypeGameDataUploaded = "game_data.uploaded"
+
+	webhookSigningSecretPrefix = "whsec_"
+	webhookSignatureScheme     = "v1"
+)
+
+const (
+	DefaultWebhookSigningSecretGracePeriod = 24 * time.Hour
+	MaxWebhookSigningSecretGracePeriod     = 7 * 24 * time.Hour
+)
+
+var (
+	ErrWebhookSignatureMissing  = errors.New("webhook signature header is missing or malformed")
+	ErrWebhookSignatureExpired  = errors.New("webhook signature timestamp is outside the tolerance")
+	ErrWebhookSignatureMismatch = errors.New("no webhook signature matches the payload")
+)
+
+// WebhookEvent is the JSON body sent to webhook receivers after an upload.
+type WebhookEvent struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	UserID       string `json:"userId"`
+	Server       string `json:"server"`
+	DataType     string `json:"dataType"`
+	UploadTime   int64  `json:"uploadTime"`
+	UploadMethod string `json:"uploadMethod,omitempty"`
+	// ChangedKeys are the top-level keys whose stored value this upload
+	// changed.
+	ChangedKeys []string `json:"changedKeys"`
+}
+
+func newWebhookEvent(
+	userID int64,
+	server utils.SupportedDataUploadServer,
+	dataType utils.UploadDataType,
+	uploadMethod utils.UploadMethod,
+	data map[string]any,
+	changedKeys []string,
+) (WebhookEvent, error) {
+	id, err := newWebhookDeliveryID()
+	if err != nil {
+		return WebhookEvent{}, err
+	}
+	if changedKeys == nil {
+		changedKeys = []string{}
+	}
+	uploadTime := int64FromAny(data["upload_time"])
+	if uploadTime == 0 {
+		uploadTime = webhookDeliveryNow().Unix()
+	}
+	return WebhookEvent{
+		ID:           id,
+		Type:         WebhookEventTypeGameDataUploaded,
+		UserID:       strconv.FormatInt(userID, 10),
+		Server:       string(server),
+		DataType:     string(dataType),
+		UploadTime:   uploadTime,
+		UploadMethod: string(uploadMethod),
+		ChangedKeys:  changedKeys,
+	}, nil
+}
+
+// GenerateWebhookSigningSecret returns a new random per-endpoint signing secret.
+func GenerateWebhookSigningSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return webhookSigningSecretPrefix + hex.EncodeToString(buf), nil
+}
+
+// ResolveWebhookSigningSecretGracePeriod validates a requested grace period in
+// seconds. Nil selects the default; zero revokes the old secret immediately.
+func ResolveWebhookSigningSecretGracePeriod(seconds *int) (time.Duration, error) {
+	if seconds == nil {
+		return DefaultWebhookSigningSecretGracePeriod, nil
+	}
+	if *seconds < 0 {
+		return 0, fmt.Errorf("gracePeriodSeconds must not be negative")
+	}
+	grace := time.Duration(*seconds) * time.Second
+	if grace > MaxWebhookSigningSecretGracePeriod {
+		return 0, fmt.Errorf("gracePeriodSeconds must not exceed %d", int(MaxWebhookSigningSecretGracePeriod/time.Second))
+	}
+	return grace, nil
+}
+
+func computeWebhookSignature(payload []byte, timestamp int64, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignWebhookPayload builds the signature header value
+// "t=<unix>,v1=<hex>[,v1=<hex>]". Each v1 entry is HMAC-SHA256 over
+// "<unix>.<payload>" with one of the active secrets, so receivers holding
+// either the new or the previous secret can verify during a rotation.
+func SignWebhookPayload(payload []byte, timestamp time.Time, secrets []string) string {
+	unix := timestamp.Unix()
+	parts := make([]string, 0, len(secrets)+1)
+	parts = append(parts, "t="+strconv.FormatInt(unix, 10))
+	for _, secret := range secrets {
+		parts = append(parts, webhookSignatureScheme+"="+computeWebhookSignature(payload, unix, secret))
+	}
+	return strings.Join(parts, ",")
+}
+
+// VerifyWebhookSignature checks a signature header produced by
+// SignWebhookPayload against secret. A non-positive tolerance disables the
+// timestamp check.
+func VerifyWebhookSignature(payload []byte, header, secret string, tolerance time.Duration, now time.Time) error {
+	var timestamp int64
+	var hasTimestamp bool
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return ErrWebhookSignatureMissing
+			}
+			timestamp, hasTimestamp = parsed, true
+		case webhookSignatureScheme:
+			signatures = append(signatures, value)
+		}
+	}
+	if !hasTimestamp || len(signatures) == 0 {
+		return ErrWebhookSignatureMissing
+	}
+	if tolerance > 0 {
+		skew := now.Sub(time.Unix(timestamp, 0))
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > tolerance {
+			return ErrWebhookSignatureExpired
+		}
+	}
+	expected := []byte(computeWebhookSignature(payload, timestamp, secret))
+	for _, signature := range signatures {
+		if hmac.Equal(expected, []byte(signature)) {
+			return nil
+		}
+	}
+	return ErrWebhookSignatureMismatch
+}
+
+// buildWebhookRequestHeaders assembles the headers of one callback attempt.
+// Deliveries queued before signed payloads existed have no payload and are
+// sent bare, as they were originally.
+func buildWebhookRequestHeaders(bearer, deliveryID, eventID string, attempt int, payload []byte, secrets []string, now time.Time) map[string]string {
+	headers := map[string]string{
+		"User-Agent": webhookUserAgent(),
+	}
+	if bearer != "" {
+		headers["Authorization"] = "Bearer " + bearer
+	}
+	if deliveryID != "" {
+		headers[webhookDeliveryIDHeader] = deliveryID
+		headers[webhookDeliveryAttemptHeader] = strconv.Itoa(attempt)
+	}
+	if len(payload) == 0 {
+		return headers
+	}
+	headers["Content-Type"] = "application/json"
+	if eventID != "" {
+		headers[WebhookEventIDHeader] = eventID
+	}
+	if len(secrets) > 0 {
+		headers[WebhookSignatureHeader] = SignWebhookPayload(payload, now, secrets)
+	}
+	return headers
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	dbManager "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/enttest"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/webhookdelivery"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSignAndVerifyWebhookPayload(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"id":"evt","type":"game_data.uploaded"}`)
+	signedAt := time.Unix(1700000000, 0)
+	header := SignWebhookPayload(payload, signedAt, []string{"whsec_new", "whsec_old"})
+	if !strings.HasPrefix(header, "t=1700000000,v1=") || strings.Count(header, "v1=") != 2 {
+		t.Fatalf("signature header = %q", header)
+	}
+
+	for _, secret := range []string{"whsec_new", "whsec_old"} {
+		if err := VerifyWebhookSignature(payload, header, secret, 5*time.Minute, signedAt.Add(time.Minute)); err != nil {
+			t.Fatalf("VerifyWebhookSignature(%s) returned error: %v", secret, err)
+		}
+	}
+	if err := VerifyWebhookSignature(payload, header, "whsec_other", 5*time.Minute, signedAt); !errors.Is(err, ErrWebhookSignatureMismatch) {
+		t.Fatalf("wrong secret error = %v, want mismatch", err)
+	}
+	if err := VerifyWebhookSignature([]byte(`{"id":"evt"}`), header, "whsec_new", 0, signedAt); !errors.Is(err, ErrWebhookSignatureMismatch) {
+		t.Fatalf("tampered payload error = %v, want mismatch", err)
+	}
+	if err := VerifyWebhookSignature(payload, header, "whsec_new", 5*time.Minute, signedAt.Add(10*time.Minute)); !errors.Is(err, ErrWebhookSignatureExpired) {
+		t.Fatalf("stale signature error = %v, want expired", err)
+	}
+	if err := VerifyWebhookSignature(payload, "v1=abc", "whsec_new", 0, signedAt); !errors.Is(err, ErrWebhookSignatureMissing) {
+		t.Fatalf("missing timestamp error = %v, want missing", err)
+	}
+}
+
+func TestResolveWebhookSigningSecretGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+	if got, err := ResolveWebhookSigningSecretGracePeriod(nil); err != nil || got != DefaultWebhookSigningSecretGracePeriod {
+		t.Fatalf("nil grace = %s, %v", got, err)
+	}
+	if got, err := ResolveWebhookSigningSecretGracePeriod(intPtr(0)); err != nil || got != 0 {
+		t.Fatalf("zero grace = %s, %v", got, err)
+	}
+	if got, err := ResolveWebhookSigningSecretGracePeriod(intPtr(3600)); err != nil || got != time.Hour {
+		t.Fatalf("hour grace = %s, %v", got, err)
+	}
+	if _, err := ResolveWebhookSigningSecretGracePeriod(intPtr(-1)); err == nil {
+		t.Fatalf("expected negative grace to fail")
+	}
+	if _, err := ResolveWebhookSigningSecretGracePeriod(intPtr(int(MaxWebhookSigningSecretGracePeriod/time.Second) + 1)); err == nil {
+		t.Fatalf("expected oversized grace to fail")
+	}
+}
+
+func TestNewWebhookEventCarriesChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	event, err := newWebhookEvent(123, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite, utils.UploadMethodManual, map[string]any{
+		"_id":          int64(123),
+		"upload_time":  int64(1700000000),
+		"server":       "jp",
+		"userGamedata": map[string]any{},
+		"userCards":    []any{},
+	}, []string{"userCards"})
+	if err != nil {
+		t.Fatalf("newWebhookEvent returned error: %v", err)
+	}
+	if event.ID == "" || event.Type != WebhookEventTypeGameDataUploaded {
+		t.Fatalf("unexpected event identity: %+v", event)
+	}
+	if event.UserID != "123" || event.Server != "jp" || event.DataType != "suite" || event.UploadTime != 1700000000 || event.UploadMethod != string(utils.UploadMethodManual) {
+		t.Fatalf("unexpected event fields: %+v", event)
+	}
+	if !reflect.DeepEqual(event.ChangedKeys, []string{"userCards"}) {
+		t.Fatalf("changed keys = %#v", event.ChangedKeys)
+	}
+
+	unchanged, err := newWebhookEvent(123, utils.SupportedDataUploadServerJP, utils.UploadDataTypeSuite, utils.UploadMethodManual, map[string]any{}, nil)
+	if err != nil {
+		t.Fatalf("newWebhookEvent returned error: %v", err)
+	}
+	if unchanged.ChangedKeys == nil || len(unchanged.ChangedKeys) != 0 {
+		t.Fatalf("changed keys for an unchanged upload = %#v, want an empty list", unchanged.ChangedKeys)
+	}
+}
+
+func TestWebhookDeliveryWorkerSignsPayloadWithActiveSecrets(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:webhook-signature-worker-test?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		_ = client.Close()
+	}()
+
+	var mu sync.Mutex
+	var gotHeader stdhttp.Header
+	var gotBody []byte
+	srv := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		gotHeader = r.Header.Clone()
+		gotBody = body
+		w.WriteHeader(stdhttp.StatusNoContent)
+	}))
+	defer srv.Close()
+	useWebhookDeliveryTestServer(t, srv)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	originalNow := webhookDeliveryNow
+	webhookDeliveryNow = func() time.Time { return now }
+	defer func() {
+		webhookDeliveryNow = originalNow
+	}()
+
+	ctx := context.Background()
+	if err := client.UpsertWebhookEndpoint(ctx, dbManager.WebhookEndpointRecord{
+		ID:          "1",
+		Credential:  "cred-a",
+		CallbackURL: "http://hooks.example.com/{server}/{user_id}",
+		Enabled:     true,
+	}); err != nil {
+		t.Fatalf("UpsertWebhookEndpoint returned error: %v", err)
+	}
+	if _, err := client.RotateWebhookEndpointSigningSecret(ctx, "1", "whsec_old", nil); err != nil {
+		t.Fatalf("initial rotation returned error: %v", err)
+	}
+	graceUntil := now.Add(time.Hour)
+	if _, err := client.RotateWebhookEndpointSigningSecret(ctx, "1", "whsec_new", &graceUntil); err != nil {
+		t.Fatalf("grace rotation returned error: %v", err)
+	}
+
+	event := WebhookEvent{ID: "evt-1", Type: WebhookEventTypeGameDataUploaded, UserID: "123", Server: "jp", DataType: "suite", ChangedKeys: []string{"userCards"}}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+	if err := client.EnqueueWebhookDeliveries(ctx, []dbManager.WebhookDeliveryRecord{{
+		ID:           "delivery-signed",
+		EndpointType: webhookdelivery.EndpointTypeWebhook,
+		EndpointID:   "1",
+		CallbackURL:  "http://hooks.example.com/jp/123",
+		GameUserID:   "123",
+		Server:       "jp",
+		DataType:     "suite",
+		EventID:      event.ID,
+		Payload:      payload,
+		MaxAttempts:  3,
+	}}, now); err != nil {
+		t.Fatalf("EnqueueWebhookDeliveries returned error: %v", err)
+	}
+
+	worker := NewWebhookDeliveryWorker(client, testLogger(), DefaultWebhookDeliveryOptions())
+	if attempted := worker.RunOnce(ctx); attempted != 1 {
+		t.Fatalf("RunOnce attempted %d, want 1", attempted)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if string(gotBody) != string(payload) {
+		t.Fatalf("body = %s, want %s", gotBody, payload)
+	}
+	if got := gotHeader.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("Content-Type = %q", got)
+	}
+	if got := gotHeader.Get(WebhookEventIDHeader); got != "evt-1" {
+		t.Fatalf("%s = %q", WebhookEventIDHeader, got)
+	}
+	signature := gotHeader.Get(WebhookSignatureHeader)
+	for _, secret := range []string{"whsec_new", "whsec_old"} {
+		if err := VerifyWebhookSignature(gotBody, signature, secret, 5*time.Minute, now); err != nil {
+			t.Fatalf("verify with %s: %v (header %q)", secret, err, signature)
+		}
+	}
+}
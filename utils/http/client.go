@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 	"io"
 	stdhttp "net/http"
 	"time"
@@ -17,7 +18,10 @@ const defaultRequestTimeout = 15 * time.Second
 type Client struct {
 	Proxy   string
 	Timeout time.Duration
-	client  *resty.Client
+	// PropagateTrace sends traceparent headers. It stays off for clients
+	// that talk to game servers.
+	PropagateTrace bool
+	client         *resty.Client
 }
 
 func NewClient(proxy string, timeout time.Duration) *Client {
@@ -28,6 +32,16 @@ func NewClient(proxy string, timeout time.Duration) *Client {
 	return client
 }
 
+// NewTracedClient is NewClient for our own services, which receive the
+// caller's trace context.
+func NewTracedClient(proxy string, timeout time.Duration) *Client {
+	client := &Client{Proxy: proxy, Timeout: timeout, PropagateTrace: true}
+	if err := client.init(); err != nil {
+		harukiLogger.Errorf("Failed to initialize HTTP client: %v", err)
+	}
+	return client
+}
+
 func (c *Client) init() error {
 	if c.client != nil {
 		return nil
@@ -41,6 +55,11 @@ func (c *Client) init() error {
 	if c.Proxy != "" {
 		c.client.SetProxy(c.Proxy)
 	}
+	if c.PropagateTrace {
+		c.client.SetTransport(harukiTracing.Transport(c.client.GetClient().Transport))
+	} else {
+		c.client.SetTransport(harukiTracing.SpanOnlyTransport(c.client.GetClient().Transport))
+	}
 	return nil
 }
 
@@ -0,0 +1,174 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Well-known field keys. The logger's own name is always written as the
+// module field.
+const (
+	FieldRequestID  = "request_id"
+	FieldUserID     = "user_id"
+	FieldServer     = "server"
+	FieldGameUserID = "game_user_id"
+	FieldModule     = "module"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Field is one structured key/value pair attached to log lines.
+type Field struct {
+	Key   string
+	Value any
+}
+
+func KV(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+type contextFieldsKey struct{}
+
+// WithContextFields returns a copy of ctx carrying fields in addition to the
+// ones already attached; a key set again replaces its earlier value.
+func WithContextFields(ctx context.Context, fields ...Field) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(fields) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, contextFieldsKey{}, mergeFields(ContextFields(ctx), fields))
+}
+
+// ContextFields returns the fields attached to ctx.
+func ContextFields(ctx context.Context) []Field {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(contextFieldsKey{}).([]Field)
+	return fields
+}
+
+func mergeFields(base, extra []Field) []Field {
+	merged := make([]Field, 0, len(base)+len(extra))
+	merged = append(merged, base...)
+	for _, field := range extra {
+		if field.Key == "" {
+			continue
+		}
+		replaced := false
+		for i := range merged {
+			if merged[i].Key == field.Key {
+				merged[i].Value = field.Value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, field)
+		}
+	}
+	return merged
+}
+
+// WithFields returns a logger that writes fields on every line. It shares
+// the name, level and writer of l.
+func (l *Logger) WithFields(fields ...Field) *Logger {
+	return &Logger{
+		name:            l.name,
+		level:           l.level,
+		writer:          l.writer,
+		colored:         l.colored,
+		useGlobalConfig: l.useGlobalConfig,
+		fields:          mergeFields(l.fields, fields),
+	}
+}
+
+// WithContext returns a logger that writes the fields attached to ctx.
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	fields := ContextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return l.WithFields(fields...)
+}
+
+var globalLogFormat = FormatText
+var globalLogFormatMu sync.RWMutex
+
+// SetGlobalLogFormat switches every logger between colored text lines and
+// one JSON object per line. Unknown formats are ignored.
+func SetGlobalLogFormat(format string) {
+	normalized := strings.ToLower(strings.TrimSpace(format))
+	if normalized != FormatText && normalized != FormatJSON {
+		return
+	}
+	globalLogFormatMu.Lock()
+	defer globalLogFormatMu.Unlock()
+	globalLogFormat = normalized
+}
+
+func GetGlobalLogFormat() string {
+	globalLogFormatMu.RLock()
+	defer globalLogFormatMu.RUnlock()
+	return globalLogFormat
+}
+
+// moduleLevels overrides the level of loggers by name, whatever level they
+// were created with.
+var moduleLevels = map[string]logLevel{}
+var moduleLevelsMu sync.RWMutex
+
+// ParseLevel returns the canonical name of level, accepting WARN for WARNING.
+func ParseLevel(level string) (string, bool) {
+	lvl, ok := levelMap[strings.ToUpper(strings.TrimSpace(level))]
+	if !ok {
+		return "", false
+	}
+	return levelNames[lvl], true
+}
+
+// SetModuleLevels replaces all per-module level overrides. Nothing changes
+// when a module name is empty or a level is unknown.
+func SetModuleLevels(levels map[string]string) error {
+	parsed := make(map[string]logLevel, len(levels))
+	for module, level := range levels {
+		name := strings.TrimSpace(module)
+		if name == "" {
+			return fmt.Errorf("module name cannot be empty")
+		}
+		lvl, ok := levelMap[strings.ToUpper(strings.TrimSpace(level))]
+		if !ok {
+			return fmt.Errorf("invalid log level %q for module %s", level, name)
+		}
+		parsed[name] = lvl
+	}
+	moduleLevelsMu.Lock()
+	defer moduleLevelsMu.Unlock()
+	moduleLevels = parsed
+	return nil
+}
+
+// GetModuleLevels returns a copy of the per-module overrides.
+func GetModuleLevels() map[string]string {
+	moduleLevelsMu.RLock()
+	defer moduleLevelsMu.RUnlock()
+	levels := make(map[string]string, len(moduleLevels))
+	for module, lvl := range moduleLevels {
+		levels[module] = levelNames[lvl]
+	}
+	return levels
+}
+
+func moduleLevel(name string) (logLevel, bool) {
+	moduleLevelsMu.RLock()
+	defer moduleLevelsMu.RUnlock()
+	lvl, ok := moduleLevels[name]
+	return lvl, ok
+}
@@ -0,0 +1,100 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/redact"
+)
+
+const timeLayout = "2006-01-02 15:04:05.000"
+
+// reservedJSONKeys are written by the logger itself; fields using them are
+// dropped rather than producing duplicate keys.
+var reservedJSONKeys = map[string]struct{}{
+	"time":      {},
+	"level":     {},
+	FieldModule: {},
+	"msg":       {},
+}
+
+func fieldValue(field Field) any {
+	if redact.IsSensitiveKey(field.Key) {
+		return redact.Placeholder
+	}
+	switch value := field.Value.(type) {
+	case error:
+		return value.Error()
+	case fmt.Stringer:
+		return value.String()
+	case *string:
+		if value == nil {
+			return nil
+		}
+		return *value
+	case *int64:
+		if value == nil {
+			return nil
+		}
+		return *value
+	default:
+		return value
+	}
+}
+
+func formatTextLine(now time.Time, level logLevel, name, msg string, fields []Field, colored bool) string {
+	var b strings.Builder
+	timestamp := now.Format(timeLayout)
+	if colored {
+		fmt.Fprintf(&b, "%s[%s]%s[%s%s%s][%s%s%s] %s",
+			colorGreen, timestamp, colorReset,
+			levelColors[level], levelNames[level], colorReset,
+			colorMagenta, name, colorReset,
+			msg)
+	} else {
+		fmt.Fprintf(&b, "[%s][%s][%s] %s", timestamp, levelNames[level], name, msg)
+	}
+	for _, field := range fields {
+		value := fmt.Sprint(fieldValue(field))
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		fmt.Fprintf(&b, " %s=%s", field.Key, value)
+	}
+	b.WriteByte('\n')
+	return b.String()
+}
+
+func formatJSONLine(now time.Time, level logLevel, name, msg string, fields []Field) string {
+	var b strings.Builder
+	b.WriteString(`{"time":`)
+	writeJSONValue(&b, now.Format(time.RFC3339Nano))
+	b.WriteString(`,"level":`)
+	writeJSONValue(&b, levelNames[level])
+	b.WriteString(`,"module":`)
+	writeJSONValue(&b, name)
+	b.WriteString(`,"msg":`)
+	writeJSONValue(&b, msg)
+	for _, field := range fields {
+		if _, reserved := reservedJSONKeys[field.Key]; reserved {
+			continue
+		}
+		b.WriteByte(',')
+		writeJSONValue(&b, field.Key)
+		b.WriteByte(':')
+		writeJSONValue(&b, fieldValue(field))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func writeJSONValue(b *strings.Builder, value any) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		encoded, _ = json.Marshal(fmt.Sprint(value))
+	}
+	b.Write(encoded)
+}
@@ -56,6 +56,7 @@ type Logger struct {
 	writer          io.Writer
 	colored         bool
 	useGlobalConfig bool
+	fields          []Field
 }
 
 var DefaultLogger *Logger
@@ -206,28 +207,22 @@ func (l *Logger) logf(level logLevel, format string, args ...any) {
 		writer = os.Stdout
 	}
 
+	if override, ok := moduleLevel(l.name); ok {
+		effectiveLevel = override
+	}
 	if level < effectiveLevel {
 		return
 	}
 	msg := fmt.Sprintf(format, args...)
-	now := time.Now().Format("2006-01-02 15:04:05.000")
-
-	var ts string
-	var lvlStr string
-	var name string
-	if colored {
-		ts = fmt.Sprintf("%s[%s]%s", colorGreen, now, colorReset)
-		lvlColor := levelColors[level]
-		lvlStr = fmt.Sprintf("%s%s%s", lvlColor, levelNames[level], colorReset)
-		name = fmt.Sprintf("[%s%s%s]", colorMagenta, l.name, colorReset)
+	now := time.Now()
+
+	var line string
+	if GetGlobalLogFormat() == FormatJSON {
+		line = formatJSONLine(now, level, l.name, msg, l.fields)
 	} else {
-		ts = fmt.Sprintf("[%s]", now)
-		lvlStr = levelNames[level]
-		name = fmt.Sprintf("[%s]", l.name)
+		line = formatTextLine(now, level, l.name, msg, l.fields, colored)
 	}
 
-	line := fmt.Sprintf("%s[%s]%s %s\n", ts, lvlStr, name, msg)
-
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	_, _ = fmt.Fprint(writer, line)
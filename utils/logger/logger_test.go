@@ -2,6 +2,8 @@ package logger
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -53,3 +55,76 @@ func TestNewLoggerFromGlobalDoesNotDuplicateWrites(t *testing.T) {
 		t.Fatalf("expected one log line, got %d in %q", count, buf.String())
 	}
 }
+
+func TestJSONFormatWritesContextFieldsAndRedacts(t *testing.T) {
+	previousFormat := GetGlobalLogFormat()
+	t.Cleanup(func() { SetGlobalLogFormat(previousFormat) })
+	SetGlobalLogFormat("json")
+
+	ctx := WithContextFields(context.Background(), KV(FieldRequestID, "req-1"), KV(FieldServer, "jp"))
+	ctx = WithContextFields(ctx, KV(FieldServer, "en"), KV(FieldGameUserID, int64(42)), KV("access_token", "secret-value"))
+
+	var buf bytes.Buffer
+	NewLogger("json-test", "INFO", &buf).WithContext(ctx).Infof("upload %s", "done")
+
+	var line map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected one JSON object, got %q: %v", buf.String(), err)
+	}
+	want := map[string]any{
+		"level":         "INFO",
+		FieldModule:     "json-test",
+		"msg":           "upload done",
+		FieldRequestID:  "req-1",
+		FieldServer:     "en",
+		FieldGameUserID: float64(42),
+		"access_token":  "[redacted]",
+	}
+	for key, value := range want {
+		if line[key] != value {
+			t.Fatalf("field %s = %#v, want %#v (line %s)", key, line[key], value, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "secret-value") {
+		t.Fatalf("sensitive value leaked into log line: %s", buf.String())
+	}
+}
+
+func TestTextFormatAppendsFields(t *testing.T) {
+	previousFormat := GetGlobalLogFormat()
+	t.Cleanup(func() { SetGlobalLogFormat(previousFormat) })
+	SetGlobalLogFormat("text")
+
+	var buf bytes.Buffer
+	NewLogger("text-test", "INFO", &buf).WithFields(KV(FieldUserID, "u1"), KV("note", "two words")).Warnf("hello")
+
+	got := buf.String()
+	if !strings.Contains(got, "[WARNING][text-test] hello user_id=u1 note=\"two words\"\n") {
+		t.Fatalf("unexpected text line %q", got)
+	}
+}
+
+func TestModuleLevelsOverrideLoggerLevel(t *testing.T) {
+	previous := GetModuleLevels()
+	t.Cleanup(func() { _ = SetModuleLevels(previous) })
+
+	if err := SetModuleLevels(map[string]string{"noisy": "warn", "quiet": "DEBUG"}); err != nil {
+		t.Fatalf("SetModuleLevels returned error: %v", err)
+	}
+	var buf bytes.Buffer
+	NewLogger("noisy", "DEBUG", &buf).Infof("suppressed")
+	NewLogger("quiet", "ERROR", &buf).Debugf("shown")
+	if strings.Contains(buf.String(), "suppressed") || !strings.Contains(buf.String(), "shown") {
+		t.Fatalf("module overrides not applied: %q", buf.String())
+	}
+	if got := GetModuleLevels()["noisy"]; got != "WARNING" {
+		t.Fatalf("GetModuleLevels()[noisy] = %q, want WARNING", got)
+	}
+
+	if err := SetModuleLevels(map[string]string{"noisy": "LOUD"}); err == nil {
+		t.Fatalf("expected invalid level to fail")
+	}
+	if got := GetModuleLevels()["quiet"]; got != "DEBUG" {
+		t.Fatalf("failed update should keep previous overrides, got %q", got)
+	}
+}
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+)
+
+const namespace = "haruki_toolbox"
+
+// Cache names used as the cache label of the game data cache counters.
+const (
+	CachePublic  = "public"
+	CacheOAuth2  = "oauth2"
+	CachePrivate = "private"
+)
+
+// Data syncer outcomes, recorded once per third-party target.
+const (
+	SyncSuccess = "success"
+	SyncFailure = "failure"
+	SyncError   = "error"
+	SyncSkipped = "skipped"
+)
+
+// Webhook delivery outcomes, recorded once per attempt.
+const (
+	WebhookSuccess    = "success"
+	WebhookRetry      = "retry"
+	WebhookDeadLetter = "dead_letter"
+)
+
+// Registry holds every collector of the backend. It is separate from the
+// default registry so tests and libraries cannot leak metrics into it.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method and route pattern.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"method", "route"})
+	uploads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploads_total",
+		Help:      "Game data uploads by server, data type, upload method and result.",
+	}, []string{"server", "data_type", "method", "success"})
+	cacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "game_data_cache_lookups_total",
+		Help:      "Redis game data cache lookups by cache and result.",
+	}, []string{"cache", "result"})
+	dataSyncs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "data_syncer_requests_total",
+		Help:      "Data syncer pushes to third-party providers by target, data type and outcome.",
+	}, []string{"target", "data_type", "outcome"})
+	webhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "Webhook delivery attempts by endpoint type and outcome.",
+	}, []string{"endpoint_type", "outcome"})
+	retrieverSteps = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "sekai_retriever_step_duration_seconds",
+		Help:      "Sekai data retriever step latency by server, step and result.",
+		Buckets:   []float64{.1, .25, .5, 1, 2.5, 5, 10, 20, 30, 60, 120},
+	}, []string{"server", "step", "success"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		uploads,
+		cacheLookups,
+		dataSyncs,
+		webhookDeliveries,
+		retrieverSteps,
+	)
+}
+
+// Handler serves Registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+func ObserveHTTPRequest(method, route string, status int, elapsed time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+func RecordUpload(server, dataType, method string, success bool) {
+	uploads.WithLabelValues(server, dataType, method, strconv.FormatBool(success)).Inc()
+}
+
+// RecordCacheLookup counts one cache read as a hit, miss or error.
+func RecordCacheLookup(cache string, found bool, err error) {
+	result := "miss"
+	switch {
+	case err != nil:
+		result = "error"
+	case found:
+		result = "hit"
+	}
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+func RecordDataSync(target, dataType, outcome string) {
+	dataSyncs.WithLabelValues(target, dataType, outcome).Inc()
+}
+
+func RecordWebhookDelivery(endpointType, outcome string) {
+	webhookDeliveries.WithLabelValues(endpointType, outcome).Inc()
+}
+
+func ObserveRetrieverStep(server, step string, success bool, elapsed time.Duration) {
+	retrieverSteps.WithLabelValues(server, step, strconv.FormatBool(success)).Observe(elapsed.Seconds())
+}
+
+// RegisterDBStats exports the connection pool stats of db labelled with name.
+func RegisterDBStats(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterRedisPoolStats exports the connection pool stats of client.
+func RegisterRedisPoolStats(client *redis.Client) error {
+	return Registry.Register(&redisPoolCollector{client: client})
+}
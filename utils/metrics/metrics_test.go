@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRecordCacheLookupResults(t *testing.T) {
+	RecordCacheLookup(CachePublic, true, nil)
+	RecordCacheLookup(CachePublic, false, nil)
+	RecordCacheLookup(CachePublic, true, errors.New("redis down"))
+
+	for result, want := range map[string]float64{"hit": 1, "miss": 1, "error": 1} {
+		if got := testutil.ToFloat64(cacheLookups.WithLabelValues(CachePublic, result)); got != want {
+			t.Fatalf("%s lookups = %v, want %v", result, got, want)
+		}
+	}
+}
+
+func TestRegistryGathersBackendMetrics(t *testing.T) {
+	RecordUpload("jp", "suite", "manual", false)
+	RecordWebhookDelivery("webhook", WebhookDeadLetter)
+
+	families, err := Registry.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	names := make(map[string]bool, len(families))
+	for _, family := range families {
+		names[family.GetName()] = true
+	}
+	for _, want := range []string{"haruki_toolbox_uploads_total", "haruki_toolbox_webhook_deliveries_total", "go_goroutines"} {
+		if !names[want] {
+			t.Fatalf("registry is missing %s", want)
+		}
+	}
+}
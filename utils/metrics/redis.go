@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	redisPoolHits = prometheus.NewDesc(namespace+"_redis_pool_hits_total",
+		"Times a free connection was found in the Redis pool.", nil, nil)
+	redisPoolMisses = prometheus.NewDesc(namespace+"_redis_pool_misses_total",
+		"Times a free connection was not found in the Redis pool.", nil, nil)
+	redisPoolTimeouts = prometheus.NewDesc(namespace+"_redis_pool_timeouts_total",
+		"Times a wait for a Redis pool connection timed out.", nil, nil)
+	redisPoolTotalConns = prometheus.NewDesc(namespace+"_redis_pool_connections",
+		"Connections currently held by the Redis pool.", nil, nil)
+	redisPoolIdleConns = prometheus.NewDesc(namespace+"_redis_pool_idle_connections",
+		"Idle connections in the Redis pool.", nil, nil)
+	redisPoolStaleConns = prometheus.NewDesc(namespace+"_redis_pool_stale_connections_total",
+		"Stale connections removed from the Redis pool.", nil, nil)
+)
+
+// redisPoolCollector reads the pool stats on every scrape.
+type redisPoolCollector struct {
+	client *redis.Client
+}
+
+func (c *redisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- redisPoolHits
+	ch <- redisPoolMisses
+	ch <- redisPoolTimeouts
+	ch <- redisPoolTotalConns
+	ch <- redisPoolIdleConns
+	ch <- redisPoolStaleConns
+}
+
+func (c *redisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.client.PoolStats()
+	ch <- prometheus.MustNewConstMetric(redisPoolHits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(redisPoolMisses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(redisPoolTimeouts, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(redisPoolTotalConns, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(redisPoolIdleConns, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(redisPoolStaleConns, prometheus.CounterValue, float64(stats.StaleConns))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql"
 	userSchema "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/database/postgresql/user"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 	"io"
 	"net/http"
 	"net/url"
@@ -117,6 +118,7 @@ func applyOAuth2BearerAuthLocals(c fiber.Ctx, result *oauth2BearerAuthResult) {
 		return
 	}
 	c.Locals("userID", strings.TrimSpace(result.UserID))
+	c.SetContext(harukiLogger.WithContextFields(c.Context(), harukiLogger.KV(harukiLogger.FieldUserID, strings.TrimSpace(result.UserID))))
 	if identityID := strings.TrimSpace(result.IdentityID); identityID != "" {
 		c.Locals("identityID", identityID)
 	}
@@ -316,7 +318,7 @@ func hydraIntrospectionHTTPClient() *http.Client {
 	}
 	hydraIntrospectionHTTPClientMu.RUnlock()
 
-	client := &http.Client{Timeout: timeout}
+	client := &http.Client{Timeout: timeout, Transport: harukiTracing.Transport(nil)}
 
 	hydraIntrospectionHTTPClientMu.Lock()
 	defer hydraIntrospectionHTTPClientMu.Unlock()
@@ -0,0 +1,35 @@
+// Package redact holds the rules for hiding credentials in user-visible
+// metadata and structured log fields.
+package redact
+
+import "strings"
+
+// Placeholder replaces the value of a sensitive key.
+const Placeholder = "[redacted]"
+
+var sensitiveKeywords = []string{
+	"token",
+	"secret",
+	"password",
+	"authorization",
+	"cookie",
+	"session",
+	"otp",
+	"one_time",
+	"one-time",
+}
+
+// IsSensitiveKey reports whether a value stored under key may hold a
+// credential and must not be shown or logged as is.
+func IsSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(key))
+	if normalized == "" {
+		return false
+	}
+	for _, kw := range sensitiveKeywords {
+		if strings.Contains(normalized, kw) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package redact
+
+import "testing"
+
+func TestIsSensitiveKey(t *testing.T) {
+	for _, key := range []string{"accessToken", "client_secret", "Password", " Authorization ", "sessionId", "one-time-code", "totp"} {
+		if !IsSensitiveKey(key) {
+			t.Fatalf("expected %q to be sensitive", key)
+		}
+	}
+	for _, key := range []string{"", "  ", "request_id", "user_id", "server", "game_user_id"} {
+		if IsSensitiveKey(key) {
+			t.Fatalf("expected %q not to be sensitive", key)
+		}
+	}
+}
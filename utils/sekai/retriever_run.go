@@ -2,41 +2,62 @@ package sekai
 
 import (
 	"context"
+	"time"
+
 	harukiUtils "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
+	harukiMetrics "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/metrics"
 )
 
 func (r *HarukiSekaiDataRetriever) Run(ctx context.Context) (*harukiUtils.SekaiInheritDataRetrieverResponse, error) {
+	if err := r.ensureReady("run", "init"); err != nil {
+		return nil, err
+	}
+	r.reportProgress(RetrieverStepInit)
+	started := time.Now()
 	if err := r.client.Init(ctx); err != nil {
+		r.observeStep(RetrieverStepInit, started, false)
 		r.isErrorExist = true
 		r.ErrorMessage = err.Error()
 		r.logger.Errorf("Client initialization failed: %v", err)
 		return nil, NewDataRetrievalError("run", "init", "client initialization failed", err)
 	}
 	if r.client.isErrorExist {
+		r.observeStep(RetrieverStepInit, started, false)
 		r.isErrorExist = true
 		r.ErrorMessage = r.client.errorMessage
 		r.logger.Errorf("Client error: %s", r.client.errorMessage)
 		return nil, NewDataRetrievalError("run", "client_error", r.client.errorMessage, nil)
 	}
+	r.observeStep(RetrieverStepInit, started, true)
 
 	result := &harukiUtils.SekaiInheritDataRetrieverResponse{
 		Server: string(r.client.server),
 		UserID: r.client.userID,
 	}
 
+	r.reportProgress(RetrieverStepSuite)
+	started = time.Now()
 	suite, suiteErr := r.RetrieveSuite(ctx)
+	r.observeStep(RetrieverStepSuite, started, suiteErr == nil)
 	if suiteErr != nil {
 		r.logger.Errorf("Suite retrieval failed: %v", suiteErr)
 		return result, suiteErr
 	}
 	result.Suite = suite
 
-	if err := r.RefreshHome(ctx, false, false); err != nil {
-		r.logger.Warnf("Final home refresh failed (non-critical): %v", err)
+	r.reportProgress(RetrieverStepHomeRefresh)
+	started = time.Now()
+	homeErr := r.RefreshHome(ctx, false, false)
+	r.observeStep(RetrieverStepHomeRefresh, started, homeErr == nil)
+	if homeErr != nil {
+		r.logger.Warnf("Final home refresh failed (non-critical): %v", homeErr)
 	}
 
 	if shouldRetrieveMysekai(r.uploadType) {
+		r.reportProgress(RetrieverStepMysekai)
+		started = time.Now()
 		mysekai, mysekaiErr := r.RetrieveMysekai(ctx)
+		r.observeStep(RetrieverStepMysekai, started, mysekaiErr == nil)
 		if mysekaiErr != nil {
 			r.logger.Errorf("MySekai retrieval failed: %v", mysekaiErr)
 			return result, mysekaiErr
@@ -46,3 +67,7 @@ func (r *HarukiSekaiDataRetriever) Run(ctx context.Context) (*harukiUtils.SekaiI
 
 	return result, nil
 }
+
+func (r *HarukiSekaiDataRetriever) observeStep(step RetrieverStep, started time.Time, success bool) {
+	harukiMetrics.ObserveRetrieverStep(string(r.client.server), string(step), success, time.Since(started))
+}
@@ -186,6 +186,13 @@ func TestRetrieverRunIgnoresFinalHomeRefreshFailure(t *testing.T) {
 	defer server.Close()
 
 	retriever := newTestRetriever(server.URL, userID)
+	var steps []RetrieverStep
+	retriever.SetProgressReporter(func(step RetrieverStep, reportedUserID int64) {
+		steps = append(steps, step)
+		if step != RetrieverStepInit && reportedUserID != userID {
+			t.Errorf("progress %s reported user %d, want %d", step, reportedUserID, userID)
+		}
+	})
 	result, err := retriever.Run(context.Background())
 	if err != nil {
 		t.Fatalf("Run should ignore final home refresh failure, got %v", err)
@@ -193,6 +200,10 @@ func TestRetrieverRunIgnoresFinalHomeRefreshFailure(t *testing.T) {
 	if result == nil || result.UserID != userID || len(result.Suite) == 0 {
 		t.Fatalf("Run result = %#v, want userID and suite payload", result)
 	}
+	wantSteps := []RetrieverStep{RetrieverStepInit, RetrieverStepSuite, RetrieverStepHomeRefresh}
+	if !reflect.DeepEqual(steps, wantSteps) {
+		t.Fatalf("progress steps = %v, want %v", steps, wantSteps)
+	}
 }
 
 func withTestSekaiCrypto(t *testing.T) {
@@ -11,10 +11,25 @@ type DataRetriever struct {
 	client       *HarukiSekaiClient
 	uploadType   harukiUtils.UploadDataType
 	logger       *harukiLogger.Logger
+	progress     RetrieverProgressFunc
 	isErrorExist bool
 	ErrorMessage string
 }
 
+// RetrieverStep names the stage Run is about to enter.
+type RetrieverStep string
+
+const (
+	RetrieverStepInit        RetrieverStep = "init"
+	RetrieverStepSuite       RetrieverStep = "suite"
+	RetrieverStepHomeRefresh RetrieverStep = "home_refresh"
+	RetrieverStepMysekai     RetrieverStep = "mysekai"
+)
+
+// RetrieverProgressFunc is called as Run enters each step. userID is zero
+// until the client has logged in.
+type RetrieverProgressFunc func(step RetrieverStep, userID int64)
+
 type HarukiSekaiDataRetriever = DataRetriever
 
 func NewSekaiDataRetriever(
@@ -64,6 +79,22 @@ func newRetrieverClient(
 	}), nil
 }
 
+// SetProgressReporter registers fn to be called as Run advances.
+func (r *HarukiSekaiDataRetriever) SetProgressReporter(fn RetrieverProgressFunc) {
+	r.progress = fn
+}
+
+func (r *HarukiSekaiDataRetriever) reportProgress(step RetrieverStep) {
+	if r.progress == nil {
+		return
+	}
+	var userID int64
+	if r.client != nil {
+		userID = r.client.userID
+	}
+	r.progress(step, userID)
+}
+
 func (r *HarukiSekaiDataRetriever) ensureReady(dataType, step string) error {
 	if r.isErrorExist {
 		return NewDataRetrievalError(dataType, step, r.ErrorMessage, nil)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils"
 	harukiLogger "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/logger"
+	harukiTracing "github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/tracing"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -28,7 +29,7 @@ type HarukiSekaiAPIResult struct {
 
 func NewHarukiSekaiAPIClient(apiEndpoint, apiToken string) *HarukiSekaiAPIClient {
 	return &HarukiSekaiAPIClient{
-		httpClient:             resty.New().SetTimeout(sekaiAPIRequestTimeout),
+		httpClient:             resty.New().SetTimeout(sekaiAPIRequestTimeout).SetTransport(harukiTracing.Transport(nil)),
 		harukiSekaiAPIEndpoint: apiEndpoint,
 		harukiSekaiAPIToken:    apiToken,
 	}
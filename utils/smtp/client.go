@@ -2,15 +2,45 @@ package smtp
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
 	"net"
 	"net/smtp"
+	"net/textproto"
 	"strings"
+	"sync"
 	"time"
 )
 
-const defaultSMTPTimeout = 10 * time.Second
+const (
+	defaultSMTPTimeout       = 10 * time.Second
+	defaultSMTPRelayCooldown = time.Minute
+	primarySMTPRelayName     = "primary"
+	smtpRelayErrorMaxLen     = 300
+)
+
+const (
+	TLSModeAuto     = "auto"
+	TLSModeStartTLS = "starttls"
+	TLSModeNone     = "none"
+)
+
+// ErrNoRelays is returned when no SMTP relay is configured.
+var ErrNoRelays = errors.New("no smtp relay configured")
+
+// RecipientRejectedError is a permanent rejection of a recipient (a 5xx
+// reply to RCPT TO). Another relay or a later attempt would get the same
+// answer, so the address should be suppressed instead.
+type RecipientRejectedError struct {
+	Recipient string
+	Code      int
+	Message   string
+}
+
+func (e *RecipientRejectedError) Error() string {
+	return fmt.Sprintf("recipient %s rejected: %d %s", e.Recipient, e.Code, e.Message)
+}
 
 func normalizeSMTPTimeout(timeout time.Duration) time.Duration {
 	if timeout <= 0 {
@@ -43,15 +73,56 @@ func SendMailTLS(addr string, auth smtp.Auth, from string, to []string, msg []by
 	return nil
 }
 
+func sendMailPlain(addr string, auth smtp.Auth, from string, to []string, msg []byte, timeout time.Duration) error {
+	timeout = normalizeSMTPTimeout(timeout)
+	host := strings.Split(addr, ":")[0]
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return fmt.Errorf("failed to dial SMTP: %w", err)
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to set SMTP deadline: %w", err)
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to create SMTP client: %w", err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	return sendMailWithClient(client, auth, from, to, msg)
+}
+
+func sendMailStartTLS(addr string, auth smtp.Auth, from string, to []string, msg []byte, timeout time.Duration) error {
+	timeout = normalizeSMTPTimeout(timeout)
+	host := strings.Split(addr, ":")[0]
+	client, err := dialSMTPStartTLS(addr, host, timeout)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	return sendMailWithClient(client, auth, from, to, msg)
+}
+
 func sendMailWithClient(client *smtp.Client, auth smtp.Auth, from string, to []string, msg []byte) error {
-	if err := client.Auth(auth); err != nil {
-		return fmt.Errorf("failed to authenticate: %w", err)
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
 	}
 	if err := client.Mail(from); err != nil {
 		return fmt.Errorf("failed to set mail from: %w", err)
 	}
 	for _, recipient := range to {
 		if err := client.Rcpt(recipient); err != nil {
+			var protoErr *textproto.Error
+			if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+				return &RecipientRejectedError{Recipient: recipient, Code: protoErr.Code, Message: protoErr.Msg}
+			}
 			return fmt.Errorf("failed to set recipient %s: %w", recipient, err)
 		}
 	}
@@ -127,45 +198,283 @@ func shouldFallbackToStartTLS(err error) bool {
 	return strings.Contains(strings.ToLower(err.Error()), "first record does not look like a tls handshake")
 }
 
-type HarukiSMTPClient struct {
+// Relay is one SMTP server mail can be handed to.
+type Relay struct {
+	Name    string
 	Addr    string
 	Auth    smtp.Auth
 	From    string
+	TLS     string
 	Timeout time.Duration
 }
 
+// RelayStatus is the health of a relay as seen by this instance.
+type RelayStatus struct {
+	Name          string     `json:"name"`
+	Addr          string     `json:"addr"`
+	Healthy       bool       `json:"healthy"`
+	CooldownUntil *time.Time `json:"cooldownUntil,omitempty"`
+	LastError     string     `json:"lastError,omitempty"`
+	LastFailureAt *time.Time `json:"lastFailureAt,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+}
+
+type relayHealth struct {
+	cooldownUntil time.Time
+	lastError     string
+	lastFailureAt time.Time
+	lastSuccessAt time.Time
+}
+
+// HarukiSMTPClient sends mail through its relays in order. A relay that
+// fails is moved behind the healthy ones for Cooldown, but is still tried
+// as a last resort.
+type HarukiSMTPClient struct {
+	Relays        []Relay
+	Cooldown      time.Duration
+	DefaultLocale string
+
+	healthMu sync.Mutex
+	health   map[int]*relayHealth
+}
+
+var smtpNow = time.Now
+
 func NewSMTPClient(cfg config.SMTPConfig) *HarukiSMTPClient {
-	addr := fmt.Sprintf("%s:%d", cfg.SMTPAddr, cfg.SMTPPort)
-	auth := smtp.PlainAuth("", cfg.SMTPMail, cfg.SMTPPass, cfg.SMTPAddr)
-	timeout := normalizeSMTPTimeout(time.Duration(cfg.TimeoutSeconds) * time.Second)
-	return &HarukiSMTPClient{
-		Addr:    addr,
-		Auth:    auth,
-		From:    cfg.SMTPMail,
-		Timeout: timeout,
+	client := &HarukiSMTPClient{
+		Cooldown:      defaultSMTPRelayCooldown,
+		DefaultLocale: SupportedLocale(cfg.DefaultLocale),
+	}
+	if cfg.RelayCooldownSeconds > 0 {
+		client.Cooldown = time.Duration(cfg.RelayCooldownSeconds) * time.Second
+	}
+	if strings.TrimSpace(cfg.SMTPAddr) != "" {
+		client.Relays = append(client.Relays, newRelay(primarySMTPRelayName, cfg.SMTPAddr, cfg.SMTPPort, cfg.SMTPMail, cfg.SMTPMail, cfg.SMTPPass, cfg.TLS, cfg.TimeoutSeconds))
+	}
+	for i, relayCfg := range cfg.Relays {
+		if strings.TrimSpace(relayCfg.Addr) == "" {
+			continue
+		}
+		name := strings.TrimSpace(relayCfg.Name)
+		if name == "" {
+			name = fmt.Sprintf("relay-%d", i+1)
+		}
+		from := strings.TrimSpace(relayCfg.Mail)
+		if from == "" {
+			from = cfg.SMTPMail
+		}
+		username := strings.TrimSpace(relayCfg.Username)
+		if username == "" {
+			username = from
+		}
+		timeoutSeconds := relayCfg.TimeoutSeconds
+		if timeoutSeconds <= 0 {
+			timeoutSeconds = cfg.TimeoutSeconds
+		}
+		client.Relays = append(client.Relays, newRelay(name, relayCfg.Addr, relayCfg.Port, from, username, relayCfg.Password, relayCfg.TLS, timeoutSeconds))
+	}
+	return client
+}
+
+func newRelay(name, host string, port int, from, username, password, tlsMode string, timeoutSeconds int) Relay {
+	host = strings.TrimSpace(host)
+	relay := Relay{
+		Name:    name,
+		Addr:    fmt.Sprintf("%s:%d", host, port),
+		From:    strings.TrimSpace(from),
+		TLS:     normalizeTLSMode(tlsMode),
+		Timeout: normalizeSMTPTimeout(time.Duration(timeoutSeconds) * time.Second),
+	}
+	if password != "" {
+		relay.Auth = smtp.PlainAuth("", username, password, host)
+	}
+	return relay
+}
+
+func normalizeTLSMode(mode string) string {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case TLSModeStartTLS:
+		return TLSModeStartTLS
+	case TLSModeNone:
+		return TLSModeNone
+	default:
+		return TLSModeAuto
 	}
 }
 
+// Message is one mail. ID, when set, becomes the Message-ID header.
+type Message struct {
+	ID          string
+	To          []string
+	Subject     string
+	Body        string
+	DisplayName string
+}
+
 func (c *HarukiSMTPClient) Send(to []string, subject, body string, displayName string) error {
-	headers := make(map[string]string)
-	if displayName != "" {
-		headers["From"] = fmt.Sprintf("%s <%s>", displayName, c.From)
+	_, err := c.SendMessage(Message{To: to, Subject: subject, Body: body, DisplayName: displayName})
+	return err
+}
+
+func (c *HarukiSMTPClient) SendTemplate(to []string, template, locale string, vars map[string]string, displayName string) error {
+	if locale == "" {
+		locale = c.DefaultLocale
+	}
+	rendered, err := Render(template, locale, vars)
+	if err != nil {
+		return err
+	}
+	return c.Send(to, rendered.Subject, rendered.Body, displayName)
+}
+
+// SendMessage hands msg to the first relay that accepts it and returns the
+// name of that relay. A RecipientRejectedError is returned as is without
+// trying the remaining relays.
+func (c *HarukiSMTPClient) SendMessage(msg Message) (string, error) {
+	if c == nil || len(c.Relays) == 0 {
+		return "", ErrNoRelays
+	}
+	var errs []error
+	for _, index := range c.relayOrder(smtpNow()) {
+		relay := c.Relays[index]
+		data, err := buildMessage(relay.From, msg)
+		if err != nil {
+			return "", err
+		}
+		err = sendViaRelay(relay, msg.To, data)
+		var rejected *RecipientRejectedError
+		if err == nil || errors.As(err, &rejected) {
+			// A rejected recipient says nothing about the relay itself.
+			c.recordRelayResult(index, nil)
+			return relay.Name, err
+		}
+		c.recordRelayResult(index, err)
+		errs = append(errs, fmt.Errorf("relay %s: %w", relay.Name, err))
+	}
+	return "", errors.Join(errs...)
+}
+
+func sendViaRelay(relay Relay, to []string, msg []byte) error {
+	switch relay.TLS {
+	case TLSModeNone:
+		return sendMailPlain(relay.Addr, relay.Auth, relay.From, to, msg, relay.Timeout)
+	case TLSModeStartTLS:
+		return sendMailStartTLS(relay.Addr, relay.Auth, relay.From, to, msg, relay.Timeout)
+	default:
+		return SendMailTLS(relay.Addr, relay.Auth, relay.From, to, msg, relay.Timeout)
+	}
+}
+
+func buildMessage(from string, msg Message) ([]byte, error) {
+	headers := [][2]string{}
+	if msg.DisplayName != "" {
+		headers = append(headers, [2]string{"From", fmt.Sprintf("%s <%s>", msg.DisplayName, from)})
 	} else {
-		headers["From"] = c.From
+		headers = append(headers, [2]string{"From", from})
+	}
+	headers = append(headers,
+		[2]string{"To", strings.Join(msg.To, ", ")},
+		[2]string{"Subject", msg.Subject},
+		[2]string{"MIME-Version", "1.0"},
+		[2]string{"Content-Type", "text/html; charset=\"UTF-8\""},
+		[2]string{"Date", smtpNow().Format(time.RFC1123Z)},
+	)
+	if msg.ID != "" {
+		domain := "localhost"
+		if _, host, ok := strings.Cut(from, "@"); ok && host != "" {
+			domain = host
+		}
+		headers = append(headers, [2]string{"Message-ID", fmt.Sprintf("<%s@%s>", msg.ID, domain)})
 	}
-	headers["To"] = strings.Join(to, ", ")
-	headers["Subject"] = subject
-	headers["MIME-Version"] = "1.0"
-	headers["Content-Type"] = "text/html; charset=\"UTF-8\""
-	headers["Date"] = time.Now().Format(time.RFC1123Z)
 	var msgBuilder strings.Builder
-	for k, v := range headers {
-		_, err := fmt.Fprintf(&msgBuilder, "%s: %s\r\n", k, v)
+	for _, header := range headers {
+		if strings.ContainsAny(header[1], "\r\n") {
+			return nil, fmt.Errorf("invalid %s header", header[0])
+		}
+		_, err := fmt.Fprintf(&msgBuilder, "%s: %s\r\n", header[0], header[1])
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	msgBuilder.WriteString("\r\n")
-	msgBuilder.WriteString(body)
-	return SendMailTLS(c.Addr, c.Auth, c.From, to, []byte(msgBuilder.String()), c.Timeout)
+	msgBuilder.WriteString(msg.Body)
+	return []byte(msgBuilder.String()), nil
+}
+
+// relayOrder returns the relay indexes to try: healthy relays in configured
+// order, then those still cooling down.
+func (c *HarukiSMTPClient) relayOrder(now time.Time) []int {
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+	healthy := make([]int, 0, len(c.Relays))
+	var cooling []int
+	for index := range c.Relays {
+		if health := c.health[index]; health != nil && now.Before(health.cooldownUntil) {
+			cooling = append(cooling, index)
+			continue
+		}
+		healthy = append(healthy, index)
+	}
+	return append(healthy, cooling...)
+}
+
+func (c *HarukiSMTPClient) recordRelayResult(index int, err error) {
+	now := smtpNow()
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+	if c.health == nil {
+		c.health = make(map[int]*relayHealth)
+	}
+	health := c.health[index]
+	if health == nil {
+		health = &relayHealth{}
+		c.health[index] = health
+	}
+	if err == nil {
+		health.cooldownUntil = time.Time{}
+		health.lastSuccessAt = now
+		return
+	}
+	cooldown := c.Cooldown
+	if cooldown <= 0 {
+		cooldown = defaultSMTPRelayCooldown
+	}
+	health.cooldownUntil = now.Add(cooldown)
+	health.lastFailureAt = now
+	health.lastError = err.Error()
+	if len(health.lastError) > smtpRelayErrorMaxLen {
+		health.lastError = health.lastError[:smtpRelayErrorMaxLen]
+	}
+}
+
+// RelayStatuses reports the health of each relay.
+func (c *HarukiSMTPClient) RelayStatuses() []RelayStatus {
+	if c == nil {
+		return nil
+	}
+	now := smtpNow()
+	c.healthMu.Lock()
+	defer c.healthMu.Unlock()
+	statuses := make([]RelayStatus, 0, len(c.Relays))
+	for index, relay := range c.Relays {
+		status := RelayStatus{Name: relay.Name, Addr: relay.Addr, Healthy: true}
+		if health := c.health[index]; health != nil {
+			if now.Before(health.cooldownUntil) {
+				status.Healthy = false
+				cooldownUntil := health.cooldownUntil.UTC()
+				status.CooldownUntil = &cooldownUntil
+			}
+			status.LastError = health.lastError
+			if !health.lastFailureAt.IsZero() {
+				lastFailureAt := health.lastFailureAt.UTC()
+				status.LastFailureAt = &lastFailureAt
+			}
+			if !health.lastSuccessAt.IsZero() {
+				lastSuccessAt := health.lastSuccessAt.UTC()
+				status.LastSuccessAt = &lastSuccessAt
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
 }
@@ -1,7 +1,10 @@
 package smtp
 
 import (
+	"errors"
 	"github.com/Team-Haruki/Haruki-Toolbox-Backend/config"
+	"github.com/Team-Haruki/Haruki-Toolbox-Backend/utils/smtp/smtptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -27,8 +30,8 @@ func TestNewSMTPClientTimeout(t *testing.T) {
 		SMTPMail: "noreply@example.com",
 		SMTPPass: "secret",
 	})
-	if client.Timeout != defaultSMTPTimeout {
-		t.Fatalf("NewSMTPClient default timeout = %v, want %v", client.Timeout, defaultSMTPTimeout)
+	if client.Relays[0].Timeout != defaultSMTPTimeout {
+		t.Fatalf("NewSMTPClient default timeout = %v, want %v", client.Relays[0].Timeout, defaultSMTPTimeout)
 	}
 
 	client = NewSMTPClient(config.SMTPConfig{
@@ -38,7 +41,73 @@ func TestNewSMTPClientTimeout(t *testing.T) {
 		SMTPPass:       "secret",
 		TimeoutSeconds: 25,
 	})
-	if client.Timeout != 25*time.Second {
-		t.Fatalf("NewSMTPClient configured timeout = %v, want %v", client.Timeout, 25*time.Second)
+	if client.Relays[0].Timeout != 25*time.Second {
+		t.Fatalf("NewSMTPClient configured timeout = %v, want %v", client.Relays[0].Timeout, 25*time.Second)
+	}
+}
+
+func TestHarukiSMTPClientFailsOverBetweenRelays(t *testing.T) {
+	down, err := smtptest.NewServer()
+	if err != nil {
+		t.Fatalf("smtptest.NewServer returned error: %v", err)
+	}
+	defer down.Close()
+	down.FailMail("451 4.3.0 try again later")
+	backup, err := smtptest.NewServer()
+	if err != nil {
+		t.Fatalf("smtptest.NewServer returned error: %v", err)
+	}
+	defer backup.Close()
+	backup.RejectRecipient("gone@example.com", "550 5.1.1 user unknown")
+
+	client := NewSMTPClient(config.SMTPConfig{
+		SMTPAddr: down.Host,
+		SMTPPort: down.Port,
+		SMTPMail: "noreply@example.com",
+		TLS:      TLSModeNone,
+		Relays: []config.SMTPRelayConfig{{
+			Name:     "backup",
+			Addr:     backup.Host,
+			Port:     backup.Port,
+			Password: "secret",
+			TLS:      TLSModeNone,
+		}},
+	})
+
+	relay, err := client.SendMessage(Message{ID: "abc", To: []string{"user@example.com"}, Subject: "Hello", Body: "<p>hi</p>", DisplayName: "Haruki"})
+	if err != nil || relay != "backup" {
+		t.Fatalf("SendMessage = %q, %v; want backup, nil", relay, err)
+	}
+	messages := backup.Messages()
+	if len(messages) != 1 || messages[0].From != "noreply@example.com" || messages[0].To[0] != "user@example.com" {
+		t.Fatalf("backup messages = %+v", messages)
+	}
+	if !strings.Contains(messages[0].Data, "Message-ID: <abc@example.com>") || !strings.Contains(messages[0].Data, "From: Haruki <noreply@example.com>") {
+		t.Fatalf("message data missing headers: %s", messages[0].Data)
+	}
+	if order := client.relayOrder(time.Now()); order[0] != 1 {
+		t.Fatalf("relay order = %v, want the failed primary last", order)
+	}
+	statuses := client.RelayStatuses()
+	if statuses[0].Healthy || statuses[0].LastError == "" || !statuses[1].Healthy || statuses[1].LastSuccessAt == nil {
+		t.Fatalf("relay statuses = %+v", statuses)
+	}
+
+	_, err = client.SendMessage(Message{To: []string{"gone@example.com"}, Subject: "Hello", Body: "hi"})
+	var rejected *RecipientRejectedError
+	if !errors.As(err, &rejected) || rejected.Code != 550 || rejected.Recipient != "gone@example.com" {
+		t.Fatalf("SendMessage to rejected recipient returned %v, want RecipientRejectedError 550", err)
+	}
+
+	if _, err := (&HarukiSMTPClient{}).SendMessage(Message{To: []string{"user@example.com"}}); !errors.Is(err, ErrNoRelays) {
+		t.Fatalf("SendMessage without relays returned %v, want ErrNoRelays", err)
+	}
+}
+
+func TestBuildMessageRejectsHeaderInjection(t *testing.T) {
+	t.Parallel()
+
+	if _, err := buildMessage("noreply@example.com", Message{To: []string{"user@example.com"}, Subject: "Hi\r\nBcc: evil@example.com"}); err == nil {
+		t.Fatalf("buildMessage accepted a subject with a line break")
 	}
 }
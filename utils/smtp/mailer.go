@@ -0,0 +1,19 @@
+package smtp
+
+import "errors"
+
+// Mailer is what handlers send mail through. HarukiSMTPClient hands the
+// mail to a relay right away; the mail outbox queues it and retries in the
+// background.
+type Mailer interface {
+	Send(to []string, subject, body string, displayName string) error
+	// SendTemplate renders a catalog template for locale, or for the
+	// default locale when locale is empty, and sends it.
+	SendTemplate(to []string, template, locale string, vars map[string]string, displayName string) error
+}
+
+var _ Mailer = (*HarukiSMTPClient)(nil)
+
+// ErrRecipientSuppressed is returned by the mail outbox when every recipient
+// is on the suppression list, so nothing will be sent.
+var ErrRecipientSuppressed = errors.New("recipient is suppressed")
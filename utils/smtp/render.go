@@ -0,0 +1,169 @@
+package smtp
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TemplateVerificationCode           = "verification_code"
+	TemplateGameAccountBindingTransfer = "game_account_binding_transfer"
+	TemplateDataExportReady            = "data_export_ready"
+	TemplateAccountDeletionScheduled   = "account_deletion_scheduled"
+	TemplateAccountDeletionCompleted   = "account_deletion_completed"
+	TemplateWebhookAutoDisabled        = "webhook_auto_disabled"
+)
+
+const (
+	LocaleZhCN = "zh-CN"
+	LocaleEN   = "en"
+
+	DefaultLocale = LocaleZhCN
+)
+
+type localizedTemplate struct {
+	subject string
+	body    string
+}
+
+type templateDefinition struct {
+	locales map[string]localizedTemplate
+	// ttl is how long a queued mail stays worth sending; zero keeps it
+	// until the attempt budget runs out.
+	ttl time.Duration
+}
+
+var templateCatalog = map[string]templateDefinition{
+	TemplateVerificationCode: {
+		locales: map[string]localizedTemplate{
+			LocaleZhCN: {subject: "您的验证码 | {{PRODUCT}}", body: VerificationCodeTemplate},
+			LocaleEN:   {subject: "Your verification code | {{PRODUCT}}", body: VerificationCodeTemplateEN},
+		},
+		ttl: 5 * time.Minute,
+	},
+	TemplateGameAccountBindingTransfer: {
+		locales: map[string]localizedTemplate{
+			LocaleZhCN: {subject: "游戏账号绑定变更通知 | Haruki工具箱", body: GameAccountBindingTransferTemplate},
+			LocaleEN:   {subject: "Game account binding changed | Haruki Toolbox", body: GameAccountBindingTransferTemplateEN},
+		},
+	},
+	TemplateDataExportReady: {
+		locales: map[string]localizedTemplate{
+			LocaleZhCN: {subject: "账号数据导出已完成 | Haruki工具箱", body: DataExportReadyTemplate},
+			LocaleEN:   {subject: "Your data export is ready | Haruki Toolbox", body: DataExportReadyTemplateEN},
+		},
+	},
+	TemplateAccountDeletionScheduled: {
+		locales: map[string]localizedTemplate{
+			LocaleZhCN: {subject: "账号注销申请已提交 | Haruki工具箱", body: AccountDeletionScheduledTemplate},
+			LocaleEN:   {subject: "Your account deletion is scheduled | Haruki Toolbox", body: AccountDeletionScheduledTemplateEN},
+		},
+	},
+	TemplateAccountDeletionCompleted: {
+		locales: map[string]localizedTemplate{
+			LocaleZhCN: {subject: "账号已注销 | Haruki工具箱", body: AccountDeletionCompletedTemplate},
+			LocaleEN:   {subject: "Your account has been deleted | Haruki Toolbox", body: AccountDeletionCompletedTemplateEN},
+		},
+	},
+	TemplateWebhookAutoDisabled: {
+		locales: map[string]localizedTemplate{
+			LocaleZhCN: {subject: "Webhook 已自动停用：{{WEBHOOK_ID}} | Haruki工具箱", body: WebhookAutoDisabledTemplate},
+			LocaleEN:   {subject: "Webhook disabled: {{WEBHOOK_ID}} | Haruki Toolbox", body: WebhookAutoDisabledTemplateEN},
+		},
+	},
+}
+
+// Rendered is a template filled in for one locale.
+type Rendered struct {
+	Subject string
+	Body    string
+	Locale  string
+	TTL     time.Duration
+}
+
+// Render fills in template name for locale, falling back to DefaultLocale
+// when the locale has no translation. Values are HTML-escaped in the body
+// and stripped of line breaks in the subject.
+func Render(name, locale string, vars map[string]string) (Rendered, error) {
+	definition, ok := templateCatalog[name]
+	if !ok {
+		return Rendered{}, fmt.Errorf("unknown mail template %q", name)
+	}
+	locale = SupportedLocale(locale)
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	localized, ok := definition.locales[locale]
+	if !ok {
+		locale = DefaultLocale
+		localized = definition.locales[locale]
+	}
+	// Each template is scanned once, so a value that looks like another
+	// placeholder is left as it is.
+	subjectPairs := make([]string, 0, 2*len(vars))
+	bodyPairs := make([]string, 0, 2*len(vars))
+	lineBreaks := strings.NewReplacer("\r", " ", "\n", " ")
+	for key, value := range vars {
+		placeholder := "{{" + key + "}}"
+		subjectPairs = append(subjectPairs, placeholder, lineBreaks.Replace(value))
+		bodyPairs = append(bodyPairs, placeholder, html.EscapeString(value))
+	}
+	subject := strings.NewReplacer(subjectPairs...).Replace(localized.subject)
+	body := strings.NewReplacer(bodyPairs...).Replace(localized.body)
+	return Rendered{Subject: subject, Body: body, Locale: locale, TTL: definition.ttl}, nil
+}
+
+// SupportedLocale maps a language tag to a locale with templates, or returns
+// "" when there is none.
+func SupportedLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	switch {
+	case tag == "":
+		return ""
+	case tag == "zh" || strings.HasPrefix(tag, "zh-") || strings.HasPrefix(tag, "zh_"):
+		return LocaleZhCN
+	case tag == "en" || strings.HasPrefix(tag, "en-") || strings.HasPrefix(tag, "en_"):
+		return LocaleEN
+	}
+	return ""
+}
+
+// LocaleFromAcceptLanguage picks the supported locale the client prefers
+// most, or returns "" when none of the listed languages are supported.
+func LocaleFromAcceptLanguage(header string) string {
+	type candidate struct {
+		locale string
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale := SupportedLocale(tag)
+		if locale == "" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q})
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale
+}
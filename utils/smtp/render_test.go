@@ -0,0 +1,73 @@
+package smtp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderLocalizesAndEscapes(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(TemplateVerificationCode, "en", map[string]string{"CODE": "<123456>", "PRODUCT": "Haruki\r\nBot"})
+	if err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+	if rendered.Locale != LocaleEN || rendered.Subject != "Your verification code | Haruki  Bot" || rendered.TTL != 5*time.Minute {
+		t.Fatalf("rendered = %q / %q / %s", rendered.Locale, rendered.Subject, rendered.TTL)
+	}
+	if !strings.Contains(rendered.Body, "&lt;123456&gt;") || strings.Contains(rendered.Body, "{{CODE}}") {
+		t.Fatalf("rendered body does not contain the escaped code")
+	}
+
+	rendered, err = Render(TemplateGameAccountBindingTransfer, "ja", nil)
+	if err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+	if rendered.Locale != DefaultLocale || !strings.Contains(rendered.Body, "游戏账号绑定已变更") {
+		t.Fatalf("unsupported locale rendered %q, want the default locale", rendered.Locale)
+	}
+
+	rendered, err = Render(TemplateWebhookAutoDisabled, "en", map[string]string{"WEBHOOK_ID": "w1", "CLIENT_ID": "client-a", "CALLBACK_URL": "https://hooks.example.com/?a=1&b=2", "FAILURES": "5", "FAILING_SINCE": "-", "LAST_ERROR": "HTTP 502"})
+	if err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+	if rendered.Subject != "Webhook disabled: w1 | Haruki Toolbox" || !strings.Contains(rendered.Body, "a=1&amp;b=2") || strings.Contains(rendered.Body, "{{") {
+		t.Fatalf("webhook auto-disable mail = %q / %q", rendered.Subject, rendered.Body)
+	}
+
+	// A value that looks like a placeholder is never expanded, whatever
+	// order the vars come in.
+	for range 20 {
+		rendered, err = Render(TemplateWebhookAutoDisabled, "en", map[string]string{"WEBHOOK_ID": "w1", "CLIENT_ID": "{{WEBHOOK_ID}}", "CALLBACK_URL": "https://hooks.example.com", "FAILURES": "5", "FAILING_SINCE": "-", "LAST_ERROR": "{{CALLBACK_URL}}"})
+		if err != nil {
+			t.Fatalf("Render returned error: %v", err)
+		}
+		if !strings.Contains(rendered.Body, "{{CALLBACK_URL}}") || !strings.Contains(rendered.Body, "{{WEBHOOK_ID}}") {
+			t.Fatalf("placeholder inside a value was expanded: %q", rendered.Body)
+		}
+	}
+
+	if _, err := Render("missing", "en", nil); err == nil {
+		t.Fatalf("Render of an unknown template succeeded")
+	}
+}
+
+func TestLocaleFromAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"":                              "",
+		"ja-JP":                         "",
+		"en-US,en;q=0.9":                LocaleEN,
+		"zh-TW;q=0.5, en-GB;q=0.8":      LocaleEN,
+		"ja, zh-CN;q=0.7, en;q=0.3":     LocaleZhCN,
+		"en;q=0, zh_CN":                 LocaleZhCN,
+		"fr;q=0.9, en-US;q=bad, zh;q=1": LocaleZhCN,
+	}
+	for header, want := range cases {
+		if got := LocaleFromAcceptLanguage(header); got != want {
+			t.Errorf("LocaleFromAcceptLanguage(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
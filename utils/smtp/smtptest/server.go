@@ -0,0 +1,192 @@
+// Package smtptest provides a local SMTP server for tests, the mail
+// counterpart of net/http/httptest. It speaks plain SMTP without TLS, so
+// relays pointing at it use the "none" TLS mode.
+package smtptest
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Message is one mail accepted by the server.
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+type Server struct {
+	// Host and Port are where the server listens; Addr is both joined.
+	Host string
+	Port int
+	Addr string
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu               sync.Mutex
+	messages         []Message
+	rejectRecipients map[string]string
+	mailReply        string
+}
+
+// NewServer starts a server on a random loopback port. Call Close when done.
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	tcpAddr := listener.Addr().(*net.TCPAddr)
+	s := &Server{
+		Host:             tcpAddr.IP.String(),
+		Port:             tcpAddr.Port,
+		Addr:             listener.Addr().String(),
+		listener:         listener,
+		rejectRecipients: make(map[string]string),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Close stops accepting connections and waits for open sessions to end.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+// Messages returns the mails accepted so far.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// RejectRecipient makes RCPT TO for email fail with reply, for example
+// "550 5.1.1 user unknown".
+func (s *Server) RejectRecipient(email, reply string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectRecipients[strings.ToLower(email)] = reply
+}
+
+// FailMail makes every MAIL FROM fail with reply, for example
+// "451 4.3.0 try again later". An empty reply accepts mail again.
+func (s *Server) FailMail(reply string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mailReply = reply
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	reader := textproto.NewReader(bufio.NewReader(conn))
+	reply := func(line string) bool {
+		_, err := io.WriteString(conn, line+"\r\n")
+		return err == nil
+	}
+	if !reply("220 smtptest ready") {
+		return
+	}
+	var current Message
+	for {
+		line, err := reader.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			if !reply("250-smtptest") || !reply("250 AUTH PLAIN") {
+				return
+			}
+		case "HELO", "NOOP":
+			reply("250 OK")
+		case "AUTH":
+			if !strings.Contains(arg, " ") {
+				// The credentials follow on their own line.
+				if !reply("334 ") {
+					return
+				}
+				if _, err := reader.ReadLine(); err != nil {
+					return
+				}
+			}
+			reply("235 2.7.0 authenticated")
+		case "MAIL":
+			s.mu.Lock()
+			failure := s.mailReply
+			s.mu.Unlock()
+			if failure != "" {
+				reply(failure)
+				continue
+			}
+			current = Message{From: extractAddress(arg)}
+			reply("250 OK")
+		case "RCPT":
+			recipient := extractAddress(arg)
+			s.mu.Lock()
+			rejection := s.rejectRecipients[strings.ToLower(recipient)]
+			s.mu.Unlock()
+			if rejection != "" {
+				reply(rejection)
+				continue
+			}
+			current.To = append(current.To, recipient)
+			reply("250 OK")
+		case "DATA":
+			if !reply("354 end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := io.ReadAll(reader.DotReader())
+			if err != nil {
+				return
+			}
+			current.Data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			current = Message{}
+			reply("250 OK queued as " + strconv.Itoa(len(s.Messages())))
+		case "RSET":
+			current = Message{}
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func extractAddress(arg string) string {
+	_, value, _ := strings.Cut(arg, ":")
+	value = strings.TrimSpace(value)
+	if end := strings.Index(value, ">"); strings.HasPrefix(value, "<") && end > 0 {
+		return value[1:end]
+	}
+	return value
+}
@@ -377,3 +377,158 @@ const GameAccountBindingTransferTemplate = `<!DOCTYPE html>
 </body>
 </html>
 `
+
+const DataExportReadyTemplate = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+    <meta charset="UTF-8">
+    <title>您的账号数据导出已完成</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">账号数据导出已完成</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">您在 Haruki工具箱 申请的账号数据导出已经生成，压缩包中包含账号资料、游戏账号绑定与隐私设置、数据授权、OAuth2 授权、工单、操作记录、上传记录以及已验证游戏账号的数据。</p>
+                            <p style="margin:0 0 16px 0;">
+                                <a href="{{LINK}}" style="display:inline-block;padding:12px 24px;background:#18181b;color:#ffffff;text-decoration:none;border-radius:8px;font-weight:500;">下载数据</a>
+                            </p>
+                            <p style="margin:0 0 8px 0;font-size:13px;color:#6b7280;">如果按钮无法使用，请复制以下链接：</p>
+                            <p style="margin:0 0 16px 0;font-family:'SF Mono',Monaco,Consolas,monospace;font-size:12px;color:#52525b;word-break:break-all;">{{LINK}}</p>
+                            <p style="margin:0;">下载链接将在 {{EXPIRES_AT}} 失效，请勿将链接转发给他人。如果这不是您本人的操作，请尽快修改密码并通过工单联系管理员。</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            此邮件由 Haruki工具箱 自动发送，请勿直接回复。
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const AccountDeletionScheduledTemplate = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+    <meta charset="UTF-8">
+    <title>账号注销申请已提交</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">账号注销申请已提交</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">您的 Haruki工具箱 账号已申请注销，账号及相关数据将在 {{SCHEDULED_FOR}} 被永久删除。</p>
+                            <p style="margin:0 0 16px 0;">删除范围包括账号资料、游戏账号绑定及其游戏数据、数据授权、OAuth2 授权、工单、头像与数据导出，删除后无法恢复。</p>
+                            <p style="margin:0;">在此之前登录工具箱即可撤销注销申请。如果这不是您本人的操作，请立即撤销申请、修改密码并通过工单联系管理员。</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            此邮件由 Haruki工具箱 自动发送，请勿直接回复。
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const AccountDeletionCompletedTemplate = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+    <meta charset="UTF-8">
+    <title>账号已注销</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">账号已注销</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">您的 Haruki工具箱 账号已于 {{COMPLETED_AT}} 完成注销，账号及相关数据已被永久删除。</p>
+                            <p style="margin:0 0 16px 0;">注销回执编号：<span style="font-family:'SF Mono',Monaco,Consolas,monospace;">{{RECEIPT_ID}}</span>。如需就本次注销联系我们，请提供该编号。</p>
+                            <p style="margin:0;">这是我们发送到此邮箱的最后一封邮件。</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            此邮件由 Haruki工具箱 自动发送，请勿直接回复。
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const WebhookAutoDisabledTemplate = `<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+    <meta charset="UTF-8">
+    <title>Webhook 已自动停用</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">Webhook 已自动停用</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">您在 Haruki工具箱 配置的 Webhook 持续投递失败，已被自动停用。</p>
+                            <table cellpadding="0" cellspacing="0" style="margin:0 0 16px 0;font-size:14px;">
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">Webhook ID</td><td style="padding:4px 0;word-break:break-all;">{{WEBHOOK_ID}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">OAuth2 Client</td><td style="padding:4px 0;word-break:break-all;">{{CLIENT_ID}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">回调地址</td><td style="padding:4px 0;word-break:break-all;">{{CALLBACK_URL}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">连续失败次数</td><td style="padding:4px 0;word-break:break-all;">{{FAILURES}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">开始失败时间</td><td style="padding:4px 0;word-break:break-all;">{{FAILING_SINCE}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">最近一次错误</td><td style="padding:4px 0;word-break:break-all;">{{LAST_ERROR}}</td></tr>
+                            </table>
+                            <p style="margin:0;">停用期间的事件不会再投递。修复回调服务后，请联系管理员重新启用该 Webhook。</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            此邮件由 Haruki工具箱 自动发送，请勿直接回复。
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
@@ -0,0 +1,351 @@
+package smtp
+
+const VerificationCodeTemplateEN = `<!DOCTYPE html>
+<html lang="en">
+
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Your Haruki Toolbox verification code</title>
+</head>
+
+<body
+    style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; background-color: #fafafa; padding: 48px 16px;">
+    <table align="center" width="100%" cellpadding="0" cellspacing="0" style="max-width: 580px; margin: 0 auto;">
+        <tr>
+            <td>
+                <table width="100%" cellpadding="0" cellspacing="0"
+                    style="background-color: #ffffff; border-radius: 12px; box-shadow: 0 2px 8px rgba(0, 0, 0, 0.04), 0 1px 3px rgba(0, 0, 0, 0.06); border: 1px solid #e5e7eb; overflow: hidden;">
+                    <tr>
+                        <td style="padding: 40px 40px 24px 40px;">
+                            <table width="100%" cellpadding="0" cellspacing="0" border="0">
+                                <tr>
+                                    <td style="text-align:left;">
+                                        <table cellpadding="0" cellspacing="0" border="0"
+                                            style="border-collapse:collapse;">
+                                            <tr valign="middle">
+                                                <!-- Logo -->
+                                                <td style="padding-right:12px; vertical-align:middle;">
+                                                    <img src="https://images.haruki.seiunx.com/haruki.ico" alt="Logo"
+                                                        height="32"
+                                                        style="display:block; border:0; outline:none; text-decoration:none; width:auto;">
+                                                </td>
+
+                                                <!-- Title -->
+                                                <td
+                                                    style="font-size:18px; font-weight:600; color:#18181b; letter-spacing:-0.01em; vertical-align:middle;">
+                                                    Haruki Toolbox
+                                                </td>
+                                            </tr>
+                                        </table>
+                                    </td>
+                                </tr>
+                            </table>
+                        </td>
+                    </tr>
+
+                    <tr>
+                        <td style="padding: 0 40px 8px 40px; text-align: center;">
+                            <h1
+                                style="margin: 0; font-size: 24px; font-weight: 600; color: #18181b; letter-spacing: -0.02em; line-height: 1.3;">
+                                Verify your email
+                            </h1>
+                        </td>
+                    </tr>
+
+                    <tr>
+                        <td style="padding: 0 40px 32px 40px; text-align: center;">
+                            <p style="margin: 0; font-size: 15px; color: #71717a; line-height: 1.6;">
+                                We received a request to verify your email address.<br>Use the code below to finish the verification.<br>The code expires in 5 minutes.
+                            </p>
+                        </td>
+                    </tr>
+
+                    <tr>
+                        <td style="padding: 0 40px 24px 40px;">
+                            <table width="100%" cellpadding="0" cellspacing="0"
+                                style="background-color: #fafafa; border-radius: 8px; border: 1px solid #e5e7eb;">
+                                <tr>
+                                    <td style="padding: 24px; text-align: center;">
+                                        <div
+                                            style="font-size: 14px; font-weight: 500; color: #52525b; margin-bottom: 12px; text-transform: uppercase; letter-spacing: 0.05em;">
+                                            Verification code
+                                        </div>
+                                        <div
+                                            style="font-family: 'SF Mono', Monaco, 'Cascadia Code', 'Roboto Mono', Consolas, 'Courier New', monospace; font-size: 32px; font-weight: 600; letter-spacing: 8px; color: #18181b; user-select: all;">
+                                            {{CODE}}
+                                        </div>
+                                    </td>
+                                </tr>
+                            </table>
+                        </td>
+                    </tr>
+
+                    <tr>
+                        <td style="padding: 0 40px 32px 40px;">
+                            <table width="100%" cellpadding="0" cellspacing="0"
+                                style="background-color: #fef3c7; border-radius: 8px; border: 1px solid #fcd34d;">
+                                <tr>
+                                    <td style="padding: 12px 16px;">
+                                        <table width="100%" cellpadding="0" cellspacing="0">
+                                            <tr>
+                                                <td style="width: 20px; vertical-align: top; padding-top: 2px;">
+                                                    <img src="https://images.haruki.seiunx.com/triangle-alert.png" alt="Alert"
+                                                        width="20" height="20"
+                                                        style="display:block; border:0; outline:none; text-decoration:none;">
+                                                </td>
+                                                <td style="padding-left: 8px;">
+                                                    <p
+                                                        style="margin: 0; font-size: 14px; color: #78350f; line-height: 1.5;">
+                                                        If you did not request this code, you can ignore this email.
+                                                    </p>
+                                                </td>
+                                            </tr>
+                                        </table>
+                                    </td>
+                                </tr>
+                            </table>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 0 40px;">
+                            <div style="height: 1px; background-color: #e5e7eb;"></div>
+                        </td>
+                    </tr>
+
+                    <tr>
+                        <td style="padding: 24px 40px 32px 40px; text-align: center;">
+                            <p style="margin: 0 0 8px 0; font-size: 13px; color: #a1a1aa; line-height: 1.5;">
+                                This email was sent automatically by Haruki Toolbox. Please do not reply.
+                            </p>
+                            <p style="margin: 0; font-size: 13px; color: #d4d4d8; text-align: center;">
+                                © 2025 Haruki Toolbox. All rights reserved.
+                            </p>
+                        </td>
+                    </tr>
+                </table>
+
+                <table width="100%" cellpadding="0" cellspacing="0" style="margin-top: 32px;">
+                    <!--<tr>
+            <td style="text-align: center;">
+              <p style="margin: 0; font-size: 12px; color: #a1a1aa;">
+                <a href="#" style="color: #71717a; text-decoration: none; margin: 0 8px;">Help center</a>
+                <span style="color: #d4d4d8;">•</span>
+                <a href="#" style="color: #71717a; text-decoration: none; margin: 0 8px;">Privacy policy</a>
+                <span style="color: #d4d4d8;">•</span>
+                <a href="#" style="color: #71717a; text-decoration: none; margin: 0 8px;">Terms of service</a>
+              </p>
+            </td>
+          </tr>
+        </table>
+      </td>
+    </tr> -->
+                </table>
+</body>
+
+</html>
+`
+
+const GameAccountBindingTransferTemplateEN = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Game account binding changed</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">Your game account binding has changed</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">A game account you had bound on Haruki Toolbox was moved to another toolbox account after an in-game ownership verification.</p>
+                            <table width="100%" cellpadding="0" cellspacing="0" style="margin:16px 0;border-collapse:collapse;background:#f9fafb;border-radius:8px;overflow:hidden;">
+                                <tr>
+                                    <td style="padding:10px 14px;color:#6b7280;width:120px;">Server</td>
+                                    <td style="padding:10px 14px;color:#111827;">{{SERVER}}</td>
+                                </tr>
+                                <tr>
+                                    <td style="padding:10px 14px;color:#6b7280;">Game user ID</td>
+                                    <td style="padding:10px 14px;color:#111827;">{{GAME_USER_ID}}</td>
+                                </tr>
+                                <tr>
+                                    <td style="padding:10px 14px;color:#6b7280;">Changed at</td>
+                                    <td style="padding:10px 14px;color:#111827;">{{TRANSFER_TIME}}</td>
+                                </tr>
+                            </table>
+                            <p style="margin:16px 0 0 0;">If you did not expect this, check the security of your game account and contact an admin through a ticket as soon as possible.</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            This email was sent automatically by Haruki Toolbox. Please do not reply.
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const DataExportReadyTemplateEN = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Your account data export is ready</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">Your account data export is ready</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">The export of your Haruki Toolbox account has been generated. The archive contains your profile, game account bindings and privacy settings, data grants, OAuth2 authorizations, tickets, activity logs, upload logs and the game data of your verified game accounts.</p>
+                            <p style="margin:0 0 16px 0;">
+                                <a href="{{LINK}}" style="display:inline-block;padding:12px 24px;background:#18181b;color:#ffffff;text-decoration:none;border-radius:8px;font-weight:500;">Download</a>
+                            </p>
+                            <p style="margin:0 0 8px 0;font-size:13px;color:#6b7280;">If the button does not work, copy this link:</p>
+                            <p style="margin:0 0 16px 0;font-family:'SF Mono',Monaco,Consolas,monospace;font-size:12px;color:#52525b;word-break:break-all;">{{LINK}}</p>
+                            <p style="margin:0;">The link expires at {{EXPIRES_AT}}. Do not share it with anyone. If you did not request this export, change your password and contact an administrator through a ticket.</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            This email was sent automatically by Haruki Toolbox. Please do not reply.
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const AccountDeletionScheduledTemplateEN = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Your account deletion is scheduled</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">Your account deletion is scheduled</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">Your Haruki Toolbox account is scheduled for deletion. The account and its data will be permanently deleted on {{SCHEDULED_FOR}}.</p>
+                            <p style="margin:0 0 16px 0;">This covers your profile, game account bindings and their game data, data grants, OAuth2 authorizations, tickets, avatar and data exports, and cannot be undone.</p>
+                            <p style="margin:0;">Until then you can cancel the deletion by signing in to the toolbox. If you did not request this, cancel it right away, change your password and contact an administrator through a ticket.</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            This email was sent automatically by Haruki Toolbox. Please do not reply.
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const AccountDeletionCompletedTemplateEN = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Your account has been deleted</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">Your account has been deleted</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">Your Haruki Toolbox account was deleted on {{COMPLETED_AT}}, together with its data.</p>
+                            <p style="margin:0 0 16px 0;">Deletion receipt: <span style="font-family:'SF Mono',Monaco,Consolas,monospace;">{{RECEIPT_ID}}</span>. Please quote it if you contact us about this deletion.</p>
+                            <p style="margin:0;">This is the last mail we send to this address.</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            This email was sent automatically by Haruki Toolbox. Please do not reply.
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
+
+const WebhookAutoDisabledTemplateEN = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Your webhook has been disabled</title>
+</head>
+<body style="margin:0;padding:0;background:#f6f7fb;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',sans-serif;color:#202124;">
+    <table width="100%" cellpadding="0" cellspacing="0" style="background:#f6f7fb;padding:24px 0;">
+        <tr>
+            <td align="center">
+                <table width="600" cellpadding="0" cellspacing="0" style="background:#ffffff;border-radius:12px;overflow:hidden;border:1px solid #e6e8ef;">
+                    <tr>
+                        <td style="padding:28px 32px 16px 32px;">
+                            <h1 style="margin:0;font-size:22px;line-height:1.4;color:#111827;">Your webhook has been disabled</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:0 32px 24px 32px;font-size:15px;line-height:1.8;color:#374151;">
+                            <p style="margin:0 0 16px 0;">A webhook you configured in Haruki Toolbox kept failing to receive deliveries and has been disabled automatically.</p>
+                            <table cellpadding="0" cellspacing="0" style="margin:0 0 16px 0;font-size:14px;">
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">Webhook ID</td><td style="padding:4px 0;word-break:break-all;">{{WEBHOOK_ID}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">OAuth2 client</td><td style="padding:4px 0;word-break:break-all;">{{CLIENT_ID}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">Callback URL</td><td style="padding:4px 0;word-break:break-all;">{{CALLBACK_URL}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">Consecutive failures</td><td style="padding:4px 0;word-break:break-all;">{{FAILURES}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">Failing since</td><td style="padding:4px 0;word-break:break-all;">{{FAILING_SINCE}}</td></tr>
+                                <tr><td style="padding:4px 12px 4px 0;color:#6b7280;white-space:nowrap;">Last error</td><td style="padding:4px 0;word-break:break-all;">{{LAST_ERROR}}</td></tr>
+                            </table>
+                            <p style="margin:0;">Events are not delivered while the webhook is disabled. Once your callback service is fixed, ask an administrator to enable the webhook again.</p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding:18px 32px;background:#f3f4f6;font-size:13px;color:#6b7280;">
+                            This email was sent automatically by Haruki Toolbox. Please do not reply.
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`
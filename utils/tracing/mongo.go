@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mongoSpanKey struct {
+	connectionID string
+	requestID    int64
+}
+
+// MongoMonitor returns a command monitor that opens a client span for every
+// MongoDB command, parented to the span in the operation's context.
+func MongoMonitor() *event.CommandMonitor {
+	var spans sync.Map
+	finish := func(evt event.CommandFinishedEvent, failure error) {
+		value, ok := spans.LoadAndDelete(mongoSpanKey{evt.ConnectionID, evt.RequestID})
+		if !ok {
+			return
+		}
+		span := value.(trace.Span)
+		if failure != nil {
+			span.RecordError(failure)
+			span.SetStatus(codes.Error, failure.Error())
+		}
+		span.End()
+	}
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			attrs := []attribute.KeyValue{
+				attribute.String("db.system.name", "mongodb"),
+				attribute.String("db.namespace", evt.DatabaseName),
+				attribute.String("db.operation.name", evt.CommandName),
+			}
+			if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+				attrs = append(attrs, attribute.String("db.collection.name", collection))
+			}
+			_, span := Tracer().Start(ctx, fmt.Sprintf("mongodb.%s", evt.CommandName),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+			spans.Store(mongoSpanKey{evt.ConnectionID, evt.RequestID}, span)
+		},
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			finish(evt.CommandFinishedEvent, nil)
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			finish(evt.CommandFinishedEvent, evt.Failure)
+		},
+	}
+}
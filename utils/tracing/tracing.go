@@ -0,0 +1,169 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	tracerName = "github.com/Team-Haruki/Haruki-Toolbox-Backend"
+)
+
+type Options struct {
+	Enabled        bool
+	ServiceName    string
+	ServiceVersion string
+	// Exporter is ExporterOTLP or ExporterStdout.
+	Exporter string
+	// OTLPEndpoint is the OTLP/HTTP collector URL, e.g. http://collector:4318.
+	OTLPEndpoint string
+	OTLPHeaders  map[string]string
+	// StdoutPath receives one JSON span per line; empty writes to stdout.
+	StdoutPath  string
+	SampleRatio float64
+}
+
+func init() {
+	// W3C trace context is propagated even while tracing is disabled, so a
+	// caller's traceparent still reaches the services this backend calls.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Setup installs the global tracer provider. The returned func flushes
+// pending spans and must run before the process exits.
+func Setup(ctx context.Context, options Options) (func(context.Context) error, error) {
+	if !options.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, closeOutput, err := newExporter(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(options.ServiceName),
+		semconv.ServiceVersion(options.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("build tracing resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error {
+		return errors.Join(provider.Shutdown(ctx), closeOutput())
+	}, nil
+}
+
+func newExporter(ctx context.Context, options Options) (sdktrace.SpanExporter, func() error, error) {
+	noClose := func() error { return nil }
+	switch strings.ToLower(strings.TrimSpace(options.Exporter)) {
+	case ExporterOTLP:
+		clientOptions := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(options.OTLPEndpoint)}
+		if len(options.OTLPHeaders) > 0 {
+			clientOptions = append(clientOptions, otlptracehttp.WithHeaders(options.OTLPHeaders))
+		}
+		exporter, err := otlptracehttp.New(ctx, clientOptions...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("create OTLP trace exporter: %w", err)
+		}
+		return exporter, noClose, nil
+	case ExporterStdout:
+		var output io.Writer = os.Stdout
+		closeOutput := noClose
+		if path := strings.TrimSpace(options.StdoutPath); path != "" {
+			file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+			if err != nil {
+				return nil, nil, fmt.Errorf("open trace output file: %w", err)
+			}
+			output, closeOutput = file, file.Close
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(output))
+		if err != nil {
+			_ = closeOutput()
+			return nil, nil, fmt.Errorf("create stdout trace exporter: %w", err)
+		}
+		return exporter, closeOutput, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported trace exporter %q", options.Exporter)
+	}
+}
+
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Start opens an internal span under the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Detach keeps the span in ctx but drops its cancellation and deadline, for
+// goroutines that outlive the request that started them.
+func Detach(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return context.WithoutCancel(ctx)
+}
+
+// TraceID returns the hex trace ID in ctx, or "" when there is none.
+func TraceID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// Transport wraps base so every outbound request gets a client span and a
+// traceparent header. A nil base means http.DefaultTransport.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(base)
+}
+
+// SpanOnlyTransport records client spans like Transport but adds no trace
+// headers, for upstreams that must only ever see their own client's headers.
+func SpanOnlyTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(base, otelhttp.WithPropagators(propagation.NewCompositeTextMapPropagator()))
+}
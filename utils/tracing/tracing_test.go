@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetupStdoutExporterWritesSpans(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	path := filepath.Join(t.TempDir(), "spans.jsonl")
+	shutdown, err := Setup(context.Background(), Options{
+		Enabled:     true,
+		ServiceName: "tracing-test",
+		Exporter:    ExporterStdout,
+		StdoutPath:  path,
+		SampleRatio: 1,
+	})
+	if err != nil {
+		t.Fatalf("Setup returned error: %v", err)
+	}
+
+	ctx, parent := Start(context.Background(), "upload")
+	_, child := Start(ctx, "upload.persist")
+	End(child, errors.New("mongo unavailable"))
+	parent.End()
+	traceID := TraceID(ctx)
+	if len(traceID) != 32 {
+		t.Fatalf("TraceID = %q, want a 32 character hex id", traceID)
+	}
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown returned error: %v", err)
+	}
+	output, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read span output: %v", err)
+	}
+	text := string(output)
+	for _, want := range []string{`"Name":"upload"`, `"Name":"upload.persist"`, traceID, "mongo unavailable", "tracing-test"} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("span output missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestSetupDisabledIsNoop(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Options{Exporter: "unknown"})
+	if err != nil {
+		t.Fatalf("disabled Setup returned error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("disabled shutdown returned error: %v", err)
+	}
+	if _, err := Setup(context.Background(), Options{Enabled: true, Exporter: "unknown"}); err == nil {
+		t.Fatalf("expected unknown exporter to fail")
+	}
+}
+
+func TestDetachKeepsValuesWithoutCancellation(t *testing.T) {
+	type key struct{}
+	parent, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "upload"))
+	detached := Detach(parent)
+	cancel()
+	if detached.Err() != nil {
+		t.Fatalf("detached context was cancelled with its parent")
+	}
+	if detached.Value(key{}) != "upload" {
+		t.Fatalf("detached context lost parent values")
+	}
+	if TraceID(context.Background()) != "" {
+		t.Fatalf("expected no trace id without a span")
+	}
+}
+
+func TestTransportPropagation(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	send := func(transport http.RoundTripper) string {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("build request: %v", err)
+		}
+		resp, err := (&http.Client{Transport: transport}).Do(req)
+		if err != nil {
+			t.Fatalf("send request: %v", err)
+		}
+		_ = resp.Body.Close()
+		return <-received
+	}
+
+	if got := send(Transport(nil)); !strings.Contains(got, "4bf92f3577b34da6a3ce929d0e0e4736") {
+		t.Fatalf("Transport traceparent = %q, want the caller's trace id", got)
+	}
+	if got := send(SpanOnlyTransport(nil)); got != "" {
+		t.Fatalf("SpanOnlyTransport sent traceparent %q", got)
+	}
+}